@@ -6,6 +6,7 @@ import (
 	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
 	"math/rand"
 	"sync"
 	"time"
@@ -50,8 +51,30 @@ type bqlBox struct {
 	// removeMe is a function to remove this bqlBox from its
 	// topology. A nil check must be done before calling.
 	removeMe func()
+	// lateTuples is the source that tuples arriving too late for an
+	// event-time window are written to. It is nil unless the statement
+	// has an EMIT LATE TUPLES clause.
+	lateTuples *sideStreamSource
+	// name is the name of the stream executing this box.
+	name string
+	// errorStream is the name of the stream that tuples failing in the
+	// execution plan are written to, and errorTuples is its source. They
+	// are empty unless the statement has an ON ERROR clause.
+	errorStream string
+	errorTuples *sideStreamSource
+	// sideStreams has the sources of side streams taken over from the
+	// replaced box which this box doesn't write to. They are kept until
+	// commitReplacement is called so that they can be handed back when
+	// the previous statement is restored.
+	sideStreams map[string]*sideStreamSource
+	// inputs has the names of the nodes connected to this box,
+	// including temporary nodes created for UDSFs. It is used to
+	// disconnect obsolete inputs when the statement is replaced.
+	inputs []string
 }
 
+var _ core.StateInheritingBox = &bqlBox{}
+
 func NewBQLBox(stmt *parser.SelectStmt, reg udf.FunctionRegistry) *bqlBox {
 	return &bqlBox{stmt: stmt, reg: reg}
 }
@@ -93,10 +116,14 @@ func (b *bqlBox) Process(ctx *core.Context, t *core.Tuple, s core.Writer) error
 		return nil
 	}
 
-	// feed tuple into plan
-	resultData, err := b.execPlan.Process(t)
-	if err != nil {
-		return err
+	// feed tuple into plan. results computed before an error occurred
+	// are emitted before the error is handled.
+	resultData, procErr := b.execPlan.Process(t)
+	if procErr == execution.ErrLateTuple {
+		if b.lateTuples == nil {
+			return nil
+		}
+		return b.lateTuples.Write(ctx, t.ShallowCopy())
 	}
 
 	// emit result data as tuples
@@ -160,6 +187,12 @@ func (b *bqlBox) Process(ctx *core.Context, t *core.Tuple, s core.Writer) error
 	}
 	b.timeEmitterMutex.Unlock()
 
+	if procErr != nil {
+		if b.errorTuples == nil {
+			return procErr
+		}
+		return b.errorTuples.Write(ctx, b.errorTuple(t, procErr))
+	}
 	return nil
 }
 
@@ -225,9 +258,108 @@ func (b *bqlBox) Terminate(ctx *core.Context) error {
 	b.timeEmitterMutex.Lock()
 	b.stopped = true
 	b.timeEmitterMutex.Unlock()
+	if b.lateTuples != nil {
+		b.lateTuples.Stop(ctx)
+	}
+	if b.errorTuples != nil {
+		b.errorTuples.Stop(ctx)
+	}
+	for _, s := range b.sideStreams {
+		s.Stop(ctx)
+	}
+	return nil
+}
+
+// errorTuple creates a tuple written to the ON ERROR stream from a tuple
+// which couldn't be processed. It has the same fields as tuples emitted
+// from the dropped_tuples source.
+func (b *bqlBox) errorTuple(t *core.Tuple, err error) *core.Tuple {
+	et := t.ShallowCopy()
+	et.Data = data.Map{
+		"node_type":  data.String(core.NTBox.String()),
+		"node_name":  data.String(b.name),
+		"event_type": data.String(core.ETInput.String()),
+		"error":      data.String(err.Error()),
+		"data":       t.Data,
+	}
+	return et
+}
+
+// InheritState takes over the state of the bqlBox replaced by
+// CREATE OR REPLACE STREAM. When the FROM clause of the statement is
+// unchanged, the tuples in the window buffers of the previous box are
+// fed into the new execution plan. Results computed from them are
+// discarded since the previous box has already emitted them. The
+// sources of late tuples and of failed tuples are also taken over, and
+// the ones which aren't used by this box are kept until
+// commitReplacement is called.
+func (b *bqlBox) InheritState(ctx *core.Context, prev core.Box) error {
+	p, ok := prev.(*bqlBox)
+	if !ok {
+		return nil
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if p.stmt.WindowedFromAST.String() == b.stmt.WindowedFromAST.String() {
+		for _, t := range execution.BufferedTuples(p.execPlan) {
+			if _, err := b.execPlan.Process(t); err != nil &&
+				err != execution.ErrLateTuple {
+				return err
+			}
+		}
+	}
+
+	// the previous box must not stop the sources when it terminates
+	sides := p.sideStreams
+	if sides == nil {
+		sides = map[string]*sideStreamSource{}
+	}
+	if p.lateTuples != nil {
+		sides[lateTuplesStream(p.stmt)] = p.lateTuples
+	}
+	if p.errorTuples != nil {
+		sides[p.errorStream] = p.errorTuples
+	}
+	p.lateTuples, p.errorTuples, p.sideStreams = nil, nil, nil
+
+	if name := lateTuplesStream(b.stmt); b.lateTuples == nil && sides[name] != nil {
+		b.lateTuples = sides[name]
+		delete(sides, name)
+	}
+	if b.errorTuples == nil && sides[b.errorStream] != nil {
+		b.errorTuples = sides[b.errorStream]
+		delete(sides, b.errorStream)
+	}
+	b.sideStreams = sides
 	return nil
 }
 
+// commitReplacement stops the sources of the side streams taken over
+// from the replaced box which this box doesn't write to.
+func (b *bqlBox) commitReplacement(ctx *core.Context) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	for _, s := range b.sideStreams {
+		s.Stop(ctx)
+	}
+	b.sideStreams = nil
+}
+
+// lateTuplesStream returns the name of the stream to which the late
+// tuples of the statement are written, or an empty string if the
+// statement doesn't have an EMIT LATE TUPLES clause.
+func lateTuplesStream(stmt *parser.SelectStmt) string {
+	for _, rel := range stmt.Relations {
+		if rel.Lateness.Policy == parser.EmitLateTuples {
+			return string(rel.Lateness.Stream)
+		}
+	}
+	return ""
+}
+
 func (b *bqlBox) callRemoveMeIgnoringPanic() {
 	defer func() {
 		recover()
@@ -710,3 +710,119 @@ func TestBQLBoxSourceUDSF(t *testing.T) {
 		})
 	})
 }
+
+func TestBQLBoxUnnest(t *testing.T) {
+	Convey("Given a topology using UNNEST", t, func() {
+		tb, err := setupTopology(`CREATE STREAM box AS SELECT RSTREAM int, r:value AS v
+			FROM source [RANGE 1 TUPLES], UNNEST([int, int * 10]) AS r`, false)
+		So(err, ShouldBeNil)
+		dt := tb.Topology()
+		Reset(func() {
+			dt.Stop()
+		})
+
+		sin, err := dt.Sink("snk")
+		So(err, ShouldBeNil)
+		si := sin.Sink().(*tupleCollectorSink)
+
+		Convey("When 4 tuples are emitted by the source", func() {
+			Convey("Then the sink should receive a tuple for each element", func() {
+				si.Wait(8)
+				So(si.len(), ShouldEqual, 8)
+				So(si.get(2).Data, ShouldResemble, data.Map{"int": data.Int(2), "v": data.Int(2)})
+				So(si.get(3).Data, ShouldResemble, data.Map{"int": data.Int(2), "v": data.Int(20)})
+			})
+		})
+	})
+}
+
+func TestBQLBoxStateJoin(t *testing.T) {
+	Convey("Given a topology joining a stream with a kv state", t, func() {
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+		So(addBQLToTopology(tb, `
+			CREATE PAUSED SOURCE source TYPE dummy WITH num=4;
+			CREATE STATE people TYPE kv WITH key="id";`), ShouldBeNil)
+
+		s, err := dt.Context().SharedStates.Get("people")
+		So(err, ShouldBeNil)
+		w := s.(core.Writer)
+		for i, n := range []string{"a", "b"} {
+			So(w.Write(dt.Context(), core.NewTuple(data.Map{
+				"id":   data.Int(i + 1),
+				"name": data.String(n),
+			})), ShouldBeNil)
+		}
+
+		Convey("When joining it with JOIN STATE", func() {
+			So(addBQLToTopology(tb, `
+				CREATE STREAM box AS SELECT ISTREAM source:int, p:name
+					FROM source [RANGE 1 TUPLES] JOIN STATE people AS p ON source:int;
+				CREATE SINK snk TYPE collector;
+				INSERT INTO snk FROM box;
+				RESUME SOURCE source;`), ShouldBeNil)
+			sin, err := dt.Sink("snk")
+			So(err, ShouldBeNil)
+			si := sin.Sink().(*tupleCollectorSink)
+
+			Convey("Then the sink should only receive tuples having entries", func() {
+				si.Wait(2)
+				So(si.len(), ShouldEqual, 2)
+				So(si.get(0).Data, ShouldResemble, data.Map{"int": data.Int(1), "name": data.String("a")})
+				So(si.get(1).Data, ShouldResemble, data.Map{"int": data.Int(2), "name": data.String("b")})
+			})
+		})
+
+		Convey("When joining it with LEFT JOIN STATE", func() {
+			So(addBQLToTopology(tb, `
+				CREATE STREAM box AS SELECT ISTREAM source:int, p:name
+					FROM source [RANGE 1 TUPLES] LEFT JOIN STATE people AS p ON source:int;
+				CREATE SINK snk TYPE collector;
+				INSERT INTO snk FROM box;
+				RESUME SOURCE source;`), ShouldBeNil)
+			sin, err := dt.Sink("snk")
+			So(err, ShouldBeNil)
+			si := sin.Sink().(*tupleCollectorSink)
+
+			Convey("Then the sink should receive all tuples", func() {
+				si.Wait(4)
+				So(si.len(), ShouldEqual, 4)
+				So(si.get(1).Data, ShouldResemble, data.Map{"int": data.Int(2), "name": data.String("b")})
+				So(si.get(3).Data, ShouldResemble, data.Map{"int": data.Int(4), "name": data.Null{}})
+			})
+		})
+
+		Convey("When writing to it through a uds sink", func() {
+			So(addBQLToTopology(tb, `
+				CREATE STREAM box AS SELECT ISTREAM int AS id, "new" AS name
+					FROM source [RANGE 1 TUPLES] WHERE int > 2;
+				CREATE SINK people_sink TYPE uds WITH name="people";
+				INSERT INTO people_sink FROM box;
+				CREATE SINK snk TYPE collector;
+				INSERT INTO snk FROM box;
+				RESUME SOURCE source;`), ShouldBeNil)
+			sin, err := dt.Sink("snk")
+			So(err, ShouldBeNil)
+			si := sin.Sink().(*tupleCollectorSink)
+			si.Wait(2)
+
+			Convey("Then the state should be updated", func() {
+				ls := s.(core.LookupableSharedState)
+				// the uds sink may receive the tuple after the collector does
+				for i := 0; i < 100; i++ {
+					if _, err := ls.Lookup(dt.Context(), data.Int(4)); err == nil {
+						break
+					}
+					time.Sleep(10 * time.Millisecond)
+				}
+				m, err := ls.Lookup(dt.Context(), data.Int(4))
+				So(err, ShouldBeNil)
+				So(m["name"], ShouldEqual, data.String("new"))
+			})
+		})
+	})
+}
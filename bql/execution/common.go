@@ -22,6 +22,10 @@ type commonExecutionPlan struct {
 	// filter stores the evaluator of the filter condition,
 	// or nil if there is no WHERE clause.
 	filter Evaluator
+	// unnests holds the evaluators of the UNNEST clauses.
+	unnests []unnestEvaluator
+	// stateJoins holds the evaluators of the JOIN STATE clauses.
+	stateJoins []stateJoinEvaluator
 }
 
 func prepareProjections(projections []aliasedExpression, reg udf.FunctionRegistry) ([]aliasedEvaluator, error) {
@@ -90,6 +94,14 @@ func setMetadata(where data.Map, alias string, t *core.Tuple) {
 	where[tsKey] = data.Timestamp(t.Timestamp)
 }
 
+// setNullMetadata sets the metadata of the given relation to NULL
+// in the given Map. This is used for the NULL-padded rows of an
+// outer join.
+func setNullMetadata(where data.Map, alias string) {
+	tsKey := fmt.Sprintf("%s:meta:%s", alias, parser.TimestampMeta)
+	where[tsKey] = data.Null{}
+}
+
 // assignOutputValue writes the given Value `value` to the given
 // Map `where` using the given `path`.
 // If the `key` is "*" and the value is itself a Map, its contents
@@ -9,6 +9,10 @@ import (
 
 type defaultSelectExecutionPlan struct {
 	streamRelationStreamExecutionPlan
+	// analyticFuncs holds the evaluators of the analytic functions
+	// in the projections, keyed by the name under which their values
+	// are referenced.
+	analyticFuncs map[string]Evaluator
 }
 
 // CanBuildDefaultSelectExecutionPlan checks whether the given statement
@@ -31,8 +35,17 @@ func NewDefaultSelectExecutionPlan(lp *LogicalPlan, reg udf.FunctionRegistry) (P
 	if err != nil {
 		return nil, err
 	}
+	analyticFuncs := map[string]Evaluator{}
+	for key, af := range lp.AnalyticFuncs {
+		eval, err := ExpressionToEvaluator(af, reg)
+		if err != nil {
+			return nil, err
+		}
+		analyticFuncs[key] = eval
+	}
 	return &defaultSelectExecutionPlan{
 		*underlying,
+		analyticFuncs,
 	}, nil
 }
 
@@ -75,11 +88,19 @@ func (ep *defaultSelectExecutionPlan) performQueryOnBuffer() error {
 		ep.prevResults = output
 	}
 
+	// compute the values of the analytic functions, which depend
+	// on all rows in the window, so results cannot be cached then
+	analyticValues, err := ep.computeAnalyticFuncs()
+	if err != nil {
+		rollback()
+		return err
+	}
+
 	// function to compute the projection values and store
 	// the result in the `output` slice
-	evalItem := func(io *inputRowWithCachedResult) error {
+	evalItem := func(idx int, io *inputRowWithCachedResult) error {
 		// if we have a cached result, use this
-		if io.cache != nil {
+		if io.cache != nil && analyticValues == nil {
 			cachedResults, err := data.AsMap(io.cache)
 			if err != nil {
 				return fmt.Errorf("cached data was not a map: %v", io.cache)
@@ -89,6 +110,16 @@ func (ep *defaultSelectExecutionPlan) performQueryOnBuffer() error {
 		}
 		// otherwise, compute all the expressions
 		d := *io.input
+		if analyticValues != nil {
+			// add the precomputed values of the analytic functions
+			d = make(data.Map, len(*io.input)+len(analyticValues))
+			for key, value := range *io.input {
+				d[key] = value
+			}
+			for key, values := range analyticValues {
+				d[analyticKey(key)] = values[idx]
+			}
+		}
 		result := data.Map(make(map[string]data.Value, len(ep.projections)))
 		for _, proj := range ep.projections {
 			value, err := proj.evaluator.Eval(d)
@@ -109,14 +140,44 @@ func (ep *defaultSelectExecutionPlan) performQueryOnBuffer() error {
 	}
 
 	// compute the output for each item in ep.filteredInputRows
+	idx := 0
 	for e := ep.filteredInputRows.Front(); e != nil; e = e.Next() {
 		item := e.Value.(*inputRowWithCachedResult)
-		if err := evalItem(item); err != nil {
+		if err := evalItem(idx, item); err != nil {
 			rollback()
 			return err
 		}
+		idx++
 	}
 
 	ep.curResults = output
 	return nil
 }
+
+// computeAnalyticFuncs evaluates all analytic functions on the data
+// stored in `ep.filteredInputRows` and returns their values, keyed
+// by the name under which they are referenced. The i-th item of each
+// array belongs to the i-th row. If there are no analytic functions,
+// nil is returned.
+func (ep *defaultSelectExecutionPlan) computeAnalyticFuncs() (map[string]data.Array, error) {
+	if len(ep.analyticFuncs) == 0 {
+		return nil, nil
+	}
+	rows := make(data.Array, 0, ep.filteredInputRows.Len())
+	for e := ep.filteredInputRows.Front(); e != nil; e = e.Next() {
+		rows = append(rows, *e.Value.(*inputRowWithCachedResult).input)
+	}
+	values := make(map[string]data.Array, len(ep.analyticFuncs))
+	for key, eval := range ep.analyticFuncs {
+		v, err := eval.Eval(rows)
+		if err != nil {
+			return nil, err
+		}
+		arr, err := data.AsArray(v)
+		if err != nil {
+			return nil, err
+		}
+		values[key] = arr
+	}
+	return values, nil
+}
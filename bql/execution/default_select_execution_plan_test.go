@@ -83,6 +83,34 @@ func TestDefaultSelectExecutionPlan(t *testing.T) {
 		})
 	})
 
+	// Select a higher-order function referring to a column
+	Convey("Given a SELECT clause with a lambda expression", t, func() {
+		tuples := getTuples(4)
+		s := `CREATE STREAM box AS SELECT ISTREAM
+			filter([int, int * 2, int * 3], x -> x > 4 AND x != int * 2) AS f
+			FROM src [RANGE 1 TUPLES]`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			expected := []data.Array{
+				{},
+				{data.Int(6)},
+				{data.Int(9)},
+				{data.Int(12)},
+			}
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then the filtered array should appear in %v", idx), func() {
+					So(len(out), ShouldEqual, 1)
+					So(out[0], ShouldResemble, data.Map{"f": expected[idx]})
+				})
+			}
+		})
+	})
+
 	// Select a column with changing values
 	Convey("Given a SELECT clause with only a column", t, func() {
 		tuples := getTuples(4)
@@ -1562,6 +1590,462 @@ func sortedMapString(inMap data.Map) string {
 
 // tupleList implements sort.Interface for []data.Map based on
 // its string representation as per sortedMapString().
+func TestDefaultSelectExecutionPlanSlidingWindow(t *testing.T) {
+	Convey("Given a SELECT clause with a tuple-based tumbling window", t, func() {
+		tuples := getTuples(8)
+		s := `CREATE STREAM box AS SELECT ISTREAM int FROM src [RANGE 3 TUPLES TUMBLING]`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				sort.Sort(tupleList(out))
+
+				Convey(fmt.Sprintf("Then results should only appear every 3 tuples in %v", idx), func() {
+					if idx == 2 || idx == 5 {
+						So(len(out), ShouldEqual, 3)
+						for i, o := range out {
+							So(o, ShouldResemble, data.Map{"int": data.Int(idx - 1 + i)})
+						}
+					} else {
+						So(out, ShouldBeEmpty)
+					}
+				})
+			}
+		})
+	})
+
+	Convey("Given a SELECT clause with a time-based tumbling window and DSTREAM", t, func() {
+		tuples := getTuples(8)
+		s := `CREATE STREAM box AS SELECT DSTREAM int FROM src [RANGE 2 SECONDS TUMBLING]`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				sort.Sort(tupleList(out))
+
+				Convey(fmt.Sprintf("Then the previous window should be emitted in %v", idx), func() {
+					if idx == 4 || idx == 6 {
+						So(len(out), ShouldEqual, 2)
+						So(out[0], ShouldResemble, data.Map{"int": data.Int(idx - 3)})
+						So(out[1], ShouldResemble, data.Map{"int": data.Int(idx - 2)})
+					} else {
+						So(out, ShouldBeEmpty)
+					}
+				})
+			}
+		})
+	})
+
+	Convey("Given a SELECT clause with a hopping window and a gap in the input", t, func() {
+		tuples := getTuples(3)
+		tuples[1].Timestamp = tuples[0].Timestamp.Add(10 * time.Second)
+		tuples[2].Timestamp = tuples[0].Timestamp.Add(11 * time.Second)
+		s := `CREATE STREAM box AS SELECT ISTREAM int FROM src [RANGE 2 SECONDS SLIDE 1 SECONDS]`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			out, err := plan.Process(tuples[0])
+			So(err, ShouldBeNil)
+			So(out, ShouldBeEmpty)
+
+			Convey("Then the windows before the gap should be emitted", func() {
+				out, err := plan.Process(tuples[1])
+				So(err, ShouldBeNil)
+				So(out, ShouldResemble, []data.Map{{"int": data.Int(1)}})
+
+				Convey("And the empty windows in the gap should be skipped", func() {
+					ep := plan.(*defaultSelectExecutionPlan)
+					So(ep.nextBoundary, ShouldResemble, tuples[2].Timestamp)
+
+					Convey("And the next window should be emitted", func() {
+						out, err := plan.Process(tuples[2])
+						So(err, ShouldBeNil)
+						So(out, ShouldResemble, []data.Map{{"int": data.Int(2)}})
+					})
+				})
+			})
+		})
+	})
+}
+
+func TestDefaultSelectExecutionPlanEventTime(t *testing.T) {
+	// tuples with timestamps 0s, 2s, 1s, 3s arrive in that order
+	newTuples := func() []*core.Tuple {
+		tuples := getTuples(4)
+		tuples[1], tuples[2] = tuples[2], tuples[1]
+		return tuples
+	}
+	// lateTuple arrives after the tuple with timestamp 3s
+	lateTuple := &core.Tuple{
+		Data:      data.Map{"int": data.Int(99)},
+		InputName: "src",
+		Timestamp: time.Date(2015, time.April, 10, 10, 23, 1, 500000000, time.UTC),
+	}
+	expected := [][]data.Map{
+		nil,
+		{{"int": data.Int(1)}},
+		{{"int": data.Int(1)}, {"int": data.Int(2)}},
+		{{"int": data.Int(2)}, {"int": data.Int(3)}},
+	}
+
+	for _, policy := range []string{"", "DROP LATE TUPLES", "UPDATE LATE TUPLES",
+		"EMIT LATE TUPLES INTO late"} {
+		policy := policy
+
+		Convey(fmt.Sprintf("Given a SELECT clause with a LATENESS clause and policy '%s'", policy), t, func() {
+			tuples := newTuples()
+			s := `CREATE STREAM box AS SELECT RSTREAM int FROM src [RANGE 1 SECONDS, LATENESS 1 SECONDS ` +
+				policy + `]`
+			plan, err := createDefaultSelectPlan(s, t)
+			So(err, ShouldBeNil)
+
+			Convey("When feeding it with tuples out of order", func() {
+				for idx, inTup := range tuples {
+					out, err := plan.Process(inTup)
+					So(err, ShouldBeNil)
+					sort.Sort(tupleList(out))
+
+					Convey(fmt.Sprintf("Then tuples should be processed in timestamp order in %v", idx), func() {
+						So(out, ShouldResemble, expected[idx])
+					})
+				}
+
+				Convey("And when a tuple arrives after the watermark", func() {
+					out, err := plan.Process(lateTuple)
+
+					switch policy {
+					case "UPDATE LATE TUPLES":
+						Convey("Then it should be added to the window", func() {
+							So(err, ShouldBeNil)
+							sort.Sort(tupleList(out))
+							So(out, ShouldResemble, []data.Map{{"int": data.Int(2)}, {"int": data.Int(3)}, {"int": data.Int(99)}})
+
+							Convey("And it should be inserted in timestamp order", func() {
+								ep := plan.(*defaultSelectExecutionPlan)
+								var ints []data.Value
+								for e := ep.buffers["src"].tuples.Front(); e != nil; e = e.Next() {
+									ints = append(ints, e.Value.(*tupleWithDerivedInputRows).tuple.Data["src"].(data.Map)["int"])
+								}
+								So(ints, ShouldResemble, []data.Value{data.Int(2), data.Int(99), data.Int(3)})
+							})
+						})
+
+						Convey("Then a tuple outside of the current window should not be added", func() {
+							t := lateTuple.Copy()
+							t.Timestamp = tuples[0].Timestamp.Add(500 * time.Millisecond)
+							out, err := plan.Process(t)
+							So(err, ShouldBeNil)
+							sort.Sort(tupleList(out))
+							So(out, ShouldResemble, []data.Map{{"int": data.Int(2)}, {"int": data.Int(3)}, {"int": data.Int(99)}})
+						})
+
+					case "EMIT LATE TUPLES INTO late":
+						Convey("Then it should be reported as late", func() {
+							So(err, ShouldEqual, ErrLateTuple)
+							So(out, ShouldBeEmpty)
+						})
+
+					default:
+						Convey("Then it should be dropped", func() {
+							So(err, ShouldBeNil)
+							So(out, ShouldBeEmpty)
+
+							Convey("And it should not affect later results", func() {
+								tup := getTuples(5)[4]
+								out, err := plan.Process(tup)
+								So(err, ShouldBeNil)
+								sort.Sort(tupleList(out))
+								So(out, ShouldResemble, []data.Map{{"int": data.Int(3)}, {"int": data.Int(4)}})
+							})
+						})
+					}
+				})
+			})
+		})
+	}
+
+	Convey("Given a SELECT clause joining two streams with a LATENESS clause", t, func() {
+		tuples := getTuples(4)
+		tuples[1].InputName = "src2"
+		tuples[3].InputName = "src2"
+		s := `CREATE STREAM box AS SELECT RSTREAM src:int AS a, src2:int AS b
+			FROM src [RANGE 10 SECONDS, LATENESS 0 SECONDS],
+			src2 [RANGE 10 SECONDS, LATENESS 0 SECONDS]`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples from both streams", func() {
+			Convey("Then nothing should be processed before there is a tuple from each input", func() {
+				out, err := plan.Process(tuples[0])
+				So(err, ShouldBeNil)
+				So(out, ShouldBeEmpty)
+
+				Convey("And the watermark should follow the slowest input", func() {
+					out, err := plan.Process(tuples[2])
+					So(err, ShouldBeNil)
+					So(out, ShouldBeEmpty)
+
+					out, err = plan.Process(tuples[1])
+					So(err, ShouldBeNil)
+					So(out, ShouldResemble, []data.Map{{"a": data.Int(1), "b": data.Int(2)}})
+				})
+			})
+		})
+	})
+
+	Convey("Given a SELECT clause with a LATENESS clause and a function that can fail", t, func() {
+		fail := true
+		reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+		reg.Register("flaky", udf.UnaryFunc(func(ctx *core.Context, v data.Value) (data.Value, error) {
+			if fail && v.String() == "2" {
+				return nil, fmt.Errorf("flaky failed")
+			}
+			return v, nil
+		}))
+		s := `CREATE STREAM box AS SELECT RSTREAM flaky(int) AS int FROM src [RANGE 10 SECONDS, LATENESS 2 SECONDS]`
+		_stmt, _, err := parser.New().ParseStmt(s)
+		So(err, ShouldBeNil)
+		lp, err := Analyze(_stmt.(parser.CreateStreamAsSelectStmt).Select, reg)
+		So(err, ShouldBeNil)
+		plan, err := NewDefaultSelectExecutionPlan(lp, reg)
+		So(err, ShouldBeNil)
+		tuples := getTuples(4)
+
+		Convey("When processing a held back tuple fails", func() {
+			for _, inTup := range tuples[:2] {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				So(out, ShouldBeEmpty)
+			}
+			out, err := plan.Process(tuples[3])
+
+			Convey("Then the results of the tuples processed before should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(out, ShouldResemble, []data.Map{{"int": data.Int(1)}})
+			})
+
+			Convey("Then the tuple should be processed again with the next tuple", func() {
+				fail = false
+				out, err := plan.Process(tuples[2])
+				So(err, ShouldBeNil)
+				sort.Sort(tupleList(out))
+				So(out, ShouldResemble, []data.Map{{"int": data.Int(1)}, {"int": data.Int(2)}})
+			})
+		})
+	})
+}
+
+func TestDefaultSelectExecutionPlanSessionWindow(t *testing.T) {
+	Convey("Given a SELECT clause with a session window", t, func() {
+		tuples := getTuples(3)
+		tuples[2].Timestamp = tuples[1].Timestamp.Add(2 * time.Second)
+		s := `CREATE STREAM box AS SELECT ISTREAM int FROM src [SESSION GAP 1 SECONDS]`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				sort.Sort(tupleList(out))
+
+				Convey(fmt.Sprintf("Then all tuples of the session should be emitted after the gap in %v", idx), func() {
+					if idx == 2 {
+						So(out, ShouldResemble, []data.Map{{"int": data.Int(1)}, {"int": data.Int(2)}})
+					} else {
+						So(out, ShouldBeEmpty)
+					}
+				})
+			}
+		})
+	})
+
+	Convey("Given a SELECT clause with a session window and DSTREAM", t, func() {
+		s := `CREATE STREAM box AS SELECT DSTREAM int FROM src [SESSION GAP 1 SECONDS]`
+
+		Convey("Then the plan cannot be created", func() {
+			_, err := createDefaultSelectPlan(s, t)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldEqual, "DSTREAM cannot be used with a session window")
+		})
+	})
+}
+
+func TestDefaultSelectExecutionPlanOrderByLimit(t *testing.T) {
+	Convey("Given a SELECT clause with ORDER BY and LIMIT", t, func() {
+		tuples := getTuples(4)
+		s := `CREATE STREAM box AS SELECT RSTREAM int FROM src [RANGE 3 TUPLES] ORDER BY int DESC LIMIT 2`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then the largest values should appear in descending order in %v", idx), func() {
+					if idx == 0 {
+						So(out, ShouldResemble, []data.Map{{"int": data.Int(1)}})
+					} else {
+						So(out, ShouldResemble, []data.Map{
+							{"int": data.Int(idx + 1)},
+							{"int": data.Int(idx)},
+						})
+					}
+				})
+			}
+		})
+	})
+
+	Convey("Given a SELECT clause with LIMIT 0", t, func() {
+		tuples := getTuples(2)
+		s := `CREATE STREAM box AS SELECT ISTREAM int FROM src [RANGE 2 TUPLES] LIMIT 0`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then no results should appear in %v", idx), func() {
+					So(out, ShouldBeEmpty)
+				})
+			}
+		})
+	})
+}
+
+func TestDefaultSelectExecutionPlanDistinct(t *testing.T) {
+	Convey("Given a SELECT DISTINCT clause", t, func() {
+		tuples := getTuples(4)
+		foos := []string{"a", "b", "a", "a"}
+		for i, foo := range foos {
+			tuples[i].Data["foo"] = data.String(foo)
+		}
+		s := `CREATE STREAM box AS SELECT RSTREAM DISTINCT foo FROM src [RANGE 3 TUPLES]`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			expected := [][]data.Map{
+				{{"foo": data.String("a")}},
+				{{"foo": data.String("a")}, {"foo": data.String("b")}},
+				{{"foo": data.String("a")}, {"foo": data.String("b")}},
+				{{"foo": data.String("b")}, {"foo": data.String("a")}},
+			}
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then each value should appear only once in %v", idx), func() {
+					So(out, ShouldResemble, expected[idx])
+				})
+			}
+		})
+	})
+}
+
+func TestDefaultSelectExecutionPlanAnalyticFuncs(t *testing.T) {
+	Convey("Given a SELECT clause with analytic functions", t, func() {
+		tuples := getTuples(4)
+		foos := []string{"a", "b", "a", "b"}
+		for i, foo := range foos {
+			tuples[i].Data["foo"] = data.String(foo)
+		}
+		s := `CREATE STREAM box AS SELECT RSTREAM int,
+			int - lag(int) OVER (PARTITION BY foo ORDER BY ts()) AS diff,
+			row_number() OVER (PARTITION BY foo ORDER BY int DESC) AS rn,
+			sum(int) OVER (ORDER BY foo) AS s,
+			rank() OVER (ORDER BY foo) AS r
+			FROM src [RANGE 4 TUPLES]`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then the values should be computed per partition in %v", idx), func() {
+					if idx == 0 {
+						So(out, ShouldResemble, []data.Map{
+							{"int": data.Int(1), "diff": data.Null{}, "rn": data.Int(1), "s": data.Int(1), "r": data.Int(1)},
+						})
+					} else if idx == 3 {
+						So(out, ShouldResemble, []data.Map{
+							{"int": data.Int(1), "diff": data.Null{}, "rn": data.Int(2), "s": data.Int(4), "r": data.Int(1)},
+							{"int": data.Int(2), "diff": data.Null{}, "rn": data.Int(2), "s": data.Int(10), "r": data.Int(3)},
+							{"int": data.Int(3), "diff": data.Int(2), "rn": data.Int(1), "s": data.Int(4), "r": data.Int(1)},
+							{"int": data.Int(4), "diff": data.Int(2), "rn": data.Int(1), "s": data.Int(10), "r": data.Int(3)},
+						})
+					} else {
+						So(len(out), ShouldEqual, idx+1)
+					}
+				})
+			}
+		})
+	})
+
+	Convey("Given a SELECT clause with lead, first_value and last_value", t, func() {
+		tuples := getTuples(4)
+		foos := []string{"a", "b", "a", "b"}
+		for i, foo := range foos {
+			tuples[i].Data["foo"] = data.String(foo)
+		}
+		s := `CREATE STREAM box AS SELECT RSTREAM int,
+			lead(int, 2, -1) OVER (ORDER BY int DESC) AS next,
+			first_value(foo) OVER () AS f, last_value(foo) OVER () AS l
+			FROM src [RANGE 3 TUPLES]`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			var out []data.Map
+			for _, inTup := range tuples {
+				out, err = plan.Process(inTup)
+				So(err, ShouldBeNil)
+			}
+
+			Convey("Then the values should be computed over the whole window", func() {
+				So(out, ShouldResemble, []data.Map{
+					{"int": data.Int(2), "next": data.Int(-1), "f": data.String("b"), "l": data.String("b")},
+					{"int": data.Int(3), "next": data.Int(-1), "f": data.String("b"), "l": data.String("b")},
+					{"int": data.Int(4), "next": data.Int(2), "f": data.String("b"), "l": data.String("b")},
+				})
+			})
+		})
+	})
+
+	Convey("Given a SELECT clause with a wildcard and an analytic function", t, func() {
+		tuples := getTuples(2)
+		s := `CREATE STREAM box AS SELECT ISTREAM *, lag(int) OVER () AS prev FROM src [RANGE 2 TUPLES]`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			var out []data.Map
+			for _, inTup := range tuples {
+				out, err = plan.Process(inTup)
+				So(err, ShouldBeNil)
+			}
+
+			Convey("Then the wildcard should not contain the analytic values", func() {
+				So(out, ShouldResemble, []data.Map{
+					{"int": data.Int(2), "prev": data.Int(1)},
+				})
+			})
+		})
+	})
+}
+
 type tupleList []data.Map
 
 func (tl tupleList) Len() int {
@@ -2046,6 +2530,231 @@ func TestDefaultSelectExecutionPlanJoin(t *testing.T) {
 			}
 		})
 	})
+
+	Convey("Given an explicit JOIN with an equi-join condition", t, func() {
+		tuples := getTuples(8)
+		// rearrange the tuples
+		for i, t := range tuples {
+			if i%2 == 0 {
+				t.InputName = "src1"
+				t.Data["l"] = data.String(fmt.Sprintf("l%d", i))
+			} else {
+				t.InputName = "src2"
+				t.Data["r"] = data.String(fmt.Sprintf("r%d", i))
+			}
+		}
+		s := `CREATE STREAM box AS SELECT ISTREAM src1:l, src2:r FROM src1 [RANGE 2 TUPLES] ` +
+			`JOIN src2 [RANGE 2 TUPLES] ON src1:int + 1 = src2:int`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("Then the joined relation should have a hash index", func() {
+			ep := plan.(*defaultSelectExecutionPlan)
+			So(ep.buffers["src1"].join, ShouldBeNil)
+			So(ep.buffers["src2"].join, ShouldNotBeNil)
+			So(len(ep.buffers["src2"].join.keys), ShouldEqual, 1)
+			So(ep.buffers["src2"].join.index, ShouldNotBeNil)
+		})
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				// sort the output by the "l" and then the "r" key before
+				// checking if it resembles the expected value
+				sort.Sort(tupleList(out))
+
+				Convey(fmt.Sprintf("Then joined values should appear in %v", idx), func() {
+					if idx == 0 {
+						So(len(out), ShouldEqual, 0)
+					} else if idx == 1 {
+						So(len(out), ShouldEqual, 1)
+						So(out[0], ShouldResemble, data.Map{
+							"l": data.String("l0"), // int: 1
+							"r": data.String("r1"), // int: 2
+						})
+					} else if idx == 2 {
+						So(len(out), ShouldEqual, 0)
+					} else if idx%2 == 1 {
+						// a tuple from src2 (=right) was just added
+						So(len(out), ShouldEqual, 1)
+						So(out[0], ShouldResemble, data.Map{
+							"l": data.String(fmt.Sprintf("l%d", idx-1)), // int: x
+							"r": data.String(fmt.Sprintf("r%d", idx)),   // int: x+1
+						})
+					} else {
+						// a tuple from src1 (=left) was just added
+						So(len(out), ShouldEqual, 0)
+					}
+				})
+
+				Convey(fmt.Sprintf("Then the index should only hold buffered tuples in %v", idx), func() {
+					ep := plan.(*defaultSelectExecutionPlan)
+					buffer := ep.buffers["src2"]
+					n := 0
+					for _, bucket := range buffer.join.index {
+						n += len(bucket)
+					}
+					So(n, ShouldEqual, buffer.tuples.Len())
+				})
+			}
+		})
+	})
+
+	Convey("Given an explicit self-join with an equi-join condition", t, func() {
+		tuples := getTuples(8)
+		// rearrange the tuples
+		for i, t := range tuples {
+			t.InputName = "src"
+			t.Data["x"] = data.String(fmt.Sprintf("x%d", i))
+		}
+		s := `CREATE STREAM box AS SELECT ISTREAM src1:x AS l, src2:x AS r, src3:x AS x ` +
+			`FROM src [RANGE 2 TUPLES] AS src1 JOIN src [RANGE 2 TUPLES] AS src2 ON src1:int + 1 = src2:int ` +
+			`JOIN src [RANGE 3 TUPLES] AS src3 ON src2:int = src3:int + 1`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				// sort the output by the "l" and then the "r" key before
+				// checking if it resembles the expected value
+				sort.Sort(tupleList(out))
+
+				Convey(fmt.Sprintf("Then joined values should appear in %v", idx), func() {
+					if idx == 0 {
+						// join condition fails
+						So(len(out), ShouldEqual, 0)
+					} else {
+						So(len(out), ShouldEqual, 1)
+						So(out[0], ShouldResemble, data.Map{
+							"l": data.String(fmt.Sprintf("x%d", idx-1)), // int: x
+							"r": data.String(fmt.Sprintf("x%d", idx)),   // int: x+1
+							"x": data.String(fmt.Sprintf("x%d", idx-1)), // int: x
+						})
+					}
+				})
+			}
+		})
+	})
+
+	Convey("Given a LEFT OUTER JOIN", t, func() {
+		tuples := getTuples(8)
+		// rearrange the tuples
+		for i, t := range tuples {
+			if i%2 == 0 {
+				t.InputName = "src1"
+				t.Data["l"] = data.String(fmt.Sprintf("l%d", i))
+			} else {
+				t.InputName = "src2"
+				t.Data["r"] = data.String(fmt.Sprintf("r%d", i))
+			}
+		}
+		s := `CREATE STREAM box AS SELECT RSTREAM src1:l, src2:r FROM src1 [RANGE 2 TUPLES] ` +
+			`LEFT OUTER JOIN src2 [RANGE 2 TUPLES] ON src1:int + 1 = src2:int`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				// sort the output by the "l" and then the "r" key before
+				// checking if it resembles the expected value
+				sort.Sort(tupleList(out))
+
+				Convey(fmt.Sprintf("Then joined values should appear in %v", idx), func() {
+					if idx == 0 {
+						// there is no tuple in src2 yet
+						So(len(out), ShouldEqual, 1)
+						So(out[0], ShouldResemble, data.Map{
+							"l": data.String("l0"),
+							"r": data.Null{},
+						})
+					} else if idx == 1 {
+						So(len(out), ShouldEqual, 1)
+						So(out[0], ShouldResemble, data.Map{
+							"l": data.String("l0"), // int: 1
+							"r": data.String("r1"), // int: 2
+						})
+					} else if idx%2 == 1 {
+						// a tuple from src2 (=right) was just added
+						So(len(out), ShouldEqual, 2)
+						So(out[0], ShouldResemble, data.Map{
+							"l": data.String(fmt.Sprintf("l%d", idx-3)), // int: x-2
+							"r": data.String(fmt.Sprintf("r%d", idx-2)), // int: x-1
+						})
+						So(out[1], ShouldResemble, data.Map{
+							"l": data.String(fmt.Sprintf("l%d", idx-1)), // int: x
+							"r": data.String(fmt.Sprintf("r%d", idx)),   // int: x+1
+						})
+					} else {
+						// a tuple from src1 (=left) was just added and
+						// has no partner yet
+						So(len(out), ShouldEqual, 2)
+						So(out[0], ShouldResemble, data.Map{
+							"l": data.String(fmt.Sprintf("l%d", idx-2)), // int: x-1
+							"r": data.String(fmt.Sprintf("r%d", idx-1)), // int: x
+						})
+						So(out[1], ShouldResemble, data.Map{
+							"l": data.String(fmt.Sprintf("l%d", idx)), // int: x+1
+							"r": data.Null{},
+						})
+					}
+				})
+			}
+		})
+	})
+
+	Convey("Given a LEFT OUTER JOIN with an equi-join condition", t, func() {
+		calls := 0
+		reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+		reg.Register("counted", udf.UnaryFunc(func(ctx *core.Context, v data.Value) (data.Value, error) {
+			calls++
+			return v, nil
+		}))
+		s := `CREATE STREAM box AS SELECT RSTREAM src1:int AS l, src2:int AS r ` +
+			`FROM src1 [RANGE 2 TUPLES] LEFT OUTER JOIN src2 [RANGE 4 TUPLES] ` +
+			`ON src1:int = src2:int AND counted(true)`
+		_stmt, _, err := parser.New().ParseStmt(s)
+		So(err, ShouldBeNil)
+		lp, err := Analyze(_stmt.(parser.CreateStreamAsSelectStmt).Select, reg)
+		So(err, ShouldBeNil)
+		plan, err := NewDefaultSelectExecutionPlan(lp, reg)
+		So(err, ShouldBeNil)
+		tuples := getTuples(8)
+		for _, t := range tuples {
+			t.InputName = "src2"
+		}
+
+		Convey("When the rows are recomputed after a tuple arrives", func() {
+			// src2 has 1, 2, 3, 4
+			for _, t := range tuples[:4] {
+				_, err := plan.Process(t)
+				So(err, ShouldBeNil)
+			}
+			calls = 0
+			left := tuples[1].Copy()
+			left.InputName = "src1"
+			out, err := plan.Process(left)
+			So(err, ShouldBeNil)
+
+			Convey("Then the ON condition should only be evaluated on indexed matches", func() {
+				So(calls, ShouldEqual, 1)
+				So(out, ShouldResemble, []data.Map{{"l": data.Int(2), "r": data.Int(2)}})
+			})
+
+			Convey("Then the row should be NULL-padded when its partner expires", func() {
+				// src2 has 3, 4, 5, 6
+				for _, t := range tuples[4:6] {
+					out, err = plan.Process(t)
+					So(err, ShouldBeNil)
+				}
+				So(out, ShouldResemble, []data.Map{{"l": data.Int(2), "r": data.Null{}}})
+			})
+		})
+	})
 }
 
 func createDefaultSelectPlan2(s string) (PhysicalPlan, error) {
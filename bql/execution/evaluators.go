@@ -8,8 +8,10 @@ import (
 	"gopkg.in/sensorbee/sensorbee.v0/data"
 	"math"
 	"reflect"
+	"regexp"
 	"sort"
 	"strings"
+	"time"
 )
 
 // An Evaluator represents an expression such as `colX + 2` or
@@ -103,13 +105,31 @@ func ExpressionToEvaluator(ast FlatExpression, reg udf.FunctionRegistry) (Evalua
 				path = obj.Relation + "." + path
 			}
 		}
-		return newPathAccess(path)
+		pa, err := newPathAccess(path)
+		if err != nil {
+			return nil, err
+		}
+		// the relation is NULL for NULL-padded rows of an outer join
+		pa.(*pathAccess).relation = obj.Relation
+		return pa, nil
 	case aggInputRef:
 		return newPathAccess(obj.Ref)
+	case analyticRef:
+		return newPathAccess(fmt.Sprintf(`["%s"]`, analyticKey(obj.Ref)))
+	case groupingRef:
+		return newPathAccess(fmt.Sprintf(`["%s"]`, groupingKey(obj.Ref)))
+	case accumulatorRef:
+		return newPathAccess(fmt.Sprintf(`["%s"]`, accumulatorKey(obj.Ref)))
+	case lambdaParam:
+		return newPathAccess(fmt.Sprintf(`["%s"].%s`, lambdaScopeKey, obj.Column))
+	case higherOrderFuncAppAST:
+		return newHigherOrderFuncApp(obj, reg)
 	case nullLiteral:
 		return &nullConstant{}, nil
 	case numericLiteral:
 		return &intConstant{obj.Value}, nil
+	case intervalLiteral:
+		return &intConstant{obj.Value}, nil
 	case floatLiteral:
 		return &floatConstant{obj.Value}, nil
 	case boolLiteral:
@@ -148,6 +168,13 @@ func ExpressionToEvaluator(ast FlatExpression, reg udf.FunctionRegistry) (Evalua
 			return newGreaterOrnewEqual(bo), nil
 		case parser.NotEqual:
 			return newNot(newEqual(bo)), nil
+		case parser.Like, parser.NotLike, parser.ILike, parser.NotILike,
+			parser.RegexMatch, parser.NotRegexMatch:
+			return newPatternMatch(obj.Op, bo, obj.Right)
+		case parser.In:
+			return &in{bo}, nil
+		case parser.NotIn:
+			return newNot(&in{bo}), nil
 		case parser.Concat:
 			return &concat{bo}, nil
 		case parser.Is:
@@ -163,9 +190,9 @@ func ExpressionToEvaluator(ast FlatExpression, reg udf.FunctionRegistry) (Evalua
 				return newNot(newIsNull(left)), nil
 			}
 		case parser.Plus:
-			return newPlus(bo), nil
+			return newPlus(bo, isInterval(obj.Left) || isInterval(obj.Right)), nil
 		case parser.Minus:
-			return newMinus(bo), nil
+			return newMinus(bo, isInterval(obj.Right)), nil
 		case parser.Multiply:
 			return newMultiply(bo), nil
 		case parser.Divide:
@@ -173,6 +200,21 @@ func ExpressionToEvaluator(ast FlatExpression, reg udf.FunctionRegistry) (Evalua
 		case parser.Modulo:
 			return newModulo(bo), nil
 		}
+	case betweenAST:
+		// recurse
+		expr, err := ExpressionToEvaluator(obj.Expr, reg)
+		if err != nil {
+			return nil, err
+		}
+		lower, err := ExpressionToEvaluator(obj.Lower, reg)
+		if err != nil {
+			return nil, err
+		}
+		upper, err := ExpressionToEvaluator(obj.Upper, reg)
+		if err != nil {
+			return nil, err
+		}
+		return newBetween(expr, lower, upper), nil
 	case unaryOpAST:
 		// recurse
 		expr, err := ExpressionToEvaluator(obj.Expr, reg)
@@ -214,6 +256,9 @@ func ExpressionToEvaluator(ast FlatExpression, reg udf.FunctionRegistry) (Evalua
 		if err != nil {
 			return nil, err
 		}
+		if cs, ok := f.(udf.CallSiteUDF); ok {
+			f = cs.ForCallSite()
+		}
 		// compute child Evaluators
 		evals := make([]Evaluator, len(obj.Expressions))
 		for i, ast := range obj.Expressions {
@@ -226,6 +271,10 @@ func ExpressionToEvaluator(ast FlatExpression, reg udf.FunctionRegistry) (Evalua
 		return FuncApp(fName, f, reg.Context(), evals), nil
 	case aggregateInputSorter:
 		return newSortedInputAggFuncApp(obj.funcAppAST, obj.ID, obj.Ordering, reg)
+	case aggregateInputDistinct:
+		return newDistinctInputAggFuncApp(obj.funcAppAST, obj.ID, reg)
+	case analyticFuncAST:
+		return newAnalyticFuncApp(obj, reg)
 	case arrayAST:
 		// compute child Evaluators
 		evals := make([]Evaluator, len(obj.Expressions))
@@ -334,9 +383,11 @@ func (s *stringConstant) Eval(input data.Value) (data.Value, error) {
 }
 
 // pathAccess only works for maps and returns the Value at the given
-// JSON path.
+// JSON path. If relation is set and the value of the relation is NULL,
+// it returns NULL.
 type pathAccess struct {
-	path data.Path
+	path     data.Path
+	relation string
 }
 
 func (fa *pathAccess) Eval(input data.Value) (data.Value, error) {
@@ -344,6 +395,11 @@ func (fa *pathAccess) Eval(input data.Value) (data.Value, error) {
 	if err != nil {
 		return nil, err
 	}
+	if fa.relation != "" {
+		if v, ok := aMap[fa.relation]; ok && v.Type() == data.TypeNull {
+			return data.Null{}, nil
+		}
+	}
 	return aMap.Get(fa.path)
 }
 
@@ -352,7 +408,7 @@ func newPathAccess(s string) (Evaluator, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &pathAccess{path}, nil
+	return &pathAccess{path: path}, nil
 }
 
 type missingPathCheck struct {
@@ -674,53 +730,56 @@ func newEqual(bo binOp) Evaluator {
 }
 
 func newLess(bo binOp) Evaluator {
-	cmpOp := func(leftVal data.Value, rightVal data.Value) (bool, error) {
-		leftType := leftVal.Type()
-		rightType := rightVal.Type()
-		stdErr := fmt.Errorf("cannot compare %T and %T", leftVal, rightVal)
-		if leftType == rightType {
-			retVal := false
-			switch leftType {
-			default:
-				return false, stdErr
-			case data.TypeInt:
-				l, _ := data.AsInt(leftVal)
-				r, _ := data.AsInt(rightVal)
-				retVal = l < r
-			case data.TypeFloat:
-				l, _ := data.AsFloat(leftVal)
-				r, _ := data.AsFloat(rightVal)
-				retVal = l < r
-			case data.TypeString:
-				l, _ := data.AsString(leftVal)
-				r, _ := data.AsString(rightVal)
-				retVal = l < r
-			case data.TypeBool:
-				l, _ := data.AsBool(leftVal)
-				r, _ := data.AsBool(rightVal)
-				retVal = (l == false) && (r == true)
-			case data.TypeTimestamp:
-				l, _ := data.AsTimestamp(leftVal)
-				r, _ := data.AsTimestamp(rightVal)
-				retVal = l.Before(r)
-			}
-			return retVal, nil
-		} else if leftType == data.TypeInt && rightType == data.TypeFloat {
-			// left is integer
+	return &compBinOp{bo, less}
+}
+
+// less reports whether leftVal is smaller than rightVal. Neither value
+// may be NULL.
+func less(leftVal data.Value, rightVal data.Value) (bool, error) {
+	leftType := leftVal.Type()
+	rightType := rightVal.Type()
+	stdErr := fmt.Errorf("cannot compare %T and %T", leftVal, rightVal)
+	if leftType == rightType {
+		retVal := false
+		switch leftType {
+		default:
+			return false, stdErr
+		case data.TypeInt:
 			l, _ := data.AsInt(leftVal)
-			// right is float; also convert left to float to avoid overflow
-			r, _ := data.AsFloat(rightVal)
-			return float64(l) < r, nil
-		} else if leftType == data.TypeFloat && rightType == data.TypeInt {
-			// left is float
-			l, _ := data.AsFloat(leftVal)
-			// right is int; convert right to float to avoid overflow
 			r, _ := data.AsInt(rightVal)
-			return l < float64(r), nil
-		}
-		return false, stdErr
+			retVal = l < r
+		case data.TypeFloat:
+			l, _ := data.AsFloat(leftVal)
+			r, _ := data.AsFloat(rightVal)
+			retVal = l < r
+		case data.TypeString:
+			l, _ := data.AsString(leftVal)
+			r, _ := data.AsString(rightVal)
+			retVal = l < r
+		case data.TypeBool:
+			l, _ := data.AsBool(leftVal)
+			r, _ := data.AsBool(rightVal)
+			retVal = (l == false) && (r == true)
+		case data.TypeTimestamp:
+			l, _ := data.AsTimestamp(leftVal)
+			r, _ := data.AsTimestamp(rightVal)
+			retVal = l.Before(r)
+		}
+		return retVal, nil
+	} else if leftType == data.TypeInt && rightType == data.TypeFloat {
+		// left is integer
+		l, _ := data.AsInt(leftVal)
+		// right is float; also convert left to float to avoid overflow
+		r, _ := data.AsFloat(rightVal)
+		return float64(l) < r, nil
+	} else if leftType == data.TypeFloat && rightType == data.TypeInt {
+		// left is float
+		l, _ := data.AsFloat(leftVal)
+		// right is int; convert right to float to avoid overflow
+		r, _ := data.AsInt(rightVal)
+		return l < float64(r), nil
 	}
-	return &compBinOp{bo, cmpOp}
+	return false, stdErr
 }
 
 func newLessOrEqual(bo binOp) Evaluator {
@@ -739,6 +798,98 @@ func newNotEqual(bo binOp) Evaluator {
 	return newNot(newEqual(bo))
 }
 
+// between evaluates "expr BETWEEN lower AND upper". It has the same
+// semantics as "expr >= lower AND expr <= upper" but evaluates expr
+// only once, so a volatile expr is compared against both bounds with
+// the same value.
+type between struct {
+	expr  Evaluator
+	lower Evaluator
+	upper Evaluator
+}
+
+func (b *between) Eval(input data.Value) (data.Value, error) {
+	val, err := b.expr.Eval(input)
+	if err != nil {
+		return nil, err
+	}
+	lowerVal, err := b.lower.Eval(input)
+	if err != nil {
+		return nil, err
+	}
+	// NULL propagation as in "expr >= lower"
+	lowerNull := val.Type() == data.TypeNull || lowerVal.Type() == data.TypeNull
+	if !lowerNull {
+		isLess, err := less(val, lowerVal)
+		if err != nil {
+			return nil, err
+		}
+		if isLess {
+			// false AND ... => false
+			return data.Bool(false), nil
+		}
+	}
+	upperVal, err := b.upper.Eval(input)
+	if err != nil {
+		return nil, err
+	}
+	if val.Type() == data.TypeNull || upperVal.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	isLess, err := less(val, upperVal)
+	if err != nil {
+		return nil, err
+	}
+	if !isLess && !data.Equal(val, upperVal) {
+		// ... AND false => false
+		return data.Bool(false), nil
+	}
+	if lowerNull {
+		// NULL AND true => NULL
+		return data.Null{}, nil
+	}
+	return data.Bool(true), nil
+}
+
+// newBetween returns an Evaluator for "expr BETWEEN lower AND upper".
+func newBetween(expr, lower, upper Evaluator) Evaluator {
+	return &between{expr, lower, upper}
+}
+
+// in checks whether the left value is contained in the right array.
+// As in SQL, the result is NULL (not false) if the value is not found
+// and the array contains a NULL value.
+type in struct {
+	binOp
+}
+
+func (i *in) Eval(input data.Value) (data.Value, error) {
+	leftVal, rightVal, err := i.evalLeftAndRight(input)
+	if err != nil {
+		return nil, err
+	}
+	// NULL propagation
+	if leftVal.Type() == data.TypeNull || rightVal.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	list, err := data.AsArray(rightVal)
+	if err != nil {
+		return nil, fmt.Errorf("right operand of IN must be array: %v", rightVal)
+	}
+	foundNull := false
+	for _, v := range list {
+		if v.Type() == data.TypeNull {
+			foundNull = true
+		} else if data.Equal(leftVal, v) {
+			return data.Bool(true), nil
+		}
+	}
+	if foundNull {
+		return data.Null{}, nil
+	}
+	return data.Bool(false), nil
+}
+
 /// A Unary Comparison Operation
 
 type isNull struct {
@@ -766,6 +917,10 @@ type numBinOp struct {
 	verb    string
 	intOp   func(int64, int64) int64
 	floatOp func(float64, float64) float64
+	// timestampOp is applied instead of intOp and floatOp if one of
+	// the operands is a Timestamp. It's nil unless the operation is
+	// applied to a Timestamp and an interval.
+	timestampOp func(data.Value, data.Value) (data.Value, error)
 }
 
 func (nbo *numBinOp) Eval(input data.Value) (v data.Value, err error) {
@@ -788,6 +943,12 @@ func (nbo *numBinOp) Eval(input data.Value) (v data.Value, err error) {
 		return data.Null{}, nil
 	}
 	stdErr := fmt.Errorf("cannot %s %T and %T", nbo.verb, leftVal, rightVal)
+	if leftType == data.TypeTimestamp || rightType == data.TypeTimestamp {
+		if nbo.timestampOp == nil {
+			return nil, stdErr
+		}
+		return nbo.timestampOp(leftVal, rightVal)
+	}
 	// if we have same types (both int64 or both float64, apply
 	// the corresponding operation)
 	if leftType == rightType {
@@ -819,7 +980,24 @@ func (nbo *numBinOp) Eval(input data.Value) (v data.Value, err error) {
 	return nil, stdErr
 }
 
-func newPlus(bo binOp) Evaluator {
+// intervalToDuration converts an interval, which is an Int or a Float
+// value in microseconds, to a time.Duration.
+func intervalToDuration(v data.Value) (time.Duration, bool) {
+	switch v.Type() {
+	case data.TypeInt:
+		i, _ := data.AsInt(v)
+		return time.Duration(i) * time.Microsecond, true
+	case data.TypeFloat:
+		f, _ := data.AsFloat(v)
+		return time.Duration(f * float64(time.Microsecond)), true
+	}
+	return 0, false
+}
+
+// newPlus returns an evaluator adding the operands. If interval is true,
+// one of the operands is an interval, which can be added to a Timestamp
+// given by the other one.
+func newPlus(bo binOp, interval bool) Evaluator {
 	// we do not check for overflows
 	intOp := func(a, b int64) int64 {
 		return a + b
@@ -827,10 +1005,27 @@ func newPlus(bo binOp) Evaluator {
 	floatOp := func(a, b float64) float64 {
 		return a + b
 	}
-	return &numBinOp{bo, "add", intOp, floatOp}
+	nbo := &numBinOp{bo, "add", intOp, floatOp, nil}
+	if interval {
+		nbo.timestampOp = func(a, b data.Value) (data.Value, error) {
+			if b.Type() == data.TypeTimestamp {
+				a, b = b, a
+			}
+			t, _ := data.AsTimestamp(a)
+			d, ok := intervalToDuration(b)
+			if !ok {
+				return nil, fmt.Errorf("cannot add %T and %T", a, b)
+			}
+			return data.Timestamp(t.Add(d)), nil
+		}
+	}
+	return nbo
 }
 
-func newMinus(bo binOp) Evaluator {
+// newMinus returns an evaluator subtracting the right operand from the
+// left one. If interval is true, the right operand is an interval, which
+// can be subtracted from a Timestamp.
+func newMinus(bo binOp, interval bool) Evaluator {
 	// we do not check for overflows
 	intOp := func(a, b int64) int64 {
 		return a - b
@@ -838,7 +1033,21 @@ func newMinus(bo binOp) Evaluator {
 	floatOp := func(a, b float64) float64 {
 		return a - b
 	}
-	return &numBinOp{bo, "subtract", intOp, floatOp}
+	nbo := &numBinOp{bo, "subtract", intOp, floatOp, nil}
+	if interval {
+		nbo.timestampOp = func(a, b data.Value) (data.Value, error) {
+			t, err := data.AsTimestamp(a)
+			if err != nil {
+				return nil, fmt.Errorf("cannot subtract %T and %T", a, b)
+			}
+			d, ok := intervalToDuration(b)
+			if !ok {
+				return nil, fmt.Errorf("cannot subtract %T and %T", a, b)
+			}
+			return data.Timestamp(t.Add(-d)), nil
+		}
+	}
+	return nbo
 }
 
 func newMultiply(bo binOp) Evaluator {
@@ -849,7 +1058,7 @@ func newMultiply(bo binOp) Evaluator {
 	floatOp := func(a, b float64) float64 {
 		return a * b
 	}
-	return &numBinOp{bo, "multiply", intOp, floatOp}
+	return &numBinOp{bo, "multiply", intOp, floatOp, nil}
 }
 
 func newDivide(bo binOp) Evaluator {
@@ -860,7 +1069,7 @@ func newDivide(bo binOp) Evaluator {
 	floatOp := func(a, b float64) float64 {
 		return a / b
 	}
-	return &numBinOp{bo, "divide", intOp, floatOp}
+	return &numBinOp{bo, "divide", intOp, floatOp, nil}
 }
 
 func newModulo(bo binOp) Evaluator {
@@ -870,7 +1079,7 @@ func newModulo(bo binOp) Evaluator {
 	floatOp := func(a, b float64) float64 {
 		return math.Mod(a, b)
 	}
-	return &numBinOp{bo, "compute modulo for", intOp, floatOp}
+	return &numBinOp{bo, "compute modulo for", intOp, floatOp, nil}
 }
 
 /// Other Binary Operations
@@ -907,6 +1116,104 @@ func (nbo *concat) Eval(input data.Value) (v data.Value, err error) {
 	return data.String(leftString + rightString), nil
 }
 
+/// Pattern Matching Operations
+
+// patternMatch matches the left string against the pattern given by
+// the right operand. LIKE and ILIKE patterns are translated into
+// regular expressions by compile. When the pattern is a constant, it
+// is compiled only once when the evaluator is created.
+type patternMatch struct {
+	binOp
+	op      parser.Operator
+	compile func(pattern string) (*regexp.Regexp, error)
+	re      *regexp.Regexp
+}
+
+func newPatternMatch(op parser.Operator, bo binOp, pattern FlatExpression) (Evaluator, error) {
+	compile := regexp.Compile
+	switch op {
+	case parser.Like, parser.NotLike:
+		compile = func(pattern string) (*regexp.Regexp, error) {
+			return compileLikePattern(pattern, false)
+		}
+	case parser.ILike, parser.NotILike:
+		compile = func(pattern string) (*regexp.Regexp, error) {
+			return compileLikePattern(pattern, true)
+		}
+	}
+	pm := &patternMatch{bo, op, compile, nil}
+	if lit, ok := pattern.(stringLiteral); ok {
+		re, err := compile(lit.Value)
+		if err != nil {
+			return nil, err
+		}
+		pm.re = re
+	}
+	switch op {
+	case parser.NotLike, parser.NotILike, parser.NotRegexMatch:
+		return newNot(pm), nil
+	}
+	return pm, nil
+}
+
+func (pm *patternMatch) Eval(input data.Value) (data.Value, error) {
+	leftVal, rightVal, err := pm.evalLeftAndRight(input)
+	if err != nil {
+		return nil, err
+	}
+	// NULL propagation
+	if leftVal.Type() == data.TypeNull || rightVal.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	str, err := data.AsString(leftVal)
+	if err != nil {
+		return nil, fmt.Errorf("left operand of %s must be string: %v", pm.op, leftVal)
+	}
+	re := pm.re
+	if re == nil {
+		pattern, err := data.AsString(rightVal)
+		if err != nil {
+			return nil, fmt.Errorf("right operand of %s must be string: %v", pm.op, rightVal)
+		}
+		re, err = pm.compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return data.Bool(re.MatchString(str)), nil
+}
+
+// compileLikePattern translates a LIKE pattern into a regular
+// expression that matches the whole string. In the pattern, "%"
+// matches any sequence of characters, "_" matches any single
+// character and a backslash escapes the following character.
+func compileLikePattern(pattern string, caseInsensitive bool) (*regexp.Regexp, error) {
+	expr := "(?s)^"
+	if caseInsensitive {
+		expr = "(?is)^"
+	}
+	escaped := false
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			expr += regexp.QuoteMeta(string(r))
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '%':
+			expr += ".*"
+		case r == '_':
+			expr += "."
+		default:
+			expr += regexp.QuoteMeta(string(r))
+		}
+	}
+	if escaped {
+		return nil, fmt.Errorf("LIKE pattern must not end with escape character: %s", pattern)
+	}
+	return regexp.Compile(expr + "$")
+}
+
 /// Function Evaluation
 
 type funcApp struct {
@@ -1108,6 +1415,484 @@ func newSortedInputAggFuncApp(obj funcAppAST, id string, ordering []sortExpressi
 	return &sortedInputAggFuncApp{backendFun, inOutKeys, sortEvals}, nil
 }
 
+/// Aggregate Function with Distinct Input
+
+type distinctInputAggFuncApp struct {
+	f         Evaluator
+	inKeys    []string
+	inOutKeys map[string]string
+}
+
+func (d *distinctInputAggFuncApp) Eval(input data.Value) (v data.Value, err error) {
+	// catch panic (e.g., in called function)
+	defer func() {
+		if r := recover(); r != nil {
+			v = nil
+			err = fmt.Errorf("evaluating %v paniced: %s", d.f, r)
+		}
+	}()
+	inputMap, err := data.AsMap(input)
+	if err != nil {
+		return nil, err
+	}
+	// extract the arrays that contain the data to be deduplicated
+	inArrs := make([]data.Array, len(d.inKeys))
+	for i, key := range d.inKeys {
+		inData, ok := inputMap[key]
+		if !ok {
+			return nil, fmt.Errorf("there was no aggregate data with key '%s'", key)
+		}
+		arr, err := data.AsArray(inData)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 && len(arr) != len(inArrs[0]) {
+			return nil, fmt.Errorf("aggregate data with key '%s' had bad length (%d, not %d)",
+				key, len(arr), len(inArrs[0]))
+		}
+		inArrs[i] = arr
+	}
+
+	// find the indexes of the first occurrence of every distinct
+	// combination of values
+	var indexes []int
+	if len(inArrs) > 0 {
+		seen := map[data.HashValue][]data.Array{}
+		for i := range inArrs[0] {
+			value := make(data.Array, len(inArrs))
+			for j, arr := range inArrs {
+				value[j] = arr[i]
+			}
+			h := data.Hash(value)
+			found := false
+			for _, other := range seen[h] {
+				if data.Equal(value, other) {
+					found = true
+					break
+				}
+			}
+			if found {
+				continue
+			}
+			seen[h] = append(seen[h], value)
+			indexes = append(indexes, i)
+		}
+	}
+
+	// now use the index array to write a deduplicated copy of the data
+	for i, key := range d.inKeys {
+		distinctArr := make(data.Array, len(indexes))
+		for j, idx := range indexes {
+			distinctArr[j] = inArrs[i][idx]
+		}
+		inputMap[d.inOutKeys[key]] = distinctArr
+	}
+
+	return d.f.Eval(input)
+}
+
+func newDistinctInputAggFuncApp(obj funcAppAST, id string, reg udf.FunctionRegistry) (Evaluator, error) {
+	// Similar to newSortedInputAggFuncApp, we add deduplicated
+	// versions of the arrays of aggregate parameters to the input
+	// data, suffixed with the given id, and change the evaluators
+	// of those parameters to use them. Note that for a function call
+	// such as f(DISTINCT a, b), the combination of a and b must be
+	// distinct, so all arrays are deduplicated at the same time.
+
+	// lookup function in function registry
+	// (the registry will decide if the requested function
+	// is callable with the given number of arguments).
+	fName := string(obj.Function)
+	f, err := reg.Lookup(fName, len(obj.Expressions))
+	if err != nil {
+		return nil, err
+	}
+	// compute child Evaluators
+	inKeys := []string{}
+	inOutKeys := map[string]string{}
+	evals := make([]Evaluator, len(obj.Expressions))
+	for i, ast := range obj.Expressions {
+		if inputRef, ok := ast.(aggInputRef); ok {
+			newRef := inputRef.Ref + "_" + id
+			ast = aggInputRef{newRef}
+			if _, ok := inOutKeys[inputRef.Ref]; !ok {
+				inKeys = append(inKeys, inputRef.Ref)
+				inOutKeys[inputRef.Ref] = newRef
+			}
+		}
+		eval, err := ExpressionToEvaluator(ast, reg)
+		if err != nil {
+			return nil, err
+		}
+		evals[i] = eval
+	}
+	backendFun := FuncApp(fName, f, reg.Context(), evals)
+
+	return &distinctInputAggFuncApp{backendFun, inKeys, inOutKeys}, nil
+}
+
+/// Analytic Functions
+
+// analyticPartition holds the data of all rows in one partition of an
+// analytic function, sorted as per the ORDER BY part of the OVER clause.
+type analyticPartition struct {
+	// params[i][j] holds the value of the i-th parameter for the j-th row.
+	params [][]data.Value
+	// peerStart[j] and peerEnd[j] hold the range of rows that are equal
+	// to the j-th row with respect to the ORDER BY part of the OVER
+	// clause (if there is none, all rows in the partition are equal).
+	peerStart []int
+	peerEnd   []int
+}
+
+// analyticFunc computes the values of an analytic function for all
+// rows of one partition.
+type analyticFunc func(p *analyticPartition) ([]data.Value, error)
+
+// analyticFuncs holds the built-in analytic functions together with
+// the number of parameters they accept. Aggregate functions can also
+// be used with an OVER clause, see newAnalyticFuncApp.
+var analyticFuncs = map[string]struct {
+	minArity int
+	maxArity int
+	f        analyticFunc
+}{
+	"row_number":  {0, 0, rowNumber},
+	"rank":        {0, 0, rank},
+	"lag":         {1, 3, lagLead(-1)},
+	"lead":        {1, 3, lagLead(1)},
+	"first_value": {1, 1, firstValue},
+	"last_value":  {1, 1, lastValue},
+}
+
+// rowNumber returns the number of each row in its partition,
+// starting with 1.
+func rowNumber(p *analyticPartition) ([]data.Value, error) {
+	values := make([]data.Value, len(p.peerStart))
+	for i := range values {
+		values[i] = data.Int(i + 1)
+	}
+	return values, nil
+}
+
+// rank returns the rank of each row in its partition, i.e., the row
+// number of the first row that is equal to it.
+func rank(p *analyticPartition) ([]data.Value, error) {
+	values := make([]data.Value, len(p.peerStart))
+	for i := range values {
+		values[i] = data.Int(p.peerStart[i] + 1)
+	}
+	return values, nil
+}
+
+// lagLead returns a function that computes the value of its first
+// parameter in the row `offset` rows before (direction < 0) or after
+// (direction > 0) each row. The offset is given by the second parameter
+// and defaults to 1. If there is no such row, the third parameter
+// (which defaults to NULL) is returned.
+func lagLead(direction int) analyticFunc {
+	return func(p *analyticPartition) ([]data.Value, error) {
+		values := make([]data.Value, len(p.peerStart))
+		for i := range values {
+			offset := int64(1)
+			if len(p.params) > 1 {
+				o, err := data.AsInt(p.params[1][i])
+				if err != nil {
+					return nil, fmt.Errorf("offset must be an integer: %v", err)
+				}
+				offset = o
+			}
+			j := int64(i) + int64(direction)*offset
+			if j >= 0 && j < int64(len(values)) {
+				values[i] = p.params[0][j]
+			} else if len(p.params) > 2 {
+				values[i] = p.params[2][i]
+			} else {
+				values[i] = data.Null{}
+			}
+		}
+		return values, nil
+	}
+}
+
+// firstValue returns the value of its parameter in the first row
+// of the partition.
+func firstValue(p *analyticPartition) ([]data.Value, error) {
+	values := make([]data.Value, len(p.peerStart))
+	for i := range values {
+		values[i] = p.params[0][0]
+	}
+	return values, nil
+}
+
+// lastValue returns the value of its parameter in the last row
+// of the partition.
+func lastValue(p *analyticPartition) ([]data.Value, error) {
+	values := make([]data.Value, len(p.peerStart))
+	for i := range values {
+		values[i] = p.params[0][len(values)-1]
+	}
+	return values, nil
+}
+
+// cumulativeAggregate returns a function that computes an aggregate
+// function over all rows from the start of the partition up to and
+// including all rows equal to each row. That is, if there is an ORDER
+// BY part in the OVER clause, this is a running aggregate, otherwise
+// it is the aggregate over the whole partition. The running values are
+// computed in one pass by an Accumulator if the aggregate function
+// provides one, otherwise the function is called for every prefix of
+// the partition.
+func cumulativeAggregate(f udf.UDF, ctx *core.Context) analyticFunc {
+	if inc, ok := f.(udf.IncrementalUDF); ok {
+		return runningAggregate(inc, ctx)
+	}
+	return func(p *analyticPartition) ([]data.Value, error) {
+		values := make([]data.Value, len(p.peerStart))
+		args := make([]data.Value, len(p.params))
+		for i := range values {
+			if i > 0 && p.peerStart[i] == p.peerStart[i-1] {
+				// the aggregate is the same for all equal rows
+				values[i] = values[i-1]
+				continue
+			}
+			for k, param := range p.params {
+				if f.IsAggregationParameter(k) {
+					// copy the values in case the function modifies them
+					args[k] = append(data.Array(nil), param[:p.peerEnd[i]]...)
+				} else {
+					args[k] = param[i]
+				}
+			}
+			v, err := f.Call(ctx, args...)
+			if err != nil {
+				return nil, err
+			}
+			values[i] = v
+		}
+		return values, nil
+	}
+}
+
+// runningAggregate is the counterpart of cumulativeAggregate for
+// aggregate functions whose result can be maintained by an Accumulator.
+// The rows are added to the Accumulator one by one and its result is
+// taken after the last row of each range of equal rows.
+func runningAggregate(f udf.IncrementalUDF, ctx *core.Context) analyticFunc {
+	return func(p *analyticPartition) ([]data.Value, error) {
+		acc, err := f.NewAccumulator(ctx)
+		if err != nil {
+			return nil, err
+		}
+		values := make([]data.Value, len(p.peerStart))
+		for i := range values {
+			args := make([]data.Value, len(p.params))
+			for k, param := range p.params {
+				args[k] = param[i]
+			}
+			if err := acc.Add(args...); err != nil {
+				return nil, err
+			}
+			if i+1 < p.peerEnd[i] {
+				// the aggregate is the same for all equal rows
+				continue
+			}
+			v, err := acc.Result()
+			if err != nil {
+				return nil, err
+			}
+			for j := p.peerStart[i]; j <= i; j++ {
+				values[j] = v
+			}
+		}
+		return values, nil
+	}
+}
+
+// analyticFuncApp evaluates an analytic function. In contrast to other
+// Evaluators, it must be evaluated on a data.Array holding all rows
+// of the current window and returns a data.Array holding the value
+// for each of those rows.
+type analyticFuncApp struct {
+	name      string
+	f         analyticFunc
+	params    []Evaluator
+	partition []Evaluator
+	ordering  []sortEvaluator
+}
+
+func (a *analyticFuncApp) Eval(input data.Value) (v data.Value, err error) {
+	// catch panic (e.g., in called function)
+	defer func() {
+		if r := recover(); r != nil {
+			v = nil
+			err = fmt.Errorf("evaluating '%s' paniced: %s", a.name, r)
+		}
+	}()
+	rows, err := data.AsArray(input)
+	if err != nil {
+		return nil, err
+	}
+
+	// assign the rows to partitions in order of their first appearance
+	var partitions [][]int
+	var partitionKeys []data.Array
+	partitionsByHash := map[data.HashValue][]int{}
+	for i, row := range rows {
+		key := make(data.Array, len(a.partition))
+		for j, eval := range a.partition {
+			value, err := eval.Eval(row)
+			if err != nil {
+				return nil, err
+			}
+			key[j] = value
+		}
+		h := data.Hash(key)
+		idx := -1
+		for _, p := range partitionsByHash[h] {
+			if data.Equal(partitionKeys[p], key) {
+				idx = p
+				break
+			}
+		}
+		if idx < 0 {
+			idx = len(partitions)
+			partitions = append(partitions, nil)
+			partitionKeys = append(partitionKeys, key)
+			partitionsByHash[h] = append(partitionsByHash[h], idx)
+		}
+		partitions[idx] = append(partitions[idx], i)
+	}
+
+	results := make(data.Array, len(rows))
+	for _, indexes := range partitions {
+		if err := a.evalPartition(rows, indexes, results); err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+// evalPartition computes the values of the analytic function for
+// the rows with the given indexes and writes them to results.
+func (a *analyticFuncApp) evalPartition(rows data.Array, indexes []int, results data.Array) error {
+	n := len(indexes)
+	p := &analyticPartition{
+		params:    make([][]data.Value, len(a.params)),
+		peerStart: make([]int, n),
+		peerEnd:   make([]int, n),
+	}
+
+	if len(a.ordering) > 0 {
+		// sort the rows (keeping the order of equal rows)
+		sortData := make([]sortArray, len(a.ordering))
+		for i, sortEval := range a.ordering {
+			values := make(data.Array, n)
+			for j, idx := range indexes {
+				value, err := sortEval.eval.Eval(rows[idx])
+				if err != nil {
+					return err
+				}
+				values[j] = value
+			}
+			sortData[i] = sortArray{values, sortEval.ascending}
+		}
+		positions := make([]int, n)
+		for i := range positions {
+			positions[i] = i
+		}
+		is := &indexSlice{positions, sortData}
+		sort.Stable(is)
+		sorted := make([]int, n)
+		for i, pos := range positions {
+			sorted[i] = indexes[pos]
+		}
+		indexes = sorted
+
+		// compute the ranges of equal rows
+		for i := 0; i < n; i++ {
+			if i > 0 && !is.Less(i-1, i) {
+				p.peerStart[i] = p.peerStart[i-1]
+			} else {
+				p.peerStart[i] = i
+			}
+		}
+		for i := n - 1; i >= 0; i-- {
+			if i < n-1 && p.peerStart[i+1] == p.peerStart[i] {
+				p.peerEnd[i] = p.peerEnd[i+1]
+			} else {
+				p.peerEnd[i] = i + 1
+			}
+		}
+	} else {
+		for i := range indexes {
+			p.peerStart[i] = 0
+			p.peerEnd[i] = n
+		}
+	}
+
+	for i, eval := range a.params {
+		p.params[i] = make([]data.Value, n)
+		for j, idx := range indexes {
+			value, err := eval.Eval(rows[idx])
+			if err != nil {
+				return err
+			}
+			p.params[i][j] = value
+		}
+	}
+
+	values, err := a.f(p)
+	if err != nil {
+		return err
+	}
+	for i, idx := range indexes {
+		results[idx] = values[i]
+	}
+	return nil
+}
+
+func newAnalyticFuncApp(obj analyticFuncAST, reg udf.FunctionRegistry) (Evaluator, error) {
+	fName := string(obj.Function)
+	var f analyticFunc
+	if af, ok := analyticFuncs[fName]; ok {
+		f = af.f
+	} else {
+		// lookup aggregate function in function registry
+		aggFun, err := reg.Lookup(fName, len(obj.Expressions))
+		if err != nil {
+			return nil, err
+		}
+		f = cumulativeAggregate(aggFun, reg.Context())
+	}
+	// compute child Evaluators
+	params := make([]Evaluator, len(obj.Expressions))
+	for i, ast := range obj.Expressions {
+		eval, err := ExpressionToEvaluator(ast, reg)
+		if err != nil {
+			return nil, err
+		}
+		params[i] = eval
+	}
+	partition := make([]Evaluator, len(obj.Partition))
+	for i, ast := range obj.Partition {
+		eval, err := ExpressionToEvaluator(ast, reg)
+		if err != nil {
+			return nil, err
+		}
+		partition[i] = eval
+	}
+	ordering := make([]sortEvaluator, len(obj.Ordering))
+	for i, o := range obj.Ordering {
+		eval, err := ExpressionToEvaluator(o.expr, reg)
+		if err != nil {
+			return nil, err
+		}
+		ordering[i] = sortEvaluator{eval, o.ascending}
+	}
+	return &analyticFuncApp{fName, f, params, partition, ordering}, nil
+}
+
 /// JSON-like data structures
 
 type arrayBuilder struct {
@@ -1190,6 +1975,102 @@ func newCaseBuilder(ref Evaluator, whens []Evaluator, thens []Evaluator, def Eva
 	return &caseBuilder{ref, whens, thens, def}, nil
 }
 
+// lambdaScopeKey is the key of the input row under which a Map from the
+// names of the parameters of the enclosing lambda expressions to their
+// values is stored while the body of a lambda expression is evaluated.
+const lambdaScopeKey = ":lambda:"
+
+// higherOrderFuncApp evaluates the body of a lambda expression on each
+// element of an array. The input row is passed to the body so that it can
+// refer to columns of the input relations as well.
+type higherOrderFuncApp struct {
+	name  string
+	array Evaluator
+	param string
+	body  Evaluator
+	// filter is true if the elements for which the body is true are
+	// returned, and false if the results of the body are returned.
+	filter bool
+}
+
+func (h *higherOrderFuncApp) Eval(input data.Value) (data.Value, error) {
+	v, err := h.array.Eval(input)
+	if err != nil {
+		return nil, err
+	}
+	if v.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	arr, err := data.AsArray(v)
+	if err != nil {
+		return nil, fmt.Errorf("function '%s' needs an array, not %T", h.name, v)
+	}
+
+	// the input must not be modified, so the parameter is bound in a
+	// copy of the input row and of the scope of the outer lambda
+	bound := data.Map{}
+	scope := data.Map{}
+	if input != nil {
+		m, err := data.AsMap(input)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range m {
+			bound[k] = v
+		}
+		if outer, ok := m[lambdaScopeKey].(data.Map); ok {
+			for k, v := range outer {
+				scope[k] = v
+			}
+		}
+	}
+	bound[lambdaScopeKey] = scope
+
+	results := make(data.Array, 0, len(arr))
+	for _, elem := range arr {
+		scope[h.param] = elem
+		res, err := h.body.Eval(bound)
+		if err != nil {
+			return nil, err
+		}
+		if !h.filter {
+			results = append(results, res)
+			continue
+		}
+		if res.Type() == data.TypeNull {
+			continue
+		}
+		b, err := data.AsBool(res)
+		if err != nil {
+			return nil, fmt.Errorf("the lambda expression of function '%s' "+
+				"must return a boolean, not %T", h.name, res)
+		}
+		if b {
+			results = append(results, elem)
+		}
+	}
+	return results, nil
+}
+
+func newHigherOrderFuncApp(h higherOrderFuncAppAST, reg udf.FunctionRegistry) (Evaluator, error) {
+	arr, err := ExpressionToEvaluator(h.Array, reg)
+	if err != nil {
+		return nil, err
+	}
+	body, err := ExpressionToEvaluator(h.Body, reg)
+	if err != nil {
+		return nil, err
+	}
+	name := strings.ToLower(string(h.Function))
+	return &higherOrderFuncApp{
+		name:   name,
+		array:  arr,
+		param:  h.Param,
+		body:   body,
+		filter: name == "filter",
+	}, nil
+}
+
 // wildcard only works on Maps, assumes that the elements which do not contain
 // ":meta:" are also Maps and pulls them up one level, so
 //   {"a": {"x": ...}, "a:meta:ts": ..., "b": {"y": ..., "z": ...}}
@@ -1215,6 +2096,10 @@ func (w *wildcard) Eval(input data.Value) (data.Value, error) {
 		if !exists {
 			return nil, fmt.Errorf("there is no entry with key '%s'", w.Relation)
 		}
+		// a NULL-padded relation of an outer join has no columns
+		if subElement.Type() == data.TypeNull {
+			return output, nil
+		}
 		subMap, err := data.AsMap(subElement)
 		if err != nil {
 			return nil, err
@@ -1225,7 +2110,10 @@ func (w *wildcard) Eval(input data.Value) (data.Value, error) {
 	} else {
 		// if we have *, take items from all submaps
 		for alias, subElement := range aMap {
-			if strings.Contains(alias, ":meta:") {
+			if strings.Contains(alias, ":meta:") ||
+				strings.HasPrefix(alias, analyticKey("")) ||
+				alias == lambdaScopeKey ||
+				subElement.Type() == data.TypeNull {
 				continue
 			}
 			subMap, err := data.AsMap(subElement)
@@ -70,6 +70,8 @@ func TestFoldableExecution(t *testing.T) {
 			true, data.Bool(true)},
 		{parser.StringLiteral{"foo"},
 			true, data.String("foo")},
+		{parser.IntervalLiteral{5000000},
+			true, data.Int(5000000)},
 		// Access to column data should always be false
 		{parser.RowMeta{"s", parser.TimestampMeta},
 			false, nil},
@@ -121,6 +123,19 @@ func TestFoldableExecution(t *testing.T) {
 			false, nil},
 		{parser.BinaryOpAST{parser.Concat, parser.StringLiteral{"7"}, parser.StringLiteral{"b"}},
 			true, data.String("7b")},
+		{parser.BinaryOpAST{parser.Like, parser.StringLiteral{"abc"}, parser.RowValue{"", "b"}},
+			false, nil},
+		{parser.BinaryOpAST{parser.Like, parser.StringLiteral{"abc"}, parser.StringLiteral{"a%"}},
+			true, data.Bool(true)},
+		{parser.BinaryOpAST{parser.In, parser.NumericLiteral{7},
+			parser.ArrayAST{parser.ExpressionsAST{[]parser.Expression{parser.NumericLiteral{7}}}}},
+			true, data.Bool(true)},
+		{parser.BetweenAST{parser.Between, parser.RowValue{"", "a"},
+			parser.NumericLiteral{1}, parser.NumericLiteral{3}},
+			false, nil},
+		{parser.BetweenAST{parser.NotBetween, parser.NumericLiteral{7},
+			parser.NumericLiteral{1}, parser.NumericLiteral{3}},
+			true, data.Bool(true)},
 		// Other
 		{parser.AliasAST{parser.RowValue{"", "a"}, "hoge"},
 			false, nil},
@@ -131,13 +146,13 @@ func TestFoldableExecution(t *testing.T) {
 		{parser.TypeCastAST{parser.NumericLiteral{7}, parser.Float},
 			true, data.Float(7.0)},
 		{parser.FuncAppAST{parser.FuncName("now"),
-			parser.ExpressionsAST{[]parser.Expression{}}, nil},
+			parser.ExpressionsAST{[]parser.Expression{}}, nil, false},
 			false, nil},
 		{parser.FuncAppAST{parser.FuncName("plusone"),
-			parser.ExpressionsAST{[]parser.Expression{parser.RowValue{"", "a"}}}, nil},
+			parser.ExpressionsAST{[]parser.Expression{parser.RowValue{"", "a"}}}, nil, false},
 			false, nil},
 		{parser.FuncAppAST{parser.FuncName("plusone"),
-			parser.ExpressionsAST{[]parser.Expression{parser.NumericLiteral{7}}}, nil},
+			parser.ExpressionsAST{[]parser.Expression{parser.NumericLiteral{7}}}, nil, false},
 			true, data.Int(8)},
 		{parser.ArrayAST{parser.ExpressionsAST{[]parser.Expression{parser.RowValue{"", "a"}}}},
 			false, nil},
@@ -220,6 +235,34 @@ func TestFoldableExecution(t *testing.T) {
 	}
 }
 
+func TestConstantPatternCompilation(t *testing.T) {
+	reg := &testFuncRegistry{ctx: core.NewContext(nil)}
+
+	Convey("Given a LIKE expression with an invalid constant pattern", t, func() {
+		ast := parser.BinaryOpAST{parser.Like, parser.RowValue{"", "a"},
+			parser.StringLiteral{"a\\"}}
+
+		Convey("Then creating the evaluator should fail", func() {
+			flatExpr, err := ParserExprToFlatExpr(ast, reg)
+			So(err, ShouldBeNil)
+			_, err = ExpressionToEvaluator(flatExpr, reg)
+			So(err, ShouldNotBeNil)
+		})
+	})
+
+	Convey("Given a regex match with an invalid constant pattern", t, func() {
+		ast := parser.BinaryOpAST{parser.RegexMatch, parser.RowValue{"", "a"},
+			parser.StringLiteral{"a("}}
+
+		Convey("Then creating the evaluator should fail", func() {
+			flatExpr, err := ParserExprToFlatExpr(ast, reg)
+			So(err, ShouldBeNil)
+			_, err = ExpressionToEvaluator(flatExpr, reg)
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
 func TestFuncAppConversion(t *testing.T) {
 	Convey("Given a function registry", t, func() {
 		reg := &testFuncRegistry{ctx: core.NewContext(nil)}
@@ -228,7 +271,7 @@ func TestFuncAppConversion(t *testing.T) {
 			ast := parser.FuncAppAST{parser.FuncName("plusone"),
 				parser.ExpressionsAST{[]parser.Expression{
 					parser.RowValue{"", "a"},
-				}}, nil}
+				}}, nil, false}
 
 			Convey("Then we obtain an evaluatable funcApp", func() {
 				flatExpr, err := ParserExprToFlatExpr(ast, reg)
@@ -243,7 +286,7 @@ func TestFuncAppConversion(t *testing.T) {
 			ast := parser.FuncAppAST{parser.FuncName("fun"),
 				parser.ExpressionsAST{[]parser.Expression{
 					parser.RowValue{"", "a"},
-				}}, nil}
+				}}, nil, false}
 
 			Convey("Then converting to an Evaluator fails", func() {
 				// we cannot even get the flat expression in that case
@@ -257,7 +300,7 @@ func TestFuncAppConversion(t *testing.T) {
 				parser.ExpressionsAST{[]parser.Expression{
 					parser.RowValue{"", "a"},
 				}},
-				[]parser.SortedExpressionAST{{parser.RowValue{"", "a"}, parser.Yes}}}
+				[]parser.SortedExpressionAST{{parser.RowValue{"", "a"}, parser.Yes}}, false}
 
 			Convey("Then converting to an Evaluator fails", func() {
 				// we cannot even get the flat expression in that case
@@ -270,7 +313,7 @@ func TestFuncAppConversion(t *testing.T) {
 
 		Convey("When the now() function is used", func() {
 			ast := parser.FuncAppAST{parser.FuncName("now"),
-				parser.ExpressionsAST{[]parser.Expression{}}, nil}
+				parser.ExpressionsAST{[]parser.Expression{}}, nil, false}
 
 			Convey("Then we obtain an evaluatable timestampCast", func() {
 				flatExpr, err := ParserExprToFlatExpr(ast, reg)
@@ -283,6 +326,231 @@ func TestFuncAppConversion(t *testing.T) {
 	})
 }
 
+func TestCallSiteFuncAppConversion(t *testing.T) {
+	Convey("Given a function keeping state for each call site", t, func() {
+		reg := &testFuncRegistry{ctx: core.NewContext(nil)}
+		ast := parser.FuncAppAST{parser.FuncName("count_calls"),
+			parser.ExpressionsAST{[]parser.Expression{}}, nil, false}
+
+		Convey("When creating two evaluators calling it", func() {
+			evals := make([]Evaluator, 2)
+			for i := range evals {
+				flatExpr, err := ParserExprToFlatExpr(ast, reg)
+				So(err, ShouldBeNil)
+				evals[i], err = ExpressionToEvaluator(flatExpr, reg)
+				So(err, ShouldBeNil)
+			}
+
+			Convey("Then they shouldn't share the state", func() {
+				for i := 1; i <= 3; i++ {
+					v, err := evals[0].Eval(data.Map{})
+					So(err, ShouldBeNil)
+					So(v, ShouldEqual, data.Int(i))
+				}
+				v, err := evals[1].Eval(data.Map{})
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, data.Int(1))
+			})
+		})
+	})
+}
+
+func TestCumulativeAggregate(t *testing.T) {
+	Convey("Given a partition with equal rows", t, func() {
+		ctx := core.NewContext(nil)
+		reg := udf.CopyGlobalUDFRegistry(ctx)
+		sum, err := reg.Lookup("sum", 1)
+		So(err, ShouldBeNil)
+		So(sum, ShouldImplement, (*udf.IncrementalUDF)(nil))
+		p := &analyticPartition{
+			params:    [][]data.Value{{data.Int(1), data.Int(2), data.Int(3), data.Int(4)}},
+			peerStart: []int{0, 1, 1, 3},
+			peerEnd:   []int{1, 3, 3, 4},
+		}
+		expected := []data.Value{data.Int(1), data.Int(6), data.Int(6), data.Int(10)}
+
+		Convey("When computing a running aggregate with an accumulator", func() {
+			values, err := cumulativeAggregate(sum, ctx)(p)
+
+			Convey("Then it should have the values of the prefixes", func() {
+				So(err, ShouldBeNil)
+				So(values, ShouldResemble, expected)
+			})
+		})
+
+		Convey("When computing a running aggregate without an accumulator", func() {
+			// hide the NewAccumulator method
+			values, err := cumulativeAggregate(struct{ udf.UDF }{sum}, ctx)(p)
+
+			Convey("Then it should have the same values", func() {
+				So(err, ShouldBeNil)
+				So(values, ShouldResemble, expected)
+			})
+		})
+	})
+}
+
+// sequenceEvaluator returns the next value of its sequence on every
+// evaluation.
+type sequenceEvaluator struct {
+	values []data.Value
+}
+
+func (s *sequenceEvaluator) Eval(input data.Value) (data.Value, error) {
+	v := s.values[0]
+	s.values = s.values[1:]
+	return v, nil
+}
+
+func TestBetweenEvaluation(t *testing.T) {
+	Convey("Given a BETWEEN evaluator with a volatile operand", t, func() {
+		seq := &sequenceEvaluator{[]data.Value{data.Int(1), data.Int(10)}}
+		b := newBetween(seq, &intConstant{0}, &intConstant{5})
+
+		Convey("When evaluating it", func() {
+			v, err := b.Eval(data.Map{})
+
+			Convey("Then the operand should be evaluated only once", func() {
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, data.Bool(true))
+				So(len(seq.values), ShouldEqual, 1)
+			})
+		})
+	})
+
+	Convey("Given BETWEEN evaluators with NULL values", t, func() {
+		null := &nullConstant{}
+		one := &intConstant{1}
+		two := &intConstant{2}
+		three := &intConstant{3}
+		testCases := []struct {
+			b        Evaluator
+			expected data.Value
+		}{
+			{newBetween(null, one, three), data.Null{}},
+			{newBetween(two, null, three), data.Null{}},
+			{newBetween(two, one, null), data.Null{}},
+			{newBetween(three, null, one), data.Bool(false)},
+			{newBetween(one, two, null), data.Bool(false)},
+			{newBetween(two, one, three), data.Bool(true)},
+			{newBetween(three, one, three), data.Bool(true)},
+		}
+
+		for i, tc := range testCases {
+			Convey(fmt.Sprintf("Then case %d should evaluate to %v", i, tc.expected), func() {
+				v, err := tc.b.Eval(data.Map{})
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, tc.expected)
+			})
+		}
+	})
+}
+
+func TestHigherOrderFuncs(t *testing.T) {
+	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+	p := parser.New()
+	parse := func(expr string) parser.Expression {
+		stmt, _, err := p.ParseStmt("SELECT ISTREAM " + expr)
+		So(err, ShouldBeNil)
+		return stmt.(parser.SelectStmt).Projections[0]
+	}
+	input := data.Map{
+		"a": data.Array{data.Int(1), data.Int(5), data.Null{}, data.Int(3)},
+		"r": data.Array{
+			data.Map{"id": data.String("x"), "v": data.Array{data.Int(1), data.Int(7)}},
+			data.Map{"id": data.String("y"), "v": data.Array{data.Int(4)}},
+		},
+		"t": data.Int(2),
+		"n": data.Null{},
+	}
+
+	Convey("Given higher-order functions taking lambda expressions", t, func() {
+		testCases := []struct {
+			expr     string
+			expected data.Value
+		}{
+			{"filter(a, x -> x > 2)", data.Array{data.Int(5), data.Int(3)}},
+			{"filter(a, x -> x IS NULL)", data.Array{data.Null{}}},
+			{"transform(a, x -> x * t)", data.Array{data.Int(2), data.Int(10), data.Null{}, data.Int(6)}},
+			{"transform(r, x -> x.id)", data.Array{data.String("x"), data.String("y")}},
+			{"transform(r, x -> filter(x.v, y -> y > t + x.v[0]))",
+				data.Array{data.Array{data.Int(7)}, data.Array{}}},
+			{"filter(r, x -> array_length(x.v) > 1)", data.Array{input["r"].(data.Array)[0]}},
+			{"transform(filter(a, x -> x IS NOT NULL), a -> a + 1)",
+				data.Array{data.Int(2), data.Int(6), data.Int(4)}},
+			{"TRANSFORM([], x -> x)", data.Array{}},
+			{"filter(n, x -> true)", data.Null{}},
+			// the lambda expression must return a boolean
+			{"filter(a, x -> x)", nil},
+			// the first argument must be an array
+			{"transform(t, x -> x)", nil},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+			Convey(fmt.Sprintf("When evaluating %s", tc.expr), func() {
+				flatExpr, err := ParserExprToFlatExpr(parse(tc.expr), reg)
+				So(err, ShouldBeNil)
+				eval, err := ExpressionToEvaluator(flatExpr, reg)
+				So(err, ShouldBeNil)
+				actual, err := eval.Eval(input)
+
+				if tc.expected == nil {
+					Convey("Then it should fail", func() {
+						So(err, ShouldNotBeNil)
+					})
+				} else {
+					Convey("Then the result should be correct", func() {
+						So(err, ShouldBeNil)
+						So(actual, ShouldResemble, tc.expected)
+					})
+
+					Convey("Then the input should not be modified", func() {
+						So(input, ShouldNotContainKey, lambdaScopeKey)
+					})
+				}
+			})
+		}
+	})
+
+	Convey("Given invalid uses of lambda expressions", t, func() {
+		exprs := []string{
+			"array_length(a, x -> x)",
+			"filter(x -> x, a)",
+			"filter(a, x -> x, a)",
+			"filter(a, b)",
+			"filter(DISTINCT a, x -> x)",
+			"filter(a, x -> count(x) > 1)",
+		}
+
+		for _, expr := range exprs {
+			expr := expr
+			Convey(fmt.Sprintf("When converting %s", expr), func() {
+				_, err := ParserExprToFlatExpr(parse(expr), reg)
+
+				Convey("Then it should fail", func() {
+					So(err, ShouldNotBeNil)
+				})
+			})
+		}
+	})
+
+	Convey("Given a higher-order function applied to an aggregate", t, func() {
+		ast := parse("filter(array_agg(a), x -> x > t)")
+
+		Convey("When converting it", func() {
+			expr, aggrs, err := ParserExprToMaybeAggregate(ast, 0, reg)
+
+			Convey("Then the aggregate should be separated", func() {
+				So(err, ShouldBeNil)
+				So(aggrs, ShouldHaveLength, 1)
+				So(expr, ShouldHaveSameTypeAs, higherOrderFuncAppAST{})
+				So(expr.Columns(), ShouldResemble, []rowValue{{"", "t"}})
+			})
+		})
+	})
+}
+
 func TestAggFuncAppConversion(t *testing.T) {
 	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
 
@@ -556,6 +824,29 @@ var (
 	})
 )
 
+// callCounter is an example function that keeps state for each call site.
+// It returns the number of times it was called at the call site.
+type callCounter struct {
+	count int64
+}
+
+func (c *callCounter) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	c.count++
+	return data.Int(c.count), nil
+}
+
+func (c *callCounter) Accept(arity int) bool {
+	return arity == 0
+}
+
+func (c *callCounter) IsAggregationParameter(k int) bool {
+	return false
+}
+
+func (c *callCounter) ForCallSite() udf.UDF {
+	return &callCounter{}
+}
+
 // testFuncRegistry returns the PlusOne function above for any parameter.
 type testFuncRegistry struct {
 	ctx *core.Context
@@ -570,6 +861,8 @@ func (tfr *testFuncRegistry) Lookup(name string, arity int) (udf.UDF, error) {
 		return PlusOne, nil
 	} else if name == "maplen" && arity == 1 {
 		return MapLen, nil
+	} else if name == "count_calls" && arity == 0 {
+		return &callCounter{}, nil
 	}
 	return nil, fmt.Errorf("no such function: %s", name)
 }
@@ -1422,6 +1715,172 @@ func getTestCases() []struct {
 					"b": data.String("b")}, data.String("ab")},
 			}, nullOps...),
 		},
+		// Like
+		{parser.BinaryOpAST{parser.Like, parser.RowValue{"", "a"}, parser.RowValue{"", "b"}},
+			append([]evalTest{
+				// not a map:
+				{data.Int(17), nil},
+				// keys not present:
+				{data.Map{"x": data.Int(17)}, nil},
+				// operands are not strings => error
+				{data.Map{"a": data.Int(1),
+					"b": data.String("1")}, nil},
+				{data.Map{"a": data.String("1"),
+					"b": data.Int(1)}, nil},
+				// invalid pattern => error
+				{data.Map{"a": data.String("a"),
+					"b": data.String(`a\`)}, nil},
+				// left and right present
+				{data.Map{"a": data.String("日本語"),
+					"b": data.String("日本語")}, data.Bool(true)},
+				{data.Map{"a": data.String("日本語"),
+					"b": data.String("日本")}, data.Bool(false)},
+				{data.Map{"a": data.String("日本語"),
+					"b": data.String("日本%")}, data.Bool(true)},
+				{data.Map{"a": data.String("日本語"),
+					"b": data.String("_本_")}, data.Bool(true)},
+				{data.Map{"a": data.String("日本語"),
+					"b": data.String("_本")}, data.Bool(false)},
+				{data.Map{"a": data.String("a\nb"),
+					"b": data.String("a%")}, data.Bool(true)},
+				{data.Map{"a": data.String("a.c"),
+					"b": data.String("a.c")}, data.Bool(true)},
+				{data.Map{"a": data.String("abc"),
+					"b": data.String("a.c")}, data.Bool(false)},
+				{data.Map{"a": data.String("100%"),
+					"b": data.String(`1_0\%`)}, data.Bool(true)},
+				{data.Map{"a": data.String("1000"),
+					"b": data.String(`1_0\%`)}, data.Bool(false)},
+				{data.Map{"a": data.String("ABC"),
+					"b": data.String("a%")}, data.Bool(false)},
+			}, nullOps...),
+		},
+		{parser.BinaryOpAST{parser.NotLike, parser.RowValue{"", "a"}, parser.StringLiteral{"a%"}},
+			[]evalTest{
+				{data.Map{"a": data.String("abc")}, data.Bool(false)},
+				{data.Map{"a": data.String("ABC")}, data.Bool(true)},
+				{data.Map{"a": data.Null{}}, data.Null{}},
+				{data.Map{"a": data.Int(1)}, nil},
+			},
+		},
+		// ILike
+		{parser.BinaryOpAST{parser.ILike, parser.RowValue{"", "a"}, parser.StringLiteral{"a%"}},
+			[]evalTest{
+				{data.Map{"a": data.String("abc")}, data.Bool(true)},
+				{data.Map{"a": data.String("ABC")}, data.Bool(true)},
+				{data.Map{"a": data.String("cba")}, data.Bool(false)},
+				{data.Map{"a": data.Null{}}, data.Null{}},
+			},
+		},
+		{parser.BinaryOpAST{parser.NotILike, parser.RowValue{"", "a"}, parser.StringLiteral{"a%"}},
+			[]evalTest{
+				{data.Map{"a": data.String("ABC")}, data.Bool(false)},
+				{data.Map{"a": data.String("cba")}, data.Bool(true)},
+			},
+		},
+		// RegexMatch
+		{parser.BinaryOpAST{parser.RegexMatch, parser.RowValue{"", "a"}, parser.RowValue{"", "b"}},
+			append([]evalTest{
+				// not a map:
+				{data.Int(17), nil},
+				// operands are not strings => error
+				{data.Map{"a": data.Int(1),
+					"b": data.String("1")}, nil},
+				{data.Map{"a": data.String("1"),
+					"b": data.Int(1)}, nil},
+				// invalid pattern => error
+				{data.Map{"a": data.String("a"),
+					"b": data.String("a(")}, nil},
+				// left and right present
+				{data.Map{"a": data.String("abc"),
+					"b": data.String("b")}, data.Bool(true)},
+				{data.Map{"a": data.String("abc"),
+					"b": data.String("^b")}, data.Bool(false)},
+				{data.Map{"a": data.String("abc"),
+					"b": data.String("^a.c$")}, data.Bool(true)},
+				{data.Map{"a": data.String("ABC"),
+					"b": data.String("(?i)^a")}, data.Bool(true)},
+			}, nullOps...),
+		},
+		{parser.BinaryOpAST{parser.NotRegexMatch, parser.RowValue{"", "a"}, parser.StringLiteral{"^[0-9]+$"}},
+			[]evalTest{
+				{data.Map{"a": data.String("123")}, data.Bool(false)},
+				{data.Map{"a": data.String("12a")}, data.Bool(true)},
+				{data.Map{"a": data.Null{}}, data.Null{}},
+			},
+		},
+		// In
+		{parser.BinaryOpAST{parser.In, parser.RowValue{"", "a"}, parser.RowValue{"", "b"}},
+			append([]evalTest{
+				// not a map:
+				{data.Int(17), nil},
+				// keys not present:
+				{data.Map{"x": data.Int(17)}, nil},
+				// right is not an array => error
+				{data.Map{"a": data.Int(1),
+					"b": data.Int(1)}, nil},
+				{data.Map{"a": data.Int(1),
+					"b": data.Map{"a": data.Int(1)}}, nil},
+				// left and right present
+				{data.Map{"a": data.Int(1),
+					"b": data.Array{}}, data.Bool(false)},
+				{data.Map{"a": data.Int(1),
+					"b": data.Array{data.Int(2), data.Int(1)}}, data.Bool(true)},
+				{data.Map{"a": data.Int(1),
+					"b": data.Array{data.Int(2), data.Int(3)}}, data.Bool(false)},
+				{data.Map{"a": data.String("b"),
+					"b": data.Array{data.String("a"), data.String("b")}}, data.Bool(true)},
+				// NULL in the list
+				{data.Map{"a": data.Int(1),
+					"b": data.Array{data.Null{}, data.Int(1)}}, data.Bool(true)},
+				{data.Map{"a": data.Int(1),
+					"b": data.Array{data.Null{}, data.Int(2)}}, data.Null{}},
+			}, nullOps...),
+		},
+		{parser.BinaryOpAST{parser.NotIn, parser.RowValue{"", "a"},
+			parser.ArrayAST{parser.ExpressionsAST{[]parser.Expression{
+				parser.NumericLiteral{1}, parser.RowValue{"", "b"}}}}},
+			[]evalTest{
+				{data.Map{"a": data.Int(1), "b": data.Int(2)}, data.Bool(false)},
+				{data.Map{"a": data.Int(2), "b": data.Int(2)}, data.Bool(false)},
+				{data.Map{"a": data.Int(3), "b": data.Int(2)}, data.Bool(true)},
+				{data.Map{"a": data.Int(3), "b": data.Null{}}, data.Null{}},
+				{data.Map{"a": data.Null{}, "b": data.Int(2)}, data.Null{}},
+			},
+		},
+		// Between
+		{parser.BetweenAST{parser.Between, parser.RowValue{"", "a"},
+			parser.RowValue{"", "b"}, parser.NumericLiteral{3}},
+			[]evalTest{
+				// not a map:
+				{data.Int(17), nil},
+				// keys not present:
+				{data.Map{"a": data.Int(17)}, nil},
+				// types cannot be compared => error
+				{data.Map{"a": data.Int(1),
+					"b": data.String("1")}, nil},
+				// left and right present
+				{data.Map{"a": data.Int(0), "b": data.Int(1)}, data.Bool(false)},
+				{data.Map{"a": data.Int(1), "b": data.Int(1)}, data.Bool(true)},
+				{data.Map{"a": data.Int(2), "b": data.Int(1)}, data.Bool(true)},
+				{data.Map{"a": data.Int(3), "b": data.Int(1)}, data.Bool(true)},
+				{data.Map{"a": data.Int(4), "b": data.Int(1)}, data.Bool(false)},
+				{data.Map{"a": data.Int(2), "b": data.Int(3)}, data.Bool(false)},
+				// NULL bounds
+				{data.Map{"a": data.Int(2), "b": data.Null{}}, data.Null{}},
+				{data.Map{"a": data.Int(4), "b": data.Null{}}, data.Bool(false)},
+				{data.Map{"a": data.Null{}, "b": data.Int(1)}, data.Null{}},
+			},
+		},
+		{parser.BetweenAST{parser.NotBetween, parser.RowValue{"", "a"},
+			parser.NumericLiteral{1}, parser.NumericLiteral{3}},
+			[]evalTest{
+				{data.Map{"a": data.Int(0)}, data.Bool(true)},
+				{data.Map{"a": data.Int(2)}, data.Bool(false)},
+				{data.Map{"a": data.Int(4)}, data.Bool(true)},
+				{data.Map{"a": data.Null{}}, data.Null{}},
+			},
+		},
 		// IsNull
 		{parser.BinaryOpAST{parser.Is, parser.RowValue{"", "a"}, parser.NullLiteral{}},
 			[]evalTest{
@@ -1533,6 +1992,38 @@ func getTestCases() []struct {
 				// left and right present and not comparable => error
 			}, incomparables...),
 		},
+		// Timestamp arithmetic with intervals
+		{parser.BinaryOpAST{parser.Plus, parser.RowValue{"", "a"}, parser.IntervalLiteral{3000000}},
+			[]evalTest{
+				{data.Map{"a": data.Timestamp(now)}, data.Timestamp(now.Add(3 * time.Second))},
+				{data.Map{"a": data.Null{}}, data.Null{}},
+				{data.Map{"a": data.String("hoge")}, nil},
+			},
+		},
+		{parser.BinaryOpAST{parser.Plus, parser.IntervalLiteral{3000000}, parser.RowValue{"", "a"}},
+			[]evalTest{
+				{data.Map{"a": data.Timestamp(now)}, data.Timestamp(now.Add(3 * time.Second))},
+			},
+		},
+		{parser.BinaryOpAST{parser.Plus, parser.RowValue{"", "a"},
+			parser.BinaryOpAST{parser.Multiply, parser.IntervalLiteral{1000000}, parser.RowValue{"", "b"}}},
+			[]evalTest{
+				{data.Map{"a": data.Timestamp(now), "b": data.Int(2)}, data.Timestamp(now.Add(2 * time.Second))},
+				{data.Map{"a": data.Timestamp(now), "b": data.Float(1.5)}, data.Timestamp(now.Add(1500 * time.Millisecond))},
+			},
+		},
+		{parser.BinaryOpAST{parser.Minus, parser.RowValue{"", "a"}, parser.IntervalLiteral{3000000}},
+			[]evalTest{
+				{data.Map{"a": data.Timestamp(now)}, data.Timestamp(now.Add(-3 * time.Second))},
+				{data.Map{"a": data.Null{}}, data.Null{}},
+				{data.Map{"a": data.Int(2)}, data.Int(2 - 3000000)},
+			},
+		},
+		{parser.BinaryOpAST{parser.Minus, parser.IntervalLiteral{3000000}, parser.RowValue{"", "a"}},
+			[]evalTest{
+				{data.Map{"a": data.Timestamp(now)}, nil},
+			},
+		},
 		// Multiply
 		{parser.BinaryOpAST{parser.Multiply, parser.RowValue{"", "a"}, parser.RowValue{"", "b"}},
 			append([]evalTest{
@@ -1693,7 +2184,7 @@ func getTestCases() []struct {
 		},
 		/// Function Application
 		{parser.FuncAppAST{parser.FuncName("plusone"),
-			parser.ExpressionsAST{[]parser.Expression{parser.RowValue{"", "a"}}}, nil},
+			parser.ExpressionsAST{[]parser.Expression{parser.RowValue{"", "a"}}}, nil, false},
 			// NB. This only tests the behavior of funcApp.Eval.
 			// It does *not* test the function registry, mismatch
 			// in parameter counts or any particular function.
@@ -1806,7 +2297,7 @@ func getTestCases() []struct {
 		// Using now() should find the timestamp at the
 		// correct position
 		{parser.FuncAppAST{parser.FuncName("now"),
-			parser.ExpressionsAST{[]parser.Expression{}}, nil},
+			parser.ExpressionsAST{[]parser.Expression{}}, nil, false},
 			[]evalTest{
 				// not a map:
 				{data.Int(17), nil},
@@ -1886,7 +2377,7 @@ func getTestCases() []struct {
 			},
 		},
 		{parser.FuncAppAST{parser.FuncName("maplen"),
-			parser.ExpressionsAST{[]parser.Expression{parser.Wildcard{}}}, nil},
+			parser.ExpressionsAST{[]parser.Expression{parser.Wildcard{}}}, nil, false},
 			[]evalTest{
 				// not a map:
 				{data.Int(17), nil},
@@ -1903,7 +2394,7 @@ func getTestCases() []struct {
 			},
 		},
 		{parser.FuncAppAST{parser.FuncName("maplen"),
-			parser.ExpressionsAST{[]parser.Expression{parser.Wildcard{"a"}}}, nil},
+			parser.ExpressionsAST{[]parser.Expression{parser.Wildcard{"a"}}}, nil, false},
 			[]evalTest{
 				// not a map:
 				{data.Int(17), nil},
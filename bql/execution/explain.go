@@ -0,0 +1,278 @@
+package execution
+
+import (
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// Explain runs the same transformations on the given SELECT statement
+// as a BQL box does when it is initialized, i.e., Analyze,
+// LogicalOptimize and MakePhysicalPlan, and returns a description of
+// the results. The physical plan is created, but never executed.
+//
+// The returned Map has the following keys:
+//
+//	logical_plan:     the flattened expressions and other settings
+//	                  of the LogicalPlan
+//	physical_plan:    the name of the chosen PhysicalPlan, one of
+//	                  "filter", "default_select" or "groupby"
+//	folded_constants: an Array of the immutable subexpressions that
+//	                  do not depend on the input, together with the
+//	                  values they evaluate to
+func Explain(s parser.SelectStmt, reg udf.FunctionRegistry) (data.Map, error) {
+	lp, err := Analyze(s, reg)
+	if err != nil {
+		return nil, err
+	}
+	lp, err = lp.LogicalOptimize()
+	if err != nil {
+		return nil, err
+	}
+	pp, err := lp.MakePhysicalPlan(reg)
+	if err != nil {
+		return nil, err
+	}
+	consts, err := explainFoldedConstants(&s, reg)
+	if err != nil {
+		return nil, err
+	}
+	return data.Map{
+		"logical_plan":     lp.explain(),
+		"physical_plan":    data.String(physicalPlanName(pp)),
+		"folded_constants": consts,
+	}, nil
+}
+
+func physicalPlanName(pp PhysicalPlan) string {
+	switch pp.(type) {
+	case *filterPlan:
+		return "filter"
+	case *defaultSelectExecutionPlan:
+		return "default_select"
+	case *groupbyExecutionPlan:
+		return "groupby"
+	}
+	return "unknown"
+}
+
+// explain returns the contents of the LogicalPlan as a Map. Flattened
+// expressions are represented by their Repr.
+func (lp *LogicalPlan) explain() data.Map {
+	m := data.Map{
+		"grouping": data.Bool(lp.GroupingStmt),
+		"emitter":  data.String(lp.EmitterType.String()),
+		"distinct": data.Bool(lp.Distinct),
+		"limit":    data.Int(lp.Limit),
+	}
+	if lp.EmitterLimit >= 0 {
+		m["emitter_limit"] = data.Int(lp.EmitterLimit)
+	}
+	if lp.EmitterSamplingType != parser.UnspecifiedSamplingType {
+		m["emitter_sampling"] = data.Map{
+			"type":  data.String(lp.EmitterSamplingType.String()),
+			"value": data.Float(lp.EmitterSampling),
+		}
+	}
+
+	rels := make(data.Array, len(lp.Relations))
+	for i, rel := range lp.Relations {
+		rels[i] = data.Map{
+			"name":   data.String(rel.Name),
+			"alias":  data.String(rel.Alias),
+			"type":   data.String(rel.Type.String()),
+			"window": data.String(rel.String()),
+		}
+		if lp.JoinConditions != nil {
+			jc := lp.JoinConditions[i]
+			join := data.Map{
+				"type":   data.String(jc.joinType.String()),
+				"keys":   explainExpressions(jc.keys),
+				"probes": explainExpressions(jc.probes),
+			}
+			if jc.on != nil {
+				join["on"] = data.String(jc.on.Repr())
+			}
+			rels[i].(data.Map)["join"] = join
+		}
+	}
+	m["relations"] = rels
+
+	if len(lp.Unnests) > 0 {
+		unnests := make(data.Array, len(lp.Unnests))
+		for i, u := range lp.Unnests {
+			unnests[i] = data.Map{
+				"alias":           data.String(u.alias),
+				"expression":      data.String(u.expr.Repr()),
+				"with_ordinality": data.Bool(u.withOrdinality),
+			}
+		}
+		m["unnests"] = unnests
+	}
+
+	if len(lp.StateLookups) > 0 {
+		states := make(data.Array, len(lp.StateLookups))
+		for i, l := range lp.StateLookups {
+			states[i] = data.Map{
+				"state": data.String(l.state),
+				"alias": data.String(l.alias),
+				"type":  data.String(l.joinType.String()),
+				"key":   data.String(l.key.Repr()),
+			}
+		}
+		m["state_joins"] = states
+	}
+
+	projs := make(data.Array, len(lp.Projections))
+	for i, proj := range lp.Projections {
+		p := data.Map{
+			"alias":      data.String(proj.alias),
+			"expression": data.String(proj.expr.Repr()),
+			"volatility": data.String(proj.expr.Volatility().String()),
+		}
+		if len(proj.aggrInputs) > 0 {
+			p["aggregate_inputs"] = explainExpressionMap(proj.aggrInputs)
+		}
+		projs[i] = p
+	}
+	m["projections"] = projs
+
+	if lp.Filter != nil {
+		m["filter"] = data.String(lp.Filter.Repr())
+	}
+	if len(lp.GroupList) > 0 {
+		m["group_by"] = explainExpressions(lp.GroupList)
+	}
+	if lp.GroupingSets != nil {
+		sets := make(data.Array, len(lp.GroupingSets))
+		for i, set := range lp.GroupingSets {
+			cols := make(data.Array, len(set))
+			for j, idx := range set {
+				cols[j] = data.String(lp.GroupList[idx].Repr())
+			}
+			sets[i] = cols
+		}
+		m["grouping_sets"] = sets
+	}
+	if lp.Having != nil {
+		m["having"] = data.String(lp.Having.String())
+	}
+	if lp.SessionKey != nil {
+		m["session_key"] = data.String(lp.SessionKey.Repr())
+	}
+	if len(lp.OrderList) > 0 {
+		order := make(data.Array, len(lp.OrderList))
+		for i, o := range lp.OrderList {
+			order[i] = data.Map{
+				"expression": data.String(o.expr.Repr()),
+				"ascending":  data.Bool(o.ascending),
+			}
+		}
+		m["order_by"] = order
+	}
+	if len(lp.AnalyticFuncs) > 0 {
+		funcs := data.Map{}
+		for ref, f := range lp.AnalyticFuncs {
+			funcs[ref] = data.String(f.Repr())
+		}
+		m["analytic_functions"] = funcs
+	}
+	return m
+}
+
+func explainExpressions(exprs []FlatExpression) data.Array {
+	a := make(data.Array, len(exprs))
+	for i, e := range exprs {
+		a[i] = data.String(e.Repr())
+	}
+	return a
+}
+
+func explainExpressionMap(exprs map[string]FlatExpression) data.Map {
+	m := make(data.Map, len(exprs))
+	for k, e := range exprs {
+		m[k] = data.String(e.Repr())
+	}
+	return m
+}
+
+// explainFoldedConstants evaluates all maximal subexpressions of the
+// statement's projections, WHERE, GROUP BY and HAVING clauses that
+// are foldable and immutable. Literals are not reported.
+func explainFoldedConstants(s *parser.SelectStmt, reg udf.FunctionRegistry) (data.Array, error) {
+	exprs := []parser.Expression{}
+	exprs = append(exprs, s.Projections...)
+	if s.Filter != nil {
+		exprs = append(exprs, s.Filter)
+	}
+	exprs = append(exprs, s.GroupList...)
+	if s.Having != nil {
+		exprs = append(exprs, s.Having)
+	}
+
+	consts := data.Array{}
+	for _, expr := range exprs {
+		for _, e := range foldableSubExpressions(expr, reg) {
+			flatExpr, _ := ParserExprToFlatExpr(e, reg)
+			if flatExpr.Volatility() != Immutable {
+				continue
+			}
+			v, err := EvaluateFoldable(e, reg)
+			if err != nil {
+				return nil, err
+			}
+			consts = append(consts, data.Map{
+				"expression": data.String(e.String()),
+				"value":      v,
+			})
+		}
+	}
+	return consts, nil
+}
+
+// foldableSubExpressions returns the largest foldable subexpressions
+// of the given expression that are not literals and can be converted
+// to a FlatExpression (e.g., `count(*)` is foldable, but cannot be
+// evaluated without input).
+func foldableSubExpressions(expr parser.Expression, reg udf.FunctionRegistry) []parser.Expression {
+	switch e := expr.(type) {
+	case parser.NumericLiteral, parser.FloatLiteral, parser.BoolLiteral,
+		parser.StringLiteral, parser.NullLiteral, parser.IntervalLiteral,
+		parser.Wildcard:
+		return nil
+	case parser.AliasAST:
+		return foldableSubExpressions(e.Expr, reg)
+	}
+	if expr.Foldable() {
+		if _, err := ParserExprToFlatExpr(expr, reg); err == nil {
+			return []parser.Expression{expr}
+		}
+	}
+
+	var children []parser.Expression
+	switch e := expr.(type) {
+	case parser.BinaryOpAST:
+		children = []parser.Expression{e.Left, e.Right}
+	case parser.UnaryOpAST:
+		children = []parser.Expression{e.Expr}
+	case parser.BetweenAST:
+		children = []parser.Expression{e.Expr, e.Lower, e.Upper}
+	case parser.TypeCastAST:
+		children = []parser.Expression{e.Expr}
+	case parser.FuncAppAST:
+		children = e.Expressions
+	case parser.AnalyticFuncAST:
+		children = e.Expressions
+	case parser.ArrayAST:
+		children = e.Expressions
+	case parser.MapAST:
+		for _, pair := range e.Entries {
+			children = append(children, pair.Value)
+		}
+	}
+	var res []parser.Expression
+	for _, c := range children {
+		res = append(res, foldableSubExpressions(c, reg)...)
+	}
+	return res
+}
@@ -0,0 +1,105 @@
+package execution
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestExplain(t *testing.T) {
+	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+
+	testCases := []struct {
+		bql    string
+		plan   string
+		consts data.Array
+	}{
+		{"RSTREAM a FROM x [RANGE 1 TUPLES] WHERE a > 2 * 3", "filter",
+			data.Array{data.Map{"expression": data.String("2 * 3"), "value": data.Int(6)}}},
+		{`ISTREAM a || ("b" || "c") AS b FROM x [RANGE 2 TUPLES]`, "default_select",
+			data.Array{data.Map{"expression": data.String(`"b" || "c"`), "value": data.String("bc")}}},
+		{"ISTREAM a, count(*) FROM x [RANGE 2 TUPLES] GROUP BY a", "groupby",
+			data.Array{}},
+		// function calls are volatile and therefore not reported
+		{"ISTREAM a, abs(-2) FROM x [RANGE 2 TUPLES]", "default_select",
+			data.Array{}},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		Convey(fmt.Sprintf("Given the statement %s", testCase.bql), t, func() {
+			p := parser.New()
+			astUnchecked, _, err := p.ParseStmt("SELECT " + testCase.bql)
+			So(err, ShouldBeNil)
+			ast := astUnchecked.(parser.SelectStmt)
+
+			Convey("When we explain it", func() {
+				res, err := Explain(ast, reg)
+				So(err, ShouldBeNil)
+
+				Convey("Then the chosen physical plan should be returned", func() {
+					So(res["physical_plan"], ShouldEqual, data.String(testCase.plan))
+				})
+
+				Convey("Then the folded constants should be returned", func() {
+					So(res["folded_constants"], ShouldResemble, testCase.consts)
+				})
+			})
+		})
+	}
+
+	Convey("Given a statement with GROUP BY, ORDER BY and LIMIT", t, func() {
+		p := parser.New()
+		astUnchecked, _, err := p.ParseStmt("SELECT ISTREAM a, count(b) AS c " +
+			"FROM x [RANGE 2 TUPLES] GROUP BY a ORDER BY c DESC LIMIT 3")
+		So(err, ShouldBeNil)
+		ast := astUnchecked.(parser.SelectStmt)
+
+		Convey("When we explain it", func() {
+			res, err := Explain(ast, reg)
+			So(err, ShouldBeNil)
+
+			Convey("Then the logical plan should contain the flattened expressions", func() {
+				lp := res["logical_plan"].(data.Map)
+				So(lp["grouping"], ShouldEqual, data.True)
+				So(lp["emitter"], ShouldEqual, data.String("ISTREAM"))
+				So(lp["group_by"], ShouldResemble, data.Array{data.String("x:a")})
+				So(lp["limit"], ShouldEqual, data.Int(3))
+				So(lp["order_by"], ShouldResemble, data.Array{data.Map{
+					"expression": data.String(":c"), "ascending": data.False}})
+				So(lp["relations"], ShouldResemble, data.Array{data.Map{
+					"name":   data.String("x"),
+					"alias":  data.String("x"),
+					"type":   data.String("ActualStream"),
+					"window": data.String("x [RANGE 2 TUPLES] AS x"),
+				}})
+				projs := lp["projections"].(data.Array)
+				So(projs, ShouldHaveLength, 2)
+				So(projs[0].(data.Map)["alias"], ShouldEqual, data.String("a"))
+				So(projs[0].(data.Map)["expression"], ShouldEqual, data.String("x:a"))
+				So(projs[1].(data.Map)["alias"], ShouldEqual, data.String("c"))
+				So(projs[1].(data.Map)["aggregate_inputs"], ShouldHaveLength, 1)
+			})
+		})
+	})
+
+	Convey("Given an invalid statement", t, func() {
+		p := parser.New()
+		astUnchecked, _, err := p.ParseStmt("SELECT ISTREAM a FROM x [RANGE 2 TUPLES] GROUP BY b")
+		So(err, ShouldBeNil)
+		ast := astUnchecked.(parser.SelectStmt)
+
+		Convey("When we explain it", func() {
+			_, err := Explain(ast, reg)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -89,6 +89,11 @@ func ParserExprToFlatExpr(e parser.Expression, reg udf.FunctionRegistry) (FlatEx
 		return rowMeta{obj.Relation, obj.MetaType}, nil
 	case parser.RowValue:
 		return rowValue{obj.Relation, obj.Column}, nil
+	case parser.LambdaParam:
+		return lambdaParam{obj.Column}, nil
+	case parser.LambdaAST:
+		return nil, fmt.Errorf("lambda expression '%s' can only be used "+
+			"as an argument of a higher-order function", obj)
 	case parser.AliasAST:
 		return ParserExprToFlatExpr(obj.Expr, reg)
 	case parser.NullLiteral:
@@ -101,6 +106,8 @@ func ParserExprToFlatExpr(e parser.Expression, reg udf.FunctionRegistry) (FlatEx
 		return boolLiteral{obj.Value}, nil
 	case parser.StringLiteral:
 		return stringLiteral{obj.Value}, nil
+	case parser.IntervalLiteral:
+		return intervalLiteral{obj.Value}, nil
 	case parser.BinaryOpAST:
 		// recurse left
 		left, err := ParserExprToFlatExpr(obj.Left, reg)
@@ -132,6 +139,17 @@ func ParserExprToFlatExpr(e parser.Expression, reg udf.FunctionRegistry) (FlatEx
 			return nil, err
 		}
 		return binaryOpAST{obj.Op, left, right}, nil
+	case parser.BetweenAST:
+		// recurse
+		exprs := make([]FlatExpression, 3)
+		for i, ast := range []parser.Expression{obj.Expr, obj.Lower, obj.Upper} {
+			expr, err := ParserExprToFlatExpr(ast, reg)
+			if err != nil {
+				return nil, err
+			}
+			exprs[i] = expr
+		}
+		return newBetweenAST(obj.Op, exprs), nil
 	case parser.UnaryOpAST:
 		// recurse
 		expr, err := ParserExprToFlatExpr(obj.Expr, reg)
@@ -146,11 +164,35 @@ func ParserExprToFlatExpr(e parser.Expression, reg udf.FunctionRegistry) (FlatEx
 			return nil, err
 		}
 		return typeCastAST{expr, obj.Target}, nil
+	case parser.AnalyticFuncAST:
+		err := fmt.Errorf("you cannot use analytic function '%s' "+
+			"in a flat expression", obj.Function)
+		return nil, err
 	case parser.FuncAppAST:
 		// exception for now()
 		if string(obj.Function) == "now" && len(obj.Expressions) == 0 && len(obj.Ordering) == 0 {
 			return stmtMeta{parser.NowMeta}, nil
 		}
+		// higher-order functions taking a lambda expression
+		if arr, lambda, ok, err := splitHigherOrderFuncApp(obj); err != nil {
+			return nil, err
+		} else if ok {
+			arrExpr, err := ParserExprToFlatExpr(arr, reg)
+			if err != nil {
+				return nil, err
+			}
+			body, err := lambdaBodyToFlatExpr(obj, lambda, reg)
+			if err != nil {
+				return nil, err
+			}
+			return higherOrderFuncAppAST{obj.Function, arrExpr, lambda.Param, body}, nil
+		}
+		// grouping() depends on the group like an aggregate function
+		if string(obj.Function) == groupingFuncName {
+			err := fmt.Errorf("you cannot use aggregate function '%s' "+
+				"in a flat expression", obj.Function)
+			return nil, err
+		}
 		// look up the function
 		function, err := reg.Lookup(string(obj.Function), len(obj.Expressions))
 		if err != nil {
@@ -165,6 +207,10 @@ func ParserExprToFlatExpr(e parser.Expression, reg udf.FunctionRegistry) (FlatEx
 			err := fmt.Errorf("you cannot use ORDER BY in non-aggregate "+
 				"function '%s'", obj.Function)
 			return nil, err
+		} else if obj.Distinct {
+			err := fmt.Errorf("you cannot use DISTINCT in non-aggregate "+
+				"function '%s'", obj.Function)
+			return nil, err
 		}
 		// compute child expressions
 		exprs := make([]FlatExpression, len(obj.Expressions))
@@ -292,6 +338,26 @@ func ParserExprToMaybeAggregate(e parser.Expression, aggIdx int, reg udf.Functio
 			returnAgg = rightAgg
 		}
 		return binaryOpAST{obj.Op, left, right}, returnAgg, nil
+	case parser.BetweenAST:
+		// compute child expressions
+		exprs := make([]FlatExpression, 3)
+		returnAgg := map[string]FlatExpression{}
+		for i, ast := range []parser.Expression{obj.Expr, obj.Lower, obj.Upper} {
+			// compute the correct aggIdx
+			newAggIdx := aggIdx + len(returnAgg)
+			expr, agg, err := ParserExprToMaybeAggregate(ast, newAggIdx, reg)
+			if err != nil {
+				return nil, nil, err
+			}
+			for key, val := range agg {
+				returnAgg[key] = val
+			}
+			exprs[i] = expr
+		}
+		if len(returnAgg) == 0 {
+			returnAgg = nil
+		}
+		return newBetweenAST(obj.Op, exprs), returnAgg, nil
 	case parser.UnaryOpAST:
 		// recurse
 		expr, agg, err := ParserExprToMaybeAggregate(obj.Expr, aggIdx, reg)
@@ -306,11 +372,58 @@ func ParserExprToMaybeAggregate(e parser.Expression, aggIdx int, reg udf.Functio
 			return nil, nil, err
 		}
 		return typeCastAST{expr, obj.Target}, agg, nil
+	case parser.AnalyticFuncAST:
+		expr, err := analyticFuncToFlatExpr(obj, reg)
+		if err != nil {
+			return nil, nil, err
+		}
+		// analytic functions are computed over all rows of the window
+		// before the projections are evaluated, so we replace the
+		// function call by a reference to the precomputed value
+		// and return the function itself in the list of aggregates
+		// (flattenExpressions will take it out of there again)
+		// (identical function calls share the same value)
+		h := sha1.New()
+		h.Write([]byte(fmt.Sprintf("%s", expr.Repr())))
+		exprID := "w_" + hex.EncodeToString(h.Sum(nil))[:8]
+		return analyticRef{exprID}, map[string]FlatExpression{exprID: expr}, nil
 	case parser.FuncAppAST:
 		// exception for now()
 		if string(obj.Function) == "now" && len(obj.Expressions) == 0 {
 			return stmtMeta{parser.NowMeta}, nil, nil
 		}
+		// higher-order functions taking a lambda expression, whose
+		// array may be computed by aggregate functions
+		if arr, lambda, ok, err := splitHigherOrderFuncApp(obj); err != nil {
+			return nil, nil, err
+		} else if ok {
+			arrExpr, agg, err := ParserExprToMaybeAggregate(arr, aggIdx, reg)
+			if err != nil {
+				return nil, nil, err
+			}
+			body, err := lambdaBodyToFlatExpr(obj, lambda, reg)
+			if err != nil {
+				return nil, nil, err
+			}
+			return higherOrderFuncAppAST{obj.Function, arrExpr, lambda.Param, body}, agg, nil
+		}
+		// exception for grouping()
+		if string(obj.Function) == groupingFuncName {
+			expr, err := groupingFuncToFlatExpr(obj, reg)
+			if err != nil {
+				return nil, nil, err
+			}
+			// the value of grouping() only depends on the grouping set
+			// of a group, so it is computed for each group before the
+			// projections are evaluated. like analytic functions, the
+			// function call is replaced by a reference to that value
+			// and the function itself is returned in the list of
+			// aggregates (flattenExpressions will take it out of there)
+			h := sha1.New()
+			h.Write([]byte(fmt.Sprintf("%s", expr.Repr())))
+			exprID := "gs_" + hex.EncodeToString(h.Sum(nil))[:8]
+			return groupingRef{exprID}, map[string]FlatExpression{exprID: expr}, nil
+		}
 		// look up the function
 		function, err := reg.Lookup(string(obj.Function), len(obj.Expressions))
 		if err != nil {
@@ -319,8 +432,9 @@ func ParserExprToMaybeAggregate(e parser.Expression, aggIdx int, reg udf.Functio
 		// replace the "*" by 1 for the count function
 		for i, ast := range obj.Expressions {
 			if _, ok := ast.(parser.Wildcard); ok {
-				if string(obj.Function) == "count" {
+				if string(obj.Function) == "count" && !obj.Distinct {
 					// replace the wildcard by an always non-null expression
+					// (with DISTINCT, the whole rows are compared instead)
 					obj.Expressions[i] = parser.NumericLiteral{1}
 				}
 			}
@@ -332,6 +446,11 @@ func ParserExprToMaybeAggregate(e parser.Expression, aggIdx int, reg udf.Functio
 			// we have a setting like
 			//  SELECT udaf(x+1, "state", c ORDER BY d + e, f DESC) ... GROUP BY c
 			// where some parameters are aggregates, others aren't.
+			if obj.Distinct && len(obj.Ordering) > 0 {
+				err := fmt.Errorf("DISTINCT cannot be used together with "+
+					"ORDER BY in aggregate function '%s'", obj.Function)
+				return nil, nil, err
+			}
 			for i, ast := range obj.Expressions {
 				// this expression must be flat, there must not be other aggregates
 				expr, err := ParserExprToFlatExpr(ast, reg)
@@ -420,7 +539,31 @@ func ParserExprToMaybeAggregate(e parser.Expression, aggIdx int, reg udf.Functio
 				}, returnAgg, nil
 			}
 
+			// deal with DISTINCT modifiers
+			if obj.Distinct {
+				// the deduplicated values depend on the combination of
+				// aggregate parameters, so we need a string that uniquely
+				// identifies that combination in order to allow
+				// `SELECT f(DISTINCT a, b), count(DISTINCT a)`
+				distinctHash := sha1.New()
+				for _, expr := range exprs {
+					if inputRef, ok := expr.(aggInputRef); ok {
+						distinctHash.Write([]byte(inputRef.Ref))
+						distinctHash.Write([]byte(","))
+					}
+				}
+				return aggregateInputDistinct{
+					funcAppAST{obj.Function, exprs},
+					"d" + hex.EncodeToString(distinctHash.Sum(nil))[:8],
+				}, returnAgg, nil
+			}
+
 		} else {
+			if obj.Distinct {
+				err := fmt.Errorf("you cannot use DISTINCT in non-aggregate "+
+					"function '%s'", obj.Function)
+				return nil, nil, err
+			}
 			for i, ast := range obj.Expressions {
 				expr, agg, err := ParserExprToMaybeAggregate(ast, aggIdx, reg)
 				if err != nil {
@@ -636,6 +779,48 @@ func (b binaryOpAST) ContainsWildcard() bool {
 	return b.Left.ContainsWildcard() || b.Right.ContainsWildcard()
 }
 
+type betweenAST struct {
+	Expr  FlatExpression
+	Lower FlatExpression
+	Upper FlatExpression
+}
+
+// newBetweenAST creates a betweenAST from the given expression and
+// bounds, wrapping it with NOT if op is parser.NotBetween.
+func newBetweenAST(op parser.Operator, exprs []FlatExpression) FlatExpression {
+	b := betweenAST{exprs[0], exprs[1], exprs[2]}
+	if op == parser.NotBetween {
+		return unaryOpAST{parser.Not, b}
+	}
+	return b
+}
+
+func (b betweenAST) Repr() string {
+	return fmt.Sprintf("(%s)BETWEEN(%s)AND(%s)", b.Expr.Repr(),
+		b.Lower.Repr(), b.Upper.Repr())
+}
+
+func (b betweenAST) Columns() []rowValue {
+	cols := append(b.Expr.Columns(), b.Lower.Columns()...)
+	return append(cols, b.Upper.Columns()...)
+}
+
+func (b betweenAST) Volatility() VolatilityType {
+	// take the lowest level of all sub-expressions
+	v := b.Expr.Volatility()
+	for _, e := range []FlatExpression{b.Lower, b.Upper} {
+		if l := e.Volatility(); l < v {
+			v = l
+		}
+	}
+	return v
+}
+
+func (b betweenAST) ContainsWildcard() bool {
+	return b.Expr.ContainsWildcard() || b.Lower.ContainsWildcard() ||
+		b.Upper.ContainsWildcard()
+}
+
 type unaryOpAST struct {
 	Op   parser.Operator
 	Expr FlatExpression
@@ -744,6 +929,188 @@ func (a aggregateInputSorter) Repr() string {
 		strings.Join(reprs, ","), strings.Join(ordering, ","))
 }
 
+type aggregateInputDistinct struct {
+	funcAppAST
+	ID string
+}
+
+func (a aggregateInputDistinct) Repr() string {
+	reprs := make([]string, len(a.Expressions))
+	for i, e := range a.Expressions {
+		reprs[i] = e.Repr()
+	}
+	return fmt.Sprintf("%s(DISTINCT %s)", a.Function, strings.Join(reprs, ","))
+}
+
+// analyticFuncToFlatExpr converts a function application with an
+// OVER clause to an analyticFuncAST. The function must either be one
+// of the built-in analytic functions or an aggregate function.
+func analyticFuncToFlatExpr(obj parser.AnalyticFuncAST, reg udf.FunctionRegistry) (FlatExpression, error) {
+	if obj.Distinct || len(obj.Ordering) > 0 {
+		return nil, fmt.Errorf("DISTINCT and ORDER BY cannot be used in the "+
+			"parameters of analytic function '%s'", obj.Function)
+	}
+	name := string(obj.Function)
+	arity := len(obj.Expressions)
+	if af, ok := analyticFuncs[name]; ok {
+		if arity < af.minArity || arity > af.maxArity {
+			return nil, fmt.Errorf("function '%s' is not %d-ary", name, arity)
+		}
+	} else {
+		function, err := reg.Lookup(name, arity)
+		if err != nil {
+			return nil, err
+		}
+		if !isAggregateFunc(function, arity) {
+			return nil, fmt.Errorf("function '%s' is neither an analytic "+
+				"nor an aggregate function", name)
+		}
+	}
+
+	// all the parameters and OVER expressions are evaluated
+	// on single rows, so they must be flat
+	flatten := func(ast parser.Expression) (FlatExpression, error) {
+		expr, err := ParserExprToFlatExpr(ast, reg)
+		if err != nil {
+			// return a prettier error message
+			if strings.HasPrefix(err.Error(), "you cannot use aggregate") {
+				err = fmt.Errorf("aggregate functions cannot be used in analytic functions")
+			} else if strings.HasPrefix(err.Error(), "you cannot use analytic") {
+				err = fmt.Errorf("analytic functions cannot be nested")
+			}
+			return nil, err
+		}
+		return expr, nil
+	}
+	exprs := make([]FlatExpression, arity)
+	for i, ast := range obj.Expressions {
+		expr, err := flatten(ast)
+		if err != nil {
+			return nil, err
+		}
+		exprs[i] = expr
+	}
+	partition := make([]FlatExpression, len(obj.Over.PartitionList))
+	for i, ast := range obj.Over.PartitionList {
+		expr, err := flatten(ast)
+		if err != nil {
+			return nil, err
+		}
+		partition[i] = expr
+	}
+	ordering := make([]orderByExpression, len(obj.Over.OrderList))
+	for i, sortExpr := range obj.Over.OrderList {
+		expr, err := flatten(sortExpr.Expr)
+		if err != nil {
+			return nil, err
+		}
+		ordering[i] = orderByExpression{expr, sortExpr.Ascending != parser.No}
+	}
+	return analyticFuncAST{funcAppAST{obj.Function, exprs}, partition, ordering}, nil
+}
+
+// groupingFuncName is the name of the function returning which
+// columns of the GROUP BY clause are not part of the grouping set
+// of a group.
+const groupingFuncName = "grouping"
+
+// groupingFuncToFlatExpr converts an application of grouping() to a
+// groupingFuncAST. The parameters are checked to be columns of the
+// GROUP BY clause by flattenExpressions.
+func groupingFuncToFlatExpr(obj parser.FuncAppAST, reg udf.FunctionRegistry) (FlatExpression, error) {
+	if obj.Distinct || len(obj.Ordering) > 0 {
+		return nil, fmt.Errorf("DISTINCT and ORDER BY cannot be used in the "+
+			"parameters of function '%s'", obj.Function)
+	}
+	if len(obj.Expressions) == 0 || len(obj.Expressions) > 63 {
+		return nil, fmt.Errorf("function '%s' takes 1 to 63 parameters", obj.Function)
+	}
+	exprs := make([]FlatExpression, len(obj.Expressions))
+	for i, ast := range obj.Expressions {
+		expr, err := ParserExprToFlatExpr(ast, reg)
+		if err != nil {
+			// return a prettier error message
+			if strings.HasPrefix(err.Error(), "you cannot use aggregate") {
+				err = fmt.Errorf("aggregate functions cannot be used in function '%s'",
+					obj.Function)
+			}
+			return nil, err
+		}
+		exprs[i] = expr
+	}
+	return groupingFuncAST{funcAppAST{obj.Function, exprs}}, nil
+}
+
+// groupingFuncAST is an application of grouping(). Its value is a
+// bit mask where the bit of a parameter is set if the parameter is
+// not part of the grouping set of the current group. The bit of the
+// last parameter is the least significant one.
+type groupingFuncAST struct {
+	funcAppAST
+}
+
+// analyticFuncAST is a function application with an OVER clause.
+// The parameters and the expressions in the OVER clause are evaluated
+// on single rows, but the result for one row depends on all rows in
+// the same partition.
+type analyticFuncAST struct {
+	funcAppAST
+	Partition []FlatExpression
+	Ordering  []orderByExpression
+}
+
+func (a analyticFuncAST) Repr() string {
+	over := []string{}
+	if len(a.Partition) > 0 {
+		reprs := make([]string, len(a.Partition))
+		for i, e := range a.Partition {
+			reprs[i] = e.Repr()
+		}
+		over = append(over, "PARTITION BY "+strings.Join(reprs, ","))
+	}
+	if len(a.Ordering) > 0 {
+		reprs := make([]string, len(a.Ordering))
+		for i, e := range a.Ordering {
+			reprs[i] = e.expr.Repr()
+			if e.ascending {
+				reprs[i] += " ASC"
+			} else {
+				reprs[i] += " DESC"
+			}
+		}
+		over = append(over, "ORDER BY "+strings.Join(reprs, ","))
+	}
+	return fmt.Sprintf("%s OVER (%s)", a.funcAppAST.Repr(), strings.Join(over, " "))
+}
+
+func (a analyticFuncAST) Columns() []rowValue {
+	allColumns := a.funcAppAST.Columns()
+	for _, e := range a.Partition {
+		allColumns = append(allColumns, e.Columns()...)
+	}
+	for _, e := range a.Ordering {
+		allColumns = append(allColumns, e.expr.Columns()...)
+	}
+	return allColumns
+}
+
+func (a analyticFuncAST) ContainsWildcard() bool {
+	if a.funcAppAST.ContainsWildcard() {
+		return true
+	}
+	for _, e := range a.Partition {
+		if e.ContainsWildcard() {
+			return true
+		}
+	}
+	for _, e := range a.Ordering {
+		if e.expr.ContainsWildcard() {
+			return true
+		}
+	}
+	return false
+}
+
 type arrayAST struct {
 	Expressions []FlatExpression
 }
@@ -947,6 +1314,96 @@ func (a aggInputRef) ContainsWildcard() bool {
 	return false
 }
 
+// analyticRef references the precomputed value of an analytic function
+// for the current row.
+type analyticRef struct {
+	Ref string
+}
+
+func (a analyticRef) Repr() string {
+	return a.Ref
+}
+
+func (a analyticRef) Columns() []rowValue {
+	return nil
+}
+
+func (a analyticRef) Volatility() VolatilityType {
+	return Volatile
+}
+
+func (a analyticRef) ContainsWildcard() bool {
+	// whether there is a wildcard used in the analytic
+	// function is irrelevant for the reference
+	return false
+}
+
+// groupingRef references the value of grouping() for the current
+// group.
+type groupingRef struct {
+	Ref string
+}
+
+func (g groupingRef) Repr() string {
+	return g.Ref
+}
+
+func (g groupingRef) Columns() []rowValue {
+	// the parameters of grouping() are not evaluated on the
+	// input rows, so they don't have to be checked like
+	// other columns used in GROUP BY statements
+	return nil
+}
+
+func (g groupingRef) Volatility() VolatilityType {
+	return Volatile
+}
+
+func (g groupingRef) ContainsWildcard() bool {
+	return false
+}
+
+// accumulatorRef references the result of the accumulator of an
+// aggregate function for the current group.
+type accumulatorRef struct {
+	Ref string
+}
+
+func (a accumulatorRef) Repr() string {
+	return a.Ref
+}
+
+func (a accumulatorRef) Columns() []rowValue {
+	return nil
+}
+
+func (a accumulatorRef) Volatility() VolatilityType {
+	return Volatile
+}
+
+func (a accumulatorRef) ContainsWildcard() bool {
+	return false
+}
+
+// groupingKey returns the key under which the value of grouping() with
+// the given reference is stored in the representative row of a group.
+func groupingKey(ref string) string {
+	return ":grouping:" + ref
+}
+
+// accumulatorKey returns the key under which the result of the
+// accumulator with the given reference is stored in the representative
+// row of a group.
+func accumulatorKey(ref string) string {
+	return ":accumulator:" + ref
+}
+
+// analyticKey returns the key under which the value of the analytic
+// function with the given reference is stored in the input row.
+func analyticKey(ref string) string {
+	return ":analytic:" + ref
+}
+
 type rowValue struct {
 	Relation string
 	Column   string
@@ -968,6 +1425,116 @@ func (rv rowValue) ContainsWildcard() bool {
 	return false
 }
 
+// lambdaParam refers to the parameter of the lambda expression of
+// a higherOrderFuncAppAST. Its value is stored in the input row under
+// lambdaScopeKey while the body of the lambda expression is evaluated.
+type lambdaParam struct {
+	Column string
+}
+
+func (lp lambdaParam) Repr() string {
+	return lp.Column
+}
+
+func (lp lambdaParam) Columns() []rowValue {
+	return nil
+}
+
+func (lp lambdaParam) Volatility() VolatilityType {
+	return Immutable
+}
+
+func (lp lambdaParam) ContainsWildcard() bool {
+	return false
+}
+
+// higherOrderFuncs are the functions taking an array and a lambda
+// expression, which is evaluated on each element of the array.
+var higherOrderFuncs = map[string]bool{
+	"filter":    true,
+	"transform": true,
+}
+
+// higherOrderFuncAppAST is a call of a function in higherOrderFuncs.
+type higherOrderFuncAppAST struct {
+	Function parser.FuncName
+	Array    FlatExpression
+	Param    string
+	Body     FlatExpression
+}
+
+func (h higherOrderFuncAppAST) Repr() string {
+	return fmt.Sprintf("%s(%s, %s -> %s)", h.Function, h.Array.Repr(), h.Param, h.Body.Repr())
+}
+
+func (h higherOrderFuncAppAST) Columns() []rowValue {
+	return append(h.Array.Columns(), h.Body.Columns()...)
+}
+
+func (h higherOrderFuncAppAST) Volatility() VolatilityType {
+	lv := h.Array.Volatility()
+	if v := h.Body.Volatility(); v < lv {
+		lv = v
+	}
+	return lv
+}
+
+func (h higherOrderFuncAppAST) ContainsWildcard() bool {
+	return h.Array.ContainsWildcard() || h.Body.ContainsWildcard()
+}
+
+// splitHigherOrderFuncApp returns the array and the lambda expression
+// passed to a higher-order function. It returns false if no lambda
+// expression is passed to the function.
+func splitHigherOrderFuncApp(f parser.FuncAppAST) (parser.Expression, parser.LambdaAST, bool, error) {
+	hasLambda := false
+	for _, e := range f.Expressions {
+		if _, ok := e.(parser.LambdaAST); ok {
+			hasLambda = true
+		}
+	}
+	if !hasLambda {
+		return nil, parser.LambdaAST{}, false, nil
+	}
+	if !higherOrderFuncs[strings.ToLower(string(f.Function))] {
+		return nil, parser.LambdaAST{}, false, fmt.Errorf(
+			"function '%s' cannot take a lambda expression", f.Function)
+	}
+	if len(f.Ordering) > 0 || f.Distinct {
+		return nil, parser.LambdaAST{}, false, fmt.Errorf(
+			"you cannot use ORDER BY or DISTINCT in function '%s'", f.Function)
+	}
+	if len(f.Expressions) != 2 {
+		return nil, parser.LambdaAST{}, false, fmt.Errorf(
+			"function '%s' takes an array and a lambda expression", f.Function)
+	}
+	lambda, ok := f.Expressions[1].(parser.LambdaAST)
+	if !ok {
+		return nil, parser.LambdaAST{}, false, fmt.Errorf(
+			"the second argument of function '%s' must be a lambda expression", f.Function)
+	}
+	if _, ok := f.Expressions[0].(parser.LambdaAST); ok {
+		return nil, parser.LambdaAST{}, false, fmt.Errorf(
+			"the first argument of function '%s' must be an array", f.Function)
+	}
+	return f.Expressions[0], lambda, true, nil
+}
+
+// lambdaBodyToFlatExpr converts the body of a lambda expression. It must
+// not contain aggregate functions.
+func lambdaBodyToFlatExpr(f parser.FuncAppAST, lambda parser.LambdaAST, reg udf.FunctionRegistry) (FlatExpression, error) {
+	body, err := ParserExprToFlatExpr(lambda.Body, reg)
+	if err != nil {
+		// return a prettier error message
+		if strings.HasPrefix(err.Error(), "you cannot use aggregate") {
+			err = fmt.Errorf("aggregate functions cannot be used in "+
+				"the lambda expression of function '%s'", f.Function)
+		}
+		return nil, err
+	}
+	return body, nil
+}
+
 type stmtMeta struct {
 	MetaType parser.MetaInformation
 }
@@ -1029,6 +1596,59 @@ func (l numericLiteral) ContainsWildcard() bool {
 	return false
 }
 
+// intervalLiteral is evaluated to an Int value in microseconds like
+// numericLiteral, but unlike other numbers, intervals can be added to or
+// subtracted from Timestamps.
+type intervalLiteral struct {
+	Value int64
+}
+
+func (l intervalLiteral) Repr() string {
+	return fmt.Sprintf("interval(%vus)", l.Value)
+}
+
+func (l intervalLiteral) Columns() []rowValue {
+	return nil
+}
+
+func (l intervalLiteral) Volatility() VolatilityType {
+	return Immutable
+}
+
+func (l intervalLiteral) ContainsWildcard() bool {
+	return false
+}
+
+// intervalFuncs are the functions returning an interval in microseconds.
+var intervalFuncs = map[string]bool{
+	"distance_us": true,
+}
+
+// isInterval returns true if the expression is an interval, i.e., an
+// INTERVAL literal, a call of a function in intervalFuncs, the negation,
+// sum or difference of intervals, or an interval multiplied or divided
+// by a number.
+func isInterval(expr FlatExpression) bool {
+	switch obj := expr.(type) {
+	case intervalLiteral:
+		return true
+	case funcAppAST:
+		return intervalFuncs[string(obj.Function)]
+	case unaryOpAST:
+		return obj.Op == parser.UnaryMinus && isInterval(obj.Expr)
+	case binaryOpAST:
+		switch obj.Op {
+		case parser.Plus, parser.Minus:
+			return isInterval(obj.Left) && isInterval(obj.Right)
+		case parser.Multiply:
+			return isInterval(obj.Left) != isInterval(obj.Right)
+		case parser.Divide:
+			return isInterval(obj.Left) && !isInterval(obj.Right)
+		}
+	}
+	return false
+}
+
 type floatLiteral struct {
 	Value float64
 }
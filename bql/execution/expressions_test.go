@@ -114,3 +114,45 @@ func TestFlatExpressionConverter(t *testing.T) {
 		}
 	})
 }
+
+func TestIsInterval(t *testing.T) {
+	testCases := map[string]bool{
+		`INTERVAL "1 second"`:                    true,
+		`-INTERVAL "1 second"`:                   true,
+		`INTERVAL "1 second" + INTERVAL "2 ms"`:  true,
+		`INTERVAL "1 second" - INTERVAL "2 ms"`:  true,
+		`INTERVAL "1 second" * 2`:                true,
+		`2 * INTERVAL "1 second"`:                true,
+		`INTERVAL "1 second" / 2`:                true,
+		`distance_us(ts(), now())`:               true,
+		`1000000`:                                false,
+		`a`:                                      false,
+		`-a`:                                     false,
+		`INTERVAL "1 second" + 1`:                false,
+		`INTERVAL "1 second" * INTERVAL "2 ms"`:  false,
+		`2 / INTERVAL "1 second"`:                false,
+		`INTERVAL "1 second" / INTERVAL "2 ms"`:  false,
+		`abs(INTERVAL "1 second")`:               false,
+		`CAST(INTERVAL "1 second" AS INT) * 1.5`: false,
+	}
+
+	Convey("Given a BQL parser", t, func() {
+		p := parser.New()
+		reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+
+		for input, expected := range testCases {
+			input, expected := input, expected
+
+			Convey(fmt.Sprintf("When parsing %s", input), func() {
+				result, _, err := p.ParseStmt("SELECT ISTREAM " + input)
+				So(err, ShouldBeNil)
+				e, err := ParserExprToFlatExpr(result.(parser.SelectStmt).Projections[0], reg)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then isInterval should return %v", expected), func() {
+					So(isInterval(e), ShouldEqual, expected)
+				})
+			})
+		}
+	})
+}
@@ -22,7 +22,12 @@ func CanBuildFilterPlan(lp *LogicalPlan, reg udf.FunctionRegistry) bool {
 	return !lp.GroupingStmt &&
 		lp.EmitterType == parser.Rstream &&
 		lp.Relations[0].Unit == parser.Tuples &&
-		lp.Relations[0].Value == 1
+		lp.Relations[0].Value == 1 &&
+		slideInterval(&lp.Relations[0].StreamWindowAST).Unit == parser.UnspecifiedIntervalUnit &&
+		lp.Relations[0].Lateness.Unit == parser.UnspecifiedIntervalUnit &&
+		!lp.Relations[0].Session.Enabled &&
+		len(lp.OrderList) == 0 && lp.Limit < 0 && !lp.Distinct &&
+		len(lp.AnalyticFuncs) == 0
 }
 
 // NewFilterPlan creates a fast and simple plan for the case where the
@@ -40,9 +45,19 @@ func NewFilterPlan(lp *LogicalPlan, reg udf.FunctionRegistry) (PhysicalPlan, err
 	if err != nil {
 		return nil, err
 	}
+	unnests, err := prepareUnnests(lp.Unnests, reg)
+	if err != nil {
+		return nil, err
+	}
+	stateJoins, err := prepareStateJoins(lp.StateLookups, reg)
+	if err != nil {
+		return nil, err
+	}
 	return &filterPlan{commonExecutionPlan{
 		projections: projs,
 		filter:      filter,
+		unnests:     unnests,
+		stateJoins:  stateJoins,
 	}, lp.Relations[0].Alias}, nil
 }
 
@@ -57,38 +72,47 @@ func (ep *filterPlan) Process(input *core.Tuple) ([]data.Map, error) {
 	// to each item
 	d[":meta:NOW"] = data.Timestamp(time.Now().In(time.UTC))
 
-	// evaluate filter condition and convert to bool
-	if ep.filter != nil {
-		filterResult, err := ep.filter.Eval(d)
-		if err != nil {
-			return nil, err
-		}
-		// a NULL value is definitely not "true", so since we
-		// have only a binary decision, we should drop tuples
-		// where the filter condition evaluates to NULL
-		filterResultBool := false
-		if filterResult.Type() != data.TypeNull {
-			filterResultBool, err = data.AsBool(filterResult)
+	// expand the row with the UNNEST clauses and add the entries of
+	// the joined states
+	var results []data.Map
+	err := ep.expandRow(d, func(row data.Map) error {
+		// evaluate filter condition and convert to bool
+		if ep.filter != nil {
+			filterResult, err := ep.filter.Eval(row)
 			if err != nil {
-				return nil, err
+				return err
+			}
+			// a NULL value is definitely not "true", so since we
+			// have only a binary decision, we should drop tuples
+			// where the filter condition evaluates to NULL
+			filterResultBool := false
+			if filterResult.Type() != data.TypeNull {
+				filterResultBool, err = data.AsBool(filterResult)
+				if err != nil {
+					return err
+				}
+			}
+			// if it evaluated to false, do not further process this tuple
+			if !filterResultBool {
+				return nil
 			}
 		}
-		// if it evaluated to false, do not further process this tuple
-		if !filterResultBool {
-			return nil, nil
-		}
-	}
-	// otherwise, compute all the expressions
-	result := data.Map(make(map[string]data.Value, len(ep.projections)))
-	for _, proj := range ep.projections {
-		value, err := proj.evaluator.Eval(d)
-		if err != nil {
-			return nil, err
-		}
-		if err := assignOutputValue(result, proj.alias, proj.aliasPath, value); err != nil {
-			return nil, err
+		// otherwise, compute all the expressions
+		result := data.Map(make(map[string]data.Value, len(ep.projections)))
+		for _, proj := range ep.projections {
+			value, err := proj.evaluator.Eval(row)
+			if err != nil {
+				return err
+			}
+			if err := assignOutputValue(result, proj.alias, proj.aliasPath, value); err != nil {
+				return err
+			}
 		}
+		results = append(results, result)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-
-	return []data.Map{result}, nil
+	return results, nil
 }
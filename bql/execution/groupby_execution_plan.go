@@ -9,6 +9,16 @@ import (
 
 type groupbyExecutionPlan struct {
 	streamRelationStreamExecutionPlan
+	// groupingSets holds the grouping sets as indexes into groupList.
+	// It is nil for a plain GROUP BY clause without grouping().
+	groupingSets [][]int
+	// groupingFuncs holds the parameters of the grouping() functions
+	// as indexes into groupList, keyed by their references.
+	groupingFuncs map[string][]int
+	// incremental maintains the groups and the aggregates across
+	// evaluations. It is nil if they are computed from all rows in
+	// the window on every evaluation.
+	incremental *incrementalAggregation
 }
 
 // tmpGroupData is an intermediate data structure to represent
@@ -23,6 +33,8 @@ type tmpGroupData struct {
 	// as per our assumptions about grouping, the non-aggregation
 	// data should be identical within every group
 	nonAggData data.Map
+	// groupingSet is the index of the grouping set of this group
+	groupingSet int
 }
 
 // CanBuildGroupbyExecutionPlan checks whether the given statement
@@ -46,9 +58,109 @@ func NewGroupbyExecutionPlan(lp *LogicalPlan, reg udf.FunctionRegistry) (Physica
 	if err != nil {
 		return nil, err
 	}
-	return &groupbyExecutionPlan{
-		*underlying,
-	}, nil
+	groupingSets := lp.GroupingSets
+	if groupingSets == nil && lp.GroupingFuncs != nil {
+		// a plain GROUP BY clause has one grouping set having all
+		// columns, which is only required to compute grouping()
+		set := make([]int, len(lp.GroupList))
+		for i := range set {
+			set[i] = i
+		}
+		groupingSets = [][]int{set}
+	}
+	ep := &groupbyExecutionPlan{
+		streamRelationStreamExecutionPlan: *underlying,
+		groupingSets:                      groupingSets,
+		groupingFuncs:                     lp.GroupingFuncs,
+	}
+	// when all rows are recomputed on each evaluation, there's no
+	// point in maintaining the aggregates incrementally
+	if !underlying.recomputesJoin() {
+		ep.incremental, err = newIncrementalAggregation(lp.Projections, reg)
+		if err != nil {
+			return nil, err
+		}
+		ep.keepExpiredInputRows = ep.incremental != nil
+	}
+	return ep, nil
+}
+
+// applyGroupingSet prepares the representative row of a group in the
+// grouping set having the given index. The columns which are not part
+// of the grouping set are set to NULL and the values of the grouping()
+// functions are added.
+func (ep *groupbyExecutionPlan) applyGroupingSet(row data.Map, setIdx int) {
+	inSet := make([]bool, len(ep.groupList))
+	for _, idx := range ep.groupingSets[setIdx] {
+		inSet[idx] = true
+	}
+	for i, eval := range ep.groupList {
+		if inSet[i] {
+			continue
+		}
+		// only columns can be used in GROUP BY at the moment
+		if pa, ok := eval.(*pathAccess); ok {
+			// this only fails when the relation is NULL because of
+			// an outer join, where the column is NULL anyway
+			row.Set(pa.path, data.Null{})
+		}
+	}
+	for ref, params := range ep.groupingFuncs {
+		mask := int64(0)
+		for _, idx := range params {
+			mask <<= 1
+			if !inSet[idx] {
+				mask |= 1
+			}
+		}
+		row[groupingKey(ref)] = data.Int(mask)
+	}
+}
+
+// groupValues returns the values of the expressions in the GROUP BY
+// clause for the given row. They are computed only once and cached in
+// the row together with their hash value.
+func (ep *groupbyExecutionPlan) groupValues(io *inputRowWithCachedResult) (data.Array, error) {
+	// if we have a cached result, use this
+	if io.cache != nil {
+		cachedGroupValues, err := data.AsArray(io.cache)
+		if err != nil {
+			return nil, fmt.Errorf("cached data was not an array: %v", io.cache)
+		}
+		return cachedGroupValues, nil
+	}
+	// otherwise, compute the expressions in the GROUP BY to find
+	// the correct group to append to
+	itemGroupValues := make(data.Array, len(ep.groupList))
+	for i, eval := range ep.groupList {
+		// ordinary "flat" expression
+		value, err := eval.Eval(*io.input)
+		if err != nil {
+			return nil, err
+		}
+		itemGroupValues[i] = value
+	}
+	io.cache = itemGroupValues
+	io.hash = data.Hash(io.cache)
+	return itemGroupValues, nil
+}
+
+// groupingSetValues returns the values identifying the group of a row
+// in the grouping set having the given index, where groupValues are the
+// values of all columns in the GROUP BY clause for the row. The values
+// of the columns not in the set are NULL and the index of the set is
+// appended so that those groups are distinguished from groups having
+// actual NULL values.
+func (ep *groupbyExecutionPlan) groupingSetValues(groupValues data.Array, setIdx int) data.Array {
+	setValues := make(data.Array, len(groupValues)+1)
+	for j := range groupValues {
+		setValues[j] = data.Null{}
+	}
+	for _, idx := range ep.groupingSets[setIdx] {
+		setValues[idx] = groupValues[idx]
+	}
+	setValues[len(groupValues)] = data.Int(setIdx)
+	return setValues
 }
 
 // Process takes an input tuple and returns a slice of Map values that
@@ -71,6 +183,10 @@ func (ep *groupbyExecutionPlan) Process(input *core.Tuple) ([]data.Map, error) {
 // if no error had happened), but the contents of ep.curResults are
 // undefined.
 func (ep *groupbyExecutionPlan) performQueryOnBuffer() error {
+	if ep.incremental != nil {
+		return ep.incremental.performQuery(ep)
+	}
+
 	// reuse the allocated memory
 	output := ep.prevResults[0:0]
 	// remember the previous results
@@ -111,7 +227,7 @@ func (ep *groupbyExecutionPlan) performQueryOnBuffer() error {
 	// groupValues in the `groups`map. if there is no such
 	// group, a new one is created and a copy of the given map
 	// is used as a representative of this group's values.
-	findOrCreateGroup := func(groupValues []data.Value, groupHash data.HashValue, nonGroupValues data.Map) (*tmpGroupData, error) {
+	findOrCreateGroup := func(groupValues []data.Value, groupHash data.HashValue, nonGroupValues data.Map, groupingSet int) (*tmpGroupData, error) {
 		mkGroup := func() *tmpGroupData {
 			newGroup := &tmpGroupData{
 				// the values that make up this group
@@ -122,6 +238,8 @@ func (ep *groupbyExecutionPlan) performQueryOnBuffer() error {
 				// TODO actually we don't need the whole map,
 				//      just the parts common to the whole group
 				nonGroupValues.Copy(),
+				// the grouping set this group belongs to
+				groupingSet,
 			}
 			// initialize the map with the aggregate function inputs
 			for _, proj := range ep.projections {
@@ -163,33 +281,29 @@ func (ep *groupbyExecutionPlan) performQueryOnBuffer() error {
 	// function to compute the grouping expressions and store the
 	// input for aggregate functions in the correct group.
 	evalItem := func(io *inputRowWithCachedResult) error {
-		var itemGroupValues data.Array
-		// if we have a cached result, use this
-		if io.cache != nil {
-			cachedGroupValues, err := data.AsArray(io.cache)
+		itemGroupValues, err := ep.groupValues(io)
+		if err != nil {
+			return err
+		}
+
+		var itemGroups []*tmpGroupData
+		if ep.groupingSets == nil {
+			itemGroup, err := findOrCreateGroup(itemGroupValues, io.hash, *io.input, 0)
 			if err != nil {
-				return fmt.Errorf("cached data was not an array: %v", io.cache)
+				return err
 			}
-			itemGroupValues = cachedGroupValues
+			itemGroups = []*tmpGroupData{itemGroup}
 		} else {
-			// otherwise, compute the expressions in the GROUP BY to find
-			// the correct group to append to
-			itemGroupValues = make([]data.Value, len(ep.groupList))
-			for i, eval := range ep.groupList {
-				// ordinary "flat" expression
-				value, err := eval.Eval(*io.input)
+			// the item belongs to one group in each grouping set
+			itemGroups = make([]*tmpGroupData, len(ep.groupingSets))
+			for i := range ep.groupingSets {
+				setValues := ep.groupingSetValues(itemGroupValues, i)
+				itemGroup, err := findOrCreateGroup(setValues, data.Hash(setValues), *io.input, i)
 				if err != nil {
 					return err
 				}
-				itemGroupValues[i] = value
+				itemGroups[i] = itemGroup
 			}
-			io.cache = itemGroupValues
-			io.hash = data.Hash(io.cache)
-		}
-
-		itemGroup, err := findOrCreateGroup(itemGroupValues, io.hash, *io.input)
-		if err != nil {
-			return err
 		}
 
 		// now compute all the input data for the aggregate functions,
@@ -200,71 +314,127 @@ func (ep *groupbyExecutionPlan) performQueryOnBuffer() error {
 				return err
 			}
 			// store this value in the output map
-			itemGroup.aggData[key] = append(itemGroup.aggData[key], value)
+			for _, itemGroup := range itemGroups {
+				itemGroup.aggData[key] = append(itemGroup.aggData[key], value)
+			}
 		}
 		return nil
 	}
 
 	evalGroup := func(group *tmpGroupData) error {
-		result := data.Map(make(map[string]data.Value, len(ep.projections)))
 		// collect input for aggregate functions into an array
 		// within each group
 		for key := range allAggEvaluators {
 			group.nonAggData[key] = data.Array(group.aggData[key])
 			delete(group.aggData, key)
 		}
-		// evaluate HAVING condition, if there is one
-		for _, proj := range ep.projections {
-			if proj.alias == ":having:" {
-				havingResult, err := proj.evaluator.Eval(group.nonAggData)
-				if err != nil {
-					return err
-				}
-				// a NULL value is definitely not "true", so since we
-				// have only a binary decision, we should drop tuples
-				// where the condition evaluates to NULL
-				havingResultBool := false
-				if havingResult.Type() != data.TypeNull {
-					havingResultBool, err = data.AsBool(havingResult)
-					if err != nil {
-						return err
-					}
-				}
-				// if it evaluated to false, do not further process this group
-				if !havingResultBool {
-					return nil
-				}
-				break
-			}
+		if ep.groupingSets != nil {
+			ep.applyGroupingSet(group.nonAggData, group.groupingSet)
 		}
-		// now evaluate all other projections
-		for _, proj := range ep.projections {
-			if proj.alias == ":having:" {
-				continue
+		res, err := ep.evalGroup(ep.projections, group.nonAggData)
+		if err != nil {
+			return err
+		}
+		if res != nil {
+			output = append(output, *res)
+		}
+		return nil
+	}
+
+	// compute the output for each item in ep.filteredInputRows
+	for e := ep.filteredInputRows.Front(); e != nil; e = e.Next() {
+		item := e.Value.(*inputRowWithCachedResult)
+		if err := evalItem(item); err != nil {
+			rollback()
+			return err
+		}
+	}
+
+	// if we arrive here, then the input for the aggregation functions
+	// is in the `group` list and we need to compute aggregation and output.
+	// NB. we do not directly loop over the `groups` map to avoid random order.
+	for _, groupKey := range groupKeys {
+		groupsWithSameHash := groups[groupKey]
+		for _, group := range groupsWithSameHash {
+			if err := evalGroup(group); err != nil {
+				rollback()
+				return err
 			}
-			// now evaluate this projection on the flattened data
-			value, err := proj.evaluator.Eval(group.nonAggData)
+		}
+	}
+	if len(groups) == 0 {
+		res, err := ep.evalEmptyGroups()
+		if err != nil {
+			rollback()
+			return err
+		}
+		output = append(output, res...)
+	}
+
+	ep.curResults = output
+	return nil
+}
+
+// evalGroup evaluates the HAVING condition and the projections on the
+// representative row of a group, which also holds the input values or
+// the results of the aggregate functions. It returns nil if the HAVING
+// condition is not satisfied.
+func (ep *groupbyExecutionPlan) evalGroup(projections []aliasedEvaluator, row data.Map) (*resultRow, error) {
+	result := data.Map(make(map[string]data.Value, len(projections)))
+	// evaluate HAVING condition, if there is one
+	for _, proj := range projections {
+		if proj.alias == ":having:" {
+			havingResult, err := proj.evaluator.Eval(row)
 			if err != nil {
-				return err
+				return nil, err
 			}
-			if err := assignOutputValue(result, proj.alias, proj.aliasPath, value); err != nil {
-				return err
+			// a NULL value is definitely not "true", so since we
+			// have only a binary decision, we should drop tuples
+			// where the condition evaluates to NULL
+			havingResultBool := false
+			if havingResult.Type() != data.TypeNull {
+				havingResultBool, err = data.AsBool(havingResult)
+				if err != nil {
+					return nil, err
+				}
+			}
+			// if it evaluated to false, do not further process this group
+			if !havingResultBool {
+				return nil, nil
 			}
+			break
 		}
-		output = append(output, resultRow{row: result, hash: data.Hash(result)})
-		return nil
 	}
-
-	evalNoGroup := func() error {
-		// if we have an empty group list *and* a GROUP BY clause,
-		// we have to return an empty result (because there are no
-		// rows with "the same values"). but if the list is empty and
-		// we *don't* have a GROUP BY clause, then we need to compute
-		// all foldables and aggregates with an empty input
-		if len(ep.groupList) > 0 {
-			return nil
+	// now evaluate all other projections
+	for _, proj := range projections {
+		if proj.alias == ":having:" {
+			continue
 		}
+		// now evaluate this projection on the flattened data
+		value, err := proj.evaluator.Eval(row)
+		if err != nil {
+			return nil, err
+		}
+		if err := assignOutputValue(result, proj.alias, proj.aliasPath, value); err != nil {
+			return nil, err
+		}
+	}
+	return &resultRow{row: result, hash: data.Hash(result)}, nil
+}
+
+// evalEmptyGroups computes the results when there are no groups at all.
+// If we have an empty group list *and* a GROUP BY clause, we have to
+// return an empty result (because there are no rows with "the same
+// values"). But if the list is empty and we *don't* have a GROUP BY
+// clause, then we need to compute all foldables and aggregates with an
+// empty input. The same applies to each empty grouping set.
+func (ep *groupbyExecutionPlan) evalEmptyGroups() ([]resultRow, error) {
+	var output []resultRow
+	evalNoGroup := func(groupingSet int) error {
 		input := data.Map{}
+		if ep.groupingSets != nil {
+			ep.applyGroupingSet(input, groupingSet)
+		}
 		result := data.Map(make(map[string]data.Value, len(ep.projections)))
 		for _, proj := range ep.projections {
 			// collect input for aggregate functions
@@ -277,7 +447,8 @@ func (ep *groupbyExecutionPlan) performQueryOnBuffer() error {
 			// note that input has *only* the keys of the empty
 			// arrays, no other columns, but we cannot have other
 			// columns involved in the projection (since we know
-			// that GROUP BY is empty).
+			// that GROUP BY or the grouping set is empty, and the
+			// columns not in the grouping set are NULL).
 			value, err := proj.evaluator.Eval(input)
 			if err != nil {
 				return err
@@ -290,34 +461,21 @@ func (ep *groupbyExecutionPlan) performQueryOnBuffer() error {
 		return nil
 	}
 
-	// compute the output for each item in ep.filteredInputRows
-	for e := ep.filteredInputRows.Front(); e != nil; e = e.Next() {
-		item := e.Value.(*inputRowWithCachedResult)
-		if err := evalItem(item); err != nil {
-			rollback()
-			return err
-		}
-	}
-
-	// if we arrive here, then the input for the aggregation functions
-	// is in the `group` list and we need to compute aggregation and output.
-	// NB. we do not directly loop over the `groups` map to avoid random order.
-	for _, groupKey := range groupKeys {
-		groupsWithSameHash := groups[groupKey]
-		for _, group := range groupsWithSameHash {
-			if err := evalGroup(group); err != nil {
-				rollback()
-				return err
+	if ep.groupingSets == nil {
+		if len(ep.groupList) == 0 {
+			if err := evalNoGroup(0); err != nil {
+				return nil, err
 			}
 		}
+		return output, nil
 	}
-	if len(groups) == 0 {
-		if err := evalNoGroup(); err != nil {
-			rollback()
-			return err
+	for i, set := range ep.groupingSets {
+		if len(set) > 0 {
+			continue
+		}
+		if err := evalNoGroup(i); err != nil {
+			return nil, err
 		}
 	}
-
-	ep.curResults = output
-	return nil
+	return output, nil
 }
@@ -16,6 +16,8 @@ func createGroupbyPlan(s string, t *testing.T) (PhysicalPlan, error) {
 	p := parser.New()
 	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
 	reg.Register("udaf", &dummyAggregate{})
+	reg.Register("wsum", udf.ConvertUDAF(&weightedSumUDAF{}))
+	reg.Register("latest", udf.ConvertUDAF(&latestUDAF{}))
 	_stmt, _, err := p.ParseStmt(s)
 	if err != nil {
 		return nil, err
@@ -836,6 +838,314 @@ func TestGroupbyExecutionPlan(t *testing.T) {
 	})
 }
 
+func TestGroupbyExecutionPlanSlidingWindow(t *testing.T) {
+	Convey("Given a SELECT clause with an aggregate on a hopping window", t, func() {
+		tuples := getTuples(8)
+
+		s := `CREATE STREAM box AS SELECT RSTREAM count(int) AS c FROM src [RANGE 4 SECONDS SLIDE 2 SECONDS]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then results should only appear at window boundaries in %v", idx), func() {
+					if idx == 2 {
+						// window [-2s, 2s)
+						So(len(out), ShouldEqual, 1)
+						So(out[0], ShouldResemble, data.Map{"c": data.Int(2)})
+					} else if idx == 4 || idx == 6 {
+						// windows [0s, 4s) and [2s, 6s)
+						So(len(out), ShouldEqual, 1)
+						So(out[0], ShouldResemble, data.Map{"c": data.Int(4)})
+					} else {
+						So(out, ShouldBeEmpty)
+					}
+				})
+			}
+		})
+	})
+}
+
+func TestGroupbyExecutionPlanSessionWindow(t *testing.T) {
+	Convey("Given a SELECT clause with an aggregate on a keyed session window", t, func() {
+		// users a and b with tuples at 0s(a), 1s(b), 2s(a), 3s(a), 6s(b), 7s(a)
+		tuples := getTuples(8)
+		users := []string{"a", "b", "a", "a", "", "", "b", "a"}
+		for i, u := range users {
+			tuples[i].Data["user"] = data.String(u)
+		}
+		tuples = append(tuples[:4], tuples[6:]...)
+
+		s := `CREATE STREAM box AS SELECT ISTREAM user, count(int) AS c
+			FROM src [SESSION GAP 2 SECONDS BY user] GROUP BY user`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then one result per closed session should appear in %v", idx), func() {
+					if idx == 4 {
+						// the sessions of a ([0s, 3s]) and b ([1s]) are
+						// closed in the order in which they started
+						So(out, ShouldResemble, []data.Map{
+							{"user": data.String("a"), "c": data.Int(3)},
+							{"user": data.String("b"), "c": data.Int(1)},
+						})
+					} else {
+						So(out, ShouldBeEmpty)
+					}
+				})
+			}
+		})
+	})
+
+	Convey("Given a SELECT clause with an aggregate on a session window without key", t, func() {
+		tuples := getTuples(3)
+		tuples[2].Timestamp = tuples[1].Timestamp.Add(1500 * time.Millisecond)
+
+		s := `CREATE STREAM box AS SELECT RSTREAM count(int) AS c
+			FROM src [SESSION GAP 1 SECONDS] WHERE int > 1`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then the session should be closed after the gap in %v", idx), func() {
+					if idx == 2 {
+						So(out, ShouldResemble, []data.Map{{"c": data.Int(1)}})
+					} else {
+						So(out, ShouldBeEmpty)
+					}
+				})
+			}
+		})
+	})
+}
+
+func TestGroupbyExecutionPlanOrderByLimit(t *testing.T) {
+	Convey("Given a SELECT clause with an aggregate, ORDER BY and LIMIT", t, func() {
+		tuples := getTuples(5)
+		foos := []string{"a", "b", "a", "b", "b"}
+		for i, foo := range foos {
+			tuples[i].Data["foo"] = data.String(foo)
+		}
+
+		s := `CREATE STREAM box AS SELECT RSTREAM foo, count(int) AS c
+			FROM src [RANGE 5 TUPLES] GROUP BY foo ORDER BY c DESC, foo LIMIT 1`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			// ties in c are broken by the second ORDER BY expression
+			expected := []data.Map{
+				{"foo": data.String("a"), "c": data.Int(1)},
+				{"foo": data.String("a"), "c": data.Int(1)},
+				{"foo": data.String("a"), "c": data.Int(2)},
+				{"foo": data.String("a"), "c": data.Int(2)},
+				{"foo": data.String("b"), "c": data.Int(3)},
+			}
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then only the top group should appear in %v", idx), func() {
+					So(out, ShouldResemble, []data.Map{expected[idx]})
+				})
+			}
+		})
+	})
+}
+
+func TestGroupbyExecutionPlanDistinct(t *testing.T) {
+	Convey("Given a SELECT clause with DISTINCT aggregates", t, func() {
+		tuples := getTuples(5)
+		foos := []string{"a", "b", "a", "c", "b"}
+		for i, foo := range foos {
+			tuples[i].Data["foo"] = data.String(foo)
+		}
+
+		s := `CREATE STREAM box AS SELECT RSTREAM count(DISTINCT foo) AS d,
+			count(foo) AS c, array_agg(DISTINCT foo) AS a FROM src [RANGE 5 TUPLES]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then duplicates should be ignored in %v", idx), func() {
+					distinct := data.Array{}
+					for _, foo := range []string{"a", "b", "c"} {
+						for _, f := range foos[:idx+1] {
+							if f == foo {
+								distinct = append(distinct, data.String(foo))
+								break
+							}
+						}
+					}
+					So(out, ShouldResemble, []data.Map{{
+						"d": data.Int(len(distinct)),
+						"c": data.Int(idx + 1),
+						"a": distinct,
+					}})
+				})
+			}
+		})
+	})
+
+	Convey("Given a SELECT DISTINCT clause with an aggregate", t, func() {
+		tuples := getTuples(4)
+		foos := []string{"a", "b", "b", "a"}
+		for i, foo := range foos {
+			tuples[i].Data["foo"] = data.String(foo)
+		}
+
+		s := `CREATE STREAM box AS SELECT RSTREAM DISTINCT count(int) AS c
+			FROM src [RANGE 4 TUPLES] GROUP BY foo`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When feeding it with tuples", func() {
+			for idx, inTup := range tuples {
+				out, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+
+				Convey(fmt.Sprintf("Then equal groups should be emitted only once in %v", idx), func() {
+					if idx == 2 {
+						So(out, ShouldResemble, []data.Map{{"c": data.Int(1)}, {"c": data.Int(2)}})
+					} else if idx == 1 || idx == 3 {
+						So(out, ShouldResemble, []data.Map{{"c": data.Int(idx/2 + 1)}})
+					} else {
+						So(out, ShouldResemble, []data.Map{{"c": data.Int(1)}})
+					}
+				})
+			}
+		})
+	})
+}
+
+func TestGroupbyExecutionPlanGroupingSets(t *testing.T) {
+	Convey("Given tuples with two group columns", t, func() {
+		tuples := getOtherTuples()
+		bars := []string{"a", "b", "a", "a"}
+		for i, bar := range bars {
+			tuples[i].Data["bar"] = data.String(bar)
+		}
+		// the last tuple of the window
+		last := func(plan PhysicalPlan) []data.Map {
+			var out []data.Map
+			for _, inTup := range tuples {
+				res, err := plan.Process(inTup)
+				So(err, ShouldBeNil)
+				out = res
+			}
+			return out
+		}
+		row := func(foo, bar data.Value, c, g int) data.Map {
+			return data.Map{"foo": foo, "bar": bar, "c": data.Int(c), "g": data.Int(g)}
+		}
+		null := data.Null{}
+
+		Convey("When grouping them with ROLLUP", func() {
+			s := `CREATE STREAM box AS SELECT RSTREAM foo, bar, count(*) AS c,
+				grouping(foo, bar) AS g FROM src [RANGE 4 TUPLES] GROUP BY ROLLUP(foo, bar)`
+			plan, err := createGroupbyPlan(s, t)
+			So(err, ShouldBeNil)
+
+			Convey("Then every level should be computed", func() {
+				out := last(plan)
+				So(out, ShouldHaveLength, 6)
+				So(out, ShouldContain, row(data.Int(1), data.String("a"), 1, 0))
+				So(out, ShouldContain, row(data.Int(1), data.String("b"), 1, 0))
+				So(out, ShouldContain, row(data.Int(2), data.String("a"), 2, 0))
+				So(out, ShouldContain, row(data.Int(1), null, 2, 1))
+				So(out, ShouldContain, row(data.Int(2), null, 2, 1))
+				So(out, ShouldContain, row(null, null, 4, 3))
+			})
+		})
+
+		Convey("When grouping them with CUBE", func() {
+			s := `CREATE STREAM box AS SELECT RSTREAM foo, bar, count(*) AS c,
+				grouping(foo, bar) AS g FROM src [RANGE 4 TUPLES] GROUP BY CUBE(foo, bar)`
+			plan, err := createGroupbyPlan(s, t)
+			So(err, ShouldBeNil)
+
+			Convey("Then every combination should be computed", func() {
+				out := last(plan)
+				So(out, ShouldHaveLength, 8)
+				So(out, ShouldContain, row(null, data.String("a"), 3, 2))
+				So(out, ShouldContain, row(null, data.String("b"), 1, 2))
+				So(out, ShouldContain, row(null, null, 4, 3))
+			})
+		})
+
+		Convey("When grouping them with GROUPING SETS and HAVING", func() {
+			s := `CREATE STREAM box AS SELECT RSTREAM foo, bar, count(*) AS c,
+				grouping(foo, bar) AS g FROM src [RANGE 4 TUPLES]
+				GROUP BY GROUPING SETS((foo, bar), bar) HAVING grouping(foo) = 1`
+			plan, err := createGroupbyPlan(s, t)
+			So(err, ShouldBeNil)
+
+			Convey("Then only the groups of the filtered set should be emitted", func() {
+				out := last(plan)
+				So(out, ShouldHaveLength, 2)
+				So(out, ShouldContain, row(null, data.String("a"), 3, 2))
+				So(out, ShouldContain, row(null, data.String("b"), 1, 2))
+			})
+		})
+
+		Convey("When a group column has a NULL value", func() {
+			tuples[3].Data["bar"] = null
+			s := `CREATE STREAM box AS SELECT RSTREAM foo, bar, count(*) AS c,
+				grouping(foo, bar) AS g FROM src [RANGE 4 TUPLES] GROUP BY ROLLUP(foo, bar)`
+			plan, err := createGroupbyPlan(s, t)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should be distinguished by grouping()", func() {
+				out := last(plan)
+				So(out, ShouldHaveLength, 7)
+				So(out, ShouldContain, row(data.Int(2), null, 1, 0))
+				So(out, ShouldContain, row(data.Int(2), null, 2, 1))
+			})
+		})
+
+		Convey("When all tuples are filtered out", func() {
+			s := `CREATE STREAM box AS SELECT RSTREAM foo, bar, count(*) AS c,
+				grouping(foo, bar) AS g FROM src [RANGE 4 TUPLES] WHERE int > 10
+				GROUP BY ROLLUP(foo, bar)`
+			plan, err := createGroupbyPlan(s, t)
+			So(err, ShouldBeNil)
+
+			Convey("Then only the empty grouping set should be emitted", func() {
+				So(last(plan), ShouldResemble, []data.Map{row(null, null, 0, 3)})
+			})
+		})
+
+		Convey("When using grouping() with a plain GROUP BY", func() {
+			s := `CREATE STREAM box AS SELECT RSTREAM foo, bar, count(*) AS c,
+				grouping(foo, bar) AS g FROM src [RANGE 4 TUPLES] GROUP BY foo, bar`
+			plan, err := createGroupbyPlan(s, t)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should always return 0", func() {
+				out := last(plan)
+				So(out, ShouldHaveLength, 3)
+				So(out, ShouldContain, row(data.Int(2), data.String("a"), 2, 0))
+			})
+		})
+	})
+}
+
 func TestAggregateFunctions(t *testing.T) {
 	getExtTuples := func() []*core.Tuple {
 		tuples := getOtherTuples()
@@ -1179,6 +1489,8 @@ func createGroupbyPlan2(s string) (PhysicalPlan, error) {
 	p := parser.New()
 	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
 	reg.Register("udaf", &dummyAggregate{})
+	reg.Register("wsum", udf.ConvertUDAF(&weightedSumUDAF{}))
+	reg.Register("latest", udf.ConvertUDAF(&latestUDAF{}))
 	_stmt, _, err := p.ParseStmt(s)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,529 @@
+package execution
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sort"
+	"strings"
+)
+
+// incrementalAggregation maintains the groups of a groupbyExecutionPlan
+// and the accumulators of their aggregate functions across evaluations.
+// Instead of computing all aggregates from all rows in the window on
+// every evaluation, only the rows that entered the window are added to
+// the accumulators and the rows that left it are retracted from them.
+// This is only possible if all aggregate functions in the statement
+// implement udf.IncrementalUDF.
+type incrementalAggregation struct {
+	// ctx is passed to the aggregate functions creating accumulators.
+	ctx *core.Context
+	// projections are the projections of the statement in which the
+	// aggregate functions are replaced by accumulatorRefs.
+	projections []aliasedEvaluator
+	// aggregates holds the aggregate functions keyed by the references
+	// of their results.
+	aggregates map[string]incrementalAggregate
+	// inputs holds the evaluators of the aggregate inputs keyed by
+	// their references.
+	inputs map[string]Evaluator
+
+	// groups holds the non-empty groups keyed by the hash values of
+	// the values identifying them. It is nil if the groups have to be
+	// rebuilt from all rows in the window, for example because an
+	// update failed.
+	groups    map[data.HashValue][]*accumulatedGroup
+	numGroups int
+	// nextSeq is the sequence number of the next row added.
+	nextSeq int64
+}
+
+// incrementalAggregate is an aggregate function whose result is
+// maintained by an accumulator.
+type incrementalAggregate struct {
+	f udf.IncrementalUDF
+	// inputs are the references of the aggregate inputs passed as
+	// arguments.
+	inputs []string
+}
+
+// args returns the arguments of the aggregate function for the row.
+func (a *incrementalAggregate) args(r *accumulatedRow) []data.Value {
+	args := make([]data.Value, len(a.inputs))
+	for i, in := range a.inputs {
+		args[i] = r.inputs[in]
+	}
+	return args
+}
+
+// accumulatedGroup is the incrementally maintained counterpart of
+// tmpGroupData.
+type accumulatedGroup struct {
+	group       data.Array
+	groupingSet int
+	// rows holds the rows of the group in the order in which they
+	// were added, which is the order of the rows in the window.
+	rows         []accumulatedRow
+	accumulators map[string]udf.Accumulator
+}
+
+// accumulatedRow is a row which was added to the accumulators of one
+// or more groups.
+type accumulatedRow struct {
+	row *inputRowWithCachedResult
+	seq int64
+	// inputs holds the values of the aggregate inputs for the row. They
+	// are stored so that the same values can be retracted again, even
+	// if the inputs are volatile.
+	inputs map[string]data.Value
+}
+
+// newIncrementalAggregation returns an incrementalAggregation for the
+// given projections. It returns nil if one of the aggregate functions
+// used in them doesn't implement udf.IncrementalUDF.
+func newIncrementalAggregation(projections []aliasedExpression, reg udf.FunctionRegistry) (*incrementalAggregation, error) {
+	aggregates := map[string]incrementalAggregate{}
+	replaced := make([]aliasedExpression, len(projections))
+	for i, proj := range projections {
+		expr, ok := replaceIncrementalAggregates(proj.expr, reg, aggregates)
+		if !ok {
+			return nil, nil
+		}
+		replaced[i] = aliasedExpression{proj.alias, expr, proj.aggrInputs}
+	}
+	projs, err := prepareProjections(replaced, reg)
+	if err != nil {
+		return nil, err
+	}
+	inputs := map[string]Evaluator{}
+	for _, proj := range projs {
+		for key, eval := range proj.aggrEvals {
+			inputs[key] = eval
+		}
+	}
+	return &incrementalAggregation{
+		ctx:         reg.Context(),
+		projections: projs,
+		aggregates:  aggregates,
+		inputs:      inputs,
+	}, nil
+}
+
+// replaceIncrementalAggregates replaces all calls of aggregate functions
+// implementing udf.IncrementalUDF in the given expression by references
+// to the results of their accumulators, which are added to aggregates.
+// It returns false if there are other aggregate functions.
+func replaceIncrementalAggregates(expr FlatExpression, reg udf.FunctionRegistry, aggregates map[string]incrementalAggregate) (FlatExpression, bool) {
+	replaceAll := func(exprs []FlatExpression) ([]FlatExpression, bool) {
+		replaced := make([]FlatExpression, len(exprs))
+		for i, e := range exprs {
+			r, ok := replaceIncrementalAggregates(e, reg, aggregates)
+			if !ok {
+				return nil, false
+			}
+			replaced[i] = r
+		}
+		return replaced, true
+	}
+
+	switch obj := expr.(type) {
+	case aggInputRef, aggregateInputSorter, aggregateInputDistinct:
+		// the values of the aggregate input are required as a whole
+		return nil, false
+	case funcAppAST:
+		// all parameters of an incremental aggregate function are
+		// aggregation parameters
+		inputs := make([]string, len(obj.Expressions))
+		for i, e := range obj.Expressions {
+			in, ok := e.(aggInputRef)
+			if !ok {
+				inputs = nil
+				break
+			}
+			inputs[i] = in.Ref
+		}
+		if len(inputs) > 0 {
+			f, err := reg.Lookup(string(obj.Function), len(inputs))
+			if err != nil {
+				return nil, false
+			}
+			inc, ok := f.(udf.IncrementalUDF)
+			if !ok {
+				return nil, false
+			}
+			ref := fmt.Sprintf("%s(%s)", obj.Function, strings.Join(inputs, ","))
+			aggregates[ref] = incrementalAggregate{inc, inputs}
+			return accumulatorRef{ref}, true
+		}
+		exprs, ok := replaceAll(obj.Expressions)
+		if !ok {
+			return nil, false
+		}
+		return funcAppAST{obj.Function, exprs}, true
+	case binaryOpAST:
+		exprs, ok := replaceAll([]FlatExpression{obj.Left, obj.Right})
+		if !ok {
+			return nil, false
+		}
+		return binaryOpAST{obj.Op, exprs[0], exprs[1]}, true
+	case betweenAST:
+		exprs, ok := replaceAll([]FlatExpression{obj.Expr, obj.Lower, obj.Upper})
+		if !ok {
+			return nil, false
+		}
+		return betweenAST{exprs[0], exprs[1], exprs[2]}, true
+	case unaryOpAST:
+		e, ok := replaceIncrementalAggregates(obj.Expr, reg, aggregates)
+		if !ok {
+			return nil, false
+		}
+		return unaryOpAST{obj.Op, e}, true
+	case typeCastAST:
+		e, ok := replaceIncrementalAggregates(obj.Expr, reg, aggregates)
+		if !ok {
+			return nil, false
+		}
+		return typeCastAST{e, obj.Target}, true
+	case higherOrderFuncAppAST:
+		// the lambda expression cannot contain aggregate functions
+		e, ok := replaceIncrementalAggregates(obj.Array, reg, aggregates)
+		if !ok {
+			return nil, false
+		}
+		return higherOrderFuncAppAST{obj.Function, e, obj.Param, obj.Body}, true
+	case arrayAST:
+		exprs, ok := replaceAll(obj.Expressions)
+		if !ok {
+			return nil, false
+		}
+		return arrayAST{exprs}, true
+	case mapAST:
+		entries := make([]keyValuePair, len(obj.Entries))
+		for i, pair := range obj.Entries {
+			v, ok := replaceIncrementalAggregates(pair.Value, reg, aggregates)
+			if !ok {
+				return nil, false
+			}
+			entries[i] = keyValuePair{pair.Key, v}
+		}
+		return mapAST{entries}, true
+	case caseAST:
+		exprs := []FlatExpression{obj.Reference, obj.Default}
+		for _, pair := range obj.Checks {
+			exprs = append(exprs, pair.When, pair.Then)
+		}
+		exprs, ok := replaceAll(exprs)
+		if !ok {
+			return nil, false
+		}
+		checks := make([]whenThenPair, len(obj.Checks))
+		for i := range checks {
+			checks[i] = whenThenPair{exprs[2+2*i], exprs[3+2*i]}
+		}
+		return caseAST{exprs[0], checks, exprs[1]}, true
+	}
+	// all other expressions don't contain aggregate functions
+	return expr, true
+}
+
+// update adds the rows which entered the window since the last update to
+// the accumulators and retracts the rows which left it. If inc.groups
+// is nil, the groups are rebuilt from all rows in the window instead,
+// which is also required after update returned an error.
+func (inc *incrementalAggregation) update(ep *groupbyExecutionPlan) error {
+	expired := ep.expiredInputRows
+	ep.expiredInputRows = nil
+	added := ep.filteredInputRowsBuffer
+	if inc.groups == nil {
+		inc.groups = map[data.HashValue][]*accumulatedGroup{}
+		inc.numGroups = 0
+		expired = nil
+		added = ep.filteredInputRows
+	}
+
+	for _, row := range expired {
+		if err := inc.retract(ep, row); err != nil {
+			return err
+		}
+	}
+	if added != nil {
+		for e := added.Front(); e != nil; e = e.Next() {
+			if err := inc.add(ep, e.Value.(*inputRowWithCachedResult)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// rowGroups returns the values and the hash values identifying the
+// groups of the given row as well as the indexes of their grouping sets.
+func (inc *incrementalAggregation) rowGroups(ep *groupbyExecutionPlan, row *inputRowWithCachedResult) ([]data.Array, []data.HashValue, error) {
+	values, err := ep.groupValues(row)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ep.groupingSets == nil {
+		return []data.Array{values}, []data.HashValue{row.hash}, nil
+	}
+	groups := make([]data.Array, len(ep.groupingSets))
+	hashes := make([]data.HashValue, len(ep.groupingSets))
+	for i := range ep.groupingSets {
+		groups[i] = ep.groupingSetValues(values, i)
+		hashes[i] = data.Hash(groups[i])
+	}
+	return groups, hashes, nil
+}
+
+// findGroup returns the group identified by the given values. It returns
+// nil if there is no such group.
+func (inc *incrementalAggregation) findGroup(values data.Array, hash data.HashValue) *accumulatedGroup {
+	for _, g := range inc.groups[hash] {
+		if data.Equal(values, g.group) {
+			return g
+		}
+	}
+	return nil
+}
+
+func (inc *incrementalAggregation) add(ep *groupbyExecutionPlan, row *inputRowWithCachedResult) error {
+	groups, hashes, err := inc.rowGroups(ep, row)
+	if err != nil {
+		return err
+	}
+	r := accumulatedRow{
+		row:    row,
+		seq:    inc.nextSeq,
+		inputs: make(map[string]data.Value, len(inc.inputs)),
+	}
+	inc.nextSeq++
+	// e.g. for `SELECT count(a) + max(b/2)`, compute `a` and `b/2`
+	for key, eval := range inc.inputs {
+		value, err := eval.Eval(*row.input)
+		if err != nil {
+			return err
+		}
+		r.inputs[key] = value
+	}
+
+	for i, values := range groups {
+		g := inc.findGroup(values, hashes[i])
+		if g == nil {
+			g = &accumulatedGroup{
+				group:       values,
+				groupingSet: i,
+			}
+			if err := inc.resetAccumulators(g); err != nil {
+				return err
+			}
+			inc.groups[hashes[i]] = append(inc.groups[hashes[i]], g)
+			inc.numGroups++
+		}
+		if err := inc.accumulate(g, &r); err != nil {
+			return err
+		}
+		g.rows = append(g.rows, r)
+	}
+	return nil
+}
+
+// accumulate adds the aggregate inputs of the row to the accumulators
+// of the group.
+func (inc *incrementalAggregation) accumulate(g *accumulatedGroup, r *accumulatedRow) error {
+	for ref, acc := range g.accumulators {
+		agg := inc.aggregates[ref]
+		if err := acc.Add(agg.args(r)...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resetAccumulators replaces the accumulators of the group by new ones
+// to which the rows of the group are added.
+func (inc *incrementalAggregation) resetAccumulators(g *accumulatedGroup) error {
+	g.accumulators = make(map[string]udf.Accumulator, len(inc.aggregates))
+	for ref, agg := range inc.aggregates {
+		acc, err := agg.f.NewAccumulator(inc.ctx)
+		if err != nil {
+			return err
+		}
+		g.accumulators[ref] = acc
+	}
+	for i := range g.rows {
+		if err := inc.accumulate(g, &g.rows[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (inc *incrementalAggregation) retract(ep *groupbyExecutionPlan, row *inputRowWithCachedResult) error {
+	groups, hashes, err := inc.rowGroups(ep, row)
+	if err != nil {
+		return err
+	}
+	for i, values := range groups {
+		g := inc.findGroup(values, hashes[i])
+		if g == nil {
+			return fmt.Errorf("the group of an expired row was not found: %v", values)
+		}
+		if len(g.rows) > 1 && g.rows[0].row == row {
+			retracted, err := inc.retractOldest(g)
+			if err != nil {
+				return err
+			}
+			if retracted {
+				continue
+			}
+		}
+
+		idx := -1
+		for j, r := range g.rows {
+			if r.row == row {
+				idx = j
+				break
+			}
+		}
+		if idx < 0 {
+			return fmt.Errorf("an expired row was not found in its group: %v", values)
+		}
+		g.rows = append(g.rows[:idx], g.rows[idx+1:]...)
+		if len(g.rows) == 0 {
+			inc.removeGroup(g, hashes[i])
+			continue
+		}
+		// rows which are not the oldest ones in their group can expire
+		// earlier when the window is time-based and tuples don't arrive
+		// in timestamp order or when there are multiple buffers. since
+		// accumulators can only retract the oldest row, they have to be
+		// rebuilt from the remaining rows then. the same applies to
+		// accumulators which cannot retract rows at all.
+		if err := inc.resetAccumulators(g); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// retractOldest retracts the oldest row of the group from all of its
+// accumulators and removes it from the group. It returns false if one
+// of the accumulators cannot retract rows.
+func (inc *incrementalAggregation) retractOldest(g *accumulatedGroup) (bool, error) {
+	r := &g.rows[0]
+	for ref, acc := range g.accumulators {
+		agg := inc.aggregates[ref]
+		if err := acc.Retract(agg.args(r)...); err != nil {
+			if err == udf.ErrNotRetractable {
+				return false, nil
+			}
+			return false, err
+		}
+	}
+	g.rows[0] = accumulatedRow{}
+	g.rows = g.rows[1:]
+	return true, nil
+}
+
+func (inc *incrementalAggregation) removeGroup(g *accumulatedGroup, hash data.HashValue) {
+	candidates := inc.groups[hash]
+	for i, c := range candidates {
+		if c == g {
+			candidates = append(candidates[:i], candidates[i+1:]...)
+			break
+		}
+	}
+	if len(candidates) == 0 {
+		delete(inc.groups, hash)
+	} else {
+		inc.groups[hash] = candidates
+	}
+	inc.numGroups--
+}
+
+// sortedGroups returns all groups in the order in which they would be
+// created by a full recomputation, i.e., in the order of their first rows
+// in the window and of their grouping sets.
+func (inc *incrementalAggregation) sortedGroups() []*accumulatedGroup {
+	groups := make(groupsByFirstRow, 0, inc.numGroups)
+	for _, candidates := range inc.groups {
+		groups = append(groups, candidates...)
+	}
+	sort.Sort(groups)
+	return groups
+}
+
+type groupsByFirstRow []*accumulatedGroup
+
+func (g groupsByFirstRow) Len() int {
+	return len(g)
+}
+
+func (g groupsByFirstRow) Less(i, j int) bool {
+	if g[i].rows[0].seq != g[j].rows[0].seq {
+		return g[i].rows[0].seq < g[j].rows[0].seq
+	}
+	return g[i].groupingSet < g[j].groupingSet
+}
+
+func (g groupsByFirstRow) Swap(i, j int) {
+	g[i], g[j] = g[j], g[i]
+}
+
+// performQuery is the counterpart of groupbyExecutionPlan's
+// performQueryOnBuffer using the accumulators.
+func (inc *incrementalAggregation) performQuery(ep *groupbyExecutionPlan) error {
+	// reuse the allocated memory
+	output := ep.prevResults[0:0]
+	// remember the previous results
+	ep.prevResults = ep.curResults
+
+	rollback := func() {
+		// see groupbyExecutionPlan.performQueryOnBuffer
+		ep.prevResults = output
+	}
+
+	if err := inc.update(ep); err != nil {
+		// the accumulators may be inconsistent now
+		inc.groups = nil
+		rollback()
+		return err
+	}
+
+	groups := inc.sortedGroups()
+	for _, g := range groups {
+		// a representative set of values for this group
+		row := g.rows[0].row.input.Copy()
+		for ref, acc := range g.accumulators {
+			v, err := acc.Result()
+			if err != nil {
+				rollback()
+				return err
+			}
+			row[accumulatorKey(ref)] = v
+		}
+		if ep.groupingSets != nil {
+			ep.applyGroupingSet(row, g.groupingSet)
+		}
+		res, err := ep.evalGroup(inc.projections, row)
+		if err != nil {
+			rollback()
+			return err
+		}
+		if res != nil {
+			output = append(output, *res)
+		}
+	}
+	if len(groups) == 0 {
+		res, err := ep.evalEmptyGroups()
+		if err != nil {
+			rollback()
+			return err
+		}
+		output = append(output, res...)
+	}
+
+	ep.curResults = output
+	return nil
+}
@@ -0,0 +1,225 @@
+package execution
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+	"time"
+)
+
+// getIncrementalTuples returns tuples having some NULL values and
+// timestamps which are not in order.
+func getIncrementalTuples(num int) []*core.Tuple {
+	tuples := getTuples(num)
+	for i, t := range tuples {
+		t.Data["int"] = data.Int((i * 7) % 11)
+		if i%5 == 4 {
+			t.Data["int"] = data.Null{}
+		}
+		t.Data["foo"] = data.Int((i / 2) % 3)
+		t.Data["bar"] = data.Int(i % 2)
+		if i%4 == 1 {
+			t.Timestamp = t.Timestamp.Add(2 * time.Second)
+		}
+	}
+	return tuples
+}
+
+// weightedSumUDAF computes the sum of its first argument multiplied by
+// its second argument.
+type weightedSumUDAF struct {
+}
+
+func (w *weightedSumUDAF) Accept(arity int) bool {
+	return arity == 2
+}
+
+func (w *weightedSumUDAF) Init(ctx *core.Context) (data.Value, error) {
+	return data.Float(0), nil
+}
+
+func (w *weightedSumUDAF) update(state data.Value, sign float64, args ...data.Value) (data.Value, error) {
+	if args[0].Type() == data.TypeNull || args[1].Type() == data.TypeNull {
+		return state, nil
+	}
+	sum, err := data.AsFloat(state)
+	if err != nil {
+		return nil, err
+	}
+	v, err := data.ToFloat(args[0])
+	if err != nil {
+		return nil, err
+	}
+	wt, err := data.ToFloat(args[1])
+	if err != nil {
+		return nil, err
+	}
+	return data.Float(sum + sign*v*wt), nil
+}
+
+func (w *weightedSumUDAF) Accumulate(ctx *core.Context, state data.Value, args ...data.Value) (data.Value, error) {
+	return w.update(state, 1, args...)
+}
+
+func (w *weightedSumUDAF) Retract(ctx *core.Context, state data.Value, args ...data.Value) (data.Value, error) {
+	return w.update(state, -1, args...)
+}
+
+func (w *weightedSumUDAF) Result(ctx *core.Context, state data.Value) (data.Value, error) {
+	return state, nil
+}
+
+// latestUDAF returns the last value added. It cannot retract values.
+type latestUDAF struct {
+}
+
+func (l *latestUDAF) Accept(arity int) bool {
+	return arity == 1
+}
+
+func (l *latestUDAF) Init(ctx *core.Context) (data.Value, error) {
+	return data.Null{}, nil
+}
+
+func (l *latestUDAF) Accumulate(ctx *core.Context, state data.Value, args ...data.Value) (data.Value, error) {
+	return args[0], nil
+}
+
+func (l *latestUDAF) Retract(ctx *core.Context, state data.Value, args ...data.Value) (data.Value, error) {
+	return nil, udf.ErrNotRetractable
+}
+
+func (l *latestUDAF) Result(ctx *core.Context, state data.Value) (data.Value, error) {
+	return state, nil
+}
+
+func TestIncrementalAggregation(t *testing.T) {
+	Convey("Given statements using only incremental aggregate functions", t, func() {
+		stmts := []string{
+			`CREATE STREAM box AS SELECT ISTREAM foo, count(*) AS c, sum(int) AS s,
+				avg(int) AS a, min(int) AS mi, max(int * 1.5) AS ma
+				FROM src [RANGE 4 TUPLES] GROUP BY foo`,
+			`CREATE STREAM box AS SELECT RSTREAM foo, sum(int) AS s, max(ts()) AS t
+				FROM src [RANGE 3 SECONDS] GROUP BY foo HAVING count(int) > 1`,
+			`CREATE STREAM box AS SELECT DSTREAM count(int) + 1 AS c,
+				CASE WHEN max(int) > 5 THEN "big" ELSE "small" END AS s,
+				[min(int), sum(int * 0.5)] AS a FROM src [RANGE 5 TUPLES]`,
+			`CREATE STREAM box AS SELECT RSTREAM foo, bar, count(*) AS c, sum(int) AS s,
+				grouping(foo, bar) AS g FROM src [RANGE 6 TUPLES] GROUP BY ROLLUP(foo, bar)`,
+			`CREATE STREAM box AS SELECT RSTREAM l:foo, count(*) AS c, min(r:int) AS m
+				FROM src [RANGE 4 TUPLES] AS l, src [RANGE 2 TUPLES] AS r
+				WHERE l:bar = r:bar GROUP BY l:foo`,
+			`CREATE STREAM box AS SELECT ISTREAM foo, wsum(int, bar + 1) AS w,
+				latest(int) AS l, count(*) AS c FROM src [RANGE 5 TUPLES] GROUP BY foo`,
+			`CREATE STREAM box AS SELECT RSTREAM bar, wsum(int, foo) - sum(foo) AS a,
+				latest(foo) AS l FROM src [RANGE 2 SECONDS] GROUP BY bar`,
+			`CREATE STREAM box AS SELECT RSTREAM foo, transform([sum(int), max(int)], x -> x * 2) AS t,
+				filter([min(int), count(*)], x -> x > foo) AS f FROM src [RANGE 4 TUPLES] GROUP BY foo`,
+		}
+		tuples := getIncrementalTuples(30)
+
+		for _, s := range stmts {
+			s := s
+			Convey(fmt.Sprintf("When feeding tuples to %s", s), func() {
+				plan, err := createGroupbyPlan(s, t)
+				So(err, ShouldBeNil)
+				other, err := createGroupbyPlan(s, t)
+				So(err, ShouldBeNil)
+				// recompute all aggregates on every evaluation
+				full := other.(*groupbyExecutionPlan)
+				full.incremental = nil
+				full.keepExpiredInputRows = false
+
+				Convey("Then the aggregates should be maintained incrementally", func() {
+					So(plan.(*groupbyExecutionPlan).incremental, ShouldNotBeNil)
+				})
+
+				Convey("Then the results should be the same as the recomputed ones", func() {
+					for _, inTup := range tuples {
+						out, err := plan.Process(inTup)
+						So(err, ShouldBeNil)
+						expected, err := full.Process(inTup)
+						So(err, ShouldBeNil)
+						// the order of rows of a join depends on the plan
+						So(out, ShouldHaveLength, len(expected))
+						for _, e := range expected {
+							So(out, ShouldContain, e)
+						}
+					}
+				})
+			})
+		}
+	})
+
+	Convey("Given statements which cannot be evaluated incrementally", t, func() {
+		stmts := []string{
+			`CREATE STREAM box AS SELECT RSTREAM foo, median(int) AS m FROM src [RANGE 4 TUPLES] GROUP BY foo`,
+			`CREATE STREAM box AS SELECT RSTREAM count(*) AS c, udaf(int) AS u FROM src [RANGE 4 TUPLES]`,
+			`CREATE STREAM box AS SELECT RSTREAM count(DISTINCT int) AS c FROM src [RANGE 4 TUPLES]`,
+			`CREATE STREAM box AS SELECT RSTREAM array_agg(int ORDER BY foo) AS a FROM src [RANGE 4 TUPLES]`,
+			`CREATE STREAM box AS SELECT RSTREAM sum(int) AS s FROM src [RANGE 4 TUPLES SLIDE 2 TUPLES]`,
+		}
+		for _, s := range stmts {
+			s := s
+			Convey(fmt.Sprintf("When creating a plan for %s", s), func() {
+				plan, err := createGroupbyPlan(s, t)
+				So(err, ShouldBeNil)
+
+				Convey("Then all aggregates should be recomputed on every evaluation", func() {
+					So(plan.(*groupbyExecutionPlan).incremental, ShouldBeNil)
+				})
+			})
+		}
+	})
+
+	Convey("Given a statement summing up values", t, func() {
+		s := `CREATE STREAM box AS SELECT RSTREAM sum(int) AS s FROM src [RANGE 2 TUPLES]`
+		plan, err := createGroupbyPlan(s, t)
+		So(err, ShouldBeNil)
+		tuples := getTuples(4)
+		tuples[1].Data["int"] = data.String("two")
+
+		Convey("When a value which cannot be summed up is in the window", func() {
+			_, err := plan.Process(tuples[0])
+			So(err, ShouldBeNil)
+			_, err = plan.Process(tuples[1])
+			So(err, ShouldNotBeNil)
+			_, err = plan.Process(tuples[2])
+			So(err, ShouldNotBeNil)
+
+			Convey("Then the sum should be correct after the value left the window", func() {
+				out, err := plan.Process(tuples[3])
+				So(err, ShouldBeNil)
+				So(out, ShouldResemble, []data.Map{{"s": data.Int(7)}})
+			})
+		})
+	})
+}
+
+func BenchmarkIncrementalGroupingExecution(b *testing.B) {
+	s := `CREATE STREAM box AS SELECT RSTREAM foo, count(int), sum(int), max(int)
+		FROM src [RANGE 10000 TUPLES] GROUP BY foo`
+	plan, err := createGroupbyPlan2(s)
+	if err != nil {
+		panic(err.Error())
+	}
+	tmplTup := core.Tuple{
+		Data:          data.Map{"int": data.Int(-1)},
+		InputName:     "src",
+		Timestamp:     time.Date(2015, time.April, 10, 10, 23, 0, 0, time.UTC),
+		ProcTimestamp: time.Date(2015, time.April, 10, 10, 24, 0, 0, time.UTC),
+		BatchID:       7,
+	}
+	for n := 0; n < b.N; n++ {
+		inTup := tmplTup.Copy()
+		inTup.Data["int"] = data.Int(n)
+		inTup.Data["foo"] = data.Int(n % 2)
+		_, err := plan.Process(inTup)
+		if err != nil {
+			panic(err.Error())
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package execution
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// stateJoinEvaluator looks up the entry of a shared state for a
+// JOIN STATE clause.
+type stateJoinEvaluator struct {
+	joinType parser.JoinType
+	state    string
+	alias    string
+	key      Evaluator
+	ctx      *core.Context
+}
+
+// lookup evaluates the key on the given row and returns the entry of
+// the state having the key. It returns nil if the key is NULL or the
+// state doesn't have an entry for the key.
+func (j *stateJoinEvaluator) lookup(row data.Map) (data.Map, error) {
+	key, err := j.key.Eval(row)
+	if err != nil {
+		return nil, err
+	}
+	if key.Type() == data.TypeNull {
+		return nil, nil
+	}
+	s, err := lookupableState(j.ctx, j.state)
+	if err != nil {
+		return nil, err
+	}
+	entry, err := s.Lookup(j.ctx, key)
+	if err != nil {
+		if core.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return entry, nil
+}
+
+// lookupableState returns the shared state having the given name if
+// it supports lookups.
+func lookupableState(ctx *core.Context, name string) (core.LookupableSharedState, error) {
+	s, err := ctx.SharedStates.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	l, ok := s.(core.LookupableSharedState)
+	if !ok {
+		return nil, fmt.Errorf("state '%v' cannot be used in JOIN STATE", name)
+	}
+	return l, nil
+}
+
+// joinStates adds the entries looked up by the JOIN STATE clauses to
+// the given row, using the aliases of the clauses as keys. It returns
+// false if the row has to be dropped because an inner-joined state
+// doesn't have an entry for the key. The entry of an outer-joined
+// state is NULL in that case.
+func (ep *commonExecutionPlan) joinStates(row data.Map) (bool, error) {
+	for _, j := range ep.stateJoins {
+		entry, err := j.lookup(row)
+		if err != nil {
+			return false, err
+		}
+		if entry != nil {
+			// the entry is owned by the state
+			row[j.alias] = entry.Copy()
+		} else if j.joinType == parser.LeftOuterJoin {
+			row[j.alias] = data.Null{}
+		} else {
+			return false, nil
+		}
+		// entries don't have timestamps
+		setNullMetadata(row, j.alias)
+	}
+	return true, nil
+}
+
+// prepareStateJoins creates the evaluators of the JOIN STATE clauses.
+// It fails if one of the states doesn't exist at this point.
+func prepareStateJoins(lookups []stateLookup, reg udf.FunctionRegistry) ([]stateJoinEvaluator, error) {
+	if len(lookups) == 0 {
+		return nil, nil
+	}
+	output := make([]stateJoinEvaluator, len(lookups))
+	for i, l := range lookups {
+		if _, err := lookupableState(reg.Context(), l.state); err != nil {
+			return nil, err
+		}
+		key, err := ExpressionToEvaluator(l.key, reg)
+		if err != nil {
+			return nil, err
+		}
+		output[i] = stateJoinEvaluator{l.joinType, l.state, l.alias, key, reg.Context()}
+	}
+	return output, nil
+}
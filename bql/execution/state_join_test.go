@@ -0,0 +1,178 @@
+package execution
+
+import (
+	"errors"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+// lookupTestState is a LookupableSharedState having entries keyed by
+// integers.
+type lookupTestState struct {
+	entries map[int64]data.Map
+}
+
+func (s *lookupTestState) Terminate(ctx *core.Context) error {
+	return nil
+}
+
+func (s *lookupTestState) Lookup(ctx *core.Context, key data.Value) (data.Map, error) {
+	k, err := data.AsInt(key)
+	if err != nil {
+		return nil, err
+	}
+	if m, ok := s.entries[k]; ok {
+		return m, nil
+	}
+	return nil, core.NotExistError(errors.New("no such entry"))
+}
+
+type nonLookupTestState struct{}
+
+func (s *nonLookupTestState) Terminate(ctx *core.Context) error {
+	return nil
+}
+
+func createStateJoinPlan(s string, reg udf.FunctionRegistry) (PhysicalPlan, error) {
+	p := parser.New()
+	_stmt, _, err := p.ParseStmt(s)
+	So(err, ShouldBeNil)
+	So(_stmt, ShouldHaveSameTypeAs, parser.CreateStreamAsSelectStmt{})
+	stmt := _stmt.(parser.CreateStreamAsSelectStmt).Select
+	logicalPlan, err := Analyze(stmt, reg)
+	So(err, ShouldBeNil)
+	return logicalPlan.MakePhysicalPlan(reg)
+}
+
+func TestStateJoin(t *testing.T) {
+	Convey("Given a registry with a lookupable state", t, func() {
+		ctx := core.NewContext(nil)
+		So(ctx.SharedStates.Add("people", "test", &lookupTestState{
+			entries: map[int64]data.Map{
+				1: {"name": data.String("one"), "group": data.Int(4)},
+				2: {"name": data.String("two"), "group": data.Int(9)},
+				4: {"name": data.String("four"), "group": data.Int(2)},
+			},
+		}), ShouldBeNil)
+		So(ctx.SharedStates.Add("not_lookupable", "test", &nonLookupTestState{}), ShouldBeNil)
+		reg := udf.CopyGlobalUDFRegistry(ctx)
+		tuples := getTuples(4)
+
+		process := func(plan PhysicalPlan) [][]data.Map {
+			var results [][]data.Map
+			for _, t := range tuples {
+				out, err := plan.Process(t)
+				So(err, ShouldBeNil)
+				results = append(results, out)
+			}
+			return results
+		}
+
+		Convey("When joining the state with a filter plan", func() {
+			plan, err := createStateJoinPlan(`CREATE STREAM box AS SELECT RSTREAM
+				int, people:name FROM src [RANGE 1 TUPLES] JOIN STATE people ON int`, reg)
+			So(err, ShouldBeNil)
+			So(plan, ShouldHaveSameTypeAs, &filterPlan{})
+
+			Convey("Then only tuples having an entry should be emitted with the entry", func() {
+				res := process(plan)
+				So(res[0], ShouldResemble, []data.Map{{"int": data.Int(1), "name": data.String("one")}})
+				So(res[1], ShouldResemble, []data.Map{{"int": data.Int(2), "name": data.String("two")}})
+				So(res[2], ShouldBeEmpty)
+				So(res[3], ShouldResemble, []data.Map{{"int": data.Int(4), "name": data.String("four")}})
+			})
+		})
+
+		Convey("When left-joining the state with a default plan", func() {
+			plan, err := createStateJoinPlan(`CREATE STREAM box AS SELECT ISTREAM
+				int, n:* FROM src [RANGE 2 TUPLES] LEFT JOIN STATE people AS n ON int`, reg)
+			So(err, ShouldBeNil)
+			So(plan, ShouldHaveSameTypeAs, &defaultSelectExecutionPlan{})
+
+			Convey("Then tuples without an entry should be emitted without its columns", func() {
+				res := process(plan)
+				So(res[0], ShouldResemble, []data.Map{{"int": data.Int(1), "name": data.String("one"),
+					"group": data.Int(4)}})
+				So(res[1], ShouldResemble, []data.Map{{"int": data.Int(2), "name": data.String("two"),
+					"group": data.Int(9)}})
+				So(res[2], ShouldResemble, []data.Map{{"int": data.Int(3)}})
+				So(res[3], ShouldResemble, []data.Map{{"int": data.Int(4), "name": data.String("four"),
+					"group": data.Int(2)}})
+			})
+		})
+
+		Convey("When joining the state twice with a groupby plan", func() {
+			plan, err := createStateJoinPlan(`CREATE STREAM box AS SELECT RSTREAM
+				count(*) AS c, g:name FROM src [RANGE 4 TUPLES]
+				JOIN STATE people AS n ON int JOIN STATE people AS g ON n:group
+				GROUP BY g:name`, reg)
+			So(err, ShouldBeNil)
+			So(plan, ShouldHaveSameTypeAs, &groupbyExecutionPlan{})
+
+			Convey("Then the key of the second state should refer to the first one", func() {
+				res := process(plan)
+				So(res[0], ShouldResemble, []data.Map{{"c": data.Int(1), "name": data.String("four")}})
+				So(res[2], ShouldResemble, res[0])
+				So(res[3], ShouldHaveLength, 2)
+				So(res[3], ShouldContain, data.Map{"c": data.Int(1), "name": data.String("four")})
+				So(res[3], ShouldContain, data.Map{"c": data.Int(1), "name": data.String("two")})
+			})
+		})
+
+		Convey("When joining a state having nested entries", func() {
+			entry := data.Map{"tags": data.Map{"a": data.Int(1)}}
+			So(ctx.SharedStates.Add("nested", "test", &lookupTestState{
+				entries: map[int64]data.Map{1: entry},
+			}), ShouldBeNil)
+			plan, err := createStateJoinPlan(`CREATE STREAM box AS SELECT RSTREAM
+				n:tags AS tags FROM src [RANGE 1 TUPLES] JOIN STATE nested AS n ON int`, reg)
+			So(err, ShouldBeNil)
+
+			Convey("Then modifying the output should not change the state", func() {
+				res, err := plan.Process(tuples[0])
+				So(err, ShouldBeNil)
+				So(res, ShouldHaveLength, 1)
+				tags, err := data.AsMap(res[0]["tags"])
+				So(err, ShouldBeNil)
+				tags["a"] = data.Int(2)
+				So(entry, ShouldResemble, data.Map{"tags": data.Map{"a": data.Int(1)}})
+			})
+		})
+
+		Convey("When joining a state which doesn't exist", func() {
+			_, err := createStateJoinPlan(`CREATE STREAM box AS SELECT RSTREAM
+				int FROM src [RANGE 1 TUPLES] JOIN STATE no_such_state ON int`, reg)
+
+			Convey("Then creating the plan should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When joining a state which cannot be looked up", func() {
+			_, err := createStateJoinPlan(`CREATE STREAM box AS SELECT RSTREAM
+				int FROM src [RANGE 1 TUPLES] JOIN STATE not_lookupable ON int`, reg)
+
+			Convey("Then creating the plan should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "cannot be used in JOIN STATE")
+			})
+		})
+
+		Convey("When the state is dropped after creating the plan", func() {
+			plan, err := createStateJoinPlan(`CREATE STREAM box AS SELECT RSTREAM
+				int FROM src [RANGE 1 TUPLES] JOIN STATE people ON int`, reg)
+			So(err, ShouldBeNil)
+			_, err = ctx.SharedStates.Remove("people")
+			So(err, ShouldBeNil)
+
+			Convey("Then processing a tuple should fail", func() {
+				_, err := plan.Process(tuples[0])
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sort"
 	"time"
 )
 
@@ -14,16 +15,158 @@ type inputBuffer struct {
 	tuples     *list.List
 	windowSize float64
 	windowType parser.IntervalUnit
+	// join is nil unless the relation is joined with an explicit
+	// JOIN clause
+	join *joinIndex
 }
 
 type tupleWithDerivedInputRows struct {
 	tuple *core.Tuple
 	rows  []*inputRowWithCachedResult
+	// joinKey holds the values of the join keys of this tuple if it
+	// has been added to a joinIndex, nil otherwise.
+	joinKey  data.Value
+	joinHash data.HashValue
+}
+
+// joinIndex holds the evaluators for the ON condition of a JOIN
+// clause and, if the ON condition contains equi-join predicates,
+// a hash index over the join keys of all tuples in the buffer of
+// the joined relation.
+type joinIndex struct {
+	joinType parser.JoinType
+	on       Evaluator
+	keys     []Evaluator
+	probes   []Evaluator
+	// index maps the hash of a tuple's join key to all tuples in the
+	// buffer with that hash (in buffer order). It is nil if there are
+	// no equi-join predicates in the ON condition.
+	index map[data.HashValue][]*tupleWithDerivedInputRows
+}
+
+func newJoinIndex(cond *joinCondition, reg udf.FunctionRegistry) (*joinIndex, error) {
+	on, err := ExpressionToEvaluator(cond.on, reg)
+	if err != nil {
+		return nil, err
+	}
+	j := &joinIndex{
+		joinType: cond.joinType,
+		on:       on,
+	}
+	for i := range cond.keys {
+		key, err := ExpressionToEvaluator(cond.keys[i], reg)
+		if err != nil {
+			return nil, err
+		}
+		probe, err := ExpressionToEvaluator(cond.probes[i], reg)
+		if err != nil {
+			return nil, err
+		}
+		j.keys = append(j.keys, key)
+		j.probes = append(j.probes, probe)
+	}
+	if len(j.keys) > 0 {
+		j.index = map[data.HashValue][]*tupleWithDerivedInputRows{}
+	}
+	return j, nil
+}
+
+// evalKey evaluates the given key evaluators on the given row. It
+// returns nil if one of the values is NULL, because then the equality
+// predicates cannot be true.
+func (j *joinIndex) evalKey(evals []Evaluator, row data.Map) (data.Value, error) {
+	key := make(data.Array, len(evals))
+	for i, e := range evals {
+		v, err := e.Eval(row)
+		if err != nil {
+			return nil, err
+		}
+		if v.Type() == data.TypeNull {
+			return nil, nil
+		}
+		key[i] = v
+	}
+	return key, nil
+}
+
+// add computes the join key of the given tuple and adds the tuple to
+// the index. The tuple's data must already be nested under the alias
+// of the joined relation. Tuples whose join key cannot be computed
+// are not added, as they cannot match any other tuple.
+func (j *joinIndex) add(alias string, t *tupleWithDerivedInputRows) {
+	if j.index == nil {
+		return
+	}
+	row := data.Map{alias: t.tuple.Data[alias]}
+	setMetadata(row, alias, t.tuple)
+	key, err := j.evalKey(j.keys, row)
+	if err != nil || key == nil {
+		return
+	}
+	t.joinKey = key
+	t.joinHash = data.Hash(key)
+	j.index[t.joinHash] = append(j.index[t.joinHash], t)
+}
+
+// remove removes the given tuple from the index.
+func (j *joinIndex) remove(t *tupleWithDerivedInputRows) {
+	if j.index == nil || t.joinKey == nil {
+		return
+	}
+	bucket := j.index[t.joinHash]
+	for i, other := range bucket {
+		if other == t {
+			bucket = append(bucket[:i], bucket[i+1:]...)
+			break
+		}
+	}
+	if len(bucket) == 0 {
+		delete(j.index, t.joinHash)
+	} else {
+		j.index[t.joinHash] = bucket
+	}
+}
+
+// lookup returns all tuples in the index whose join key equals the
+// value of the probe expressions evaluated on the given row.
+func (j *joinIndex) lookup(row data.Map) ([]*tupleWithDerivedInputRows, error) {
+	key, err := j.evalKey(j.probes, row)
+	if err != nil || key == nil {
+		return nil, err
+	}
+	var matches []*tupleWithDerivedInputRows
+	for _, t := range j.index[data.Hash(key)] {
+		if data.Equal(key, t.joinKey) {
+			matches = append(matches, t)
+		}
+	}
+	return matches, nil
 }
 
 func (i *inputBuffer) isTimeBased() bool {
-	return i.windowType == parser.Seconds ||
-		i.windowType == parser.Milliseconds
+	switch i.windowType {
+	case parser.Seconds, parser.Milliseconds, parser.Minutes, parser.Hours:
+		return true
+	}
+	return false
+}
+
+// windowDuration returns the size of a time-based window.
+func (i *inputBuffer) windowDuration() time.Duration {
+	return intervalDuration(i.windowSize, i.windowType)
+}
+
+// intervalDuration converts a time interval to a time.Duration.
+func intervalDuration(value float64, unit parser.IntervalUnit) time.Duration {
+	switch unit {
+	case parser.Milliseconds:
+		return time.Duration(value * float64(time.Millisecond))
+	case parser.Minutes:
+		return time.Duration(value * float64(time.Minute))
+	case parser.Hours:
+		return time.Duration(value * float64(time.Hour))
+	}
+	return time.Duration(value * float64(time.Second))
 }
 
 // inputRowWithCachedResult holds an input tuple plus space for
@@ -54,9 +197,11 @@ type resultRowCount struct {
 // partialList is a data structure representing a continuous sublist
 // of a linked list. An iteration must start at the `start` item
 // and must go on until `end` is reached (not including `end`!)
+// The `skip` item, if any, is not part of the sublist.
 type partialList struct {
 	start *list.Element
 	end   *list.Element
+	skip  *list.Element
 }
 
 // streamRelationStreamExecutionPlan provides methods for
@@ -100,10 +245,86 @@ type streamRelationStreamExecutionPlan struct {
 	// filteredInputRows holds data that serves as the input for
 	// the relation-to-relation operation
 	filteredInputRowsBuffer *list.List
-	// lastTupleBuffers stores the names of the input buffers that
-	// the last tuple was appended to. this is valid after
-	// `addTupleToBuffer` has returned.
-	lastTupleBuffers map[string]bool
+	// expiredInputRows holds the rows removed from filteredInputRows
+	// since the last evaluation, in the order in which they were in
+	// filteredInputRows. It is only maintained if keepExpiredInputRows
+	// is true.
+	expiredInputRows     []*inputRowWithCachedResult
+	keepExpiredInputRows bool
+	// lastTupleBuffers stores the elements of the last tuple keyed by
+	// the names of the input buffers that it was added to. this is
+	// valid after `addTupleToBuffer` has returned.
+	lastTupleBuffers map[string]*list.Element
+	// hasOuterJoin is true if the FROM clause contains a LEFT OUTER
+	// JOIN. Since a new tuple can invalidate previously computed
+	// NULL-padded rows and an expiring tuple can require new ones, the
+	// joined rows are then recomputed from the whole buffers on every
+	// run instead of incrementally. The hash indexes of equi-joins are
+	// still used for the recomputation, so it only visits the matching
+	// tuples of the joined relations rather than their whole buffers.
+	hasOuterJoin bool
+	// slideSize and slideType hold the SLIDE specification of the
+	// windows. slideType is parser.UnspecifiedIntervalUnit if the
+	// query is evaluated on every incoming tuple.
+	slideSize float64
+	slideType parser.IntervalUnit
+	// numTuplesInSlide counts the tuples received since the last
+	// evaluation of a tuple-based sliding window.
+	numTuplesInSlide int64
+	// nextBoundary is the end of the next time-based sliding window
+	// to be evaluated. It is zero until the first tuple arrives.
+	nextBoundary time.Time
+	// windowEnd is the end of the time-based sliding window that is
+	// currently evaluated. Only tuples with a timestamp in
+	// [windowEnd-RANGE, windowEnd) are used while it is non-zero.
+	windowEnd time.Time
+	// eventTime is true if the statement has a LATENESS clause. In
+	// that case, tuples are held back in pendingTuples until the
+	// watermark passes their timestamp and are then processed in
+	// timestamp order.
+	eventTime  bool
+	lateness   time.Duration
+	latePolicy parser.LatePolicy
+	// maxTimestamps holds the largest timestamp seen so far for each
+	// input name.
+	maxTimestamps map[string]time.Time
+	// numInputs is the number of distinct input names. There is no
+	// watermark until a tuple has arrived from each of them.
+	numInputs int
+	// watermark is the minimum of the largest timestamps of all
+	// inputs minus the allowed lateness. It never decreases. Tuples
+	// with a timestamp before the watermark are late.
+	watermark time.Time
+	// pendingTuples holds the tuples that have not been processed yet
+	// because the watermark has not passed their timestamp, ordered
+	// by timestamp.
+	pendingTuples []*core.Tuple
+	// isSession is true if the relation uses a session window. In that
+	// case, the buffer only holds the tuples of a session while it is
+	// evaluated and the tuples of all open sessions are kept in
+	// sessions, in the order in which the sessions were started.
+	isSession  bool
+	sessionGap time.Duration
+	sessionKey Evaluator
+	sessions   []*session
+	// distinct is true if duplicates must be removed from the results
+	// of each evaluation.
+	distinct bool
+	// ordering holds the evaluators of the ORDER BY clause that sort
+	// the results of each evaluation, and limit is the maximum number
+	// of results of each evaluation (-1 if there is no limit).
+	ordering []sortEvaluator
+	limit    int64
+}
+
+// session holds the tuples of an open session of a session window.
+type session struct {
+	key    data.Value
+	hash   data.HashValue
+	tuples []*core.Tuple
+	// lastTimestamp is the largest timestamp of the tuples in
+	// the session.
+	lastTimestamp time.Time
 }
 
 func newStreamRelationStreamExecutionPlan(lp *LogicalPlan, reg udf.FunctionRegistry) (*streamRelationStreamExecutionPlan, error) {
@@ -122,26 +343,53 @@ func newStreamRelationStreamExecutionPlan(lp *LogicalPlan, reg udf.FunctionRegis
 	if err != nil {
 		return nil, err
 	}
+	unnests, err := prepareUnnests(lp.Unnests, reg)
+	if err != nil {
+		return nil, err
+	}
+	stateJoins, err := prepareStateJoins(lp.StateLookups, reg)
+	if err != nil {
+		return nil, err
+	}
 	// for compatibility with the old syntax, take the last RANGE
 	// specification as valid for all buffers
 
 	// initialize buffers (one per declared input relation)
 	buffers := make(map[string]*inputBuffer, len(lp.Relations))
-	for _, rel := range lp.Relations {
+	hasOuterJoin := false
+	for i, rel := range lp.Relations {
 		tuples := list.New()
 		rangeValue := float64(rel.Value)
 		rangeUnit := rel.Unit
+		var join *joinIndex
+		if lp.JoinConditions != nil && lp.JoinConditions[i].on != nil {
+			join, err = newJoinIndex(&lp.JoinConditions[i], reg)
+			if err != nil {
+				return nil, err
+			}
+			if join.joinType == parser.LeftOuterJoin {
+				hasOuterJoin = true
+			}
+		}
 		// the alias of the relation is the key of the buffer
 		buffers[rel.Alias] = &inputBuffer{
-			tuples, rangeValue, rangeUnit,
+			tuples, rangeValue, rangeUnit, join,
 		}
 	}
 
-	return &streamRelationStreamExecutionPlan{
+	// all relations have the same slide (this was checked by Analyze)
+	var slide parser.IntervalAST
+	if len(lp.Relations) > 0 {
+		slide = slideInterval(&lp.Relations[0].StreamWindowAST)
+	}
+
+	ep := &streamRelationStreamExecutionPlan{
 		commonExecutionPlan: commonExecutionPlan{
 			projections: projs,
 			groupList:   groupList,
 			filter:      filter,
+			unnests:     unnests,
+			stateJoins:  stateJoins,
 		},
 		relations:            lp.Relations,
 		buffers:              buffers,
@@ -150,7 +398,110 @@ func newStreamRelationStreamExecutionPlan(lp *LogicalPlan, reg udf.FunctionRegis
 		prevResults:          []resultRow{},
 		prevHashesForIstream: map[data.HashValue][]resultRowCount{},
 		filteredInputRows:    list.New(),
-	}, nil
+		hasOuterJoin:         hasOuterJoin,
+		slideSize:            slide.Value,
+		slideType:            slide.Unit,
+		distinct:             lp.Distinct,
+		limit:                lp.Limit,
+	}
+
+	for _, o := range lp.OrderList {
+		eval, err := ExpressionToEvaluator(o.expr, reg)
+		if err != nil {
+			return nil, err
+		}
+		ep.ordering = append(ep.ordering, sortEvaluator{eval, o.ascending})
+	}
+
+	// a session window is only allowed with a single relation
+	// (this was checked by Analyze)
+	if len(lp.Relations) == 1 && lp.Relations[0].Session.Enabled {
+		ep.isSession = true
+		ep.sessionGap = intervalDuration(lp.Relations[0].Value, lp.Relations[0].Unit)
+		if lp.SessionKey != nil {
+			ep.sessionKey, err = ExpressionToEvaluator(lp.SessionKey, reg)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// all relations have the same lateness (this was checked by Analyze)
+	if len(lp.Relations) > 0 {
+		lateness := lp.Relations[0].Lateness
+		if lateness.Unit != parser.UnspecifiedIntervalUnit {
+			ep.eventTime = true
+			ep.lateness = intervalDuration(lateness.Value, lateness.Unit)
+			ep.latePolicy = latePolicy(lateness)
+			ep.maxTimestamps = map[string]time.Time{}
+			inputs := map[string]bool{}
+			for _, rel := range lp.Relations {
+				inputs[ep.relationKey(&rel)] = true
+			}
+			ep.numInputs = len(inputs)
+		}
+	}
+	return ep, nil
+}
+
+// bufferedTuples returns copies of the input tuples held in the buffers,
+// in the sessions, and in the pending tuples of the plan, ordered by
+// their timestamps. A tuple appended to the buffers of several aliases
+// (on a self-join) is only returned once.
+func (ep *streamRelationStreamExecutionPlan) bufferedTuples() []*core.Tuple {
+	// the buffer of each alias holds the latest tuples having the
+	// input name of the relation, so the largest buffer contains all
+	// tuples of the other buffers for the same input name
+	largest := map[string]*parser.AliasedStreamWindowAST{}
+	for i := range ep.relations {
+		rel := &ep.relations[i]
+		key := ep.relationKey(rel)
+		if l, ok := largest[key]; !ok ||
+			ep.buffers[rel.Alias].tuples.Len() > ep.buffers[l.Alias].tuples.Len() {
+			largest[key] = rel
+		}
+	}
+
+	var ts []*core.Tuple
+	for i := range ep.relations {
+		rel := &ep.relations[i]
+		if largest[ep.relationKey(rel)] != rel {
+			continue
+		}
+		for e := ep.buffers[rel.Alias].tuples.Front(); e != nil; e = e.Next() {
+			// undo the nesting done in addTupleToBuffer
+			orig := e.Value.(*tupleWithDerivedInputRows).tuple
+			t := orig.ShallowCopy()
+			if m, ok := orig.Data[rel.Alias].(data.Map); ok {
+				t.Data = m
+			}
+			ts = append(ts, t)
+		}
+	}
+	for _, sess := range ep.sessions {
+		for _, t := range sess.tuples {
+			ts = append(ts, t.ShallowCopy())
+		}
+	}
+	for _, t := range ep.pendingTuples {
+		ts = append(ts, t.ShallowCopy())
+	}
+	sort.Stable(tuplesByTimestamp(ts))
+	return ts
+}
+
+type tuplesByTimestamp []*core.Tuple
+
+func (ts tuplesByTimestamp) Len() int {
+	return len(ts)
+}
+
+func (ts tuplesByTimestamp) Less(i, j int) bool {
+	return ts[i].Timestamp.Before(ts[j].Timestamp)
+}
+
+func (ts tuplesByTimestamp) Swap(i, j int) {
+	ts[i], ts[j] = ts[j], ts[i]
 }
 
 // relationKey computes the InputName that belongs to a relation.
@@ -166,6 +517,9 @@ func (ep *streamRelationStreamExecutionPlan) relationKey(rel *parser.AliasedStre
 
 // addTupleToBuffer appends the received tuple to all internal buffers that
 // are associated to the tuple's input name (more than one on self-join).
+// A tuple with an earlier timestamp than the last tuple of a buffer,
+// which can only be a late tuple of an event-time window, is inserted
+// so that the buffer stays ordered by timestamp instead.
 // Note that after calling this function, these buffers may hold more
 // items than allowed by the window specification, so a call to
 // removeOutdatedTuplesFromBuffer is necessary afterwards.
@@ -193,7 +547,7 @@ func (ep *streamRelationStreamExecutionPlan) addTupleToBuffer(t *core.Tuple) err
 
 	// core.TFSharedData is set by t.ShallowCopy() below.
 
-	ep.lastTupleBuffers = make(map[string]bool, numAppends)
+	ep.lastTupleBuffers = make(map[string]*list.Element, numAppends)
 	for _, rel := range ep.relations {
 		if t.InputName == ep.relationKey(&rel) {
 			// because the tuple is always cached, ShallowCopy is required here.
@@ -205,8 +559,24 @@ func (ep *streamRelationStreamExecutionPlan) addTupleToBuffer(t *core.Tuple) err
 				tuple: editTuple,
 			}
 			buffer := ep.buffers[rel.Alias]
-			buffer.tuples.PushBack(&editTupleCont)
-			ep.lastTupleBuffers[rel.Alias] = true
+			var elem *list.Element
+			if ep.eventTime {
+				prev := buffer.tuples.Back()
+				for prev != nil && prev.Value.(*tupleWithDerivedInputRows).tuple.Timestamp.After(t.Timestamp) {
+					prev = prev.Prev()
+				}
+				if prev == nil {
+					elem = buffer.tuples.PushFront(&editTupleCont)
+				} else {
+					elem = buffer.tuples.InsertAfter(&editTupleCont, prev)
+				}
+			} else {
+				elem = buffer.tuples.PushBack(&editTupleCont)
+			}
+			if buffer.join != nil {
+				buffer.join.add(rel.Alias, &editTupleCont)
+			}
+			ep.lastTupleBuffers[rel.Alias] = elem
 		}
 	}
 
@@ -218,7 +588,7 @@ func (ep *streamRelationStreamExecutionPlan) addTupleToBuffer(t *core.Tuple) err
 // specification.
 func (ep *streamRelationStreamExecutionPlan) removeOutdatedTuplesFromBuffer(curTupTime time.Time) error {
 	expiredInputRows := map[*inputRowWithCachedResult]bool{}
-	for _, buffer := range ep.buffers {
+	for alias, buffer := range ep.buffers {
 		curBufSize := int64(buffer.tuples.Len())
 		if buffer.windowType == parser.Tuples { // tuple-based window
 			windowSizeInt := int64(buffer.windowSize)
@@ -237,15 +607,15 @@ func (ep *streamRelationStreamExecutionPlan) removeOutdatedTuplesFromBuffer(curT
 					for _, inputRow := range tupCont.rows {
 						expiredInputRows[inputRow] = true
 					}
+					if buffer.join != nil {
+						buffer.join.remove(tupCont)
+					}
 					buffer.tuples.Remove(e)
 				}
 			}
 
 		} else if buffer.isTimeBased() {
-			windowSizeSeconds := float64(buffer.windowSize)
-			if buffer.windowType == parser.Milliseconds {
-				windowSizeSeconds = windowSizeSeconds / 1000
-			}
+			windowSizeSeconds := buffer.windowDuration().Seconds()
 			// we have to remove all items from the list that are
 			// older than the specified window length
 			var next *list.Element
@@ -259,6 +629,14 @@ func (ep *streamRelationStreamExecutionPlan) removeOutdatedTuplesFromBuffer(curT
 					for _, inputRow := range tupCont.rows {
 						expiredInputRows[inputRow] = true
 					}
+					if buffer.join != nil {
+						buffer.join.remove(tupCont)
+					}
+					// a late tuple can be outdated right away, so
+					// there are no rows to be derived from it then
+					if ep.lastTupleBuffers[alias] == e {
+						delete(ep.lastTupleBuffers, alias)
+					}
 					buffer.tuples.Remove(e)
 				}
 			}
@@ -267,16 +645,43 @@ func (ep *streamRelationStreamExecutionPlan) removeOutdatedTuplesFromBuffer(curT
 		}
 	}
 	// now delete all rows marked for deletion
+	ep.removeInputRows(expiredInputRows)
+	return nil
+}
+
+// removeInputRows removes the given rows from filteredInputRows.
+func (ep *streamRelationStreamExecutionPlan) removeInputRows(rows map[*inputRowWithCachedResult]bool) {
 	var next *list.Element
 	for e := ep.filteredInputRows.Front(); e != nil; e = next {
 		next = e.Next()
 		itemPtr := e.Value.(*inputRowWithCachedResult)
-		if toDelete := expiredInputRows[itemPtr]; toDelete {
+		if toDelete := rows[itemPtr]; toDelete {
 			ep.filteredInputRows.Remove(e)
+			if ep.keepExpiredInputRows {
+				ep.expiredInputRows = append(ep.expiredInputRows, itemPtr)
+			}
 		}
 	}
+}
 
-	return nil
+// removeLastTuple removes the tuple added by the last call of
+// addTupleToBuffer from the buffers again, together with the rows
+// derived from it.
+func (ep *streamRelationStreamExecutionPlan) removeLastTuple() {
+	rows := map[*inputRowWithCachedResult]bool{}
+	for alias, e := range ep.lastTupleBuffers {
+		buffer := ep.buffers[alias]
+		tupCont := e.Value.(*tupleWithDerivedInputRows)
+		for _, inputRow := range tupCont.rows {
+			rows[inputRow] = true
+		}
+		if buffer.join != nil {
+			buffer.join.remove(tupCont)
+		}
+		buffer.tuples.Remove(e)
+	}
+	ep.lastTupleBuffers = nil
+	ep.removeInputRows(rows)
 }
 
 // previousMultiplicity returns how often the given map was emitted
@@ -409,15 +814,252 @@ func (ep *streamRelationStreamExecutionPlan) computeResultTuples() ([]data.Map,
 // order of items in the returned slice is undefined and cannot be relied on.
 func (ep *streamRelationStreamExecutionPlan) process(input *core.Tuple, performQueryOnBuffer func() error) ([]data.Map, error) {
 	ep.now = time.Now().In(time.UTC)
+	if ep.eventTime {
+		return ep.processEventTime(input, performQueryOnBuffer)
+	}
+	return ep.processTuple(input, performQueryOnBuffer)
+}
+
+// processTuple adds the given tuple to the buffers and performs the
+// query if required.
+func (ep *streamRelationStreamExecutionPlan) processTuple(input *core.Tuple, performQueryOnBuffer func() error) ([]data.Map, error) {
+	if ep.isSession {
+		return ep.processSession(input, performQueryOnBuffer)
+	}
 
 	// stream-to-relation:
 	// updates the internal buffer with correct window data
 	if err := ep.addTupleToBuffer(input); err != nil {
 		return nil, err
 	}
-	if err := ep.removeOutdatedTuplesFromBuffer(input.Timestamp); err != nil {
+	return ep.processBufferedTuple(input, performQueryOnBuffer)
+}
+
+// processBufferedTuple removes outdated tuples from the buffers after
+// the given tuple has been added to them and performs the query if
+// required.
+func (ep *streamRelationStreamExecutionPlan) processBufferedTuple(input *core.Tuple, performQueryOnBuffer func() error) ([]data.Map, error) {
+	if ep.slideType != parser.UnspecifiedIntervalUnit {
+		return ep.processSlidingWindow(input, performQueryOnBuffer)
+	}
+	curTupTime := input.Timestamp
+	if ep.eventTime {
+		// a late tuple must not move the window back in time, so
+		// the window ends at the latest timestamp in the buffers
+		for _, buffer := range ep.buffers {
+			if e := buffer.tuples.Back(); e != nil {
+				if ts := e.Value.(*tupleWithDerivedInputRows).tuple.Timestamp; ts.After(curTupTime) {
+					curTupTime = ts
+				}
+			}
+		}
+	}
+	if err := ep.removeOutdatedTuplesFromBuffer(curTupTime); err != nil {
 		return nil, err
 	}
+	return ep.evaluateWindow(performQueryOnBuffer)
+}
+
+// processSession is the counterpart of processTuple for statements
+// with a session window. It closes all sessions that have not received
+// a tuple for longer than the gap before the timestamp of the given
+// tuple, performs the query on each of them in the order in which they
+// were started, and then adds the tuple to the session for its key.
+// The results of a closed session are emitted completely, independent
+// of the results of previous sessions.
+func (ep *streamRelationStreamExecutionPlan) processSession(input *core.Tuple, performQueryOnBuffer func() error) ([]data.Map, error) {
+	rel := &ep.relations[0]
+	if input.InputName != ep.relationKey(rel) {
+		return nil, fmt.Errorf("tuple has input name '%s' set, but we "+
+			"can only deal with %v", input.InputName, []string{rel.Name})
+	}
+
+	var key data.Value = data.Null{}
+	if ep.sessionKey != nil {
+		row := data.Map{rel.Alias: input.Data}
+		setMetadata(row, rel.Alias, input)
+		k, err := ep.sessionKey.Eval(row)
+		if err != nil {
+			return nil, err
+		}
+		key = k
+	}
+	hash := data.Hash(key)
+
+	var output []data.Map
+	var cur *session
+	open := ep.sessions[:0]
+	for i, sess := range ep.sessions {
+		if input.Timestamp.Sub(sess.lastTimestamp) <= ep.sessionGap {
+			open = append(open, sess)
+			if sess.hash == hash && data.Equal(sess.key, key) {
+				cur = sess
+			}
+			continue
+		}
+		results, err := ep.evaluateSession(sess, performQueryOnBuffer)
+		if err != nil {
+			// keep the sessions that have not been evaluated yet
+			ep.sessions = append(open, ep.sessions[i+1:]...)
+			return nil, err
+		}
+		output = append(output, results...)
+	}
+	for i := len(open); i < len(ep.sessions); i++ {
+		ep.sessions[i] = nil
+	}
+	ep.sessions = open
+
+	if cur == nil {
+		cur = &session{
+			key:  key,
+			hash: hash,
+		}
+		ep.sessions = append(ep.sessions, cur)
+	}
+	cur.tuples = append(cur.tuples, input.ShallowCopy())
+	if input.Timestamp.After(cur.lastTimestamp) {
+		cur.lastTimestamp = input.Timestamp
+	}
+	return output, nil
+}
+
+// evaluateSession performs the query on the tuples of the given
+// session and returns all results.
+func (ep *streamRelationStreamExecutionPlan) evaluateSession(sess *session, performQueryOnBuffer func() error) ([]data.Map, error) {
+	buffer := ep.buffers[ep.relations[0].Alias]
+	defer buffer.tuples.Init()
+	for _, t := range sess.tuples {
+		if err := ep.addTupleToBuffer(t); err != nil {
+			return nil, err
+		}
+	}
+	if err := ep.filterInputTuples(); err != nil {
+		return nil, err
+	}
+	if err := performQueryOnBuffer(); err != nil {
+		return nil, err
+	}
+	ep.removeDuplicateResults()
+	if err := ep.orderAndLimitResults(); err != nil {
+		return nil, err
+	}
+	output := make([]data.Map, len(ep.curResults))
+	for i, res := range ep.curResults {
+		output[i] = res.row
+	}
+	return output, nil
+}
+
+// recomputesJoin returns true if the joined rows are recomputed from
+// the whole buffers on every evaluation instead of incrementally.
+func (ep *streamRelationStreamExecutionPlan) recomputesJoin() bool {
+	// whether a NULL-padded row is part of the result depends on all
+	// tuples in the other buffers (see hasOuterJoin), sliding windows
+	// are only evaluated once in a while anyway, and the buffer of a
+	// session window only holds the tuples of one session at a time
+	return ep.hasOuterJoin || ep.slideType != parser.UnspecifiedIntervalUnit ||
+		ep.isSession
+}
+
+// processEventTime is the counterpart of processTuple for statements
+// with a LATENESS clause. It updates the watermark with the timestamp
+// of the given tuple and then processes all held back tuples that the
+// watermark has passed in timestamp order, so that the result does not
+// depend on the order in which tuples arrive as long as they are not
+// later than the allowed lateness. Tuples that arrive after the
+// watermark has passed their timestamp are handled as per the policy
+// for late tuples.
+func (ep *streamRelationStreamExecutionPlan) processEventTime(input *core.Tuple, performQueryOnBuffer func() error) ([]data.Map, error) {
+	known := false
+	for _, rel := range ep.relations {
+		if input.InputName == ep.relationKey(&rel) {
+			known = true
+			break
+		}
+	}
+	if !known {
+		// let addTupleToBuffer report the error
+		return ep.processTuple(input, performQueryOnBuffer)
+	}
+
+	if input.Timestamp.Before(ep.watermark) {
+		switch ep.latePolicy {
+		case parser.UpdateLateTuples:
+			return ep.processTuple(input, performQueryOnBuffer)
+		case parser.EmitLateTuples:
+			return nil, ErrLateTuple
+		}
+		return nil, nil
+	}
+
+	// insert the tuple after all pending tuples with the same or an
+	// earlier timestamp
+	i := sort.Search(len(ep.pendingTuples), func(i int) bool {
+		return ep.pendingTuples[i].Timestamp.After(input.Timestamp)
+	})
+	ep.pendingTuples = append(ep.pendingTuples, nil)
+	copy(ep.pendingTuples[i+1:], ep.pendingTuples[i:])
+	ep.pendingTuples[i] = input.ShallowCopy()
+
+	// update the watermark
+	if maxTs, ok := ep.maxTimestamps[input.InputName]; !ok || input.Timestamp.After(maxTs) {
+		ep.maxTimestamps[input.InputName] = input.Timestamp
+	}
+	if len(ep.maxTimestamps) < ep.numInputs {
+		return nil, nil
+	}
+	var minTs time.Time
+	for _, ts := range ep.maxTimestamps {
+		if minTs.IsZero() || ts.Before(minTs) {
+			minTs = ts
+		}
+	}
+	if wm := minTs.Add(-ep.lateness); wm.After(ep.watermark) {
+		ep.watermark = wm
+	}
+
+	// process all tuples that the watermark has passed. a tuple is only
+	// removed from the pending tuples when it has been processed
+	// successfully, so that it is processed again with the next tuple
+	// if an error occurs. the results of the tuples processed before
+	// are returned together with the error.
+	var output []data.Map
+	for len(ep.pendingTuples) > 0 && !ep.pendingTuples[0].Timestamp.After(ep.watermark) {
+		results, err := ep.processPendingTuple(ep.pendingTuples[0], performQueryOnBuffer)
+		if err != nil {
+			return output, err
+		}
+		output = append(output, results...)
+		ep.pendingTuples[0] = nil
+		ep.pendingTuples = ep.pendingTuples[1:]
+	}
+	return output, nil
+}
+
+// processPendingTuple processes the first of the pending tuples. If
+// that fails, the tuple is removed from the buffers again so that it
+// can be processed again later.
+func (ep *streamRelationStreamExecutionPlan) processPendingTuple(t *core.Tuple, performQueryOnBuffer func() error) ([]data.Map, error) {
+	if ep.isSession {
+		// processSession doesn't add the tuple to a session before
+		// the closed sessions have been evaluated successfully
+		return ep.processSession(t, performQueryOnBuffer)
+	}
+	if err := ep.addTupleToBuffer(t); err != nil {
+		return nil, err
+	}
+	results, err := ep.processBufferedTuple(t, performQueryOnBuffer)
+	if err != nil {
+		ep.removeLastTuple()
+		return nil, err
+	}
+	return results, nil
+}
+
+// evaluateWindow performs the query on the current contents of the
+// buffers and returns the data to be emitted.
+func (ep *streamRelationStreamExecutionPlan) evaluateWindow(performQueryOnBuffer func() error) ([]data.Map, error) {
 
 	// relation-to-relation:
 	// performs a SELECT query on buffer and writes result
@@ -428,12 +1070,179 @@ func (ep *streamRelationStreamExecutionPlan) process(input *core.Tuple, performQ
 	if err := performQueryOnBuffer(); err != nil {
 		return nil, err
 	}
+	ep.removeDuplicateResults()
+	if err := ep.orderAndLimitResults(); err != nil {
+		return nil, err
+	}
 
 	// relation-to-stream:
 	// compute new/old/all result data and return it
 	return ep.computeResultTuples()
 }
 
+// removeDuplicateResults removes all but the first occurrence of
+// every result of the current evaluation if the statement has a
+// DISTINCT clause.
+func (ep *streamRelationStreamExecutionPlan) removeDuplicateResults() {
+	if !ep.distinct {
+		return
+	}
+	counts := make(map[data.HashValue][]resultRowCount, len(ep.curResults))
+	unique := ep.curResults[:0]
+	for _, res := range ep.curResults {
+		if ep.incrAndGetMultiplicity(&res, counts) == 1 {
+			unique = append(unique, res)
+		}
+	}
+	ep.curResults = unique
+}
+
+// orderAndLimitResults sorts the results of the current evaluation
+// as per the ORDER BY clause and removes the results exceeding the
+// LIMIT clause. The sort is stable, i.e., results that are equal
+// with respect to the ORDER BY clause keep their order.
+func (ep *streamRelationStreamExecutionPlan) orderAndLimitResults() error {
+	if len(ep.ordering) > 0 && len(ep.curResults) > 1 {
+		sortData := make([]sortArray, len(ep.ordering))
+		for i, o := range ep.ordering {
+			values := make(data.Array, len(ep.curResults))
+			for j, res := range ep.curResults {
+				v, err := o.eval.Eval(res.row)
+				if err != nil {
+					return err
+				}
+				values[j] = v
+			}
+			sortData[i] = sortArray{values, o.ascending}
+		}
+		indexes := make([]int, len(ep.curResults))
+		for i := range indexes {
+			indexes[i] = i
+		}
+		sort.Stable(&indexSlice{indexes, sortData})
+		sorted := make([]resultRow, len(ep.curResults))
+		for i, idx := range indexes {
+			sorted[i] = ep.curResults[idx]
+		}
+		copy(ep.curResults, sorted)
+	}
+	if ep.limit >= 0 && int64(len(ep.curResults)) > ep.limit {
+		ep.curResults = ep.curResults[:ep.limit]
+	}
+	return nil
+}
+
+// processSlidingWindow is the counterpart of the last steps of process
+// for statements with a SLIDE or TUMBLING clause. Instead of performing
+// the query whenever a tuple arrives, the query is performed only when
+// a window boundary is crossed, i.e., every slideSize tuples for
+// tuple-based windows or for every multiple of the slide interval
+// (since the Unix epoch) that lies between the timestamp of the
+// previous tuple and the one of the current tuple for time-based
+// windows. Each evaluation is compared to the previous one as per
+// the emitter type.
+func (ep *streamRelationStreamExecutionPlan) processSlidingWindow(input *core.Tuple, performQueryOnBuffer func() error) ([]data.Map, error) {
+	if ep.slideType == parser.Tuples {
+		if err := ep.removeOutdatedTuplesFromBuffer(input.Timestamp); err != nil {
+			return nil, err
+		}
+		ep.numTuplesInSlide++
+		if ep.numTuplesInSlide < int64(ep.slideSize) {
+			return nil, nil
+		}
+		ep.numTuplesInSlide = 0
+		return ep.evaluateWindow(performQueryOnBuffer)
+	}
+
+	slide := intervalDuration(ep.slideSize, ep.slideType)
+	if ep.nextBoundary.IsZero() {
+		ep.nextBoundary = nextWindowBoundary(input.Timestamp, slide)
+	}
+	defer func() {
+		ep.windowEnd = time.Time{}
+	}()
+
+	var output []data.Map
+	for !input.Timestamp.Before(ep.nextBoundary) {
+		// the tuple lies behind the end of the next window, so
+		// this window is complete now
+		ep.windowEnd = ep.nextBoundary
+		empty := ep.isWindowEmpty()
+		results, err := ep.evaluateWindow(performQueryOnBuffer)
+		if err != nil {
+			// the windows evaluated before are not evaluated again
+			return output, err
+		}
+		output = append(output, results...)
+
+		// remove all tuples that are not contained in any of the
+		// following windows
+		ep.nextBoundary = ep.nextBoundary.Add(slide)
+		if err := ep.removeOutdatedTuplesFromBuffer(ep.nextBoundary); err != nil {
+			return output, err
+		}
+
+		// evaluating a window without any tuples twice in a row gives
+		// the same result, so skip the following empty windows
+		ep.windowEnd = ep.nextBoundary
+		if empty && ep.isWindowEmpty() {
+			ep.nextBoundary = nextWindowBoundary(ep.earliestTimestamp(), slide)
+		}
+	}
+	return output, nil
+}
+
+// nextWindowBoundary returns the first multiple of slide (since the
+// Unix epoch) that is strictly after t.
+func nextWindowBoundary(t time.Time, slide time.Duration) time.Time {
+	offset := time.Duration(t.UnixNano() % int64(slide))
+	if offset < 0 {
+		offset += slide
+	}
+	return t.Add(slide - offset)
+}
+
+// inWindow checks whether the given tuple lies in the time-based
+// sliding window that is currently evaluated. It always returns true
+// if no such window is evaluated.
+func (ep *streamRelationStreamExecutionPlan) inWindow(buffer *inputBuffer, t *tupleWithDerivedInputRows) bool {
+	if ep.windowEnd.IsZero() {
+		return true
+	}
+	ts := t.tuple.Timestamp
+	return ts.Before(ep.windowEnd) &&
+		!ts.Before(ep.windowEnd.Add(-buffer.windowDuration()))
+}
+
+// isWindowEmpty checks whether none of the buffers holds a tuple that
+// lies in the time-based sliding window ending at ep.windowEnd.
+func (ep *streamRelationStreamExecutionPlan) isWindowEmpty() bool {
+	for _, buffer := range ep.buffers {
+		for e := buffer.tuples.Front(); e != nil; e = e.Next() {
+			if ep.inWindow(buffer, e.Value.(*tupleWithDerivedInputRows)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// earliestTimestamp returns the earliest timestamp of all tuples
+// in the buffers. It must only be called if there is at least one
+// tuple in the buffers.
+func (ep *streamRelationStreamExecutionPlan) earliestTimestamp() time.Time {
+	var earliest time.Time
+	for _, buffer := range ep.buffers {
+		for e := buffer.tuples.Front(); e != nil; e = e.Next() {
+			ts := e.Value.(*tupleWithDerivedInputRows).tuple.Timestamp
+			if earliest.IsZero() || ts.Before(earliest) {
+				earliest = ts
+			}
+		}
+	}
+	return earliest
+}
+
 func (ep *streamRelationStreamExecutionPlan) filterInputTuples() error {
 	// we need to make a cross product of the data in all buffers,
 	// combine it to get an input like
@@ -446,12 +1255,22 @@ func (ep *streamRelationStreamExecutionPlan) filterInputTuples() error {
 	// we avoid having to rollback our actual buffer if something fails
 	ep.filteredInputRowsBuffer = list.New()
 
-	// Note: `ep.buffers` is a map, so iterating over its keys may yield
-	// different results in every run of the program. We cannot expect
-	// a consistent order in which evalItem is run on the items of the
-	// cartesian product.
+	// Note: the buffers are visited in the order of the FROM clause
+	// so that the ON condition of a JOIN can be evaluated as soon as
+	// the relations it refers to have been visited.
 	allStreams := make(map[string]partialList, len(ep.buffers))
 
+	if ep.recomputesJoin() {
+		for key, buffer := range ep.buffers {
+			allStreams[key] = partialList{buffer.tuples.Front(), nil, nil}
+		}
+		if err := ep.preprocessCartesianProduct(dataHolder, allStreams); err != nil {
+			return err
+		}
+		ep.filteredInputRows = ep.filteredInputRowsBuffer
+		return nil
+	}
+
 	// we do not reevaluate the filter for all elements in the cartesian
 	// product of the input buffers, but only for those elements that use
 	// the newly added tuple.
@@ -468,7 +1287,7 @@ func (ep *streamRelationStreamExecutionPlan) filterInputTuples() error {
 	buffersWithNewTuple := make([]string, 0, len(ep.lastTupleBuffers))
 	buffersWithoutNewTuple := make([]string, 0, len(ep.buffers)-len(ep.lastTupleBuffers))
 	for key := range ep.buffers {
-		if justAppended := ep.lastTupleBuffers[key]; justAppended {
+		if _, justAdded := ep.lastTupleBuffers[key]; justAdded {
 			buffersWithNewTuple = append(buffersWithNewTuple, key)
 		} else {
 			buffersWithoutNewTuple = append(buffersWithoutNewTuple, key)
@@ -476,41 +1295,31 @@ func (ep *streamRelationStreamExecutionPlan) filterInputTuples() error {
 	}
 	// we need as many runs as there are buffers that hold the new tuple
 	for i := 0; i < len(buffersWithNewTuple); i++ {
-		// buffers <i are taken without the new tuple
+		// buffers <i are taken without the new tuple (which is the
+		// last one unless it was a late tuple of an event-time window)
 		for j := 0; j < i; j++ {
 			key := buffersWithNewTuple[j]
 			buffer := ep.buffers[key]
-			var start, end *list.Element
-			if buffer.tuples.Len() > 1 {
-				start = buffer.tuples.Front()
-				end = buffer.tuples.Back()
-			}
-			allStreams[key] = partialList{start, end}
+			allStreams[key] = partialList{buffer.tuples.Front(), nil, ep.lastTupleBuffers[key]}
 		}
 		// buffer i uses just the new tuple
 		{
 			j := i
 			key := buffersWithNewTuple[j]
-			buffer := ep.buffers[key]
-			start := buffer.tuples.Back()
-			var end *list.Element
-			allStreams[key] = partialList{start, end}
+			start := ep.lastTupleBuffers[key]
+			allStreams[key] = partialList{start, start.Next(), nil}
 		}
 		// buffers >i are taken including the new tuple
 		for j := i + 1; j < len(buffersWithNewTuple); j++ {
 			key := buffersWithNewTuple[j]
 			buffer := ep.buffers[key]
-			start := buffer.tuples.Front()
-			var end *list.Element
-			allStreams[key] = partialList{start, end}
+			allStreams[key] = partialList{buffer.tuples.Front(), nil, nil}
 		}
 		// and all buffers that do not hold the new tuple are
 		// always taken completely
 		for _, key := range buffersWithoutNewTuple {
 			buffer := ep.buffers[key]
-			start := buffer.tuples.Front()
-			var end *list.Element
-			allStreams[key] = partialList{start, end}
+			allStreams[key] = partialList{buffer.tuples.Front(), nil, nil}
 		}
 		// write matching items to ep.filteredInputRowsBuffer
 		if err := ep.preprocessCartesianProduct(dataHolder, allStreams); err != nil {
@@ -533,14 +1342,22 @@ func (ep *streamRelationStreamExecutionPlan) preprocessCartesianProduct(dataHold
 }
 
 func (ep *streamRelationStreamExecutionPlan) preprocCartProdInt(dataHolder data.Map, remainingBuffers map[string]partialList, origin map[string]*tupleWithDerivedInputRows) error {
+	// add the information accessed by the now() function
+	// to each item
+	dataHolder[":meta:NOW"] = data.Timestamp(ep.now)
+
 	if len(remainingBuffers) > 0 {
-		// not all buffers have been visited yet
+		// not all buffers have been visited yet, take the first
+		// one in the order of the FROM clause
 		var myKey string
-		for key := range remainingBuffers {
-			myKey = key
-			break
+		for _, rel := range ep.relations {
+			if _, ok := remainingBuffers[rel.Alias]; ok {
+				myKey = rel.Alias
+				break
+			}
 		}
 		myBuffer := remainingBuffers[myKey]
+		join := ep.buffers[myKey].join
 		// compile a dictionary with the rest of the unvisited streams
 		// (do NOT modify remainingBuffers directly!)
 		rest := map[string]partialList{}
@@ -549,12 +1366,67 @@ func (ep *streamRelationStreamExecutionPlan) preprocCartProdInt(dataHolder data.
 				rest[key] = buffer
 			}
 		}
-		for e := myBuffer.start; e != myBuffer.end; e = e.Next() {
-			t := e.Value.(*tupleWithDerivedInputRows)
+		matched := false
+		visit := func(t *tupleWithDerivedInputRows) error {
+			if !ep.inWindow(ep.buffers[myKey], t) {
+				return nil
+			}
 			// add the data of this tuple to dataHolder and recurse
 			dataHolder[myKey] = t.tuple.Data[myKey]
 			origin[myKey] = t
 			setMetadata(dataHolder, myKey, t.tuple)
+			if join != nil {
+				ok, err := evalCondition(join.on, dataHolder)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					return nil
+				}
+			}
+			matched = true
+			return ep.preprocCartProdInt(dataHolder, rest, origin)
+		}
+
+		if join != nil && join.index != nil && myBuffer.start != nil &&
+			myBuffer.start == ep.buffers[myKey].tuples.Front() &&
+			myBuffer.end == nil {
+			// the partial list spans the whole buffer, so we can use the
+			// index to find the matching tuples. we only need to check
+			// for the skipped item.
+			var skipped *tupleWithDerivedInputRows
+			if myBuffer.skip != nil {
+				skipped = myBuffer.skip.Value.(*tupleWithDerivedInputRows)
+			}
+			matches, err := join.lookup(dataHolder)
+			if err != nil {
+				return err
+			}
+			for _, t := range matches {
+				if t == skipped {
+					continue
+				}
+				if err := visit(t); err != nil {
+					return err
+				}
+			}
+		} else {
+			for e := myBuffer.start; e != myBuffer.end; e = e.Next() {
+				if e == myBuffer.skip {
+					continue
+				}
+				if err := visit(e.Value.(*tupleWithDerivedInputRows)); err != nil {
+					return err
+				}
+			}
+		}
+		delete(origin, myKey)
+
+		if !matched && join != nil && join.joinType == parser.LeftOuterJoin {
+			// there is no matching tuple, so we continue with NULL
+			// values for the outer-joined relation
+			dataHolder[myKey] = data.Null{}
+			setNullMetadata(dataHolder, myKey)
 			if err := ep.preprocCartProdInt(dataHolder, rest, origin); err != nil {
 				return err
 			}
@@ -564,48 +1436,58 @@ func (ep *streamRelationStreamExecutionPlan) preprocCartProdInt(dataHolder data.
 		// all tuples have been visited and we should now have the data
 		// of one cartesian product item in dataHolder
 
-		// add the information accessed by the now() function
-		// to each item
-		dataHolder[":meta:NOW"] = data.Timestamp(ep.now)
-
-		// evaluate filter condition
-		if ep.filter != nil {
-			filterResult, err := ep.filter.Eval(dataHolder)
-			if err != nil {
-				return err
-			}
-			// a NULL value is definitely not "true", so since we
-			// have only a binary decision, we should drop tuples
-			// where the filter condition evaluates to NULL
-			filterResultBool := false
-			if filterResult.Type() != data.TypeNull {
-				filterResultBool, err = data.AsBool(filterResult)
+		// expand the item with the UNNEST clauses and add the entries
+		// of the joined states. they are computed only once when the
+		// item is computed, not on every evaluation.
+		return ep.expandRow(dataHolder, func(row data.Map) error {
+			// evaluate filter condition
+			if ep.filter != nil {
+				ok, err := evalCondition(ep.filter, row)
 				if err != nil {
 					return err
 				}
+				// if it evaluated to false, do not further process this tuple
+				if !ok {
+					return nil
+				}
 			}
-			// if it evaluated to false, do not further process this tuple
-			if !filterResultBool {
-				return nil
-			}
-		}
 
-		// if we arrive here, this item of the cartesian product fulfills
-		// the filter/join condition, so we make a shallow copy (that should
-		// be fine) and add it to the list of input items
-		item := make(data.Map, len(dataHolder))
-		for key, val := range dataHolder {
-			item[key] = val
-		}
-		itemWithCachedResult := &inputRowWithCachedResult{
-			input: &item,
-		}
-		// also write the address of this item to all tuples
-		// it originates from
-		for _, tupHolder := range origin {
-			tupHolder.rows = append(tupHolder.rows, itemWithCachedResult)
-		}
-		ep.filteredInputRowsBuffer.PushBack(itemWithCachedResult)
+			// if we arrive here, this item of the cartesian product fulfills
+			// the filter/join condition, so we make a shallow copy (that should
+			// be fine) and add it to the list of input items
+			item := make(data.Map, len(row))
+			for key, val := range row {
+				item[key] = val
+			}
+			itemWithCachedResult := &inputRowWithCachedResult{
+				input: &item,
+			}
+			// also write the address of this item to all tuples
+			// it originates from (this is not required if the rows
+			// are recomputed in every run anyway)
+			if !ep.recomputesJoin() {
+				for _, tupHolder := range origin {
+					tupHolder.rows = append(tupHolder.rows, itemWithCachedResult)
+				}
+			}
+			ep.filteredInputRowsBuffer.PushBack(itemWithCachedResult)
+			return nil
+		})
 	}
 	return nil
 }
+
+// evalCondition evaluates a filter or join condition on the given row.
+// A NULL value is definitely not "true", so since we have only a binary
+// decision, rows where the condition evaluates to NULL are treated
+// as not matching.
+func evalCondition(cond Evaluator, row data.Map) (bool, error) {
+	result, err := cond.Eval(row)
+	if err != nil {
+		return false, err
+	}
+	if result.Type() == data.TypeNull {
+		return false, nil
+	}
+	return data.AsBool(result)
+}
@@ -49,3 +49,62 @@ func TestMultiplicityHandling(t *testing.T) {
 		})
 	})
 }
+
+func TestBufferedTuples(t *testing.T) {
+	Convey("Given a SELECT statement with a self-join", t, func() {
+		s := `CREATE STREAM box AS SELECT ISTREAM a:int AS x, b:int AS y
+			FROM src [RANGE 2 TUPLES] AS a, src [RANGE 3 TUPLES] AS b`
+		plan, err := createDefaultSelectPlan(s, t)
+		So(err, ShouldBeNil)
+
+		Convey("When no tuple has been processed", func() {
+			Convey("Then there should be no buffered tuple", func() {
+				So(BufferedTuples(plan), ShouldBeEmpty)
+			})
+		})
+
+		Convey("When feeding it with tuples", func() {
+			tuples := getTuples(4)
+			for _, t := range tuples {
+				_, err := plan.Process(t)
+				So(err, ShouldBeNil)
+			}
+
+			Convey("Then the tuples in the largest window should be returned once", func() {
+				ts := BufferedTuples(plan)
+				So(ts, ShouldHaveLength, 3)
+				for i, t := range ts {
+					So(t.Data, ShouldResemble, tuples[i+1].Data)
+					So(t.InputName, ShouldEqual, "src")
+					So(t.Timestamp, ShouldResemble, tuples[i+1].Timestamp)
+				}
+			})
+
+			Convey("Then another plan should be restored from the tuples", func() {
+				plan2, err := createDefaultSelectPlan(s, t)
+				So(err, ShouldBeNil)
+				for _, t := range BufferedTuples(plan) {
+					_, err := plan2.Process(t)
+					So(err, ShouldBeNil)
+				}
+
+				next := getTuples(5)[4]
+				out1, err := plan.Process(next.Copy())
+				So(err, ShouldBeNil)
+				out2, err := plan2.Process(next.Copy())
+				So(err, ShouldBeNil)
+				// the order of joined results isn't deterministic
+				So(out2, ShouldHaveLength, len(out1))
+				for _, d := range out1 {
+					So(out2, ShouldContain, d)
+				}
+			})
+		})
+	})
+
+	Convey("Given a plan that doesn't buffer tuples", t, func() {
+		Convey("Then there should be no buffered tuple", func() {
+			So(BufferedTuples(&filterPlan{}), ShouldBeNil)
+		})
+	})
+}
@@ -1,6 +1,7 @@
 package execution
 
 import (
+	"errors"
 	"fmt"
 	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
@@ -8,6 +9,7 @@ import (
 	"gopkg.in/sensorbee/sensorbee.v0/data"
 	"math"
 	"regexp"
+	"sort"
 	"strings"
 )
 
@@ -15,6 +17,11 @@ const (
 	MaxRangeTuples   float64 = 1<<20 - 1
 	MaxRangeSec      float64 = 60 * 60 * 24
 	MaxRangeMillisec float64 = 60 * 60 * 24 * 1000
+	MaxRangeMin      float64 = 60 * 24
+	MaxRangeHour     float64 = 24
+	// MaxCubeColumns is the maximum number of columns in CUBE, which
+	// generates a grouping set for each subset of the columns.
+	MaxCubeColumns = 12
 )
 
 /*
@@ -42,11 +49,82 @@ type LogicalPlan struct {
 	EmitterLimit        int64
 	EmitterSampling     float64
 	EmitterSamplingType parser.EmitterSamplingType
-	Projections         []aliasedExpression
+	parser.DistinctAST
+	Projections []aliasedExpression
 	parser.WindowedFromAST
 	Filter    FlatExpression
 	GroupList []FlatExpression
+	// GroupingSets holds the grouping sets of the GROUP BY clause as
+	// indexes into GroupList. It is nil for a plain GROUP BY clause.
+	GroupingSets [][]int
+	// GroupingFuncs holds the parameters of the grouping() functions
+	// used in the projections as indexes into GroupList, keyed by the
+	// name under which their values are referenced.
+	GroupingFuncs map[string][]int
 	parser.HavingAST
+	// JoinConditions is either nil (if there are no explicit JOIN
+	// clauses) or has the same length as Relations, where the i-th
+	// item holds the flattened ON condition of Relations[i].
+	JoinConditions []joinCondition
+	// SessionKey is the flattened key expression of a session window.
+	// It is nil if there is no session window or no key.
+	SessionKey FlatExpression
+	// OrderList holds the flattened expressions of the ORDER BY clause.
+	// They are evaluated on the result rows of each evaluation of the
+	// statement, so they can only refer to output columns.
+	OrderList []orderByExpression
+	// Limit is the maximum number of result rows of each evaluation
+	// of the statement, or -1 if there is no LIMIT clause.
+	Limit int64
+	// AnalyticFuncs holds the analytic functions used in the
+	// projections, keyed by the name under which their values
+	// are referenced. It is nil if there are none.
+	AnalyticFuncs map[string]analyticFuncAST
+	// Unnests holds the flattened UNNEST clauses in the order in
+	// which they appear in the statement.
+	Unnests []unnestExpression
+	// StateLookups holds the flattened JOIN STATE clauses in the order
+	// in which they appear in the statement.
+	StateLookups []stateLookup
+}
+
+// orderByExpression is a flattened expression of the ORDER BY clause
+// together with its sort direction.
+type orderByExpression struct {
+	expr      FlatExpression
+	ascending bool
+}
+
+// joinCondition holds the flattened ON condition of a JOIN clause
+// together with the equi-join keys extracted from it.
+type joinCondition struct {
+	joinType parser.JoinType
+	on       FlatExpression
+	// keys and probes are the two sides of the equality predicates
+	// `probes[k] = keys[k]` that are part of the ON condition, where
+	// keys only refer to the joined relation itself and probes only
+	// refer to the relations before it. They can be used to look up
+	// matching tuples with a hash index instead of a full scan.
+	keys   []FlatExpression
+	probes []FlatExpression
+}
+
+// unnestExpression holds a flattened UNNEST clause. Each element of
+// the array computed by expr is available under alias.
+type unnestExpression struct {
+	expr           FlatExpression
+	alias          string
+	withOrdinality bool
+}
+
+// stateLookup holds a flattened JOIN STATE clause. The value of key
+// is used to look up an entry of the shared state, which is then
+// available under alias.
+type stateLookup struct {
+	joinType parser.JoinType
+	state    string
+	alias    string
+	key      FlatExpression
 }
 
 // PhysicalPlan is a physical interface that is capable of
@@ -58,6 +136,9 @@ type PhysicalPlan interface {
 	// items where each of these items is to be emitted as
 	// a tuple. It is the caller's task to create those tuples
 	// and set appropriate meta information such as timestamps.
+	// When an error is returned, the list may still contain items
+	// that were computed before the error occurred and that have
+	// to be emitted as well.
 	//
 	// Process must NOT modify any field of the input tuple when its
 	// core.TFShared flag is set. To modify the tuple, create a shallow copy of
@@ -70,6 +151,28 @@ type PhysicalPlan interface {
 	Process(input *core.Tuple) ([]data.Map, error)
 }
 
+// ErrLateTuple is returned by PhysicalPlan.Process when the input tuple
+// arrived after the watermark of an event-time window had passed its
+// timestamp and the statement wants such tuples to be emitted to a
+// separate stream. It is the caller's task to emit the tuple.
+var ErrLateTuple = errors.New("the tuple arrived after the watermark")
+
+// BufferedTuples returns the input tuples which are held in the windows of
+// the given PhysicalPlan, ordered by their timestamps. The windows of
+// another PhysicalPlan created from a statement with the same FROM clause
+// can be restored by passing the tuples to its Process method (and
+// discarding the results). It returns nil if the plan doesn't hold any
+// tuples. The plan must not be used concurrently while calling this
+// function.
+func BufferedTuples(p PhysicalPlan) []*core.Tuple {
+	if b, ok := p.(interface {
+		bufferedTuples() []*core.Tuple
+	}); ok {
+		return b.bufferedTuples()
+	}
+	return nil
+}
+
 // Analyze checks the given SELECT statement for logical errors
 // (references to unknown tables etc.) and creates a LogicalPlan
 // that is internally consistent.
@@ -123,6 +226,8 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 
 	flatProjExprs := make([]aliasedExpression, len(s.Projections))
 	numAggParams := 0
+	analyticFuncs := map[string]analyticFuncAST{}
+	groupingFuncs := map[string]groupingFuncAST{}
 	for i, expr := range s.Projections {
 		// convert the parser Expression to a FlatExpression
 		flatExpr, aggrs, err := ParserExprToMaybeAggregate(expr, numAggParams, reg)
@@ -130,6 +235,8 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 		if err != nil {
 			return nil, err
 		}
+		aggrs = extractAnalyticFuncs(aggrs, analyticFuncs)
+		aggrs = extractGroupingFuncs(aggrs, groupingFuncs)
 		// remember if we have aggregates at all
 		if len(aggrs) > 0 {
 			groupingMode = true
@@ -153,6 +260,8 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 			colHeader = projType.Alias
 		case parser.FuncAppAST:
 			colHeader = string(projType.Function)
+		case parser.AnalyticFuncAST:
+			colHeader = string(projType.Function)
 		case parser.Wildcard:
 			// The wildcard projection (without AS) is very special in that
 			// it is the only case where the BQL user does not determine
@@ -179,6 +288,8 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 		if err != nil {
 			return nil, err
 		}
+		aggrs = extractAnalyticFuncs(aggrs, analyticFuncs)
+		aggrs = extractGroupingFuncs(aggrs, groupingFuncs)
 		// use a special column name
 		colHeader := ":having:"
 		flatProjExprs = append(flatProjExprs,
@@ -200,6 +311,61 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 		filterExpr = filterFlatExpr
 	}
 
+	joinConds, err := flattenJoinConditions(s, reg)
+	if err != nil {
+		return nil, err
+	}
+
+	unnests := make([]unnestExpression, len(s.Unnests))
+	for i, u := range s.Unnests {
+		expr, err := ParserExprToFlatExpr(u.Expr, reg)
+		if err != nil {
+			// return a prettier error message
+			if strings.HasPrefix(err.Error(), "you cannot use aggregate") {
+				err = fmt.Errorf("aggregates not allowed in UNNEST clause")
+			}
+			return nil, err
+		}
+		unnests[i] = unnestExpression{expr, u.Alias, u.WithOrdinality == parser.Yes}
+	}
+
+	stateLookups := make([]stateLookup, len(s.StateJoins))
+	for i, j := range s.StateJoins {
+		key, err := ParserExprToFlatExpr(j.Key, reg)
+		if err != nil {
+			// return a prettier error message
+			if strings.HasPrefix(err.Error(), "you cannot use aggregate") {
+				err = fmt.Errorf("aggregates not allowed in JOIN STATE clause")
+			}
+			return nil, err
+		}
+		stateLookups[i] = stateLookup{j.Type, string(j.State), j.Alias, key}
+	}
+
+	var sessionKey FlatExpression
+	if len(s.Relations) > 0 && s.Relations[0].Session.Key != nil {
+		sessionKey, err = ParserExprToFlatExpr(s.Relations[0].Session.Key, reg)
+		if err != nil {
+			// return a prettier error message
+			if strings.HasPrefix(err.Error(), "you cannot use aggregate") {
+				err = fmt.Errorf("aggregates not allowed in the key of a session window")
+			}
+			return nil, err
+		}
+	}
+
+	orderList, err := flattenOrderList(s, reg)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkOrderListColumns(orderList, flatProjExprs); err != nil {
+		return nil, err
+	}
+	limit := int64(-1)
+	if s.HasLimit {
+		limit = s.Limit
+	}
+
 	groupCols := make([]rowValue, len(s.GroupList))
 	flatGroupExprs := make([]FlatExpression, len(s.GroupList))
 	for i, expr := range s.GroupList {
@@ -222,7 +388,24 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 		groupCols[i] = col
 		flatGroupExprs[i] = flatExpr
 	}
-	groupingMode = groupingMode || len(flatGroupExprs) > 0
+	groupingSets := s.ExpandGroupingSets()
+	if s.GroupingSets.Type == parser.CubeGroupingSets && len(groupCols) > MaxCubeColumns {
+		return nil, fmt.Errorf("CUBE can have at most %d columns", MaxCubeColumns)
+	}
+	groupingMode = groupingMode || len(flatGroupExprs) > 0 || groupingSets != nil
+
+	groupingFuncParams, err := resolveGroupingFuncs(groupingFuncs, groupCols)
+	if err != nil {
+		return nil, err
+	}
+	groupingMode = groupingMode || groupingFuncParams != nil
+
+	if len(analyticFuncs) == 0 {
+		analyticFuncs = nil
+	} else if groupingMode {
+		return nil, fmt.Errorf("analytic functions cannot be used " +
+			"together with aggregates or GROUP BY")
+	}
 
 	// check if grouping is done correctly
 	if groupingMode {
@@ -313,14 +496,243 @@ func flattenExpressions(s *parser.SelectStmt, reg udf.FunctionRegistry) (*Logica
 		emitLimit,
 		emitSampling,
 		emitSamplingType,
+		s.DistinctAST,
 		flatProjExprs,
 		s.WindowedFromAST,
 		filterExpr,
 		flatGroupExprs,
+		groupingSets,
+		groupingFuncParams,
 		s.HavingAST,
+		joinConds,
+		sessionKey,
+		orderList,
+		limit,
+		analyticFuncs,
+		unnests,
+		stateLookups,
 	}, nil
 }
 
+// flattenJoinConditions converts the ON conditions of all JOIN clauses
+// in the given statement into FlatExpressions and extracts the
+// equi-join keys that can be used for hash-based lookups.
+func flattenJoinConditions(s *parser.SelectStmt, reg udf.FunctionRegistry) ([]joinCondition, error) {
+	if s.Joins == nil {
+		return nil, nil
+	}
+
+	conds := make([]joinCondition, len(s.Relations))
+	// prevRels holds the aliases of the relations before the current one
+	prevRels := map[string]bool{}
+	for i, rel := range s.Relations {
+		join := s.Join(i)
+		if join.Type == parser.UnspecifiedJoinType {
+			prevRels[rel.Alias] = true
+			continue
+		}
+
+		on, err := ParserExprToFlatExpr(join.On, reg)
+		if err != nil {
+			// return a prettier error message
+			if strings.HasPrefix(err.Error(), "you cannot use aggregate") {
+				err = fmt.Errorf("aggregates not allowed in ON clause")
+			}
+			return nil, err
+		}
+		cond := joinCondition{
+			joinType: join.Type,
+			on:       on,
+		}
+
+		// look for predicates of the form `prev:x = rel:y` in the
+		// top-level conjunction of the ON condition
+		for _, pred := range splitConjunction(join.On) {
+			bo, ok := pred.(parser.BinaryOpAST)
+			if !ok || bo.Op != parser.Equal {
+				continue
+			}
+			key, probe := bo.Left, bo.Right
+			if !refersOnlyTo(key, map[string]bool{rel.Alias: true}) {
+				key, probe = probe, key
+			}
+			if !refersOnlyTo(key, map[string]bool{rel.Alias: true}) ||
+				!refersOnlyTo(probe, prevRels) {
+				continue
+			}
+			flatKey, err := ParserExprToFlatExpr(key, reg)
+			if err != nil {
+				return nil, err
+			}
+			flatProbe, err := ParserExprToFlatExpr(probe, reg)
+			if err != nil {
+				return nil, err
+			}
+			// the values of the keys are computed only once per tuple,
+			// so they must not change between evaluations
+			if flatKey.Volatility() != Immutable ||
+				flatProbe.Volatility() != Immutable {
+				continue
+			}
+			cond.keys = append(cond.keys, flatKey)
+			cond.probes = append(cond.probes, flatProbe)
+		}
+		conds[i] = cond
+		prevRels[rel.Alias] = true
+	}
+	return conds, nil
+}
+
+// extractAnalyticFuncs moves the analytic functions from the given
+// map of aggregates (see ParserExprToMaybeAggregate) to the given map
+// of analytic functions and returns the remaining aggregates.
+func extractAnalyticFuncs(aggrs map[string]FlatExpression, analyticFuncs map[string]analyticFuncAST) map[string]FlatExpression {
+	for key, expr := range aggrs {
+		if af, ok := expr.(analyticFuncAST); ok {
+			analyticFuncs[key] = af
+			delete(aggrs, key)
+		}
+	}
+	if len(aggrs) == 0 {
+		return nil
+	}
+	return aggrs
+}
+
+// extractGroupingFuncs moves the grouping() functions from the given
+// map of aggregates (see ParserExprToMaybeAggregate) to the given map
+// of grouping functions and returns the remaining aggregates.
+func extractGroupingFuncs(aggrs map[string]FlatExpression, groupingFuncs map[string]groupingFuncAST) map[string]FlatExpression {
+	for key, expr := range aggrs {
+		if gf, ok := expr.(groupingFuncAST); ok {
+			groupingFuncs[key] = gf
+			delete(aggrs, key)
+		}
+	}
+	if len(aggrs) == 0 {
+		return nil
+	}
+	return aggrs
+}
+
+// resolveGroupingFuncs converts the parameters of the given grouping()
+// functions to indexes into the GROUP BY clause. It fails if one of
+// them is not a column of the GROUP BY clause.
+func resolveGroupingFuncs(groupingFuncs map[string]groupingFuncAST, groupCols []rowValue) (map[string][]int, error) {
+	if len(groupingFuncs) == 0 {
+		return nil, nil
+	}
+	params := make(map[string][]int, len(groupingFuncs))
+	for key, gf := range groupingFuncs {
+		idxs := make([]int, len(gf.Expressions))
+		for i, expr := range gf.Expressions {
+			idxs[i] = -1
+			if col, ok := expr.(rowValue); ok {
+				for j, groupCol := range groupCols {
+					if col == groupCol {
+						idxs[i] = j
+						break
+					}
+				}
+			}
+			if idxs[i] < 0 {
+				return nil, fmt.Errorf("parameters of function '%s' must be "+
+					"columns in the GROUP BY clause: %s", gf.Function, expr.Repr())
+			}
+		}
+		params[key] = idxs
+	}
+	return params, nil
+}
+
+// flattenOrderList converts the expressions in the ORDER BY clause of
+// the given statement into FlatExpressions. As they are evaluated on
+// the result rows, they must not refer to input relations or contain
+// aggregate functions.
+func flattenOrderList(s *parser.SelectStmt, reg udf.FunctionRegistry) ([]orderByExpression, error) {
+	if len(s.OrderList) == 0 {
+		return nil, nil
+	}
+	orderList := make([]orderByExpression, len(s.OrderList))
+	for i, sortExpr := range s.OrderList {
+		for rel := range sortExpr.ReferencedRelations() {
+			if rel != "" {
+				return nil, fmt.Errorf("ORDER BY can only refer to output "+
+					"columns, not to relation '%s'", rel)
+			}
+		}
+		expr, err := ParserExprToFlatExpr(sortExpr.Expr, reg)
+		if err != nil {
+			// return a prettier error message
+			if strings.HasPrefix(err.Error(), "you cannot use aggregate") {
+				err = fmt.Errorf("aggregate functions cannot be used in ORDER BY")
+			}
+			return nil, err
+		}
+		orderList[i] = orderByExpression{expr, sortExpr.Ascending != parser.No}
+	}
+	return orderList, nil
+}
+
+// checkOrderListColumns checks that the columns referred to in the
+// ORDER BY clause are output columns of the given projections. Nothing
+// can be checked if there is a wildcard projection since the output
+// columns are only known at runtime then.
+func checkOrderListColumns(orderList []orderByExpression, projections []aliasedExpression) error {
+	outputCols := map[string]bool{}
+	for _, proj := range projections {
+		if proj.alias == "*" {
+			return nil
+		}
+		if proj.alias == ":having:" {
+			continue
+		}
+		if path, err := data.CompilePath(proj.alias); err == nil {
+			if keys, _ := data.MapKeys(path); len(keys) > 0 {
+				outputCols[keys[0]] = true
+			}
+		}
+	}
+	for _, o := range orderList {
+		for _, col := range o.expr.Columns() {
+			path, err := data.CompilePath(col.Column)
+			if err != nil {
+				return err
+			}
+			keys, _ := data.MapKeys(path)
+			if len(keys) == 0 || !outputCols[keys[0]] {
+				return fmt.Errorf("ORDER BY can only refer to output "+
+					"columns, not to '%s'", col.Column)
+			}
+		}
+	}
+	return nil
+}
+
+// splitConjunction returns the operands of the top-level AND operators
+// of the given expression.
+func splitConjunction(e parser.Expression) []parser.Expression {
+	if bo, ok := e.(parser.BinaryOpAST); ok && bo.Op == parser.And {
+		return append(splitConjunction(bo.Left), splitConjunction(bo.Right)...)
+	}
+	return []parser.Expression{e}
+}
+
+// refersOnlyTo checks whether the given expression refers to at least
+// one relation and all referenced relations are contained in rels.
+func refersOnlyTo(e parser.Expression, rels map[string]bool) bool {
+	refRels := e.ReferencedRelations()
+	if len(refRels) == 0 {
+		return false
+	}
+	for rel := range refRels {
+		if !rels[rel] {
+			return false
+		}
+	}
+	return true
+}
+
 // makeRelationAliases will assign an internal alias to every relation
 // does not yet have one (given by the user). It will also detect if
 // there is a conflict between aliases.
@@ -342,6 +754,44 @@ func makeRelationAliases(s *parser.SelectStmt) error {
 		newRels[i] = aliasedRel
 	}
 	s.Relations = newRels
+
+	unnestAliases := make(map[string]bool, len(s.Unnests))
+	for _, u := range s.Unnests {
+		if otherRel, exists := relNames[u.Alias]; exists {
+			return fmt.Errorf("cannot use relation '%s' and UNNEST with the "+
+				"same alias '%s'", otherRel.Name, u.Alias)
+		}
+		if unnestAliases[u.Alias] {
+			return fmt.Errorf("cannot use UNNEST clauses with the same alias '%s'", u.Alias)
+		}
+		unnestAliases[u.Alias] = true
+	}
+
+	if len(s.StateJoins) == 0 {
+		return nil
+	}
+	stateNames := make(map[string]string, len(s.StateJoins))
+	newJoins := make([]parser.StateJoinAST, len(s.StateJoins))
+	for i, j := range s.StateJoins {
+		if j.Alias == "" {
+			j.Alias = string(j.State)
+		}
+		if otherRel, exists := relNames[j.Alias]; exists {
+			return fmt.Errorf("cannot use relation '%s' and state '%s' with the "+
+				"same alias '%s'", otherRel.Name, j.State, j.Alias)
+		}
+		if unnestAliases[j.Alias] {
+			return fmt.Errorf("cannot use state '%s' and UNNEST with the "+
+				"same alias '%s'", j.State, j.Alias)
+		}
+		if otherState, exists := stateNames[j.Alias]; exists {
+			return fmt.Errorf("cannot use states '%s' and '%s' with the "+
+				"same alias '%s'", j.State, otherState, j.Alias)
+		}
+		stateNames[j.Alias] = string(j.State)
+		newJoins[i] = j
+	}
+	s.StateJoins = newJoins
 	return nil
 }
 
@@ -383,6 +833,32 @@ func validateReferences(s *parser.SelectStmt) error {
 			refRels[rel] = true
 		}
 	}
+	for i := range s.Relations {
+		if on := s.Join(i).On; on != nil {
+			for rel := range on.ReferencedRelations() {
+				refRels[rel] = true
+			}
+		}
+	}
+	for _, u := range s.Unnests {
+		for rel := range u.Expr.ReferencedRelations() {
+			refRels[rel] = true
+		}
+	}
+	for _, j := range s.StateJoins {
+		for rel := range j.Key.ReferencedRelations() {
+			refRels[rel] = true
+		}
+	}
+	// references to the elements of UNNEST clauses and the entries
+	// looked up by JOIN STATE clauses are valid anywhere (the order
+	// is checked by validateLateralRelations)
+	for _, u := range s.Unnests {
+		delete(refRels, u.Alias)
+	}
+	for _, j := range s.StateJoins {
+		delete(refRels, j.Alias)
+	}
 
 	// do the correctness check for SELECT, WHERE, GROUP BY clauses
 	if len(s.Relations) == 0 {
@@ -422,6 +898,18 @@ func validateReferences(s *parser.SelectStmt) error {
 			if s.Having != nil {
 				s.Having = s.Having.RenameReferencedRelation("", inputRel)
 			}
+			newUnnests := make([]parser.UnnestAST, len(s.Unnests))
+			for i, u := range s.Unnests {
+				u.Expr = u.Expr.RenameReferencedRelation("", inputRel)
+				newUnnests[i] = u
+			}
+			s.Unnests = newUnnests
+			newJoins := make([]parser.StateJoinAST, len(s.StateJoins))
+			for i, j := range s.StateJoins {
+				j.Key = j.Key.RenameReferencedRelation("", inputRel)
+				newJoins[i] = j
+			}
+			s.StateJoins = newJoins
 
 		} else if len(refRels) > 1 {
 			// Sample: SELECT a, b.a FROM b // SELECT b.a, x.a FROM b
@@ -462,11 +950,38 @@ func validateReferences(s *parser.SelectStmt) error {
 		}
 		// if we arrive here, all referenced relations exist in the
 		// FROM clause -> OK
+
+		// the ON condition of a JOIN can only refer to the joined
+		// relation and the relations before it
+		for i, inputRel := range s.Relations {
+			on := s.Join(i).On
+			if on == nil {
+				continue
+			}
+			for rel := range on.ReferencedRelations() {
+				found := false
+				for _, prevRel := range s.Relations[:i+1] {
+					if rel == prevRel.Alias {
+						found = true
+						break
+					}
+				}
+				if !found {
+					err := fmt.Errorf("cannot reference relation '%s' in "+
+						"the ON clause of '%s'", rel, inputRel.Alias)
+					return err
+				}
+			}
+		}
 	}
 
 	for _, rel := range s.Relations {
+		clause := "RANGE"
+		if rel.Session.Enabled {
+			clause = "GAP"
+		}
 		if rel.Value <= 0 {
-			err := fmt.Errorf("number in RANGE clause must be positive, not %v", rel.Value)
+			err := fmt.Errorf("number in %s clause must be positive, not %v", clause, rel.Value)
 			return err
 		}
 		if rel.Unit == parser.Tuples && math.Trunc(rel.Value) != rel.Value {
@@ -485,22 +1000,217 @@ func validateReferences(s *parser.SelectStmt) error {
 			}
 		case parser.Seconds:
 			if rel.Value > MaxRangeSec {
-				err := fmt.Errorf("RANGE value %v is too large for SECONDS (must be at most %d)",
-					rel.Value, int64(MaxRangeSec))
+				err := fmt.Errorf("%s value %v is too large for SECONDS (must be at most %d)",
+					clause, rel.Value, int64(MaxRangeSec))
 				return err
 			}
 		case parser.Milliseconds:
 			if rel.Value > MaxRangeMillisec {
-				err := fmt.Errorf("RANGE value %v is too large for MILLISECONDS (must be at most %d)",
-					rel.Value, int64(MaxRangeMillisec))
+				err := fmt.Errorf("%s value %v is too large for MILLISECONDS (must be at most %d)",
+					clause, rel.Value, int64(MaxRangeMillisec))
+				return err
+			}
+		case parser.Minutes:
+			if rel.Value > MaxRangeMin {
+				err := fmt.Errorf("%s value %v is too large for MINUTES (must be at most %d)",
+					clause, rel.Value, int64(MaxRangeMin))
 				return err
 			}
+		case parser.Hours:
+			if rel.Value > MaxRangeHour {
+				err := fmt.Errorf("%s value %v is too large for HOURS (must be at most %d)",
+					clause, rel.Value, int64(MaxRangeHour))
+				return err
+			}
+		}
+	}
+
+	if err := validateLateralRelations(s); err != nil {
+		return err
+	}
+	if err := validateSlides(s); err != nil {
+		return err
+	}
+	if err := validateLateness(s); err != nil {
+		return err
+	}
+	return validateSessions(s)
+}
+
+// validateLateralRelations checks that the UNNEST and JOIN STATE
+// clauses only refer to the relations in the FROM clause and the
+// UNNEST and JOIN STATE clauses before them. The key of a JOIN STATE
+// clause must also refer to at least one of them.
+func validateLateralRelations(s *parser.SelectStmt) error {
+	rels := make(map[string]bool, len(s.Relations)+len(s.Unnests)+len(s.StateJoins))
+	for _, rel := range s.Relations {
+		rels[rel.Alias] = true
+	}
+	for _, u := range s.Unnests {
+		for rel := range u.Expr.ReferencedRelations() {
+			if !rels[rel] {
+				return fmt.Errorf("cannot reference relation '%s' in "+
+					"the UNNEST clause of '%s'", rel, u.Alias)
+			}
+		}
+		rels[u.Alias] = true
+	}
+	for _, j := range s.StateJoins {
+		refRels := j.Key.ReferencedRelations()
+		if len(refRels) == 0 {
+			return fmt.Errorf("the key of the JOIN STATE clause of '%s' "+
+				"must refer to an input relation", j.Alias)
+		}
+		for rel := range refRels {
+			if !rels[rel] {
+				return fmt.Errorf("cannot reference relation '%s' in "+
+					"the JOIN STATE clause of '%s'", rel, j.Alias)
+			}
 		}
+		rels[j.Alias] = true
 	}
+	return nil
+}
+
+// validateSlides checks the SLIDE and TUMBLING specifications of the
+// relations in the FROM clause. Since all windows are evaluated at
+// the same time, either no relation or all relations must specify
+// the same slide.
+func validateSlides(s *parser.SelectStmt) error {
+	var first parser.IntervalAST
+	for i, rel := range s.Relations {
+		slide := slideInterval(&rel.StreamWindowAST)
+		if i == 0 {
+			first = slide
+		} else if intervalLength(slide) != intervalLength(first) ||
+			(slide.Unit == parser.Tuples) != (first.Unit == parser.Tuples) {
+			return fmt.Errorf("all relations must use the same SLIDE specification")
+		}
+		if slide.Unit == parser.UnspecifiedIntervalUnit {
+			continue
+		}
 
+		if slide.Value <= 0 {
+			return fmt.Errorf("number in SLIDE clause must be positive, not %v", slide.Value)
+		}
+		if (slide.Unit == parser.Tuples) != (rel.Unit == parser.Tuples) {
+			return fmt.Errorf("SLIDE and RANGE must both be given in TUPLES " +
+				"or both as a time interval")
+		}
+		if slide.Unit == parser.Tuples && math.Trunc(slide.Value) != slide.Value {
+			// actually the parser should not allow fractional numbers,
+			// but we check anyway
+			return fmt.Errorf("number in SLIDE clause must be integral "+
+				"for TUPLES, not %v", slide.Value)
+		}
+		if intervalLength(slide) > intervalLength(rel.IntervalAST) {
+			return fmt.Errorf("SLIDE value %v %s must not be larger than the RANGE",
+				slide.Value, slide.Unit)
+		}
+	}
 	return nil
 }
 
+// validateLateness checks the LATENESS specifications of the relations
+// in the FROM clause. Since there is only one watermark per statement,
+// either no relation or all relations must specify the same lateness
+// and the same policy for late tuples.
+func validateLateness(s *parser.SelectStmt) error {
+	var first parser.LatenessAST
+	for i, rel := range s.Relations {
+		lateness := rel.Lateness
+		if i == 0 {
+			first = lateness
+		} else if intervalLength(lateness.IntervalAST) != intervalLength(first.IntervalAST) ||
+			(lateness.Unit == parser.UnspecifiedIntervalUnit) != (first.Unit == parser.UnspecifiedIntervalUnit) ||
+			latePolicy(lateness) != latePolicy(first) ||
+			lateness.Stream != first.Stream {
+			return fmt.Errorf("all relations must use the same LATENESS specification")
+		}
+		if lateness.Unit == parser.UnspecifiedIntervalUnit {
+			continue
+		}
+
+		if lateness.Value < 0 {
+			return fmt.Errorf("number in LATENESS clause must not be negative, not %v",
+				lateness.Value)
+		}
+		if rel.Unit == parser.Tuples {
+			// the watermark only bounds the time by which a tuple
+			// can be late, not the number of tuples
+			return fmt.Errorf("LATENESS can only be used with a time-based RANGE")
+		}
+		if lateness.Policy == parser.EmitLateTuples && lateness.Stream == "" {
+			return fmt.Errorf("the stream for late tuples must not be empty")
+		}
+	}
+	return nil
+}
+
+// validateSessions checks the session windows in the FROM clause.
+// Since sessions are evaluated one by one when they are closed, a
+// session window cannot be combined with other relations and it does
+// not make sense to emit the results of a session as they are deleted.
+// The relation in the key expression of a session window is made
+// explicit as for the other clauses.
+func validateSessions(s *parser.SelectStmt) error {
+	for i, rel := range s.Relations {
+		if !rel.Session.Enabled {
+			continue
+		}
+		if len(s.Relations) > 1 {
+			return fmt.Errorf("a session window cannot be used together " +
+				"with other relations")
+		}
+		if s.EmitterType == parser.Dstream {
+			return fmt.Errorf("DSTREAM cannot be used with a session window")
+		}
+		if key := rel.Session.Key; key != nil {
+			for ref := range key.ReferencedRelations() {
+				if ref != "" && ref != rel.Alias {
+					return fmt.Errorf("cannot refer to relation '%s' "+
+						"when using only '%s'", ref, rel.Alias)
+				}
+			}
+			s.Relations[i].Session.Key = key.RenameReferencedRelation("", rel.Alias)
+		}
+	}
+	return nil
+}
+
+// latePolicy returns the policy for late tuples of the given LATENESS
+// specification. Late tuples are dropped unless specified otherwise.
+func latePolicy(l parser.LatenessAST) parser.LatePolicy {
+	if l.Policy == parser.UnspecifiedLatePolicy {
+		return parser.DropLateTuples
+	}
+	return l.Policy
+}
+
+// slideInterval returns the interval by which the given window slides.
+// If there is no SLIDE or TUMBLING clause, the returned interval has
+// an UnspecifiedIntervalUnit.
+func slideInterval(w *parser.StreamWindowAST) parser.IntervalAST {
+	if w.Slide.Tumbling {
+		return w.IntervalAST
+	}
+	return w.Slide.IntervalAST
+}
+
+// intervalLength returns the length of the given interval in seconds
+// or, for TUPLES, the number of tuples.
+func intervalLength(i parser.IntervalAST) float64 {
+	switch i.Unit {
+	case parser.Milliseconds:
+		return i.Value / 1000
+	case parser.Minutes:
+		return i.Value * 60
+	case parser.Hours:
+		return i.Value * 60 * 60
+	}
+	return i.Value
+}
+
 // LogicalOptimize does nothing at the moment. In the future, logical
 // optimizations (evaluation of foldable terms etc.) can be added here.
 func (lp *LogicalPlan) LogicalOptimize() (*LogicalPlan, error) {
@@ -514,6 +1224,77 @@ func (lp *LogicalPlan) LogicalOptimize() (*LogicalPlan, error) {
 	return lp, nil
 }
 
+// InputColumns returns the JSON Paths of the columns of the input
+// relations which are referenced by the statement, keyed by the aliases
+// of the relations. The columns of the elements of UNNEST clauses and
+// of the entries of JOIN STATE clauses aren't included.
+func (lp *LogicalPlan) InputColumns() map[string][]string {
+	rels := make(map[string]bool, len(lp.Relations))
+	for _, rel := range lp.Relations {
+		rels[rel.Alias] = true
+	}
+	cols := map[string][]string{}
+	seen := map[rowValue]bool{}
+	add := func(e FlatExpression) {
+		if e == nil {
+			return
+		}
+		for _, col := range e.Columns() {
+			if !rels[col.Relation] || seen[col] {
+				continue
+			}
+			seen[col] = true
+			cols[col.Relation] = append(cols[col.Relation], col.Column)
+		}
+	}
+
+	for _, proj := range lp.Projections {
+		add(proj.expr)
+		for _, in := range proj.aggrInputs {
+			add(in)
+		}
+	}
+	add(lp.Filter)
+	for _, g := range lp.GroupList {
+		add(g)
+	}
+	for _, j := range lp.JoinConditions {
+		add(j.on)
+	}
+	add(lp.SessionKey)
+	for _, f := range lp.AnalyticFuncs {
+		add(f)
+	}
+	for _, u := range lp.Unnests {
+		add(u.expr)
+	}
+	for _, l := range lp.StateLookups {
+		add(l.key)
+	}
+	// the aggregate inputs and analytic functions are held in maps
+	for _, c := range cols {
+		sort.Strings(c)
+	}
+	return cols
+}
+
+// OutputColumns returns the JSON Paths of the columns of the result
+// rows, which are the aliases of the projections. It returns false
+// when they cannot be determined because of a wildcard.
+func (lp *LogicalPlan) OutputColumns() ([]string, bool) {
+	cols := make([]string, 0, len(lp.Projections))
+	for _, proj := range lp.Projections {
+		switch proj.alias {
+		case ":having:":
+			continue
+		case "*":
+			return nil, false
+		}
+		cols = append(cols, proj.alias)
+	}
+	return cols, true
+}
+
 // MakePhysicalPlan creates a physical execution plan that is able to
 // deal with the statement under consideration.
 func (lp *LogicalPlan) MakePhysicalPlan(reg udf.FunctionRegistry) (PhysicalPlan, error) {
@@ -47,13 +47,13 @@ func TestRelationChecker(t *testing.T) {
 	r := parser.IntervalAST{parser.FloatLiteral{2}, parser.Tuples}
 	singleFrom := parser.WindowedFromAST{
 		[]parser.AliasedStreamWindowAST{
-			{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "t", nil}, r, 0, parser.Wait}, ""},
-		},
+			{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "t", nil}, r, 0, parser.Wait, parser.SlideAST{}, parser.LatenessAST{}, parser.SessionAST{}}, ""},
+		}, nil, nil, nil,
 	}
 	singleFromAlias := parser.WindowedFromAST{
 		[]parser.AliasedStreamWindowAST{
-			{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "s", nil}, r, 0, parser.Wait}, "t"},
-		},
+			{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "s", nil}, r, 0, parser.Wait, parser.SlideAST{}, parser.LatenessAST{}, parser.SessionAST{}}, "t"},
+		}, nil, nil, nil,
 	}
 	two := parser.NumericLiteral{2}
 	a := parser.RowValue{"", "a"}
@@ -120,7 +120,7 @@ func TestRelationChecker(t *testing.T) {
 		{&parser.SelectStmt{
 			ProjectionsAST: parser.ProjectionsAST{[]parser.Expression{
 				parser.FuncAppAST{"f", parser.ExpressionsAST{[]parser.Expression{a}},
-					[]parser.SortedExpressionAST{{b, parser.UnspecifiedKeyword}}},
+					[]parser.SortedExpressionAST{{b, parser.UnspecifiedKeyword}}, false},
 			}},
 			WindowedFromAST: singleFrom,
 		}, ""},
@@ -178,7 +178,7 @@ func TestRelationChecker(t *testing.T) {
 		{&parser.SelectStmt{
 			ProjectionsAST: parser.ProjectionsAST{[]parser.Expression{
 				parser.FuncAppAST{"f", parser.ExpressionsAST{[]parser.Expression{a}},
-					[]parser.SortedExpressionAST{{tB, parser.UnspecifiedKeyword}}},
+					[]parser.SortedExpressionAST{{tB, parser.UnspecifiedKeyword}}, false},
 			}},
 			WindowedFromAST: singleFrom,
 		}, "cannot refer to relations"},
@@ -186,7 +186,7 @@ func TestRelationChecker(t *testing.T) {
 		{&parser.SelectStmt{
 			ProjectionsAST: parser.ProjectionsAST{[]parser.Expression{
 				parser.FuncAppAST{"f", parser.ExpressionsAST{[]parser.Expression{tA}},
-					[]parser.SortedExpressionAST{{b, parser.UnspecifiedKeyword}}},
+					[]parser.SortedExpressionAST{{b, parser.UnspecifiedKeyword}}, false},
 			}},
 			WindowedFromAST: singleFrom,
 		}, "cannot refer to relations"},
@@ -298,79 +298,79 @@ func TestRelationChecker(t *testing.T) {
 		{&parser.SelectStmt{
 			ProjectionsAST:  parser.ProjectionsAST{[]parser.Expression{a}},
 			WindowedFromAST: singleFrom,
-			GroupingAST:     parser.GroupingAST{[]parser.Expression{two}},
+			GroupingAST:     parser.GroupingAST{[]parser.Expression{two}, parser.GroupingSetsAST{}},
 		}, ""},
 		// SELECT 2   FROM t GROUP BY 2        -> OK
 		{&parser.SelectStmt{
 			ProjectionsAST:  parser.ProjectionsAST{[]parser.Expression{two}},
 			WindowedFromAST: singleFrom,
-			GroupingAST:     parser.GroupingAST{[]parser.Expression{two}},
+			GroupingAST:     parser.GroupingAST{[]parser.Expression{two}, parser.GroupingSetsAST{}},
 		}, ""},
 		// SELECT t:a FROM t GROUP BY 2        -> OK
 		{&parser.SelectStmt{
 			ProjectionsAST:  parser.ProjectionsAST{[]parser.Expression{tA}},
 			WindowedFromAST: singleFrom,
-			GroupingAST:     parser.GroupingAST{[]parser.Expression{two}},
+			GroupingAST:     parser.GroupingAST{[]parser.Expression{two}, parser.GroupingSetsAST{}},
 		}, ""},
 		// SELECT a   FROM t GROUP BY b        -> OK
 		{&parser.SelectStmt{
 			ProjectionsAST:  parser.ProjectionsAST{[]parser.Expression{a}},
 			WindowedFromAST: singleFrom,
-			GroupingAST:     parser.GroupingAST{[]parser.Expression{b}},
+			GroupingAST:     parser.GroupingAST{[]parser.Expression{b}, parser.GroupingSetsAST{}},
 		}, ""},
 		// SELECT a   FROM t GROUP BY b, c     -> OK
 		{&parser.SelectStmt{
 			ProjectionsAST:  parser.ProjectionsAST{[]parser.Expression{a}},
 			WindowedFromAST: singleFrom,
-			GroupingAST:     parser.GroupingAST{[]parser.Expression{b, c}},
+			GroupingAST:     parser.GroupingAST{[]parser.Expression{b, c}, parser.GroupingSetsAST{}},
 		}, ""},
 		// SELECT 2   FROM t GROUP BY b        -> OK
 		{&parser.SelectStmt{
 			ProjectionsAST:  parser.ProjectionsAST{[]parser.Expression{two}},
 			WindowedFromAST: singleFrom,
-			GroupingAST:     parser.GroupingAST{[]parser.Expression{b}},
+			GroupingAST:     parser.GroupingAST{[]parser.Expression{b}, parser.GroupingSetsAST{}},
 		}, ""},
 		// SELECT t:a FROM t GROUP BY b        -> NG
 		{&parser.SelectStmt{
 			ProjectionsAST:  parser.ProjectionsAST{[]parser.Expression{tA}},
 			WindowedFromAST: singleFrom,
-			GroupingAST:     parser.GroupingAST{[]parser.Expression{b}},
+			GroupingAST:     parser.GroupingAST{[]parser.Expression{b}, parser.GroupingSetsAST{}},
 		}, "cannot refer to relations"},
 		// SELECT a   FROM t GROUP BY t:b      -> NG
 		{&parser.SelectStmt{
 			ProjectionsAST:  parser.ProjectionsAST{[]parser.Expression{a}},
 			WindowedFromAST: singleFrom,
-			GroupingAST:     parser.GroupingAST{[]parser.Expression{tB}},
+			GroupingAST:     parser.GroupingAST{[]parser.Expression{tB}, parser.GroupingSetsAST{}},
 		}, "cannot refer to relations"},
 		// SELECT 2   FROM t GROUP BY t:b      -> OK
 		{&parser.SelectStmt{
 			ProjectionsAST:  parser.ProjectionsAST{[]parser.Expression{two}},
 			WindowedFromAST: singleFrom,
-			GroupingAST:     parser.GroupingAST{[]parser.Expression{tB}},
+			GroupingAST:     parser.GroupingAST{[]parser.Expression{tB}, parser.GroupingSetsAST{}},
 		}, ""},
 		// SELECT t:a FROM t GROUP BY t:b      -> OK
 		{&parser.SelectStmt{
 			ProjectionsAST:  parser.ProjectionsAST{[]parser.Expression{tA}},
 			WindowedFromAST: singleFrom,
-			GroupingAST:     parser.GroupingAST{[]parser.Expression{tB}},
+			GroupingAST:     parser.GroupingAST{[]parser.Expression{tB}, parser.GroupingSetsAST{}},
 		}, ""},
 		// SELECT t:a FROM t GROUP BY t:b, t:c -> OK
 		{&parser.SelectStmt{
 			ProjectionsAST:  parser.ProjectionsAST{[]parser.Expression{tA}},
 			WindowedFromAST: singleFrom,
-			GroupingAST:     parser.GroupingAST{[]parser.Expression{tB, tC}},
+			GroupingAST:     parser.GroupingAST{[]parser.Expression{tB, tC}, parser.GroupingSetsAST{}},
 		}, ""},
 		// SELECT t:a FROM t GROUP BY b, t:b   -> NG (same table with multiple aliases)
 		{&parser.SelectStmt{
 			ProjectionsAST:  parser.ProjectionsAST{[]parser.Expression{tA}},
 			WindowedFromAST: singleFrom,
-			GroupingAST:     parser.GroupingAST{[]parser.Expression{b, tB}},
+			GroupingAST:     parser.GroupingAST{[]parser.Expression{b, tB}, parser.GroupingSetsAST{}},
 		}, "cannot refer to relations"},
 		// SELECT 2   FROM t GROUP BY x:b      -> NG
 		{&parser.SelectStmt{
 			ProjectionsAST:  parser.ProjectionsAST{[]parser.Expression{two}},
 			WindowedFromAST: singleFrom,
-			GroupingAST:     parser.GroupingAST{[]parser.Expression{xB}},
+			GroupingAST:     parser.GroupingAST{[]parser.Expression{xB}, parser.GroupingSetsAST{}},
 		}, "cannot refer to relation 'x' when using only 't'"},
 
 		////////// HAVING //////////////
@@ -533,52 +533,52 @@ func TestRelationAliasing(t *testing.T) {
 			ProjectionsAST: proj,
 			WindowedFromAST: parser.WindowedFromAST{
 				[]parser.AliasedStreamWindowAST{
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, ""},
-				}},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait, parser.SlideAST{}, parser.LatenessAST{}, parser.SessionAST{}}, ""},
+				}, nil, nil, nil},
 		}, ""},
 		// SELECT 2 FROM a AS b         -> OK
 		{&parser.SelectStmt{
 			ProjectionsAST: proj,
 			WindowedFromAST: parser.WindowedFromAST{
 				[]parser.AliasedStreamWindowAST{
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, "b"},
-				}},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait, parser.SlideAST{}, parser.LatenessAST{}, parser.SessionAST{}}, "b"},
+				}, nil, nil, nil},
 		}, ""},
 		// SELECT 2 FROM a AS b, a      -> OK
 		{&parser.SelectStmt{
 			ProjectionsAST: proj,
 			WindowedFromAST: parser.WindowedFromAST{
 				[]parser.AliasedStreamWindowAST{
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, "b"},
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, ""},
-				}},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait, parser.SlideAST{}, parser.LatenessAST{}, parser.SessionAST{}}, "b"},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait, parser.SlideAST{}, parser.LatenessAST{}, parser.SessionAST{}}, ""},
+				}, nil, nil, nil},
 		}, ""},
 		// SELECT 2 FROM a AS b, c AS a -> OK
 		{&parser.SelectStmt{
 			ProjectionsAST: proj,
 			WindowedFromAST: parser.WindowedFromAST{
 				[]parser.AliasedStreamWindowAST{
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, "b"},
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "c", nil}, r, 0, parser.Wait}, "a"},
-				}},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait, parser.SlideAST{}, parser.LatenessAST{}, parser.SessionAST{}}, "b"},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "c", nil}, r, 0, parser.Wait, parser.SlideAST{}, parser.LatenessAST{}, parser.SessionAST{}}, "a"},
+				}, nil, nil, nil},
 		}, ""},
 		// SELECT 2 FROM a, a           -> NG
 		{&parser.SelectStmt{
 			ProjectionsAST: proj,
 			WindowedFromAST: parser.WindowedFromAST{
 				[]parser.AliasedStreamWindowAST{
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, ""},
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, ""},
-				}},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait, parser.SlideAST{}, parser.LatenessAST{}, parser.SessionAST{}}, ""},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait, parser.SlideAST{}, parser.LatenessAST{}, parser.SessionAST{}}, ""},
+				}, nil, nil, nil},
 		}, "cannot use relations"},
 		// SELECT 2 FROM a, b AS a      -> NG
 		{&parser.SelectStmt{
 			ProjectionsAST: proj,
 			WindowedFromAST: parser.WindowedFromAST{
 				[]parser.AliasedStreamWindowAST{
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait}, ""},
-					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "b", nil}, r, 0, parser.Wait}, "a"},
-				}},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "a", nil}, r, 0, parser.Wait, parser.SlideAST{}, parser.LatenessAST{}, parser.SessionAST{}}, ""},
+					{parser.StreamWindowAST{parser.Stream{parser.ActualStream, "b", nil}, r, 0, parser.Wait, parser.SlideAST{}, parser.LatenessAST{}, parser.SessionAST{}}, "a"},
+				}, nil, nil, nil},
 		}, "cannot use relations"},
 	}
 
@@ -811,6 +811,22 @@ func TestAggregateChecker(t *testing.T) {
 				"g_77d2dd39": rowValue{"x", "b"},
 			}},
 
+		// deduplicate the aggregate variables
+		{"count(DISTINCT a) FROM x [RANGE 1 TUPLES]", "",
+			aggregateInputDistinct{
+				funcAppAST{"count", []FlatExpression{aggInputRef{"g_f12cd6bc"}}},
+				"d39111947",
+			},
+			map[string]FlatExpression{
+				"g_f12cd6bc": rowValue{"x", "a"},
+			}},
+
+		{"count(DISTINCT a ORDER BY b) FROM x [RANGE 1 TUPLES]",
+			"DISTINCT cannot be used together with ORDER BY in aggregate function 'count'", nil, nil},
+
+		{"f(DISTINCT a) FROM x [RANGE 1 TUPLES]",
+			"you cannot use DISTINCT in non-aggregate function 'f'", nil, nil},
+
 		{"count(udaf(a)) FROM x [RANGE 1 TUPLES]",
 			"aggregate functions cannot be nested", nil, nil},
 
@@ -928,6 +944,20 @@ func TestRangeChecker(t *testing.T) {
 		{"a FROM x [RANGE 86400000 MILLISECONDS]", ""},
 		{"a FROM x [RANGE 86400000.01 MILLISECONDS]",
 			"RANGE value 8.640000001e+07 is too large for MILLISECONDS (must be at most 86400000)"},
+		// MINUTES
+		{"a FROM x [RANGE 1 MINUTES]", ""},
+		{"a FROM x [RANGE 1440 MINUTES]", ""},
+		{"a FROM x [RANGE 1440.5 MINUTES]",
+			"RANGE value 1440.5 is too large for MINUTES (must be at most 1440)"},
+		// HOURS
+		{"a FROM x [RANGE 1 HOURS]", ""},
+		{"a FROM x [RANGE 24 HOURS]", ""},
+		{"a FROM x [RANGE 24.5 HOURS]",
+			"RANGE value 24.5 is too large for HOURS (must be at most 24)"},
+		// mixed units
+		{"a FROM x [RANGE 1 MINUTES SLIDE 30 SECONDS]", ""},
+		{"a FROM x [RANGE 30 SECONDS SLIDE 1 MINUTES]",
+			"SLIDE value 1 MINUTES must not be larger than the RANGE"},
 	}
 
 	for _, testCase := range testCases {
@@ -959,6 +989,529 @@ func TestRangeChecker(t *testing.T) {
 	}
 }
 
+func TestJoinConditionChecker(t *testing.T) {
+	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+
+	testCases := []struct {
+		bql           string
+		expectedError string
+		numKeys       []int
+	}{
+		{"a:x FROM a [RANGE 1 TUPLES] JOIN b [RANGE 1 TUPLES] ON a:k = b:k", "",
+			[]int{0, 1}},
+		{"a:x FROM a [RANGE 1 TUPLES] JOIN b [RANGE 1 TUPLES] ON b:k = a:k AND a:l + 1 = b:l", "",
+			[]int{0, 2}},
+		// predicates that are not an equality between the joined
+		// relation and the previous ones cannot be indexed
+		{"a:x FROM a [RANGE 1 TUPLES] JOIN b [RANGE 1 TUPLES] ON a:k < b:k OR a:l = b:l", "",
+			[]int{0, 0}},
+		{"a:x FROM a [RANGE 1 TUPLES] JOIN b [RANGE 1 TUPLES] ON a:k + b:k = 3 AND b:l = 2", "",
+			[]int{0, 0}},
+		{"a:x FROM a [RANGE 1 TUPLES] JOIN b [RANGE 1 TUPLES] ON a:k = b:k + random()", "",
+			[]int{0, 0}},
+		{"a:x FROM a [RANGE 1 TUPLES], c [RANGE 1 TUPLES] LEFT OUTER JOIN b [RANGE 1 TUPLES] ON b:k = c:k", "",
+			[]int{0, 0, 1}},
+		{"a:x FROM a [RANGE 1 TUPLES] JOIN b [RANGE 1 TUPLES] ON a:k = c:k",
+			"cannot reference relation 'c' when using input relations 'a', 'b'", nil},
+		{"a:x FROM a [RANGE 1 TUPLES] JOIN b [RANGE 1 TUPLES] ON a:k = b:k, c [RANGE 1 TUPLES] JOIN d [RANGE 1 TUPLES] ON c:k = d:k", "",
+			[]int{0, 1, 0, 1}},
+		{"a:x FROM a [RANGE 1 TUPLES] JOIN b [RANGE 1 TUPLES] ON a:k = c:k, c [RANGE 1 TUPLES]",
+			"cannot reference relation 'c' in the ON clause of 'b'", nil},
+		{"a:x FROM a [RANGE 1 TUPLES] JOIN b [RANGE 1 TUPLES] ON count(a:k) = 1",
+			"aggregates not allowed in ON clause", nil},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		Convey(fmt.Sprintf("Given the statement %s", testCase.bql), t, func() {
+			p := parser.New()
+			stmt := "CREATE STREAM x AS SELECT ISTREAM " + testCase.bql
+			astUnchecked, _, err := p.ParseStmt(stmt)
+			So(err, ShouldBeNil)
+			So(astUnchecked, ShouldHaveSameTypeAs, parser.CreateStreamAsSelectStmt{})
+			ast := astUnchecked.(parser.CreateStreamAsSelectStmt).Select
+
+			Convey("When we analyze it", func() {
+				logPlan, err := Analyze(ast, reg)
+				expectedError := testCase.expectedError
+				if expectedError == "" {
+					Convey("There is no error", func() {
+						So(err, ShouldBeNil)
+						So(len(logPlan.JoinConditions), ShouldEqual, len(testCase.numKeys))
+						for i, n := range testCase.numKeys {
+							So(len(logPlan.JoinConditions[i].keys), ShouldEqual, n)
+							So(len(logPlan.JoinConditions[i].probes), ShouldEqual, n)
+						}
+					})
+				} else {
+					Convey("There is an error", func() {
+						So(err, ShouldNotBeNil)
+						So(err.Error(), ShouldStartWith, expectedError)
+					})
+				}
+			})
+		})
+	}
+}
+
+func TestStateJoinChecker(t *testing.T) {
+	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+
+	testCases := []struct {
+		bql           string
+		expectedError string
+		aliases       []string
+	}{
+		{"a, users:name FROM a [RANGE 1 TUPLES] JOIN STATE users ON user_id", "",
+			[]string{"users"}},
+		{"a:x, u:name FROM a [RANGE 1 TUPLES] JOIN b [RANGE 1 TUPLES] ON a:k = b:k " +
+			"LEFT JOIN STATE users AS u ON b:user_id JOIN STATE groups AS g ON u:group_id", "",
+			[]string{"u", "g"}},
+		{"a FROM a [RANGE 1 TUPLES] JOIN STATE a ON k",
+			"cannot use relation 'a' and state 'a' with the same alias 'a'", nil},
+		{"a FROM a [RANGE 1 TUPLES] JOIN STATE s ON k JOIN STATE t AS s ON k",
+			"cannot use states 't' and 's' with the same alias 's'", nil},
+		{"a FROM a [RANGE 1 TUPLES] JOIN STATE s ON 1",
+			"the key of the JOIN STATE clause of 's' must refer to an input relation", nil},
+		{"a:x FROM a [RANGE 1 TUPLES] JOIN STATE s ON t:k JOIN STATE t ON a:k",
+			"cannot reference relation 't' in the JOIN STATE clause of 's'", nil},
+		{"a:x FROM a [RANGE 1 TUPLES], b [RANGE 1 TUPLES] JOIN STATE s ON k",
+			"cannot reference relation '' when using input relations 'a', 'b'", nil},
+		{"a FROM a [RANGE 1 TUPLES] JOIN STATE s ON count(k)",
+			"aggregates not allowed in JOIN STATE clause", nil},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		Convey(fmt.Sprintf("Given the statement %s", testCase.bql), t, func() {
+			p := parser.New()
+			stmt := "CREATE STREAM x AS SELECT ISTREAM " + testCase.bql
+			astUnchecked, _, err := p.ParseStmt(stmt)
+			So(err, ShouldBeNil)
+			So(astUnchecked, ShouldHaveSameTypeAs, parser.CreateStreamAsSelectStmt{})
+			ast := astUnchecked.(parser.CreateStreamAsSelectStmt).Select
+
+			Convey("When we analyze it", func() {
+				logPlan, err := Analyze(ast, reg)
+				expectedError := testCase.expectedError
+				if expectedError == "" {
+					Convey("There is no error", func() {
+						So(err, ShouldBeNil)
+						So(len(logPlan.StateLookups), ShouldEqual, len(testCase.aliases))
+						for i, alias := range testCase.aliases {
+							So(logPlan.StateLookups[i].alias, ShouldEqual, alias)
+						}
+					})
+				} else {
+					Convey("There is an error", func() {
+						So(err, ShouldNotBeNil)
+						So(err.Error(), ShouldStartWith, expectedError)
+					})
+				}
+			})
+		})
+	}
+}
+
+func TestUnnestChecker(t *testing.T) {
+	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+
+	testCases := []struct {
+		bql           string
+		expectedError string
+		aliases       []string
+	}{
+		{"id, r:value FROM a [RANGE 1 TUPLES], UNNEST(readings) AS r", "",
+			[]string{"r"}},
+		{"a:x, q:value FROM a [RANGE 1 TUPLES], b [RANGE 1 TUPLES], " +
+			"UNNEST(a:rs) AS r, UNNEST(r:value.qs) WITH ORDINALITY AS q " +
+			"JOIN STATE s ON q:value", "",
+			[]string{"r", "q"}},
+		{"a:x FROM a [RANGE 1 TUPLES], UNNEST([1, 2]) AS r", "",
+			[]string{"r"}},
+		{"a FROM a [RANGE 1 TUPLES], UNNEST(rs) AS a",
+			"cannot use relation 'a' and UNNEST with the same alias 'a'", nil},
+		{"a FROM a [RANGE 1 TUPLES], UNNEST(rs) AS r, UNNEST(qs) AS r",
+			"cannot use UNNEST clauses with the same alias 'r'", nil},
+		{"a FROM a [RANGE 1 TUPLES], UNNEST(rs) AS r JOIN STATE s AS r ON k",
+			"cannot use state 's' and UNNEST with the same alias 'r'", nil},
+		{"a:x FROM a [RANGE 1 TUPLES], UNNEST(q:value) AS r, UNNEST(a:qs) AS q",
+			"cannot reference relation 'q' in the UNNEST clause of 'r'", nil},
+		{"a:x FROM a [RANGE 1 TUPLES], UNNEST(s:rs) AS r JOIN STATE s ON a:k",
+			"cannot reference relation 's' in the UNNEST clause of 'r'", nil},
+		{"a:x FROM a [RANGE 1 TUPLES], b [RANGE 1 TUPLES], UNNEST(rs) AS r",
+			"cannot reference relation '' when using input relations 'a', 'b'", nil},
+		{"a FROM a [RANGE 1 TUPLES], UNNEST(array_agg(k)) AS r",
+			"aggregates not allowed in UNNEST clause", nil},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		Convey(fmt.Sprintf("Given the statement %s", testCase.bql), t, func() {
+			p := parser.New()
+			stmt := "CREATE STREAM x AS SELECT ISTREAM " + testCase.bql
+			astUnchecked, _, err := p.ParseStmt(stmt)
+			So(err, ShouldBeNil)
+			So(astUnchecked, ShouldHaveSameTypeAs, parser.CreateStreamAsSelectStmt{})
+			ast := astUnchecked.(parser.CreateStreamAsSelectStmt).Select
+
+			Convey("When we analyze it", func() {
+				logPlan, err := Analyze(ast, reg)
+				expectedError := testCase.expectedError
+				if expectedError == "" {
+					Convey("There is no error", func() {
+						So(err, ShouldBeNil)
+						So(len(logPlan.Unnests), ShouldEqual, len(testCase.aliases))
+						for i, alias := range testCase.aliases {
+							So(logPlan.Unnests[i].alias, ShouldEqual, alias)
+						}
+					})
+				} else {
+					Convey("There is an error", func() {
+						So(err, ShouldNotBeNil)
+						So(err.Error(), ShouldStartWith, expectedError)
+					})
+				}
+			})
+		})
+	}
+}
+
+func TestGroupingSetsChecker(t *testing.T) {
+	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+
+	testCases := []struct {
+		bql           string
+		expectedError string
+		groupingSets  [][]int
+		groupingFuncs [][]int
+	}{
+		{"a, count(*) FROM x [RANGE 1 TUPLES] GROUP BY a", "", nil, nil},
+		{"a, b, count(*) FROM x [RANGE 1 TUPLES] GROUP BY ROLLUP(a, b)", "",
+			[][]int{{0, 1}, {0}, {}}, nil},
+		{"a, b, count(*) FROM x [RANGE 1 TUPLES] GROUP BY CUBE(a, b)", "",
+			[][]int{{0, 1}, {0}, {1}, {}}, nil},
+		{"a, b, grouping(b, a) FROM x [RANGE 1 TUPLES] GROUP BY GROUPING SETS((a, b), b, ())", "",
+			[][]int{{0, 1}, {1}, {}}, [][]int{{1, 0}}},
+		{"a, grouping(a) FROM x [RANGE 1 TUPLES] GROUP BY a", "",
+			nil, [][]int{{0}}},
+		{"a, count(*) FROM x [RANGE 1 TUPLES] GROUP BY ROLLUP(a) HAVING grouping(a) = 0", "",
+			[][]int{{0}, {}}, [][]int{{0}}},
+		{"a, grouping(b) FROM x [RANGE 1 TUPLES] GROUP BY ROLLUP(a)",
+			"parameters of function 'grouping' must be columns in the GROUP BY clause: x:b", nil, nil},
+		{"a, grouping(a + 1) FROM x [RANGE 1 TUPLES] GROUP BY ROLLUP(a)",
+			"parameters of function 'grouping' must be columns in the GROUP BY clause", nil, nil},
+		{"a, grouping(count(a)) FROM x [RANGE 1 TUPLES] GROUP BY ROLLUP(a)",
+			"aggregate functions cannot be used in function 'grouping'", nil, nil},
+		{"a FROM x [RANGE 1 TUPLES] WHERE grouping(a) = 0 GROUP BY ROLLUP(a)",
+			"aggregates not allowed in WHERE clause", nil, nil},
+		{"a FROM x [RANGE 1 TUPLES] GROUP BY CUBE(a, b, c, d, e, f, g, h, i, j, k, l, m)",
+			"CUBE can have at most 12 columns", nil, nil},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		Convey(fmt.Sprintf("Given the statement %s", testCase.bql), t, func() {
+			p := parser.New()
+			stmt := "CREATE STREAM x AS SELECT ISTREAM " + testCase.bql
+			astUnchecked, _, err := p.ParseStmt(stmt)
+			So(err, ShouldBeNil)
+			So(astUnchecked, ShouldHaveSameTypeAs, parser.CreateStreamAsSelectStmt{})
+			ast := astUnchecked.(parser.CreateStreamAsSelectStmt).Select
+
+			Convey("When we analyze it", func() {
+				logPlan, err := Analyze(ast, reg)
+				expectedError := testCase.expectedError
+				if expectedError == "" {
+					Convey("There is no error", func() {
+						So(err, ShouldBeNil)
+						So(logPlan.GroupingStmt, ShouldBeTrue)
+						So(logPlan.GroupingSets, ShouldResemble, testCase.groupingSets)
+						So(len(logPlan.GroupingFuncs), ShouldEqual, len(testCase.groupingFuncs))
+						for _, params := range logPlan.GroupingFuncs {
+							So(params, ShouldResemble, testCase.groupingFuncs[0])
+						}
+					})
+				} else {
+					Convey("There is an error", func() {
+						So(err, ShouldNotBeNil)
+						So(err.Error(), ShouldStartWith, expectedError)
+					})
+				}
+			})
+		})
+	}
+}
+
+func TestSlideChecker(t *testing.T) {
+	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+
+	testCases := []struct {
+		bql           string
+		expectedError string
+	}{
+		{"a FROM x [RANGE 10 TUPLES SLIDE 2 TUPLES]", ""},
+		{"a FROM x [RANGE 10 TUPLES TUMBLING]", ""},
+		{"a FROM x [RANGE 1 SECONDS SLIDE 500 MILLISECONDS]", ""},
+		{"x:a FROM x [RANGE 2 SECONDS TUMBLING], y [RANGE 4 SECONDS SLIDE 2000 MILLISECONDS]", ""},
+		{"a FROM x [RANGE 1 SECONDS SLIDE 0 SECONDS]",
+			"number in SLIDE clause must be positive, not 0"},
+		{"a FROM x [RANGE 1 SECONDS SLIDE 2 SECONDS]",
+			"SLIDE value 2 SECONDS must not be larger than the RANGE"},
+		{"a FROM x [RANGE 10 TUPLES SLIDE 2 SECONDS]",
+			"SLIDE and RANGE must both be given in TUPLES or both as a time interval"},
+		{"x:a FROM x [RANGE 2 SECONDS TUMBLING], y [RANGE 2 SECONDS]",
+			"all relations must use the same SLIDE specification"},
+		{"x:a FROM x [RANGE 2 SECONDS TUMBLING], y [RANGE 3 SECONDS TUMBLING]",
+			"all relations must use the same SLIDE specification"},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		Convey(fmt.Sprintf("Given the statement %s", testCase.bql), t, func() {
+			p := parser.New()
+			stmt := "CREATE STREAM x AS SELECT ISTREAM " + testCase.bql
+			astUnchecked, _, err := p.ParseStmt(stmt)
+			So(err, ShouldBeNil)
+			So(astUnchecked, ShouldHaveSameTypeAs, parser.CreateStreamAsSelectStmt{})
+			ast := astUnchecked.(parser.CreateStreamAsSelectStmt).Select
+
+			Convey("When we analyze it", func() {
+				_, err := Analyze(ast, reg)
+				expectedError := testCase.expectedError
+				if expectedError == "" {
+					Convey("There is no error", func() {
+						So(err, ShouldBeNil)
+					})
+				} else {
+					Convey("There is an error", func() {
+						So(err, ShouldNotBeNil)
+						So(err.Error(), ShouldStartWith, expectedError)
+					})
+				}
+			})
+		})
+	}
+}
+
+func TestLatenessChecker(t *testing.T) {
+	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+
+	testCases := []struct {
+		bql           string
+		expectedError string
+	}{
+		{"a FROM x [RANGE 10 SECONDS, LATENESS 1 SECONDS]", ""},
+		{"a FROM x [RANGE 1 SECONDS TUMBLING, LATENESS 0 SECONDS DROP LATE TUPLES]", ""},
+		{"x:a FROM x [RANGE 2 SECONDS, LATENESS 1 SECONDS], y [RANGE 4 SECONDS, LATENESS 1000 MILLISECONDS DROP LATE TUPLES]", ""},
+		{"x:a FROM x [RANGE 2 SECONDS, LATENESS 1 SECONDS EMIT LATE TUPLES INTO l], y [RANGE 4 SECONDS, LATENESS 1 SECONDS EMIT LATE TUPLES INTO l]", ""},
+		{"a FROM x [RANGE 1 SECONDS, LATENESS -1 SECONDS]",
+			"number in LATENESS clause must not be negative, not -1"},
+		{"a FROM x [RANGE 10 TUPLES, LATENESS 5 SECONDS]",
+			"LATENESS can only be used with a time-based RANGE"},
+		{"x:a FROM x [RANGE 2 SECONDS, LATENESS 1 SECONDS], y [RANGE 2 SECONDS]",
+			"all relations must use the same LATENESS specification"},
+		{"x:a FROM x [RANGE 2 SECONDS, LATENESS 1 SECONDS], y [RANGE 2 SECONDS, LATENESS 2 SECONDS]",
+			"all relations must use the same LATENESS specification"},
+		{"x:a FROM x [RANGE 2 SECONDS, LATENESS 1 SECONDS], y [RANGE 2 SECONDS, LATENESS 1 SECONDS UPDATE LATE TUPLES]",
+			"all relations must use the same LATENESS specification"},
+		{"x:a FROM x [RANGE 2 SECONDS, LATENESS 1 SECONDS EMIT LATE TUPLES INTO l], y [RANGE 2 SECONDS, LATENESS 1 SECONDS EMIT LATE TUPLES INTO m]",
+			"all relations must use the same LATENESS specification"},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		Convey(fmt.Sprintf("Given the statement %s", testCase.bql), t, func() {
+			p := parser.New()
+			stmt := "CREATE STREAM x AS SELECT ISTREAM " + testCase.bql
+			astUnchecked, _, err := p.ParseStmt(stmt)
+			So(err, ShouldBeNil)
+			So(astUnchecked, ShouldHaveSameTypeAs, parser.CreateStreamAsSelectStmt{})
+			ast := astUnchecked.(parser.CreateStreamAsSelectStmt).Select
+
+			Convey("When we analyze it", func() {
+				_, err := Analyze(ast, reg)
+				expectedError := testCase.expectedError
+				if expectedError == "" {
+					Convey("There is no error", func() {
+						So(err, ShouldBeNil)
+					})
+				} else {
+					Convey("There is an error", func() {
+						So(err, ShouldNotBeNil)
+						So(err.Error(), ShouldStartWith, expectedError)
+					})
+				}
+			})
+		})
+	}
+}
+
+func TestSessionChecker(t *testing.T) {
+	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+
+	testCases := []struct {
+		bql           string
+		expectedError string
+	}{
+		{"a FROM x [SESSION GAP 10 SECONDS]", ""},
+		{"a FROM x [SESSION GAP 10 SECONDS BY b]", ""},
+		{"a FROM x [SESSION GAP 10 SECONDS BY x:b || c, LATENESS 1 SECONDS]", ""},
+		{"a FROM x [SESSION GAP 0 SECONDS]",
+			"number in GAP clause must be positive, not 0"},
+		{"a FROM x [SESSION GAP 10 SECONDS BY y:b]",
+			"cannot refer to relation 'y' when using only 'x'"},
+		{"a FROM x [SESSION GAP 10 SECONDS BY count(b)]",
+			"aggregates not allowed in the key of a session window"},
+		{"x:a FROM x [SESSION GAP 10 SECONDS], y [RANGE 10 SECONDS]",
+			"a session window cannot be used together with other relations"},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		Convey(fmt.Sprintf("Given the statement %s", testCase.bql), t, func() {
+			p := parser.New()
+			stmt := "CREATE STREAM x AS SELECT ISTREAM " + testCase.bql
+			astUnchecked, _, err := p.ParseStmt(stmt)
+			So(err, ShouldBeNil)
+			So(astUnchecked, ShouldHaveSameTypeAs, parser.CreateStreamAsSelectStmt{})
+			ast := astUnchecked.(parser.CreateStreamAsSelectStmt).Select
+
+			Convey("When we analyze it", func() {
+				_, err := Analyze(ast, reg)
+				expectedError := testCase.expectedError
+				if expectedError == "" {
+					Convey("There is no error", func() {
+						So(err, ShouldBeNil)
+					})
+				} else {
+					Convey("There is an error", func() {
+						So(err, ShouldNotBeNil)
+						So(err.Error(), ShouldStartWith, expectedError)
+					})
+				}
+			})
+		})
+	}
+}
+
+func TestOrderByChecker(t *testing.T) {
+	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+
+	testCases := []struct {
+		bql           string
+		expectedError string
+	}{
+		{"a FROM x [RANGE 1 TUPLES] ORDER BY a", ""},
+		{"a, count(b) AS c FROM x [RANGE 1 TUPLES] GROUP BY a ORDER BY c DESC, a LIMIT 3", ""},
+		{"a FROM x [RANGE 1 TUPLES] LIMIT 0", ""},
+		{"a FROM x [RANGE 1 TUPLES] ORDER BY x:a",
+			"ORDER BY can only refer to output columns, not to relation 'x'"},
+		{"a FROM x [RANGE 1 TUPLES] ORDER BY count(a)",
+			"aggregate functions cannot be used in ORDER BY"},
+		{"a FROM x [RANGE 1 TUPLES] ORDER BY b",
+			"ORDER BY can only refer to output columns, not to 'b'"},
+		{"a AS c FROM x [RANGE 1 TUPLES] ORDER BY a",
+			"ORDER BY can only refer to output columns, not to 'a'"},
+		{"a, count(b) AS c FROM x [RANGE 1 TUPLES] GROUP BY a ORDER BY b",
+			"ORDER BY can only refer to output columns, not to 'b'"},
+		{"a AS c FROM x [RANGE 1 TUPLES] ORDER BY c.d[0], -c", ""},
+		{"* FROM x [RANGE 1 TUPLES] ORDER BY b", ""},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		Convey(fmt.Sprintf("Given the statement %s", testCase.bql), t, func() {
+			p := parser.New()
+			stmt := "CREATE STREAM x AS SELECT ISTREAM " + testCase.bql
+			astUnchecked, _, err := p.ParseStmt(stmt)
+			So(err, ShouldBeNil)
+			So(astUnchecked, ShouldHaveSameTypeAs, parser.CreateStreamAsSelectStmt{})
+			ast := astUnchecked.(parser.CreateStreamAsSelectStmt).Select
+
+			Convey("When we analyze it", func() {
+				_, err := Analyze(ast, reg)
+				expectedError := testCase.expectedError
+				if expectedError == "" {
+					Convey("There is no error", func() {
+						So(err, ShouldBeNil)
+					})
+				} else {
+					Convey("There is an error", func() {
+						So(err, ShouldNotBeNil)
+						So(err.Error(), ShouldStartWith, expectedError)
+					})
+				}
+			})
+		})
+	}
+}
+
+func TestAnalyticFuncChecker(t *testing.T) {
+	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
+
+	testCases := []struct {
+		bql           string
+		expectedError string
+	}{
+		{"a, lag(a) OVER (PARTITION BY b ORDER BY ts()) FROM x [RANGE 1 TUPLES]", ""},
+		{"row_number() OVER () AS n, sum(a) OVER (ORDER BY b DESC) FROM x [RANGE 1 TUPLES]", ""},
+		{"a - lead(a, 2, 0) OVER (ORDER BY a) FROM x [RANGE 1 TUPLES]", ""},
+		{"abs(a) OVER () FROM x [RANGE 1 TUPLES]",
+			"function 'abs' is neither an analytic nor an aggregate function"},
+		{"lag(a, 1, 2, 3) OVER () FROM x [RANGE 1 TUPLES]",
+			"function 'lag' is not 4-ary"},
+		{"count(DISTINCT a) OVER () FROM x [RANGE 1 TUPLES]",
+			"DISTINCT and ORDER BY cannot be used in the parameters of analytic function 'count'"},
+		{"count(a), lag(a) OVER () FROM x [RANGE 1 TUPLES]",
+			"analytic functions cannot be used together with aggregates or GROUP BY"},
+		{"lag(a) OVER () FROM x [RANGE 1 TUPLES] GROUP BY a",
+			"analytic functions cannot be used together with aggregates or GROUP BY"},
+		{"lag(count(a)) OVER () FROM x [RANGE 1 TUPLES]",
+			"aggregate functions cannot be used in analytic functions"},
+		{"lag(lag(a) OVER ()) OVER () FROM x [RANGE 1 TUPLES]",
+			"analytic functions cannot be nested"},
+		{"a FROM x [RANGE 1 TUPLES] WHERE lag(a) OVER () = 1",
+			"you cannot use analytic function 'lag' in a flat expression"},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		Convey(fmt.Sprintf("Given the statement %s", testCase.bql), t, func() {
+			p := parser.New()
+			stmt := "CREATE STREAM x AS SELECT ISTREAM " + testCase.bql
+			astUnchecked, _, err := p.ParseStmt(stmt)
+			So(err, ShouldBeNil)
+			So(astUnchecked, ShouldHaveSameTypeAs, parser.CreateStreamAsSelectStmt{})
+			ast := astUnchecked.(parser.CreateStreamAsSelectStmt).Select
+
+			Convey("When we analyze it", func() {
+				_, err := Analyze(ast, reg)
+				expectedError := testCase.expectedError
+				if expectedError == "" {
+					Convey("There is no error", func() {
+						So(err, ShouldBeNil)
+					})
+				} else {
+					Convey("There is an error", func() {
+						So(err, ShouldNotBeNil)
+						So(err.Error(), ShouldStartWith, expectedError)
+					})
+				}
+			})
+		})
+	}
+}
+
 func TestVolatileAggregateChecker(t *testing.T) {
 	reg := udf.CopyGlobalUDFRegistry(core.NewContext(nil))
 
@@ -0,0 +1,78 @@
+package execution
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// unnestEvaluator expands a row into one row per element of an array
+// for an UNNEST clause.
+type unnestEvaluator struct {
+	expr           Evaluator
+	alias          string
+	withOrdinality bool
+}
+
+// expandRow calls f with each row obtained by expanding the given row
+// with the UNNEST clauses and joining the states of the JOIN STATE
+// clauses. The row is modified in place and passed to f for each
+// expanded row, so f has to copy it if it keeps the row.
+func (ep *commonExecutionPlan) expandRow(row data.Map, f func(data.Map) error) error {
+	return ep.unnestRow(0, row, f)
+}
+
+func (ep *commonExecutionPlan) unnestRow(i int, row data.Map, f func(data.Map) error) error {
+	if i == len(ep.unnests) {
+		// add the entries of the joined states
+		if ok, err := ep.joinStates(row); err != nil {
+			return err
+		} else if !ok {
+			return nil
+		}
+		return f(row)
+	}
+
+	u := ep.unnests[i]
+	v, err := u.expr.Eval(row)
+	if err != nil {
+		return err
+	}
+	if v.Type() == data.TypeNull {
+		// like an empty array, NULL doesn't generate any row
+		return nil
+	}
+	arr, err := data.AsArray(v)
+	if err != nil {
+		return fmt.Errorf("UNNEST clause of '%s' requires an array: %v", u.alias, err)
+	}
+	for j, elem := range arr {
+		m := data.Map{"value": elem}
+		if u.withOrdinality {
+			m["ordinality"] = data.Int(j + 1)
+		}
+		row[u.alias] = m
+		// elements don't have timestamps
+		setNullMetadata(row, u.alias)
+		if err := ep.unnestRow(i+1, row, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// prepareUnnests creates the evaluators of the UNNEST clauses.
+func prepareUnnests(unnests []unnestExpression, reg udf.FunctionRegistry) ([]unnestEvaluator, error) {
+	if len(unnests) == 0 {
+		return nil, nil
+	}
+	output := make([]unnestEvaluator, len(unnests))
+	for i, u := range unnests {
+		expr, err := ExpressionToEvaluator(u.expr, reg)
+		if err != nil {
+			return nil, err
+		}
+		output[i] = unnestEvaluator{expr, u.alias, u.withOrdinality}
+	}
+	return output, nil
+}
@@ -0,0 +1,106 @@
+package execution
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestUnnest(t *testing.T) {
+	Convey("Given tuples having arrays", t, func() {
+		ctx := core.NewContext(nil)
+		So(ctx.SharedStates.Add("people", "test", &lookupTestState{
+			entries: map[int64]data.Map{
+				2: {"name": data.String("two")},
+			},
+		}), ShouldBeNil)
+		reg := udf.CopyGlobalUDFRegistry(ctx)
+		tuples := getTuples(4)
+		tuples[0].Data["readings"] = data.Array{data.Int(1)}
+		tuples[1].Data["readings"] = data.Array{data.Int(2), data.Int(3)}
+		tuples[2].Data["readings"] = data.Array{}
+		tuples[3].Data["readings"] = data.Null{}
+
+		process := func(plan PhysicalPlan) [][]data.Map {
+			var results [][]data.Map
+			for _, t := range tuples {
+				out, err := plan.Process(t)
+				So(err, ShouldBeNil)
+				results = append(results, out)
+			}
+			return results
+		}
+
+		Convey("When unnesting them with a filter plan", func() {
+			plan, err := createStateJoinPlan(`CREATE STREAM box AS SELECT RSTREAM
+				int, r:value AS v FROM src [RANGE 1 TUPLES], UNNEST(readings) AS r`, reg)
+			So(err, ShouldBeNil)
+			So(plan, ShouldHaveSameTypeAs, &filterPlan{})
+
+			Convey("Then each element should be emitted with the tuple", func() {
+				res := process(plan)
+				So(res[0], ShouldResemble, []data.Map{{"int": data.Int(1), "v": data.Int(1)}})
+				So(res[1], ShouldResemble, []data.Map{
+					{"int": data.Int(2), "v": data.Int(2)},
+					{"int": data.Int(2), "v": data.Int(3)},
+				})
+				So(res[2], ShouldBeEmpty)
+				So(res[3], ShouldBeEmpty)
+			})
+		})
+
+		Convey("When unnesting them with an ordinality and a filter", func() {
+			plan, err := createStateJoinPlan(`CREATE STREAM box AS SELECT RSTREAM
+				r:value AS v, r:ordinality AS o FROM src [RANGE 1 TUPLES],
+				UNNEST(readings) WITH ORDINALITY AS r WHERE r:ordinality > 1`, reg)
+			So(err, ShouldBeNil)
+
+			Convey("Then the filter should be applied to each element", func() {
+				res := process(plan)
+				So(res[0], ShouldBeEmpty)
+				So(res[1], ShouldResemble, []data.Map{{"v": data.Int(3), "o": data.Int(2)}})
+			})
+		})
+
+		Convey("When joining a state with the elements", func() {
+			plan, err := createStateJoinPlan(`CREATE STREAM box AS SELECT RSTREAM
+				int, people:name FROM src [RANGE 1 TUPLES], UNNEST(readings) AS r
+				JOIN STATE people ON r:value`, reg)
+			So(err, ShouldBeNil)
+
+			Convey("Then the state should be looked up for each element", func() {
+				res := process(plan)
+				So(res[0], ShouldBeEmpty)
+				So(res[1], ShouldResemble, []data.Map{{"int": data.Int(2), "name": data.String("two")}})
+			})
+		})
+
+		Convey("When unnesting them with a groupby plan", func() {
+			plan, err := createStateJoinPlan(`CREATE STREAM box AS SELECT RSTREAM
+				count(*) AS c, sum(r:value) AS s FROM src [RANGE 2 TUPLES], UNNEST(readings) AS r`, reg)
+			So(err, ShouldBeNil)
+			So(plan, ShouldHaveSameTypeAs, &groupbyExecutionPlan{})
+
+			Convey("Then the aggregates should be computed over the elements in the window", func() {
+				res := process(plan)
+				So(res[0], ShouldResemble, []data.Map{{"c": data.Int(1), "s": data.Int(1)}})
+				So(res[1], ShouldResemble, []data.Map{{"c": data.Int(3), "s": data.Int(6)}})
+				So(res[2], ShouldResemble, []data.Map{{"c": data.Int(2), "s": data.Int(5)}})
+				So(res[3], ShouldResemble, []data.Map{{"c": data.Int(0), "s": data.Null{}}})
+			})
+		})
+
+		Convey("When unnesting a value which isn't an array", func() {
+			plan, err := createStateJoinPlan(`CREATE STREAM box AS SELECT RSTREAM
+				r:value FROM src [RANGE 1 TUPLES], UNNEST(int) AS r`, reg)
+			So(err, ShouldBeNil)
+
+			Convey("Then processing should fail", func() {
+				_, err := plan.Process(tuples[0])
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,255 @@
+package bql
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/execution"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// RunExplainStmt returns a description of how the statement contained
+// in the given ExplainStmt would be executed without modifying the
+// topology. The returned Map has the following keys:
+//
+//	statement: the explained statement
+//	selects:   the result of execution.Explain for each SELECT
+//	           statement, in the order in which they appear
+//	nodes:     the nodes that would be added to the topology
+//	edges:     the connections that would be made between nodes
+//	removed_edges: the connections that would be removed, each having
+//	           "from" and "to" (only for CREATE OR REPLACE STREAM)
+//
+// Each node has a "name", a "node_type" ("source", "box", "sink" or
+// "udsf") and a "kind" describing what the node does. Temporary nodes,
+// whose names are generated when the statement is actually executed,
+// have a "temporary" flag and a placeholder name of the form
+// "sensorbee_tmp_..._$N". A UDSF becomes a source or a box depending
+// on whether it declares inputs, which is only known after creating
+// it, so its node_type is "udsf". When CREATE OR REPLACE STREAM replaces
+// the box of an existing stream, the node of the stream has a "replaced"
+// flag, and inputs used by both statements don't appear in edges since
+// they stay connected.
+func (tb *TopologyBuilder) RunExplainStmt(stmt *parser.ExplainStmt) (data.Value, error) {
+	e := &topologyExplainer{tb: tb, selects: data.Array{},
+		nodes: data.Array{}, edges: data.Array{}}
+
+	switch s := stmt.Stmt.(type) {
+	case parser.SelectStmt:
+		if err := e.explainSelectUnion([]parser.SelectStmt{s}); err != nil {
+			return nil, err
+		}
+	case parser.SelectUnionStmt:
+		if err := e.explainSelectUnion(s.Selects); err != nil {
+			return nil, err
+		}
+	case parser.CreateStreamAsSelectStmt:
+		if err := e.checkName(string(s.Name)); err != nil {
+			return nil, err
+		}
+		if err := e.explainSelect(string(s.Name), false, s.Select, string(s.OnError.Stream), nil); err != nil {
+			return nil, err
+		}
+	case parser.CreateOrReplaceStreamAsSelectStmt:
+		_, prev, err := tb.replaceableStream(string(s.Name))
+		if err != nil {
+			if !core.IsNotExist(err) {
+				return nil, err
+			}
+			if err := e.checkName(string(s.Name)); err != nil {
+				return nil, err
+			}
+		}
+		if err := e.explainSelect(string(s.Name), false, s.Select, string(s.OnError.Stream), prev); err != nil {
+			return nil, err
+		}
+	case parser.CreateStreamAsSelectUnionStmt:
+		if err := e.checkName(string(s.Name)); err != nil {
+			return nil, err
+		}
+		for _, sel := range s.Selects {
+			name := e.temporaryName("sensorbee_tmp_")
+			if err := e.explainSelect(name, true, sel, "", nil); err != nil {
+				return nil, err
+			}
+			e.addEdge(name, string(s.Name), name)
+		}
+		e.addNode(string(s.Name), core.NTBox, "forwarder", false)
+	default:
+		return nil, fmt.Errorf("cannot explain statement: %v", stmt.Stmt)
+	}
+
+	res := data.Map{
+		"statement": data.String(fmt.Sprint(stmt.Stmt)),
+		"selects":   e.selects,
+		"nodes":     e.nodes,
+		"edges":     e.edges,
+	}
+	if e.removedEdges != nil {
+		res["removed_edges"] = e.removedEdges
+	}
+	return res, nil
+}
+
+// topologyExplainer collects the nodes and edges that the
+// TopologyBuilder would create for a statement.
+type topologyExplainer struct {
+	tb      *TopologyBuilder
+	numTmp  int
+	selects data.Array
+	nodes   data.Array
+	edges   data.Array
+
+	// removedEdges is nil unless an existing box is replaced.
+	removedEdges data.Array
+}
+
+func (e *topologyExplainer) temporaryName(prefix string) string {
+	e.numTmp++
+	return fmt.Sprintf("%v$%v", prefix, e.numTmp)
+}
+
+func (e *topologyExplainer) addNode(name string, nodeType interface{}, kind string, temporary bool) {
+	node := data.Map{
+		"name":      data.String(name),
+		"node_type": data.String(fmt.Sprint(nodeType)),
+		"kind":      data.String(kind),
+	}
+	if temporary {
+		node["temporary"] = data.True
+	}
+	e.nodes = append(e.nodes, node)
+}
+
+func (e *topologyExplainer) addEdge(from, to, inputName string) {
+	e.edges = append(e.edges, data.Map{
+		"from":       data.String(from),
+		"to":         data.String(to),
+		"input_name": data.String(inputName),
+	})
+}
+
+// checkName returns an error if a node with the given name cannot be
+// added to the topology.
+func (e *topologyExplainer) checkName(name string) error {
+	if err := core.ValidateSymbol(name); err != nil {
+		return err
+	}
+	if _, err := e.tb.topology.Node(name); err == nil {
+		return fmt.Errorf("the name is already used: %v", name)
+	}
+	return nil
+}
+
+// explainSelectUnion explains the nodes created by AddSelectUnionStmt.
+func (e *topologyExplainer) explainSelectUnion(selects []parser.SelectStmt) error {
+	sinkName := e.temporaryName("sensorbee_tmp_select_sink_")
+	e.addNode(sinkName, core.NTSink, "select_result", true)
+	for _, sel := range selects {
+		name := e.temporaryName("sensorbee_tmp_")
+		if err := e.explainSelect(name, true, sel, "", nil); err != nil {
+			return err
+		}
+		e.addEdge(name, sinkName, name)
+	}
+	return nil
+}
+
+// explainSelect explains the nodes created by createStreamAsSelectStmt.
+// errorStream is the stream of ON ERROR INSERT INTO, if any. When prev
+// isn't nil, it explains how createOrReplaceStreamAsSelectStmt replaces
+// prev with a box executing the statement.
+func (e *topologyExplainer) explainSelect(name string, temporary bool, stmt parser.SelectStmt, errorStream string, prev *bqlBox) error {
+	plan, err := execution.Explain(stmt, e.tb.Reg)
+	if err != nil {
+		return err
+	}
+	e.selects = append(e.selects, plan)
+	e.addNode(name, core.NTBox, "bql", temporary)
+	// kept has the inputs of prev, and those used by the statement as
+	// well are set to true
+	kept := map[string]bool{}
+	if prev != nil {
+		e.nodes[len(e.nodes)-1].(data.Map)["replaced"] = data.True
+		for _, in := range prev.inputs {
+			kept[in] = false
+		}
+	}
+
+	// the sources of the previous box are taken over when the tuples are
+	// written to the same streams
+	if lateName := lateTuplesStream(&stmt); lateName != "" &&
+		(prev == nil || lateName != lateTuplesStream(prev.stmt)) {
+		if err := e.checkName(lateName); err != nil {
+			return err
+		}
+		e.addNode(lateName, core.NTSource, "late_tuples", false)
+	}
+	if errorStream != "" && (prev == nil || errorStream != prev.errorStream) {
+		if err := e.checkName(errorStream); err != nil {
+			return err
+		}
+		e.addNode(errorStream, core.NTSource, "error_tuples", false)
+	}
+
+	connected := map[string]bool{}
+	for _, rel := range stmt.Relations {
+		switch rel.Type {
+		case parser.ActualStream:
+			if connected[rel.Name] {
+				continue
+			}
+			if rel.Name == name {
+				return fmt.Errorf("stream '%v' cannot refer to itself", name)
+			}
+			if _, err := e.tb.topology.Node(rel.Name); err != nil {
+				return err
+			}
+			connected[rel.Name] = true
+			if _, ok := kept[rel.Name]; ok {
+				if err := checkKeptInput(prev.stmt, &stmt, rel.Name, name); err != nil {
+					return err
+				}
+				kept[rel.Name] = true
+				continue
+			}
+			e.addEdge(rel.Name, name, rel.Name)
+
+		case parser.UDSFStream:
+			// validate the parameters and the UDSF name in the
+			// same way as createUDSFStream, but don't create it
+			for _, expr := range rel.Params {
+				if _, err := execution.EvaluateFoldable(expr, e.tb.Reg); err != nil {
+					return err
+				}
+			}
+			if _, err := e.tb.UDSFCreators.Lookup(rel.Name, len(rel.Params)); err != nil {
+				return err
+			}
+			alias := rel.Alias
+			if alias == "" {
+				alias = rel.Name
+			}
+			udsfName := e.temporaryName("sensorbee_tmp_udsf_")
+			e.addNode(udsfName, "udsf", rel.Name, true)
+			e.addEdge(udsfName, name, fmt.Sprintf("%s/%s", rel.Name, alias))
+
+		default:
+			return fmt.Errorf("input stream of type %s not implemented",
+				rel.Type)
+		}
+	}
+
+	if prev != nil {
+		e.removedEdges = data.Array{}
+		for _, in := range prev.inputs {
+			if !kept[in] {
+				e.removedEdges = append(e.removedEdges, data.Map{
+					"from": data.String(in),
+					"to":   data.String(name),
+				})
+			}
+		}
+	}
+	return nil
+}
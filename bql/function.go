@@ -0,0 +1,260 @@
+package bql
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/execution"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"strings"
+	"sync"
+	"time"
+)
+
+// bqlFunction is a UDF defined by a CREATE FUNCTION statement. Its body
+// is evaluated on a Map having the names of the parameters as keys.
+type bqlFunction struct {
+	stmt parser.CreateFunctionStmt
+	body execution.FlatExpression
+	reg  udf.FunctionRegistry
+
+	// evals has compiled bodies which aren't used by any call. Evaluators
+	// aren't safe for concurrent use, so concurrent calls, e.g. from
+	// different boxes, evaluate different ones.
+	evals sync.Pool
+
+	// calls has the lower case names of the functions called in the body.
+	calls []string
+}
+
+var (
+	_ udf.UDF = &bqlFunction{}
+)
+
+// newBQLFunction compiles the body of the function. Functions called in
+// the body are looked up in the given registry, so the body cannot call
+// the function itself, directly or through other functions.
+func newBQLFunction(stmt parser.CreateFunctionStmt, reg udf.FunctionRegistry) (*bqlFunction, error) {
+	name := strings.ToLower(string(stmt.Name))
+	params := map[string]bool{}
+	for _, p := range stmt.Params {
+		if params[p] {
+			return nil, fmt.Errorf("parameter '%s' of function '%s' is declared more than once", p, stmt.Name)
+		}
+		params[p] = true
+	}
+
+	f := &bqlFunction{
+		stmt: stmt,
+	}
+	if err := f.collectCalls(stmt.Body); err != nil {
+		return nil, err
+	}
+	// functions called in the body must already exist, so the registry
+	// can only be checked for indirect recursion when it lists functions
+	if lister, ok := reg.(udf.FunctionLister); ok {
+		funcs, err := lister.List()
+		if err != nil {
+			return nil, err
+		}
+		if path := findCall(name, f.calls, funcs, nil); path != nil {
+			if len(path) == 1 {
+				return nil, fmt.Errorf("function '%s' cannot call itself", stmt.Name)
+			}
+			return nil, fmt.Errorf("function '%s' cannot be called recursively via %s",
+				stmt.Name, strings.Join(path[:len(path)-1], " -> "))
+		}
+	}
+
+	var err error
+	f.body, err = execution.ParserExprToFlatExpr(stmt.Body, reg)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range f.body.Columns() {
+		if c.Relation != "" {
+			return nil, fmt.Errorf("function '%s' cannot refer to stream '%s'", stmt.Name, c.Relation)
+		}
+		path, err := data.CompilePath(c.Column)
+		if err != nil {
+			return nil, err
+		}
+		if keys, _ := data.MapKeys(path); len(keys) == 0 || !params[keys[0]] {
+			return nil, fmt.Errorf("'%s' is not a parameter of function '%s'", c.Column, stmt.Name)
+		}
+	}
+	f.reg = reg
+	eval, err := execution.ExpressionToEvaluator(f.body, reg)
+	if err != nil {
+		return nil, err
+	}
+	f.evals.Put(eval)
+	return f, nil
+}
+
+// collectCalls adds the names of the functions called in the expression
+// to f.calls. It also checks that the expression doesn't depend on an
+// input row.
+func (f *bqlFunction) collectCalls(expr parser.Expression) error {
+	var children []parser.Expression
+	switch e := expr.(type) {
+	case parser.RowMeta:
+		return fmt.Errorf("function '%s' cannot use ts()", f.stmt.Name)
+	case parser.Wildcard:
+		return fmt.Errorf("function '%s' cannot use a wildcard", f.stmt.Name)
+	case parser.AliasAST:
+		children = []parser.Expression{e.Expr}
+	case parser.BinaryOpAST:
+		children = []parser.Expression{e.Left, e.Right}
+	case parser.UnaryOpAST:
+		children = []parser.Expression{e.Expr}
+	case parser.BetweenAST:
+		children = []parser.Expression{e.Expr, e.Lower, e.Upper}
+	case parser.TypeCastAST:
+		children = []parser.Expression{e.Expr}
+	case parser.FuncAppAST:
+		f.calls = append(f.calls, strings.ToLower(string(e.Function)))
+		children = e.Expressions
+	case parser.AnalyticFuncAST:
+		f.calls = append(f.calls, strings.ToLower(string(e.Function)))
+		children = e.Expressions
+	case parser.ArrayAST:
+		children = e.Expressions
+	case parser.LambdaAST:
+		children = []parser.Expression{e.Body}
+	case parser.MapAST:
+		for _, pair := range e.Entries {
+			children = append(children, pair.Value)
+		}
+	case parser.ExpressionCaseAST:
+		children = append(children, e.Expr)
+		children = append(children, caseChildren(e.ConditionCaseAST)...)
+	case parser.ConditionCaseAST:
+		children = caseChildren(e)
+	}
+	for _, c := range children {
+		if err := f.collectCalls(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func caseChildren(c parser.ConditionCaseAST) []parser.Expression {
+	var children []parser.Expression
+	for _, check := range c.Checks {
+		children = append(children, check.When, check.Then)
+	}
+	if c.Else != nil {
+		children = append(children, c.Else)
+	}
+	return children
+}
+
+// findCall returns the names of the functions through which the function
+// having the given name would be called from the calls. It returns nil
+// if the function isn't called.
+func findCall(name string, calls []string, funcs map[string]udf.UDF, visited map[string]bool) []string {
+	if visited == nil {
+		visited = map[string]bool{}
+	}
+	for _, c := range calls {
+		if c == name {
+			return []string{c}
+		}
+		if visited[c] {
+			continue
+		}
+		visited[c] = true
+		f, ok := funcs[c].(*bqlFunction)
+		if !ok {
+			continue
+		}
+		if path := findCall(name, f.calls, funcs, visited); path != nil {
+			return append([]string{c}, path...)
+		}
+	}
+	return nil
+}
+
+func (f *bqlFunction) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if len(args) != len(f.stmt.Params) {
+		return nil, fmt.Errorf("function '%s' takes %d arguments but %d were given",
+			f.stmt.Name, len(f.stmt.Params), len(args))
+	}
+	input := make(data.Map, len(args)+1)
+	for i, p := range f.stmt.Params {
+		input[p] = args[i]
+	}
+	// now() in the body returns the time when the function is called
+	input[":meta:NOW"] = data.Timestamp(time.Now().In(time.UTC))
+
+	eval, ok := f.evals.Get().(execution.Evaluator)
+	if !ok {
+		var err error
+		if eval, err = execution.ExpressionToEvaluator(f.body, f.reg); err != nil {
+			return nil, err
+		}
+	}
+	defer f.evals.Put(eval)
+	return eval.Eval(input)
+}
+
+func (f *bqlFunction) Accept(arity int) bool {
+	return arity == len(f.stmt.Params)
+}
+
+func (f *bqlFunction) IsAggregationParameter(k int) bool {
+	return false
+}
+
+// createFunction registers a function defined by the statement.
+func (tb *TopologyBuilder) createFunction(stmt *parser.CreateFunctionStmt) error {
+	if err := core.ValidateSymbol(string(stmt.Name)); err != nil {
+		return fmt.Errorf("invalid name for function: %s", err.Error())
+	}
+	if _, err := tb.Reg.Lookup(string(stmt.Name), len(stmt.Params)); !core.IsNotExist(err) {
+		return fmt.Errorf("there is already a function named '%s'", stmt.Name)
+	}
+	f, err := newBQLFunction(*stmt, tb.Reg)
+	if err != nil {
+		return err
+	}
+	return tb.Reg.Register(string(stmt.Name), f)
+}
+
+// dropFunction removes a function defined by a CREATE FUNCTION statement.
+// Functions called by other such functions cannot be dropped.
+func (tb *TopologyBuilder) dropFunction(stmt *parser.DropFunctionStmt) error {
+	unregisterer, ok := tb.Reg.(udf.FunctionUnregisterer)
+	if !ok {
+		return fmt.Errorf("the function registry cannot remove functions")
+	}
+	lister, ok := tb.Reg.(udf.FunctionLister)
+	if !ok {
+		return fmt.Errorf("the function registry cannot list functions")
+	}
+	funcs, err := lister.List()
+	if err != nil {
+		return err
+	}
+	name := strings.ToLower(string(stmt.Name))
+	f, ok := funcs[name]
+	if !ok {
+		return core.NotExistError(fmt.Errorf("function '%s' is unknown", stmt.Name))
+	}
+	if _, ok := f.(*bqlFunction); !ok {
+		return fmt.Errorf("function '%s' wasn't created by a CREATE FUNCTION statement and cannot be dropped", stmt.Name)
+	}
+	for n, other := range funcs {
+		if o, ok := other.(*bqlFunction); ok {
+			for _, c := range o.calls {
+				if c == name {
+					return fmt.Errorf("function '%s' is called by function '%s'", stmt.Name, n)
+				}
+			}
+		}
+	}
+	return unregisterer.Unregister(name)
+}
@@ -0,0 +1,237 @@
+package bql
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sync"
+	"testing"
+)
+
+func TestCreateFunctionStmt(t *testing.T) {
+	Convey("Given a BQL TopologyBuilder", t, func() {
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+
+		eval := func(expr string) (data.Value, error) {
+			istmt, _, err := parser.New().ParseStmt("EVAL " + expr)
+			So(err, ShouldBeNil)
+			stmt := istmt.(parser.EvalStmt)
+			return tb.RunEvalStmt(&stmt)
+		}
+
+		Convey("When creating a function", func() {
+			So(addBQLToTopology(tb, `CREATE FUNCTION c_to_f(x) AS x * 9.0 / 5 + 32`), ShouldBeNil)
+
+			Convey("Then it should be callable from expressions", func() {
+				v, err := eval("c_to_f(100)")
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, data.Float(212))
+			})
+
+			Convey("Then it should be callable from other functions", func() {
+				So(addBQLToTopology(tb, `CREATE FUNCTION warm(c) AS c_to_f(c) > 80`), ShouldBeNil)
+				v, err := eval("warm(30)")
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, data.True)
+
+				Convey("And it cannot be dropped while the other function uses it", func() {
+					err := addBQLToTopology(tb, `DROP FUNCTION c_to_f`)
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldEqual, "function 'c_to_f' is called by function 'warm'")
+				})
+			})
+
+			Convey("Then it should be callable from a SELECT statement", func() {
+				So(addBQLToTopology(tb, `
+					CREATE PAUSED SOURCE s TYPE dummy;
+					CREATE STREAM b AS SELECT ISTREAM c_to_f(int) AS f FROM s [RANGE 1 TUPLES];
+					CREATE SINK snk TYPE collector;
+					INSERT INTO snk FROM b;
+					RESUME SOURCE s;`), ShouldBeNil)
+				sin, err := dt.Sink("snk")
+				So(err, ShouldBeNil)
+				si := sin.Sink().(*tupleCollectorSink)
+				si.Wait(4)
+				So(si.get(0).Data["f"], ShouldEqual, data.Float(33.8))
+				So(si.get(3).Data["f"], ShouldEqual, data.Float(39.2))
+			})
+
+			Convey("Then it should be callable concurrently", func() {
+				So(addBQLToTopology(tb, `CREATE FUNCTION dist(x, y) AS abs(x - y) + abs(y - x)`), ShouldBeNil)
+				f, err := tb.Reg.Lookup("dist", 2)
+				So(err, ShouldBeNil)
+
+				ctx := dt.Context()
+				wrong := make([]data.Value, 8)
+				wg := sync.WaitGroup{}
+				for i := range wrong {
+					wg.Add(1)
+					go func(i int) {
+						defer wg.Done()
+						for j := 0; j < 1000; j++ {
+							d := int64(i - j)
+							if d < 0 {
+								d = -d
+							}
+							v, err := f.Call(ctx, data.Int(i), data.Int(j))
+							if err != nil || v != data.Int(2*d) {
+								wrong[i] = v
+								return
+							}
+						}
+					}(i)
+				}
+				wg.Wait()
+				for _, v := range wrong {
+					So(v, ShouldBeNil)
+				}
+			})
+
+			Convey("Then call sites in the body shouldn't be shared by concurrent calls", func() {
+				// regexp_replace caches the pattern compiled at each call site
+				So(addBQLToTopology(tb, `CREATE FUNCTION strip(s, p) AS regexp_replace(s, p, "", "g")`), ShouldBeNil)
+				f, err := tb.Reg.Lookup("strip", 2)
+				So(err, ShouldBeNil)
+
+				ctx := dt.Context()
+				patterns := []string{"[0-9]", "[a-z]"}
+				expected := []data.Value{data.String("abc"), data.String("123")}
+				wrong := make([]data.Value, 8)
+				wg := sync.WaitGroup{}
+				for i := range wrong {
+					wg.Add(1)
+					go func(i int) {
+						defer wg.Done()
+						for j := 0; j < 1000; j++ {
+							k := (i + j) % 2
+							v, err := f.Call(ctx, data.String("a1b2c3"), data.String(patterns[k]))
+							if err != nil || v != expected[k] {
+								wrong[i] = v
+								return
+							}
+						}
+					}(i)
+				}
+				wg.Wait()
+				for _, v := range wrong {
+					So(v, ShouldBeNil)
+				}
+			})
+
+			Convey("Then calling it with a wrong number of arguments should fail", func() {
+				_, err := eval("c_to_f(1, 2)")
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "not 2-ary")
+			})
+
+			Convey("Then SHOW FUNCTIONS should show its definition", func() {
+				istmt, _, err := parser.New().ParseStmt("SHOW FUNCTIONS")
+				So(err, ShouldBeNil)
+				stmt := istmt.(parser.ShowStmt)
+				v, err := tb.RunShowStmt(&stmt)
+				So(err, ShouldBeNil)
+				So(v, ShouldContain, data.Map{
+					"name":       data.String("c_to_f"),
+					"kind":       data.String("udf"),
+					"definition": data.String("CREATE FUNCTION c_to_f(x) AS (x * 9) / 5 + 32"),
+				})
+			})
+
+			Convey("Then creating it again should fail", func() {
+				err := addBQLToTopology(tb, `CREATE FUNCTION c_to_f(y) AS y`)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "there is already a function named 'c_to_f'")
+			})
+
+			Convey("Then dropping it should remove the function", func() {
+				So(addBQLToTopology(tb, `DROP FUNCTION c_to_f`), ShouldBeNil)
+				_, err := eval("c_to_f(100)")
+				So(err, ShouldNotBeNil)
+
+				Convey("And dropping it again should fail", func() {
+					err := addBQLToTopology(tb, `DROP FUNCTION c_to_f`)
+					So(core.IsNotExist(err), ShouldBeTrue)
+				})
+			})
+		})
+
+		Convey("When creating a function using now()", func() {
+			So(addBQLToTopology(tb, `CREATE FUNCTION is_past(t) AS t < now()`), ShouldBeNil)
+
+			Convey("Then now() should return the current time", func() {
+				v, err := eval(`is_past("2015-01-01T00:00:00Z"::timestamp)`)
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, data.True)
+			})
+		})
+
+		Convey("When creating invalid functions", func() {
+			cases := map[string]string{
+				`CREATE FUNCTION f(x) AS f(x) + 1`:   "function 'f' cannot call itself",
+				`CREATE FUNCTION f(x) AS y + 1`:      "'y' is not a parameter of function 'f'",
+				`CREATE FUNCTION f(x) AS s:x + 1`:    "function 'f' cannot refer to stream 's'",
+				`CREATE FUNCTION f(x, x) AS x`:       "parameter 'x' of function 'f' is declared more than once",
+				`CREATE FUNCTION f(x) AS ts()`:       "function 'f' cannot use ts()",
+				`CREATE FUNCTION f(x) AS count(x)`:   "you cannot use aggregate function 'count' in a flat expression",
+				`CREATE FUNCTION f(x) AS unknown(x)`: "function 'unknown' is unknown",
+				`CREATE FUNCTION str(x) AS x`:        "there is already a function named 'str'",
+				`CREATE FUNCTION select(x) AS x`:     "invalid name for function: select is a reserved word and cannot be used as a name",
+			}
+			for stmt, msg := range cases {
+				stmt, msg := stmt, msg
+				Convey("Then "+stmt+" should fail", func() {
+					err := addBQLToTopology(tb, stmt)
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldEqual, msg)
+				})
+			}
+		})
+
+		Convey("When creating functions calling each other", func() {
+			So(addBQLToTopology(tb, `
+				CREATE FUNCTION g(x) AS x + 1;
+				CREATE FUNCTION h(x) AS g(x) * 2;`), ShouldBeNil)
+			_, err := newBQLFunction(parser.CreateFunctionStmt{
+				Name:   "g",
+				Params: []string{"x"},
+				Body:   parser.FuncAppAST{Function: "h", ExpressionsAST: parser.ExpressionsAST{[]parser.Expression{parser.RowValue{"", "x"}}}},
+			}, tb.Reg)
+
+			Convey("Then the recursion should be detected", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "function 'g' cannot be called recursively via h")
+			})
+		})
+
+		Convey("When the function registry can neither list nor remove functions", func() {
+			tb.Reg = struct{ udf.FunctionManager }{tb.Reg}
+
+			Convey("Then functions can still be created and called", func() {
+				So(addBQLToTopology(tb, `CREATE FUNCTION c_to_f(x) AS x * 9.0 / 5 + 32`), ShouldBeNil)
+				v, err := eval("c_to_f(100)")
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, data.Float(212))
+			})
+
+			Convey("Then dropping a function should fail", func() {
+				So(addBQLToTopology(tb, `CREATE FUNCTION c_to_f(x) AS x * 9.0 / 5 + 32`), ShouldBeNil)
+				So(addBQLToTopology(tb, `DROP FUNCTION c_to_f`), ShouldNotBeNil)
+			})
+		})
+
+		Convey("When dropping a function not created by CREATE FUNCTION", func() {
+			err := addBQLToTopology(tb, `DROP FUNCTION str`)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
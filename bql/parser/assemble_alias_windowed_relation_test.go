@@ -12,7 +12,7 @@ func TestAssembleAliasedStreamWindow(t *testing.T) {
 		Convey("When the stack contains two correct items", func() {
 			ps.PushComponent(0, 6, Raw{"PRE"})
 			ps.PushComponent(6, 7, StreamWindowAST{Stream{ActualStream, "a", nil},
-				IntervalAST{FloatLiteral{2}, Seconds}, 2, UnspecifiedSheddingOption})
+				IntervalAST{FloatLiteral{2}, Seconds}, 2, UnspecifiedSheddingOption, SlideAST{}, LatenessAST{}, SessionAST{}})
 			ps.PushComponent(7, 8, Identifier("out"))
 			ps.AssembleAliasedStreamWindow()
 
@@ -30,7 +30,7 @@ func TestAssembleAliasedStreamWindow(t *testing.T) {
 						comp := top.comp.(AliasedStreamWindowAST)
 						So(comp.StreamWindowAST, ShouldResemble,
 							StreamWindowAST{Stream{ActualStream, "a", nil},
-								IntervalAST{FloatLiteral{2}, Seconds}, 2, UnspecifiedSheddingOption})
+								IntervalAST{FloatLiteral{2}, Seconds}, 2, UnspecifiedSheddingOption, SlideAST{}, LatenessAST{}, SessionAST{}})
 						So(comp.Alias, ShouldEqual, "out")
 					})
 				})
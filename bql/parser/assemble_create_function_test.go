@@ -0,0 +1,125 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleCreateFunction(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+		Convey("When the stack contains the correct CREATE FUNCTION items", func() {
+			ps.PushComponent(2, 4, FuncName("f"))
+			ps.PushComponent(5, 6, Identifier("a"))
+			ps.PushComponent(7, 8, Identifier("b"))
+			ps.AssembleFunctionParams(5, 8)
+			ps.PushComponent(12, 13, NewRowValue("a"))
+			ps.AssembleCreateFunction()
+
+			Convey("Then AssembleCreateFunction transforms them into one item", func() {
+				So(ps.Len(), ShouldEqual, 1)
+
+				Convey("And that item is a CreateFunctionStmt", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 2)
+					So(top.end, ShouldEqual, 13)
+					So(top.comp, ShouldHaveSameTypeAs, CreateFunctionStmt{})
+
+					Convey("And it contains the previously pushed data", func() {
+						comp := top.comp.(CreateFunctionStmt)
+						So(comp.Name, ShouldEqual, "f")
+						So(comp.Params, ShouldResemble, []string{"a", "b"})
+						So(comp.Body, ShouldResemble, RowValue{"", "a"})
+					})
+				})
+			})
+		})
+
+		Convey("When the function doesn't have parameters", func() {
+			ps.PushComponent(2, 4, FuncName("f"))
+			ps.AssembleFunctionParams(5, 5)
+			ps.PushComponent(10, 11, NumericLiteral{1})
+			ps.AssembleCreateFunction()
+
+			Convey("Then the statement should have an empty parameter list", func() {
+				So(ps.Len(), ShouldEqual, 1)
+				comp := ps.Peek().comp.(CreateFunctionStmt)
+				So(comp.Params, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When the stack contains a wrong item", func() {
+			ps.PushComponent(2, 4, StreamIdentifier("f")) // must be FuncName
+			ps.AssembleFunctionParams(5, 5)
+			ps.PushComponent(10, 11, NumericLiteral{1})
+
+			Convey("Then AssembleCreateFunction panics", func() {
+				So(ps.AssembleCreateFunction, ShouldPanic)
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When doing a full CREATE FUNCTION", func() {
+			p.Buffer = "CREATE FUNCTION c_to_f(x) AS x * 1.8 + 32"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateFunctionStmt{})
+				comp := top.(CreateFunctionStmt)
+
+				So(comp.Name, ShouldEqual, "c_to_f")
+				So(comp.Params, ShouldResemble, []string{"x"})
+				So(comp.Body, ShouldHaveSameTypeAs, BinaryOpAST{})
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When doing a CREATE FUNCTION with multiple parameters", func() {
+			p.Buffer = "CREATE FUNCTION bucket (v , size) AS (v / size) * size"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				comp := p.parseStack.Peek().comp.(CreateFunctionStmt)
+				So(comp.Name, ShouldEqual, "bucket")
+				So(comp.Params, ShouldResemble, []string{"v", "size"})
+
+				Convey("And String() should return a normalized statement", func() {
+					So(comp.String(), ShouldEqual, "CREATE FUNCTION bucket(v, size) AS (v / size) * size")
+				})
+			})
+		})
+
+		Convey("When doing a CREATE FUNCTION without parameters", func() {
+			p.Buffer = "CREATE FUNCTION one() AS 1"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				comp := p.parseStack.Peek().comp.(CreateFunctionStmt)
+				So(comp.Params, ShouldBeEmpty)
+				So(comp.String(), ShouldEqual, p.Buffer)
+			})
+		})
+	})
+}
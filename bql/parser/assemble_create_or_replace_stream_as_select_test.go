@@ -0,0 +1,106 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleCreateOrReplaceStreamAsSelect(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+		Convey("When the stack contains the correct CREATE OR REPLACE STREAM items", func() {
+			sel := SelectStmt{EmitterAST: EmitterAST{Istream, nil}}
+			ps.PushComponent(2, 4, StreamIdentifier("x"))
+			ps.AssembleSchema(4, 4)
+			ps.PushComponent(4, 10, sel)
+			ps.PushComponent(30, 31, StreamIdentifier("e"))
+			ps.AssembleOnError(10, 31)
+			ps.AssembleCreateOrReplaceStreamAsSelect()
+
+			Convey("Then AssembleCreateOrReplaceStreamAsSelect transforms them into one item", func() {
+				So(ps.Len(), ShouldEqual, 1)
+
+				Convey("And that item is a CreateOrReplaceStreamAsSelectStmt", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 2)
+					So(top.end, ShouldEqual, 31)
+					So(top.comp, ShouldHaveSameTypeAs, CreateOrReplaceStreamAsSelectStmt{})
+
+					Convey("And it contains the previously pushed data", func() {
+						comp := top.comp.(CreateOrReplaceStreamAsSelectStmt)
+						So(comp.Name, ShouldEqual, "x")
+						So(comp.Select, ShouldResemble, sel)
+						So(comp.OnError.Stream, ShouldEqual, "e")
+					})
+				})
+			})
+		})
+
+		Convey("When the stack contains a wrong item", func() {
+			ps.PushComponent(2, 4, Raw{"x"})
+			ps.PushComponent(4, 10, SelectStmt{})
+
+			Convey("Then AssembleCreateOrReplaceStreamAsSelect panics", func() {
+				So(ps.AssembleCreateOrReplaceStreamAsSelect, ShouldPanic)
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When doing a full CREATE OR REPLACE STREAM", func() {
+			p.Buffer = "CREATE OR REPLACE STREAM x AS SELECT ISTREAM a, b FROM c [RANGE 3 TUPLES] WHERE d > 1"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateOrReplaceStreamAsSelectStmt{})
+				comp := top.(CreateOrReplaceStreamAsSelectStmt)
+
+				So(comp.Name, ShouldEqual, "x")
+				So(comp.Select.EmitterType, ShouldEqual, Istream)
+				So(comp.Select.Projections, ShouldHaveLength, 2)
+				So(comp.Select.Relations, ShouldHaveLength, 1)
+				So(comp.Select.Relations[0].Name, ShouldEqual, "c")
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When doing a CREATE OR REPLACE STREAM with ON ERROR", func() {
+			p.Buffer = "CREATE OR REPLACE STREAM x AS SELECT ISTREAM a FROM c [RANGE 3 TUPLES] ON ERROR INSERT INTO e"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				comp := p.parseStack.Peek().comp.(CreateOrReplaceStreamAsSelectStmt)
+				So(comp.OnError.Stream, ShouldEqual, "e")
+				So(comp.String(), ShouldEqual, p.Buffer)
+			})
+		})
+
+		Convey("When doing a CREATE OR REPLACE STREAM with UNION ALL", func() {
+			p.Buffer = "CREATE OR REPLACE STREAM x AS SELECT ISTREAM a FROM c [RANGE 3 TUPLES] " +
+				"UNION ALL SELECT ISTREAM a FROM d [RANGE 3 TUPLES]"
+			p.Init()
+
+			Convey("Then parsing should fail", func() {
+				err := p.Parse()
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -12,6 +12,7 @@ func TestAssembleCreateSource(t *testing.T) {
 		Convey("When the stack contains the correct CREATE SOURCE items", func() {
 			ps.PushComponent(0, 2, Yes)
 			ps.PushComponent(2, 4, StreamIdentifier("a"))
+			ps.AssembleSchema(4, 4)
 			ps.PushComponent(4, 6, SourceSinkType("b"))
 			ps.PushComponent(6, 8, SourceSinkParamAST{"c", data.String("d")})
 			ps.PushComponent(8, 10, SourceSinkParamAST{"e", data.String("f")})
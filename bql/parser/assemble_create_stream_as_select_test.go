@@ -10,9 +10,11 @@ func TestAssembleCreateStreamAsSelect(t *testing.T) {
 		ps := parseStack{}
 		Convey("When the stack contains the correct CREATE STREAM items", func() {
 			ps.PushComponent(2, 4, StreamIdentifier("x"))
+			ps.AssembleSchema(4, 4)
 			ps.PushComponent(4, 6, Istream)
 			ps.AssembleEmitterOptions(6, 6)
 			ps.AssembleEmitter()
+			ps.AssembleDistinct(6, 6)
 			ps.PushComponent(6, 7, RowValue{"", "a"})
 			ps.PushComponent(7, 8, RowValue{"", "b"})
 			ps.PushComponent(8, 9, Identifier("y"))
@@ -20,6 +22,8 @@ func TestAssembleCreateStreamAsSelect(t *testing.T) {
 			ps.AssembleProjections(6, 9)
 			ps.PushComponent(10, 11, Stream{ActualStream, "c", nil})
 			ps.PushComponent(11, 12, IntervalAST{FloatLiteral{3}, Tuples})
+			ps.EnsureSlideSpec(12, 12)
+			ps.EnsureLatenessSpec(12, 12)
 			ps.PushComponent(12, 13, NumericLiteral{2})
 			ps.EnsureCapacitySpec(12, 13)
 			ps.PushComponent(13, 14, DropOldest)
@@ -30,6 +34,8 @@ func TestAssembleCreateStreamAsSelect(t *testing.T) {
 			ps.PushComponent(16, 17, NumericLiteral{2})
 			ps.PushComponent(17, 18, Seconds)
 			ps.AssembleInterval()
+			ps.EnsureSlideSpec(18, 18)
+			ps.EnsureLatenessSpec(18, 18)
 			ps.EnsureCapacitySpec(18, 18)
 			ps.EnsureSheddingSpec(18, 18)
 			ps.AssembleStreamWindow()
@@ -44,7 +50,10 @@ func TestAssembleCreateStreamAsSelect(t *testing.T) {
 			ps.AssembleGrouping(21, 23)
 			ps.PushComponent(23, 24, RowValue{"", "h"})
 			ps.AssembleHaving(23, 24)
+			ps.AssembleOrderBy(24, 24)
+			ps.AssembleLimit(24, 24)
 			ps.AssembleSelect()
+			ps.AssembleOnError(24, 24)
 			ps.AssembleCreateStreamAsSelect()
 
 			Convey("Then AssembleCreateStreamAsSelect transforms them into one item", func() {
@@ -98,6 +107,7 @@ func TestAssembleCreateStreamAsSelect(t *testing.T) {
 
 		Convey("When the stack contains a wrong item", func() {
 			ps.PushComponent(2, 4, StreamIdentifier("x"))
+			ps.AssembleSchema(4, 4)
 			ps.PushComponent(4, 6, Istream) // must be SELECT in correct stmt
 
 			Convey("Then AssembleCreateStreamAsSelect panics", func() {
@@ -150,6 +160,30 @@ func TestAssembleCreateStreamAsSelect(t *testing.T) {
 				So(comp.GroupList[1], ShouldResemble, RowValue{"", "g"})
 				So(comp.Having, ShouldResemble, RowValue{"", "h"})
 
+				So(cssComp.OnError.Stream, ShouldEqual, "")
+
+				Convey("And String() should return the original statement", func() {
+					So(cssComp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When doing a SELECT with ON ERROR", func() {
+			p.Buffer = `CREATE STREAM x AS SELECT ISTREAM a FROM c [RANGE 3 TUPLES] WHERE e ON ERROR INSERT INTO errors`
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				cssComp := ps.Peek().comp.(CreateStreamAsSelectStmt)
+				So(cssComp.Name, ShouldEqual, "x")
+				So(cssComp.Select.Filter, ShouldResemble, RowValue{"", "e"})
+				So(cssComp.OnError.Stream, ShouldEqual, "errors")
+
 				Convey("And String() should return the original statement", func() {
 					So(cssComp.String(), ShouldEqual, p.Buffer)
 				})
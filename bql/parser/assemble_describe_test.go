@@ -0,0 +1,67 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleDescribe(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+		Convey("When the stack contains a StreamIdentifier", func() {
+			ps.PushComponent(2, 4, Raw{"PRE"})
+			ps.PushComponent(4, 6, StreamIdentifier("a"))
+			ps.AssembleDescribe()
+
+			Convey("Then AssembleDescribe transforms it into one item", func() {
+				So(ps.Len(), ShouldEqual, 2)
+
+				Convey("And that item is a DescribeStmt", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 4)
+					So(top.end, ShouldEqual, 6)
+					So(top.comp, ShouldHaveSameTypeAs, DescribeStmt{})
+
+					Convey("And it contains the previously pushed data", func() {
+						comp := top.comp.(DescribeStmt)
+						So(comp.Name, ShouldEqual, "a")
+					})
+				})
+			})
+		})
+
+		Convey("When the stack does not contain enough items", func() {
+			Convey("Then AssembleDescribe panics", func() {
+				So(ps.AssembleDescribe, ShouldPanic)
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When doing a DESCRIBE", func() {
+			p.Buffer = "DESCRIBE a_1"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, DescribeStmt{})
+				comp := top.(DescribeStmt)
+
+				So(comp.Name, ShouldEqual, "a_1")
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+	})
+}
@@ -252,3 +252,65 @@ func TestAssembleDropState(t *testing.T) {
 		})
 	})
 }
+
+func TestAssembleDropFunction(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+		Convey("When the stack contains the correct DROP FUNCTION items", func() {
+			ps.PushComponent(2, 4, FuncName("a"))
+			ps.AssembleDropFunction()
+
+			Convey("Then AssembleDropFunction transforms them into one item", func() {
+				So(ps.Len(), ShouldEqual, 1)
+
+				Convey("And that item is a DropFunctionStmt", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 2)
+					So(top.end, ShouldEqual, 4)
+					So(top.comp, ShouldHaveSameTypeAs, DropFunctionStmt{})
+
+					Convey("And it contains the previously pushed data", func() {
+						comp := top.comp.(DropFunctionStmt)
+						So(comp.Name, ShouldEqual, "a")
+					})
+				})
+			})
+		})
+
+		Convey("When the stack contains a wrong item", func() {
+			ps.PushComponent(2, 4, StreamIdentifier("a")) // must be FuncName
+
+			Convey("Then AssembleDropFunction panics", func() {
+				So(ps.AssembleDropFunction, ShouldPanic)
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When doing a full DROP FUNCTION", func() {
+			p.Buffer = "DROP FUNCTION a_1"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, DropFunctionStmt{})
+				comp := top.(DropFunctionStmt)
+
+				So(comp.Name, ShouldEqual, "a_1")
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+	})
+}
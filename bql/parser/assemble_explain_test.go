@@ -0,0 +1,125 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleExplain(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+		Convey("When the stack contains a statement", func() {
+			ps.PushComponent(2, 4, Raw{"PRE"})
+			ps.PushComponent(4, 6, EvalStmt{RowValue{"", "a"}, nil})
+			ps.AssembleExplain()
+
+			Convey("Then AssembleExplain transforms it into one item", func() {
+				So(ps.Len(), ShouldEqual, 2)
+
+				Convey("And that item is an ExplainStmt", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 4)
+					So(top.end, ShouldEqual, 6)
+					So(top.comp, ShouldHaveSameTypeAs, ExplainStmt{})
+
+					Convey("And it contains the previously pushed data", func() {
+						comp := top.comp.(ExplainStmt)
+						So(comp.Stmt, ShouldResemble, EvalStmt{RowValue{"", "a"}, nil})
+					})
+				})
+			})
+		})
+
+		Convey("When the stack does not contain enough items", func() {
+			f := func() { ps.AssembleExplain() }
+			Convey("Then AssembleExplain panics", func() {
+				So(f, ShouldPanic)
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When doing an EXPLAIN of a SELECT statement", func() {
+			p.Buffer = "EXPLAIN SELECT ISTREAM a FROM x [RANGE 1 TUPLES]"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, ExplainStmt{})
+				comp := top.(ExplainStmt)
+
+				So(comp.Stmt, ShouldHaveSameTypeAs, SelectStmt{})
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When doing an EXPLAIN of a CREATE STREAM statement", func() {
+			p.Buffer = "EXPLAIN CREATE STREAM s AS SELECT ISTREAM a FROM x [RANGE 1 TUPLES] " +
+				"UNION ALL SELECT ISTREAM b FROM y [RANGE 1 TUPLES]"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, ExplainStmt{})
+				comp := top.(ExplainStmt)
+
+				So(comp.Stmt, ShouldHaveSameTypeAs, CreateStreamAsSelectUnionStmt{})
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When doing an EXPLAIN of a CREATE OR REPLACE STREAM statement", func() {
+			p.Buffer = "EXPLAIN CREATE OR REPLACE STREAM s AS SELECT ISTREAM a FROM x [RANGE 1 TUPLES]"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, ExplainStmt{})
+				comp := top.(ExplainStmt)
+
+				So(comp.Stmt, ShouldHaveSameTypeAs, CreateOrReplaceStreamAsSelectStmt{})
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When doing an EXPLAIN of a statement that cannot be explained", func() {
+			p.Buffer = "EXPLAIN DROP STREAM s"
+			p.Init()
+
+			Convey("Then parsing should fail", func() {
+				err := p.Parse()
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -128,5 +128,85 @@ func TestAssembleGrouping(t *testing.T) {
 				})
 			})
 		})
+
+		Convey("When selecting with a GROUP BY ROLLUP", func() {
+			p.Buffer = "SELECT ISTREAM a, b, grouping(a, b) GROUP BY ROLLUP(a, b)"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				s := p.parseStack.Peek().comp.(SelectStmt)
+				So(s.GroupList, ShouldResemble, []Expression{RowValue{"", "a"}, RowValue{"", "b"}})
+				So(s.GroupingSets, ShouldResemble, GroupingSetsAST{RollupGroupingSets, nil})
+				So(s.ExpandGroupingSets(), ShouldResemble, [][]int{{0, 1}, {0}, {}})
+
+				Convey("And String() should return the original statement", func() {
+					So(s.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a GROUP BY CUBE", func() {
+			p.Buffer = "SELECT ISTREAM a, b GROUP BY CUBE(a, b)"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				s := p.parseStack.Peek().comp.(SelectStmt)
+				So(s.GroupList, ShouldHaveLength, 2)
+				So(s.GroupingSets, ShouldResemble, GroupingSetsAST{CubeGroupingSets, nil})
+				So(s.ExpandGroupingSets(), ShouldResemble, [][]int{{0, 1}, {0}, {1}, {}})
+
+				Convey("And String() should return the original statement", func() {
+					So(s.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a GROUP BY GROUPING SETS", func() {
+			p.Buffer = "SELECT ISTREAM a, b GROUP BY GROUPING SETS((a, b), (b), (a, b), ())"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				s := p.parseStack.Peek().comp.(SelectStmt)
+				So(s.GroupList, ShouldResemble, []Expression{RowValue{"", "a"}, RowValue{"", "b"}})
+				So(s.GroupingSets, ShouldResemble, GroupingSetsAST{ExplicitGroupingSets,
+					[][]int{{0, 1}, {1}, {0, 1}, {}}})
+				So(s.ExpandGroupingSets(), ShouldResemble, [][]int{{0, 1}, {1}, {0, 1}, {}})
+
+				Convey("And String() should return the original statement", func() {
+					So(s.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When grouping sets are given without parentheses", func() {
+			p.Buffer = "SELECT ISTREAM a, b GROUP BY GROUPING SETS(a, b)"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				s := p.parseStack.Peek().comp.(SelectStmt)
+				So(s.GroupingSets, ShouldResemble, GroupingSetsAST{ExplicitGroupingSets,
+					[][]int{{0}, {1}}})
+
+				Convey("And String() should return a normalized statement", func() {
+					So(s.String(), ShouldEqual, "SELECT ISTREAM a, b GROUP BY GROUPING SETS((a), (b))")
+				})
+			})
+		})
 	})
 }
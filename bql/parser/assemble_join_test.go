@@ -0,0 +1,53 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleJoin(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+
+		Convey("When the stack contains a JoinType, a relation and an expression", func() {
+			ps.PushComponent(0, 4, InnerJoin)
+			ps.PushComponent(5, 8, AliasedStreamWindowAST{
+				StreamWindowAST{Stream{ActualStream, "b", nil}, IntervalAST{FloatLiteral{3}, Tuples},
+					UnspecifiedCapacity, UnspecifiedSheddingOption, SlideAST{}, LatenessAST{}, SessionAST{}}, "",
+			})
+			ps.PushComponent(12, 19, BinaryOpAST{Equal, RowValue{"a", "k"}, RowValue{"b", "k"}})
+			ps.AssembleJoin()
+
+			Convey("Then AssembleJoin replaces them by two items", func() {
+				So(ps.Len(), ShouldEqual, 2)
+
+				Convey("And the top item is a JoinAST", func() {
+					top := ps.Pop()
+					So(top.begin, ShouldEqual, 12)
+					So(top.end, ShouldEqual, 19)
+					So(top.comp, ShouldResemble, JoinAST{InnerJoin,
+						BinaryOpAST{Equal, RowValue{"a", "k"}, RowValue{"b", "k"}}})
+
+					Convey("And the item below is the joined relation", func() {
+						rel := ps.Pop()
+						So(rel.begin, ShouldEqual, 5)
+						So(rel.end, ShouldEqual, 8)
+						So(rel.comp, ShouldHaveSameTypeAs, AliasedStreamWindowAST{})
+						So(rel.comp.(AliasedStreamWindowAST).Name, ShouldEqual, "b")
+					})
+				})
+			})
+		})
+
+		Convey("When the stack does not contain enough items", func() {
+			ps.PushComponent(0, 4, InnerJoin)
+			f := func() {
+				ps.AssembleJoin()
+			}
+
+			Convey("Then AssembleJoin panics", func() {
+				So(f, ShouldPanic)
+			})
+		})
+	})
+}
@@ -0,0 +1,151 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleSchema(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+		Convey("When the stack contains the correct schema field items", func() {
+			ps.PushComponent(1, 2, Identifier("a"))
+			ps.PushComponent(3, 4, Int)
+			ps.PushComponent(4, 5, Yes)
+			ps.AssembleSchemaField()
+			ps.PushComponent(6, 7, Identifier("b.c"))
+			ps.PushComponent(8, 9, Float)
+			ps.PushComponent(9, 9, UnspecifiedKeyword)
+			ps.AssembleSchemaField()
+			ps.AssembleSchema(0, 10)
+
+			Convey("Then AssembleSchema transforms them into one item", func() {
+				So(ps.Len(), ShouldEqual, 1)
+
+				Convey("And that item is a SchemaAST", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 0)
+					So(top.end, ShouldEqual, 10)
+					So(top.comp, ShouldHaveSameTypeAs, SchemaAST{})
+
+					Convey("And it contains the previously pushed data", func() {
+						comp := top.comp.(SchemaAST)
+						So(comp.Fields, ShouldResemble, []SchemaFieldAST{
+							{"a", Int, Yes},
+							{"b.c", Float, UnspecifiedKeyword},
+						})
+					})
+				})
+			})
+		})
+
+		Convey("When the stack doesn't contain any schema field", func() {
+			ps.PushComponent(0, 2, StreamIdentifier("a"))
+			ps.AssembleSchema(2, 2)
+
+			Convey("Then AssembleSchema pushes an empty SchemaAST", func() {
+				So(ps.Len(), ShouldEqual, 2)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, SchemaAST{})
+				So(top.(SchemaAST).Fields, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When the stack contains a wrong item", func() {
+			ps.PushComponent(1, 2, Identifier("a"))
+			ps.PushComponent(3, 4, Raw{"INT"}) // must be Type
+			ps.PushComponent(4, 5, Yes)
+
+			Convey("Then AssembleSchemaField panics", func() {
+				So(ps.AssembleSchemaField, ShouldPanic)
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When doing a CREATE SOURCE with a schema", func() {
+			p.Buffer = `CREATE SOURCE s (a INT NOT NULL, pos.x FLOAT, pos["y"] FLOAT, tags ARRAY) TYPE t`
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateSourceStmt{})
+				comp := top.(CreateSourceStmt)
+
+				So(comp.Name, ShouldEqual, "s")
+				So(comp.Type, ShouldEqual, "t")
+				So(comp.Schema.Fields, ShouldResemble, []SchemaFieldAST{
+					{"a", Int, Yes},
+					{"pos.x", Float, UnspecifiedKeyword},
+					{`pos["y"]`, Float, UnspecifiedKeyword},
+					{"tags", Array, UnspecifiedKeyword},
+				})
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When doing a CREATE STREAM with a schema", func() {
+			p.Buffer = `CREATE STREAM s (a STRING NOT NULL) AS SELECT ISTREAM a FROM t [RANGE 1 TUPLES]`
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt)
+
+				So(comp.Name, ShouldEqual, "s")
+				So(comp.Schema.Fields, ShouldResemble, []SchemaFieldAST{
+					{"a", String, Yes},
+				})
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When doing a CREATE OR REPLACE STREAM with a schema", func() {
+			p.Buffer = `CREATE OR REPLACE STREAM s (a INT) AS SELECT ISTREAM a FROM t [RANGE 1 TUPLES]`
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				comp := p.parseStack.Peek().comp.(CreateOrReplaceStreamAsSelectStmt)
+				So(comp.Schema.Fields, ShouldResemble, []SchemaFieldAST{
+					{"a", Int, UnspecifiedKeyword},
+				})
+				So(comp.String(), ShouldEqual, p.Buffer)
+			})
+		})
+
+		Convey("When a schema has no field", func() {
+			p.Buffer = `CREATE SOURCE s () TYPE t`
+			p.Init()
+
+			Convey("Then parsing should fail", func() {
+				So(p.Parse(), ShouldNotBeNil)
+			})
+		})
+	})
+}
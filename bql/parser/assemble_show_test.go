@@ -0,0 +1,86 @@
+package parser
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleShow(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+		Convey("When the stack contains a ShowTarget", func() {
+			ps.PushComponent(2, 4, Raw{"PRE"})
+			ps.PushComponent(4, 6, ShowStreams)
+			ps.AssembleShow()
+
+			Convey("Then AssembleShow transforms it into one item", func() {
+				So(ps.Len(), ShouldEqual, 2)
+
+				Convey("And that item is a ShowStmt", func() {
+					top := ps.Peek()
+					So(top, ShouldNotBeNil)
+					So(top.begin, ShouldEqual, 4)
+					So(top.end, ShouldEqual, 6)
+					So(top.comp, ShouldHaveSameTypeAs, ShowStmt{})
+
+					Convey("And it contains the previously pushed data", func() {
+						comp := top.comp.(ShowStmt)
+						So(comp.Target, ShouldEqual, ShowStreams)
+					})
+				})
+			})
+		})
+
+		Convey("When the stack contains a wrong item", func() {
+			ps.PushComponent(4, 6, Raw{"PRE"})
+
+			Convey("Then AssembleShow panics", func() {
+				So(ps.AssembleShow, ShouldPanic)
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		targets := []ShowTarget{ShowSources, ShowStreams, ShowSinks, ShowStates,
+			ShowFunctions, ShowSourceTypes, ShowSinkTypes, ShowStateTypes}
+		for _, target := range targets {
+			target := target
+
+			Convey(fmt.Sprintf("When doing a SHOW %v", target), func() {
+				p.Buffer = "SHOW " + target.String()
+				p.Init()
+
+				Convey("Then the statement should be parsed correctly", func() {
+					err := p.Parse()
+					So(err, ShouldEqual, nil)
+					p.Execute()
+
+					ps := p.parseStack
+					So(ps.Len(), ShouldEqual, 1)
+					top := ps.Peek().comp
+					So(top, ShouldHaveSameTypeAs, ShowStmt{})
+					comp := top.(ShowStmt)
+
+					So(comp.Target, ShouldEqual, target)
+
+					Convey("And String() should return the original statement", func() {
+						So(comp.String(), ShouldEqual, p.Buffer)
+					})
+				})
+			})
+		}
+
+		Convey("When doing a SHOW with an unknown target", func() {
+			p.Buffer = "SHOW NODES"
+			p.Init()
+
+			Convey("Then parsing should fail", func() {
+				err := p.Parse()
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
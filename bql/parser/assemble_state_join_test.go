@@ -0,0 +1,104 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleStateJoin(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+
+		Convey("When the stack contains the components of a JOIN STATE clause", func() {
+			ps.PushComponent(0, 4, LeftOuterJoin)
+			ps.PushComponent(11, 16, StreamIdentifier("users"))
+			ps.PushComponent(17, 22, Identifier("u"))
+			ps.PushComponent(26, 35, RowValue{"s", "user_id"})
+			ps.AssembleStateJoin()
+
+			Convey("Then AssembleStateJoin replaces them by a StateJoinAST", func() {
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek()
+				So(top.begin, ShouldEqual, 0)
+				So(top.end, ShouldEqual, 35)
+				So(top.comp, ShouldResemble, StateJoinAST{LeftOuterJoin, "users", "u",
+					RowValue{"s", "user_id"}})
+			})
+		})
+
+		Convey("When the stack does not contain enough items", func() {
+			ps.PushComponent(0, 4, InnerJoin)
+			ps.PushComponent(11, 16, StreamIdentifier("users"))
+
+			Convey("Then AssembleStateJoin panics", func() {
+				So(ps.AssembleStateJoin, ShouldPanic)
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When doing a SELECT with a JOIN STATE clause", func() {
+			p.Buffer = "SELECT ISTREAM s:a, users:name FROM s [RANGE 1 TUPLES] JOIN STATE users ON s:user_id"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				comp := ps.Peek().comp.(SelectStmt)
+				So(comp.Relations, ShouldHaveLength, 1)
+				So(comp.Joins, ShouldBeNil)
+				So(comp.StateJoins, ShouldResemble, []StateJoinAST{
+					{InnerJoin, "users", "", RowValue{"s", "user_id"}},
+				})
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When doing a SELECT with multiple joins", func() {
+			p.Buffer = "SELECT ISTREAM a:x, u:name, g:name FROM a [RANGE 1 TUPLES] " +
+				"JOIN b [RANGE 2 TUPLES] ON a:k = b:k " +
+				"LEFT OUTER JOIN STATE users AS u ON a:user_id " +
+				"JOIN STATE groups AS g ON u:group_id"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				comp := ps.Peek().comp.(SelectStmt)
+				So(comp.Relations, ShouldHaveLength, 2)
+				So(comp.Joins, ShouldHaveLength, 2)
+				So(comp.StateJoins, ShouldResemble, []StateJoinAST{
+					{LeftOuterJoin, "users", "u", RowValue{"a", "user_id"}},
+					{InnerJoin, "groups", "g", RowValue{"u", "group_id"}},
+				})
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When a JOIN STATE clause is followed by a relation", func() {
+			p.Buffer = "SELECT ISTREAM a:x FROM a [RANGE 1 TUPLES] " +
+				"JOIN STATE users ON a:user_id, b [RANGE 1 TUPLES]"
+			p.Init()
+
+			Convey("Then parsing should fail", func() {
+				So(p.Parse(), ShouldNotBeNil)
+			})
+		})
+	})
+}
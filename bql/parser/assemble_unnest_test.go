@@ -0,0 +1,123 @@
+package parser
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"testing"
+)
+
+func TestAssembleUnnest(t *testing.T) {
+	Convey("Given a parseStack", t, func() {
+		ps := parseStack{}
+
+		Convey("When the stack contains the components of an UNNEST clause", func() {
+			ps.PushComponent(7, 17, RowValue{"s", "readings"})
+			ps.PushComponent(18, 33, Yes)
+			ps.PushComponent(37, 38, Identifier("r"))
+			ps.AssembleUnnest()
+
+			Convey("Then AssembleUnnest replaces them by an UnnestAST", func() {
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek()
+				So(top.begin, ShouldEqual, 7)
+				So(top.end, ShouldEqual, 38)
+				So(top.comp, ShouldResemble, UnnestAST{RowValue{"s", "readings"}, Yes, "r"})
+			})
+		})
+
+		Convey("When the stack does not contain enough items", func() {
+			ps.PushComponent(18, 33, Yes)
+			ps.PushComponent(37, 38, Identifier("r"))
+
+			Convey("Then AssembleUnnest panics", func() {
+				So(ps.AssembleUnnest, ShouldPanic)
+			})
+		})
+	})
+
+	Convey("Given a parser", t, func() {
+		p := &bqlPeg{}
+
+		Convey("When doing a SELECT with an UNNEST clause", func() {
+			p.Buffer = "SELECT ISTREAM s:id, r:value FROM s [RANGE 1 TUPLES], UNNEST(s:readings) AS r"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				comp := ps.Peek().comp.(SelectStmt)
+				So(comp.Relations, ShouldHaveLength, 1)
+				So(comp.Unnests, ShouldResemble, []UnnestAST{
+					{RowValue{"s", "readings"}, UnspecifiedKeyword, "r"},
+				})
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When doing a SELECT with multiple UNNEST clauses and a JOIN STATE clause", func() {
+			p.Buffer = "SELECT ISTREAM a:x, r:value, q:ordinality FROM a [RANGE 1 TUPLES], " +
+				"b [RANGE 1 TUPLES], UNNEST(a:rs) AS r, UNNEST(r:value.qs) WITH ORDINALITY AS q " +
+				"JOIN STATE users ON q:value"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				comp := ps.Peek().comp.(SelectStmt)
+				So(comp.Relations, ShouldHaveLength, 2)
+				So(comp.Unnests, ShouldResemble, []UnnestAST{
+					{RowValue{"a", "rs"}, UnspecifiedKeyword, "r"},
+					{RowValue{"r", "value.qs"}, Yes, "q"},
+				})
+				So(comp.StateJoins, ShouldHaveLength, 1)
+
+				Convey("And String() should return the original statement", func() {
+					So(comp.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When doing a SELECT with a UDSF named like UNNEST", func() {
+			p.Buffer = "SELECT ISTREAM a:x FROM a [RANGE 1 TUPLES], unnest_array(\"a\") [RANGE 1 TUPLES] AS r"
+			p.Init()
+
+			Convey("Then it should be parsed as a relation", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				comp := p.parseStack.Peek().comp.(SelectStmt)
+				So(comp.Relations, ShouldHaveLength, 2)
+				So(comp.Unnests, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When an UNNEST clause doesn't have an alias", func() {
+			p.Buffer = "SELECT ISTREAM a:x FROM a [RANGE 1 TUPLES], UNNEST(a:rs)"
+			p.Init()
+
+			Convey("Then parsing should fail", func() {
+				So(p.Parse(), ShouldNotBeNil)
+			})
+		})
+
+		Convey("When an UNNEST clause is followed by a relation", func() {
+			p.Buffer = "SELECT ISTREAM a:x FROM a [RANGE 1 TUPLES], UNNEST(a:rs) AS r, b [RANGE 1 TUPLES]"
+			p.Init()
+
+			Convey("Then parsing should fail", func() {
+				So(p.Parse(), ShouldNotBeNil)
+			})
+		})
+	})
+}
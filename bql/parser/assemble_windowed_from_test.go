@@ -13,11 +13,11 @@ func TestAssembleWindowedFrom(t *testing.T) {
 			ps.PushComponent(0, 6, Raw{"PRE"})
 			ps.PushComponent(6, 8, AliasedStreamWindowAST{
 				StreamWindowAST{Stream{ActualStream, "a", nil}, IntervalAST{FloatLiteral{3}, Tuples},
-					2, UnspecifiedSheddingOption}, "",
+					2, UnspecifiedSheddingOption, SlideAST{}, LatenessAST{}, SessionAST{}}, "",
 			})
 			ps.PushComponent(8, 10, AliasedStreamWindowAST{
 				StreamWindowAST{Stream{ActualStream, "b", nil}, IntervalAST{FloatLiteral{2}, Seconds},
-					UnspecifiedCapacity, Wait}, "",
+					UnspecifiedCapacity, Wait, SlideAST{}, LatenessAST{}, SessionAST{}}, "",
 			})
 			ps.AssembleWindowedFrom(6, 10)
 
@@ -159,5 +159,71 @@ func TestAssembleWindowedFrom(t *testing.T) {
 				})
 			})
 		})
+
+		Convey("When selecting with a JOIN", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a:k, b:v FROM a [RANGE 3 TUPLES] JOIN b [RANGE 2 SECONDS] ON a:k = b:k LEFT OUTER JOIN c [RANGE 1 TUPLES] AS y ON y:k = b:k, d [RANGE 1 TUPLES]"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(len(comp.Relations), ShouldEqual, 4)
+				So(comp.Relations[0].Name, ShouldEqual, "a")
+				So(comp.Relations[1].Name, ShouldEqual, "b")
+				So(comp.Relations[2].Name, ShouldEqual, "c")
+				So(comp.Relations[2].Alias, ShouldEqual, "y")
+				So(comp.Relations[3].Name, ShouldEqual, "d")
+				So(len(comp.Joins), ShouldEqual, 4)
+				So(comp.Joins[0], ShouldResemble, JoinAST{})
+				So(comp.Joins[1].Type, ShouldEqual, InnerJoin)
+				So(comp.Joins[1].On, ShouldResemble, BinaryOpAST{Equal,
+					RowValue{"a", "k"}, RowValue{"b", "k"}})
+				So(comp.Joins[2].Type, ShouldEqual, LeftOuterJoin)
+				So(comp.Joins[2].On, ShouldResemble, BinaryOpAST{Equal,
+					RowValue{"y", "k"}, RowValue{"b", "k"}})
+				So(comp.Joins[3], ShouldResemble, JoinAST{})
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with an INNER JOIN", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a:k FROM a [RANGE 3 TUPLES] INNER JOIN b [RANGE 3 TUPLES] ON a:k = b:k WHERE a:v > 1"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(len(comp.Relations), ShouldEqual, 2)
+				So(comp.Join(1).Type, ShouldEqual, InnerJoin)
+				So(comp.Filter, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When selecting with a JOIN without ON", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a:k FROM a [RANGE 3 TUPLES] JOIN b [RANGE 3 TUPLES]"
+			p.Init()
+
+			Convey("Then parsing should fail", func() {
+				err := p.Parse()
+				So(err, ShouldNotBeNil)
+			})
+		})
 	})
 }
@@ -13,6 +13,8 @@ func TestAssembleStreamWindow(t *testing.T) {
 			ps.PushComponent(0, 6, Raw{"PRE"})
 			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil})
 			ps.PushComponent(8, 10, IntervalAST{FloatLiteral{2}, Seconds})
+			ps.EnsureSlideSpec(10, 10)
+			ps.EnsureLatenessSpec(10, 10)
 			ps.PushComponent(10, 12, NumericLiteral{2})
 			ps.EnsureCapacitySpec(10, 12)
 			ps.PushComponent(12, 14, DropOldest)
@@ -45,6 +47,8 @@ func TestAssembleStreamWindow(t *testing.T) {
 			ps.PushComponent(0, 6, Raw{"PRE"})
 			ps.PushComponent(6, 8, Stream{ActualStream, "a", nil})
 			ps.PushComponent(8, 10, IntervalAST{FloatLiteral{0.2}, Seconds})
+			ps.EnsureSlideSpec(10, 10)
+			ps.EnsureLatenessSpec(10, 10)
 			ps.PushComponent(10, 12, NumericLiteral{2})
 			ps.EnsureCapacitySpec(10, 12)
 			ps.PushComponent(12, 14, DropNewest)
@@ -177,6 +181,7 @@ func TestAssembleStreamWindow(t *testing.T) {
 				So(comp.Relations[0].Unit, ShouldEqual, Milliseconds)
 				So(comp.Relations[0].Capacity, ShouldEqual, UnspecifiedCapacity)
 				So(comp.Relations[0].Shedding, ShouldEqual, UnspecifiedSheddingOption)
+				So(comp.Relations[0].Slide, ShouldResemble, SlideAST{})
 				So(comp.Relations[0].Alias, ShouldEqual, "")
 
 				Convey("And String() should return the original statement", func() {
@@ -185,5 +190,266 @@ func TestAssembleStreamWindow(t *testing.T) {
 				})
 			})
 		})
+
+		Convey("When selecting with a FROM (SLIDE)", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a, b FROM c [RANGE 60 SECONDS SLIDE 10 SECONDS, BUFFER SIZE 5, WAIT IF FULL]"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(comp.Relations[0].Name, ShouldEqual, "c")
+				So(comp.Relations[0].Value, ShouldEqual, 60)
+				So(comp.Relations[0].Unit, ShouldEqual, Seconds)
+				So(comp.Relations[0].Slide, ShouldResemble,
+					SlideAST{IntervalAST{FloatLiteral{10}, Seconds}, false})
+				So(comp.Relations[0].Capacity, ShouldEqual, 5)
+				So(comp.Relations[0].Shedding, ShouldEqual, Wait)
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a FROM (TUMBLING)", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a, b FROM c [RANGE 3 TUPLES TUMBLING] AS d"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(comp.Relations[0].Name, ShouldEqual, "c")
+				So(comp.Relations[0].Value, ShouldEqual, 3)
+				So(comp.Relations[0].Unit, ShouldEqual, Tuples)
+				So(comp.Relations[0].Slide, ShouldResemble, SlideAST{Tumbling: true})
+				So(comp.Relations[0].Alias, ShouldEqual, "d")
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+		Convey("When selecting with a FROM (LATENESS)", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a, b FROM c [RANGE 60 SECONDS TUMBLING, LATENESS 5 SECONDS, BUFFER SIZE 5]"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(comp.Relations[0].Name, ShouldEqual, "c")
+				So(comp.Relations[0].Slide, ShouldResemble, SlideAST{Tumbling: true})
+				So(comp.Relations[0].Lateness, ShouldResemble,
+					LatenessAST{IntervalAST{FloatLiteral{5}, Seconds}, LatePolicyAST{}})
+				So(comp.Relations[0].Capacity, ShouldEqual, 5)
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a FROM (LATENESS with UPDATE policy)", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a, b FROM c [RANGE 2 SECONDS, LATENESS 500 MILLISECONDS UPDATE LATE TUPLES]"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(comp.Relations[0].Lateness, ShouldResemble,
+					LatenessAST{IntervalAST{FloatLiteral{500}, Milliseconds},
+						LatePolicyAST{UpdateLateTuples, ""}})
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a FROM (MINUTES with LATENESS)", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a, b FROM c [RANGE 1 MINUTES, LATENESS 5 SECONDS]"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(comp.Relations[0].Value, ShouldEqual, 1)
+				So(comp.Relations[0].Unit, ShouldEqual, Minutes)
+				So(comp.Relations[0].Lateness, ShouldResemble,
+					LatenessAST{IntervalAST{FloatLiteral{5}, Seconds}, LatePolicyAST{}})
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a FROM (HOURS)", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a, b FROM c [RANGE 2 HOURS SLIDE 30 MINUTES]"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(comp.Relations[0].Value, ShouldEqual, 2)
+				So(comp.Relations[0].Unit, ShouldEqual, Hours)
+				So(comp.Relations[0].Slide.Unit, ShouldEqual, Minutes)
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a FROM (LATENESS with EMIT policy)", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a, b FROM c [RANGE 2 SECONDS, LATENESS 1 SECONDS EMIT LATE TUPLES INTO late, WAIT IF FULL] AS d"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(comp.Relations[0].Lateness, ShouldResemble,
+					LatenessAST{IntervalAST{FloatLiteral{1}, Seconds},
+						LatePolicyAST{EmitLateTuples, "late"}})
+				So(comp.Relations[0].Shedding, ShouldEqual, Wait)
+				So(comp.Relations[0].Alias, ShouldEqual, "d")
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a FROM (LATENESS in TUPLES)", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a, b FROM c [RANGE 2 SECONDS, LATENESS 5 TUPLES]"
+			p.Init()
+
+			Convey("Then the statement should not be parsed", func() {
+				err := p.Parse()
+				So(err, ShouldNotBeNil)
+			})
+		})
+		Convey("When selecting with a FROM (SESSION)", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a, b FROM c [SESSION GAP 30 SECONDS BY c:user, LATENESS 1 SECONDS, BUFFER SIZE 5]"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(comp.Relations[0].Name, ShouldEqual, "c")
+				So(comp.Relations[0].Value, ShouldEqual, 30)
+				So(comp.Relations[0].Unit, ShouldEqual, Seconds)
+				So(comp.Relations[0].Slide, ShouldResemble, SlideAST{})
+				So(comp.Relations[0].Session, ShouldResemble,
+					SessionAST{true, RowValue{"c", "user"}})
+				So(comp.Relations[0].Lateness, ShouldResemble,
+					LatenessAST{IntervalAST{FloatLiteral{1}, Seconds}, LatePolicyAST{}})
+				So(comp.Relations[0].Capacity, ShouldEqual, 5)
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a FROM (SESSION without key)", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a, b FROM c [SESSION GAP 500 MILLISECONDS] AS d"
+			p.Init()
+
+			Convey("Then the statement should be parsed correctly", func() {
+				err := p.Parse()
+				So(err, ShouldEqual, nil)
+				p.Execute()
+
+				ps := p.parseStack
+				So(ps.Len(), ShouldEqual, 1)
+				top := ps.Peek().comp
+				So(top, ShouldHaveSameTypeAs, CreateStreamAsSelectStmt{})
+				comp := top.(CreateStreamAsSelectStmt).Select
+				So(comp.Relations[0].Value, ShouldEqual, 500)
+				So(comp.Relations[0].Unit, ShouldEqual, Milliseconds)
+				So(comp.Relations[0].Session, ShouldResemble, SessionAST{Enabled: true})
+				So(comp.Relations[0].Alias, ShouldEqual, "d")
+
+				Convey("And String() should return the original statement", func() {
+					stmt := top.(CreateStreamAsSelectStmt)
+					So(stmt.String(), ShouldEqual, p.Buffer)
+				})
+			})
+		})
+
+		Convey("When selecting with a FROM (SESSION in TUPLES)", func() {
+			p.Buffer = "CREATE STREAM x AS SELECT ISTREAM a, b FROM c [SESSION GAP 5 TUPLES]"
+			p.Init()
+
+			Convey("Then the statement should not be parsed", func() {
+				err := p.Parse()
+				So(err, ShouldNotBeNil)
+			})
+		})
 	})
 }
@@ -3,6 +3,8 @@ package parser
 import (
 	"fmt"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"regexp"
 	"strconv"
 	"strings"
 )
@@ -23,20 +25,26 @@ type Expression interface {
 
 type SelectStmt struct {
 	EmitterAST
+	DistinctAST
 	ProjectionsAST
 	WindowedFromAST
 	FilterAST
 	GroupingAST
 	HavingAST
+	OrderByAST
+	LimitAST
 }
 
 func (s SelectStmt) String() string {
 	str := []string{"SELECT", s.EmitterAST.string()}
+	str = append(str, s.DistinctAST.string())
 	str = append(str, s.ProjectionsAST.string())
 	str = append(str, s.WindowedFromAST.string())
 	str = append(str, s.FilterAST.string())
 	str = append(str, s.GroupingAST.string())
 	str = append(str, s.HavingAST.string())
+	str = append(str, s.OrderByAST.string())
+	str = append(str, s.LimitAST.string())
 
 	st := []string{}
 	for _, s := range str {
@@ -60,12 +68,43 @@ func (s SelectUnionStmt) String() string {
 }
 
 type CreateStreamAsSelectStmt struct {
-	Name   StreamIdentifier
-	Select SelectStmt
+	Name    StreamIdentifier
+	Schema  SchemaAST
+	Select  SelectStmt
+	OnError OnErrorAST
 }
 
 func (s CreateStreamAsSelectStmt) String() string {
-	str := []string{"CREATE", "STREAM", string(s.Name), "AS", s.Select.String()}
+	str := []string{"CREATE", "STREAM", string(s.Name)}
+	if schema := s.Schema.string(); schema != "" {
+		str = append(str, schema)
+	}
+	str = append(str, "AS", s.Select.String())
+	if onError := s.OnError.string(); onError != "" {
+		str = append(str, onError)
+	}
+	return strings.Join(str, " ")
+}
+
+// CreateOrReplaceStreamAsSelectStmt creates a stream like
+// CreateStreamAsSelectStmt, or replaces the SELECT statement of the
+// stream if it already exists.
+type CreateOrReplaceStreamAsSelectStmt struct {
+	Name    StreamIdentifier
+	Schema  SchemaAST
+	Select  SelectStmt
+	OnError OnErrorAST
+}
+
+func (s CreateOrReplaceStreamAsSelectStmt) String() string {
+	str := []string{"CREATE", "OR", "REPLACE", "STREAM", string(s.Name)}
+	if schema := s.Schema.string(); schema != "" {
+		str = append(str, schema)
+	}
+	str = append(str, "AS", s.Select.String())
+	if onError := s.OnError.string(); onError != "" {
+		str = append(str, onError)
+	}
 	return strings.Join(str, " ")
 }
 
@@ -82,12 +121,17 @@ func (s CreateStreamAsSelectUnionStmt) String() string {
 type CreateSourceStmt struct {
 	Paused BinaryKeyword
 	Name   StreamIdentifier
+	Schema SchemaAST
 	Type   SourceSinkType
 	SourceSinkSpecsAST
 }
 
 func (s CreateSourceStmt) String() string {
-	str := []string{"CREATE", "SOURCE", string(s.Name), "TYPE", string(s.Type)}
+	str := []string{"CREATE", "SOURCE", string(s.Name)}
+	if schema := s.Schema.string(); schema != "" {
+		str = append(str, schema)
+	}
+	str = append(str, "TYPE", string(s.Type))
 	paused := s.Paused.string("PAUSED", "UNPAUSED")
 	if paused != "" {
 		str = append(str[:1], append([]string{paused}, str[1:]...)...)
@@ -316,6 +360,58 @@ func (s EvalStmt) String() string {
 	return strings.Join(str, " ")
 }
 
+// ExplainStmt asks for the execution plan of Stmt, which is one of
+// SelectStmt, SelectUnionStmt, CreateStreamAsSelectStmt,
+// CreateStreamAsSelectUnionStmt or CreateOrReplaceStreamAsSelectStmt.
+type ExplainStmt struct {
+	Stmt interface{}
+}
+
+func (s ExplainStmt) String() string {
+	return "EXPLAIN " + fmt.Sprint(s.Stmt)
+}
+
+// ShowStmt lists the nodes, shared states, functions or registered
+// types selected by Target.
+type ShowStmt struct {
+	Target ShowTarget
+}
+
+func (s ShowStmt) String() string {
+	return "SHOW " + s.Target.String()
+}
+
+// DescribeStmt asks for the details of the node called Name.
+type DescribeStmt struct {
+	Name StreamIdentifier
+}
+
+func (s DescribeStmt) String() string {
+	str := []string{"DESCRIBE", string(s.Name)}
+	return strings.Join(str, " ")
+}
+
+type CreateFunctionStmt struct {
+	Name   FuncName
+	Params []string
+	Body   Expression
+}
+
+func (s CreateFunctionStmt) String() string {
+	str := []string{"CREATE", "FUNCTION", fmt.Sprintf("%s(%s)", s.Name, strings.Join(s.Params, ", ")),
+		"AS", s.Body.String()}
+	return strings.Join(str, " ")
+}
+
+type DropFunctionStmt struct {
+	Name FuncName
+}
+
+func (s DropFunctionStmt) String() string {
+	str := []string{"DROP", "FUNCTION", string(s.Name)}
+	return strings.Join(str, " ")
+}
+
 type EmitterAST struct {
 	EmitterType    Emitter
 	EmitterOptions []interface{}
@@ -370,6 +466,20 @@ func (e EmitterSampling) string() string {
 	return ""
 }
 
+// DistinctAST holds whether duplicates are removed from the results
+// of a SELECT statement or from the input values of an aggregate
+// function.
+type DistinctAST struct {
+	Distinct bool
+}
+
+func (a DistinctAST) string() string {
+	if !a.Distinct {
+		return ""
+	}
+	return "DISTINCT"
+}
+
 type ProjectionsAST struct {
 	Projections []Expression
 }
@@ -405,6 +515,17 @@ func (a AliasAST) String() string {
 
 type WindowedFromAST struct {
 	Relations []AliasedStreamWindowAST
+	// Joins is either nil (if all relations are separated by commas)
+	// or has the same length as Relations, where Joins[i] describes
+	// how Relations[i] is joined with the relations before it.
+	// Joins[0] is always the zero value.
+	Joins []JoinAST
+	// Unnests holds the UNNEST clauses, which follow all relations
+	// in the FROM clause.
+	Unnests []UnnestAST
+	// StateJoins holds the JOIN STATE clauses, which follow all
+	// relations in the FROM clause.
+	StateJoins []StateJoinAST
 }
 
 func (a WindowedFromAST) string() string {
@@ -412,11 +533,82 @@ func (a WindowedFromAST) string() string {
 		return ""
 	}
 
-	str := []string{}
-	for _, r := range a.Relations {
-		str = append(str, r.string())
+	str := ""
+	for i, r := range a.Relations {
+		if i > 0 {
+			if j := a.Join(i); j.Type != UnspecifiedJoinType {
+				str += " " + j.Type.String() + " " + r.string() + " ON " + j.On.String()
+				continue
+			}
+			str += ", "
+		}
+		str += r.string()
+	}
+	for _, u := range a.Unnests {
+		str += ", " + u.string()
+	}
+	for _, j := range a.StateJoins {
+		str += " " + j.string()
+	}
+	return "FROM " + str
+}
+
+// String returns the FROM clause as it is written in a statement.
+func (a WindowedFromAST) String() string {
+	return a.string()
+}
+
+// Join returns the JoinAST describing how the i-th relation is
+// joined with the relations before it.
+func (a WindowedFromAST) Join(i int) JoinAST {
+	if i >= len(a.Joins) {
+		return JoinAST{}
+	}
+	return a.Joins[i]
+}
+
+// JoinAST holds the type and the ON condition of an explicit JOIN.
+// A relation listed with a comma has an UnspecifiedJoinType and no
+// condition.
+type JoinAST struct {
+	Type JoinType
+	On   Expression
+}
+
+// UnnestAST holds an UNNEST clause, which expands each row of the
+// relations before it into one row per element of the array computed
+// by Expr. The element can be referred to as Alias:value and its
+// 1-based position as Alias:ordinality if WithOrdinality is Yes.
+type UnnestAST struct {
+	Expr           Expression
+	WithOrdinality BinaryKeyword
+	Alias          string
+}
+
+func (a UnnestAST) string() string {
+	str := "UNNEST(" + a.Expr.String() + ")"
+	if a.WithOrdinality == Yes {
+		str += " WITH ORDINALITY"
+	}
+	return str + " AS " + a.Alias
+}
+
+// StateJoinAST holds a JOIN STATE clause, which looks up the entry of
+// a shared state having the value of Key as its key. Alias is empty
+// if the clause doesn't have an AS clause.
+type StateJoinAST struct {
+	Type  JoinType
+	State StreamIdentifier
+	Alias string
+	Key   Expression
+}
+
+func (a StateJoinAST) string() string {
+	str := a.Type.String() + " STATE " + string(a.State)
+	if a.Alias != "" {
+		str += " AS " + a.Alias
 	}
-	return "FROM " + strings.Join(str, ", ")
+	return str + " ON " + a.Key.String()
 }
 
 type AliasedStreamWindowAST struct {
@@ -432,6 +624,11 @@ func (a AliasedStreamWindowAST) string() string {
 	return str
 }
 
+// String returns the relation as it is written in a FROM clause.
+func (a AliasedStreamWindowAST) String() string {
+	return a.string()
+}
+
 const UnspecifiedCapacity int64 = -1
 
 type StreamWindowAST struct {
@@ -439,10 +636,17 @@ type StreamWindowAST struct {
 	IntervalAST
 	Capacity int64
 	Shedding SheddingOption
+	Slide    SlideAST
+	Lateness LatenessAST
+	Session  SessionAST
 }
 
 func (a StreamWindowAST) string() string {
-	interval := a.IntervalAST.string()
+	interval := a.IntervalAST.string() + a.Slide.string()
+	if a.Session.Enabled {
+		interval = a.Session.string(a.IntervalAST)
+	}
+	interval += a.Lateness.string()
 	capacity := ""
 	if a.Capacity != UnspecifiedCapacity {
 		capacity = fmt.Sprintf(", BUFFER SIZE %d", a.Capacity)
@@ -477,6 +681,78 @@ func (a IntervalAST) string() string {
 	return "RANGE " + a.FloatLiteral.String() + " " + a.Unit.String()
 }
 
+// SlideAST describes how often the query on a window is evaluated.
+// If its Unit is UnspecifiedIntervalUnit and Tumbling is false, the
+// query is evaluated whenever a tuple arrives. If Tumbling is true,
+// the window slides by its own RANGE.
+type SlideAST struct {
+	IntervalAST
+	Tumbling bool
+}
+
+func (a SlideAST) string() string {
+	if a.Tumbling {
+		return " TUMBLING"
+	}
+	if a.Unit == UnspecifiedIntervalUnit {
+		return ""
+	}
+	return " SLIDE " + a.FloatLiteral.String() + " " + a.Unit.String()
+}
+
+// SessionAST describes a session window. If Enabled is true, the
+// IntervalAST of the StreamWindowAST holds the inactivity gap after
+// which a session is closed, and Key is the expression that assigns
+// tuples to sessions (nil if all tuples belong to the same session).
+type SessionAST struct {
+	Enabled bool
+	Key     Expression
+}
+
+func (a SessionAST) string(gap IntervalAST) string {
+	s := "SESSION GAP " + gap.FloatLiteral.String() + " " + gap.Unit.String()
+	if a.Key != nil {
+		s += " BY " + a.Key.String()
+	}
+	return s
+}
+
+// LatenessAST describes how long to wait for tuples that arrive out of
+// timestamp order. If its Unit is UnspecifiedIntervalUnit, tuples are
+// processed in the order in which they arrive. Otherwise the window is
+// driven by the tuples' timestamps (event time) and LatePolicyAST
+// describes what happens to tuples that arrive too late.
+type LatenessAST struct {
+	IntervalAST
+	LatePolicyAST
+}
+
+func (a LatenessAST) string() string {
+	if a.Unit == UnspecifiedIntervalUnit {
+		return ""
+	}
+	return ", LATENESS " + a.FloatLiteral.String() + " " + a.Unit.String() +
+		a.LatePolicyAST.string()
+}
+
+// LatePolicyAST describes what happens to a tuple that arrives after
+// the watermark of an event-time window has passed its timestamp.
+// Stream is only used with EmitLateTuples.
+type LatePolicyAST struct {
+	Policy LatePolicy
+	Stream StreamIdentifier
+}
+
+func (a LatePolicyAST) string() string {
+	switch a.Policy {
+	case DropLateTuples, UpdateLateTuples:
+		return " " + a.Policy.String()
+	case EmitLateTuples:
+		return " " + a.Policy.String() + " INTO " + string(a.Stream)
+	}
+	return ""
+}
+
 type FilterAST struct {
 	Filter Expression
 }
@@ -490,10 +766,14 @@ func (a FilterAST) string() string {
 
 type GroupingAST struct {
 	GroupList []Expression
+	// GroupingSets holds the grouping sets of a GROUP BY clause
+	// having ROLLUP, CUBE or GROUPING SETS. GroupList then holds all
+	// expressions used in the grouping sets.
+	GroupingSets GroupingSetsAST
 }
 
 func (a GroupingAST) string() string {
-	if len(a.GroupList) == 0 {
+	if len(a.GroupList) == 0 && a.GroupingSets.Type == UnspecifiedGroupingSetsType {
 		return ""
 	}
 
@@ -501,9 +781,67 @@ func (a GroupingAST) string() string {
 	for _, e := range a.GroupList {
 		str = append(str, e.String())
 	}
+	switch a.GroupingSets.Type {
+	case RollupGroupingSets, CubeGroupingSets:
+		return "GROUP BY " + a.GroupingSets.Type.String() + "(" + strings.Join(str, ", ") + ")"
+	case ExplicitGroupingSets:
+		sets := make([]string, len(a.GroupingSets.Sets))
+		for i, set := range a.GroupingSets.Sets {
+			exprs := make([]string, len(set))
+			for j, idx := range set {
+				exprs[j] = str[idx]
+			}
+			sets[i] = "(" + strings.Join(exprs, ", ") + ")"
+		}
+		return "GROUP BY " + a.GroupingSets.Type.String() + "(" + strings.Join(sets, ", ") + ")"
+	}
 	return "GROUP BY " + strings.Join(str, ", ")
 }
 
+// ExpandGroupingSets returns the grouping sets of the GROUP BY clause
+// as lists of indexes into GroupList. It returns nil for a plain
+// GROUP BY clause.
+func (a GroupingAST) ExpandGroupingSets() [][]int {
+	n := len(a.GroupList)
+	switch a.GroupingSets.Type {
+	case RollupGroupingSets:
+		// ROLLUP(a, b) is GROUPING SETS((a, b), (a), ())
+		sets := make([][]int, n+1)
+		for i := range sets {
+			set := make([]int, n-i)
+			for j := range set {
+				set[j] = j
+			}
+			sets[i] = set
+		}
+		return sets
+	case CubeGroupingSets:
+		// CUBE(a, b) is GROUPING SETS((a, b), (a), (b), ())
+		sets := make([][]int, 0, 1<<uint(n))
+		for mask := 1<<uint(n) - 1; mask >= 0; mask-- {
+			set := []int{}
+			for j := 0; j < n; j++ {
+				if mask&(1<<uint(n-1-j)) != 0 {
+					set = append(set, j)
+				}
+			}
+			sets = append(sets, set)
+		}
+		return sets
+	case ExplicitGroupingSets:
+		return a.GroupingSets.Sets
+	}
+	return nil
+}
+
+// GroupingSetsAST holds the type of the grouping sets of a GROUP BY
+// clause. Sets is only used by GROUPING SETS and holds each set as
+// indexes into GroupingAST.GroupList.
+type GroupingSetsAST struct {
+	Type GroupingSetsType
+	Sets [][]int
+}
+
 type HavingAST struct {
 	Having Expression
 }
@@ -515,6 +853,85 @@ func (a HavingAST) string() string {
 	return "HAVING " + a.Having.String()
 }
 
+// OrderByAST holds the expressions in the ORDER BY clause of
+// a SELECT statement that are used to sort the results of each
+// evaluation of the statement.
+type OrderByAST struct {
+	OrderList []SortedExpressionAST
+}
+
+func (a OrderByAST) string() string {
+	if len(a.OrderList) == 0 {
+		return ""
+	}
+	str := make([]string, len(a.OrderList))
+	for i, e := range a.OrderList {
+		str[i] = e.String()
+	}
+	return "ORDER BY " + strings.Join(str, ", ")
+}
+
+// LimitAST holds the maximum number of results of each evaluation
+// of a SELECT statement. There is no limit if HasLimit is false.
+type LimitAST struct {
+	HasLimit bool
+	Limit    int64
+}
+
+func (a LimitAST) string() string {
+	if !a.HasLimit {
+		return ""
+	}
+	return fmt.Sprintf("LIMIT %d", a.Limit)
+}
+
+// SchemaAST declares the fields of the tuples of a source or a stream.
+// Fields is empty when no schema is declared.
+// OnErrorAST is the ON ERROR INSERT INTO clause of a CREATE STREAM
+// statement. Stream is empty when the statement doesn't have one.
+type OnErrorAST struct {
+	Stream StreamIdentifier
+}
+
+func (a OnErrorAST) string() string {
+	if a.Stream == "" {
+		return ""
+	}
+	return "ON ERROR INSERT INTO " + string(a.Stream)
+}
+
+type SchemaAST struct {
+	Fields []SchemaFieldAST
+}
+
+func (a SchemaAST) string() string {
+	if len(a.Fields) == 0 {
+		return ""
+	}
+	str := make([]string, len(a.Fields))
+	for i, f := range a.Fields {
+		str[i] = f.string()
+	}
+	return "(" + strings.Join(str, ", ") + ")"
+}
+
+// SchemaFieldAST declares a field of a schema. Path is a JSON Path
+// only consisting of map accesses, so that nested fields can be
+// declared. A field can be NULL or missing unless NotNull is Yes.
+type SchemaFieldAST struct {
+	Path    string
+	Type    Type
+	NotNull BinaryKeyword
+}
+
+func (a SchemaFieldAST) string() string {
+	s := a.Path + " " + a.Type.String()
+	if a.NotNull == Yes {
+		s += " NOT NULL"
+	}
+	return s
+}
+
 type SourceSinkSpecsAST struct {
 	Params []SourceSinkParamAST
 }
@@ -627,6 +1044,13 @@ func (b BinaryOpAST) String() string {
 		}
 	}
 
+	if _, ok := b.Left.(BetweenAST); ok {
+		encloseLeft = true
+	}
+	if _, ok := b.Right.(BetweenAST); ok {
+		encloseRight = true
+	}
+
 	if encloseLeft {
 		str[0] = "(" + str[0] + ")"
 	}
@@ -634,6 +1058,61 @@ func (b BinaryOpAST) String() string {
 		str[2] = "(" + str[2] + ")"
 	}
 
+	// The list of IN is written in parentheses, not in brackets
+	if list, ok := b.Right.(ArrayAST); ok && (b.Op == In || b.Op == NotIn) {
+		str[2] = "(" + list.ExpressionsAST.string() + ")"
+	}
+
+	return strings.Join(str, " ")
+}
+
+// BetweenAST represents "Expr [NOT] BETWEEN Lower AND Upper". Op is
+// either Between or NotBetween.
+type BetweenAST struct {
+	Op    Operator
+	Expr  Expression
+	Lower Expression
+	Upper Expression
+}
+
+func (b BetweenAST) ReferencedRelations() map[string]bool {
+	rels := map[string]bool{}
+	for _, e := range []Expression{b.Expr, b.Lower, b.Upper} {
+		for rel := range e.ReferencedRelations() {
+			rels[rel] = true
+		}
+	}
+	return rels
+}
+
+func (b BetweenAST) RenameReferencedRelation(from, to string) Expression {
+	return BetweenAST{b.Op,
+		b.Expr.RenameReferencedRelation(from, to),
+		b.Lower.RenameReferencedRelation(from, to),
+		b.Upper.RenameReferencedRelation(from, to)}
+}
+
+func (b BetweenAST) Foldable() bool {
+	return b.Expr.Foldable() && b.Lower.Foldable() && b.Upper.Foldable()
+}
+
+func (b BetweenAST) String() string {
+	str := []string{b.Expr.String(), b.Op.String(), b.Lower.String(),
+		"AND", b.Upper.String()}
+
+	// all operands are parsed at the level of "||", so operators with
+	// a lower precedence require parentheses
+	for i, e := range []Expression{b.Expr, nil, b.Lower, nil, b.Upper} {
+		switch e := e.(type) {
+		case BinaryOpAST:
+			if !e.Op.hasHigherPrecedenceThan(b.Op) {
+				str[i] = "(" + str[i] + ")"
+			}
+		case BetweenAST:
+			str[i] = "(" + str[i] + ")"
+		}
+	}
+
 	return strings.Join(str, " ")
 }
 
@@ -665,7 +1144,8 @@ func (u UnaryOpAST) String() string {
 	}
 
 	// Enclose expression in parentheses for "NOT (a AND B)" like case
-	if _, ok := u.Expr.(BinaryOpAST); ok {
+	switch u.Expr.(type) {
+	case BinaryOpAST, BetweenAST:
 		expr = "(" + expr + ")"
 	}
 
@@ -706,6 +1186,7 @@ type FuncAppAST struct {
 	Function FuncName
 	ExpressionsAST
 	Ordering []SortedExpressionAST
+	Distinct bool
 }
 
 func (f FuncAppAST) ReferencedRelations() map[string]bool {
@@ -732,7 +1213,7 @@ func (f FuncAppAST) RenameReferencedRelation(from, to string) Expression {
 	for i, expr := range f.Ordering {
 		newOrderExprs[i] = expr.RenameReferencedRelation(from, to).(SortedExpressionAST)
 	}
-	return FuncAppAST{f.Function, ExpressionsAST{newExprs}, newOrderExprs}
+	return FuncAppAST{f.Function, ExpressionsAST{newExprs}, newOrderExprs, f.Distinct}
 }
 
 func (f FuncAppAST) Foldable() bool {
@@ -741,9 +1222,9 @@ func (f FuncAppAST) Foldable() bool {
 	if string(f.Function) == "now" && len(f.Expressions) == 0 {
 		return false
 	}
-	// if there is a ORDER BY clause, then this is definitely an
-	// aggregate function and therefore not foldable
-	if len(f.Ordering) > 0 {
+	// if there is a ORDER BY clause or a DISTINCT modifier, then this
+	// is definitely an aggregate function and therefore not foldable
+	if len(f.Ordering) > 0 || f.Distinct {
 		return false
 	}
 	for _, expr := range f.Expressions {
@@ -756,7 +1237,11 @@ func (f FuncAppAST) Foldable() bool {
 }
 
 func (f FuncAppAST) String() string {
-	s := string(f.Function) + "(" + f.ExpressionsAST.string()
+	s := string(f.Function) + "("
+	if f.Distinct {
+		s += "DISTINCT "
+	}
+	s += f.ExpressionsAST.string()
 	if len(f.Ordering) > 0 {
 		orderStrings := make([]string, len(f.Ordering))
 		for i, expr := range f.Ordering {
@@ -767,6 +1252,79 @@ func (f FuncAppAST) String() string {
 	return s + ")"
 }
 
+// AnalyticFuncAST is a function application with an OVER clause.
+// It is evaluated over all rows of the current window that are in
+// the same partition as the current row.
+type AnalyticFuncAST struct {
+	FuncAppAST
+	Over OverAST
+}
+
+func (a AnalyticFuncAST) ReferencedRelations() map[string]bool {
+	rels := a.FuncAppAST.ReferencedRelations()
+	for _, expr := range a.Over.PartitionList {
+		for rel := range expr.ReferencedRelations() {
+			rels[rel] = true
+		}
+	}
+	for _, expr := range a.Over.OrderList {
+		for rel := range expr.ReferencedRelations() {
+			rels[rel] = true
+		}
+	}
+	return rels
+}
+
+func (a AnalyticFuncAST) RenameReferencedRelation(from, to string) Expression {
+	newPartition := make([]Expression, len(a.Over.PartitionList))
+	for i, expr := range a.Over.PartitionList {
+		newPartition[i] = expr.RenameReferencedRelation(from, to)
+	}
+	newOrderExprs := make([]SortedExpressionAST, len(a.Over.OrderList))
+	for i, expr := range a.Over.OrderList {
+		newOrderExprs[i] = expr.RenameReferencedRelation(from, to).(SortedExpressionAST)
+	}
+	return AnalyticFuncAST{
+		a.FuncAppAST.RenameReferencedRelation(from, to).(FuncAppAST),
+		OverAST{newPartition, newOrderExprs},
+	}
+}
+
+func (a AnalyticFuncAST) Foldable() bool {
+	// the result depends on other rows in the window
+	return false
+}
+
+func (a AnalyticFuncAST) String() string {
+	return a.FuncAppAST.String() + " " + a.Over.string()
+}
+
+// OverAST holds the PARTITION BY and ORDER BY clauses of the OVER
+// clause of an analytic function.
+type OverAST struct {
+	PartitionList []Expression
+	OrderList     []SortedExpressionAST
+}
+
+func (a OverAST) string() string {
+	str := []string{}
+	if len(a.PartitionList) > 0 {
+		exprs := make([]string, len(a.PartitionList))
+		for i, expr := range a.PartitionList {
+			exprs[i] = expr.String()
+		}
+		str = append(str, "PARTITION BY "+strings.Join(exprs, ", "))
+	}
+	if len(a.OrderList) > 0 {
+		exprs := make([]string, len(a.OrderList))
+		for i, expr := range a.OrderList {
+			exprs[i] = expr.String()
+		}
+		str = append(str, "ORDER BY "+strings.Join(exprs, ", "))
+	}
+	return "OVER (" + strings.Join(str, " ") + ")"
+}
+
 type SortedExpressionAST struct {
 	Expr      Expression
 	Ascending BinaryKeyword
@@ -1111,6 +1669,143 @@ func (c ExpressionCaseAST) String() string {
 		c.Expr.String(), strings.Join(entries, " "))
 }
 
+// LambdaAST is a lambda expression such as `x -> x.a > 0`, which can be
+// passed to higher-order functions like filter and transform. References
+// to the parameter in Body are LambdaParam expressions.
+type LambdaAST struct {
+	Param string
+	Body  Expression
+}
+
+// NewLambdaAST creates a lambda expression, replacing the row values in
+// the body which refer to the parameter by LambdaParam expressions.
+func NewLambdaAST(param string, body Expression) LambdaAST {
+	return LambdaAST{param, bindLambdaParam(body, param)}
+}
+
+func (l LambdaAST) ReferencedRelations() map[string]bool {
+	return l.Body.ReferencedRelations()
+}
+
+func (l LambdaAST) RenameReferencedRelation(from, to string) Expression {
+	return LambdaAST{l.Param, l.Body.RenameReferencedRelation(from, to)}
+}
+
+func (l LambdaAST) Foldable() bool {
+	// a lambda expression isn't a value
+	return false
+}
+
+func (l LambdaAST) String() string {
+	return l.Param + " -> " + l.Body.String()
+}
+
+// LambdaParam refers to the parameter of a lambda expression. Column is
+// a JSON path whose first component is the name of the parameter.
+type LambdaParam struct {
+	Column string
+}
+
+func (lp LambdaParam) ReferencedRelations() map[string]bool {
+	return nil
+}
+
+func (lp LambdaParam) RenameReferencedRelation(from, to string) Expression {
+	return lp
+}
+
+func (lp LambdaParam) Foldable() bool {
+	return false
+}
+
+func (lp LambdaParam) String() string {
+	return lp.Column
+}
+
+// bindLambdaParam replaces the row values without a relation whose path
+// starts with param by LambdaParam expressions.
+func bindLambdaParam(e Expression, param string) Expression {
+	bind := func(e Expression) Expression {
+		return bindLambdaParam(e, param)
+	}
+	bindAll := func(exprs []Expression) []Expression {
+		bound := make([]Expression, len(exprs))
+		for i, e := range exprs {
+			bound[i] = bind(e)
+		}
+		return bound
+	}
+	bindSorted := func(exprs []SortedExpressionAST) []SortedExpressionAST {
+		if exprs == nil {
+			return nil
+		}
+		bound := make([]SortedExpressionAST, len(exprs))
+		for i, e := range exprs {
+			bound[i] = SortedExpressionAST{bind(e.Expr), e.Ascending}
+		}
+		return bound
+	}
+	bindCase := func(c ConditionCaseAST) ConditionCaseAST {
+		checks := make([]WhenThenPairAST, len(c.Checks))
+		for i, pair := range c.Checks {
+			checks[i] = WhenThenPairAST{bind(pair.When), bind(pair.Then)}
+		}
+		if c.Else != nil {
+			return ConditionCaseAST{checks, bind(c.Else)}
+		}
+		return ConditionCaseAST{checks, nil}
+	}
+
+	switch obj := e.(type) {
+	case RowValue:
+		if obj.Relation != "" {
+			return obj
+		}
+		head := obj.Column
+		if i := strings.IndexAny(head, ".["); i >= 0 {
+			head = head[:i]
+		}
+		if head == param {
+			return LambdaParam{obj.Column}
+		}
+		return obj
+	case AliasAST:
+		return AliasAST{bind(obj.Expr), obj.Alias}
+	case BinaryOpAST:
+		return BinaryOpAST{obj.Op, bind(obj.Left), bind(obj.Right)}
+	case BetweenAST:
+		return BetweenAST{obj.Op, bind(obj.Expr), bind(obj.Lower), bind(obj.Upper)}
+	case UnaryOpAST:
+		return UnaryOpAST{obj.Op, bind(obj.Expr)}
+	case TypeCastAST:
+		return TypeCastAST{bind(obj.Expr), obj.Target}
+	case FuncAppAST:
+		return FuncAppAST{obj.Function, ExpressionsAST{bindAll(obj.Expressions)},
+			bindSorted(obj.Ordering), obj.Distinct}
+	case AnalyticFuncAST:
+		f := bind(obj.FuncAppAST).(FuncAppAST)
+		return AnalyticFuncAST{f, OverAST{bindAll(obj.Over.PartitionList),
+			bindSorted(obj.Over.OrderList)}}
+	case ArrayAST:
+		return ArrayAST{ExpressionsAST{bindAll(obj.Expressions)}}
+	case MapAST:
+		entries := make([]KeyValuePairAST, len(obj.Entries))
+		for i, pair := range obj.Entries {
+			entries[i] = KeyValuePairAST{pair.Key, bind(pair.Value)}
+		}
+		return MapAST{entries}
+	case ConditionCaseAST:
+		return bindCase(obj)
+	case ExpressionCaseAST:
+		return ExpressionCaseAST{bind(obj.Expr), bindCase(obj.ConditionCaseAST)}
+	case LambdaAST:
+		// the parameter of an inner lambda expression has already been
+		// bound, so the remaining row values may refer to this one
+		return LambdaAST{obj.Param, bind(obj.Body)}
+	}
+	return e
+}
+
 type RowMeta struct {
 	Relation string
 	MetaType MetaInformation
@@ -1312,6 +2007,136 @@ func NewStringLiteral(s string) StringLiteral {
 	return StringLiteral{unescaped}
 }
 
+// IntervalLiteral is a duration written as INTERVAL "5 minutes". Its
+// Value is the number of microseconds, so that it can be added to or
+// subtracted from a Timestamp and compared with the result of distance_us.
+type IntervalLiteral struct {
+	Value int64
+}
+
+func (l IntervalLiteral) ReferencedRelations() map[string]bool {
+	return nil
+}
+
+func (l IntervalLiteral) RenameReferencedRelation(from, to string) Expression {
+	return l
+}
+
+func (l IntervalLiteral) Foldable() bool {
+	return true
+}
+
+func (l IntervalLiteral) String() string {
+	return fmt.Sprintf(`INTERVAL "%s"`, FormatInterval(l.Value))
+}
+
+func NewIntervalLiteral(s string) IntervalLiteral {
+	us, err := ParseInterval(s)
+	if err != nil {
+		panic(err)
+	}
+	return IntervalLiteral{us}
+}
+
+// intervalUnits are the lengths of the units which can be used in
+// intervals in microseconds. Months and years aren't supported because
+// their lengths aren't fixed.
+var intervalUnits = map[string]int64{
+	"us": 1, "usec": 1, "usecs": 1, "microsecond": 1, "microseconds": 1,
+	"ms": 1000, "msec": 1000, "msecs": 1000, "millisecond": 1000, "milliseconds": 1000,
+	"s": 1000000, "sec": 1000000, "secs": 1000000, "second": 1000000, "seconds": 1000000,
+	"m": 60000000, "min": 60000000, "mins": 60000000, "minute": 60000000, "minutes": 60000000,
+	"h": 3600000000, "hr": 3600000000, "hrs": 3600000000, "hour": 3600000000, "hours": 3600000000,
+	"d": 86400000000, "day": 86400000000, "days": 86400000000,
+	"w": 604800000000, "week": 604800000000, "weeks": 604800000000,
+}
+
+var intervalPartRegexp = regexp.MustCompile(`^\s*([+-]?[0-9]+(?:\.[0-9]+)?)\s*([a-zA-Z]+)`)
+
+// ParseInterval returns the number of microseconds of an interval such
+// as "5 minutes" or "1 hour 30 mins". An interval consists of one or more
+// pairs of a number, which may be negative or have a fractional part, and
+// a unit from microseconds to weeks.
+func ParseInterval(s string) (int64, error) {
+	rest := s
+	var us int64
+	parts := 0
+	for strings.TrimSpace(rest) != "" {
+		m := intervalPartRegexp.FindStringSubmatch(rest)
+		if m == nil {
+			return 0, fmt.Errorf("invalid interval: %v", s)
+		}
+		unit, ok := intervalUnits[strings.ToLower(m[2])]
+		if !ok {
+			return 0, fmt.Errorf("unsupported interval unit '%v' in %v", m[2], s)
+		}
+		var part int64
+		if strings.Contains(m[1], ".") {
+			f, err := strconv.ParseFloat(m[1], 64)
+			if err != nil {
+				return 0, err
+			}
+			v := f * float64(unit)
+			if v >= math.MaxInt64 || v < math.MinInt64 {
+				return 0, fmt.Errorf("interval out of range: %v", s)
+			}
+			part = int64(v)
+		} else {
+			i, err := strconv.ParseInt(m[1], 10, 64)
+			if err != nil {
+				return 0, err
+			}
+			if i > math.MaxInt64/unit || i < math.MinInt64/unit {
+				return 0, fmt.Errorf("interval out of range: %v", s)
+			}
+			part = i * unit
+		}
+		if (part > 0 && us > math.MaxInt64-part) || (part < 0 && us < math.MinInt64-part) {
+			return 0, fmt.Errorf("interval out of range: %v", s)
+		}
+		us += part
+		rest = rest[len(m[0]):]
+		parts++
+	}
+	if parts == 0 {
+		return 0, fmt.Errorf("empty interval")
+	}
+	return us, nil
+}
+
+// FormatInterval returns a string representation of an interval given
+// in microseconds which can be parsed by ParseInterval.
+func FormatInterval(us int64) string {
+	if us == 0 {
+		return "0 seconds"
+	}
+	units := []struct {
+		name string
+		len  int64
+	}{
+		{"day", 86400000000},
+		{"hour", 3600000000},
+		{"minute", 60000000},
+		{"second", 1000000},
+		{"millisecond", 1000},
+		{"microsecond", 1},
+	}
+	parts := []string{}
+	for _, u := range units {
+		n := us / u.len
+		if n == 0 {
+			continue
+		}
+		us -= n * u.len
+		if n == 1 || n == -1 {
+			parts = append(parts, fmt.Sprintf("%d %s", n, u.name))
+		} else {
+			parts = append(parts, fmt.Sprintf("%d %ss", n, u.name))
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
 type FuncName string
 
 type StreamIdentifier string
@@ -1342,6 +2167,43 @@ func (e Emitter) String() string {
 	return s
 }
 
+type ShowTarget int
+
+const (
+	UnspecifiedShowTarget ShowTarget = iota
+	ShowSources
+	ShowStreams
+	ShowSinks
+	ShowStates
+	ShowFunctions
+	ShowSourceTypes
+	ShowSinkTypes
+	ShowStateTypes
+)
+
+func (t ShowTarget) String() string {
+	s := "UNSPECIFIED"
+	switch t {
+	case ShowSources:
+		s = "SOURCES"
+	case ShowStreams:
+		s = "STREAMS"
+	case ShowSinks:
+		s = "SINKS"
+	case ShowStates:
+		s = "STATES"
+	case ShowFunctions:
+		s = "FUNCTIONS"
+	case ShowSourceTypes:
+		s = "SOURCE TYPES"
+	case ShowSinkTypes:
+		s = "SINK TYPES"
+	case ShowStateTypes:
+		s = "STATE TYPES"
+	}
+	return s
+}
+
 type EmitterSamplingType int
 
 const (
@@ -1390,6 +2252,8 @@ const (
 	Tuples
 	Seconds
 	Milliseconds
+	Minutes
+	Hours
 )
 
 func (i IntervalUnit) String() string {
@@ -1401,6 +2265,32 @@ func (i IntervalUnit) String() string {
 		s = "SECONDS"
 	case Milliseconds:
 		s = "MILLISECONDS"
+	case Minutes:
+		s = "MINUTES"
+	case Hours:
+		s = "HOURS"
+	}
+	return s
+}
+
+type LatePolicy int
+
+const (
+	UnspecifiedLatePolicy LatePolicy = iota
+	DropLateTuples
+	UpdateLateTuples
+	EmitLateTuples
+)
+
+func (p LatePolicy) String() string {
+	s := "UNSPECIFIED"
+	switch p {
+	case DropLateTuples:
+		s = "DROP LATE TUPLES"
+	case UpdateLateTuples:
+		s = "UPDATE LATE TUPLES"
+	case EmitLateTuples:
+		s = "EMIT LATE TUPLES"
 	}
 	return s
 }
@@ -1486,6 +2376,47 @@ func (t SheddingOption) String() string {
 	return s
 }
 
+type JoinType int
+
+const (
+	UnspecifiedJoinType JoinType = iota
+	InnerJoin
+	LeftOuterJoin
+)
+
+func (t JoinType) String() string {
+	s := "UnspecifiedJoinType"
+	switch t {
+	case InnerJoin:
+		s = "JOIN"
+	case LeftOuterJoin:
+		s = "LEFT OUTER JOIN"
+	}
+	return s
+}
+
+type GroupingSetsType int
+
+const (
+	UnspecifiedGroupingSetsType GroupingSetsType = iota
+	RollupGroupingSets
+	CubeGroupingSets
+	ExplicitGroupingSets
+)
+
+func (t GroupingSetsType) String() string {
+	s := "UnspecifiedGroupingSetsType"
+	switch t {
+	case RollupGroupingSets:
+		s = "ROLLUP"
+	case CubeGroupingSets:
+		s = "CUBE"
+	case ExplicitGroupingSets:
+		s = "GROUPING SETS"
+	}
+	return s
+}
+
 type Type int
 
 const (
@@ -1538,6 +2469,16 @@ const (
 	Greater
 	GreaterOrEqual
 	NotEqual
+	Like
+	NotLike
+	ILike
+	NotILike
+	RegexMatch
+	NotRegexMatch
+	In
+	NotIn
+	Between
+	NotBetween
 	Concat
 	Is
 	IsNot
@@ -1557,6 +2498,9 @@ func (op Operator) hasSamePrecedenceAs(rhs Operator) bool {
 	if Less <= op && op <= GreaterOrEqual && Less <= rhs && rhs <= GreaterOrEqual {
 		return true
 	}
+	if Like <= op && op <= NotBetween && Like <= rhs && rhs <= NotBetween {
+		return true
+	}
 	if Is <= op && op <= IsNot && Is <= rhs && rhs <= IsNot {
 		return true
 	}
@@ -1599,6 +2543,26 @@ func (o Operator) String() string {
 		s = ">="
 	case NotEqual:
 		s = "!="
+	case Like:
+		s = "LIKE"
+	case NotLike:
+		s = "NOT LIKE"
+	case ILike:
+		s = "ILIKE"
+	case NotILike:
+		s = "NOT ILIKE"
+	case RegexMatch:
+		s = "~"
+	case NotRegexMatch:
+		s = "!~"
+	case In:
+		s = "IN"
+	case NotIn:
+		s = "NOT IN"
+	case Between:
+		s = "BETWEEN"
+	case NotBetween:
+		s = "NOT BETWEEN"
 	case Concat:
 		s = "||"
 	case Is:
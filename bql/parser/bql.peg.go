@@ -22,9 +22,11 @@ const (
 	ruleSinkStmt
 	ruleStateStmt
 	ruleStreamStmt
+	ruleFunctionStmt
 	ruleSelectStmt
 	ruleSelectUnionStmt
 	ruleCreateStreamAsSelectStmt
+	ruleCreateOrReplaceStreamAsSelectStmt
 	ruleCreateStreamAsSelectUnionStmt
 	ruleCreateSourceStmt
 	ruleCreateSinkStmt
@@ -40,10 +42,16 @@ const (
 	ruleDropStreamStmt
 	ruleDropSinkStmt
 	ruleDropStateStmt
+	ruleDropFunctionStmt
 	ruleLoadStateStmt
 	ruleLoadStateOrCreateStmt
 	ruleSaveStateStmt
 	ruleEvalStmt
+	ruleExplainStmt
+	ruleShowStmt
+	ruleDescribeStmt
+	ruleCreateFunctionStmt
+	ruleFunctionParams
 	ruleEmitter
 	ruleEmitterOptions
 	ruleEmitterOptionCombinations
@@ -54,6 +62,7 @@ const (
 	ruleTimeBasedSampling
 	ruleTimeBasedSamplingSeconds
 	ruleTimeBasedSamplingMilliseconds
+	ruleDistinctOpt
 	ruleProjections
 	ruleProjection
 	ruleAliasExpression
@@ -62,21 +71,48 @@ const (
 	ruleTimeInterval
 	ruleTuplesInterval
 	ruleRelations
+	ruleJoinedRelation
+	ruleUnnest
+	ruleWithOrdinalityOpt
+	ruleWithOrdinality
+	ruleStateJoin
+	ruleStateJoinAliasOpt
+	ruleJoinType
 	ruleFilter
 	ruleGrouping
 	ruleGroupList
+	ruleGroupingSets
+	ruleRollup
+	ruleCube
+	ruleExplicitGroupingSets
+	ruleGroupingSet
+	ruleParenGroupingSet
+	ruleSingleGroupingSet
 	ruleHaving
+	ruleOrderBy
+	ruleLimit
 	ruleRelationLike
 	ruleAliasedStreamWindow
 	ruleStreamWindow
 	ruleStreamLike
 	ruleUDSFFuncApp
+	ruleRangeWindow
+	ruleSessionWindow
+	ruleSessionKeyOpt
+	ruleSlideSpecOpt
+	ruleSlideInterval
+	ruleLatenessSpecOpt
+	ruleLateness
+	ruleLatePolicyOpt
+	ruleLatePolicy
+	ruleEmitLateTuples
 	ruleCapacitySpecOpt
 	ruleSheddingSpecOpt
 	ruleSheddingOption
 	ruleSourceSinkSpecs
 	ruleUpdateSourceSinkSpecs
 	ruleSetOptSpecs
+	ruleOnErrorOpt
 	ruleStateTagOpt
 	ruleSourceSinkParam
 	ruleSourceSinkParamVal
@@ -85,12 +121,17 @@ const (
 	ruleParamMapExpr
 	ruleParamKeyValuePair
 	rulePausedOpt
+	ruleSchemaOpt
+	ruleSchemaField
+	ruleSchemaFieldPath
+	ruleNotNullOpt
 	ruleExpressionOrWildcard
 	ruleExpression
 	ruleorExpr
 	ruleandExpr
 	rulenotExpr
 	rulecomparisonExpr
+	ruleInList
 	ruleotherOpExpr
 	ruleisExpr
 	ruletermExpr
@@ -99,10 +140,17 @@ const (
 	rulecastExpr
 	rulebaseExpr
 	ruleFuncTypeCast
+	ruleFuncAppOrAnalytic
+	ruleOverSpecOpt
+	rulePartitionByOpt
+	ruleOverOrderByOpt
 	ruleFuncApp
 	ruleFuncAppWithOrderBy
 	ruleFuncAppWithoutOrderBy
+	ruleFuncDistinctOpt
 	ruleFuncParams
+	ruleFuncParam
+	ruleLambda
 	ruleParamsOrder
 	ruleSortedExpression
 	ruleOrderDirectionOpt
@@ -114,7 +162,11 @@ const (
 	ruleExpressionCase
 	ruleWhenThenPair
 	ruleLiteral
+	ruleIntervalLiteral
 	ruleComparisonOp
+	rulePatternMatchOp
+	ruleInOp
+	ruleBetweenOp
 	ruleOtherOp
 	ruleIsOp
 	rulePlusMinusOp
@@ -134,20 +186,37 @@ const (
 	ruleFALSE
 	ruleWildcard
 	ruleStringLiteral
+	ruleShowTarget
+	ruleShowSources
+	ruleShowStreams
+	ruleShowSinks
+	ruleShowStates
+	ruleShowFunctions
+	ruleShowSourceTypes
+	ruleShowSinkTypes
+	ruleShowStateTypes
 	ruleISTREAM
 	ruleDSTREAM
 	ruleRSTREAM
 	ruleTUPLES
 	ruleSECONDS
 	ruleMILLISECONDS
+	ruleMINUTES
+	ruleHOURS
+	ruleTumbling
+	ruleDropLateTuples
+	ruleUpdateLateTuples
 	ruleWait
 	ruleDropOldest
 	ruleDropNewest
+	ruleInnerJoin
+	ruleLeftOuterJoin
 	ruleStreamIdentifier
 	ruleSourceSinkType
 	ruleSourceSinkParamKey
 	rulePaused
 	ruleUnpaused
+	ruleNotNull
 	ruleAscending
 	ruleDescending
 	ruleType
@@ -168,6 +237,16 @@ const (
 	ruleGreater
 	ruleGreaterOrEqual
 	ruleNotEqual
+	ruleLike
+	ruleNotLike
+	ruleILike
+	ruleNotILike
+	ruleRegexMatch
+	ruleNotRegexMatch
+	ruleIn
+	ruleNotIn
+	ruleBetween
+	ruleNotBetween
 	ruleConcat
 	ruleIs
 	ruleIsNot
@@ -335,6 +414,72 @@ const (
 	ruleAction131
 	ruleAction132
 	ruleAction133
+	ruleAction134
+	ruleAction135
+	ruleAction136
+	ruleAction137
+	ruleAction138
+	ruleAction139
+	ruleAction140
+	ruleAction141
+	ruleAction142
+	ruleAction143
+	ruleAction144
+	ruleAction145
+	ruleAction146
+	ruleAction147
+	ruleAction148
+	ruleAction149
+	ruleAction150
+	ruleAction151
+	ruleAction152
+	ruleAction153
+	ruleAction154
+	ruleAction155
+	ruleAction156
+	ruleAction157
+	ruleAction158
+	ruleAction159
+	ruleAction160
+	ruleAction161
+	ruleAction162
+	ruleAction163
+	ruleAction164
+	ruleAction165
+	ruleAction166
+	ruleAction167
+	ruleAction168
+	ruleAction169
+	ruleAction170
+	ruleAction171
+	ruleAction172
+	ruleAction173
+	ruleAction174
+	ruleAction175
+	ruleAction176
+	ruleAction177
+	ruleAction178
+	ruleAction179
+	ruleAction180
+	ruleAction181
+	ruleAction182
+	ruleAction183
+	ruleAction184
+	ruleAction185
+	ruleAction186
+	ruleAction187
+	ruleAction188
+	ruleAction189
+	ruleAction190
+	ruleAction191
+	ruleAction192
+	ruleAction193
+	ruleAction194
+	ruleAction195
+	ruleAction196
+	ruleAction197
+	ruleAction198
+	ruleAction199
 )
 
 var rul3s = [...]string{
@@ -347,9 +492,11 @@ var rul3s = [...]string{
 	"SinkStmt",
 	"StateStmt",
 	"StreamStmt",
+	"FunctionStmt",
 	"SelectStmt",
 	"SelectUnionStmt",
 	"CreateStreamAsSelectStmt",
+	"CreateOrReplaceStreamAsSelectStmt",
 	"CreateStreamAsSelectUnionStmt",
 	"CreateSourceStmt",
 	"CreateSinkStmt",
@@ -365,10 +512,16 @@ var rul3s = [...]string{
 	"DropStreamStmt",
 	"DropSinkStmt",
 	"DropStateStmt",
+	"DropFunctionStmt",
 	"LoadStateStmt",
 	"LoadStateOrCreateStmt",
 	"SaveStateStmt",
 	"EvalStmt",
+	"ExplainStmt",
+	"ShowStmt",
+	"DescribeStmt",
+	"CreateFunctionStmt",
+	"FunctionParams",
 	"Emitter",
 	"EmitterOptions",
 	"EmitterOptionCombinations",
@@ -379,6 +532,7 @@ var rul3s = [...]string{
 	"TimeBasedSampling",
 	"TimeBasedSamplingSeconds",
 	"TimeBasedSamplingMilliseconds",
+	"DistinctOpt",
 	"Projections",
 	"Projection",
 	"AliasExpression",
@@ -387,21 +541,48 @@ var rul3s = [...]string{
 	"TimeInterval",
 	"TuplesInterval",
 	"Relations",
+	"JoinedRelation",
+	"Unnest",
+	"WithOrdinalityOpt",
+	"WithOrdinality",
+	"StateJoin",
+	"StateJoinAliasOpt",
+	"JoinType",
 	"Filter",
 	"Grouping",
 	"GroupList",
+	"GroupingSets",
+	"Rollup",
+	"Cube",
+	"ExplicitGroupingSets",
+	"GroupingSet",
+	"ParenGroupingSet",
+	"SingleGroupingSet",
 	"Having",
+	"OrderBy",
+	"Limit",
 	"RelationLike",
 	"AliasedStreamWindow",
 	"StreamWindow",
 	"StreamLike",
 	"UDSFFuncApp",
+	"RangeWindow",
+	"SessionWindow",
+	"SessionKeyOpt",
+	"SlideSpecOpt",
+	"SlideInterval",
+	"LatenessSpecOpt",
+	"Lateness",
+	"LatePolicyOpt",
+	"LatePolicy",
+	"EmitLateTuples",
 	"CapacitySpecOpt",
 	"SheddingSpecOpt",
 	"SheddingOption",
 	"SourceSinkSpecs",
 	"UpdateSourceSinkSpecs",
 	"SetOptSpecs",
+	"OnErrorOpt",
 	"StateTagOpt",
 	"SourceSinkParam",
 	"SourceSinkParamVal",
@@ -410,12 +591,17 @@ var rul3s = [...]string{
 	"ParamMapExpr",
 	"ParamKeyValuePair",
 	"PausedOpt",
+	"SchemaOpt",
+	"SchemaField",
+	"SchemaFieldPath",
+	"NotNullOpt",
 	"ExpressionOrWildcard",
 	"Expression",
 	"orExpr",
 	"andExpr",
 	"notExpr",
 	"comparisonExpr",
+	"InList",
 	"otherOpExpr",
 	"isExpr",
 	"termExpr",
@@ -424,10 +610,17 @@ var rul3s = [...]string{
 	"castExpr",
 	"baseExpr",
 	"FuncTypeCast",
+	"FuncAppOrAnalytic",
+	"OverSpecOpt",
+	"PartitionByOpt",
+	"OverOrderByOpt",
 	"FuncApp",
 	"FuncAppWithOrderBy",
 	"FuncAppWithoutOrderBy",
+	"FuncDistinctOpt",
 	"FuncParams",
+	"FuncParam",
+	"Lambda",
 	"ParamsOrder",
 	"SortedExpression",
 	"OrderDirectionOpt",
@@ -439,7 +632,11 @@ var rul3s = [...]string{
 	"ExpressionCase",
 	"WhenThenPair",
 	"Literal",
+	"IntervalLiteral",
 	"ComparisonOp",
+	"PatternMatchOp",
+	"InOp",
+	"BetweenOp",
 	"OtherOp",
 	"IsOp",
 	"PlusMinusOp",
@@ -459,20 +656,37 @@ var rul3s = [...]string{
 	"FALSE",
 	"Wildcard",
 	"StringLiteral",
+	"ShowTarget",
+	"ShowSources",
+	"ShowStreams",
+	"ShowSinks",
+	"ShowStates",
+	"ShowFunctions",
+	"ShowSourceTypes",
+	"ShowSinkTypes",
+	"ShowStateTypes",
 	"ISTREAM",
 	"DSTREAM",
 	"RSTREAM",
 	"TUPLES",
 	"SECONDS",
 	"MILLISECONDS",
+	"MINUTES",
+	"HOURS",
+	"Tumbling",
+	"DropLateTuples",
+	"UpdateLateTuples",
 	"Wait",
 	"DropOldest",
 	"DropNewest",
+	"InnerJoin",
+	"LeftOuterJoin",
 	"StreamIdentifier",
 	"SourceSinkType",
 	"SourceSinkParamKey",
 	"Paused",
 	"Unpaused",
+	"NotNull",
 	"Ascending",
 	"Descending",
 	"Type",
@@ -493,6 +707,16 @@ var rul3s = [...]string{
 	"Greater",
 	"GreaterOrEqual",
 	"NotEqual",
+	"Like",
+	"NotLike",
+	"ILike",
+	"NotILike",
+	"RegexMatch",
+	"NotRegexMatch",
+	"In",
+	"NotIn",
+	"Between",
+	"NotBetween",
 	"Concat",
 	"Is",
 	"IsNot",
@@ -660,6 +884,72 @@ var rul3s = [...]string{
 	"Action131",
 	"Action132",
 	"Action133",
+	"Action134",
+	"Action135",
+	"Action136",
+	"Action137",
+	"Action138",
+	"Action139",
+	"Action140",
+	"Action141",
+	"Action142",
+	"Action143",
+	"Action144",
+	"Action145",
+	"Action146",
+	"Action147",
+	"Action148",
+	"Action149",
+	"Action150",
+	"Action151",
+	"Action152",
+	"Action153",
+	"Action154",
+	"Action155",
+	"Action156",
+	"Action157",
+	"Action158",
+	"Action159",
+	"Action160",
+	"Action161",
+	"Action162",
+	"Action163",
+	"Action164",
+	"Action165",
+	"Action166",
+	"Action167",
+	"Action168",
+	"Action169",
+	"Action170",
+	"Action171",
+	"Action172",
+	"Action173",
+	"Action174",
+	"Action175",
+	"Action176",
+	"Action177",
+	"Action178",
+	"Action179",
+	"Action180",
+	"Action181",
+	"Action182",
+	"Action183",
+	"Action184",
+	"Action185",
+	"Action186",
+	"Action187",
+	"Action188",
+	"Action189",
+	"Action190",
+	"Action191",
+	"Action192",
+	"Action193",
+	"Action194",
+	"Action195",
+	"Action196",
+	"Action197",
+	"Action198",
+	"Action199",
 }
 
 type token32 struct {
@@ -776,7 +1066,7 @@ type bqlPegBackend struct {
 
 	Buffer string
 	buffer []rune
-	rules  [322]func() bool
+	rules  [467]func() bool
 	parse  func(rule ...int) error
 	reset  func()
 	Pretty bool
@@ -890,541 +1180,813 @@ func (p *bqlPegBackend) Execute() {
 
 		case ruleAction5:
 
-			p.AssembleCreateStreamAsSelectUnion()
+			p.AssembleCreateOrReplaceStreamAsSelect()
 
 		case ruleAction6:
 
-			p.AssembleCreateSource()
+			p.AssembleCreateStreamAsSelectUnion()
 
 		case ruleAction7:
 
-			p.AssembleCreateSink()
+			p.AssembleCreateSource()
 
 		case ruleAction8:
 
-			p.AssembleCreateState()
+			p.AssembleCreateSink()
 
 		case ruleAction9:
 
-			p.AssembleUpdateState()
+			p.AssembleCreateState()
 
 		case ruleAction10:
 
-			p.AssembleUpdateSource()
+			p.AssembleUpdateState()
 
 		case ruleAction11:
 
-			p.AssembleUpdateSink()
+			p.AssembleUpdateSource()
 
 		case ruleAction12:
 
-			p.AssembleInsertIntoFrom()
+			p.AssembleUpdateSink()
 
 		case ruleAction13:
 
-			p.AssemblePauseSource()
+			p.AssembleInsertIntoFrom()
 
 		case ruleAction14:
 
-			p.AssembleResumeSource()
+			p.AssemblePauseSource()
 
 		case ruleAction15:
 
-			p.AssembleRewindSource()
+			p.AssembleResumeSource()
 
 		case ruleAction16:
 
-			p.AssembleDropSource()
+			p.AssembleRewindSource()
 
 		case ruleAction17:
 
-			p.AssembleDropStream()
+			p.AssembleDropSource()
 
 		case ruleAction18:
 
-			p.AssembleDropSink()
+			p.AssembleDropStream()
 
 		case ruleAction19:
 
-			p.AssembleDropState()
+			p.AssembleDropSink()
 
 		case ruleAction20:
 
-			p.AssembleLoadState()
+			p.AssembleDropState()
 
 		case ruleAction21:
 
-			p.AssembleLoadStateOrCreate()
+			p.AssembleDropFunction()
 
 		case ruleAction22:
 
-			p.AssembleSaveState()
+			p.AssembleLoadState()
 
 		case ruleAction23:
 
-			p.AssembleEval(begin, end)
+			p.AssembleLoadStateOrCreate()
 
 		case ruleAction24:
 
-			p.AssembleEmitter()
+			p.AssembleSaveState()
 
 		case ruleAction25:
 
-			p.AssembleEmitterOptions(begin, end)
+			p.AssembleEval(begin, end)
 
 		case ruleAction26:
 
-			p.AssembleEmitterLimit()
+			p.AssembleExplain()
 
 		case ruleAction27:
 
-			p.AssembleEmitterSampling(CountBasedSampling, 1)
+			p.AssembleShow()
 
 		case ruleAction28:
 
-			p.AssembleEmitterSampling(RandomizedSampling, 1)
+			p.AssembleDescribe()
 
 		case ruleAction29:
 
-			p.AssembleEmitterSampling(TimeBasedSampling, 1)
+			p.AssembleCreateFunction()
 
 		case ruleAction30:
 
-			p.AssembleEmitterSampling(TimeBasedSampling, 0.001)
+			p.AssembleFunctionParams(begin, end)
 
 		case ruleAction31:
 
-			p.AssembleProjections(begin, end)
+			p.AssembleEmitter()
 
 		case ruleAction32:
 
-			p.AssembleAlias()
+			p.AssembleEmitterOptions(begin, end)
 
 		case ruleAction33:
 
-			// This is *always* executed, even if there is no
-			// FROM clause present in the statement.
-			p.AssembleWindowedFrom(begin, end)
+			p.AssembleEmitterLimit()
 
 		case ruleAction34:
 
-			p.AssembleInterval()
+			p.AssembleEmitterSampling(CountBasedSampling, 1)
 
 		case ruleAction35:
 
-			p.AssembleInterval()
+			p.AssembleEmitterSampling(RandomizedSampling, 1)
 
 		case ruleAction36:
 
-			// This is *always* executed, even if there is no
-			// WHERE clause present in the statement.
-			p.AssembleFilter(begin, end)
+			p.AssembleEmitterSampling(TimeBasedSampling, 1)
 
 		case ruleAction37:
 
-			// This is *always* executed, even if there is no
-			// GROUP BY clause present in the statement.
-			p.AssembleGrouping(begin, end)
+			p.AssembleEmitterSampling(TimeBasedSampling, 0.001)
 
 		case ruleAction38:
 
-			// This is *always* executed, even if there is no
-			// HAVING clause present in the statement.
-			p.AssembleHaving(begin, end)
+			p.AssembleDistinct(begin, end)
 
 		case ruleAction39:
 
-			p.EnsureAliasedStreamWindow()
+			p.AssembleProjections(begin, end)
 
 		case ruleAction40:
 
-			p.AssembleAliasedStreamWindow()
+			p.AssembleAlias()
 
 		case ruleAction41:
 
-			p.AssembleStreamWindow()
+			// This is *always* executed, even if there is no
+			// FROM clause present in the statement.
+			p.AssembleWindowedFrom(begin, end)
 
 		case ruleAction42:
 
-			p.AssembleUDSFFuncApp()
+			p.AssembleInterval()
 
 		case ruleAction43:
 
-			p.EnsureCapacitySpec(begin, end)
+			p.AssembleInterval()
 
 		case ruleAction44:
 
-			p.EnsureSheddingSpec(begin, end)
+			p.AssembleJoin()
 
 		case ruleAction45:
 
-			p.AssembleSourceSinkSpecs(begin, end)
+			p.AssembleUnnest()
 
 		case ruleAction46:
 
-			p.AssembleSourceSinkSpecs(begin, end)
+			p.EnsureKeywordPresent(begin, end)
 
 		case ruleAction47:
 
-			p.AssembleSourceSinkSpecs(begin, end)
+			p.PushComponent(begin, end, Yes)
 
 		case ruleAction48:
 
-			p.EnsureIdentifier(begin, end)
+			p.AssembleStateJoin()
 
 		case ruleAction49:
 
-			p.AssembleSourceSinkParam()
+			p.EnsureIdentifier(begin, end)
 
 		case ruleAction50:
 
-			p.AssembleExpressions(begin, end)
-			p.AssembleArray()
+			// This is *always* executed, even if there is no
+			// WHERE clause present in the statement.
+			p.AssembleFilter(begin, end)
 
 		case ruleAction51:
 
-			p.AssembleMap(begin, end)
+			// This is *always* executed, even if there is no
+			// GROUP BY clause present in the statement.
+			p.AssembleGrouping(begin, end)
 
 		case ruleAction52:
 
-			p.AssembleKeyValuePair()
+			p.PushComponent(begin, end, RollupGroupingSets)
 
 		case ruleAction53:
 
-			p.EnsureKeywordPresent(begin, end)
+			p.PushComponent(begin, end, CubeGroupingSets)
 
 		case ruleAction54:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.PushComponent(begin, end, ExplicitGroupingSets)
 
 		case ruleAction55:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.AssembleExpressions(begin, end)
 
 		case ruleAction56:
 
-			p.AssembleUnaryPrefixOperation(begin, end)
+			p.AssembleExpressions(begin, end)
 
 		case ruleAction57:
 
-			p.AssembleBinaryOperation(begin, end)
+			// This is *always* executed, even if there is no
+			// HAVING clause present in the statement.
+			p.AssembleHaving(begin, end)
 
 		case ruleAction58:
 
-			p.AssembleBinaryOperation(begin, end)
+			// This is *always* executed, even if there is no
+			// ORDER BY clause present in the statement.
+			p.AssembleOrderBy(begin, end)
 
 		case ruleAction59:
 
-			p.AssembleBinaryOperation(begin, end)
+			// This is *always* executed, even if there is no
+			// LIMIT clause present in the statement.
+			p.AssembleLimit(begin, end)
 
 		case ruleAction60:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.EnsureAliasedStreamWindow()
 
 		case ruleAction61:
 
-			p.AssembleBinaryOperation(begin, end)
+			p.AssembleAliasedStreamWindow()
 
 		case ruleAction62:
 
-			p.AssembleUnaryPrefixOperation(begin, end)
+			p.AssembleStreamWindow()
 
 		case ruleAction63:
 
-			p.AssembleTypeCast(begin, end)
+			p.AssembleUDSFFuncApp()
 
 		case ruleAction64:
 
-			p.AssembleTypeCast(begin, end)
+			p.AssembleSession(begin, end)
 
 		case ruleAction65:
 
-			p.AssembleFuncApp()
+			p.EnsureSlideSpec(begin, end)
 
 		case ruleAction66:
 
-			p.AssembleExpressions(begin, end)
-			p.AssembleFuncApp()
+			p.AssembleSlide()
 
 		case ruleAction67:
 
-			p.AssembleExpressions(begin, end)
+			p.EnsureLatenessSpec(begin, end)
 
 		case ruleAction68:
 
-			p.AssembleExpressions(begin, end)
+			p.AssembleLateness()
 
 		case ruleAction69:
 
-			p.AssembleSortedExpression()
+			p.EnsureLatePolicy(begin, end)
 
 		case ruleAction70:
 
-			p.EnsureKeywordPresent(begin, end)
+			p.AssembleEmitLateTuples()
 
 		case ruleAction71:
 
-			p.AssembleExpressions(begin, end)
-			p.AssembleArray()
+			p.EnsureCapacitySpec(begin, end)
 
 		case ruleAction72:
 
-			p.AssembleMap(begin, end)
+			p.EnsureSheddingSpec(begin, end)
 
 		case ruleAction73:
 
-			p.AssembleKeyValuePair()
+			p.AssembleSourceSinkSpecs(begin, end)
 
 		case ruleAction74:
 
-			p.AssembleConditionCase(begin, end)
+			p.AssembleSourceSinkSpecs(begin, end)
 
 		case ruleAction75:
 
-			p.AssembleExpressionCase(begin, end)
+			p.AssembleSourceSinkSpecs(begin, end)
 
 		case ruleAction76:
 
-			p.AssembleWhenThenPair()
+			p.AssembleOnError(begin, end)
 
 		case ruleAction77:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewStream(substr))
+			p.EnsureIdentifier(begin, end)
 
 		case ruleAction78:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewRowMeta(substr, TimestampMeta))
+			p.AssembleSourceSinkParam()
 
 		case ruleAction79:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewRowValue(substr))
+			p.AssembleExpressions(begin, end)
+			p.AssembleArray()
 
 		case ruleAction80:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewNumericLiteral(substr))
+			p.AssembleMap(begin, end)
 
 		case ruleAction81:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewNumericLiteral(substr))
+			p.AssembleKeyValuePair()
 
 		case ruleAction82:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewFloatLiteral(substr))
+			p.EnsureKeywordPresent(begin, end)
 
 		case ruleAction83:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, FuncName(substr))
+			// This is *always* executed, even if there is no
+			// schema present in the statement.
+			p.AssembleSchema(begin, end)
 
 		case ruleAction84:
 
-			p.PushComponent(begin, end, NewNullLiteral())
+			p.AssembleSchemaField()
 
 		case ruleAction85:
 
-			p.PushComponent(begin, end, NewMissing())
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, Identifier(substr))
 
 		case ruleAction86:
 
-			p.PushComponent(begin, end, NewBoolLiteral(true))
+			p.EnsureKeywordPresent(begin, end)
 
 		case ruleAction87:
 
-			p.PushComponent(begin, end, NewBoolLiteral(false))
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction88:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewWildcard(substr))
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction89:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, NewStringLiteral(substr))
+			p.AssembleUnaryPrefixOperation(begin, end)
 
 		case ruleAction90:
 
-			p.PushComponent(begin, end, Istream)
+			p.AssembleComparison(begin, end)
 
 		case ruleAction91:
 
-			p.PushComponent(begin, end, Dstream)
+			p.AssembleExpressions(begin, end)
+			p.AssembleArray()
 
 		case ruleAction92:
 
-			p.PushComponent(begin, end, Rstream)
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction93:
 
-			p.PushComponent(begin, end, Tuples)
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction94:
 
-			p.PushComponent(begin, end, Seconds)
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction95:
 
-			p.PushComponent(begin, end, Milliseconds)
+			p.AssembleBinaryOperation(begin, end)
 
 		case ruleAction96:
 
-			p.PushComponent(begin, end, Wait)
+			p.AssembleUnaryPrefixOperation(begin, end)
 
 		case ruleAction97:
 
-			p.PushComponent(begin, end, DropOldest)
+			p.AssembleTypeCast(begin, end)
 
 		case ruleAction98:
 
-			p.PushComponent(begin, end, DropNewest)
+			p.AssembleTypeCast(begin, end)
 
 		case ruleAction99:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, StreamIdentifier(substr))
+			p.AssembleAnalyticFuncApp(begin, end)
 
 		case ruleAction100:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, SourceSinkType(substr))
+			p.AssembleExpressions(begin, end)
 
 		case ruleAction101:
 
-			substr := string([]rune(buffer)[begin:end])
-			p.PushComponent(begin, end, SourceSinkParamKey(substr))
+			p.AssembleExpressions(begin, end)
 
 		case ruleAction102:
 
-			p.PushComponent(begin, end, Yes)
+			p.AssembleFuncApp()
 
 		case ruleAction103:
 
-			p.PushComponent(begin, end, No)
+			p.AssembleExpressions(begin, end)
+			p.AssembleFuncApp()
 
 		case ruleAction104:
 
-			p.PushComponent(begin, end, Yes)
+			p.AssembleDistinct(begin, end)
 
 		case ruleAction105:
 
-			p.PushComponent(begin, end, No)
+			p.AssembleExpressions(begin, end)
 
 		case ruleAction106:
 
-			p.PushComponent(begin, end, Bool)
+			p.AssembleLambda()
 
 		case ruleAction107:
 
-			p.PushComponent(begin, end, Int)
+			p.AssembleExpressions(begin, end)
 
 		case ruleAction108:
 
-			p.PushComponent(begin, end, Float)
+			p.AssembleSortedExpression()
 
 		case ruleAction109:
 
-			p.PushComponent(begin, end, String)
+			p.EnsureKeywordPresent(begin, end)
 
 		case ruleAction110:
 
-			p.PushComponent(begin, end, Blob)
+			p.AssembleExpressions(begin, end)
+			p.AssembleArray()
 
 		case ruleAction111:
 
-			p.PushComponent(begin, end, Timestamp)
+			p.AssembleMap(begin, end)
 
 		case ruleAction112:
 
-			p.PushComponent(begin, end, Array)
+			p.AssembleKeyValuePair()
 
 		case ruleAction113:
 
-			p.PushComponent(begin, end, Map)
+			p.AssembleConditionCase(begin, end)
 
 		case ruleAction114:
 
-			p.PushComponent(begin, end, Or)
+			p.AssembleExpressionCase(begin, end)
 
 		case ruleAction115:
 
-			p.PushComponent(begin, end, And)
+			p.AssembleWhenThenPair()
 
 		case ruleAction116:
 
-			p.PushComponent(begin, end, Not)
+			p.AssembleIntervalLiteral(begin, end)
 
 		case ruleAction117:
 
-			p.PushComponent(begin, end, Equal)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewStream(substr))
 
 		case ruleAction118:
 
-			p.PushComponent(begin, end, Less)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewRowMeta(substr, TimestampMeta))
 
 		case ruleAction119:
 
-			p.PushComponent(begin, end, LessOrEqual)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewRowValue(substr))
 
 		case ruleAction120:
 
-			p.PushComponent(begin, end, Greater)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewNumericLiteral(substr))
 
 		case ruleAction121:
 
-			p.PushComponent(begin, end, GreaterOrEqual)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewNumericLiteral(substr))
 
 		case ruleAction122:
 
-			p.PushComponent(begin, end, NotEqual)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewFloatLiteral(substr))
 
 		case ruleAction123:
 
-			p.PushComponent(begin, end, Concat)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, FuncName(substr))
 
 		case ruleAction124:
 
-			p.PushComponent(begin, end, Is)
+			p.PushComponent(begin, end, NewNullLiteral())
 
 		case ruleAction125:
 
-			p.PushComponent(begin, end, IsNot)
+			p.PushComponent(begin, end, NewMissing())
 
 		case ruleAction126:
 
-			p.PushComponent(begin, end, Plus)
+			p.PushComponent(begin, end, NewBoolLiteral(true))
 
 		case ruleAction127:
 
-			p.PushComponent(begin, end, Minus)
+			p.PushComponent(begin, end, NewBoolLiteral(false))
 
 		case ruleAction128:
 
-			p.PushComponent(begin, end, Multiply)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewWildcard(substr))
 
 		case ruleAction129:
 
-			p.PushComponent(begin, end, Divide)
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, NewStringLiteral(substr))
 
 		case ruleAction130:
 
-			p.PushComponent(begin, end, Modulo)
+			p.PushComponent(begin, end, ShowSources)
 
 		case ruleAction131:
 
-			p.PushComponent(begin, end, UnaryMinus)
+			p.PushComponent(begin, end, ShowStreams)
 
 		case ruleAction132:
 
+			p.PushComponent(begin, end, ShowSinks)
+
+		case ruleAction133:
+
+			p.PushComponent(begin, end, ShowStates)
+
+		case ruleAction134:
+
+			p.PushComponent(begin, end, ShowFunctions)
+
+		case ruleAction135:
+
+			p.PushComponent(begin, end, ShowSourceTypes)
+
+		case ruleAction136:
+
+			p.PushComponent(begin, end, ShowSinkTypes)
+
+		case ruleAction137:
+
+			p.PushComponent(begin, end, ShowStateTypes)
+
+		case ruleAction138:
+
+			p.PushComponent(begin, end, Istream)
+
+		case ruleAction139:
+
+			p.PushComponent(begin, end, Dstream)
+
+		case ruleAction140:
+
+			p.PushComponent(begin, end, Rstream)
+
+		case ruleAction141:
+
+			p.PushComponent(begin, end, Tuples)
+
+		case ruleAction142:
+
+			p.PushComponent(begin, end, Seconds)
+
+		case ruleAction143:
+
+			p.PushComponent(begin, end, Milliseconds)
+
+		case ruleAction144:
+
+			p.PushComponent(begin, end, Minutes)
+
+		case ruleAction145:
+
+			p.PushComponent(begin, end, Hours)
+
+		case ruleAction146:
+
+			p.PushComponent(begin, end, SlideAST{Tumbling: true})
+
+		case ruleAction147:
+
+			p.PushComponent(begin, end, LatePolicyAST{Policy: DropLateTuples})
+
+		case ruleAction148:
+
+			p.PushComponent(begin, end, LatePolicyAST{Policy: UpdateLateTuples})
+
+		case ruleAction149:
+
+			p.PushComponent(begin, end, Wait)
+
+		case ruleAction150:
+
+			p.PushComponent(begin, end, DropOldest)
+
+		case ruleAction151:
+
+			p.PushComponent(begin, end, DropNewest)
+
+		case ruleAction152:
+
+			p.PushComponent(begin, end, InnerJoin)
+
+		case ruleAction153:
+
+			p.PushComponent(begin, end, LeftOuterJoin)
+
+		case ruleAction154:
+
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, StreamIdentifier(substr))
+
+		case ruleAction155:
+
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, SourceSinkType(substr))
+
+		case ruleAction156:
+
+			substr := string([]rune(buffer)[begin:end])
+			p.PushComponent(begin, end, SourceSinkParamKey(substr))
+
+		case ruleAction157:
+
+			p.PushComponent(begin, end, Yes)
+
+		case ruleAction158:
+
+			p.PushComponent(begin, end, No)
+
+		case ruleAction159:
+
+			p.PushComponent(begin, end, Yes)
+
+		case ruleAction160:
+
+			p.PushComponent(begin, end, Yes)
+
+		case ruleAction161:
+
+			p.PushComponent(begin, end, No)
+
+		case ruleAction162:
+
+			p.PushComponent(begin, end, Bool)
+
+		case ruleAction163:
+
+			p.PushComponent(begin, end, Int)
+
+		case ruleAction164:
+
+			p.PushComponent(begin, end, Float)
+
+		case ruleAction165:
+
+			p.PushComponent(begin, end, String)
+
+		case ruleAction166:
+
+			p.PushComponent(begin, end, Blob)
+
+		case ruleAction167:
+
+			p.PushComponent(begin, end, Timestamp)
+
+		case ruleAction168:
+
+			p.PushComponent(begin, end, Array)
+
+		case ruleAction169:
+
+			p.PushComponent(begin, end, Map)
+
+		case ruleAction170:
+
+			p.PushComponent(begin, end, Or)
+
+		case ruleAction171:
+
+			p.PushComponent(begin, end, And)
+
+		case ruleAction172:
+
+			p.PushComponent(begin, end, Not)
+
+		case ruleAction173:
+
+			p.PushComponent(begin, end, Equal)
+
+		case ruleAction174:
+
+			p.PushComponent(begin, end, Less)
+
+		case ruleAction175:
+
+			p.PushComponent(begin, end, LessOrEqual)
+
+		case ruleAction176:
+
+			p.PushComponent(begin, end, Greater)
+
+		case ruleAction177:
+
+			p.PushComponent(begin, end, GreaterOrEqual)
+
+		case ruleAction178:
+
+			p.PushComponent(begin, end, NotEqual)
+
+		case ruleAction179:
+
+			p.PushComponent(begin, end, Like)
+
+		case ruleAction180:
+
+			p.PushComponent(begin, end, NotLike)
+
+		case ruleAction181:
+
+			p.PushComponent(begin, end, ILike)
+
+		case ruleAction182:
+
+			p.PushComponent(begin, end, NotILike)
+
+		case ruleAction183:
+
+			p.PushComponent(begin, end, RegexMatch)
+
+		case ruleAction184:
+
+			p.PushComponent(begin, end, NotRegexMatch)
+
+		case ruleAction185:
+
+			p.PushComponent(begin, end, In)
+
+		case ruleAction186:
+
+			p.PushComponent(begin, end, NotIn)
+
+		case ruleAction187:
+
+			p.PushComponent(begin, end, Between)
+
+		case ruleAction188:
+
+			p.PushComponent(begin, end, NotBetween)
+
+		case ruleAction189:
+
+			p.PushComponent(begin, end, Concat)
+
+		case ruleAction190:
+
+			p.PushComponent(begin, end, Is)
+
+		case ruleAction191:
+
+			p.PushComponent(begin, end, IsNot)
+
+		case ruleAction192:
+
+			p.PushComponent(begin, end, Plus)
+
+		case ruleAction193:
+
+			p.PushComponent(begin, end, Minus)
+
+		case ruleAction194:
+
+			p.PushComponent(begin, end, Multiply)
+
+		case ruleAction195:
+
+			p.PushComponent(begin, end, Divide)
+
+		case ruleAction196:
+
+			p.PushComponent(begin, end, Modulo)
+
+		case ruleAction197:
+
+			p.PushComponent(begin, end, UnaryMinus)
+
+		case ruleAction198:
+
 			substr := string([]rune(buffer)[begin:end])
 			p.PushComponent(begin, end, Identifier(substr))
 
-		case ruleAction133:
+		case ruleAction199:
 
 			substr := string([]rune(buffer)[begin:end])
 			p.PushComponent(begin, end, Identifier(substr))
@@ -1606,7 +2168,7 @@ func (p *bqlPegBackend) Init() {
 			position, tokenIndex = position10, tokenIndex10
 			return false
 		},
-		/* 3 Statement <- <(SelectUnionStmt / SelectStmt / SourceStmt / SinkStmt / StateStmt / StreamStmt / EvalStmt)> */
+		/* 3 Statement <- <(SelectUnionStmt / SelectStmt / SourceStmt / SinkStmt / StateStmt / StreamStmt / FunctionStmt / EvalStmt / ExplainStmt / ShowStmt / DescribeStmt)> */
 		func() bool {
 			position13, tokenIndex13 := position, tokenIndex
 			{
@@ -1648,8 +2210,32 @@ func (p *bqlPegBackend) Init() {
 					}
 					goto l15
 				l21:
+					position, tokenIndex = position15, tokenIndex15
+					if !_rules[ruleFunctionStmt]() {
+						goto l22
+					}
+					goto l15
+				l22:
 					position, tokenIndex = position15, tokenIndex15
 					if !_rules[ruleEvalStmt]() {
+						goto l23
+					}
+					goto l15
+				l23:
+					position, tokenIndex = position15, tokenIndex15
+					if !_rules[ruleExplainStmt]() {
+						goto l24
+					}
+					goto l15
+				l24:
+					position, tokenIndex = position15, tokenIndex15
+					if !_rules[ruleShowStmt]() {
+						goto l25
+					}
+					goto l15
+				l25:
+					position, tokenIndex = position15, tokenIndex15
+					if !_rules[ruleDescribeStmt]() {
 						goto l13
 					}
 				}
@@ -1663,8267 +2249,8270 @@ func (p *bqlPegBackend) Init() {
 		},
 		/* 4 SourceStmt <- <(CreateSourceStmt / UpdateSourceStmt / DropSourceStmt / PauseSourceStmt / ResumeSourceStmt / RewindSourceStmt)> */
 		func() bool {
-			position22, tokenIndex22 := position, tokenIndex
+			position26, tokenIndex26 := position, tokenIndex
 			{
-				position23 := position
+				position27 := position
 				{
-					position24, tokenIndex24 := position, tokenIndex
+					position28, tokenIndex28 := position, tokenIndex
 					if !_rules[ruleCreateSourceStmt]() {
-						goto l25
+						goto l29
 					}
-					goto l24
-				l25:
-					position, tokenIndex = position24, tokenIndex24
+					goto l28
+				l29:
+					position, tokenIndex = position28, tokenIndex28
 					if !_rules[ruleUpdateSourceStmt]() {
-						goto l26
+						goto l30
 					}
-					goto l24
-				l26:
-					position, tokenIndex = position24, tokenIndex24
+					goto l28
+				l30:
+					position, tokenIndex = position28, tokenIndex28
 					if !_rules[ruleDropSourceStmt]() {
-						goto l27
+						goto l31
 					}
-					goto l24
-				l27:
-					position, tokenIndex = position24, tokenIndex24
+					goto l28
+				l31:
+					position, tokenIndex = position28, tokenIndex28
 					if !_rules[rulePauseSourceStmt]() {
-						goto l28
+						goto l32
 					}
-					goto l24
-				l28:
-					position, tokenIndex = position24, tokenIndex24
+					goto l28
+				l32:
+					position, tokenIndex = position28, tokenIndex28
 					if !_rules[ruleResumeSourceStmt]() {
-						goto l29
+						goto l33
 					}
-					goto l24
-				l29:
-					position, tokenIndex = position24, tokenIndex24
+					goto l28
+				l33:
+					position, tokenIndex = position28, tokenIndex28
 					if !_rules[ruleRewindSourceStmt]() {
-						goto l22
+						goto l26
 					}
 				}
-			l24:
-				add(ruleSourceStmt, position23)
+			l28:
+				add(ruleSourceStmt, position27)
 			}
 			return true
-		l22:
-			position, tokenIndex = position22, tokenIndex22
+		l26:
+			position, tokenIndex = position26, tokenIndex26
 			return false
 		},
 		/* 5 SinkStmt <- <(CreateSinkStmt / UpdateSinkStmt / DropSinkStmt)> */
 		func() bool {
-			position30, tokenIndex30 := position, tokenIndex
+			position34, tokenIndex34 := position, tokenIndex
 			{
-				position31 := position
+				position35 := position
 				{
-					position32, tokenIndex32 := position, tokenIndex
+					position36, tokenIndex36 := position, tokenIndex
 					if !_rules[ruleCreateSinkStmt]() {
-						goto l33
+						goto l37
 					}
-					goto l32
-				l33:
-					position, tokenIndex = position32, tokenIndex32
+					goto l36
+				l37:
+					position, tokenIndex = position36, tokenIndex36
 					if !_rules[ruleUpdateSinkStmt]() {
-						goto l34
+						goto l38
 					}
-					goto l32
-				l34:
-					position, tokenIndex = position32, tokenIndex32
+					goto l36
+				l38:
+					position, tokenIndex = position36, tokenIndex36
 					if !_rules[ruleDropSinkStmt]() {
-						goto l30
+						goto l34
 					}
 				}
-			l32:
-				add(ruleSinkStmt, position31)
+			l36:
+				add(ruleSinkStmt, position35)
 			}
 			return true
-		l30:
-			position, tokenIndex = position30, tokenIndex30
+		l34:
+			position, tokenIndex = position34, tokenIndex34
 			return false
 		},
 		/* 6 StateStmt <- <(CreateStateStmt / UpdateStateStmt / DropStateStmt / LoadStateOrCreateStmt / LoadStateStmt / SaveStateStmt)> */
 		func() bool {
-			position35, tokenIndex35 := position, tokenIndex
+			position39, tokenIndex39 := position, tokenIndex
 			{
-				position36 := position
+				position40 := position
 				{
-					position37, tokenIndex37 := position, tokenIndex
+					position41, tokenIndex41 := position, tokenIndex
 					if !_rules[ruleCreateStateStmt]() {
-						goto l38
+						goto l42
 					}
-					goto l37
-				l38:
-					position, tokenIndex = position37, tokenIndex37
+					goto l41
+				l42:
+					position, tokenIndex = position41, tokenIndex41
 					if !_rules[ruleUpdateStateStmt]() {
-						goto l39
+						goto l43
 					}
-					goto l37
-				l39:
-					position, tokenIndex = position37, tokenIndex37
+					goto l41
+				l43:
+					position, tokenIndex = position41, tokenIndex41
 					if !_rules[ruleDropStateStmt]() {
-						goto l40
+						goto l44
 					}
-					goto l37
-				l40:
-					position, tokenIndex = position37, tokenIndex37
+					goto l41
+				l44:
+					position, tokenIndex = position41, tokenIndex41
 					if !_rules[ruleLoadStateOrCreateStmt]() {
-						goto l41
+						goto l45
 					}
-					goto l37
-				l41:
-					position, tokenIndex = position37, tokenIndex37
+					goto l41
+				l45:
+					position, tokenIndex = position41, tokenIndex41
 					if !_rules[ruleLoadStateStmt]() {
-						goto l42
+						goto l46
 					}
-					goto l37
-				l42:
-					position, tokenIndex = position37, tokenIndex37
+					goto l41
+				l46:
+					position, tokenIndex = position41, tokenIndex41
 					if !_rules[ruleSaveStateStmt]() {
-						goto l35
+						goto l39
 					}
 				}
-			l37:
-				add(ruleStateStmt, position36)
+			l41:
+				add(ruleStateStmt, position40)
 			}
 			return true
-		l35:
-			position, tokenIndex = position35, tokenIndex35
+		l39:
+			position, tokenIndex = position39, tokenIndex39
 			return false
 		},
-		/* 7 StreamStmt <- <(CreateStreamAsSelectUnionStmt / CreateStreamAsSelectStmt / DropStreamStmt / InsertIntoFromStmt)> */
+		/* 7 StreamStmt <- <(CreateStreamAsSelectUnionStmt / CreateStreamAsSelectStmt / CreateOrReplaceStreamAsSelectStmt / DropStreamStmt / InsertIntoFromStmt)> */
 		func() bool {
-			position43, tokenIndex43 := position, tokenIndex
+			position47, tokenIndex47 := position, tokenIndex
 			{
-				position44 := position
+				position48 := position
 				{
-					position45, tokenIndex45 := position, tokenIndex
+					position49, tokenIndex49 := position, tokenIndex
 					if !_rules[ruleCreateStreamAsSelectUnionStmt]() {
-						goto l46
+						goto l50
 					}
-					goto l45
-				l46:
-					position, tokenIndex = position45, tokenIndex45
+					goto l49
+				l50:
+					position, tokenIndex = position49, tokenIndex49
 					if !_rules[ruleCreateStreamAsSelectStmt]() {
-						goto l47
+						goto l51
+					}
+					goto l49
+				l51:
+					position, tokenIndex = position49, tokenIndex49
+					if !_rules[ruleCreateOrReplaceStreamAsSelectStmt]() {
+						goto l52
 					}
-					goto l45
-				l47:
-					position, tokenIndex = position45, tokenIndex45
+					goto l49
+				l52:
+					position, tokenIndex = position49, tokenIndex49
 					if !_rules[ruleDropStreamStmt]() {
-						goto l48
+						goto l53
 					}
-					goto l45
-				l48:
-					position, tokenIndex = position45, tokenIndex45
+					goto l49
+				l53:
+					position, tokenIndex = position49, tokenIndex49
 					if !_rules[ruleInsertIntoFromStmt]() {
-						goto l43
+						goto l47
 					}
 				}
-			l45:
-				add(ruleStreamStmt, position44)
+			l49:
+				add(ruleStreamStmt, position48)
 			}
 			return true
-		l43:
-			position, tokenIndex = position43, tokenIndex43
+		l47:
+			position, tokenIndex = position47, tokenIndex47
 			return false
 		},
-		/* 8 SelectStmt <- <(('s' / 'S') ('e' / 'E') ('l' / 'L') ('e' / 'E') ('c' / 'C') ('t' / 'T') Emitter Projections WindowedFrom Filter Grouping Having Action2)> */
+		/* 8 FunctionStmt <- <(CreateFunctionStmt / DropFunctionStmt)> */
 		func() bool {
-			position49, tokenIndex49 := position, tokenIndex
+			position54, tokenIndex54 := position, tokenIndex
 			{
-				position50 := position
+				position55 := position
 				{
-					position51, tokenIndex51 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l52
+					position56, tokenIndex56 := position, tokenIndex
+					if !_rules[ruleCreateFunctionStmt]() {
+						goto l57
 					}
-					position++
-					goto l51
-				l52:
-					position, tokenIndex = position51, tokenIndex51
-					if buffer[position] != rune('S') {
-						goto l49
+					goto l56
+				l57:
+					position, tokenIndex = position56, tokenIndex56
+					if !_rules[ruleDropFunctionStmt]() {
+						goto l54
+					}
+				}
+			l56:
+				add(ruleFunctionStmt, position55)
+			}
+			return true
+		l54:
+			position, tokenIndex = position54, tokenIndex54
+			return false
+		},
+		/* 9 SelectStmt <- <(('s' / 'S') ('e' / 'E') ('l' / 'L') ('e' / 'E') ('c' / 'C') ('t' / 'T') Emitter DistinctOpt Projections WindowedFrom Filter Grouping Having OrderBy Limit Action2)> */
+		func() bool {
+			position58, tokenIndex58 := position, tokenIndex
+			{
+				position59 := position
+				{
+					position60, tokenIndex60 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l61
+					}
+					position++
+					goto l60
+				l61:
+					position, tokenIndex = position60, tokenIndex60
+					if buffer[position] != rune('S') {
+						goto l58
 					}
 					position++
 				}
-			l51:
+			l60:
 				{
-					position53, tokenIndex53 := position, tokenIndex
+					position62, tokenIndex62 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l54
+						goto l63
 					}
 					position++
-					goto l53
-				l54:
-					position, tokenIndex = position53, tokenIndex53
+					goto l62
+				l63:
+					position, tokenIndex = position62, tokenIndex62
 					if buffer[position] != rune('E') {
-						goto l49
+						goto l58
 					}
 					position++
 				}
-			l53:
+			l62:
 				{
-					position55, tokenIndex55 := position, tokenIndex
+					position64, tokenIndex64 := position, tokenIndex
 					if buffer[position] != rune('l') {
-						goto l56
+						goto l65
 					}
 					position++
-					goto l55
-				l56:
-					position, tokenIndex = position55, tokenIndex55
+					goto l64
+				l65:
+					position, tokenIndex = position64, tokenIndex64
 					if buffer[position] != rune('L') {
-						goto l49
+						goto l58
 					}
 					position++
 				}
-			l55:
+			l64:
 				{
-					position57, tokenIndex57 := position, tokenIndex
+					position66, tokenIndex66 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l58
+						goto l67
 					}
 					position++
-					goto l57
-				l58:
-					position, tokenIndex = position57, tokenIndex57
+					goto l66
+				l67:
+					position, tokenIndex = position66, tokenIndex66
 					if buffer[position] != rune('E') {
-						goto l49
+						goto l58
 					}
 					position++
 				}
-			l57:
+			l66:
 				{
-					position59, tokenIndex59 := position, tokenIndex
+					position68, tokenIndex68 := position, tokenIndex
 					if buffer[position] != rune('c') {
-						goto l60
+						goto l69
 					}
 					position++
-					goto l59
-				l60:
-					position, tokenIndex = position59, tokenIndex59
+					goto l68
+				l69:
+					position, tokenIndex = position68, tokenIndex68
 					if buffer[position] != rune('C') {
-						goto l49
+						goto l58
 					}
 					position++
 				}
-			l59:
+			l68:
 				{
-					position61, tokenIndex61 := position, tokenIndex
+					position70, tokenIndex70 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l62
+						goto l71
 					}
 					position++
-					goto l61
-				l62:
-					position, tokenIndex = position61, tokenIndex61
+					goto l70
+				l71:
+					position, tokenIndex = position70, tokenIndex70
 					if buffer[position] != rune('T') {
-						goto l49
+						goto l58
 					}
 					position++
 				}
-			l61:
+			l70:
 				if !_rules[ruleEmitter]() {
-					goto l49
+					goto l58
+				}
+				if !_rules[ruleDistinctOpt]() {
+					goto l58
 				}
 				if !_rules[ruleProjections]() {
-					goto l49
+					goto l58
 				}
 				if !_rules[ruleWindowedFrom]() {
-					goto l49
+					goto l58
 				}
 				if !_rules[ruleFilter]() {
-					goto l49
+					goto l58
 				}
 				if !_rules[ruleGrouping]() {
-					goto l49
+					goto l58
 				}
 				if !_rules[ruleHaving]() {
-					goto l49
+					goto l58
+				}
+				if !_rules[ruleOrderBy]() {
+					goto l58
+				}
+				if !_rules[ruleLimit]() {
+					goto l58
 				}
 				if !_rules[ruleAction2]() {
-					goto l49
+					goto l58
 				}
-				add(ruleSelectStmt, position50)
+				add(ruleSelectStmt, position59)
 			}
 			return true
-		l49:
-			position, tokenIndex = position49, tokenIndex49
+		l58:
+			position, tokenIndex = position58, tokenIndex58
 			return false
 		},
-		/* 9 SelectUnionStmt <- <(<(SelectStmt (sp (('u' / 'U') ('n' / 'N') ('i' / 'I') ('o' / 'O') ('n' / 'N')) sp (('a' / 'A') ('l' / 'L') ('l' / 'L')) sp SelectStmt)+)> Action3)> */
+		/* 10 SelectUnionStmt <- <(<(SelectStmt (sp (('u' / 'U') ('n' / 'N') ('i' / 'I') ('o' / 'O') ('n' / 'N')) sp (('a' / 'A') ('l' / 'L') ('l' / 'L')) sp SelectStmt)+)> Action3)> */
 		func() bool {
-			position63, tokenIndex63 := position, tokenIndex
+			position72, tokenIndex72 := position, tokenIndex
 			{
-				position64 := position
+				position73 := position
 				{
-					position65 := position
+					position74 := position
 					if !_rules[ruleSelectStmt]() {
-						goto l63
+						goto l72
 					}
 					if !_rules[rulesp]() {
-						goto l63
+						goto l72
 					}
 					{
-						position68, tokenIndex68 := position, tokenIndex
+						position77, tokenIndex77 := position, tokenIndex
 						if buffer[position] != rune('u') {
-							goto l69
+							goto l78
 						}
 						position++
-						goto l68
-					l69:
-						position, tokenIndex = position68, tokenIndex68
+						goto l77
+					l78:
+						position, tokenIndex = position77, tokenIndex77
 						if buffer[position] != rune('U') {
-							goto l63
+							goto l72
 						}
 						position++
 					}
-				l68:
+				l77:
 					{
-						position70, tokenIndex70 := position, tokenIndex
+						position79, tokenIndex79 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l71
+							goto l80
 						}
 						position++
-						goto l70
-					l71:
-						position, tokenIndex = position70, tokenIndex70
+						goto l79
+					l80:
+						position, tokenIndex = position79, tokenIndex79
 						if buffer[position] != rune('N') {
-							goto l63
+							goto l72
 						}
 						position++
 					}
-				l70:
+				l79:
 					{
-						position72, tokenIndex72 := position, tokenIndex
+						position81, tokenIndex81 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l73
+							goto l82
 						}
 						position++
-						goto l72
-					l73:
-						position, tokenIndex = position72, tokenIndex72
+						goto l81
+					l82:
+						position, tokenIndex = position81, tokenIndex81
 						if buffer[position] != rune('I') {
-							goto l63
+							goto l72
 						}
 						position++
 					}
-				l72:
+				l81:
 					{
-						position74, tokenIndex74 := position, tokenIndex
+						position83, tokenIndex83 := position, tokenIndex
 						if buffer[position] != rune('o') {
-							goto l75
+							goto l84
 						}
 						position++
-						goto l74
-					l75:
-						position, tokenIndex = position74, tokenIndex74
+						goto l83
+					l84:
+						position, tokenIndex = position83, tokenIndex83
 						if buffer[position] != rune('O') {
-							goto l63
+							goto l72
 						}
 						position++
 					}
-				l74:
+				l83:
 					{
-						position76, tokenIndex76 := position, tokenIndex
+						position85, tokenIndex85 := position, tokenIndex
 						if buffer[position] != rune('n') {
-							goto l77
+							goto l86
 						}
 						position++
-						goto l76
-					l77:
-						position, tokenIndex = position76, tokenIndex76
+						goto l85
+					l86:
+						position, tokenIndex = position85, tokenIndex85
 						if buffer[position] != rune('N') {
-							goto l63
+							goto l72
 						}
 						position++
 					}
-				l76:
+				l85:
 					if !_rules[rulesp]() {
-						goto l63
+						goto l72
 					}
 					{
-						position78, tokenIndex78 := position, tokenIndex
+						position87, tokenIndex87 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l79
+							goto l88
 						}
 						position++
-						goto l78
-					l79:
-						position, tokenIndex = position78, tokenIndex78
+						goto l87
+					l88:
+						position, tokenIndex = position87, tokenIndex87
 						if buffer[position] != rune('A') {
-							goto l63
+							goto l72
 						}
 						position++
 					}
-				l78:
+				l87:
 					{
-						position80, tokenIndex80 := position, tokenIndex
+						position89, tokenIndex89 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l81
+							goto l90
 						}
 						position++
-						goto l80
-					l81:
-						position, tokenIndex = position80, tokenIndex80
+						goto l89
+					l90:
+						position, tokenIndex = position89, tokenIndex89
 						if buffer[position] != rune('L') {
-							goto l63
+							goto l72
 						}
 						position++
 					}
-				l80:
+				l89:
 					{
-						position82, tokenIndex82 := position, tokenIndex
+						position91, tokenIndex91 := position, tokenIndex
 						if buffer[position] != rune('l') {
-							goto l83
+							goto l92
 						}
 						position++
-						goto l82
-					l83:
-						position, tokenIndex = position82, tokenIndex82
+						goto l91
+					l92:
+						position, tokenIndex = position91, tokenIndex91
 						if buffer[position] != rune('L') {
-							goto l63
+							goto l72
 						}
 						position++
 					}
-				l82:
+				l91:
 					if !_rules[rulesp]() {
-						goto l63
+						goto l72
 					}
 					if !_rules[ruleSelectStmt]() {
-						goto l63
+						goto l72
 					}
-				l66:
+				l75:
 					{
-						position67, tokenIndex67 := position, tokenIndex
+						position76, tokenIndex76 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l67
+							goto l76
 						}
 						{
-							position84, tokenIndex84 := position, tokenIndex
+							position93, tokenIndex93 := position, tokenIndex
 							if buffer[position] != rune('u') {
-								goto l85
+								goto l94
 							}
 							position++
-							goto l84
-						l85:
-							position, tokenIndex = position84, tokenIndex84
+							goto l93
+						l94:
+							position, tokenIndex = position93, tokenIndex93
 							if buffer[position] != rune('U') {
-								goto l67
+								goto l76
 							}
 							position++
 						}
-					l84:
+					l93:
 						{
-							position86, tokenIndex86 := position, tokenIndex
+							position95, tokenIndex95 := position, tokenIndex
 							if buffer[position] != rune('n') {
-								goto l87
+								goto l96
 							}
 							position++
-							goto l86
-						l87:
-							position, tokenIndex = position86, tokenIndex86
+							goto l95
+						l96:
+							position, tokenIndex = position95, tokenIndex95
 							if buffer[position] != rune('N') {
-								goto l67
+								goto l76
 							}
 							position++
 						}
-					l86:
+					l95:
 						{
-							position88, tokenIndex88 := position, tokenIndex
+							position97, tokenIndex97 := position, tokenIndex
 							if buffer[position] != rune('i') {
-								goto l89
+								goto l98
 							}
 							position++
-							goto l88
-						l89:
-							position, tokenIndex = position88, tokenIndex88
+							goto l97
+						l98:
+							position, tokenIndex = position97, tokenIndex97
 							if buffer[position] != rune('I') {
-								goto l67
+								goto l76
 							}
 							position++
 						}
-					l88:
+					l97:
 						{
-							position90, tokenIndex90 := position, tokenIndex
+							position99, tokenIndex99 := position, tokenIndex
 							if buffer[position] != rune('o') {
-								goto l91
+								goto l100
 							}
 							position++
-							goto l90
-						l91:
-							position, tokenIndex = position90, tokenIndex90
+							goto l99
+						l100:
+							position, tokenIndex = position99, tokenIndex99
 							if buffer[position] != rune('O') {
-								goto l67
+								goto l76
 							}
 							position++
 						}
-					l90:
+					l99:
 						{
-							position92, tokenIndex92 := position, tokenIndex
+							position101, tokenIndex101 := position, tokenIndex
 							if buffer[position] != rune('n') {
-								goto l93
+								goto l102
 							}
 							position++
-							goto l92
-						l93:
-							position, tokenIndex = position92, tokenIndex92
+							goto l101
+						l102:
+							position, tokenIndex = position101, tokenIndex101
 							if buffer[position] != rune('N') {
-								goto l67
+								goto l76
 							}
 							position++
 						}
-					l92:
+					l101:
 						if !_rules[rulesp]() {
-							goto l67
+							goto l76
 						}
 						{
-							position94, tokenIndex94 := position, tokenIndex
+							position103, tokenIndex103 := position, tokenIndex
 							if buffer[position] != rune('a') {
-								goto l95
+								goto l104
 							}
 							position++
-							goto l94
-						l95:
-							position, tokenIndex = position94, tokenIndex94
+							goto l103
+						l104:
+							position, tokenIndex = position103, tokenIndex103
 							if buffer[position] != rune('A') {
-								goto l67
+								goto l76
 							}
 							position++
 						}
-					l94:
+					l103:
 						{
-							position96, tokenIndex96 := position, tokenIndex
+							position105, tokenIndex105 := position, tokenIndex
 							if buffer[position] != rune('l') {
-								goto l97
+								goto l106
 							}
 							position++
-							goto l96
-						l97:
-							position, tokenIndex = position96, tokenIndex96
+							goto l105
+						l106:
+							position, tokenIndex = position105, tokenIndex105
 							if buffer[position] != rune('L') {
-								goto l67
+								goto l76
 							}
 							position++
 						}
-					l96:
+					l105:
 						{
-							position98, tokenIndex98 := position, tokenIndex
+							position107, tokenIndex107 := position, tokenIndex
 							if buffer[position] != rune('l') {
-								goto l99
+								goto l108
 							}
 							position++
-							goto l98
-						l99:
-							position, tokenIndex = position98, tokenIndex98
+							goto l107
+						l108:
+							position, tokenIndex = position107, tokenIndex107
 							if buffer[position] != rune('L') {
-								goto l67
+								goto l76
 							}
 							position++
 						}
-					l98:
+					l107:
 						if !_rules[rulesp]() {
-							goto l67
+							goto l76
 						}
 						if !_rules[ruleSelectStmt]() {
-							goto l67
+							goto l76
 						}
-						goto l66
-					l67:
-						position, tokenIndex = position67, tokenIndex67
+						goto l75
+					l76:
+						position, tokenIndex = position76, tokenIndex76
 					}
-					add(rulePegText, position65)
+					add(rulePegText, position74)
 				}
 				if !_rules[ruleAction3]() {
-					goto l63
+					goto l72
 				}
-				add(ruleSelectUnionStmt, position64)
+				add(ruleSelectUnionStmt, position73)
 			}
 			return true
-		l63:
-			position, tokenIndex = position63, tokenIndex63
+		l72:
+			position, tokenIndex = position72, tokenIndex72
 			return false
 		},
-		/* 10 CreateStreamAsSelectStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier sp (('a' / 'A') ('s' / 'S')) sp SelectStmt Action4)> */
+		/* 11 CreateStreamAsSelectStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier SchemaOpt sp (('a' / 'A') ('s' / 'S')) sp SelectStmt OnErrorOpt Action4)> */
 		func() bool {
-			position100, tokenIndex100 := position, tokenIndex
+			position109, tokenIndex109 := position, tokenIndex
 			{
-				position101 := position
+				position110 := position
 				{
-					position102, tokenIndex102 := position, tokenIndex
+					position111, tokenIndex111 := position, tokenIndex
 					if buffer[position] != rune('c') {
-						goto l103
+						goto l112
 					}
 					position++
-					goto l102
-				l103:
-					position, tokenIndex = position102, tokenIndex102
+					goto l111
+				l112:
+					position, tokenIndex = position111, tokenIndex111
 					if buffer[position] != rune('C') {
-						goto l100
+						goto l109
 					}
 					position++
 				}
-			l102:
+			l111:
 				{
-					position104, tokenIndex104 := position, tokenIndex
+					position113, tokenIndex113 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l105
+						goto l114
 					}
 					position++
-					goto l104
-				l105:
-					position, tokenIndex = position104, tokenIndex104
+					goto l113
+				l114:
+					position, tokenIndex = position113, tokenIndex113
 					if buffer[position] != rune('R') {
-						goto l100
+						goto l109
 					}
 					position++
 				}
-			l104:
+			l113:
 				{
-					position106, tokenIndex106 := position, tokenIndex
+					position115, tokenIndex115 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l107
+						goto l116
 					}
 					position++
-					goto l106
-				l107:
-					position, tokenIndex = position106, tokenIndex106
+					goto l115
+				l116:
+					position, tokenIndex = position115, tokenIndex115
 					if buffer[position] != rune('E') {
-						goto l100
+						goto l109
 					}
 					position++
 				}
-			l106:
+			l115:
 				{
-					position108, tokenIndex108 := position, tokenIndex
+					position117, tokenIndex117 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l109
+						goto l118
 					}
 					position++
-					goto l108
-				l109:
-					position, tokenIndex = position108, tokenIndex108
+					goto l117
+				l118:
+					position, tokenIndex = position117, tokenIndex117
 					if buffer[position] != rune('A') {
-						goto l100
+						goto l109
 					}
 					position++
 				}
-			l108:
+			l117:
 				{
-					position110, tokenIndex110 := position, tokenIndex
+					position119, tokenIndex119 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l111
+						goto l120
 					}
 					position++
-					goto l110
-				l111:
-					position, tokenIndex = position110, tokenIndex110
+					goto l119
+				l120:
+					position, tokenIndex = position119, tokenIndex119
 					if buffer[position] != rune('T') {
-						goto l100
+						goto l109
 					}
 					position++
 				}
-			l110:
+			l119:
 				{
-					position112, tokenIndex112 := position, tokenIndex
+					position121, tokenIndex121 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l113
+						goto l122
 					}
 					position++
-					goto l112
-				l113:
-					position, tokenIndex = position112, tokenIndex112
+					goto l121
+				l122:
+					position, tokenIndex = position121, tokenIndex121
 					if buffer[position] != rune('E') {
-						goto l100
+						goto l109
 					}
 					position++
 				}
-			l112:
+			l121:
 				if !_rules[rulesp]() {
-					goto l100
+					goto l109
 				}
 				{
-					position114, tokenIndex114 := position, tokenIndex
+					position123, tokenIndex123 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l115
+						goto l124
 					}
 					position++
-					goto l114
-				l115:
-					position, tokenIndex = position114, tokenIndex114
+					goto l123
+				l124:
+					position, tokenIndex = position123, tokenIndex123
 					if buffer[position] != rune('S') {
-						goto l100
+						goto l109
 					}
 					position++
 				}
-			l114:
+			l123:
 				{
-					position116, tokenIndex116 := position, tokenIndex
+					position125, tokenIndex125 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l117
+						goto l126
 					}
 					position++
-					goto l116
-				l117:
-					position, tokenIndex = position116, tokenIndex116
+					goto l125
+				l126:
+					position, tokenIndex = position125, tokenIndex125
 					if buffer[position] != rune('T') {
-						goto l100
+						goto l109
 					}
 					position++
 				}
-			l116:
+			l125:
 				{
-					position118, tokenIndex118 := position, tokenIndex
+					position127, tokenIndex127 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l119
+						goto l128
 					}
 					position++
-					goto l118
-				l119:
-					position, tokenIndex = position118, tokenIndex118
+					goto l127
+				l128:
+					position, tokenIndex = position127, tokenIndex127
 					if buffer[position] != rune('R') {
-						goto l100
+						goto l109
 					}
 					position++
 				}
-			l118:
+			l127:
 				{
-					position120, tokenIndex120 := position, tokenIndex
+					position129, tokenIndex129 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l121
+						goto l130
 					}
 					position++
-					goto l120
-				l121:
-					position, tokenIndex = position120, tokenIndex120
+					goto l129
+				l130:
+					position, tokenIndex = position129, tokenIndex129
 					if buffer[position] != rune('E') {
-						goto l100
+						goto l109
 					}
 					position++
 				}
-			l120:
+			l129:
 				{
-					position122, tokenIndex122 := position, tokenIndex
+					position131, tokenIndex131 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l123
+						goto l132
 					}
 					position++
-					goto l122
-				l123:
-					position, tokenIndex = position122, tokenIndex122
+					goto l131
+				l132:
+					position, tokenIndex = position131, tokenIndex131
 					if buffer[position] != rune('A') {
-						goto l100
+						goto l109
 					}
 					position++
 				}
-			l122:
+			l131:
 				{
-					position124, tokenIndex124 := position, tokenIndex
+					position133, tokenIndex133 := position, tokenIndex
 					if buffer[position] != rune('m') {
-						goto l125
+						goto l134
 					}
 					position++
-					goto l124
-				l125:
-					position, tokenIndex = position124, tokenIndex124
+					goto l133
+				l134:
+					position, tokenIndex = position133, tokenIndex133
 					if buffer[position] != rune('M') {
-						goto l100
+						goto l109
 					}
 					position++
 				}
-			l124:
+			l133:
 				if !_rules[rulesp]() {
-					goto l100
+					goto l109
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l100
+					goto l109
+				}
+				if !_rules[ruleSchemaOpt]() {
+					goto l109
 				}
 				if !_rules[rulesp]() {
-					goto l100
+					goto l109
 				}
 				{
-					position126, tokenIndex126 := position, tokenIndex
+					position135, tokenIndex135 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l127
+						goto l136
 					}
 					position++
-					goto l126
-				l127:
-					position, tokenIndex = position126, tokenIndex126
+					goto l135
+				l136:
+					position, tokenIndex = position135, tokenIndex135
 					if buffer[position] != rune('A') {
-						goto l100
+						goto l109
 					}
 					position++
 				}
-			l126:
+			l135:
 				{
-					position128, tokenIndex128 := position, tokenIndex
+					position137, tokenIndex137 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l129
+						goto l138
 					}
 					position++
-					goto l128
-				l129:
-					position, tokenIndex = position128, tokenIndex128
+					goto l137
+				l138:
+					position, tokenIndex = position137, tokenIndex137
 					if buffer[position] != rune('S') {
-						goto l100
+						goto l109
 					}
 					position++
 				}
-			l128:
+			l137:
 				if !_rules[rulesp]() {
-					goto l100
+					goto l109
 				}
 				if !_rules[ruleSelectStmt]() {
-					goto l100
+					goto l109
+				}
+				if !_rules[ruleOnErrorOpt]() {
+					goto l109
 				}
 				if !_rules[ruleAction4]() {
-					goto l100
+					goto l109
 				}
-				add(ruleCreateStreamAsSelectStmt, position101)
+				add(ruleCreateStreamAsSelectStmt, position110)
 			}
 			return true
-		l100:
-			position, tokenIndex = position100, tokenIndex100
+		l109:
+			position, tokenIndex = position109, tokenIndex109
 			return false
 		},
-		/* 11 CreateStreamAsSelectUnionStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier sp (('a' / 'A') ('s' / 'S')) sp SelectUnionStmt Action5)> */
+		/* 12 CreateOrReplaceStreamAsSelectStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('o' / 'O') ('r' / 'R')) sp (('r' / 'R') ('e' / 'E') ('p' / 'P') ('l' / 'L') ('a' / 'A') ('c' / 'C') ('e' / 'E')) sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier SchemaOpt sp (('a' / 'A') ('s' / 'S')) sp SelectStmt OnErrorOpt Action5)> */
 		func() bool {
-			position130, tokenIndex130 := position, tokenIndex
+			position139, tokenIndex139 := position, tokenIndex
 			{
-				position131 := position
+				position140 := position
 				{
-					position132, tokenIndex132 := position, tokenIndex
+					position141, tokenIndex141 := position, tokenIndex
 					if buffer[position] != rune('c') {
-						goto l133
+						goto l142
 					}
 					position++
-					goto l132
-				l133:
-					position, tokenIndex = position132, tokenIndex132
+					goto l141
+				l142:
+					position, tokenIndex = position141, tokenIndex141
 					if buffer[position] != rune('C') {
-						goto l130
+						goto l139
 					}
 					position++
 				}
-			l132:
+			l141:
 				{
-					position134, tokenIndex134 := position, tokenIndex
+					position143, tokenIndex143 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l135
+						goto l144
 					}
 					position++
-					goto l134
-				l135:
-					position, tokenIndex = position134, tokenIndex134
+					goto l143
+				l144:
+					position, tokenIndex = position143, tokenIndex143
 					if buffer[position] != rune('R') {
-						goto l130
+						goto l139
 					}
 					position++
 				}
-			l134:
+			l143:
 				{
-					position136, tokenIndex136 := position, tokenIndex
+					position145, tokenIndex145 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l137
+						goto l146
 					}
 					position++
-					goto l136
-				l137:
-					position, tokenIndex = position136, tokenIndex136
+					goto l145
+				l146:
+					position, tokenIndex = position145, tokenIndex145
 					if buffer[position] != rune('E') {
-						goto l130
+						goto l139
 					}
 					position++
 				}
-			l136:
+			l145:
 				{
-					position138, tokenIndex138 := position, tokenIndex
+					position147, tokenIndex147 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l139
+						goto l148
 					}
 					position++
-					goto l138
-				l139:
-					position, tokenIndex = position138, tokenIndex138
+					goto l147
+				l148:
+					position, tokenIndex = position147, tokenIndex147
 					if buffer[position] != rune('A') {
-						goto l130
+						goto l139
 					}
 					position++
 				}
-			l138:
+			l147:
 				{
-					position140, tokenIndex140 := position, tokenIndex
+					position149, tokenIndex149 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l141
+						goto l150
 					}
 					position++
-					goto l140
-				l141:
-					position, tokenIndex = position140, tokenIndex140
+					goto l149
+				l150:
+					position, tokenIndex = position149, tokenIndex149
 					if buffer[position] != rune('T') {
-						goto l130
+						goto l139
 					}
 					position++
 				}
-			l140:
+			l149:
 				{
-					position142, tokenIndex142 := position, tokenIndex
+					position151, tokenIndex151 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l143
+						goto l152
 					}
 					position++
-					goto l142
-				l143:
-					position, tokenIndex = position142, tokenIndex142
+					goto l151
+				l152:
+					position, tokenIndex = position151, tokenIndex151
 					if buffer[position] != rune('E') {
-						goto l130
+						goto l139
+					}
+					position++
+				}
+			l151:
+				if !_rules[rulesp]() {
+					goto l139
+				}
+				{
+					position153, tokenIndex153 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l154
+					}
+					position++
+					goto l153
+				l154:
+					position, tokenIndex = position153, tokenIndex153
+					if buffer[position] != rune('O') {
+						goto l139
+					}
+					position++
+				}
+			l153:
+				{
+					position155, tokenIndex155 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l156
+					}
+					position++
+					goto l155
+				l156:
+					position, tokenIndex = position155, tokenIndex155
+					if buffer[position] != rune('R') {
+						goto l139
+					}
+					position++
+				}
+			l155:
+				if !_rules[rulesp]() {
+					goto l139
+				}
+				{
+					position157, tokenIndex157 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l158
+					}
+					position++
+					goto l157
+				l158:
+					position, tokenIndex = position157, tokenIndex157
+					if buffer[position] != rune('R') {
+						goto l139
+					}
+					position++
+				}
+			l157:
+				{
+					position159, tokenIndex159 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l160
+					}
+					position++
+					goto l159
+				l160:
+					position, tokenIndex = position159, tokenIndex159
+					if buffer[position] != rune('E') {
+						goto l139
+					}
+					position++
+				}
+			l159:
+				{
+					position161, tokenIndex161 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l162
+					}
+					position++
+					goto l161
+				l162:
+					position, tokenIndex = position161, tokenIndex161
+					if buffer[position] != rune('P') {
+						goto l139
+					}
+					position++
+				}
+			l161:
+				{
+					position163, tokenIndex163 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l164
+					}
+					position++
+					goto l163
+				l164:
+					position, tokenIndex = position163, tokenIndex163
+					if buffer[position] != rune('L') {
+						goto l139
+					}
+					position++
+				}
+			l163:
+				{
+					position165, tokenIndex165 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l166
+					}
+					position++
+					goto l165
+				l166:
+					position, tokenIndex = position165, tokenIndex165
+					if buffer[position] != rune('A') {
+						goto l139
+					}
+					position++
+				}
+			l165:
+				{
+					position167, tokenIndex167 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l168
+					}
+					position++
+					goto l167
+				l168:
+					position, tokenIndex = position167, tokenIndex167
+					if buffer[position] != rune('C') {
+						goto l139
+					}
+					position++
+				}
+			l167:
+				{
+					position169, tokenIndex169 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l170
+					}
+					position++
+					goto l169
+				l170:
+					position, tokenIndex = position169, tokenIndex169
+					if buffer[position] != rune('E') {
+						goto l139
 					}
 					position++
 				}
-			l142:
+			l169:
 				if !_rules[rulesp]() {
-					goto l130
+					goto l139
 				}
 				{
-					position144, tokenIndex144 := position, tokenIndex
+					position171, tokenIndex171 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l145
+						goto l172
 					}
 					position++
-					goto l144
-				l145:
-					position, tokenIndex = position144, tokenIndex144
+					goto l171
+				l172:
+					position, tokenIndex = position171, tokenIndex171
 					if buffer[position] != rune('S') {
-						goto l130
+						goto l139
 					}
 					position++
 				}
-			l144:
+			l171:
 				{
-					position146, tokenIndex146 := position, tokenIndex
+					position173, tokenIndex173 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l147
+						goto l174
 					}
 					position++
-					goto l146
-				l147:
-					position, tokenIndex = position146, tokenIndex146
+					goto l173
+				l174:
+					position, tokenIndex = position173, tokenIndex173
 					if buffer[position] != rune('T') {
-						goto l130
+						goto l139
 					}
 					position++
 				}
-			l146:
+			l173:
 				{
-					position148, tokenIndex148 := position, tokenIndex
+					position175, tokenIndex175 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l149
+						goto l176
 					}
 					position++
-					goto l148
-				l149:
-					position, tokenIndex = position148, tokenIndex148
+					goto l175
+				l176:
+					position, tokenIndex = position175, tokenIndex175
 					if buffer[position] != rune('R') {
-						goto l130
+						goto l139
 					}
 					position++
 				}
-			l148:
+			l175:
 				{
-					position150, tokenIndex150 := position, tokenIndex
+					position177, tokenIndex177 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l151
+						goto l178
 					}
 					position++
-					goto l150
-				l151:
-					position, tokenIndex = position150, tokenIndex150
+					goto l177
+				l178:
+					position, tokenIndex = position177, tokenIndex177
 					if buffer[position] != rune('E') {
-						goto l130
+						goto l139
 					}
 					position++
 				}
-			l150:
+			l177:
 				{
-					position152, tokenIndex152 := position, tokenIndex
+					position179, tokenIndex179 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l153
+						goto l180
 					}
 					position++
-					goto l152
-				l153:
-					position, tokenIndex = position152, tokenIndex152
+					goto l179
+				l180:
+					position, tokenIndex = position179, tokenIndex179
 					if buffer[position] != rune('A') {
-						goto l130
+						goto l139
 					}
 					position++
 				}
-			l152:
+			l179:
 				{
-					position154, tokenIndex154 := position, tokenIndex
+					position181, tokenIndex181 := position, tokenIndex
 					if buffer[position] != rune('m') {
-						goto l155
+						goto l182
 					}
 					position++
-					goto l154
-				l155:
-					position, tokenIndex = position154, tokenIndex154
+					goto l181
+				l182:
+					position, tokenIndex = position181, tokenIndex181
 					if buffer[position] != rune('M') {
-						goto l130
+						goto l139
 					}
 					position++
 				}
-			l154:
+			l181:
 				if !_rules[rulesp]() {
-					goto l130
+					goto l139
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l130
+					goto l139
+				}
+				if !_rules[ruleSchemaOpt]() {
+					goto l139
 				}
 				if !_rules[rulesp]() {
-					goto l130
+					goto l139
 				}
 				{
-					position156, tokenIndex156 := position, tokenIndex
+					position183, tokenIndex183 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l157
+						goto l184
 					}
 					position++
-					goto l156
-				l157:
-					position, tokenIndex = position156, tokenIndex156
+					goto l183
+				l184:
+					position, tokenIndex = position183, tokenIndex183
 					if buffer[position] != rune('A') {
-						goto l130
+						goto l139
 					}
 					position++
 				}
-			l156:
+			l183:
 				{
-					position158, tokenIndex158 := position, tokenIndex
+					position185, tokenIndex185 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l159
+						goto l186
 					}
 					position++
-					goto l158
-				l159:
-					position, tokenIndex = position158, tokenIndex158
+					goto l185
+				l186:
+					position, tokenIndex = position185, tokenIndex185
 					if buffer[position] != rune('S') {
-						goto l130
+						goto l139
 					}
 					position++
 				}
-			l158:
+			l185:
 				if !_rules[rulesp]() {
-					goto l130
+					goto l139
 				}
-				if !_rules[ruleSelectUnionStmt]() {
-					goto l130
+				if !_rules[ruleSelectStmt]() {
+					goto l139
+				}
+				if !_rules[ruleOnErrorOpt]() {
+					goto l139
 				}
 				if !_rules[ruleAction5]() {
-					goto l130
+					goto l139
 				}
-				add(ruleCreateStreamAsSelectUnionStmt, position131)
+				add(ruleCreateOrReplaceStreamAsSelectStmt, position140)
 			}
 			return true
-		l130:
-			position, tokenIndex = position130, tokenIndex130
+		l139:
+			position, tokenIndex = position139, tokenIndex139
 			return false
 		},
-		/* 12 CreateSourceStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') PausedOpt sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType SourceSinkSpecs Action6)> */
+		/* 13 CreateStreamAsSelectUnionStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier sp (('a' / 'A') ('s' / 'S')) sp SelectUnionStmt Action6)> */
 		func() bool {
-			position160, tokenIndex160 := position, tokenIndex
+			position187, tokenIndex187 := position, tokenIndex
 			{
-				position161 := position
+				position188 := position
 				{
-					position162, tokenIndex162 := position, tokenIndex
+					position189, tokenIndex189 := position, tokenIndex
 					if buffer[position] != rune('c') {
-						goto l163
+						goto l190
 					}
 					position++
-					goto l162
-				l163:
-					position, tokenIndex = position162, tokenIndex162
+					goto l189
+				l190:
+					position, tokenIndex = position189, tokenIndex189
 					if buffer[position] != rune('C') {
-						goto l160
+						goto l187
 					}
 					position++
 				}
-			l162:
+			l189:
 				{
-					position164, tokenIndex164 := position, tokenIndex
+					position191, tokenIndex191 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l165
+						goto l192
 					}
 					position++
-					goto l164
-				l165:
-					position, tokenIndex = position164, tokenIndex164
+					goto l191
+				l192:
+					position, tokenIndex = position191, tokenIndex191
 					if buffer[position] != rune('R') {
-						goto l160
+						goto l187
 					}
 					position++
 				}
-			l164:
+			l191:
 				{
-					position166, tokenIndex166 := position, tokenIndex
+					position193, tokenIndex193 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l167
+						goto l194
 					}
 					position++
-					goto l166
-				l167:
-					position, tokenIndex = position166, tokenIndex166
+					goto l193
+				l194:
+					position, tokenIndex = position193, tokenIndex193
 					if buffer[position] != rune('E') {
-						goto l160
+						goto l187
 					}
 					position++
 				}
-			l166:
+			l193:
 				{
-					position168, tokenIndex168 := position, tokenIndex
+					position195, tokenIndex195 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l169
+						goto l196
 					}
 					position++
-					goto l168
-				l169:
-					position, tokenIndex = position168, tokenIndex168
+					goto l195
+				l196:
+					position, tokenIndex = position195, tokenIndex195
 					if buffer[position] != rune('A') {
-						goto l160
+						goto l187
 					}
 					position++
 				}
-			l168:
+			l195:
 				{
-					position170, tokenIndex170 := position, tokenIndex
+					position197, tokenIndex197 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l171
+						goto l198
 					}
 					position++
-					goto l170
-				l171:
-					position, tokenIndex = position170, tokenIndex170
+					goto l197
+				l198:
+					position, tokenIndex = position197, tokenIndex197
 					if buffer[position] != rune('T') {
-						goto l160
+						goto l187
 					}
 					position++
 				}
-			l170:
+			l197:
 				{
-					position172, tokenIndex172 := position, tokenIndex
+					position199, tokenIndex199 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l173
+						goto l200
 					}
 					position++
-					goto l172
-				l173:
-					position, tokenIndex = position172, tokenIndex172
+					goto l199
+				l200:
+					position, tokenIndex = position199, tokenIndex199
 					if buffer[position] != rune('E') {
-						goto l160
+						goto l187
 					}
 					position++
 				}
-			l172:
-				if !_rules[rulePausedOpt]() {
-					goto l160
-				}
+			l199:
 				if !_rules[rulesp]() {
-					goto l160
+					goto l187
 				}
 				{
-					position174, tokenIndex174 := position, tokenIndex
+					position201, tokenIndex201 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l175
+						goto l202
 					}
 					position++
-					goto l174
-				l175:
-					position, tokenIndex = position174, tokenIndex174
+					goto l201
+				l202:
+					position, tokenIndex = position201, tokenIndex201
 					if buffer[position] != rune('S') {
-						goto l160
+						goto l187
 					}
 					position++
 				}
-			l174:
+			l201:
 				{
-					position176, tokenIndex176 := position, tokenIndex
-					if buffer[position] != rune('o') {
-						goto l177
+					position203, tokenIndex203 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l204
 					}
 					position++
-					goto l176
-				l177:
-					position, tokenIndex = position176, tokenIndex176
-					if buffer[position] != rune('O') {
-						goto l160
+					goto l203
+				l204:
+					position, tokenIndex = position203, tokenIndex203
+					if buffer[position] != rune('T') {
+						goto l187
 					}
 					position++
 				}
-			l176:
+			l203:
 				{
-					position178, tokenIndex178 := position, tokenIndex
-					if buffer[position] != rune('u') {
-						goto l179
+					position205, tokenIndex205 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l206
 					}
 					position++
-					goto l178
-				l179:
-					position, tokenIndex = position178, tokenIndex178
-					if buffer[position] != rune('U') {
-						goto l160
+					goto l205
+				l206:
+					position, tokenIndex = position205, tokenIndex205
+					if buffer[position] != rune('R') {
+						goto l187
 					}
 					position++
 				}
-			l178:
+			l205:
 				{
-					position180, tokenIndex180 := position, tokenIndex
-					if buffer[position] != rune('r') {
-						goto l181
+					position207, tokenIndex207 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l208
 					}
 					position++
-					goto l180
-				l181:
-					position, tokenIndex = position180, tokenIndex180
-					if buffer[position] != rune('R') {
-						goto l160
+					goto l207
+				l208:
+					position, tokenIndex = position207, tokenIndex207
+					if buffer[position] != rune('E') {
+						goto l187
 					}
 					position++
 				}
-			l180:
+			l207:
 				{
-					position182, tokenIndex182 := position, tokenIndex
-					if buffer[position] != rune('c') {
-						goto l183
+					position209, tokenIndex209 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l210
 					}
 					position++
-					goto l182
-				l183:
-					position, tokenIndex = position182, tokenIndex182
-					if buffer[position] != rune('C') {
-						goto l160
+					goto l209
+				l210:
+					position, tokenIndex = position209, tokenIndex209
+					if buffer[position] != rune('A') {
+						goto l187
 					}
 					position++
 				}
-			l182:
+			l209:
 				{
-					position184, tokenIndex184 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l185
+					position211, tokenIndex211 := position, tokenIndex
+					if buffer[position] != rune('m') {
+						goto l212
 					}
 					position++
-					goto l184
-				l185:
-					position, tokenIndex = position184, tokenIndex184
-					if buffer[position] != rune('E') {
-						goto l160
+					goto l211
+				l212:
+					position, tokenIndex = position211, tokenIndex211
+					if buffer[position] != rune('M') {
+						goto l187
 					}
 					position++
 				}
-			l184:
+			l211:
 				if !_rules[rulesp]() {
-					goto l160
+					goto l187
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l160
+					goto l187
 				}
 				if !_rules[rulesp]() {
-					goto l160
+					goto l187
 				}
 				{
-					position186, tokenIndex186 := position, tokenIndex
-					if buffer[position] != rune('t') {
+					position213, tokenIndex213 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l214
+					}
+					position++
+					goto l213
+				l214:
+					position, tokenIndex = position213, tokenIndex213
+					if buffer[position] != rune('A') {
 						goto l187
 					}
 					position++
-					goto l186
-				l187:
-					position, tokenIndex = position186, tokenIndex186
+				}
+			l213:
+				{
+					position215, tokenIndex215 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l216
+					}
+					position++
+					goto l215
+				l216:
+					position, tokenIndex = position215, tokenIndex215
+					if buffer[position] != rune('S') {
+						goto l187
+					}
+					position++
+				}
+			l215:
+				if !_rules[rulesp]() {
+					goto l187
+				}
+				if !_rules[ruleSelectUnionStmt]() {
+					goto l187
+				}
+				if !_rules[ruleAction6]() {
+					goto l187
+				}
+				add(ruleCreateStreamAsSelectUnionStmt, position188)
+			}
+			return true
+		l187:
+			position, tokenIndex = position187, tokenIndex187
+			return false
+		},
+		/* 14 CreateSourceStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') PausedOpt sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier SchemaOpt sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType SourceSinkSpecs Action7)> */
+		func() bool {
+			position217, tokenIndex217 := position, tokenIndex
+			{
+				position218 := position
+				{
+					position219, tokenIndex219 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l220
+					}
+					position++
+					goto l219
+				l220:
+					position, tokenIndex = position219, tokenIndex219
+					if buffer[position] != rune('C') {
+						goto l217
+					}
+					position++
+				}
+			l219:
+				{
+					position221, tokenIndex221 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l222
+					}
+					position++
+					goto l221
+				l222:
+					position, tokenIndex = position221, tokenIndex221
+					if buffer[position] != rune('R') {
+						goto l217
+					}
+					position++
+				}
+			l221:
+				{
+					position223, tokenIndex223 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l224
+					}
+					position++
+					goto l223
+				l224:
+					position, tokenIndex = position223, tokenIndex223
+					if buffer[position] != rune('E') {
+						goto l217
+					}
+					position++
+				}
+			l223:
+				{
+					position225, tokenIndex225 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l226
+					}
+					position++
+					goto l225
+				l226:
+					position, tokenIndex = position225, tokenIndex225
+					if buffer[position] != rune('A') {
+						goto l217
+					}
+					position++
+				}
+			l225:
+				{
+					position227, tokenIndex227 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l228
+					}
+					position++
+					goto l227
+				l228:
+					position, tokenIndex = position227, tokenIndex227
 					if buffer[position] != rune('T') {
-						goto l160
+						goto l217
+					}
+					position++
+				}
+			l227:
+				{
+					position229, tokenIndex229 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l230
+					}
+					position++
+					goto l229
+				l230:
+					position, tokenIndex = position229, tokenIndex229
+					if buffer[position] != rune('E') {
+						goto l217
+					}
+					position++
+				}
+			l229:
+				if !_rules[rulePausedOpt]() {
+					goto l217
+				}
+				if !_rules[rulesp]() {
+					goto l217
+				}
+				{
+					position231, tokenIndex231 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l232
+					}
+					position++
+					goto l231
+				l232:
+					position, tokenIndex = position231, tokenIndex231
+					if buffer[position] != rune('S') {
+						goto l217
+					}
+					position++
+				}
+			l231:
+				{
+					position233, tokenIndex233 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l234
+					}
+					position++
+					goto l233
+				l234:
+					position, tokenIndex = position233, tokenIndex233
+					if buffer[position] != rune('O') {
+						goto l217
+					}
+					position++
+				}
+			l233:
+				{
+					position235, tokenIndex235 := position, tokenIndex
+					if buffer[position] != rune('u') {
+						goto l236
+					}
+					position++
+					goto l235
+				l236:
+					position, tokenIndex = position235, tokenIndex235
+					if buffer[position] != rune('U') {
+						goto l217
+					}
+					position++
+				}
+			l235:
+				{
+					position237, tokenIndex237 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l238
+					}
+					position++
+					goto l237
+				l238:
+					position, tokenIndex = position237, tokenIndex237
+					if buffer[position] != rune('R') {
+						goto l217
+					}
+					position++
+				}
+			l237:
+				{
+					position239, tokenIndex239 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l240
+					}
+					position++
+					goto l239
+				l240:
+					position, tokenIndex = position239, tokenIndex239
+					if buffer[position] != rune('C') {
+						goto l217
+					}
+					position++
+				}
+			l239:
+				{
+					position241, tokenIndex241 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l242
+					}
+					position++
+					goto l241
+				l242:
+					position, tokenIndex = position241, tokenIndex241
+					if buffer[position] != rune('E') {
+						goto l217
+					}
+					position++
+				}
+			l241:
+				if !_rules[rulesp]() {
+					goto l217
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l217
+				}
+				if !_rules[ruleSchemaOpt]() {
+					goto l217
+				}
+				if !_rules[rulesp]() {
+					goto l217
+				}
+				{
+					position243, tokenIndex243 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l244
+					}
+					position++
+					goto l243
+				l244:
+					position, tokenIndex = position243, tokenIndex243
+					if buffer[position] != rune('T') {
+						goto l217
 					}
 					position++
 				}
-			l186:
+			l243:
 				{
-					position188, tokenIndex188 := position, tokenIndex
+					position245, tokenIndex245 := position, tokenIndex
 					if buffer[position] != rune('y') {
-						goto l189
+						goto l246
 					}
 					position++
-					goto l188
-				l189:
-					position, tokenIndex = position188, tokenIndex188
+					goto l245
+				l246:
+					position, tokenIndex = position245, tokenIndex245
 					if buffer[position] != rune('Y') {
-						goto l160
+						goto l217
 					}
 					position++
 				}
-			l188:
+			l245:
 				{
-					position190, tokenIndex190 := position, tokenIndex
+					position247, tokenIndex247 := position, tokenIndex
 					if buffer[position] != rune('p') {
-						goto l191
+						goto l248
 					}
 					position++
-					goto l190
-				l191:
-					position, tokenIndex = position190, tokenIndex190
+					goto l247
+				l248:
+					position, tokenIndex = position247, tokenIndex247
 					if buffer[position] != rune('P') {
-						goto l160
+						goto l217
 					}
 					position++
 				}
-			l190:
+			l247:
 				{
-					position192, tokenIndex192 := position, tokenIndex
+					position249, tokenIndex249 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l193
+						goto l250
 					}
 					position++
-					goto l192
-				l193:
-					position, tokenIndex = position192, tokenIndex192
+					goto l249
+				l250:
+					position, tokenIndex = position249, tokenIndex249
 					if buffer[position] != rune('E') {
-						goto l160
+						goto l217
 					}
 					position++
 				}
-			l192:
+			l249:
 				if !_rules[rulesp]() {
-					goto l160
+					goto l217
 				}
 				if !_rules[ruleSourceSinkType]() {
-					goto l160
+					goto l217
 				}
 				if !_rules[ruleSourceSinkSpecs]() {
-					goto l160
+					goto l217
 				}
-				if !_rules[ruleAction6]() {
-					goto l160
+				if !_rules[ruleAction7]() {
+					goto l217
 				}
-				add(ruleCreateSourceStmt, position161)
+				add(ruleCreateSourceStmt, position218)
 			}
 			return true
-		l160:
-			position, tokenIndex = position160, tokenIndex160
+		l217:
+			position, tokenIndex = position217, tokenIndex217
 			return false
 		},
-		/* 13 CreateSinkStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType SourceSinkSpecs Action7)> */
+		/* 15 CreateSinkStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType SourceSinkSpecs Action8)> */
 		func() bool {
-			position194, tokenIndex194 := position, tokenIndex
+			position251, tokenIndex251 := position, tokenIndex
 			{
-				position195 := position
+				position252 := position
 				{
-					position196, tokenIndex196 := position, tokenIndex
+					position253, tokenIndex253 := position, tokenIndex
 					if buffer[position] != rune('c') {
-						goto l197
+						goto l254
 					}
 					position++
-					goto l196
-				l197:
-					position, tokenIndex = position196, tokenIndex196
+					goto l253
+				l254:
+					position, tokenIndex = position253, tokenIndex253
 					if buffer[position] != rune('C') {
-						goto l194
+						goto l251
 					}
 					position++
 				}
-			l196:
+			l253:
 				{
-					position198, tokenIndex198 := position, tokenIndex
+					position255, tokenIndex255 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l199
+						goto l256
 					}
 					position++
-					goto l198
-				l199:
-					position, tokenIndex = position198, tokenIndex198
+					goto l255
+				l256:
+					position, tokenIndex = position255, tokenIndex255
 					if buffer[position] != rune('R') {
-						goto l194
+						goto l251
 					}
 					position++
 				}
-			l198:
+			l255:
 				{
-					position200, tokenIndex200 := position, tokenIndex
+					position257, tokenIndex257 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l201
+						goto l258
 					}
 					position++
-					goto l200
-				l201:
-					position, tokenIndex = position200, tokenIndex200
+					goto l257
+				l258:
+					position, tokenIndex = position257, tokenIndex257
 					if buffer[position] != rune('E') {
-						goto l194
+						goto l251
 					}
 					position++
 				}
-			l200:
+			l257:
 				{
-					position202, tokenIndex202 := position, tokenIndex
+					position259, tokenIndex259 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l203
+						goto l260
 					}
 					position++
-					goto l202
-				l203:
-					position, tokenIndex = position202, tokenIndex202
+					goto l259
+				l260:
+					position, tokenIndex = position259, tokenIndex259
 					if buffer[position] != rune('A') {
-						goto l194
+						goto l251
 					}
 					position++
 				}
-			l202:
+			l259:
 				{
-					position204, tokenIndex204 := position, tokenIndex
+					position261, tokenIndex261 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l205
+						goto l262
 					}
 					position++
-					goto l204
-				l205:
-					position, tokenIndex = position204, tokenIndex204
+					goto l261
+				l262:
+					position, tokenIndex = position261, tokenIndex261
 					if buffer[position] != rune('T') {
-						goto l194
+						goto l251
 					}
 					position++
 				}
-			l204:
+			l261:
 				{
-					position206, tokenIndex206 := position, tokenIndex
+					position263, tokenIndex263 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l207
+						goto l264
 					}
 					position++
-					goto l206
-				l207:
-					position, tokenIndex = position206, tokenIndex206
+					goto l263
+				l264:
+					position, tokenIndex = position263, tokenIndex263
 					if buffer[position] != rune('E') {
-						goto l194
+						goto l251
 					}
 					position++
 				}
-			l206:
+			l263:
 				if !_rules[rulesp]() {
-					goto l194
+					goto l251
 				}
 				{
-					position208, tokenIndex208 := position, tokenIndex
+					position265, tokenIndex265 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l209
+						goto l266
 					}
 					position++
-					goto l208
-				l209:
-					position, tokenIndex = position208, tokenIndex208
+					goto l265
+				l266:
+					position, tokenIndex = position265, tokenIndex265
 					if buffer[position] != rune('S') {
-						goto l194
+						goto l251
 					}
 					position++
 				}
-			l208:
+			l265:
 				{
-					position210, tokenIndex210 := position, tokenIndex
+					position267, tokenIndex267 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l211
+						goto l268
 					}
 					position++
-					goto l210
-				l211:
-					position, tokenIndex = position210, tokenIndex210
+					goto l267
+				l268:
+					position, tokenIndex = position267, tokenIndex267
 					if buffer[position] != rune('I') {
-						goto l194
+						goto l251
 					}
 					position++
 				}
-			l210:
+			l267:
 				{
-					position212, tokenIndex212 := position, tokenIndex
+					position269, tokenIndex269 := position, tokenIndex
 					if buffer[position] != rune('n') {
-						goto l213
+						goto l270
 					}
 					position++
-					goto l212
-				l213:
-					position, tokenIndex = position212, tokenIndex212
+					goto l269
+				l270:
+					position, tokenIndex = position269, tokenIndex269
 					if buffer[position] != rune('N') {
-						goto l194
+						goto l251
 					}
 					position++
 				}
-			l212:
+			l269:
 				{
-					position214, tokenIndex214 := position, tokenIndex
+					position271, tokenIndex271 := position, tokenIndex
 					if buffer[position] != rune('k') {
-						goto l215
+						goto l272
 					}
 					position++
-					goto l214
-				l215:
-					position, tokenIndex = position214, tokenIndex214
+					goto l271
+				l272:
+					position, tokenIndex = position271, tokenIndex271
 					if buffer[position] != rune('K') {
-						goto l194
+						goto l251
 					}
 					position++
 				}
-			l214:
+			l271:
 				if !_rules[rulesp]() {
-					goto l194
+					goto l251
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l194
+					goto l251
 				}
 				if !_rules[rulesp]() {
-					goto l194
+					goto l251
 				}
 				{
-					position216, tokenIndex216 := position, tokenIndex
+					position273, tokenIndex273 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l217
+						goto l274
 					}
 					position++
-					goto l216
-				l217:
-					position, tokenIndex = position216, tokenIndex216
+					goto l273
+				l274:
+					position, tokenIndex = position273, tokenIndex273
 					if buffer[position] != rune('T') {
-						goto l194
+						goto l251
 					}
 					position++
 				}
-			l216:
+			l273:
 				{
-					position218, tokenIndex218 := position, tokenIndex
+					position275, tokenIndex275 := position, tokenIndex
 					if buffer[position] != rune('y') {
-						goto l219
+						goto l276
 					}
 					position++
-					goto l218
-				l219:
-					position, tokenIndex = position218, tokenIndex218
+					goto l275
+				l276:
+					position, tokenIndex = position275, tokenIndex275
 					if buffer[position] != rune('Y') {
-						goto l194
+						goto l251
 					}
 					position++
 				}
-			l218:
+			l275:
 				{
-					position220, tokenIndex220 := position, tokenIndex
+					position277, tokenIndex277 := position, tokenIndex
 					if buffer[position] != rune('p') {
-						goto l221
+						goto l278
 					}
 					position++
-					goto l220
-				l221:
-					position, tokenIndex = position220, tokenIndex220
+					goto l277
+				l278:
+					position, tokenIndex = position277, tokenIndex277
 					if buffer[position] != rune('P') {
-						goto l194
+						goto l251
 					}
 					position++
 				}
-			l220:
+			l277:
 				{
-					position222, tokenIndex222 := position, tokenIndex
+					position279, tokenIndex279 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l223
+						goto l280
 					}
 					position++
-					goto l222
-				l223:
-					position, tokenIndex = position222, tokenIndex222
+					goto l279
+				l280:
+					position, tokenIndex = position279, tokenIndex279
 					if buffer[position] != rune('E') {
-						goto l194
+						goto l251
 					}
 					position++
 				}
-			l222:
+			l279:
 				if !_rules[rulesp]() {
-					goto l194
+					goto l251
 				}
 				if !_rules[ruleSourceSinkType]() {
-					goto l194
+					goto l251
 				}
 				if !_rules[ruleSourceSinkSpecs]() {
-					goto l194
+					goto l251
 				}
-				if !_rules[ruleAction7]() {
-					goto l194
+				if !_rules[ruleAction8]() {
+					goto l251
 				}
-				add(ruleCreateSinkStmt, position195)
+				add(ruleCreateSinkStmt, position252)
 			}
 			return true
-		l194:
-			position, tokenIndex = position194, tokenIndex194
+		l251:
+			position, tokenIndex = position251, tokenIndex251
 			return false
 		},
-		/* 14 CreateStateStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType SourceSinkSpecs Action8)> */
+		/* 16 CreateStateStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType SourceSinkSpecs Action9)> */
 		func() bool {
-			position224, tokenIndex224 := position, tokenIndex
+			position281, tokenIndex281 := position, tokenIndex
 			{
-				position225 := position
+				position282 := position
 				{
-					position226, tokenIndex226 := position, tokenIndex
+					position283, tokenIndex283 := position, tokenIndex
 					if buffer[position] != rune('c') {
-						goto l227
+						goto l284
 					}
 					position++
-					goto l226
-				l227:
-					position, tokenIndex = position226, tokenIndex226
+					goto l283
+				l284:
+					position, tokenIndex = position283, tokenIndex283
 					if buffer[position] != rune('C') {
-						goto l224
+						goto l281
 					}
 					position++
 				}
-			l226:
+			l283:
 				{
-					position228, tokenIndex228 := position, tokenIndex
+					position285, tokenIndex285 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l229
+						goto l286
 					}
 					position++
-					goto l228
-				l229:
-					position, tokenIndex = position228, tokenIndex228
+					goto l285
+				l286:
+					position, tokenIndex = position285, tokenIndex285
 					if buffer[position] != rune('R') {
-						goto l224
+						goto l281
 					}
 					position++
 				}
-			l228:
+			l285:
 				{
-					position230, tokenIndex230 := position, tokenIndex
+					position287, tokenIndex287 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l231
+						goto l288
 					}
 					position++
-					goto l230
-				l231:
-					position, tokenIndex = position230, tokenIndex230
+					goto l287
+				l288:
+					position, tokenIndex = position287, tokenIndex287
 					if buffer[position] != rune('E') {
-						goto l224
+						goto l281
 					}
 					position++
 				}
-			l230:
+			l287:
 				{
-					position232, tokenIndex232 := position, tokenIndex
+					position289, tokenIndex289 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l233
+						goto l290
 					}
 					position++
-					goto l232
-				l233:
-					position, tokenIndex = position232, tokenIndex232
+					goto l289
+				l290:
+					position, tokenIndex = position289, tokenIndex289
 					if buffer[position] != rune('A') {
-						goto l224
+						goto l281
 					}
 					position++
 				}
-			l232:
+			l289:
 				{
-					position234, tokenIndex234 := position, tokenIndex
+					position291, tokenIndex291 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l235
+						goto l292
 					}
 					position++
-					goto l234
-				l235:
-					position, tokenIndex = position234, tokenIndex234
+					goto l291
+				l292:
+					position, tokenIndex = position291, tokenIndex291
 					if buffer[position] != rune('T') {
-						goto l224
+						goto l281
 					}
 					position++
 				}
-			l234:
+			l291:
 				{
-					position236, tokenIndex236 := position, tokenIndex
+					position293, tokenIndex293 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l237
+						goto l294
 					}
 					position++
-					goto l236
-				l237:
-					position, tokenIndex = position236, tokenIndex236
+					goto l293
+				l294:
+					position, tokenIndex = position293, tokenIndex293
 					if buffer[position] != rune('E') {
-						goto l224
+						goto l281
 					}
 					position++
 				}
-			l236:
+			l293:
 				if !_rules[rulesp]() {
-					goto l224
+					goto l281
 				}
 				{
-					position238, tokenIndex238 := position, tokenIndex
+					position295, tokenIndex295 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l239
+						goto l296
 					}
 					position++
-					goto l238
-				l239:
-					position, tokenIndex = position238, tokenIndex238
+					goto l295
+				l296:
+					position, tokenIndex = position295, tokenIndex295
 					if buffer[position] != rune('S') {
-						goto l224
+						goto l281
 					}
 					position++
 				}
-			l238:
+			l295:
 				{
-					position240, tokenIndex240 := position, tokenIndex
+					position297, tokenIndex297 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l241
+						goto l298
 					}
 					position++
-					goto l240
-				l241:
-					position, tokenIndex = position240, tokenIndex240
+					goto l297
+				l298:
+					position, tokenIndex = position297, tokenIndex297
 					if buffer[position] != rune('T') {
-						goto l224
+						goto l281
 					}
 					position++
 				}
-			l240:
+			l297:
 				{
-					position242, tokenIndex242 := position, tokenIndex
+					position299, tokenIndex299 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l243
+						goto l300
 					}
 					position++
-					goto l242
-				l243:
-					position, tokenIndex = position242, tokenIndex242
+					goto l299
+				l300:
+					position, tokenIndex = position299, tokenIndex299
 					if buffer[position] != rune('A') {
-						goto l224
+						goto l281
 					}
 					position++
 				}
-			l242:
+			l299:
 				{
-					position244, tokenIndex244 := position, tokenIndex
+					position301, tokenIndex301 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l245
+						goto l302
 					}
 					position++
-					goto l244
-				l245:
-					position, tokenIndex = position244, tokenIndex244
+					goto l301
+				l302:
+					position, tokenIndex = position301, tokenIndex301
 					if buffer[position] != rune('T') {
-						goto l224
+						goto l281
 					}
 					position++
 				}
-			l244:
+			l301:
 				{
-					position246, tokenIndex246 := position, tokenIndex
+					position303, tokenIndex303 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l247
+						goto l304
 					}
 					position++
-					goto l246
-				l247:
-					position, tokenIndex = position246, tokenIndex246
+					goto l303
+				l304:
+					position, tokenIndex = position303, tokenIndex303
 					if buffer[position] != rune('E') {
-						goto l224
+						goto l281
 					}
 					position++
 				}
-			l246:
+			l303:
 				if !_rules[rulesp]() {
-					goto l224
+					goto l281
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l224
+					goto l281
 				}
 				if !_rules[rulesp]() {
-					goto l224
+					goto l281
 				}
 				{
-					position248, tokenIndex248 := position, tokenIndex
+					position305, tokenIndex305 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l249
+						goto l306
 					}
 					position++
-					goto l248
-				l249:
-					position, tokenIndex = position248, tokenIndex248
+					goto l305
+				l306:
+					position, tokenIndex = position305, tokenIndex305
 					if buffer[position] != rune('T') {
-						goto l224
+						goto l281
 					}
 					position++
 				}
-			l248:
+			l305:
 				{
-					position250, tokenIndex250 := position, tokenIndex
+					position307, tokenIndex307 := position, tokenIndex
 					if buffer[position] != rune('y') {
-						goto l251
+						goto l308
 					}
 					position++
-					goto l250
-				l251:
-					position, tokenIndex = position250, tokenIndex250
+					goto l307
+				l308:
+					position, tokenIndex = position307, tokenIndex307
 					if buffer[position] != rune('Y') {
-						goto l224
+						goto l281
 					}
 					position++
 				}
-			l250:
+			l307:
 				{
-					position252, tokenIndex252 := position, tokenIndex
+					position309, tokenIndex309 := position, tokenIndex
 					if buffer[position] != rune('p') {
-						goto l253
+						goto l310
 					}
 					position++
-					goto l252
-				l253:
-					position, tokenIndex = position252, tokenIndex252
+					goto l309
+				l310:
+					position, tokenIndex = position309, tokenIndex309
 					if buffer[position] != rune('P') {
-						goto l224
+						goto l281
 					}
 					position++
 				}
-			l252:
+			l309:
 				{
-					position254, tokenIndex254 := position, tokenIndex
+					position311, tokenIndex311 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l255
+						goto l312
 					}
 					position++
-					goto l254
-				l255:
-					position, tokenIndex = position254, tokenIndex254
+					goto l311
+				l312:
+					position, tokenIndex = position311, tokenIndex311
 					if buffer[position] != rune('E') {
-						goto l224
+						goto l281
 					}
 					position++
 				}
-			l254:
+			l311:
 				if !_rules[rulesp]() {
-					goto l224
+					goto l281
 				}
 				if !_rules[ruleSourceSinkType]() {
-					goto l224
+					goto l281
 				}
 				if !_rules[ruleSourceSinkSpecs]() {
-					goto l224
+					goto l281
 				}
-				if !_rules[ruleAction8]() {
-					goto l224
+				if !_rules[ruleAction9]() {
+					goto l281
 				}
-				add(ruleCreateStateStmt, position225)
+				add(ruleCreateStateStmt, position282)
 			}
 			return true
-		l224:
-			position, tokenIndex = position224, tokenIndex224
+		l281:
+			position, tokenIndex = position281, tokenIndex281
 			return false
 		},
-		/* 15 UpdateStateStmt <- <(('u' / 'U') ('p' / 'P') ('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier UpdateSourceSinkSpecs Action9)> */
+		/* 17 UpdateStateStmt <- <(('u' / 'U') ('p' / 'P') ('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier UpdateSourceSinkSpecs Action10)> */
 		func() bool {
-			position256, tokenIndex256 := position, tokenIndex
+			position313, tokenIndex313 := position, tokenIndex
 			{
-				position257 := position
+				position314 := position
 				{
-					position258, tokenIndex258 := position, tokenIndex
+					position315, tokenIndex315 := position, tokenIndex
 					if buffer[position] != rune('u') {
-						goto l259
+						goto l316
 					}
 					position++
-					goto l258
-				l259:
-					position, tokenIndex = position258, tokenIndex258
+					goto l315
+				l316:
+					position, tokenIndex = position315, tokenIndex315
 					if buffer[position] != rune('U') {
-						goto l256
+						goto l313
 					}
 					position++
 				}
-			l258:
+			l315:
 				{
-					position260, tokenIndex260 := position, tokenIndex
+					position317, tokenIndex317 := position, tokenIndex
 					if buffer[position] != rune('p') {
-						goto l261
+						goto l318
 					}
 					position++
-					goto l260
-				l261:
-					position, tokenIndex = position260, tokenIndex260
+					goto l317
+				l318:
+					position, tokenIndex = position317, tokenIndex317
 					if buffer[position] != rune('P') {
-						goto l256
+						goto l313
 					}
 					position++
 				}
-			l260:
+			l317:
 				{
-					position262, tokenIndex262 := position, tokenIndex
+					position319, tokenIndex319 := position, tokenIndex
 					if buffer[position] != rune('d') {
-						goto l263
+						goto l320
 					}
 					position++
-					goto l262
-				l263:
-					position, tokenIndex = position262, tokenIndex262
+					goto l319
+				l320:
+					position, tokenIndex = position319, tokenIndex319
 					if buffer[position] != rune('D') {
-						goto l256
+						goto l313
 					}
 					position++
 				}
-			l262:
+			l319:
 				{
-					position264, tokenIndex264 := position, tokenIndex
+					position321, tokenIndex321 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l265
+						goto l322
 					}
 					position++
-					goto l264
-				l265:
-					position, tokenIndex = position264, tokenIndex264
+					goto l321
+				l322:
+					position, tokenIndex = position321, tokenIndex321
 					if buffer[position] != rune('A') {
-						goto l256
+						goto l313
 					}
 					position++
 				}
-			l264:
+			l321:
 				{
-					position266, tokenIndex266 := position, tokenIndex
+					position323, tokenIndex323 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l267
+						goto l324
 					}
 					position++
-					goto l266
-				l267:
-					position, tokenIndex = position266, tokenIndex266
+					goto l323
+				l324:
+					position, tokenIndex = position323, tokenIndex323
 					if buffer[position] != rune('T') {
-						goto l256
+						goto l313
 					}
 					position++
 				}
-			l266:
+			l323:
 				{
-					position268, tokenIndex268 := position, tokenIndex
+					position325, tokenIndex325 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l269
+						goto l326
 					}
 					position++
-					goto l268
-				l269:
-					position, tokenIndex = position268, tokenIndex268
+					goto l325
+				l326:
+					position, tokenIndex = position325, tokenIndex325
 					if buffer[position] != rune('E') {
-						goto l256
+						goto l313
 					}
 					position++
 				}
-			l268:
+			l325:
 				if !_rules[rulesp]() {
-					goto l256
+					goto l313
 				}
 				{
-					position270, tokenIndex270 := position, tokenIndex
+					position327, tokenIndex327 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l271
+						goto l328
 					}
 					position++
-					goto l270
-				l271:
-					position, tokenIndex = position270, tokenIndex270
+					goto l327
+				l328:
+					position, tokenIndex = position327, tokenIndex327
 					if buffer[position] != rune('S') {
-						goto l256
+						goto l313
 					}
 					position++
 				}
-			l270:
+			l327:
 				{
-					position272, tokenIndex272 := position, tokenIndex
+					position329, tokenIndex329 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l273
+						goto l330
 					}
 					position++
-					goto l272
-				l273:
-					position, tokenIndex = position272, tokenIndex272
+					goto l329
+				l330:
+					position, tokenIndex = position329, tokenIndex329
 					if buffer[position] != rune('T') {
-						goto l256
+						goto l313
 					}
 					position++
 				}
-			l272:
+			l329:
 				{
-					position274, tokenIndex274 := position, tokenIndex
+					position331, tokenIndex331 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l275
+						goto l332
 					}
 					position++
-					goto l274
-				l275:
-					position, tokenIndex = position274, tokenIndex274
+					goto l331
+				l332:
+					position, tokenIndex = position331, tokenIndex331
 					if buffer[position] != rune('A') {
-						goto l256
+						goto l313
 					}
 					position++
 				}
-			l274:
+			l331:
 				{
-					position276, tokenIndex276 := position, tokenIndex
+					position333, tokenIndex333 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l277
+						goto l334
 					}
 					position++
-					goto l276
-				l277:
-					position, tokenIndex = position276, tokenIndex276
+					goto l333
+				l334:
+					position, tokenIndex = position333, tokenIndex333
 					if buffer[position] != rune('T') {
-						goto l256
+						goto l313
 					}
 					position++
 				}
-			l276:
+			l333:
 				{
-					position278, tokenIndex278 := position, tokenIndex
+					position335, tokenIndex335 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l279
+						goto l336
 					}
 					position++
-					goto l278
-				l279:
-					position, tokenIndex = position278, tokenIndex278
+					goto l335
+				l336:
+					position, tokenIndex = position335, tokenIndex335
 					if buffer[position] != rune('E') {
-						goto l256
+						goto l313
 					}
 					position++
 				}
-			l278:
+			l335:
 				if !_rules[rulesp]() {
-					goto l256
+					goto l313
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l256
+					goto l313
 				}
 				if !_rules[ruleUpdateSourceSinkSpecs]() {
-					goto l256
+					goto l313
 				}
-				if !_rules[ruleAction9]() {
-					goto l256
+				if !_rules[ruleAction10]() {
+					goto l313
 				}
-				add(ruleUpdateStateStmt, position257)
+				add(ruleUpdateStateStmt, position314)
 			}
 			return true
-		l256:
-			position, tokenIndex = position256, tokenIndex256
+		l313:
+			position, tokenIndex = position313, tokenIndex313
 			return false
 		},
-		/* 16 UpdateSourceStmt <- <(('u' / 'U') ('p' / 'P') ('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier UpdateSourceSinkSpecs Action10)> */
+		/* 18 UpdateSourceStmt <- <(('u' / 'U') ('p' / 'P') ('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier UpdateSourceSinkSpecs Action11)> */
 		func() bool {
-			position280, tokenIndex280 := position, tokenIndex
+			position337, tokenIndex337 := position, tokenIndex
 			{
-				position281 := position
+				position338 := position
 				{
-					position282, tokenIndex282 := position, tokenIndex
+					position339, tokenIndex339 := position, tokenIndex
 					if buffer[position] != rune('u') {
-						goto l283
+						goto l340
 					}
 					position++
-					goto l282
-				l283:
-					position, tokenIndex = position282, tokenIndex282
+					goto l339
+				l340:
+					position, tokenIndex = position339, tokenIndex339
 					if buffer[position] != rune('U') {
-						goto l280
+						goto l337
 					}
 					position++
 				}
-			l282:
+			l339:
 				{
-					position284, tokenIndex284 := position, tokenIndex
+					position341, tokenIndex341 := position, tokenIndex
 					if buffer[position] != rune('p') {
-						goto l285
+						goto l342
 					}
 					position++
-					goto l284
-				l285:
-					position, tokenIndex = position284, tokenIndex284
+					goto l341
+				l342:
+					position, tokenIndex = position341, tokenIndex341
 					if buffer[position] != rune('P') {
-						goto l280
+						goto l337
 					}
 					position++
 				}
-			l284:
+			l341:
 				{
-					position286, tokenIndex286 := position, tokenIndex
+					position343, tokenIndex343 := position, tokenIndex
 					if buffer[position] != rune('d') {
-						goto l287
+						goto l344
 					}
 					position++
-					goto l286
-				l287:
-					position, tokenIndex = position286, tokenIndex286
+					goto l343
+				l344:
+					position, tokenIndex = position343, tokenIndex343
 					if buffer[position] != rune('D') {
-						goto l280
+						goto l337
 					}
 					position++
 				}
-			l286:
+			l343:
 				{
-					position288, tokenIndex288 := position, tokenIndex
+					position345, tokenIndex345 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l289
+						goto l346
 					}
 					position++
-					goto l288
-				l289:
-					position, tokenIndex = position288, tokenIndex288
+					goto l345
+				l346:
+					position, tokenIndex = position345, tokenIndex345
 					if buffer[position] != rune('A') {
-						goto l280
+						goto l337
 					}
 					position++
 				}
-			l288:
+			l345:
 				{
-					position290, tokenIndex290 := position, tokenIndex
+					position347, tokenIndex347 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l291
+						goto l348
 					}
 					position++
-					goto l290
-				l291:
-					position, tokenIndex = position290, tokenIndex290
+					goto l347
+				l348:
+					position, tokenIndex = position347, tokenIndex347
 					if buffer[position] != rune('T') {
-						goto l280
+						goto l337
 					}
 					position++
 				}
-			l290:
+			l347:
 				{
-					position292, tokenIndex292 := position, tokenIndex
+					position349, tokenIndex349 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l293
+						goto l350
 					}
 					position++
-					goto l292
-				l293:
-					position, tokenIndex = position292, tokenIndex292
+					goto l349
+				l350:
+					position, tokenIndex = position349, tokenIndex349
 					if buffer[position] != rune('E') {
-						goto l280
+						goto l337
 					}
 					position++
 				}
-			l292:
+			l349:
 				if !_rules[rulesp]() {
-					goto l280
+					goto l337
 				}
 				{
-					position294, tokenIndex294 := position, tokenIndex
+					position351, tokenIndex351 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l295
+						goto l352
 					}
 					position++
-					goto l294
-				l295:
-					position, tokenIndex = position294, tokenIndex294
+					goto l351
+				l352:
+					position, tokenIndex = position351, tokenIndex351
 					if buffer[position] != rune('S') {
-						goto l280
+						goto l337
 					}
 					position++
 				}
-			l294:
+			l351:
 				{
-					position296, tokenIndex296 := position, tokenIndex
+					position353, tokenIndex353 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l297
+						goto l354
 					}
 					position++
-					goto l296
-				l297:
-					position, tokenIndex = position296, tokenIndex296
+					goto l353
+				l354:
+					position, tokenIndex = position353, tokenIndex353
 					if buffer[position] != rune('O') {
-						goto l280
+						goto l337
 					}
 					position++
 				}
-			l296:
+			l353:
 				{
-					position298, tokenIndex298 := position, tokenIndex
+					position355, tokenIndex355 := position, tokenIndex
 					if buffer[position] != rune('u') {
-						goto l299
+						goto l356
 					}
 					position++
-					goto l298
-				l299:
-					position, tokenIndex = position298, tokenIndex298
+					goto l355
+				l356:
+					position, tokenIndex = position355, tokenIndex355
 					if buffer[position] != rune('U') {
-						goto l280
+						goto l337
 					}
 					position++
 				}
-			l298:
+			l355:
 				{
-					position300, tokenIndex300 := position, tokenIndex
+					position357, tokenIndex357 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l301
+						goto l358
 					}
 					position++
-					goto l300
-				l301:
-					position, tokenIndex = position300, tokenIndex300
+					goto l357
+				l358:
+					position, tokenIndex = position357, tokenIndex357
 					if buffer[position] != rune('R') {
-						goto l280
+						goto l337
 					}
 					position++
 				}
-			l300:
+			l357:
 				{
-					position302, tokenIndex302 := position, tokenIndex
+					position359, tokenIndex359 := position, tokenIndex
 					if buffer[position] != rune('c') {
-						goto l303
+						goto l360
 					}
 					position++
-					goto l302
-				l303:
-					position, tokenIndex = position302, tokenIndex302
+					goto l359
+				l360:
+					position, tokenIndex = position359, tokenIndex359
 					if buffer[position] != rune('C') {
-						goto l280
+						goto l337
 					}
 					position++
 				}
-			l302:
+			l359:
 				{
-					position304, tokenIndex304 := position, tokenIndex
+					position361, tokenIndex361 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l305
+						goto l362
 					}
 					position++
-					goto l304
-				l305:
-					position, tokenIndex = position304, tokenIndex304
+					goto l361
+				l362:
+					position, tokenIndex = position361, tokenIndex361
 					if buffer[position] != rune('E') {
-						goto l280
+						goto l337
 					}
 					position++
 				}
-			l304:
+			l361:
 				if !_rules[rulesp]() {
-					goto l280
+					goto l337
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l280
+					goto l337
 				}
 				if !_rules[ruleUpdateSourceSinkSpecs]() {
-					goto l280
+					goto l337
 				}
-				if !_rules[ruleAction10]() {
-					goto l280
+				if !_rules[ruleAction11]() {
+					goto l337
 				}
-				add(ruleUpdateSourceStmt, position281)
+				add(ruleUpdateSourceStmt, position338)
 			}
 			return true
-		l280:
-			position, tokenIndex = position280, tokenIndex280
+		l337:
+			position, tokenIndex = position337, tokenIndex337
 			return false
 		},
-		/* 17 UpdateSinkStmt <- <(('u' / 'U') ('p' / 'P') ('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K')) sp StreamIdentifier UpdateSourceSinkSpecs Action11)> */
+		/* 19 UpdateSinkStmt <- <(('u' / 'U') ('p' / 'P') ('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K')) sp StreamIdentifier UpdateSourceSinkSpecs Action12)> */
 		func() bool {
-			position306, tokenIndex306 := position, tokenIndex
+			position363, tokenIndex363 := position, tokenIndex
 			{
-				position307 := position
+				position364 := position
 				{
-					position308, tokenIndex308 := position, tokenIndex
+					position365, tokenIndex365 := position, tokenIndex
 					if buffer[position] != rune('u') {
-						goto l309
+						goto l366
 					}
 					position++
-					goto l308
-				l309:
-					position, tokenIndex = position308, tokenIndex308
+					goto l365
+				l366:
+					position, tokenIndex = position365, tokenIndex365
 					if buffer[position] != rune('U') {
-						goto l306
+						goto l363
 					}
 					position++
 				}
-			l308:
+			l365:
 				{
-					position310, tokenIndex310 := position, tokenIndex
+					position367, tokenIndex367 := position, tokenIndex
 					if buffer[position] != rune('p') {
-						goto l311
+						goto l368
 					}
 					position++
-					goto l310
-				l311:
-					position, tokenIndex = position310, tokenIndex310
+					goto l367
+				l368:
+					position, tokenIndex = position367, tokenIndex367
 					if buffer[position] != rune('P') {
-						goto l306
+						goto l363
 					}
 					position++
 				}
-			l310:
+			l367:
 				{
-					position312, tokenIndex312 := position, tokenIndex
+					position369, tokenIndex369 := position, tokenIndex
 					if buffer[position] != rune('d') {
-						goto l313
+						goto l370
 					}
 					position++
-					goto l312
-				l313:
-					position, tokenIndex = position312, tokenIndex312
+					goto l369
+				l370:
+					position, tokenIndex = position369, tokenIndex369
 					if buffer[position] != rune('D') {
-						goto l306
+						goto l363
 					}
 					position++
 				}
-			l312:
+			l369:
 				{
-					position314, tokenIndex314 := position, tokenIndex
+					position371, tokenIndex371 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l315
+						goto l372
 					}
 					position++
-					goto l314
-				l315:
-					position, tokenIndex = position314, tokenIndex314
+					goto l371
+				l372:
+					position, tokenIndex = position371, tokenIndex371
 					if buffer[position] != rune('A') {
-						goto l306
+						goto l363
 					}
 					position++
 				}
-			l314:
+			l371:
 				{
-					position316, tokenIndex316 := position, tokenIndex
+					position373, tokenIndex373 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l317
+						goto l374
 					}
 					position++
-					goto l316
-				l317:
-					position, tokenIndex = position316, tokenIndex316
+					goto l373
+				l374:
+					position, tokenIndex = position373, tokenIndex373
 					if buffer[position] != rune('T') {
-						goto l306
+						goto l363
 					}
 					position++
 				}
-			l316:
+			l373:
 				{
-					position318, tokenIndex318 := position, tokenIndex
+					position375, tokenIndex375 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l319
+						goto l376
 					}
 					position++
-					goto l318
-				l319:
-					position, tokenIndex = position318, tokenIndex318
+					goto l375
+				l376:
+					position, tokenIndex = position375, tokenIndex375
 					if buffer[position] != rune('E') {
-						goto l306
+						goto l363
 					}
 					position++
 				}
-			l318:
+			l375:
 				if !_rules[rulesp]() {
-					goto l306
+					goto l363
 				}
 				{
-					position320, tokenIndex320 := position, tokenIndex
+					position377, tokenIndex377 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l321
+						goto l378
 					}
 					position++
-					goto l320
-				l321:
-					position, tokenIndex = position320, tokenIndex320
+					goto l377
+				l378:
+					position, tokenIndex = position377, tokenIndex377
 					if buffer[position] != rune('S') {
-						goto l306
+						goto l363
 					}
 					position++
 				}
-			l320:
+			l377:
 				{
-					position322, tokenIndex322 := position, tokenIndex
+					position379, tokenIndex379 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l323
+						goto l380
 					}
 					position++
-					goto l322
-				l323:
-					position, tokenIndex = position322, tokenIndex322
+					goto l379
+				l380:
+					position, tokenIndex = position379, tokenIndex379
 					if buffer[position] != rune('I') {
-						goto l306
+						goto l363
 					}
 					position++
 				}
-			l322:
+			l379:
 				{
-					position324, tokenIndex324 := position, tokenIndex
+					position381, tokenIndex381 := position, tokenIndex
 					if buffer[position] != rune('n') {
-						goto l325
+						goto l382
 					}
 					position++
-					goto l324
-				l325:
-					position, tokenIndex = position324, tokenIndex324
+					goto l381
+				l382:
+					position, tokenIndex = position381, tokenIndex381
 					if buffer[position] != rune('N') {
-						goto l306
+						goto l363
 					}
 					position++
 				}
-			l324:
+			l381:
 				{
-					position326, tokenIndex326 := position, tokenIndex
+					position383, tokenIndex383 := position, tokenIndex
 					if buffer[position] != rune('k') {
-						goto l327
+						goto l384
 					}
 					position++
-					goto l326
-				l327:
-					position, tokenIndex = position326, tokenIndex326
+					goto l383
+				l384:
+					position, tokenIndex = position383, tokenIndex383
 					if buffer[position] != rune('K') {
-						goto l306
+						goto l363
 					}
 					position++
 				}
-			l326:
+			l383:
 				if !_rules[rulesp]() {
-					goto l306
+					goto l363
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l306
+					goto l363
 				}
 				if !_rules[ruleUpdateSourceSinkSpecs]() {
-					goto l306
+					goto l363
 				}
-				if !_rules[ruleAction11]() {
-					goto l306
+				if !_rules[ruleAction12]() {
+					goto l363
 				}
-				add(ruleUpdateSinkStmt, position307)
+				add(ruleUpdateSinkStmt, position364)
 			}
 			return true
-		l306:
-			position, tokenIndex = position306, tokenIndex306
+		l363:
+			position, tokenIndex = position363, tokenIndex363
 			return false
 		},
-		/* 18 InsertIntoFromStmt <- <(('i' / 'I') ('n' / 'N') ('s' / 'S') ('e' / 'E') ('r' / 'R') ('t' / 'T') sp (('i' / 'I') ('n' / 'N') ('t' / 'T') ('o' / 'O')) sp StreamIdentifier sp (('f' / 'F') ('r' / 'R') ('o' / 'O') ('m' / 'M')) sp StreamIdentifier Action12)> */
+		/* 20 InsertIntoFromStmt <- <(('i' / 'I') ('n' / 'N') ('s' / 'S') ('e' / 'E') ('r' / 'R') ('t' / 'T') sp (('i' / 'I') ('n' / 'N') ('t' / 'T') ('o' / 'O')) sp StreamIdentifier sp (('f' / 'F') ('r' / 'R') ('o' / 'O') ('m' / 'M')) sp StreamIdentifier Action13)> */
 		func() bool {
-			position328, tokenIndex328 := position, tokenIndex
+			position385, tokenIndex385 := position, tokenIndex
 			{
-				position329 := position
+				position386 := position
 				{
-					position330, tokenIndex330 := position, tokenIndex
+					position387, tokenIndex387 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l331
+						goto l388
 					}
 					position++
-					goto l330
-				l331:
-					position, tokenIndex = position330, tokenIndex330
+					goto l387
+				l388:
+					position, tokenIndex = position387, tokenIndex387
 					if buffer[position] != rune('I') {
-						goto l328
+						goto l385
 					}
 					position++
 				}
-			l330:
+			l387:
 				{
-					position332, tokenIndex332 := position, tokenIndex
+					position389, tokenIndex389 := position, tokenIndex
 					if buffer[position] != rune('n') {
-						goto l333
+						goto l390
 					}
 					position++
-					goto l332
-				l333:
-					position, tokenIndex = position332, tokenIndex332
+					goto l389
+				l390:
+					position, tokenIndex = position389, tokenIndex389
 					if buffer[position] != rune('N') {
-						goto l328
+						goto l385
 					}
 					position++
 				}
-			l332:
+			l389:
 				{
-					position334, tokenIndex334 := position, tokenIndex
+					position391, tokenIndex391 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l335
+						goto l392
 					}
 					position++
-					goto l334
-				l335:
-					position, tokenIndex = position334, tokenIndex334
+					goto l391
+				l392:
+					position, tokenIndex = position391, tokenIndex391
 					if buffer[position] != rune('S') {
-						goto l328
+						goto l385
 					}
 					position++
 				}
-			l334:
+			l391:
 				{
-					position336, tokenIndex336 := position, tokenIndex
+					position393, tokenIndex393 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l337
+						goto l394
 					}
 					position++
-					goto l336
-				l337:
-					position, tokenIndex = position336, tokenIndex336
+					goto l393
+				l394:
+					position, tokenIndex = position393, tokenIndex393
 					if buffer[position] != rune('E') {
-						goto l328
+						goto l385
 					}
 					position++
 				}
-			l336:
+			l393:
 				{
-					position338, tokenIndex338 := position, tokenIndex
+					position395, tokenIndex395 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l339
+						goto l396
 					}
 					position++
-					goto l338
-				l339:
-					position, tokenIndex = position338, tokenIndex338
+					goto l395
+				l396:
+					position, tokenIndex = position395, tokenIndex395
 					if buffer[position] != rune('R') {
-						goto l328
+						goto l385
 					}
 					position++
 				}
-			l338:
+			l395:
 				{
-					position340, tokenIndex340 := position, tokenIndex
+					position397, tokenIndex397 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l341
+						goto l398
 					}
 					position++
-					goto l340
-				l341:
-					position, tokenIndex = position340, tokenIndex340
+					goto l397
+				l398:
+					position, tokenIndex = position397, tokenIndex397
 					if buffer[position] != rune('T') {
-						goto l328
+						goto l385
 					}
 					position++
 				}
-			l340:
+			l397:
 				if !_rules[rulesp]() {
-					goto l328
+					goto l385
 				}
 				{
-					position342, tokenIndex342 := position, tokenIndex
+					position399, tokenIndex399 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l343
+						goto l400
 					}
 					position++
-					goto l342
-				l343:
-					position, tokenIndex = position342, tokenIndex342
+					goto l399
+				l400:
+					position, tokenIndex = position399, tokenIndex399
 					if buffer[position] != rune('I') {
-						goto l328
+						goto l385
 					}
 					position++
 				}
-			l342:
+			l399:
 				{
-					position344, tokenIndex344 := position, tokenIndex
+					position401, tokenIndex401 := position, tokenIndex
 					if buffer[position] != rune('n') {
-						goto l345
+						goto l402
 					}
 					position++
-					goto l344
-				l345:
-					position, tokenIndex = position344, tokenIndex344
+					goto l401
+				l402:
+					position, tokenIndex = position401, tokenIndex401
 					if buffer[position] != rune('N') {
-						goto l328
+						goto l385
 					}
 					position++
 				}
-			l344:
+			l401:
 				{
-					position346, tokenIndex346 := position, tokenIndex
+					position403, tokenIndex403 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l347
+						goto l404
 					}
 					position++
-					goto l346
-				l347:
-					position, tokenIndex = position346, tokenIndex346
+					goto l403
+				l404:
+					position, tokenIndex = position403, tokenIndex403
 					if buffer[position] != rune('T') {
-						goto l328
+						goto l385
 					}
 					position++
 				}
-			l346:
+			l403:
 				{
-					position348, tokenIndex348 := position, tokenIndex
+					position405, tokenIndex405 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l349
+						goto l406
 					}
 					position++
-					goto l348
-				l349:
-					position, tokenIndex = position348, tokenIndex348
+					goto l405
+				l406:
+					position, tokenIndex = position405, tokenIndex405
 					if buffer[position] != rune('O') {
-						goto l328
+						goto l385
 					}
 					position++
 				}
-			l348:
+			l405:
 				if !_rules[rulesp]() {
-					goto l328
+					goto l385
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l328
+					goto l385
 				}
 				if !_rules[rulesp]() {
-					goto l328
+					goto l385
 				}
 				{
-					position350, tokenIndex350 := position, tokenIndex
+					position407, tokenIndex407 := position, tokenIndex
 					if buffer[position] != rune('f') {
-						goto l351
+						goto l408
 					}
 					position++
-					goto l350
-				l351:
-					position, tokenIndex = position350, tokenIndex350
+					goto l407
+				l408:
+					position, tokenIndex = position407, tokenIndex407
 					if buffer[position] != rune('F') {
-						goto l328
+						goto l385
 					}
 					position++
 				}
-			l350:
+			l407:
 				{
-					position352, tokenIndex352 := position, tokenIndex
+					position409, tokenIndex409 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l353
+						goto l410
 					}
 					position++
-					goto l352
-				l353:
-					position, tokenIndex = position352, tokenIndex352
+					goto l409
+				l410:
+					position, tokenIndex = position409, tokenIndex409
 					if buffer[position] != rune('R') {
-						goto l328
+						goto l385
 					}
 					position++
 				}
-			l352:
+			l409:
 				{
-					position354, tokenIndex354 := position, tokenIndex
+					position411, tokenIndex411 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l355
+						goto l412
 					}
 					position++
-					goto l354
-				l355:
-					position, tokenIndex = position354, tokenIndex354
+					goto l411
+				l412:
+					position, tokenIndex = position411, tokenIndex411
 					if buffer[position] != rune('O') {
-						goto l328
+						goto l385
 					}
 					position++
 				}
-			l354:
+			l411:
 				{
-					position356, tokenIndex356 := position, tokenIndex
+					position413, tokenIndex413 := position, tokenIndex
 					if buffer[position] != rune('m') {
-						goto l357
+						goto l414
 					}
 					position++
-					goto l356
-				l357:
-					position, tokenIndex = position356, tokenIndex356
+					goto l413
+				l414:
+					position, tokenIndex = position413, tokenIndex413
 					if buffer[position] != rune('M') {
-						goto l328
+						goto l385
 					}
 					position++
 				}
-			l356:
+			l413:
 				if !_rules[rulesp]() {
-					goto l328
+					goto l385
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l328
+					goto l385
 				}
-				if !_rules[ruleAction12]() {
-					goto l328
+				if !_rules[ruleAction13]() {
+					goto l385
 				}
-				add(ruleInsertIntoFromStmt, position329)
+				add(ruleInsertIntoFromStmt, position386)
 			}
 			return true
-		l328:
-			position, tokenIndex = position328, tokenIndex328
+		l385:
+			position, tokenIndex = position385, tokenIndex385
 			return false
 		},
-		/* 19 PauseSourceStmt <- <(('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action13)> */
+		/* 21 PauseSourceStmt <- <(('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action14)> */
 		func() bool {
-			position358, tokenIndex358 := position, tokenIndex
+			position415, tokenIndex415 := position, tokenIndex
 			{
-				position359 := position
+				position416 := position
 				{
-					position360, tokenIndex360 := position, tokenIndex
+					position417, tokenIndex417 := position, tokenIndex
 					if buffer[position] != rune('p') {
-						goto l361
+						goto l418
 					}
 					position++
-					goto l360
-				l361:
-					position, tokenIndex = position360, tokenIndex360
+					goto l417
+				l418:
+					position, tokenIndex = position417, tokenIndex417
 					if buffer[position] != rune('P') {
-						goto l358
+						goto l415
 					}
 					position++
 				}
-			l360:
+			l417:
 				{
-					position362, tokenIndex362 := position, tokenIndex
+					position419, tokenIndex419 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l363
+						goto l420
 					}
 					position++
-					goto l362
-				l363:
-					position, tokenIndex = position362, tokenIndex362
+					goto l419
+				l420:
+					position, tokenIndex = position419, tokenIndex419
 					if buffer[position] != rune('A') {
-						goto l358
+						goto l415
 					}
 					position++
 				}
-			l362:
+			l419:
 				{
-					position364, tokenIndex364 := position, tokenIndex
+					position421, tokenIndex421 := position, tokenIndex
 					if buffer[position] != rune('u') {
-						goto l365
+						goto l422
 					}
 					position++
-					goto l364
-				l365:
-					position, tokenIndex = position364, tokenIndex364
+					goto l421
+				l422:
+					position, tokenIndex = position421, tokenIndex421
 					if buffer[position] != rune('U') {
-						goto l358
+						goto l415
 					}
 					position++
 				}
-			l364:
+			l421:
 				{
-					position366, tokenIndex366 := position, tokenIndex
+					position423, tokenIndex423 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l367
+						goto l424
 					}
 					position++
-					goto l366
-				l367:
-					position, tokenIndex = position366, tokenIndex366
+					goto l423
+				l424:
+					position, tokenIndex = position423, tokenIndex423
 					if buffer[position] != rune('S') {
-						goto l358
+						goto l415
 					}
 					position++
 				}
-			l366:
+			l423:
 				{
-					position368, tokenIndex368 := position, tokenIndex
+					position425, tokenIndex425 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l369
+						goto l426
 					}
 					position++
-					goto l368
-				l369:
-					position, tokenIndex = position368, tokenIndex368
+					goto l425
+				l426:
+					position, tokenIndex = position425, tokenIndex425
 					if buffer[position] != rune('E') {
-						goto l358
+						goto l415
 					}
 					position++
 				}
-			l368:
+			l425:
 				if !_rules[rulesp]() {
-					goto l358
+					goto l415
 				}
 				{
-					position370, tokenIndex370 := position, tokenIndex
+					position427, tokenIndex427 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l371
+						goto l428
 					}
 					position++
-					goto l370
-				l371:
-					position, tokenIndex = position370, tokenIndex370
+					goto l427
+				l428:
+					position, tokenIndex = position427, tokenIndex427
 					if buffer[position] != rune('S') {
-						goto l358
+						goto l415
 					}
 					position++
 				}
-			l370:
+			l427:
 				{
-					position372, tokenIndex372 := position, tokenIndex
+					position429, tokenIndex429 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l373
+						goto l430
 					}
 					position++
-					goto l372
-				l373:
-					position, tokenIndex = position372, tokenIndex372
+					goto l429
+				l430:
+					position, tokenIndex = position429, tokenIndex429
 					if buffer[position] != rune('O') {
-						goto l358
+						goto l415
 					}
 					position++
 				}
-			l372:
+			l429:
 				{
-					position374, tokenIndex374 := position, tokenIndex
+					position431, tokenIndex431 := position, tokenIndex
 					if buffer[position] != rune('u') {
-						goto l375
+						goto l432
 					}
 					position++
-					goto l374
-				l375:
-					position, tokenIndex = position374, tokenIndex374
+					goto l431
+				l432:
+					position, tokenIndex = position431, tokenIndex431
 					if buffer[position] != rune('U') {
-						goto l358
+						goto l415
 					}
 					position++
 				}
-			l374:
+			l431:
 				{
-					position376, tokenIndex376 := position, tokenIndex
+					position433, tokenIndex433 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l377
+						goto l434
 					}
 					position++
-					goto l376
-				l377:
-					position, tokenIndex = position376, tokenIndex376
+					goto l433
+				l434:
+					position, tokenIndex = position433, tokenIndex433
 					if buffer[position] != rune('R') {
-						goto l358
+						goto l415
 					}
 					position++
 				}
-			l376:
+			l433:
 				{
-					position378, tokenIndex378 := position, tokenIndex
+					position435, tokenIndex435 := position, tokenIndex
 					if buffer[position] != rune('c') {
-						goto l379
+						goto l436
 					}
 					position++
-					goto l378
-				l379:
-					position, tokenIndex = position378, tokenIndex378
+					goto l435
+				l436:
+					position, tokenIndex = position435, tokenIndex435
 					if buffer[position] != rune('C') {
-						goto l358
+						goto l415
 					}
 					position++
 				}
-			l378:
+			l435:
 				{
-					position380, tokenIndex380 := position, tokenIndex
+					position437, tokenIndex437 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l381
+						goto l438
 					}
 					position++
-					goto l380
-				l381:
-					position, tokenIndex = position380, tokenIndex380
+					goto l437
+				l438:
+					position, tokenIndex = position437, tokenIndex437
 					if buffer[position] != rune('E') {
-						goto l358
+						goto l415
 					}
 					position++
 				}
-			l380:
+			l437:
 				if !_rules[rulesp]() {
-					goto l358
+					goto l415
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l358
+					goto l415
 				}
-				if !_rules[ruleAction13]() {
-					goto l358
+				if !_rules[ruleAction14]() {
+					goto l415
 				}
-				add(rulePauseSourceStmt, position359)
+				add(rulePauseSourceStmt, position416)
 			}
 			return true
-		l358:
-			position, tokenIndex = position358, tokenIndex358
+		l415:
+			position, tokenIndex = position415, tokenIndex415
 			return false
 		},
-		/* 20 ResumeSourceStmt <- <(('r' / 'R') ('e' / 'E') ('s' / 'S') ('u' / 'U') ('m' / 'M') ('e' / 'E') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action14)> */
+		/* 22 ResumeSourceStmt <- <(('r' / 'R') ('e' / 'E') ('s' / 'S') ('u' / 'U') ('m' / 'M') ('e' / 'E') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action15)> */
 		func() bool {
-			position382, tokenIndex382 := position, tokenIndex
+			position439, tokenIndex439 := position, tokenIndex
 			{
-				position383 := position
+				position440 := position
 				{
-					position384, tokenIndex384 := position, tokenIndex
+					position441, tokenIndex441 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l385
+						goto l442
 					}
 					position++
-					goto l384
-				l385:
-					position, tokenIndex = position384, tokenIndex384
+					goto l441
+				l442:
+					position, tokenIndex = position441, tokenIndex441
 					if buffer[position] != rune('R') {
-						goto l382
+						goto l439
 					}
 					position++
 				}
-			l384:
+			l441:
 				{
-					position386, tokenIndex386 := position, tokenIndex
+					position443, tokenIndex443 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l387
+						goto l444
 					}
 					position++
-					goto l386
-				l387:
-					position, tokenIndex = position386, tokenIndex386
+					goto l443
+				l444:
+					position, tokenIndex = position443, tokenIndex443
 					if buffer[position] != rune('E') {
-						goto l382
+						goto l439
 					}
 					position++
 				}
-			l386:
+			l443:
 				{
-					position388, tokenIndex388 := position, tokenIndex
+					position445, tokenIndex445 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l389
+						goto l446
 					}
 					position++
-					goto l388
-				l389:
-					position, tokenIndex = position388, tokenIndex388
+					goto l445
+				l446:
+					position, tokenIndex = position445, tokenIndex445
 					if buffer[position] != rune('S') {
-						goto l382
+						goto l439
 					}
 					position++
 				}
-			l388:
+			l445:
 				{
-					position390, tokenIndex390 := position, tokenIndex
+					position447, tokenIndex447 := position, tokenIndex
 					if buffer[position] != rune('u') {
-						goto l391
+						goto l448
 					}
 					position++
-					goto l390
-				l391:
-					position, tokenIndex = position390, tokenIndex390
+					goto l447
+				l448:
+					position, tokenIndex = position447, tokenIndex447
 					if buffer[position] != rune('U') {
-						goto l382
+						goto l439
 					}
 					position++
 				}
-			l390:
+			l447:
 				{
-					position392, tokenIndex392 := position, tokenIndex
+					position449, tokenIndex449 := position, tokenIndex
 					if buffer[position] != rune('m') {
-						goto l393
+						goto l450
 					}
 					position++
-					goto l392
-				l393:
-					position, tokenIndex = position392, tokenIndex392
+					goto l449
+				l450:
+					position, tokenIndex = position449, tokenIndex449
 					if buffer[position] != rune('M') {
-						goto l382
+						goto l439
 					}
 					position++
 				}
-			l392:
+			l449:
 				{
-					position394, tokenIndex394 := position, tokenIndex
+					position451, tokenIndex451 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l395
+						goto l452
 					}
 					position++
-					goto l394
-				l395:
-					position, tokenIndex = position394, tokenIndex394
+					goto l451
+				l452:
+					position, tokenIndex = position451, tokenIndex451
 					if buffer[position] != rune('E') {
-						goto l382
+						goto l439
 					}
 					position++
 				}
-			l394:
+			l451:
 				if !_rules[rulesp]() {
-					goto l382
+					goto l439
 				}
 				{
-					position396, tokenIndex396 := position, tokenIndex
+					position453, tokenIndex453 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l397
+						goto l454
 					}
 					position++
-					goto l396
-				l397:
-					position, tokenIndex = position396, tokenIndex396
+					goto l453
+				l454:
+					position, tokenIndex = position453, tokenIndex453
 					if buffer[position] != rune('S') {
-						goto l382
+						goto l439
 					}
 					position++
 				}
-			l396:
+			l453:
 				{
-					position398, tokenIndex398 := position, tokenIndex
+					position455, tokenIndex455 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l399
+						goto l456
 					}
 					position++
-					goto l398
-				l399:
-					position, tokenIndex = position398, tokenIndex398
+					goto l455
+				l456:
+					position, tokenIndex = position455, tokenIndex455
 					if buffer[position] != rune('O') {
-						goto l382
+						goto l439
 					}
 					position++
 				}
-			l398:
+			l455:
 				{
-					position400, tokenIndex400 := position, tokenIndex
+					position457, tokenIndex457 := position, tokenIndex
 					if buffer[position] != rune('u') {
-						goto l401
+						goto l458
 					}
 					position++
-					goto l400
-				l401:
-					position, tokenIndex = position400, tokenIndex400
+					goto l457
+				l458:
+					position, tokenIndex = position457, tokenIndex457
 					if buffer[position] != rune('U') {
-						goto l382
+						goto l439
 					}
 					position++
 				}
-			l400:
+			l457:
 				{
-					position402, tokenIndex402 := position, tokenIndex
+					position459, tokenIndex459 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l403
+						goto l460
 					}
 					position++
-					goto l402
-				l403:
-					position, tokenIndex = position402, tokenIndex402
+					goto l459
+				l460:
+					position, tokenIndex = position459, tokenIndex459
 					if buffer[position] != rune('R') {
-						goto l382
+						goto l439
 					}
 					position++
 				}
-			l402:
+			l459:
 				{
-					position404, tokenIndex404 := position, tokenIndex
+					position461, tokenIndex461 := position, tokenIndex
 					if buffer[position] != rune('c') {
-						goto l405
+						goto l462
 					}
 					position++
-					goto l404
-				l405:
-					position, tokenIndex = position404, tokenIndex404
+					goto l461
+				l462:
+					position, tokenIndex = position461, tokenIndex461
 					if buffer[position] != rune('C') {
-						goto l382
+						goto l439
 					}
 					position++
 				}
-			l404:
+			l461:
 				{
-					position406, tokenIndex406 := position, tokenIndex
+					position463, tokenIndex463 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l407
+						goto l464
 					}
 					position++
-					goto l406
-				l407:
-					position, tokenIndex = position406, tokenIndex406
+					goto l463
+				l464:
+					position, tokenIndex = position463, tokenIndex463
 					if buffer[position] != rune('E') {
-						goto l382
+						goto l439
 					}
 					position++
 				}
-			l406:
+			l463:
 				if !_rules[rulesp]() {
-					goto l382
+					goto l439
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l382
+					goto l439
 				}
-				if !_rules[ruleAction14]() {
-					goto l382
+				if !_rules[ruleAction15]() {
+					goto l439
 				}
-				add(ruleResumeSourceStmt, position383)
+				add(ruleResumeSourceStmt, position440)
 			}
 			return true
-		l382:
-			position, tokenIndex = position382, tokenIndex382
+		l439:
+			position, tokenIndex = position439, tokenIndex439
 			return false
 		},
-		/* 21 RewindSourceStmt <- <(('r' / 'R') ('e' / 'E') ('w' / 'W') ('i' / 'I') ('n' / 'N') ('d' / 'D') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action15)> */
+		/* 23 RewindSourceStmt <- <(('r' / 'R') ('e' / 'E') ('w' / 'W') ('i' / 'I') ('n' / 'N') ('d' / 'D') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action16)> */
 		func() bool {
-			position408, tokenIndex408 := position, tokenIndex
+			position465, tokenIndex465 := position, tokenIndex
 			{
-				position409 := position
+				position466 := position
 				{
-					position410, tokenIndex410 := position, tokenIndex
+					position467, tokenIndex467 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l411
+						goto l468
 					}
 					position++
-					goto l410
-				l411:
-					position, tokenIndex = position410, tokenIndex410
+					goto l467
+				l468:
+					position, tokenIndex = position467, tokenIndex467
 					if buffer[position] != rune('R') {
-						goto l408
+						goto l465
 					}
 					position++
 				}
-			l410:
+			l467:
 				{
-					position412, tokenIndex412 := position, tokenIndex
+					position469, tokenIndex469 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l413
+						goto l470
 					}
 					position++
-					goto l412
-				l413:
-					position, tokenIndex = position412, tokenIndex412
+					goto l469
+				l470:
+					position, tokenIndex = position469, tokenIndex469
 					if buffer[position] != rune('E') {
-						goto l408
+						goto l465
 					}
 					position++
 				}
-			l412:
+			l469:
 				{
-					position414, tokenIndex414 := position, tokenIndex
+					position471, tokenIndex471 := position, tokenIndex
 					if buffer[position] != rune('w') {
-						goto l415
+						goto l472
 					}
 					position++
-					goto l414
-				l415:
-					position, tokenIndex = position414, tokenIndex414
+					goto l471
+				l472:
+					position, tokenIndex = position471, tokenIndex471
 					if buffer[position] != rune('W') {
-						goto l408
+						goto l465
 					}
 					position++
 				}
-			l414:
+			l471:
 				{
-					position416, tokenIndex416 := position, tokenIndex
+					position473, tokenIndex473 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l417
+						goto l474
 					}
 					position++
-					goto l416
-				l417:
-					position, tokenIndex = position416, tokenIndex416
+					goto l473
+				l474:
+					position, tokenIndex = position473, tokenIndex473
 					if buffer[position] != rune('I') {
-						goto l408
+						goto l465
 					}
 					position++
 				}
-			l416:
+			l473:
 				{
-					position418, tokenIndex418 := position, tokenIndex
+					position475, tokenIndex475 := position, tokenIndex
 					if buffer[position] != rune('n') {
-						goto l419
+						goto l476
 					}
 					position++
-					goto l418
-				l419:
-					position, tokenIndex = position418, tokenIndex418
+					goto l475
+				l476:
+					position, tokenIndex = position475, tokenIndex475
 					if buffer[position] != rune('N') {
-						goto l408
+						goto l465
 					}
 					position++
 				}
-			l418:
+			l475:
 				{
-					position420, tokenIndex420 := position, tokenIndex
+					position477, tokenIndex477 := position, tokenIndex
 					if buffer[position] != rune('d') {
-						goto l421
+						goto l478
 					}
 					position++
-					goto l420
-				l421:
-					position, tokenIndex = position420, tokenIndex420
+					goto l477
+				l478:
+					position, tokenIndex = position477, tokenIndex477
 					if buffer[position] != rune('D') {
-						goto l408
+						goto l465
 					}
 					position++
 				}
-			l420:
+			l477:
 				if !_rules[rulesp]() {
-					goto l408
+					goto l465
 				}
 				{
-					position422, tokenIndex422 := position, tokenIndex
+					position479, tokenIndex479 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l423
+						goto l480
 					}
 					position++
-					goto l422
-				l423:
-					position, tokenIndex = position422, tokenIndex422
+					goto l479
+				l480:
+					position, tokenIndex = position479, tokenIndex479
 					if buffer[position] != rune('S') {
-						goto l408
+						goto l465
 					}
 					position++
 				}
-			l422:
+			l479:
 				{
-					position424, tokenIndex424 := position, tokenIndex
+					position481, tokenIndex481 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l425
+						goto l482
 					}
 					position++
-					goto l424
-				l425:
-					position, tokenIndex = position424, tokenIndex424
+					goto l481
+				l482:
+					position, tokenIndex = position481, tokenIndex481
 					if buffer[position] != rune('O') {
-						goto l408
+						goto l465
 					}
 					position++
 				}
-			l424:
+			l481:
 				{
-					position426, tokenIndex426 := position, tokenIndex
+					position483, tokenIndex483 := position, tokenIndex
 					if buffer[position] != rune('u') {
-						goto l427
+						goto l484
 					}
 					position++
-					goto l426
-				l427:
-					position, tokenIndex = position426, tokenIndex426
+					goto l483
+				l484:
+					position, tokenIndex = position483, tokenIndex483
 					if buffer[position] != rune('U') {
-						goto l408
+						goto l465
 					}
 					position++
 				}
-			l426:
+			l483:
 				{
-					position428, tokenIndex428 := position, tokenIndex
+					position485, tokenIndex485 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l429
+						goto l486
 					}
 					position++
-					goto l428
-				l429:
-					position, tokenIndex = position428, tokenIndex428
+					goto l485
+				l486:
+					position, tokenIndex = position485, tokenIndex485
 					if buffer[position] != rune('R') {
-						goto l408
+						goto l465
 					}
 					position++
 				}
-			l428:
+			l485:
 				{
-					position430, tokenIndex430 := position, tokenIndex
+					position487, tokenIndex487 := position, tokenIndex
 					if buffer[position] != rune('c') {
-						goto l431
+						goto l488
 					}
 					position++
-					goto l430
-				l431:
-					position, tokenIndex = position430, tokenIndex430
+					goto l487
+				l488:
+					position, tokenIndex = position487, tokenIndex487
 					if buffer[position] != rune('C') {
-						goto l408
+						goto l465
 					}
 					position++
 				}
-			l430:
+			l487:
 				{
-					position432, tokenIndex432 := position, tokenIndex
+					position489, tokenIndex489 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l433
+						goto l490
 					}
 					position++
-					goto l432
-				l433:
-					position, tokenIndex = position432, tokenIndex432
+					goto l489
+				l490:
+					position, tokenIndex = position489, tokenIndex489
 					if buffer[position] != rune('E') {
-						goto l408
+						goto l465
 					}
 					position++
 				}
-			l432:
+			l489:
 				if !_rules[rulesp]() {
-					goto l408
+					goto l465
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l408
+					goto l465
 				}
-				if !_rules[ruleAction15]() {
-					goto l408
+				if !_rules[ruleAction16]() {
+					goto l465
 				}
-				add(ruleRewindSourceStmt, position409)
+				add(ruleRewindSourceStmt, position466)
 			}
 			return true
-		l408:
-			position, tokenIndex = position408, tokenIndex408
+		l465:
+			position, tokenIndex = position465, tokenIndex465
 			return false
 		},
-		/* 22 DropSourceStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action16)> */
+		/* 24 DropSourceStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E')) sp StreamIdentifier Action17)> */
 		func() bool {
-			position434, tokenIndex434 := position, tokenIndex
+			position491, tokenIndex491 := position, tokenIndex
 			{
-				position435 := position
+				position492 := position
 				{
-					position436, tokenIndex436 := position, tokenIndex
+					position493, tokenIndex493 := position, tokenIndex
 					if buffer[position] != rune('d') {
-						goto l437
+						goto l494
 					}
 					position++
-					goto l436
-				l437:
-					position, tokenIndex = position436, tokenIndex436
+					goto l493
+				l494:
+					position, tokenIndex = position493, tokenIndex493
 					if buffer[position] != rune('D') {
-						goto l434
+						goto l491
 					}
 					position++
 				}
-			l436:
+			l493:
 				{
-					position438, tokenIndex438 := position, tokenIndex
+					position495, tokenIndex495 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l439
+						goto l496
 					}
 					position++
-					goto l438
-				l439:
-					position, tokenIndex = position438, tokenIndex438
+					goto l495
+				l496:
+					position, tokenIndex = position495, tokenIndex495
 					if buffer[position] != rune('R') {
-						goto l434
+						goto l491
 					}
 					position++
 				}
-			l438:
+			l495:
 				{
-					position440, tokenIndex440 := position, tokenIndex
+					position497, tokenIndex497 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l441
+						goto l498
 					}
 					position++
-					goto l440
-				l441:
-					position, tokenIndex = position440, tokenIndex440
+					goto l497
+				l498:
+					position, tokenIndex = position497, tokenIndex497
 					if buffer[position] != rune('O') {
-						goto l434
+						goto l491
 					}
 					position++
 				}
-			l440:
+			l497:
 				{
-					position442, tokenIndex442 := position, tokenIndex
+					position499, tokenIndex499 := position, tokenIndex
 					if buffer[position] != rune('p') {
-						goto l443
+						goto l500
 					}
 					position++
-					goto l442
-				l443:
-					position, tokenIndex = position442, tokenIndex442
+					goto l499
+				l500:
+					position, tokenIndex = position499, tokenIndex499
 					if buffer[position] != rune('P') {
-						goto l434
+						goto l491
 					}
 					position++
 				}
-			l442:
+			l499:
 				if !_rules[rulesp]() {
-					goto l434
+					goto l491
 				}
 				{
-					position444, tokenIndex444 := position, tokenIndex
+					position501, tokenIndex501 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l445
+						goto l502
 					}
 					position++
-					goto l444
-				l445:
-					position, tokenIndex = position444, tokenIndex444
+					goto l501
+				l502:
+					position, tokenIndex = position501, tokenIndex501
 					if buffer[position] != rune('S') {
-						goto l434
+						goto l491
 					}
 					position++
 				}
-			l444:
+			l501:
 				{
-					position446, tokenIndex446 := position, tokenIndex
+					position503, tokenIndex503 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l447
+						goto l504
 					}
 					position++
-					goto l446
-				l447:
-					position, tokenIndex = position446, tokenIndex446
+					goto l503
+				l504:
+					position, tokenIndex = position503, tokenIndex503
 					if buffer[position] != rune('O') {
-						goto l434
+						goto l491
 					}
 					position++
 				}
-			l446:
+			l503:
 				{
-					position448, tokenIndex448 := position, tokenIndex
+					position505, tokenIndex505 := position, tokenIndex
 					if buffer[position] != rune('u') {
-						goto l449
+						goto l506
 					}
 					position++
-					goto l448
-				l449:
-					position, tokenIndex = position448, tokenIndex448
+					goto l505
+				l506:
+					position, tokenIndex = position505, tokenIndex505
 					if buffer[position] != rune('U') {
-						goto l434
+						goto l491
 					}
 					position++
 				}
-			l448:
+			l505:
 				{
-					position450, tokenIndex450 := position, tokenIndex
+					position507, tokenIndex507 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l451
+						goto l508
 					}
 					position++
-					goto l450
-				l451:
-					position, tokenIndex = position450, tokenIndex450
+					goto l507
+				l508:
+					position, tokenIndex = position507, tokenIndex507
 					if buffer[position] != rune('R') {
-						goto l434
+						goto l491
 					}
 					position++
 				}
-			l450:
+			l507:
 				{
-					position452, tokenIndex452 := position, tokenIndex
+					position509, tokenIndex509 := position, tokenIndex
 					if buffer[position] != rune('c') {
-						goto l453
+						goto l510
 					}
 					position++
-					goto l452
-				l453:
-					position, tokenIndex = position452, tokenIndex452
+					goto l509
+				l510:
+					position, tokenIndex = position509, tokenIndex509
 					if buffer[position] != rune('C') {
-						goto l434
+						goto l491
 					}
 					position++
 				}
-			l452:
+			l509:
 				{
-					position454, tokenIndex454 := position, tokenIndex
+					position511, tokenIndex511 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l455
+						goto l512
 					}
 					position++
-					goto l454
-				l455:
-					position, tokenIndex = position454, tokenIndex454
+					goto l511
+				l512:
+					position, tokenIndex = position511, tokenIndex511
 					if buffer[position] != rune('E') {
-						goto l434
+						goto l491
 					}
 					position++
 				}
-			l454:
+			l511:
 				if !_rules[rulesp]() {
-					goto l434
+					goto l491
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l434
+					goto l491
 				}
-				if !_rules[ruleAction16]() {
-					goto l434
+				if !_rules[ruleAction17]() {
+					goto l491
 				}
-				add(ruleDropSourceStmt, position435)
+				add(ruleDropSourceStmt, position492)
 			}
 			return true
-		l434:
-			position, tokenIndex = position434, tokenIndex434
+		l491:
+			position, tokenIndex = position491, tokenIndex491
 			return false
 		},
-		/* 23 DropStreamStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier Action17)> */
+		/* 25 DropStreamStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M')) sp StreamIdentifier Action18)> */
 		func() bool {
-			position456, tokenIndex456 := position, tokenIndex
+			position513, tokenIndex513 := position, tokenIndex
 			{
-				position457 := position
+				position514 := position
 				{
-					position458, tokenIndex458 := position, tokenIndex
+					position515, tokenIndex515 := position, tokenIndex
 					if buffer[position] != rune('d') {
-						goto l459
+						goto l516
 					}
 					position++
-					goto l458
-				l459:
-					position, tokenIndex = position458, tokenIndex458
+					goto l515
+				l516:
+					position, tokenIndex = position515, tokenIndex515
 					if buffer[position] != rune('D') {
-						goto l456
+						goto l513
 					}
 					position++
 				}
-			l458:
+			l515:
 				{
-					position460, tokenIndex460 := position, tokenIndex
+					position517, tokenIndex517 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l461
+						goto l518
 					}
 					position++
-					goto l460
-				l461:
-					position, tokenIndex = position460, tokenIndex460
+					goto l517
+				l518:
+					position, tokenIndex = position517, tokenIndex517
 					if buffer[position] != rune('R') {
-						goto l456
+						goto l513
 					}
 					position++
 				}
-			l460:
+			l517:
 				{
-					position462, tokenIndex462 := position, tokenIndex
+					position519, tokenIndex519 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l463
+						goto l520
 					}
 					position++
-					goto l462
-				l463:
-					position, tokenIndex = position462, tokenIndex462
+					goto l519
+				l520:
+					position, tokenIndex = position519, tokenIndex519
 					if buffer[position] != rune('O') {
-						goto l456
+						goto l513
 					}
 					position++
 				}
-			l462:
+			l519:
 				{
-					position464, tokenIndex464 := position, tokenIndex
+					position521, tokenIndex521 := position, tokenIndex
 					if buffer[position] != rune('p') {
-						goto l465
+						goto l522
 					}
 					position++
-					goto l464
-				l465:
-					position, tokenIndex = position464, tokenIndex464
+					goto l521
+				l522:
+					position, tokenIndex = position521, tokenIndex521
 					if buffer[position] != rune('P') {
-						goto l456
+						goto l513
 					}
 					position++
 				}
-			l464:
+			l521:
 				if !_rules[rulesp]() {
-					goto l456
+					goto l513
 				}
 				{
-					position466, tokenIndex466 := position, tokenIndex
+					position523, tokenIndex523 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l467
+						goto l524
 					}
 					position++
-					goto l466
-				l467:
-					position, tokenIndex = position466, tokenIndex466
+					goto l523
+				l524:
+					position, tokenIndex = position523, tokenIndex523
 					if buffer[position] != rune('S') {
-						goto l456
+						goto l513
 					}
 					position++
 				}
-			l466:
+			l523:
 				{
-					position468, tokenIndex468 := position, tokenIndex
+					position525, tokenIndex525 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l469
+						goto l526
 					}
 					position++
-					goto l468
-				l469:
-					position, tokenIndex = position468, tokenIndex468
+					goto l525
+				l526:
+					position, tokenIndex = position525, tokenIndex525
 					if buffer[position] != rune('T') {
-						goto l456
+						goto l513
 					}
 					position++
 				}
-			l468:
+			l525:
 				{
-					position470, tokenIndex470 := position, tokenIndex
+					position527, tokenIndex527 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l471
+						goto l528
 					}
 					position++
-					goto l470
-				l471:
-					position, tokenIndex = position470, tokenIndex470
+					goto l527
+				l528:
+					position, tokenIndex = position527, tokenIndex527
 					if buffer[position] != rune('R') {
-						goto l456
+						goto l513
 					}
 					position++
 				}
-			l470:
+			l527:
 				{
-					position472, tokenIndex472 := position, tokenIndex
+					position529, tokenIndex529 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l473
+						goto l530
 					}
 					position++
-					goto l472
-				l473:
-					position, tokenIndex = position472, tokenIndex472
+					goto l529
+				l530:
+					position, tokenIndex = position529, tokenIndex529
 					if buffer[position] != rune('E') {
-						goto l456
+						goto l513
 					}
 					position++
 				}
-			l472:
+			l529:
 				{
-					position474, tokenIndex474 := position, tokenIndex
+					position531, tokenIndex531 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l475
+						goto l532
 					}
 					position++
-					goto l474
-				l475:
-					position, tokenIndex = position474, tokenIndex474
+					goto l531
+				l532:
+					position, tokenIndex = position531, tokenIndex531
 					if buffer[position] != rune('A') {
-						goto l456
+						goto l513
 					}
 					position++
 				}
-			l474:
+			l531:
 				{
-					position476, tokenIndex476 := position, tokenIndex
+					position533, tokenIndex533 := position, tokenIndex
 					if buffer[position] != rune('m') {
-						goto l477
+						goto l534
 					}
 					position++
-					goto l476
-				l477:
-					position, tokenIndex = position476, tokenIndex476
+					goto l533
+				l534:
+					position, tokenIndex = position533, tokenIndex533
 					if buffer[position] != rune('M') {
-						goto l456
+						goto l513
 					}
 					position++
 				}
-			l476:
+			l533:
 				if !_rules[rulesp]() {
-					goto l456
+					goto l513
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l456
+					goto l513
 				}
-				if !_rules[ruleAction17]() {
-					goto l456
+				if !_rules[ruleAction18]() {
+					goto l513
 				}
-				add(ruleDropStreamStmt, position457)
+				add(ruleDropStreamStmt, position514)
 			}
 			return true
-		l456:
-			position, tokenIndex = position456, tokenIndex456
+		l513:
+			position, tokenIndex = position513, tokenIndex513
 			return false
 		},
-		/* 24 DropSinkStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K')) sp StreamIdentifier Action18)> */
+		/* 26 DropSinkStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K')) sp StreamIdentifier Action19)> */
 		func() bool {
-			position478, tokenIndex478 := position, tokenIndex
+			position535, tokenIndex535 := position, tokenIndex
 			{
-				position479 := position
+				position536 := position
 				{
-					position480, tokenIndex480 := position, tokenIndex
+					position537, tokenIndex537 := position, tokenIndex
 					if buffer[position] != rune('d') {
-						goto l481
+						goto l538
 					}
 					position++
-					goto l480
-				l481:
-					position, tokenIndex = position480, tokenIndex480
+					goto l537
+				l538:
+					position, tokenIndex = position537, tokenIndex537
 					if buffer[position] != rune('D') {
-						goto l478
+						goto l535
 					}
 					position++
 				}
-			l480:
+			l537:
 				{
-					position482, tokenIndex482 := position, tokenIndex
+					position539, tokenIndex539 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l483
+						goto l540
 					}
 					position++
-					goto l482
-				l483:
-					position, tokenIndex = position482, tokenIndex482
+					goto l539
+				l540:
+					position, tokenIndex = position539, tokenIndex539
 					if buffer[position] != rune('R') {
-						goto l478
+						goto l535
 					}
 					position++
 				}
-			l482:
+			l539:
 				{
-					position484, tokenIndex484 := position, tokenIndex
+					position541, tokenIndex541 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l485
+						goto l542
 					}
 					position++
-					goto l484
-				l485:
-					position, tokenIndex = position484, tokenIndex484
+					goto l541
+				l542:
+					position, tokenIndex = position541, tokenIndex541
 					if buffer[position] != rune('O') {
-						goto l478
+						goto l535
 					}
 					position++
 				}
-			l484:
+			l541:
 				{
-					position486, tokenIndex486 := position, tokenIndex
+					position543, tokenIndex543 := position, tokenIndex
 					if buffer[position] != rune('p') {
-						goto l487
+						goto l544
 					}
 					position++
-					goto l486
-				l487:
-					position, tokenIndex = position486, tokenIndex486
+					goto l543
+				l544:
+					position, tokenIndex = position543, tokenIndex543
 					if buffer[position] != rune('P') {
-						goto l478
+						goto l535
 					}
 					position++
 				}
-			l486:
+			l543:
 				if !_rules[rulesp]() {
-					goto l478
+					goto l535
 				}
 				{
-					position488, tokenIndex488 := position, tokenIndex
+					position545, tokenIndex545 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l489
+						goto l546
 					}
 					position++
-					goto l488
-				l489:
-					position, tokenIndex = position488, tokenIndex488
+					goto l545
+				l546:
+					position, tokenIndex = position545, tokenIndex545
 					if buffer[position] != rune('S') {
-						goto l478
+						goto l535
 					}
 					position++
 				}
-			l488:
+			l545:
 				{
-					position490, tokenIndex490 := position, tokenIndex
+					position547, tokenIndex547 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l491
+						goto l548
 					}
 					position++
-					goto l490
-				l491:
-					position, tokenIndex = position490, tokenIndex490
+					goto l547
+				l548:
+					position, tokenIndex = position547, tokenIndex547
 					if buffer[position] != rune('I') {
-						goto l478
+						goto l535
 					}
 					position++
 				}
-			l490:
+			l547:
 				{
-					position492, tokenIndex492 := position, tokenIndex
+					position549, tokenIndex549 := position, tokenIndex
 					if buffer[position] != rune('n') {
-						goto l493
+						goto l550
 					}
 					position++
-					goto l492
-				l493:
-					position, tokenIndex = position492, tokenIndex492
+					goto l549
+				l550:
+					position, tokenIndex = position549, tokenIndex549
 					if buffer[position] != rune('N') {
-						goto l478
+						goto l535
 					}
 					position++
 				}
-			l492:
+			l549:
 				{
-					position494, tokenIndex494 := position, tokenIndex
+					position551, tokenIndex551 := position, tokenIndex
 					if buffer[position] != rune('k') {
-						goto l495
+						goto l552
 					}
 					position++
-					goto l494
-				l495:
-					position, tokenIndex = position494, tokenIndex494
+					goto l551
+				l552:
+					position, tokenIndex = position551, tokenIndex551
 					if buffer[position] != rune('K') {
-						goto l478
+						goto l535
 					}
 					position++
 				}
-			l494:
+			l551:
 				if !_rules[rulesp]() {
-					goto l478
+					goto l535
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l478
+					goto l535
 				}
-				if !_rules[ruleAction18]() {
-					goto l478
+				if !_rules[ruleAction19]() {
+					goto l535
 				}
-				add(ruleDropSinkStmt, position479)
+				add(ruleDropSinkStmt, position536)
 			}
 			return true
-		l478:
-			position, tokenIndex = position478, tokenIndex478
+		l535:
+			position, tokenIndex = position535, tokenIndex535
 			return false
 		},
-		/* 25 DropStateStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier Action19)> */
+		/* 27 DropStateStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier Action20)> */
 		func() bool {
-			position496, tokenIndex496 := position, tokenIndex
+			position553, tokenIndex553 := position, tokenIndex
 			{
-				position497 := position
+				position554 := position
 				{
-					position498, tokenIndex498 := position, tokenIndex
+					position555, tokenIndex555 := position, tokenIndex
 					if buffer[position] != rune('d') {
-						goto l499
+						goto l556
 					}
 					position++
-					goto l498
-				l499:
-					position, tokenIndex = position498, tokenIndex498
+					goto l555
+				l556:
+					position, tokenIndex = position555, tokenIndex555
 					if buffer[position] != rune('D') {
-						goto l496
+						goto l553
 					}
 					position++
 				}
-			l498:
+			l555:
 				{
-					position500, tokenIndex500 := position, tokenIndex
+					position557, tokenIndex557 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l501
+						goto l558
 					}
 					position++
-					goto l500
-				l501:
-					position, tokenIndex = position500, tokenIndex500
+					goto l557
+				l558:
+					position, tokenIndex = position557, tokenIndex557
 					if buffer[position] != rune('R') {
-						goto l496
+						goto l553
 					}
 					position++
 				}
-			l500:
+			l557:
 				{
-					position502, tokenIndex502 := position, tokenIndex
+					position559, tokenIndex559 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l503
+						goto l560
 					}
 					position++
-					goto l502
-				l503:
-					position, tokenIndex = position502, tokenIndex502
+					goto l559
+				l560:
+					position, tokenIndex = position559, tokenIndex559
 					if buffer[position] != rune('O') {
-						goto l496
+						goto l553
 					}
 					position++
 				}
-			l502:
+			l559:
 				{
-					position504, tokenIndex504 := position, tokenIndex
+					position561, tokenIndex561 := position, tokenIndex
 					if buffer[position] != rune('p') {
-						goto l505
+						goto l562
 					}
 					position++
-					goto l504
-				l505:
-					position, tokenIndex = position504, tokenIndex504
+					goto l561
+				l562:
+					position, tokenIndex = position561, tokenIndex561
 					if buffer[position] != rune('P') {
-						goto l496
+						goto l553
 					}
 					position++
 				}
-			l504:
+			l561:
 				if !_rules[rulesp]() {
-					goto l496
+					goto l553
 				}
 				{
-					position506, tokenIndex506 := position, tokenIndex
+					position563, tokenIndex563 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l507
+						goto l564
 					}
 					position++
-					goto l506
-				l507:
-					position, tokenIndex = position506, tokenIndex506
+					goto l563
+				l564:
+					position, tokenIndex = position563, tokenIndex563
 					if buffer[position] != rune('S') {
-						goto l496
+						goto l553
 					}
 					position++
 				}
-			l506:
+			l563:
 				{
-					position508, tokenIndex508 := position, tokenIndex
+					position565, tokenIndex565 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l509
+						goto l566
 					}
 					position++
-					goto l508
-				l509:
-					position, tokenIndex = position508, tokenIndex508
+					goto l565
+				l566:
+					position, tokenIndex = position565, tokenIndex565
 					if buffer[position] != rune('T') {
-						goto l496
+						goto l553
 					}
 					position++
 				}
-			l508:
+			l565:
 				{
-					position510, tokenIndex510 := position, tokenIndex
+					position567, tokenIndex567 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l511
+						goto l568
 					}
 					position++
-					goto l510
-				l511:
-					position, tokenIndex = position510, tokenIndex510
+					goto l567
+				l568:
+					position, tokenIndex = position567, tokenIndex567
 					if buffer[position] != rune('A') {
-						goto l496
+						goto l553
 					}
 					position++
 				}
-			l510:
+			l567:
 				{
-					position512, tokenIndex512 := position, tokenIndex
+					position569, tokenIndex569 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l513
+						goto l570
 					}
 					position++
-					goto l512
-				l513:
-					position, tokenIndex = position512, tokenIndex512
+					goto l569
+				l570:
+					position, tokenIndex = position569, tokenIndex569
 					if buffer[position] != rune('T') {
-						goto l496
+						goto l553
 					}
 					position++
 				}
-			l512:
+			l569:
 				{
-					position514, tokenIndex514 := position, tokenIndex
+					position571, tokenIndex571 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l515
+						goto l572
 					}
 					position++
-					goto l514
-				l515:
-					position, tokenIndex = position514, tokenIndex514
+					goto l571
+				l572:
+					position, tokenIndex = position571, tokenIndex571
 					if buffer[position] != rune('E') {
-						goto l496
+						goto l553
 					}
 					position++
 				}
-			l514:
+			l571:
 				if !_rules[rulesp]() {
-					goto l496
+					goto l553
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l496
+					goto l553
 				}
-				if !_rules[ruleAction19]() {
-					goto l496
+				if !_rules[ruleAction20]() {
+					goto l553
 				}
-				add(ruleDropStateStmt, position497)
+				add(ruleDropStateStmt, position554)
 			}
 			return true
-		l496:
-			position, tokenIndex = position496, tokenIndex496
+		l553:
+			position, tokenIndex = position553, tokenIndex553
 			return false
 		},
-		/* 26 LoadStateStmt <- <(('l' / 'L') ('o' / 'O') ('a' / 'A') ('d' / 'D') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType StateTagOpt SetOptSpecs Action20)> */
+		/* 28 DropFunctionStmt <- <(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('f' / 'F') ('u' / 'U') ('n' / 'N') ('c' / 'C') ('t' / 'T') ('i' / 'I') ('o' / 'O') ('n' / 'N')) sp Function Action21)> */
 		func() bool {
-			position516, tokenIndex516 := position, tokenIndex
+			position573, tokenIndex573 := position, tokenIndex
 			{
-				position517 := position
+				position574 := position
 				{
-					position518, tokenIndex518 := position, tokenIndex
-					if buffer[position] != rune('l') {
-						goto l519
+					position575, tokenIndex575 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l576
 					}
 					position++
-					goto l518
-				l519:
-					position, tokenIndex = position518, tokenIndex518
-					if buffer[position] != rune('L') {
-						goto l516
+					goto l575
+				l576:
+					position, tokenIndex = position575, tokenIndex575
+					if buffer[position] != rune('D') {
+						goto l573
 					}
 					position++
 				}
-			l518:
+			l575:
 				{
-					position520, tokenIndex520 := position, tokenIndex
-					if buffer[position] != rune('o') {
-						goto l521
+					position577, tokenIndex577 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l578
 					}
 					position++
-					goto l520
-				l521:
-					position, tokenIndex = position520, tokenIndex520
-					if buffer[position] != rune('O') {
-						goto l516
+					goto l577
+				l578:
+					position, tokenIndex = position577, tokenIndex577
+					if buffer[position] != rune('R') {
+						goto l573
 					}
 					position++
 				}
-			l520:
+			l577:
 				{
-					position522, tokenIndex522 := position, tokenIndex
-					if buffer[position] != rune('a') {
-						goto l523
+					position579, tokenIndex579 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l580
 					}
 					position++
-					goto l522
-				l523:
-					position, tokenIndex = position522, tokenIndex522
-					if buffer[position] != rune('A') {
-						goto l516
+					goto l579
+				l580:
+					position, tokenIndex = position579, tokenIndex579
+					if buffer[position] != rune('O') {
+						goto l573
 					}
 					position++
 				}
-			l522:
+			l579:
 				{
-					position524, tokenIndex524 := position, tokenIndex
-					if buffer[position] != rune('d') {
-						goto l525
+					position581, tokenIndex581 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l582
 					}
 					position++
-					goto l524
-				l525:
-					position, tokenIndex = position524, tokenIndex524
-					if buffer[position] != rune('D') {
-						goto l516
+					goto l581
+				l582:
+					position, tokenIndex = position581, tokenIndex581
+					if buffer[position] != rune('P') {
+						goto l573
+					}
+					position++
+				}
+			l581:
+				if !_rules[rulesp]() {
+					goto l573
+				}
+				{
+					position583, tokenIndex583 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l584
+					}
+					position++
+					goto l583
+				l584:
+					position, tokenIndex = position583, tokenIndex583
+					if buffer[position] != rune('F') {
+						goto l573
+					}
+					position++
+				}
+			l583:
+				{
+					position585, tokenIndex585 := position, tokenIndex
+					if buffer[position] != rune('u') {
+						goto l586
+					}
+					position++
+					goto l585
+				l586:
+					position, tokenIndex = position585, tokenIndex585
+					if buffer[position] != rune('U') {
+						goto l573
+					}
+					position++
+				}
+			l585:
+				{
+					position587, tokenIndex587 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l588
+					}
+					position++
+					goto l587
+				l588:
+					position, tokenIndex = position587, tokenIndex587
+					if buffer[position] != rune('N') {
+						goto l573
+					}
+					position++
+				}
+			l587:
+				{
+					position589, tokenIndex589 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l590
+					}
+					position++
+					goto l589
+				l590:
+					position, tokenIndex = position589, tokenIndex589
+					if buffer[position] != rune('C') {
+						goto l573
+					}
+					position++
+				}
+			l589:
+				{
+					position591, tokenIndex591 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l592
+					}
+					position++
+					goto l591
+				l592:
+					position, tokenIndex = position591, tokenIndex591
+					if buffer[position] != rune('T') {
+						goto l573
+					}
+					position++
+				}
+			l591:
+				{
+					position593, tokenIndex593 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l594
+					}
+					position++
+					goto l593
+				l594:
+					position, tokenIndex = position593, tokenIndex593
+					if buffer[position] != rune('I') {
+						goto l573
+					}
+					position++
+				}
+			l593:
+				{
+					position595, tokenIndex595 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l596
+					}
+					position++
+					goto l595
+				l596:
+					position, tokenIndex = position595, tokenIndex595
+					if buffer[position] != rune('O') {
+						goto l573
+					}
+					position++
+				}
+			l595:
+				{
+					position597, tokenIndex597 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l598
+					}
+					position++
+					goto l597
+				l598:
+					position, tokenIndex = position597, tokenIndex597
+					if buffer[position] != rune('N') {
+						goto l573
+					}
+					position++
+				}
+			l597:
+				if !_rules[rulesp]() {
+					goto l573
+				}
+				if !_rules[ruleFunction]() {
+					goto l573
+				}
+				if !_rules[ruleAction21]() {
+					goto l573
+				}
+				add(ruleDropFunctionStmt, position574)
+			}
+			return true
+		l573:
+			position, tokenIndex = position573, tokenIndex573
+			return false
+		},
+		/* 29 LoadStateStmt <- <(('l' / 'L') ('o' / 'O') ('a' / 'A') ('d' / 'D') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E')) sp SourceSinkType StateTagOpt SetOptSpecs Action22)> */
+		func() bool {
+			position599, tokenIndex599 := position, tokenIndex
+			{
+				position600 := position
+				{
+					position601, tokenIndex601 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l602
+					}
+					position++
+					goto l601
+				l602:
+					position, tokenIndex = position601, tokenIndex601
+					if buffer[position] != rune('L') {
+						goto l599
+					}
+					position++
+				}
+			l601:
+				{
+					position603, tokenIndex603 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l604
+					}
+					position++
+					goto l603
+				l604:
+					position, tokenIndex = position603, tokenIndex603
+					if buffer[position] != rune('O') {
+						goto l599
+					}
+					position++
+				}
+			l603:
+				{
+					position605, tokenIndex605 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l606
+					}
+					position++
+					goto l605
+				l606:
+					position, tokenIndex = position605, tokenIndex605
+					if buffer[position] != rune('A') {
+						goto l599
+					}
+					position++
+				}
+			l605:
+				{
+					position607, tokenIndex607 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l608
+					}
+					position++
+					goto l607
+				l608:
+					position, tokenIndex = position607, tokenIndex607
+					if buffer[position] != rune('D') {
+						goto l599
 					}
 					position++
 				}
-			l524:
+			l607:
 				if !_rules[rulesp]() {
-					goto l516
+					goto l599
 				}
 				{
-					position526, tokenIndex526 := position, tokenIndex
+					position609, tokenIndex609 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l527
+						goto l610
 					}
 					position++
-					goto l526
-				l527:
-					position, tokenIndex = position526, tokenIndex526
+					goto l609
+				l610:
+					position, tokenIndex = position609, tokenIndex609
 					if buffer[position] != rune('S') {
-						goto l516
+						goto l599
 					}
 					position++
 				}
-			l526:
+			l609:
 				{
-					position528, tokenIndex528 := position, tokenIndex
+					position611, tokenIndex611 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l529
+						goto l612
 					}
 					position++
-					goto l528
-				l529:
-					position, tokenIndex = position528, tokenIndex528
+					goto l611
+				l612:
+					position, tokenIndex = position611, tokenIndex611
 					if buffer[position] != rune('T') {
-						goto l516
+						goto l599
 					}
 					position++
 				}
-			l528:
+			l611:
 				{
-					position530, tokenIndex530 := position, tokenIndex
+					position613, tokenIndex613 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l531
+						goto l614
 					}
 					position++
-					goto l530
-				l531:
-					position, tokenIndex = position530, tokenIndex530
+					goto l613
+				l614:
+					position, tokenIndex = position613, tokenIndex613
 					if buffer[position] != rune('A') {
-						goto l516
+						goto l599
 					}
 					position++
 				}
-			l530:
+			l613:
 				{
-					position532, tokenIndex532 := position, tokenIndex
+					position615, tokenIndex615 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l533
+						goto l616
 					}
 					position++
-					goto l532
-				l533:
-					position, tokenIndex = position532, tokenIndex532
+					goto l615
+				l616:
+					position, tokenIndex = position615, tokenIndex615
 					if buffer[position] != rune('T') {
-						goto l516
+						goto l599
 					}
 					position++
 				}
-			l532:
+			l615:
 				{
-					position534, tokenIndex534 := position, tokenIndex
+					position617, tokenIndex617 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l535
+						goto l618
 					}
 					position++
-					goto l534
-				l535:
-					position, tokenIndex = position534, tokenIndex534
+					goto l617
+				l618:
+					position, tokenIndex = position617, tokenIndex617
 					if buffer[position] != rune('E') {
-						goto l516
+						goto l599
 					}
 					position++
 				}
-			l534:
+			l617:
 				if !_rules[rulesp]() {
-					goto l516
+					goto l599
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l516
+					goto l599
 				}
 				if !_rules[rulesp]() {
-					goto l516
+					goto l599
 				}
 				{
-					position536, tokenIndex536 := position, tokenIndex
+					position619, tokenIndex619 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l537
+						goto l620
 					}
 					position++
-					goto l536
-				l537:
-					position, tokenIndex = position536, tokenIndex536
+					goto l619
+				l620:
+					position, tokenIndex = position619, tokenIndex619
 					if buffer[position] != rune('T') {
-						goto l516
+						goto l599
 					}
 					position++
 				}
-			l536:
+			l619:
 				{
-					position538, tokenIndex538 := position, tokenIndex
+					position621, tokenIndex621 := position, tokenIndex
 					if buffer[position] != rune('y') {
-						goto l539
+						goto l622
 					}
 					position++
-					goto l538
-				l539:
-					position, tokenIndex = position538, tokenIndex538
+					goto l621
+				l622:
+					position, tokenIndex = position621, tokenIndex621
 					if buffer[position] != rune('Y') {
-						goto l516
+						goto l599
 					}
 					position++
 				}
-			l538:
+			l621:
 				{
-					position540, tokenIndex540 := position, tokenIndex
+					position623, tokenIndex623 := position, tokenIndex
 					if buffer[position] != rune('p') {
-						goto l541
+						goto l624
 					}
 					position++
-					goto l540
-				l541:
-					position, tokenIndex = position540, tokenIndex540
+					goto l623
+				l624:
+					position, tokenIndex = position623, tokenIndex623
 					if buffer[position] != rune('P') {
-						goto l516
+						goto l599
 					}
 					position++
 				}
-			l540:
+			l623:
 				{
-					position542, tokenIndex542 := position, tokenIndex
+					position625, tokenIndex625 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l543
+						goto l626
 					}
 					position++
-					goto l542
-				l543:
-					position, tokenIndex = position542, tokenIndex542
+					goto l625
+				l626:
+					position, tokenIndex = position625, tokenIndex625
 					if buffer[position] != rune('E') {
-						goto l516
+						goto l599
 					}
 					position++
 				}
-			l542:
+			l625:
 				if !_rules[rulesp]() {
-					goto l516
+					goto l599
 				}
 				if !_rules[ruleSourceSinkType]() {
-					goto l516
+					goto l599
 				}
 				if !_rules[ruleStateTagOpt]() {
-					goto l516
+					goto l599
 				}
 				if !_rules[ruleSetOptSpecs]() {
-					goto l516
+					goto l599
 				}
-				if !_rules[ruleAction20]() {
-					goto l516
+				if !_rules[ruleAction22]() {
+					goto l599
 				}
-				add(ruleLoadStateStmt, position517)
+				add(ruleLoadStateStmt, position600)
 			}
 			return true
-		l516:
-			position, tokenIndex = position516, tokenIndex516
+		l599:
+			position, tokenIndex = position599, tokenIndex599
 			return false
 		},
-		/* 27 LoadStateOrCreateStmt <- <(LoadStateStmt sp (('o' / 'O') ('r' / 'R')) sp (('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp (('i' / 'I') ('f' / 'F')) sp (('n' / 'N') ('o' / 'O') ('t' / 'T')) sp ((('s' / 'S') ('a' / 'A') ('v' / 'V') ('e' / 'E') ('d' / 'D')) / (('e' / 'E') ('x' / 'X') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('s' / 'S'))) SourceSinkSpecs Action21)> */
+		/* 30 LoadStateOrCreateStmt <- <(LoadStateStmt sp (('o' / 'O') ('r' / 'R')) sp (('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp (('i' / 'I') ('f' / 'F')) sp (('n' / 'N') ('o' / 'O') ('t' / 'T')) sp ((('s' / 'S') ('a' / 'A') ('v' / 'V') ('e' / 'E') ('d' / 'D')) / (('e' / 'E') ('x' / 'X') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('s' / 'S'))) SourceSinkSpecs Action23)> */
 		func() bool {
-			position544, tokenIndex544 := position, tokenIndex
+			position627, tokenIndex627 := position, tokenIndex
 			{
-				position545 := position
+				position628 := position
 				if !_rules[ruleLoadStateStmt]() {
-					goto l544
+					goto l627
 				}
 				if !_rules[rulesp]() {
-					goto l544
+					goto l627
 				}
 				{
-					position546, tokenIndex546 := position, tokenIndex
+					position629, tokenIndex629 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l547
+						goto l630
 					}
 					position++
-					goto l546
-				l547:
-					position, tokenIndex = position546, tokenIndex546
+					goto l629
+				l630:
+					position, tokenIndex = position629, tokenIndex629
 					if buffer[position] != rune('O') {
-						goto l544
+						goto l627
 					}
 					position++
 				}
-			l546:
+			l629:
 				{
-					position548, tokenIndex548 := position, tokenIndex
+					position631, tokenIndex631 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l549
+						goto l632
 					}
 					position++
-					goto l548
-				l549:
-					position, tokenIndex = position548, tokenIndex548
+					goto l631
+				l632:
+					position, tokenIndex = position631, tokenIndex631
 					if buffer[position] != rune('R') {
-						goto l544
+						goto l627
 					}
 					position++
 				}
-			l548:
+			l631:
 				if !_rules[rulesp]() {
-					goto l544
+					goto l627
 				}
 				{
-					position550, tokenIndex550 := position, tokenIndex
+					position633, tokenIndex633 := position, tokenIndex
 					if buffer[position] != rune('c') {
-						goto l551
+						goto l634
 					}
 					position++
-					goto l550
-				l551:
-					position, tokenIndex = position550, tokenIndex550
+					goto l633
+				l634:
+					position, tokenIndex = position633, tokenIndex633
 					if buffer[position] != rune('C') {
-						goto l544
+						goto l627
 					}
 					position++
 				}
-			l550:
+			l633:
 				{
-					position552, tokenIndex552 := position, tokenIndex
+					position635, tokenIndex635 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l553
+						goto l636
 					}
 					position++
-					goto l552
-				l553:
-					position, tokenIndex = position552, tokenIndex552
+					goto l635
+				l636:
+					position, tokenIndex = position635, tokenIndex635
 					if buffer[position] != rune('R') {
-						goto l544
+						goto l627
 					}
 					position++
 				}
-			l552:
+			l635:
 				{
-					position554, tokenIndex554 := position, tokenIndex
+					position637, tokenIndex637 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l555
+						goto l638
 					}
 					position++
-					goto l554
-				l555:
-					position, tokenIndex = position554, tokenIndex554
+					goto l637
+				l638:
+					position, tokenIndex = position637, tokenIndex637
 					if buffer[position] != rune('E') {
-						goto l544
+						goto l627
 					}
 					position++
 				}
-			l554:
+			l637:
 				{
-					position556, tokenIndex556 := position, tokenIndex
+					position639, tokenIndex639 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l557
+						goto l640
 					}
 					position++
-					goto l556
-				l557:
-					position, tokenIndex = position556, tokenIndex556
+					goto l639
+				l640:
+					position, tokenIndex = position639, tokenIndex639
 					if buffer[position] != rune('A') {
-						goto l544
+						goto l627
 					}
 					position++
 				}
-			l556:
+			l639:
 				{
-					position558, tokenIndex558 := position, tokenIndex
+					position641, tokenIndex641 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l559
+						goto l642
 					}
 					position++
-					goto l558
-				l559:
-					position, tokenIndex = position558, tokenIndex558
+					goto l641
+				l642:
+					position, tokenIndex = position641, tokenIndex641
 					if buffer[position] != rune('T') {
-						goto l544
+						goto l627
 					}
 					position++
 				}
-			l558:
+			l641:
 				{
-					position560, tokenIndex560 := position, tokenIndex
+					position643, tokenIndex643 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l561
+						goto l644
 					}
 					position++
-					goto l560
-				l561:
-					position, tokenIndex = position560, tokenIndex560
+					goto l643
+				l644:
+					position, tokenIndex = position643, tokenIndex643
 					if buffer[position] != rune('E') {
-						goto l544
+						goto l627
 					}
 					position++
 				}
-			l560:
+			l643:
 				if !_rules[rulesp]() {
-					goto l544
+					goto l627
 				}
 				{
-					position562, tokenIndex562 := position, tokenIndex
+					position645, tokenIndex645 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l563
+						goto l646
 					}
 					position++
-					goto l562
-				l563:
-					position, tokenIndex = position562, tokenIndex562
+					goto l645
+				l646:
+					position, tokenIndex = position645, tokenIndex645
 					if buffer[position] != rune('I') {
-						goto l544
+						goto l627
 					}
 					position++
 				}
-			l562:
+			l645:
 				{
-					position564, tokenIndex564 := position, tokenIndex
+					position647, tokenIndex647 := position, tokenIndex
 					if buffer[position] != rune('f') {
-						goto l565
+						goto l648
 					}
 					position++
-					goto l564
-				l565:
-					position, tokenIndex = position564, tokenIndex564
+					goto l647
+				l648:
+					position, tokenIndex = position647, tokenIndex647
 					if buffer[position] != rune('F') {
-						goto l544
+						goto l627
 					}
 					position++
 				}
-			l564:
+			l647:
 				if !_rules[rulesp]() {
-					goto l544
+					goto l627
 				}
 				{
-					position566, tokenIndex566 := position, tokenIndex
+					position649, tokenIndex649 := position, tokenIndex
 					if buffer[position] != rune('n') {
-						goto l567
+						goto l650
 					}
 					position++
-					goto l566
-				l567:
-					position, tokenIndex = position566, tokenIndex566
+					goto l649
+				l650:
+					position, tokenIndex = position649, tokenIndex649
 					if buffer[position] != rune('N') {
-						goto l544
+						goto l627
 					}
 					position++
 				}
-			l566:
+			l649:
 				{
-					position568, tokenIndex568 := position, tokenIndex
+					position651, tokenIndex651 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l569
+						goto l652
 					}
 					position++
-					goto l568
-				l569:
-					position, tokenIndex = position568, tokenIndex568
+					goto l651
+				l652:
+					position, tokenIndex = position651, tokenIndex651
 					if buffer[position] != rune('O') {
-						goto l544
+						goto l627
 					}
 					position++
 				}
-			l568:
+			l651:
 				{
-					position570, tokenIndex570 := position, tokenIndex
+					position653, tokenIndex653 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l571
+						goto l654
 					}
 					position++
-					goto l570
-				l571:
-					position, tokenIndex = position570, tokenIndex570
+					goto l653
+				l654:
+					position, tokenIndex = position653, tokenIndex653
 					if buffer[position] != rune('T') {
-						goto l544
+						goto l627
 					}
 					position++
 				}
-			l570:
+			l653:
 				if !_rules[rulesp]() {
-					goto l544
+					goto l627
 				}
 				{
-					position572, tokenIndex572 := position, tokenIndex
+					position655, tokenIndex655 := position, tokenIndex
 					{
-						position574, tokenIndex574 := position, tokenIndex
+						position657, tokenIndex657 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l575
+							goto l658
 						}
 						position++
-						goto l574
-					l575:
-						position, tokenIndex = position574, tokenIndex574
+						goto l657
+					l658:
+						position, tokenIndex = position657, tokenIndex657
 						if buffer[position] != rune('S') {
-							goto l573
+							goto l656
 						}
 						position++
 					}
-				l574:
+				l657:
 					{
-						position576, tokenIndex576 := position, tokenIndex
+						position659, tokenIndex659 := position, tokenIndex
 						if buffer[position] != rune('a') {
-							goto l577
+							goto l660
 						}
 						position++
-						goto l576
-					l577:
-						position, tokenIndex = position576, tokenIndex576
+						goto l659
+					l660:
+						position, tokenIndex = position659, tokenIndex659
 						if buffer[position] != rune('A') {
-							goto l573
+							goto l656
 						}
 						position++
 					}
-				l576:
+				l659:
 					{
-						position578, tokenIndex578 := position, tokenIndex
+						position661, tokenIndex661 := position, tokenIndex
 						if buffer[position] != rune('v') {
-							goto l579
+							goto l662
 						}
 						position++
-						goto l578
-					l579:
-						position, tokenIndex = position578, tokenIndex578
+						goto l661
+					l662:
+						position, tokenIndex = position661, tokenIndex661
 						if buffer[position] != rune('V') {
-							goto l573
+							goto l656
 						}
 						position++
 					}
-				l578:
+				l661:
 					{
-						position580, tokenIndex580 := position, tokenIndex
+						position663, tokenIndex663 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l581
+							goto l664
 						}
 						position++
-						goto l580
-					l581:
-						position, tokenIndex = position580, tokenIndex580
+						goto l663
+					l664:
+						position, tokenIndex = position663, tokenIndex663
 						if buffer[position] != rune('E') {
-							goto l573
+							goto l656
 						}
 						position++
 					}
-				l580:
+				l663:
 					{
-						position582, tokenIndex582 := position, tokenIndex
+						position665, tokenIndex665 := position, tokenIndex
 						if buffer[position] != rune('d') {
-							goto l583
+							goto l666
 						}
 						position++
-						goto l582
-					l583:
-						position, tokenIndex = position582, tokenIndex582
+						goto l665
+					l666:
+						position, tokenIndex = position665, tokenIndex665
 						if buffer[position] != rune('D') {
-							goto l573
+							goto l656
 						}
 						position++
 					}
-				l582:
-					goto l572
-				l573:
-					position, tokenIndex = position572, tokenIndex572
+				l665:
+					goto l655
+				l656:
+					position, tokenIndex = position655, tokenIndex655
 					{
-						position584, tokenIndex584 := position, tokenIndex
+						position667, tokenIndex667 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l585
+							goto l668
 						}
 						position++
-						goto l584
-					l585:
-						position, tokenIndex = position584, tokenIndex584
+						goto l667
+					l668:
+						position, tokenIndex = position667, tokenIndex667
 						if buffer[position] != rune('E') {
-							goto l544
+							goto l627
 						}
 						position++
 					}
-				l584:
+				l667:
 					{
-						position586, tokenIndex586 := position, tokenIndex
+						position669, tokenIndex669 := position, tokenIndex
 						if buffer[position] != rune('x') {
-							goto l587
+							goto l670
 						}
 						position++
-						goto l586
-					l587:
-						position, tokenIndex = position586, tokenIndex586
+						goto l669
+					l670:
+						position, tokenIndex = position669, tokenIndex669
 						if buffer[position] != rune('X') {
-							goto l544
+							goto l627
 						}
 						position++
 					}
-				l586:
+				l669:
 					{
-						position588, tokenIndex588 := position, tokenIndex
+						position671, tokenIndex671 := position, tokenIndex
 						if buffer[position] != rune('i') {
-							goto l589
+							goto l672
 						}
 						position++
-						goto l588
-					l589:
-						position, tokenIndex = position588, tokenIndex588
+						goto l671
+					l672:
+						position, tokenIndex = position671, tokenIndex671
 						if buffer[position] != rune('I') {
-							goto l544
+							goto l627
 						}
 						position++
 					}
-				l588:
+				l671:
 					{
-						position590, tokenIndex590 := position, tokenIndex
+						position673, tokenIndex673 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l591
+							goto l674
 						}
 						position++
-						goto l590
-					l591:
-						position, tokenIndex = position590, tokenIndex590
+						goto l673
+					l674:
+						position, tokenIndex = position673, tokenIndex673
 						if buffer[position] != rune('S') {
-							goto l544
+							goto l627
 						}
 						position++
 					}
-				l590:
+				l673:
 					{
-						position592, tokenIndex592 := position, tokenIndex
+						position675, tokenIndex675 := position, tokenIndex
 						if buffer[position] != rune('t') {
-							goto l593
+							goto l676
 						}
 						position++
-						goto l592
-					l593:
-						position, tokenIndex = position592, tokenIndex592
+						goto l675
+					l676:
+						position, tokenIndex = position675, tokenIndex675
 						if buffer[position] != rune('T') {
-							goto l544
+							goto l627
 						}
 						position++
 					}
-				l592:
+				l675:
 					{
-						position594, tokenIndex594 := position, tokenIndex
+						position677, tokenIndex677 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l595
+							goto l678
 						}
 						position++
-						goto l594
-					l595:
-						position, tokenIndex = position594, tokenIndex594
+						goto l677
+					l678:
+						position, tokenIndex = position677, tokenIndex677
 						if buffer[position] != rune('S') {
-							goto l544
+							goto l627
 						}
 						position++
 					}
-				l594:
+				l677:
 				}
-			l572:
+			l655:
 				if !_rules[ruleSourceSinkSpecs]() {
-					goto l544
+					goto l627
 				}
-				if !_rules[ruleAction21]() {
-					goto l544
+				if !_rules[ruleAction23]() {
+					goto l627
 				}
-				add(ruleLoadStateOrCreateStmt, position545)
+				add(ruleLoadStateOrCreateStmt, position628)
 			}
 			return true
-		l544:
-			position, tokenIndex = position544, tokenIndex544
+		l627:
+			position, tokenIndex = position627, tokenIndex627
 			return false
 		},
-		/* 28 SaveStateStmt <- <(('s' / 'S') ('a' / 'A') ('v' / 'V') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier StateTagOpt Action22)> */
+		/* 31 SaveStateStmt <- <(('s' / 'S') ('a' / 'A') ('v' / 'V') ('e' / 'E') sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier StateTagOpt Action24)> */
 		func() bool {
-			position596, tokenIndex596 := position, tokenIndex
+			position679, tokenIndex679 := position, tokenIndex
 			{
-				position597 := position
+				position680 := position
 				{
-					position598, tokenIndex598 := position, tokenIndex
+					position681, tokenIndex681 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l599
+						goto l682
 					}
 					position++
-					goto l598
-				l599:
-					position, tokenIndex = position598, tokenIndex598
+					goto l681
+				l682:
+					position, tokenIndex = position681, tokenIndex681
 					if buffer[position] != rune('S') {
-						goto l596
+						goto l679
 					}
 					position++
 				}
-			l598:
+			l681:
 				{
-					position600, tokenIndex600 := position, tokenIndex
+					position683, tokenIndex683 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l601
+						goto l684
 					}
 					position++
-					goto l600
-				l601:
-					position, tokenIndex = position600, tokenIndex600
+					goto l683
+				l684:
+					position, tokenIndex = position683, tokenIndex683
 					if buffer[position] != rune('A') {
-						goto l596
+						goto l679
 					}
 					position++
 				}
-			l600:
+			l683:
 				{
-					position602, tokenIndex602 := position, tokenIndex
+					position685, tokenIndex685 := position, tokenIndex
 					if buffer[position] != rune('v') {
-						goto l603
+						goto l686
 					}
 					position++
-					goto l602
-				l603:
-					position, tokenIndex = position602, tokenIndex602
+					goto l685
+				l686:
+					position, tokenIndex = position685, tokenIndex685
 					if buffer[position] != rune('V') {
-						goto l596
+						goto l679
 					}
 					position++
 				}
-			l602:
+			l685:
 				{
-					position604, tokenIndex604 := position, tokenIndex
+					position687, tokenIndex687 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l605
+						goto l688
 					}
 					position++
-					goto l604
-				l605:
-					position, tokenIndex = position604, tokenIndex604
+					goto l687
+				l688:
+					position, tokenIndex = position687, tokenIndex687
 					if buffer[position] != rune('E') {
-						goto l596
+						goto l679
 					}
 					position++
 				}
-			l604:
+			l687:
 				if !_rules[rulesp]() {
-					goto l596
+					goto l679
 				}
 				{
-					position606, tokenIndex606 := position, tokenIndex
+					position689, tokenIndex689 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l607
+						goto l690
 					}
 					position++
-					goto l606
-				l607:
-					position, tokenIndex = position606, tokenIndex606
+					goto l689
+				l690:
+					position, tokenIndex = position689, tokenIndex689
 					if buffer[position] != rune('S') {
-						goto l596
+						goto l679
 					}
 					position++
 				}
-			l606:
+			l689:
 				{
-					position608, tokenIndex608 := position, tokenIndex
+					position691, tokenIndex691 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l609
+						goto l692
 					}
 					position++
-					goto l608
-				l609:
-					position, tokenIndex = position608, tokenIndex608
+					goto l691
+				l692:
+					position, tokenIndex = position691, tokenIndex691
 					if buffer[position] != rune('T') {
-						goto l596
+						goto l679
 					}
 					position++
 				}
-			l608:
+			l691:
 				{
-					position610, tokenIndex610 := position, tokenIndex
+					position693, tokenIndex693 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l611
+						goto l694
 					}
 					position++
-					goto l610
-				l611:
-					position, tokenIndex = position610, tokenIndex610
+					goto l693
+				l694:
+					position, tokenIndex = position693, tokenIndex693
 					if buffer[position] != rune('A') {
-						goto l596
+						goto l679
 					}
 					position++
 				}
-			l610:
+			l693:
 				{
-					position612, tokenIndex612 := position, tokenIndex
+					position695, tokenIndex695 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l613
+						goto l696
 					}
 					position++
-					goto l612
-				l613:
-					position, tokenIndex = position612, tokenIndex612
+					goto l695
+				l696:
+					position, tokenIndex = position695, tokenIndex695
 					if buffer[position] != rune('T') {
-						goto l596
+						goto l679
 					}
 					position++
 				}
-			l612:
+			l695:
 				{
-					position614, tokenIndex614 := position, tokenIndex
+					position697, tokenIndex697 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l615
+						goto l698
 					}
 					position++
-					goto l614
-				l615:
-					position, tokenIndex = position614, tokenIndex614
+					goto l697
+				l698:
+					position, tokenIndex = position697, tokenIndex697
 					if buffer[position] != rune('E') {
-						goto l596
+						goto l679
 					}
 					position++
 				}
-			l614:
+			l697:
 				if !_rules[rulesp]() {
-					goto l596
+					goto l679
 				}
 				if !_rules[ruleStreamIdentifier]() {
-					goto l596
+					goto l679
 				}
 				if !_rules[ruleStateTagOpt]() {
-					goto l596
+					goto l679
 				}
-				if !_rules[ruleAction22]() {
-					goto l596
+				if !_rules[ruleAction24]() {
+					goto l679
 				}
-				add(ruleSaveStateStmt, position597)
+				add(ruleSaveStateStmt, position680)
 			}
 			return true
-		l596:
-			position, tokenIndex = position596, tokenIndex596
+		l679:
+			position, tokenIndex = position679, tokenIndex679
 			return false
 		},
-		/* 29 EvalStmt <- <(('e' / 'E') ('v' / 'V') ('a' / 'A') ('l' / 'L') sp Expression <(sp (('o' / 'O') ('n' / 'N')) sp MapExpr)?> Action23)> */
+		/* 32 EvalStmt <- <(('e' / 'E') ('v' / 'V') ('a' / 'A') ('l' / 'L') sp Expression <(sp (('o' / 'O') ('n' / 'N')) sp MapExpr)?> Action25)> */
 		func() bool {
-			position616, tokenIndex616 := position, tokenIndex
+			position699, tokenIndex699 := position, tokenIndex
 			{
-				position617 := position
+				position700 := position
 				{
-					position618, tokenIndex618 := position, tokenIndex
+					position701, tokenIndex701 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l619
+						goto l702
 					}
 					position++
-					goto l618
-				l619:
-					position, tokenIndex = position618, tokenIndex618
+					goto l701
+				l702:
+					position, tokenIndex = position701, tokenIndex701
 					if buffer[position] != rune('E') {
-						goto l616
+						goto l699
 					}
 					position++
 				}
-			l618:
+			l701:
 				{
-					position620, tokenIndex620 := position, tokenIndex
+					position703, tokenIndex703 := position, tokenIndex
 					if buffer[position] != rune('v') {
-						goto l621
+						goto l704
 					}
 					position++
-					goto l620
-				l621:
-					position, tokenIndex = position620, tokenIndex620
+					goto l703
+				l704:
+					position, tokenIndex = position703, tokenIndex703
 					if buffer[position] != rune('V') {
-						goto l616
+						goto l699
 					}
 					position++
 				}
-			l620:
+			l703:
 				{
-					position622, tokenIndex622 := position, tokenIndex
+					position705, tokenIndex705 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l623
+						goto l706
 					}
 					position++
-					goto l622
-				l623:
-					position, tokenIndex = position622, tokenIndex622
+					goto l705
+				l706:
+					position, tokenIndex = position705, tokenIndex705
 					if buffer[position] != rune('A') {
-						goto l616
+						goto l699
 					}
 					position++
 				}
-			l622:
+			l705:
 				{
-					position624, tokenIndex624 := position, tokenIndex
+					position707, tokenIndex707 := position, tokenIndex
 					if buffer[position] != rune('l') {
-						goto l625
+						goto l708
 					}
 					position++
-					goto l624
-				l625:
-					position, tokenIndex = position624, tokenIndex624
+					goto l707
+				l708:
+					position, tokenIndex = position707, tokenIndex707
 					if buffer[position] != rune('L') {
-						goto l616
+						goto l699
 					}
 					position++
 				}
-			l624:
+			l707:
 				if !_rules[rulesp]() {
-					goto l616
+					goto l699
 				}
 				if !_rules[ruleExpression]() {
-					goto l616
+					goto l699
 				}
 				{
-					position626 := position
+					position709 := position
 					{
-						position627, tokenIndex627 := position, tokenIndex
+						position710, tokenIndex710 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l627
+							goto l710
 						}
 						{
-							position629, tokenIndex629 := position, tokenIndex
+							position712, tokenIndex712 := position, tokenIndex
 							if buffer[position] != rune('o') {
-								goto l630
+								goto l713
 							}
 							position++
-							goto l629
-						l630:
-							position, tokenIndex = position629, tokenIndex629
+							goto l712
+						l713:
+							position, tokenIndex = position712, tokenIndex712
 							if buffer[position] != rune('O') {
-								goto l627
+								goto l710
 							}
 							position++
 						}
-					l629:
+					l712:
 						{
-							position631, tokenIndex631 := position, tokenIndex
+							position714, tokenIndex714 := position, tokenIndex
 							if buffer[position] != rune('n') {
-								goto l632
+								goto l715
 							}
 							position++
-							goto l631
-						l632:
-							position, tokenIndex = position631, tokenIndex631
+							goto l714
+						l715:
+							position, tokenIndex = position714, tokenIndex714
 							if buffer[position] != rune('N') {
-								goto l627
+								goto l710
 							}
 							position++
 						}
-					l631:
+					l714:
 						if !_rules[rulesp]() {
-							goto l627
+							goto l710
 						}
 						if !_rules[ruleMapExpr]() {
-							goto l627
+							goto l710
 						}
-						goto l628
-					l627:
-						position, tokenIndex = position627, tokenIndex627
+						goto l711
+					l710:
+						position, tokenIndex = position710, tokenIndex710
 					}
-				l628:
-					add(rulePegText, position626)
+				l711:
+					add(rulePegText, position709)
 				}
-				if !_rules[ruleAction23]() {
-					goto l616
+				if !_rules[ruleAction25]() {
+					goto l699
 				}
-				add(ruleEvalStmt, position617)
+				add(ruleEvalStmt, position700)
 			}
 			return true
-		l616:
-			position, tokenIndex = position616, tokenIndex616
+		l699:
+			position, tokenIndex = position699, tokenIndex699
 			return false
 		},
-		/* 30 Emitter <- <(sp (ISTREAM / DSTREAM / RSTREAM) EmitterOptions Action24)> */
+		/* 33 ExplainStmt <- <(('e' / 'E') ('x' / 'X') ('p' / 'P') ('l' / 'L') ('a' / 'A') ('i' / 'I') ('n' / 'N') sp (SelectUnionStmt / SelectStmt / CreateStreamAsSelectUnionStmt / CreateStreamAsSelectStmt / CreateOrReplaceStreamAsSelectStmt) Action26)> */
 		func() bool {
-			position633, tokenIndex633 := position, tokenIndex
+			position716, tokenIndex716 := position, tokenIndex
 			{
-				position634 := position
-				if !_rules[rulesp]() {
-					goto l633
-				}
+				position717 := position
 				{
-					position635, tokenIndex635 := position, tokenIndex
-					if !_rules[ruleISTREAM]() {
-						goto l636
-					}
-					goto l635
-				l636:
-					position, tokenIndex = position635, tokenIndex635
-					if !_rules[ruleDSTREAM]() {
-						goto l637
-					}
-					goto l635
-				l637:
-					position, tokenIndex = position635, tokenIndex635
-					if !_rules[ruleRSTREAM]() {
-						goto l633
+					position718, tokenIndex718 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l719
 					}
-				}
-			l635:
-				if !_rules[ruleEmitterOptions]() {
-					goto l633
-				}
-				if !_rules[ruleAction24]() {
-					goto l633
-				}
-				add(ruleEmitter, position634)
-			}
-			return true
-		l633:
-			position, tokenIndex = position633, tokenIndex633
-			return false
-		},
-		/* 31 EmitterOptions <- <(<(spOpt '[' spOpt EmitterOptionCombinations spOpt ']')?> Action25)> */
-		func() bool {
-			position638, tokenIndex638 := position, tokenIndex
-			{
-				position639 := position
-				{
-					position640 := position
-					{
-						position641, tokenIndex641 := position, tokenIndex
-						if !_rules[rulespOpt]() {
-							goto l641
-						}
-						if buffer[position] != rune('[') {
-							goto l641
-						}
-						position++
-						if !_rules[rulespOpt]() {
-							goto l641
-						}
-						if !_rules[ruleEmitterOptionCombinations]() {
-							goto l641
-						}
-						if !_rules[rulespOpt]() {
-							goto l641
-						}
-						if buffer[position] != rune(']') {
-							goto l641
-						}
-						position++
-						goto l642
-					l641:
-						position, tokenIndex = position641, tokenIndex641
+					position++
+					goto l718
+				l719:
+					position, tokenIndex = position718, tokenIndex718
+					if buffer[position] != rune('E') {
+						goto l716
 					}
-				l642:
-					add(rulePegText, position640)
-				}
-				if !_rules[ruleAction25]() {
-					goto l638
+					position++
 				}
-				add(ruleEmitterOptions, position639)
-			}
-			return true
-		l638:
-			position, tokenIndex = position638, tokenIndex638
-			return false
-		},
-		/* 32 EmitterOptionCombinations <- <(EmitterLimit / (EmitterSample sp EmitterLimit) / EmitterSample)> */
-		func() bool {
-			position643, tokenIndex643 := position, tokenIndex
-			{
-				position644 := position
+			l718:
 				{
-					position645, tokenIndex645 := position, tokenIndex
-					if !_rules[ruleEmitterLimit]() {
-						goto l646
-					}
-					goto l645
-				l646:
-					position, tokenIndex = position645, tokenIndex645
-					if !_rules[ruleEmitterSample]() {
-						goto l647
-					}
-					if !_rules[rulesp]() {
-						goto l647
-					}
-					if !_rules[ruleEmitterLimit]() {
-						goto l647
+					position720, tokenIndex720 := position, tokenIndex
+					if buffer[position] != rune('x') {
+						goto l721
 					}
-					goto l645
-				l647:
-					position, tokenIndex = position645, tokenIndex645
-					if !_rules[ruleEmitterSample]() {
-						goto l643
+					position++
+					goto l720
+				l721:
+					position, tokenIndex = position720, tokenIndex720
+					if buffer[position] != rune('X') {
+						goto l716
 					}
+					position++
 				}
-			l645:
-				add(ruleEmitterOptionCombinations, position644)
-			}
-			return true
-		l643:
-			position, tokenIndex = position643, tokenIndex643
-			return false
-		},
-		/* 33 EmitterLimit <- <(('l' / 'L') ('i' / 'I') ('m' / 'M') ('i' / 'I') ('t' / 'T') sp NumericLiteral Action26)> */
-		func() bool {
-			position648, tokenIndex648 := position, tokenIndex
-			{
-				position649 := position
+			l720:
 				{
-					position650, tokenIndex650 := position, tokenIndex
-					if buffer[position] != rune('l') {
-						goto l651
+					position722, tokenIndex722 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l723
 					}
 					position++
-					goto l650
-				l651:
-					position, tokenIndex = position650, tokenIndex650
-					if buffer[position] != rune('L') {
-						goto l648
+					goto l722
+				l723:
+					position, tokenIndex = position722, tokenIndex722
+					if buffer[position] != rune('P') {
+						goto l716
 					}
 					position++
 				}
-			l650:
+			l722:
 				{
-					position652, tokenIndex652 := position, tokenIndex
-					if buffer[position] != rune('i') {
-						goto l653
+					position724, tokenIndex724 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l725
 					}
 					position++
-					goto l652
-				l653:
-					position, tokenIndex = position652, tokenIndex652
-					if buffer[position] != rune('I') {
-						goto l648
+					goto l724
+				l725:
+					position, tokenIndex = position724, tokenIndex724
+					if buffer[position] != rune('L') {
+						goto l716
 					}
 					position++
 				}
-			l652:
+			l724:
 				{
-					position654, tokenIndex654 := position, tokenIndex
-					if buffer[position] != rune('m') {
-						goto l655
+					position726, tokenIndex726 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l727
 					}
 					position++
-					goto l654
-				l655:
-					position, tokenIndex = position654, tokenIndex654
-					if buffer[position] != rune('M') {
-						goto l648
+					goto l726
+				l727:
+					position, tokenIndex = position726, tokenIndex726
+					if buffer[position] != rune('A') {
+						goto l716
 					}
 					position++
 				}
-			l654:
+			l726:
 				{
-					position656, tokenIndex656 := position, tokenIndex
+					position728, tokenIndex728 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l657
+						goto l729
 					}
 					position++
-					goto l656
-				l657:
-					position, tokenIndex = position656, tokenIndex656
+					goto l728
+				l729:
+					position, tokenIndex = position728, tokenIndex728
 					if buffer[position] != rune('I') {
-						goto l648
+						goto l716
 					}
 					position++
 				}
-			l656:
+			l728:
 				{
-					position658, tokenIndex658 := position, tokenIndex
-					if buffer[position] != rune('t') {
-						goto l659
+					position730, tokenIndex730 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l731
 					}
 					position++
-					goto l658
-				l659:
-					position, tokenIndex = position658, tokenIndex658
-					if buffer[position] != rune('T') {
-						goto l648
+					goto l730
+				l731:
+					position, tokenIndex = position730, tokenIndex730
+					if buffer[position] != rune('N') {
+						goto l716
 					}
 					position++
 				}
-			l658:
+			l730:
 				if !_rules[rulesp]() {
-					goto l648
-				}
-				if !_rules[ruleNumericLiteral]() {
-					goto l648
+					goto l716
 				}
-				if !_rules[ruleAction26]() {
-					goto l648
-				}
-				add(ruleEmitterLimit, position649)
-			}
-			return true
-		l648:
-			position, tokenIndex = position648, tokenIndex648
-			return false
-		},
-		/* 34 EmitterSample <- <(CountBasedSampling / RandomizedSampling / TimeBasedSampling)> */
-		func() bool {
-			position660, tokenIndex660 := position, tokenIndex
-			{
-				position661 := position
 				{
-					position662, tokenIndex662 := position, tokenIndex
-					if !_rules[ruleCountBasedSampling]() {
-						goto l663
+					position732, tokenIndex732 := position, tokenIndex
+					if !_rules[ruleSelectUnionStmt]() {
+						goto l733
 					}
-					goto l662
-				l663:
-					position, tokenIndex = position662, tokenIndex662
-					if !_rules[ruleRandomizedSampling]() {
-						goto l664
+					goto l732
+				l733:
+					position, tokenIndex = position732, tokenIndex732
+					if !_rules[ruleSelectStmt]() {
+						goto l734
 					}
-					goto l662
-				l664:
-					position, tokenIndex = position662, tokenIndex662
-					if !_rules[ruleTimeBasedSampling]() {
-						goto l660
+					goto l732
+				l734:
+					position, tokenIndex = position732, tokenIndex732
+					if !_rules[ruleCreateStreamAsSelectUnionStmt]() {
+						goto l735
+					}
+					goto l732
+				l735:
+					position, tokenIndex = position732, tokenIndex732
+					if !_rules[ruleCreateStreamAsSelectStmt]() {
+						goto l736
+					}
+					goto l732
+				l736:
+					position, tokenIndex = position732, tokenIndex732
+					if !_rules[ruleCreateOrReplaceStreamAsSelectStmt]() {
+						goto l716
 					}
 				}
-			l662:
-				add(ruleEmitterSample, position661)
+			l732:
+				if !_rules[ruleAction26]() {
+					goto l716
+				}
+				add(ruleExplainStmt, position717)
 			}
 			return true
-		l660:
-			position, tokenIndex = position660, tokenIndex660
+		l716:
+			position, tokenIndex = position716, tokenIndex716
 			return false
 		},
-		/* 35 CountBasedSampling <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp NumericLiteral spOpt '-'? spOpt ((('s' / 'S') ('t' / 'T')) / (('n' / 'N') ('d' / 'D')) / (('r' / 'R') ('d' / 'D')) / (('t' / 'T') ('h' / 'H'))) sp (('t' / 'T') ('u' / 'U') ('p' / 'P') ('l' / 'L') ('e' / 'E')) Action27)> */
+		/* 34 ShowStmt <- <(('s' / 'S') ('h' / 'H') ('o' / 'O') ('w' / 'W') sp ShowTarget Action27)> */
 		func() bool {
-			position665, tokenIndex665 := position, tokenIndex
+			position737, tokenIndex737 := position, tokenIndex
 			{
-				position666 := position
+				position738 := position
 				{
-					position667, tokenIndex667 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l668
+					position739, tokenIndex739 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l740
 					}
 					position++
-					goto l667
-				l668:
-					position, tokenIndex = position667, tokenIndex667
-					if buffer[position] != rune('E') {
-						goto l665
+					goto l739
+				l740:
+					position, tokenIndex = position739, tokenIndex739
+					if buffer[position] != rune('S') {
+						goto l737
 					}
 					position++
 				}
-			l667:
+			l739:
 				{
-					position669, tokenIndex669 := position, tokenIndex
-					if buffer[position] != rune('v') {
-						goto l670
+					position741, tokenIndex741 := position, tokenIndex
+					if buffer[position] != rune('h') {
+						goto l742
 					}
 					position++
-					goto l669
-				l670:
-					position, tokenIndex = position669, tokenIndex669
-					if buffer[position] != rune('V') {
-						goto l665
+					goto l741
+				l742:
+					position, tokenIndex = position741, tokenIndex741
+					if buffer[position] != rune('H') {
+						goto l737
 					}
 					position++
 				}
-			l669:
+			l741:
 				{
-					position671, tokenIndex671 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l672
+					position743, tokenIndex743 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l744
 					}
 					position++
-					goto l671
-				l672:
-					position, tokenIndex = position671, tokenIndex671
-					if buffer[position] != rune('E') {
-						goto l665
+					goto l743
+				l744:
+					position, tokenIndex = position743, tokenIndex743
+					if buffer[position] != rune('O') {
+						goto l737
 					}
 					position++
 				}
-			l671:
+			l743:
 				{
-					position673, tokenIndex673 := position, tokenIndex
-					if buffer[position] != rune('r') {
-						goto l674
+					position745, tokenIndex745 := position, tokenIndex
+					if buffer[position] != rune('w') {
+						goto l746
 					}
 					position++
-					goto l673
-				l674:
-					position, tokenIndex = position673, tokenIndex673
-					if buffer[position] != rune('R') {
-						goto l665
+					goto l745
+				l746:
+					position, tokenIndex = position745, tokenIndex745
+					if buffer[position] != rune('W') {
+						goto l737
 					}
 					position++
 				}
-			l673:
+			l745:
+				if !_rules[rulesp]() {
+					goto l737
+				}
+				if !_rules[ruleShowTarget]() {
+					goto l737
+				}
+				if !_rules[ruleAction27]() {
+					goto l737
+				}
+				add(ruleShowStmt, position738)
+			}
+			return true
+		l737:
+			position, tokenIndex = position737, tokenIndex737
+			return false
+		},
+		/* 35 DescribeStmt <- <(('d' / 'D') ('e' / 'E') ('s' / 'S') ('c' / 'C') ('r' / 'R') ('i' / 'I') ('b' / 'B') ('e' / 'E') sp StreamIdentifier Action28)> */
+		func() bool {
+			position747, tokenIndex747 := position, tokenIndex
+			{
+				position748 := position
 				{
-					position675, tokenIndex675 := position, tokenIndex
-					if buffer[position] != rune('y') {
-						goto l676
+					position749, tokenIndex749 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l750
 					}
 					position++
-					goto l675
-				l676:
-					position, tokenIndex = position675, tokenIndex675
-					if buffer[position] != rune('Y') {
-						goto l665
+					goto l749
+				l750:
+					position, tokenIndex = position749, tokenIndex749
+					if buffer[position] != rune('D') {
+						goto l747
 					}
 					position++
 				}
-			l675:
-				if !_rules[rulesp]() {
-					goto l665
-				}
-				if !_rules[ruleNumericLiteral]() {
-					goto l665
-				}
-				if !_rules[rulespOpt]() {
-					goto l665
-				}
+			l749:
 				{
-					position677, tokenIndex677 := position, tokenIndex
-					if buffer[position] != rune('-') {
-						goto l677
+					position751, tokenIndex751 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l752
+					}
+					position++
+					goto l751
+				l752:
+					position, tokenIndex = position751, tokenIndex751
+					if buffer[position] != rune('E') {
+						goto l747
 					}
 					position++
-					goto l678
-				l677:
-					position, tokenIndex = position677, tokenIndex677
-				}
-			l678:
-				if !_rules[rulespOpt]() {
-					goto l665
 				}
+			l751:
 				{
-					position679, tokenIndex679 := position, tokenIndex
-					{
-						position681, tokenIndex681 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l682
-						}
-						position++
-						goto l681
-					l682:
-						position, tokenIndex = position681, tokenIndex681
-						if buffer[position] != rune('S') {
-							goto l680
-						}
-						position++
-					}
-				l681:
-					{
-						position683, tokenIndex683 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l684
-						}
-						position++
-						goto l683
-					l684:
-						position, tokenIndex = position683, tokenIndex683
-						if buffer[position] != rune('T') {
-							goto l680
-						}
-						position++
-					}
-				l683:
-					goto l679
-				l680:
-					position, tokenIndex = position679, tokenIndex679
-					{
-						position686, tokenIndex686 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l687
-						}
-						position++
-						goto l686
-					l687:
-						position, tokenIndex = position686, tokenIndex686
-						if buffer[position] != rune('N') {
-							goto l685
-						}
-						position++
-					}
-				l686:
-					{
-						position688, tokenIndex688 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l689
-						}
-						position++
-						goto l688
-					l689:
-						position, tokenIndex = position688, tokenIndex688
-						if buffer[position] != rune('D') {
-							goto l685
-						}
-						position++
-					}
-				l688:
-					goto l679
-				l685:
-					position, tokenIndex = position679, tokenIndex679
-					{
-						position691, tokenIndex691 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l692
-						}
-						position++
-						goto l691
-					l692:
-						position, tokenIndex = position691, tokenIndex691
-						if buffer[position] != rune('R') {
-							goto l690
-						}
-						position++
-					}
-				l691:
-					{
-						position693, tokenIndex693 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l694
-						}
-						position++
-						goto l693
-					l694:
-						position, tokenIndex = position693, tokenIndex693
-						if buffer[position] != rune('D') {
-							goto l690
-						}
-						position++
-					}
-				l693:
-					goto l679
-				l690:
-					position, tokenIndex = position679, tokenIndex679
-					{
-						position695, tokenIndex695 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l696
-						}
-						position++
-						goto l695
-					l696:
-						position, tokenIndex = position695, tokenIndex695
-						if buffer[position] != rune('T') {
-							goto l665
-						}
-						position++
+					position753, tokenIndex753 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l754
 					}
-				l695:
-					{
-						position697, tokenIndex697 := position, tokenIndex
-						if buffer[position] != rune('h') {
-							goto l698
-						}
-						position++
-						goto l697
-					l698:
-						position, tokenIndex = position697, tokenIndex697
-						if buffer[position] != rune('H') {
-							goto l665
-						}
-						position++
+					position++
+					goto l753
+				l754:
+					position, tokenIndex = position753, tokenIndex753
+					if buffer[position] != rune('S') {
+						goto l747
 					}
-				l697:
-				}
-			l679:
-				if !_rules[rulesp]() {
-					goto l665
+					position++
 				}
+			l753:
 				{
-					position699, tokenIndex699 := position, tokenIndex
-					if buffer[position] != rune('t') {
-						goto l700
+					position755, tokenIndex755 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l756
 					}
 					position++
-					goto l699
-				l700:
-					position, tokenIndex = position699, tokenIndex699
-					if buffer[position] != rune('T') {
-						goto l665
+					goto l755
+				l756:
+					position, tokenIndex = position755, tokenIndex755
+					if buffer[position] != rune('C') {
+						goto l747
 					}
 					position++
 				}
-			l699:
+			l755:
 				{
-					position701, tokenIndex701 := position, tokenIndex
-					if buffer[position] != rune('u') {
-						goto l702
+					position757, tokenIndex757 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l758
 					}
 					position++
-					goto l701
-				l702:
-					position, tokenIndex = position701, tokenIndex701
-					if buffer[position] != rune('U') {
-						goto l665
+					goto l757
+				l758:
+					position, tokenIndex = position757, tokenIndex757
+					if buffer[position] != rune('R') {
+						goto l747
 					}
 					position++
 				}
-			l701:
+			l757:
 				{
-					position703, tokenIndex703 := position, tokenIndex
-					if buffer[position] != rune('p') {
-						goto l704
+					position759, tokenIndex759 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l760
 					}
 					position++
-					goto l703
-				l704:
-					position, tokenIndex = position703, tokenIndex703
-					if buffer[position] != rune('P') {
-						goto l665
+					goto l759
+				l760:
+					position, tokenIndex = position759, tokenIndex759
+					if buffer[position] != rune('I') {
+						goto l747
 					}
 					position++
 				}
-			l703:
+			l759:
 				{
-					position705, tokenIndex705 := position, tokenIndex
-					if buffer[position] != rune('l') {
-						goto l706
+					position761, tokenIndex761 := position, tokenIndex
+					if buffer[position] != rune('b') {
+						goto l762
 					}
 					position++
-					goto l705
-				l706:
-					position, tokenIndex = position705, tokenIndex705
-					if buffer[position] != rune('L') {
-						goto l665
+					goto l761
+				l762:
+					position, tokenIndex = position761, tokenIndex761
+					if buffer[position] != rune('B') {
+						goto l747
 					}
 					position++
 				}
-			l705:
+			l761:
 				{
-					position707, tokenIndex707 := position, tokenIndex
+					position763, tokenIndex763 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l708
+						goto l764
 					}
 					position++
-					goto l707
-				l708:
-					position, tokenIndex = position707, tokenIndex707
+					goto l763
+				l764:
+					position, tokenIndex = position763, tokenIndex763
 					if buffer[position] != rune('E') {
-						goto l665
+						goto l747
 					}
 					position++
 				}
-			l707:
-				if !_rules[ruleAction27]() {
-					goto l665
+			l763:
+				if !_rules[rulesp]() {
+					goto l747
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l747
+				}
+				if !_rules[ruleAction28]() {
+					goto l747
 				}
-				add(ruleCountBasedSampling, position666)
+				add(ruleDescribeStmt, position748)
 			}
 			return true
-		l665:
-			position, tokenIndex = position665, tokenIndex665
+		l747:
+			position, tokenIndex = position747, tokenIndex747
 			return false
 		},
-		/* 36 RandomizedSampling <- <(('s' / 'S') ('a' / 'A') ('m' / 'M') ('p' / 'P') ('l' / 'L') ('e' / 'E') sp (FloatLiteral / NumericLiteral) spOpt '%' Action28)> */
+		/* 36 CreateFunctionStmt <- <(('c' / 'C') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('f' / 'F') ('u' / 'U') ('n' / 'N') ('c' / 'C') ('t' / 'T') ('i' / 'I') ('o' / 'O') ('n' / 'N')) sp Function spOpt '(' spOpt FunctionParams spOpt ')' sp (('a' / 'A') ('s' / 'S')) sp Expression Action29)> */
 		func() bool {
-			position709, tokenIndex709 := position, tokenIndex
+			position765, tokenIndex765 := position, tokenIndex
 			{
-				position710 := position
+				position766 := position
 				{
-					position711, tokenIndex711 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l712
+					position767, tokenIndex767 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l768
 					}
 					position++
-					goto l711
-				l712:
-					position, tokenIndex = position711, tokenIndex711
-					if buffer[position] != rune('S') {
-						goto l709
+					goto l767
+				l768:
+					position, tokenIndex = position767, tokenIndex767
+					if buffer[position] != rune('C') {
+						goto l765
 					}
 					position++
 				}
-			l711:
+			l767:
 				{
-					position713, tokenIndex713 := position, tokenIndex
-					if buffer[position] != rune('a') {
-						goto l714
+					position769, tokenIndex769 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l770
 					}
 					position++
-					goto l713
-				l714:
-					position, tokenIndex = position713, tokenIndex713
-					if buffer[position] != rune('A') {
-						goto l709
+					goto l769
+				l770:
+					position, tokenIndex = position769, tokenIndex769
+					if buffer[position] != rune('R') {
+						goto l765
 					}
 					position++
 				}
-			l713:
+			l769:
 				{
-					position715, tokenIndex715 := position, tokenIndex
-					if buffer[position] != rune('m') {
-						goto l716
+					position771, tokenIndex771 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l772
 					}
 					position++
-					goto l715
-				l716:
-					position, tokenIndex = position715, tokenIndex715
-					if buffer[position] != rune('M') {
-						goto l709
+					goto l771
+				l772:
+					position, tokenIndex = position771, tokenIndex771
+					if buffer[position] != rune('E') {
+						goto l765
 					}
 					position++
 				}
-			l715:
+			l771:
 				{
-					position717, tokenIndex717 := position, tokenIndex
-					if buffer[position] != rune('p') {
-						goto l718
+					position773, tokenIndex773 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l774
 					}
 					position++
-					goto l717
-				l718:
-					position, tokenIndex = position717, tokenIndex717
-					if buffer[position] != rune('P') {
-						goto l709
+					goto l773
+				l774:
+					position, tokenIndex = position773, tokenIndex773
+					if buffer[position] != rune('A') {
+						goto l765
 					}
 					position++
 				}
-			l717:
+			l773:
 				{
-					position719, tokenIndex719 := position, tokenIndex
-					if buffer[position] != rune('l') {
-						goto l720
+					position775, tokenIndex775 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l776
 					}
 					position++
-					goto l719
-				l720:
-					position, tokenIndex = position719, tokenIndex719
-					if buffer[position] != rune('L') {
-						goto l709
+					goto l775
+				l776:
+					position, tokenIndex = position775, tokenIndex775
+					if buffer[position] != rune('T') {
+						goto l765
 					}
 					position++
 				}
-			l719:
+			l775:
 				{
-					position721, tokenIndex721 := position, tokenIndex
+					position777, tokenIndex777 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l722
+						goto l778
 					}
 					position++
-					goto l721
-				l722:
-					position, tokenIndex = position721, tokenIndex721
+					goto l777
+				l778:
+					position, tokenIndex = position777, tokenIndex777
 					if buffer[position] != rune('E') {
-						goto l709
+						goto l765
 					}
 					position++
 				}
-			l721:
+			l777:
 				if !_rules[rulesp]() {
-					goto l709
+					goto l765
 				}
 				{
-					position723, tokenIndex723 := position, tokenIndex
-					if !_rules[ruleFloatLiteral]() {
-						goto l724
+					position779, tokenIndex779 := position, tokenIndex
+					if buffer[position] != rune('f') {
+						goto l780
 					}
-					goto l723
-				l724:
-					position, tokenIndex = position723, tokenIndex723
-					if !_rules[ruleNumericLiteral]() {
-						goto l709
+					position++
+					goto l779
+				l780:
+					position, tokenIndex = position779, tokenIndex779
+					if buffer[position] != rune('F') {
+						goto l765
 					}
+					position++
 				}
-			l723:
-				if !_rules[rulespOpt]() {
-					goto l709
-				}
-				if buffer[position] != rune('%') {
-					goto l709
-				}
-				position++
-				if !_rules[ruleAction28]() {
-					goto l709
-				}
-				add(ruleRandomizedSampling, position710)
-			}
-			return true
-		l709:
-			position, tokenIndex = position709, tokenIndex709
-			return false
-		},
-		/* 37 TimeBasedSampling <- <(TimeBasedSamplingSeconds / TimeBasedSamplingMilliseconds)> */
-		func() bool {
-			position725, tokenIndex725 := position, tokenIndex
-			{
-				position726 := position
+			l779:
 				{
-					position727, tokenIndex727 := position, tokenIndex
-					if !_rules[ruleTimeBasedSamplingSeconds]() {
-						goto l728
+					position781, tokenIndex781 := position, tokenIndex
+					if buffer[position] != rune('u') {
+						goto l782
 					}
-					goto l727
-				l728:
-					position, tokenIndex = position727, tokenIndex727
-					if !_rules[ruleTimeBasedSamplingMilliseconds]() {
-						goto l725
+					position++
+					goto l781
+				l782:
+					position, tokenIndex = position781, tokenIndex781
+					if buffer[position] != rune('U') {
+						goto l765
 					}
+					position++
 				}
-			l727:
-				add(ruleTimeBasedSampling, position726)
-			}
-			return true
-		l725:
-			position, tokenIndex = position725, tokenIndex725
-			return false
-		},
-		/* 38 TimeBasedSamplingSeconds <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp (FloatLiteral / NumericLiteral) sp (('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S')) Action29)> */
-		func() bool {
-			position729, tokenIndex729 := position, tokenIndex
-			{
-				position730 := position
+			l781:
 				{
-					position731, tokenIndex731 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l732
+					position783, tokenIndex783 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l784
 					}
 					position++
-					goto l731
-				l732:
-					position, tokenIndex = position731, tokenIndex731
-					if buffer[position] != rune('E') {
-						goto l729
+					goto l783
+				l784:
+					position, tokenIndex = position783, tokenIndex783
+					if buffer[position] != rune('N') {
+						goto l765
 					}
 					position++
 				}
-			l731:
+			l783:
 				{
-					position733, tokenIndex733 := position, tokenIndex
-					if buffer[position] != rune('v') {
-						goto l734
+					position785, tokenIndex785 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l786
 					}
 					position++
-					goto l733
-				l734:
-					position, tokenIndex = position733, tokenIndex733
-					if buffer[position] != rune('V') {
-						goto l729
+					goto l785
+				l786:
+					position, tokenIndex = position785, tokenIndex785
+					if buffer[position] != rune('C') {
+						goto l765
 					}
 					position++
 				}
-			l733:
+			l785:
 				{
-					position735, tokenIndex735 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l736
+					position787, tokenIndex787 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l788
 					}
 					position++
-					goto l735
-				l736:
-					position, tokenIndex = position735, tokenIndex735
-					if buffer[position] != rune('E') {
-						goto l729
+					goto l787
+				l788:
+					position, tokenIndex = position787, tokenIndex787
+					if buffer[position] != rune('T') {
+						goto l765
 					}
 					position++
 				}
-			l735:
+			l787:
 				{
-					position737, tokenIndex737 := position, tokenIndex
-					if buffer[position] != rune('r') {
-						goto l738
+					position789, tokenIndex789 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l790
 					}
 					position++
-					goto l737
-				l738:
-					position, tokenIndex = position737, tokenIndex737
-					if buffer[position] != rune('R') {
-						goto l729
+					goto l789
+				l790:
+					position, tokenIndex = position789, tokenIndex789
+					if buffer[position] != rune('I') {
+						goto l765
 					}
 					position++
 				}
-			l737:
+			l789:
 				{
-					position739, tokenIndex739 := position, tokenIndex
-					if buffer[position] != rune('y') {
-						goto l740
+					position791, tokenIndex791 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l792
 					}
 					position++
-					goto l739
-				l740:
-					position, tokenIndex = position739, tokenIndex739
-					if buffer[position] != rune('Y') {
-						goto l729
+					goto l791
+				l792:
+					position, tokenIndex = position791, tokenIndex791
+					if buffer[position] != rune('O') {
+						goto l765
 					}
 					position++
 				}
-			l739:
-				if !_rules[rulesp]() {
-					goto l729
-				}
+			l791:
 				{
-					position741, tokenIndex741 := position, tokenIndex
-					if !_rules[ruleFloatLiteral]() {
-						goto l742
+					position793, tokenIndex793 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l794
 					}
-					goto l741
-				l742:
-					position, tokenIndex = position741, tokenIndex741
-					if !_rules[ruleNumericLiteral]() {
-						goto l729
+					position++
+					goto l793
+				l794:
+					position, tokenIndex = position793, tokenIndex793
+					if buffer[position] != rune('N') {
+						goto l765
 					}
+					position++
 				}
-			l741:
+			l793:
 				if !_rules[rulesp]() {
-					goto l729
+					goto l765
 				}
-				{
-					position743, tokenIndex743 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l744
-					}
-					position++
-					goto l743
-				l744:
-					position, tokenIndex = position743, tokenIndex743
-					if buffer[position] != rune('S') {
-						goto l729
-					}
-					position++
+				if !_rules[ruleFunction]() {
+					goto l765
 				}
-			l743:
-				{
-					position745, tokenIndex745 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l746
-					}
-					position++
-					goto l745
-				l746:
-					position, tokenIndex = position745, tokenIndex745
-					if buffer[position] != rune('E') {
-						goto l729
-					}
-					position++
+				if !_rules[rulespOpt]() {
+					goto l765
 				}
-			l745:
-				{
-					position747, tokenIndex747 := position, tokenIndex
-					if buffer[position] != rune('c') {
-						goto l748
-					}
-					position++
-					goto l747
-				l748:
-					position, tokenIndex = position747, tokenIndex747
-					if buffer[position] != rune('C') {
-						goto l729
-					}
-					position++
+				if buffer[position] != rune('(') {
+					goto l765
 				}
-			l747:
-				{
-					position749, tokenIndex749 := position, tokenIndex
-					if buffer[position] != rune('o') {
-						goto l750
-					}
-					position++
-					goto l749
-				l750:
-					position, tokenIndex = position749, tokenIndex749
-					if buffer[position] != rune('O') {
-						goto l729
-					}
-					position++
+				position++
+				if !_rules[rulespOpt]() {
+					goto l765
 				}
-			l749:
-				{
-					position751, tokenIndex751 := position, tokenIndex
-					if buffer[position] != rune('n') {
-						goto l752
-					}
-					position++
-					goto l751
-				l752:
-					position, tokenIndex = position751, tokenIndex751
-					if buffer[position] != rune('N') {
-						goto l729
-					}
-					position++
+				if !_rules[ruleFunctionParams]() {
+					goto l765
+				}
+				if !_rules[rulespOpt]() {
+					goto l765
+				}
+				if buffer[position] != rune(')') {
+					goto l765
+				}
+				position++
+				if !_rules[rulesp]() {
+					goto l765
 				}
-			l751:
 				{
-					position753, tokenIndex753 := position, tokenIndex
-					if buffer[position] != rune('d') {
-						goto l754
+					position795, tokenIndex795 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l796
 					}
 					position++
-					goto l753
-				l754:
-					position, tokenIndex = position753, tokenIndex753
-					if buffer[position] != rune('D') {
-						goto l729
+					goto l795
+				l796:
+					position, tokenIndex = position795, tokenIndex795
+					if buffer[position] != rune('A') {
+						goto l765
 					}
 					position++
 				}
-			l753:
+			l795:
 				{
-					position755, tokenIndex755 := position, tokenIndex
+					position797, tokenIndex797 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l756
+						goto l798
 					}
 					position++
-					goto l755
-				l756:
-					position, tokenIndex = position755, tokenIndex755
+					goto l797
+				l798:
+					position, tokenIndex = position797, tokenIndex797
 					if buffer[position] != rune('S') {
-						goto l729
+						goto l765
 					}
 					position++
 				}
-			l755:
+			l797:
+				if !_rules[rulesp]() {
+					goto l765
+				}
+				if !_rules[ruleExpression]() {
+					goto l765
+				}
 				if !_rules[ruleAction29]() {
-					goto l729
+					goto l765
 				}
-				add(ruleTimeBasedSamplingSeconds, position730)
+				add(ruleCreateFunctionStmt, position766)
 			}
 			return true
-		l729:
-			position, tokenIndex = position729, tokenIndex729
+		l765:
+			position, tokenIndex = position765, tokenIndex765
 			return false
 		},
-		/* 39 TimeBasedSamplingMilliseconds <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp (FloatLiteral / NumericLiteral) sp (('m' / 'M') ('i' / 'I') ('l' / 'L') ('l' / 'L') ('i' / 'I') ('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S')) Action30)> */
+		/* 37 FunctionParams <- <(<(Identifier (spOpt ',' spOpt Identifier)*)?> Action30)> */
 		func() bool {
-			position757, tokenIndex757 := position, tokenIndex
+			position799, tokenIndex799 := position, tokenIndex
 			{
-				position758 := position
+				position800 := position
 				{
-					position759, tokenIndex759 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l760
-					}
-					position++
-					goto l759
-				l760:
-					position, tokenIndex = position759, tokenIndex759
-					if buffer[position] != rune('E') {
-						goto l757
+					position801 := position
+					{
+						position802, tokenIndex802 := position, tokenIndex
+						if !_rules[ruleIdentifier]() {
+							goto l802
+						}
+					l804:
+						{
+							position805, tokenIndex805 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l805
+							}
+							if buffer[position] != rune(',') {
+								goto l805
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l805
+							}
+							if !_rules[ruleIdentifier]() {
+								goto l805
+							}
+							goto l804
+						l805:
+							position, tokenIndex = position805, tokenIndex805
+						}
+						goto l803
+					l802:
+						position, tokenIndex = position802, tokenIndex802
 					}
-					position++
+				l803:
+					add(rulePegText, position801)
 				}
-			l759:
-				{
-					position761, tokenIndex761 := position, tokenIndex
-					if buffer[position] != rune('v') {
-						goto l762
-					}
-					position++
-					goto l761
-				l762:
-					position, tokenIndex = position761, tokenIndex761
-					if buffer[position] != rune('V') {
-						goto l757
-					}
-					position++
+				if !_rules[ruleAction30]() {
+					goto l799
+				}
+				add(ruleFunctionParams, position800)
+			}
+			return true
+		l799:
+			position, tokenIndex = position799, tokenIndex799
+			return false
+		},
+		/* 38 Emitter <- <(sp (ISTREAM / DSTREAM / RSTREAM) EmitterOptions Action31)> */
+		func() bool {
+			position806, tokenIndex806 := position, tokenIndex
+			{
+				position807 := position
+				if !_rules[rulesp]() {
+					goto l806
 				}
-			l761:
 				{
-					position763, tokenIndex763 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l764
-					}
-					position++
-					goto l763
-				l764:
-					position, tokenIndex = position763, tokenIndex763
-					if buffer[position] != rune('E') {
-						goto l757
+					position808, tokenIndex808 := position, tokenIndex
+					if !_rules[ruleISTREAM]() {
+						goto l809
 					}
-					position++
-				}
-			l763:
-				{
-					position765, tokenIndex765 := position, tokenIndex
-					if buffer[position] != rune('r') {
-						goto l766
+					goto l808
+				l809:
+					position, tokenIndex = position808, tokenIndex808
+					if !_rules[ruleDSTREAM]() {
+						goto l810
 					}
-					position++
-					goto l765
-				l766:
-					position, tokenIndex = position765, tokenIndex765
-					if buffer[position] != rune('R') {
-						goto l757
+					goto l808
+				l810:
+					position, tokenIndex = position808, tokenIndex808
+					if !_rules[ruleRSTREAM]() {
+						goto l806
 					}
-					position++
 				}
-			l765:
+			l808:
+				if !_rules[ruleEmitterOptions]() {
+					goto l806
+				}
+				if !_rules[ruleAction31]() {
+					goto l806
+				}
+				add(ruleEmitter, position807)
+			}
+			return true
+		l806:
+			position, tokenIndex = position806, tokenIndex806
+			return false
+		},
+		/* 39 EmitterOptions <- <(<(spOpt '[' spOpt EmitterOptionCombinations spOpt ']')?> Action32)> */
+		func() bool {
+			position811, tokenIndex811 := position, tokenIndex
+			{
+				position812 := position
 				{
-					position767, tokenIndex767 := position, tokenIndex
-					if buffer[position] != rune('y') {
-						goto l768
-					}
-					position++
-					goto l767
-				l768:
-					position, tokenIndex = position767, tokenIndex767
-					if buffer[position] != rune('Y') {
-						goto l757
+					position813 := position
+					{
+						position814, tokenIndex814 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l814
+						}
+						if buffer[position] != rune('[') {
+							goto l814
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l814
+						}
+						if !_rules[ruleEmitterOptionCombinations]() {
+							goto l814
+						}
+						if !_rules[rulespOpt]() {
+							goto l814
+						}
+						if buffer[position] != rune(']') {
+							goto l814
+						}
+						position++
+						goto l815
+					l814:
+						position, tokenIndex = position814, tokenIndex814
 					}
-					position++
+				l815:
+					add(rulePegText, position813)
 				}
-			l767:
-				if !_rules[rulesp]() {
-					goto l757
+				if !_rules[ruleAction32]() {
+					goto l811
 				}
+				add(ruleEmitterOptions, position812)
+			}
+			return true
+		l811:
+			position, tokenIndex = position811, tokenIndex811
+			return false
+		},
+		/* 40 EmitterOptionCombinations <- <(EmitterLimit / (EmitterSample sp EmitterLimit) / EmitterSample)> */
+		func() bool {
+			position816, tokenIndex816 := position, tokenIndex
+			{
+				position817 := position
 				{
-					position769, tokenIndex769 := position, tokenIndex
-					if !_rules[ruleFloatLiteral]() {
-						goto l770
+					position818, tokenIndex818 := position, tokenIndex
+					if !_rules[ruleEmitterLimit]() {
+						goto l819
 					}
-					goto l769
-				l770:
-					position, tokenIndex = position769, tokenIndex769
-					if !_rules[ruleNumericLiteral]() {
-						goto l757
+					goto l818
+				l819:
+					position, tokenIndex = position818, tokenIndex818
+					if !_rules[ruleEmitterSample]() {
+						goto l820
+					}
+					if !_rules[rulesp]() {
+						goto l820
+					}
+					if !_rules[ruleEmitterLimit]() {
+						goto l820
+					}
+					goto l818
+				l820:
+					position, tokenIndex = position818, tokenIndex818
+					if !_rules[ruleEmitterSample]() {
+						goto l816
 					}
 				}
-			l769:
-				if !_rules[rulesp]() {
-					goto l757
-				}
+			l818:
+				add(ruleEmitterOptionCombinations, position817)
+			}
+			return true
+		l816:
+			position, tokenIndex = position816, tokenIndex816
+			return false
+		},
+		/* 41 EmitterLimit <- <(('l' / 'L') ('i' / 'I') ('m' / 'M') ('i' / 'I') ('t' / 'T') sp NumericLiteral Action33)> */
+		func() bool {
+			position821, tokenIndex821 := position, tokenIndex
+			{
+				position822 := position
 				{
-					position771, tokenIndex771 := position, tokenIndex
-					if buffer[position] != rune('m') {
-						goto l772
+					position823, tokenIndex823 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l824
 					}
 					position++
-					goto l771
-				l772:
-					position, tokenIndex = position771, tokenIndex771
-					if buffer[position] != rune('M') {
-						goto l757
+					goto l823
+				l824:
+					position, tokenIndex = position823, tokenIndex823
+					if buffer[position] != rune('L') {
+						goto l821
 					}
 					position++
 				}
-			l771:
+			l823:
 				{
-					position773, tokenIndex773 := position, tokenIndex
+					position825, tokenIndex825 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l774
+						goto l826
 					}
 					position++
-					goto l773
-				l774:
-					position, tokenIndex = position773, tokenIndex773
+					goto l825
+				l826:
+					position, tokenIndex = position825, tokenIndex825
 					if buffer[position] != rune('I') {
-						goto l757
+						goto l821
 					}
 					position++
 				}
-			l773:
+			l825:
 				{
-					position775, tokenIndex775 := position, tokenIndex
-					if buffer[position] != rune('l') {
-						goto l776
+					position827, tokenIndex827 := position, tokenIndex
+					if buffer[position] != rune('m') {
+						goto l828
 					}
 					position++
-					goto l775
-				l776:
-					position, tokenIndex = position775, tokenIndex775
-					if buffer[position] != rune('L') {
-						goto l757
+					goto l827
+				l828:
+					position, tokenIndex = position827, tokenIndex827
+					if buffer[position] != rune('M') {
+						goto l821
 					}
 					position++
 				}
-			l775:
+			l827:
 				{
-					position777, tokenIndex777 := position, tokenIndex
-					if buffer[position] != rune('l') {
-						goto l778
+					position829, tokenIndex829 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l830
 					}
 					position++
-					goto l777
-				l778:
-					position, tokenIndex = position777, tokenIndex777
-					if buffer[position] != rune('L') {
-						goto l757
+					goto l829
+				l830:
+					position, tokenIndex = position829, tokenIndex829
+					if buffer[position] != rune('I') {
+						goto l821
 					}
 					position++
 				}
-			l777:
+			l829:
 				{
-					position779, tokenIndex779 := position, tokenIndex
-					if buffer[position] != rune('i') {
-						goto l780
+					position831, tokenIndex831 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l832
 					}
 					position++
-					goto l779
-				l780:
-					position, tokenIndex = position779, tokenIndex779
-					if buffer[position] != rune('I') {
-						goto l757
+					goto l831
+				l832:
+					position, tokenIndex = position831, tokenIndex831
+					if buffer[position] != rune('T') {
+						goto l821
 					}
 					position++
 				}
-			l779:
+			l831:
+				if !_rules[rulesp]() {
+					goto l821
+				}
+				if !_rules[ruleNumericLiteral]() {
+					goto l821
+				}
+				if !_rules[ruleAction33]() {
+					goto l821
+				}
+				add(ruleEmitterLimit, position822)
+			}
+			return true
+		l821:
+			position, tokenIndex = position821, tokenIndex821
+			return false
+		},
+		/* 42 EmitterSample <- <(CountBasedSampling / RandomizedSampling / TimeBasedSampling)> */
+		func() bool {
+			position833, tokenIndex833 := position, tokenIndex
+			{
+				position834 := position
 				{
-					position781, tokenIndex781 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l782
+					position835, tokenIndex835 := position, tokenIndex
+					if !_rules[ruleCountBasedSampling]() {
+						goto l836
 					}
-					position++
-					goto l781
-				l782:
-					position, tokenIndex = position781, tokenIndex781
-					if buffer[position] != rune('S') {
-						goto l757
+					goto l835
+				l836:
+					position, tokenIndex = position835, tokenIndex835
+					if !_rules[ruleRandomizedSampling]() {
+						goto l837
+					}
+					goto l835
+				l837:
+					position, tokenIndex = position835, tokenIndex835
+					if !_rules[ruleTimeBasedSampling]() {
+						goto l833
 					}
-					position++
 				}
-			l781:
+			l835:
+				add(ruleEmitterSample, position834)
+			}
+			return true
+		l833:
+			position, tokenIndex = position833, tokenIndex833
+			return false
+		},
+		/* 43 CountBasedSampling <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp NumericLiteral spOpt '-'? spOpt ((('s' / 'S') ('t' / 'T')) / (('n' / 'N') ('d' / 'D')) / (('r' / 'R') ('d' / 'D')) / (('t' / 'T') ('h' / 'H'))) sp (('t' / 'T') ('u' / 'U') ('p' / 'P') ('l' / 'L') ('e' / 'E')) Action34)> */
+		func() bool {
+			position838, tokenIndex838 := position, tokenIndex
+			{
+				position839 := position
 				{
-					position783, tokenIndex783 := position, tokenIndex
+					position840, tokenIndex840 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l784
+						goto l841
 					}
 					position++
-					goto l783
-				l784:
-					position, tokenIndex = position783, tokenIndex783
+					goto l840
+				l841:
+					position, tokenIndex = position840, tokenIndex840
 					if buffer[position] != rune('E') {
-						goto l757
+						goto l838
 					}
 					position++
 				}
-			l783:
+			l840:
 				{
-					position785, tokenIndex785 := position, tokenIndex
-					if buffer[position] != rune('c') {
-						goto l786
+					position842, tokenIndex842 := position, tokenIndex
+					if buffer[position] != rune('v') {
+						goto l843
 					}
 					position++
-					goto l785
-				l786:
-					position, tokenIndex = position785, tokenIndex785
-					if buffer[position] != rune('C') {
-						goto l757
+					goto l842
+				l843:
+					position, tokenIndex = position842, tokenIndex842
+					if buffer[position] != rune('V') {
+						goto l838
 					}
 					position++
 				}
-			l785:
+			l842:
 				{
-					position787, tokenIndex787 := position, tokenIndex
-					if buffer[position] != rune('o') {
-						goto l788
+					position844, tokenIndex844 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l845
 					}
 					position++
-					goto l787
-				l788:
-					position, tokenIndex = position787, tokenIndex787
-					if buffer[position] != rune('O') {
-						goto l757
+					goto l844
+				l845:
+					position, tokenIndex = position844, tokenIndex844
+					if buffer[position] != rune('E') {
+						goto l838
 					}
 					position++
 				}
-			l787:
+			l844:
 				{
-					position789, tokenIndex789 := position, tokenIndex
-					if buffer[position] != rune('n') {
-						goto l790
+					position846, tokenIndex846 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l847
 					}
 					position++
-					goto l789
-				l790:
-					position, tokenIndex = position789, tokenIndex789
-					if buffer[position] != rune('N') {
-						goto l757
+					goto l846
+				l847:
+					position, tokenIndex = position846, tokenIndex846
+					if buffer[position] != rune('R') {
+						goto l838
 					}
 					position++
 				}
-			l789:
+			l846:
 				{
-					position791, tokenIndex791 := position, tokenIndex
-					if buffer[position] != rune('d') {
-						goto l792
+					position848, tokenIndex848 := position, tokenIndex
+					if buffer[position] != rune('y') {
+						goto l849
 					}
 					position++
-					goto l791
-				l792:
-					position, tokenIndex = position791, tokenIndex791
-					if buffer[position] != rune('D') {
-						goto l757
+					goto l848
+				l849:
+					position, tokenIndex = position848, tokenIndex848
+					if buffer[position] != rune('Y') {
+						goto l838
 					}
 					position++
 				}
-			l791:
+			l848:
+				if !_rules[rulesp]() {
+					goto l838
+				}
+				if !_rules[ruleNumericLiteral]() {
+					goto l838
+				}
+				if !_rules[rulespOpt]() {
+					goto l838
+				}
 				{
-					position793, tokenIndex793 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l794
-					}
-					position++
-					goto l793
-				l794:
-					position, tokenIndex = position793, tokenIndex793
-					if buffer[position] != rune('S') {
-						goto l757
+					position850, tokenIndex850 := position, tokenIndex
+					if buffer[position] != rune('-') {
+						goto l850
 					}
 					position++
+					goto l851
+				l850:
+					position, tokenIndex = position850, tokenIndex850
 				}
-			l793:
-				if !_rules[ruleAction30]() {
-					goto l757
+			l851:
+				if !_rules[rulespOpt]() {
+					goto l838
 				}
-				add(ruleTimeBasedSamplingMilliseconds, position758)
-			}
-			return true
-		l757:
-			position, tokenIndex = position757, tokenIndex757
-			return false
-		},
-		/* 40 Projections <- <(<(sp Projection (spOpt ',' spOpt Projection)*)> Action31)> */
-		func() bool {
-			position795, tokenIndex795 := position, tokenIndex
-			{
-				position796 := position
 				{
-					position797 := position
-					if !_rules[rulesp]() {
-						goto l795
+					position852, tokenIndex852 := position, tokenIndex
+					{
+						position854, tokenIndex854 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l855
+						}
+						position++
+						goto l854
+					l855:
+						position, tokenIndex = position854, tokenIndex854
+						if buffer[position] != rune('S') {
+							goto l853
+						}
+						position++
 					}
-					if !_rules[ruleProjection]() {
-						goto l795
+				l854:
+					{
+						position856, tokenIndex856 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l857
+						}
+						position++
+						goto l856
+					l857:
+						position, tokenIndex = position856, tokenIndex856
+						if buffer[position] != rune('T') {
+							goto l853
+						}
+						position++
 					}
-				l798:
+				l856:
+					goto l852
+				l853:
+					position, tokenIndex = position852, tokenIndex852
 					{
-						position799, tokenIndex799 := position, tokenIndex
-						if !_rules[rulespOpt]() {
-							goto l799
+						position859, tokenIndex859 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l860
 						}
-						if buffer[position] != rune(',') {
-							goto l799
+						position++
+						goto l859
+					l860:
+						position, tokenIndex = position859, tokenIndex859
+						if buffer[position] != rune('N') {
+							goto l858
 						}
 						position++
-						if !_rules[rulespOpt]() {
-							goto l799
+					}
+				l859:
+					{
+						position861, tokenIndex861 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l862
 						}
-						if !_rules[ruleProjection]() {
-							goto l799
+						position++
+						goto l861
+					l862:
+						position, tokenIndex = position861, tokenIndex861
+						if buffer[position] != rune('D') {
+							goto l858
 						}
-						goto l798
-					l799:
-						position, tokenIndex = position799, tokenIndex799
+						position++
 					}
-					add(rulePegText, position797)
-				}
-				if !_rules[ruleAction31]() {
-					goto l795
-				}
-				add(ruleProjections, position796)
-			}
-			return true
-		l795:
-			position, tokenIndex = position795, tokenIndex795
-			return false
-		},
-		/* 41 Projection <- <(AliasExpression / ExpressionOrWildcard)> */
-		func() bool {
-			position800, tokenIndex800 := position, tokenIndex
-			{
-				position801 := position
-				{
-					position802, tokenIndex802 := position, tokenIndex
-					if !_rules[ruleAliasExpression]() {
-						goto l803
+				l861:
+					goto l852
+				l858:
+					position, tokenIndex = position852, tokenIndex852
+					{
+						position864, tokenIndex864 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l865
+						}
+						position++
+						goto l864
+					l865:
+						position, tokenIndex = position864, tokenIndex864
+						if buffer[position] != rune('R') {
+							goto l863
+						}
+						position++
 					}
-					goto l802
-				l803:
-					position, tokenIndex = position802, tokenIndex802
-					if !_rules[ruleExpressionOrWildcard]() {
-						goto l800
+				l864:
+					{
+						position866, tokenIndex866 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l867
+						}
+						position++
+						goto l866
+					l867:
+						position, tokenIndex = position866, tokenIndex866
+						if buffer[position] != rune('D') {
+							goto l863
+						}
+						position++
 					}
+				l866:
+					goto l852
+				l863:
+					position, tokenIndex = position852, tokenIndex852
+					{
+						position868, tokenIndex868 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l869
+						}
+						position++
+						goto l868
+					l869:
+						position, tokenIndex = position868, tokenIndex868
+						if buffer[position] != rune('T') {
+							goto l838
+						}
+						position++
+					}
+				l868:
+					{
+						position870, tokenIndex870 := position, tokenIndex
+						if buffer[position] != rune('h') {
+							goto l871
+						}
+						position++
+						goto l870
+					l871:
+						position, tokenIndex = position870, tokenIndex870
+						if buffer[position] != rune('H') {
+							goto l838
+						}
+						position++
+					}
+				l870:
 				}
-			l802:
-				add(ruleProjection, position801)
-			}
-			return true
-		l800:
-			position, tokenIndex = position800, tokenIndex800
-			return false
-		},
-		/* 42 AliasExpression <- <(ExpressionOrWildcard sp (('a' / 'A') ('s' / 'S')) sp TargetIdentifier Action32)> */
-		func() bool {
-			position804, tokenIndex804 := position, tokenIndex
-			{
-				position805 := position
-				if !_rules[ruleExpressionOrWildcard]() {
-					goto l804
-				}
+			l852:
 				if !_rules[rulesp]() {
-					goto l804
+					goto l838
 				}
 				{
-					position806, tokenIndex806 := position, tokenIndex
-					if buffer[position] != rune('a') {
-						goto l807
+					position872, tokenIndex872 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l873
 					}
 					position++
-					goto l806
-				l807:
-					position, tokenIndex = position806, tokenIndex806
-					if buffer[position] != rune('A') {
-						goto l804
+					goto l872
+				l873:
+					position, tokenIndex = position872, tokenIndex872
+					if buffer[position] != rune('T') {
+						goto l838
 					}
 					position++
 				}
-			l806:
+			l872:
 				{
-					position808, tokenIndex808 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l809
+					position874, tokenIndex874 := position, tokenIndex
+					if buffer[position] != rune('u') {
+						goto l875
 					}
 					position++
-					goto l808
-				l809:
-					position, tokenIndex = position808, tokenIndex808
-					if buffer[position] != rune('S') {
-						goto l804
+					goto l874
+				l875:
+					position, tokenIndex = position874, tokenIndex874
+					if buffer[position] != rune('U') {
+						goto l838
 					}
 					position++
 				}
-			l808:
-				if !_rules[rulesp]() {
-					goto l804
-				}
-				if !_rules[ruleTargetIdentifier]() {
-					goto l804
+			l874:
+				{
+					position876, tokenIndex876 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l877
+					}
+					position++
+					goto l876
+				l877:
+					position, tokenIndex = position876, tokenIndex876
+					if buffer[position] != rune('P') {
+						goto l838
+					}
+					position++
 				}
-				if !_rules[ruleAction32]() {
-					goto l804
+			l876:
+				{
+					position878, tokenIndex878 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l879
+					}
+					position++
+					goto l878
+				l879:
+					position, tokenIndex = position878, tokenIndex878
+					if buffer[position] != rune('L') {
+						goto l838
+					}
+					position++
 				}
-				add(ruleAliasExpression, position805)
-			}
-			return true
-		l804:
-			position, tokenIndex = position804, tokenIndex804
-			return false
-		},
-		/* 43 WindowedFrom <- <(<(sp (('f' / 'F') ('r' / 'R') ('o' / 'O') ('m' / 'M')) sp Relations)?> Action33)> */
-		func() bool {
-			position810, tokenIndex810 := position, tokenIndex
-			{
-				position811 := position
+			l878:
 				{
-					position812 := position
-					{
-						position813, tokenIndex813 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l813
-						}
-						{
-							position815, tokenIndex815 := position, tokenIndex
-							if buffer[position] != rune('f') {
-								goto l816
-							}
-							position++
-							goto l815
-						l816:
-							position, tokenIndex = position815, tokenIndex815
-							if buffer[position] != rune('F') {
-								goto l813
-							}
-							position++
-						}
-					l815:
-						{
-							position817, tokenIndex817 := position, tokenIndex
-							if buffer[position] != rune('r') {
-								goto l818
-							}
-							position++
-							goto l817
-						l818:
-							position, tokenIndex = position817, tokenIndex817
-							if buffer[position] != rune('R') {
-								goto l813
-							}
-							position++
-						}
-					l817:
-						{
-							position819, tokenIndex819 := position, tokenIndex
-							if buffer[position] != rune('o') {
-								goto l820
-							}
-							position++
-							goto l819
-						l820:
-							position, tokenIndex = position819, tokenIndex819
-							if buffer[position] != rune('O') {
-								goto l813
-							}
-							position++
-						}
-					l819:
-						{
-							position821, tokenIndex821 := position, tokenIndex
-							if buffer[position] != rune('m') {
-								goto l822
-							}
-							position++
-							goto l821
-						l822:
-							position, tokenIndex = position821, tokenIndex821
-							if buffer[position] != rune('M') {
-								goto l813
-							}
-							position++
-						}
-					l821:
-						if !_rules[rulesp]() {
-							goto l813
-						}
-						if !_rules[ruleRelations]() {
-							goto l813
-						}
-						goto l814
-					l813:
-						position, tokenIndex = position813, tokenIndex813
+					position880, tokenIndex880 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l881
+					}
+					position++
+					goto l880
+				l881:
+					position, tokenIndex = position880, tokenIndex880
+					if buffer[position] != rune('E') {
+						goto l838
 					}
-				l814:
-					add(rulePegText, position812)
+					position++
 				}
-				if !_rules[ruleAction33]() {
-					goto l810
+			l880:
+				if !_rules[ruleAction34]() {
+					goto l838
 				}
-				add(ruleWindowedFrom, position811)
+				add(ruleCountBasedSampling, position839)
 			}
 			return true
-		l810:
-			position, tokenIndex = position810, tokenIndex810
+		l838:
+			position, tokenIndex = position838, tokenIndex838
 			return false
 		},
-		/* 44 Interval <- <(TimeInterval / TuplesInterval)> */
+		/* 44 RandomizedSampling <- <(('s' / 'S') ('a' / 'A') ('m' / 'M') ('p' / 'P') ('l' / 'L') ('e' / 'E') sp (FloatLiteral / NumericLiteral) spOpt '%' Action35)> */
 		func() bool {
-			position823, tokenIndex823 := position, tokenIndex
+			position882, tokenIndex882 := position, tokenIndex
 			{
-				position824 := position
+				position883 := position
 				{
-					position825, tokenIndex825 := position, tokenIndex
-					if !_rules[ruleTimeInterval]() {
-						goto l826
+					position884, tokenIndex884 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l885
 					}
-					goto l825
-				l826:
-					position, tokenIndex = position825, tokenIndex825
-					if !_rules[ruleTuplesInterval]() {
-						goto l823
+					position++
+					goto l884
+				l885:
+					position, tokenIndex = position884, tokenIndex884
+					if buffer[position] != rune('S') {
+						goto l882
 					}
+					position++
 				}
-			l825:
-				add(ruleInterval, position824)
-			}
-			return true
-		l823:
-			position, tokenIndex = position823, tokenIndex823
-			return false
-		},
-		/* 45 TimeInterval <- <((FloatLiteral / NumericLiteral) sp (SECONDS / MILLISECONDS) Action34)> */
-		func() bool {
-			position827, tokenIndex827 := position, tokenIndex
-			{
-				position828 := position
+			l884:
 				{
-					position829, tokenIndex829 := position, tokenIndex
-					if !_rules[ruleFloatLiteral]() {
-						goto l830
+					position886, tokenIndex886 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l887
 					}
-					goto l829
-				l830:
-					position, tokenIndex = position829, tokenIndex829
-					if !_rules[ruleNumericLiteral]() {
-						goto l827
+					position++
+					goto l886
+				l887:
+					position, tokenIndex = position886, tokenIndex886
+					if buffer[position] != rune('A') {
+						goto l882
 					}
+					position++
 				}
-			l829:
-				if !_rules[rulesp]() {
-					goto l827
-				}
+			l886:
 				{
-					position831, tokenIndex831 := position, tokenIndex
-					if !_rules[ruleSECONDS]() {
-						goto l832
+					position888, tokenIndex888 := position, tokenIndex
+					if buffer[position] != rune('m') {
+						goto l889
 					}
-					goto l831
-				l832:
-					position, tokenIndex = position831, tokenIndex831
-					if !_rules[ruleMILLISECONDS]() {
-						goto l827
+					position++
+					goto l888
+				l889:
+					position, tokenIndex = position888, tokenIndex888
+					if buffer[position] != rune('M') {
+						goto l882
 					}
+					position++
 				}
-			l831:
-				if !_rules[ruleAction34]() {
-					goto l827
-				}
-				add(ruleTimeInterval, position828)
-			}
-			return true
-		l827:
-			position, tokenIndex = position827, tokenIndex827
-			return false
-		},
-		/* 46 TuplesInterval <- <(NumericLiteral sp TUPLES Action35)> */
-		func() bool {
-			position833, tokenIndex833 := position, tokenIndex
-			{
-				position834 := position
-				if !_rules[ruleNumericLiteral]() {
-					goto l833
-				}
-				if !_rules[rulesp]() {
-					goto l833
-				}
-				if !_rules[ruleTUPLES]() {
-					goto l833
-				}
-				if !_rules[ruleAction35]() {
-					goto l833
-				}
-				add(ruleTuplesInterval, position834)
-			}
-			return true
-		l833:
-			position, tokenIndex = position833, tokenIndex833
-			return false
-		},
-		/* 47 Relations <- <(RelationLike (spOpt ',' spOpt RelationLike)*)> */
-		func() bool {
-			position835, tokenIndex835 := position, tokenIndex
-			{
-				position836 := position
-				if !_rules[ruleRelationLike]() {
-					goto l835
+			l888:
+				{
+					position890, tokenIndex890 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l891
+					}
+					position++
+					goto l890
+				l891:
+					position, tokenIndex = position890, tokenIndex890
+					if buffer[position] != rune('P') {
+						goto l882
+					}
+					position++
 				}
-			l837:
+			l890:
 				{
-					position838, tokenIndex838 := position, tokenIndex
-					if !_rules[rulespOpt]() {
-						goto l838
+					position892, tokenIndex892 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l893
 					}
-					if buffer[position] != rune(',') {
-						goto l838
+					position++
+					goto l892
+				l893:
+					position, tokenIndex = position892, tokenIndex892
+					if buffer[position] != rune('L') {
+						goto l882
 					}
 					position++
-					if !_rules[rulespOpt]() {
-						goto l838
+				}
+			l892:
+				{
+					position894, tokenIndex894 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l895
 					}
-					if !_rules[ruleRelationLike]() {
-						goto l838
+					position++
+					goto l894
+				l895:
+					position, tokenIndex = position894, tokenIndex894
+					if buffer[position] != rune('E') {
+						goto l882
 					}
-					goto l837
-				l838:
-					position, tokenIndex = position838, tokenIndex838
+					position++
+				}
+			l894:
+				if !_rules[rulesp]() {
+					goto l882
 				}
-				add(ruleRelations, position836)
-			}
-			return true
-		l835:
-			position, tokenIndex = position835, tokenIndex835
-			return false
-		},
-		/* 48 Filter <- <(<(sp (('w' / 'W') ('h' / 'H') ('e' / 'E') ('r' / 'R') ('e' / 'E')) sp Expression)?> Action36)> */
-		func() bool {
-			position839, tokenIndex839 := position, tokenIndex
-			{
-				position840 := position
 				{
-					position841 := position
-					{
-						position842, tokenIndex842 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l842
-						}
-						{
-							position844, tokenIndex844 := position, tokenIndex
-							if buffer[position] != rune('w') {
-								goto l845
-							}
-							position++
-							goto l844
-						l845:
-							position, tokenIndex = position844, tokenIndex844
-							if buffer[position] != rune('W') {
-								goto l842
-							}
-							position++
-						}
-					l844:
-						{
-							position846, tokenIndex846 := position, tokenIndex
-							if buffer[position] != rune('h') {
-								goto l847
-							}
-							position++
-							goto l846
-						l847:
-							position, tokenIndex = position846, tokenIndex846
-							if buffer[position] != rune('H') {
-								goto l842
-							}
-							position++
-						}
-					l846:
-						{
-							position848, tokenIndex848 := position, tokenIndex
-							if buffer[position] != rune('e') {
-								goto l849
-							}
-							position++
-							goto l848
-						l849:
-							position, tokenIndex = position848, tokenIndex848
-							if buffer[position] != rune('E') {
-								goto l842
-							}
-							position++
-						}
-					l848:
-						{
-							position850, tokenIndex850 := position, tokenIndex
-							if buffer[position] != rune('r') {
-								goto l851
-							}
-							position++
-							goto l850
-						l851:
-							position, tokenIndex = position850, tokenIndex850
-							if buffer[position] != rune('R') {
-								goto l842
-							}
-							position++
-						}
-					l850:
-						{
-							position852, tokenIndex852 := position, tokenIndex
-							if buffer[position] != rune('e') {
-								goto l853
-							}
-							position++
-							goto l852
-						l853:
-							position, tokenIndex = position852, tokenIndex852
-							if buffer[position] != rune('E') {
-								goto l842
-							}
-							position++
-						}
-					l852:
-						if !_rules[rulesp]() {
-							goto l842
-						}
-						if !_rules[ruleExpression]() {
-							goto l842
-						}
-						goto l843
-					l842:
-						position, tokenIndex = position842, tokenIndex842
+					position896, tokenIndex896 := position, tokenIndex
+					if !_rules[ruleFloatLiteral]() {
+						goto l897
+					}
+					goto l896
+				l897:
+					position, tokenIndex = position896, tokenIndex896
+					if !_rules[ruleNumericLiteral]() {
+						goto l882
 					}
-				l843:
-					add(rulePegText, position841)
 				}
-				if !_rules[ruleAction36]() {
-					goto l839
+			l896:
+				if !_rules[rulespOpt]() {
+					goto l882
+				}
+				if buffer[position] != rune('%') {
+					goto l882
+				}
+				position++
+				if !_rules[ruleAction35]() {
+					goto l882
 				}
-				add(ruleFilter, position840)
+				add(ruleRandomizedSampling, position883)
 			}
 			return true
-		l839:
-			position, tokenIndex = position839, tokenIndex839
+		l882:
+			position, tokenIndex = position882, tokenIndex882
 			return false
 		},
-		/* 49 Grouping <- <(<(sp (('g' / 'G') ('r' / 'R') ('o' / 'O') ('u' / 'U') ('p' / 'P')) sp (('b' / 'B') ('y' / 'Y')) sp GroupList)?> Action37)> */
+		/* 45 TimeBasedSampling <- <(TimeBasedSamplingSeconds / TimeBasedSamplingMilliseconds)> */
 		func() bool {
-			position854, tokenIndex854 := position, tokenIndex
+			position898, tokenIndex898 := position, tokenIndex
 			{
-				position855 := position
+				position899 := position
 				{
-					position856 := position
-					{
-						position857, tokenIndex857 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l857
-						}
-						{
-							position859, tokenIndex859 := position, tokenIndex
-							if buffer[position] != rune('g') {
-								goto l860
-							}
-							position++
-							goto l859
-						l860:
-							position, tokenIndex = position859, tokenIndex859
-							if buffer[position] != rune('G') {
-								goto l857
-							}
-							position++
-						}
-					l859:
-						{
-							position861, tokenIndex861 := position, tokenIndex
-							if buffer[position] != rune('r') {
-								goto l862
-							}
-							position++
-							goto l861
-						l862:
-							position, tokenIndex = position861, tokenIndex861
-							if buffer[position] != rune('R') {
-								goto l857
-							}
-							position++
-						}
-					l861:
-						{
-							position863, tokenIndex863 := position, tokenIndex
-							if buffer[position] != rune('o') {
-								goto l864
-							}
-							position++
-							goto l863
-						l864:
-							position, tokenIndex = position863, tokenIndex863
-							if buffer[position] != rune('O') {
-								goto l857
-							}
-							position++
-						}
-					l863:
-						{
-							position865, tokenIndex865 := position, tokenIndex
-							if buffer[position] != rune('u') {
-								goto l866
-							}
-							position++
-							goto l865
-						l866:
-							position, tokenIndex = position865, tokenIndex865
-							if buffer[position] != rune('U') {
-								goto l857
-							}
-							position++
-						}
-					l865:
-						{
-							position867, tokenIndex867 := position, tokenIndex
-							if buffer[position] != rune('p') {
-								goto l868
-							}
-							position++
-							goto l867
-						l868:
-							position, tokenIndex = position867, tokenIndex867
-							if buffer[position] != rune('P') {
-								goto l857
-							}
-							position++
-						}
-					l867:
-						if !_rules[rulesp]() {
-							goto l857
-						}
-						{
-							position869, tokenIndex869 := position, tokenIndex
-							if buffer[position] != rune('b') {
-								goto l870
-							}
-							position++
-							goto l869
-						l870:
-							position, tokenIndex = position869, tokenIndex869
-							if buffer[position] != rune('B') {
-								goto l857
-							}
-							position++
-						}
-					l869:
-						{
-							position871, tokenIndex871 := position, tokenIndex
-							if buffer[position] != rune('y') {
-								goto l872
-							}
-							position++
-							goto l871
-						l872:
-							position, tokenIndex = position871, tokenIndex871
-							if buffer[position] != rune('Y') {
-								goto l857
-							}
-							position++
-						}
-					l871:
-						if !_rules[rulesp]() {
-							goto l857
-						}
-						if !_rules[ruleGroupList]() {
-							goto l857
-						}
-						goto l858
-					l857:
-						position, tokenIndex = position857, tokenIndex857
+					position900, tokenIndex900 := position, tokenIndex
+					if !_rules[ruleTimeBasedSamplingSeconds]() {
+						goto l901
+					}
+					goto l900
+				l901:
+					position, tokenIndex = position900, tokenIndex900
+					if !_rules[ruleTimeBasedSamplingMilliseconds]() {
+						goto l898
 					}
-				l858:
-					add(rulePegText, position856)
-				}
-				if !_rules[ruleAction37]() {
-					goto l854
 				}
-				add(ruleGrouping, position855)
+			l900:
+				add(ruleTimeBasedSampling, position899)
 			}
 			return true
-		l854:
-			position, tokenIndex = position854, tokenIndex854
+		l898:
+			position, tokenIndex = position898, tokenIndex898
 			return false
 		},
-		/* 50 GroupList <- <(Expression (spOpt ',' spOpt Expression)*)> */
+		/* 46 TimeBasedSamplingSeconds <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp (FloatLiteral / NumericLiteral) sp (('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S')) Action36)> */
 		func() bool {
-			position873, tokenIndex873 := position, tokenIndex
+			position902, tokenIndex902 := position, tokenIndex
 			{
-				position874 := position
-				if !_rules[ruleExpression]() {
-					goto l873
-				}
-			l875:
+				position903 := position
 				{
-					position876, tokenIndex876 := position, tokenIndex
-					if !_rules[rulespOpt]() {
-						goto l876
+					position904, tokenIndex904 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l905
 					}
-					if buffer[position] != rune(',') {
-						goto l876
+					position++
+					goto l904
+				l905:
+					position, tokenIndex = position904, tokenIndex904
+					if buffer[position] != rune('E') {
+						goto l902
 					}
 					position++
-					if !_rules[rulespOpt]() {
-						goto l876
+				}
+			l904:
+				{
+					position906, tokenIndex906 := position, tokenIndex
+					if buffer[position] != rune('v') {
+						goto l907
 					}
-					if !_rules[ruleExpression]() {
-						goto l876
+					position++
+					goto l906
+				l907:
+					position, tokenIndex = position906, tokenIndex906
+					if buffer[position] != rune('V') {
+						goto l902
 					}
-					goto l875
-				l876:
-					position, tokenIndex = position876, tokenIndex876
-				}
-				add(ruleGroupList, position874)
-			}
-			return true
-		l873:
-			position, tokenIndex = position873, tokenIndex873
-			return false
-		},
-		/* 51 Having <- <(<(sp (('h' / 'H') ('a' / 'A') ('v' / 'V') ('i' / 'I') ('n' / 'N') ('g' / 'G')) sp Expression)?> Action38)> */
-		func() bool {
-			position877, tokenIndex877 := position, tokenIndex
-			{
-				position878 := position
-				{
-					position879 := position
-					{
-						position880, tokenIndex880 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l880
-						}
-						{
-							position882, tokenIndex882 := position, tokenIndex
-							if buffer[position] != rune('h') {
-								goto l883
-							}
-							position++
-							goto l882
-						l883:
-							position, tokenIndex = position882, tokenIndex882
-							if buffer[position] != rune('H') {
-								goto l880
-							}
-							position++
-						}
-					l882:
-						{
-							position884, tokenIndex884 := position, tokenIndex
-							if buffer[position] != rune('a') {
-								goto l885
-							}
-							position++
-							goto l884
-						l885:
-							position, tokenIndex = position884, tokenIndex884
-							if buffer[position] != rune('A') {
-								goto l880
-							}
-							position++
-						}
-					l884:
-						{
-							position886, tokenIndex886 := position, tokenIndex
-							if buffer[position] != rune('v') {
-								goto l887
-							}
-							position++
-							goto l886
-						l887:
-							position, tokenIndex = position886, tokenIndex886
-							if buffer[position] != rune('V') {
-								goto l880
-							}
-							position++
-						}
-					l886:
-						{
-							position888, tokenIndex888 := position, tokenIndex
-							if buffer[position] != rune('i') {
-								goto l889
-							}
-							position++
-							goto l888
-						l889:
-							position, tokenIndex = position888, tokenIndex888
-							if buffer[position] != rune('I') {
-								goto l880
-							}
-							position++
-						}
-					l888:
-						{
-							position890, tokenIndex890 := position, tokenIndex
-							if buffer[position] != rune('n') {
-								goto l891
-							}
-							position++
-							goto l890
-						l891:
-							position, tokenIndex = position890, tokenIndex890
-							if buffer[position] != rune('N') {
-								goto l880
-							}
-							position++
-						}
-					l890:
-						{
-							position892, tokenIndex892 := position, tokenIndex
-							if buffer[position] != rune('g') {
-								goto l893
-							}
-							position++
-							goto l892
-						l893:
-							position, tokenIndex = position892, tokenIndex892
-							if buffer[position] != rune('G') {
-								goto l880
-							}
-							position++
-						}
-					l892:
-						if !_rules[rulesp]() {
-							goto l880
-						}
-						if !_rules[ruleExpression]() {
-							goto l880
-						}
-						goto l881
-					l880:
-						position, tokenIndex = position880, tokenIndex880
-					}
-				l881:
-					add(rulePegText, position879)
-				}
-				if !_rules[ruleAction38]() {
-					goto l877
-				}
-				add(ruleHaving, position878)
-			}
-			return true
-		l877:
-			position, tokenIndex = position877, tokenIndex877
-			return false
-		},
-		/* 52 RelationLike <- <(AliasedStreamWindow / (StreamWindow Action39))> */
-		func() bool {
-			position894, tokenIndex894 := position, tokenIndex
-			{
-				position895 := position
-				{
-					position896, tokenIndex896 := position, tokenIndex
-					if !_rules[ruleAliasedStreamWindow]() {
-						goto l897
-					}
-					goto l896
-				l897:
-					position, tokenIndex = position896, tokenIndex896
-					if !_rules[ruleStreamWindow]() {
-						goto l894
-					}
-					if !_rules[ruleAction39]() {
-						goto l894
-					}
-				}
-			l896:
-				add(ruleRelationLike, position895)
-			}
-			return true
-		l894:
-			position, tokenIndex = position894, tokenIndex894
-			return false
-		},
-		/* 53 AliasedStreamWindow <- <(StreamWindow sp (('a' / 'A') ('s' / 'S')) sp Identifier Action40)> */
-		func() bool {
-			position898, tokenIndex898 := position, tokenIndex
-			{
-				position899 := position
-				if !_rules[ruleStreamWindow]() {
-					goto l898
-				}
-				if !_rules[rulesp]() {
-					goto l898
-				}
-				{
-					position900, tokenIndex900 := position, tokenIndex
-					if buffer[position] != rune('a') {
-						goto l901
-					}
-					position++
-					goto l900
-				l901:
-					position, tokenIndex = position900, tokenIndex900
-					if buffer[position] != rune('A') {
-						goto l898
-					}
-					position++
-				}
-			l900:
-				{
-					position902, tokenIndex902 := position, tokenIndex
-					if buffer[position] != rune('s') {
-						goto l903
-					}
-					position++
-					goto l902
-				l903:
-					position, tokenIndex = position902, tokenIndex902
-					if buffer[position] != rune('S') {
-						goto l898
-					}
-					position++
-				}
-			l902:
-				if !_rules[rulesp]() {
-					goto l898
-				}
-				if !_rules[ruleIdentifier]() {
-					goto l898
-				}
-				if !_rules[ruleAction40]() {
-					goto l898
-				}
-				add(ruleAliasedStreamWindow, position899)
-			}
-			return true
-		l898:
-			position, tokenIndex = position898, tokenIndex898
-			return false
-		},
-		/* 54 StreamWindow <- <(StreamLike spOpt '[' spOpt (('r' / 'R') ('a' / 'A') ('n' / 'N') ('g' / 'G') ('e' / 'E')) sp Interval CapacitySpecOpt SheddingSpecOpt spOpt ']' Action41)> */
-		func() bool {
-			position904, tokenIndex904 := position, tokenIndex
-			{
-				position905 := position
-				if !_rules[ruleStreamLike]() {
-					goto l904
-				}
-				if !_rules[rulespOpt]() {
-					goto l904
-				}
-				if buffer[position] != rune('[') {
-					goto l904
-				}
-				position++
-				if !_rules[rulespOpt]() {
-					goto l904
-				}
-				{
-					position906, tokenIndex906 := position, tokenIndex
-					if buffer[position] != rune('r') {
-						goto l907
-					}
-					position++
-					goto l906
-				l907:
-					position, tokenIndex = position906, tokenIndex906
-					if buffer[position] != rune('R') {
-						goto l904
-					}
-					position++
+					position++
 				}
 			l906:
 				{
 					position908, tokenIndex908 := position, tokenIndex
-					if buffer[position] != rune('a') {
+					if buffer[position] != rune('e') {
 						goto l909
 					}
 					position++
 					goto l908
 				l909:
 					position, tokenIndex = position908, tokenIndex908
-					if buffer[position] != rune('A') {
-						goto l904
+					if buffer[position] != rune('E') {
+						goto l902
 					}
 					position++
 				}
 			l908:
 				{
 					position910, tokenIndex910 := position, tokenIndex
-					if buffer[position] != rune('n') {
+					if buffer[position] != rune('r') {
 						goto l911
 					}
 					position++
 					goto l910
 				l911:
 					position, tokenIndex = position910, tokenIndex910
-					if buffer[position] != rune('N') {
-						goto l904
+					if buffer[position] != rune('R') {
+						goto l902
 					}
 					position++
 				}
 			l910:
 				{
 					position912, tokenIndex912 := position, tokenIndex
-					if buffer[position] != rune('g') {
+					if buffer[position] != rune('y') {
 						goto l913
 					}
 					position++
 					goto l912
 				l913:
 					position, tokenIndex = position912, tokenIndex912
-					if buffer[position] != rune('G') {
-						goto l904
+					if buffer[position] != rune('Y') {
+						goto l902
 					}
 					position++
 				}
 			l912:
+				if !_rules[rulesp]() {
+					goto l902
+				}
 				{
 					position914, tokenIndex914 := position, tokenIndex
-					if buffer[position] != rune('e') {
+					if !_rules[ruleFloatLiteral]() {
 						goto l915
 					}
-					position++
 					goto l914
 				l915:
 					position, tokenIndex = position914, tokenIndex914
-					if buffer[position] != rune('E') {
-						goto l904
+					if !_rules[ruleNumericLiteral]() {
+						goto l902
 					}
-					position++
 				}
 			l914:
 				if !_rules[rulesp]() {
-					goto l904
-				}
-				if !_rules[ruleInterval]() {
-					goto l904
-				}
-				if !_rules[ruleCapacitySpecOpt]() {
-					goto l904
-				}
-				if !_rules[ruleSheddingSpecOpt]() {
-					goto l904
-				}
-				if !_rules[rulespOpt]() {
-					goto l904
-				}
-				if buffer[position] != rune(']') {
-					goto l904
+					goto l902
 				}
-				position++
-				if !_rules[ruleAction41]() {
-					goto l904
+				{
+					position916, tokenIndex916 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l917
+					}
+					position++
+					goto l916
+				l917:
+					position, tokenIndex = position916, tokenIndex916
+					if buffer[position] != rune('S') {
+						goto l902
+					}
+					position++
 				}
-				add(ruleStreamWindow, position905)
-			}
-			return true
-		l904:
-			position, tokenIndex = position904, tokenIndex904
-			return false
-		},
-		/* 55 StreamLike <- <(UDSFFuncApp / Stream)> */
-		func() bool {
-			position916, tokenIndex916 := position, tokenIndex
-			{
-				position917 := position
+			l916:
 				{
 					position918, tokenIndex918 := position, tokenIndex
-					if !_rules[ruleUDSFFuncApp]() {
+					if buffer[position] != rune('e') {
 						goto l919
 					}
+					position++
 					goto l918
 				l919:
 					position, tokenIndex = position918, tokenIndex918
-					if !_rules[ruleStream]() {
-						goto l916
+					if buffer[position] != rune('E') {
+						goto l902
 					}
+					position++
 				}
 			l918:
-				add(ruleStreamLike, position917)
-			}
-			return true
-		l916:
-			position, tokenIndex = position916, tokenIndex916
-			return false
-		},
-		/* 56 UDSFFuncApp <- <(FuncAppWithoutOrderBy Action42)> */
-		func() bool {
-			position920, tokenIndex920 := position, tokenIndex
-			{
-				position921 := position
-				if !_rules[ruleFuncAppWithoutOrderBy]() {
+				{
+					position920, tokenIndex920 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l921
+					}
+					position++
 					goto l920
+				l921:
+					position, tokenIndex = position920, tokenIndex920
+					if buffer[position] != rune('C') {
+						goto l902
+					}
+					position++
 				}
-				if !_rules[ruleAction42]() {
-					goto l920
+			l920:
+				{
+					position922, tokenIndex922 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l923
+					}
+					position++
+					goto l922
+				l923:
+					position, tokenIndex = position922, tokenIndex922
+					if buffer[position] != rune('O') {
+						goto l902
+					}
+					position++
 				}
-				add(ruleUDSFFuncApp, position921)
-			}
-			return true
-		l920:
-			position, tokenIndex = position920, tokenIndex920
-			return false
-		},
-		/* 57 CapacitySpecOpt <- <(<(spOpt ',' spOpt (('b' / 'B') ('u' / 'U') ('f' / 'F') ('f' / 'F') ('e' / 'E') ('r' / 'R')) sp (('s' / 'S') ('i' / 'I') ('z' / 'Z') ('e' / 'E')) sp NonNegativeNumericLiteral)?> Action43)> */
-		func() bool {
-			position922, tokenIndex922 := position, tokenIndex
-			{
-				position923 := position
+			l922:
 				{
-					position924 := position
-					{
-						position925, tokenIndex925 := position, tokenIndex
-						if !_rules[rulespOpt]() {
-							goto l925
-						}
-						if buffer[position] != rune(',') {
-							goto l925
-						}
-						position++
-						if !_rules[rulespOpt]() {
-							goto l925
-						}
-						{
-							position927, tokenIndex927 := position, tokenIndex
-							if buffer[position] != rune('b') {
-								goto l928
-							}
-							position++
-							goto l927
-						l928:
-							position, tokenIndex = position927, tokenIndex927
-							if buffer[position] != rune('B') {
-								goto l925
-							}
-							position++
+					position924, tokenIndex924 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l925
+					}
+					position++
+					goto l924
+				l925:
+					position, tokenIndex = position924, tokenIndex924
+					if buffer[position] != rune('N') {
+						goto l902
+					}
+					position++
+				}
+			l924:
+				{
+					position926, tokenIndex926 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l927
+					}
+					position++
+					goto l926
+				l927:
+					position, tokenIndex = position926, tokenIndex926
+					if buffer[position] != rune('D') {
+						goto l902
+					}
+					position++
+				}
+			l926:
+				{
+					position928, tokenIndex928 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l929
+					}
+					position++
+					goto l928
+				l929:
+					position, tokenIndex = position928, tokenIndex928
+					if buffer[position] != rune('S') {
+						goto l902
+					}
+					position++
+				}
+			l928:
+				if !_rules[ruleAction36]() {
+					goto l902
+				}
+				add(ruleTimeBasedSamplingSeconds, position903)
+			}
+			return true
+		l902:
+			position, tokenIndex = position902, tokenIndex902
+			return false
+		},
+		/* 47 TimeBasedSamplingMilliseconds <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('r' / 'R') ('y' / 'Y') sp (FloatLiteral / NumericLiteral) sp (('m' / 'M') ('i' / 'I') ('l' / 'L') ('l' / 'L') ('i' / 'I') ('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S')) Action37)> */
+		func() bool {
+			position930, tokenIndex930 := position, tokenIndex
+			{
+				position931 := position
+				{
+					position932, tokenIndex932 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l933
+					}
+					position++
+					goto l932
+				l933:
+					position, tokenIndex = position932, tokenIndex932
+					if buffer[position] != rune('E') {
+						goto l930
+					}
+					position++
+				}
+			l932:
+				{
+					position934, tokenIndex934 := position, tokenIndex
+					if buffer[position] != rune('v') {
+						goto l935
+					}
+					position++
+					goto l934
+				l935:
+					position, tokenIndex = position934, tokenIndex934
+					if buffer[position] != rune('V') {
+						goto l930
+					}
+					position++
+				}
+			l934:
+				{
+					position936, tokenIndex936 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l937
+					}
+					position++
+					goto l936
+				l937:
+					position, tokenIndex = position936, tokenIndex936
+					if buffer[position] != rune('E') {
+						goto l930
+					}
+					position++
+				}
+			l936:
+				{
+					position938, tokenIndex938 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l939
+					}
+					position++
+					goto l938
+				l939:
+					position, tokenIndex = position938, tokenIndex938
+					if buffer[position] != rune('R') {
+						goto l930
+					}
+					position++
+				}
+			l938:
+				{
+					position940, tokenIndex940 := position, tokenIndex
+					if buffer[position] != rune('y') {
+						goto l941
+					}
+					position++
+					goto l940
+				l941:
+					position, tokenIndex = position940, tokenIndex940
+					if buffer[position] != rune('Y') {
+						goto l930
+					}
+					position++
+				}
+			l940:
+				if !_rules[rulesp]() {
+					goto l930
+				}
+				{
+					position942, tokenIndex942 := position, tokenIndex
+					if !_rules[ruleFloatLiteral]() {
+						goto l943
+					}
+					goto l942
+				l943:
+					position, tokenIndex = position942, tokenIndex942
+					if !_rules[ruleNumericLiteral]() {
+						goto l930
+					}
+				}
+			l942:
+				if !_rules[rulesp]() {
+					goto l930
+				}
+				{
+					position944, tokenIndex944 := position, tokenIndex
+					if buffer[position] != rune('m') {
+						goto l945
+					}
+					position++
+					goto l944
+				l945:
+					position, tokenIndex = position944, tokenIndex944
+					if buffer[position] != rune('M') {
+						goto l930
+					}
+					position++
+				}
+			l944:
+				{
+					position946, tokenIndex946 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l947
+					}
+					position++
+					goto l946
+				l947:
+					position, tokenIndex = position946, tokenIndex946
+					if buffer[position] != rune('I') {
+						goto l930
+					}
+					position++
+				}
+			l946:
+				{
+					position948, tokenIndex948 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l949
+					}
+					position++
+					goto l948
+				l949:
+					position, tokenIndex = position948, tokenIndex948
+					if buffer[position] != rune('L') {
+						goto l930
+					}
+					position++
+				}
+			l948:
+				{
+					position950, tokenIndex950 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l951
+					}
+					position++
+					goto l950
+				l951:
+					position, tokenIndex = position950, tokenIndex950
+					if buffer[position] != rune('L') {
+						goto l930
+					}
+					position++
+				}
+			l950:
+				{
+					position952, tokenIndex952 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l953
+					}
+					position++
+					goto l952
+				l953:
+					position, tokenIndex = position952, tokenIndex952
+					if buffer[position] != rune('I') {
+						goto l930
+					}
+					position++
+				}
+			l952:
+				{
+					position954, tokenIndex954 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l955
+					}
+					position++
+					goto l954
+				l955:
+					position, tokenIndex = position954, tokenIndex954
+					if buffer[position] != rune('S') {
+						goto l930
+					}
+					position++
+				}
+			l954:
+				{
+					position956, tokenIndex956 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l957
+					}
+					position++
+					goto l956
+				l957:
+					position, tokenIndex = position956, tokenIndex956
+					if buffer[position] != rune('E') {
+						goto l930
+					}
+					position++
+				}
+			l956:
+				{
+					position958, tokenIndex958 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l959
+					}
+					position++
+					goto l958
+				l959:
+					position, tokenIndex = position958, tokenIndex958
+					if buffer[position] != rune('C') {
+						goto l930
+					}
+					position++
+				}
+			l958:
+				{
+					position960, tokenIndex960 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l961
+					}
+					position++
+					goto l960
+				l961:
+					position, tokenIndex = position960, tokenIndex960
+					if buffer[position] != rune('O') {
+						goto l930
+					}
+					position++
+				}
+			l960:
+				{
+					position962, tokenIndex962 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l963
+					}
+					position++
+					goto l962
+				l963:
+					position, tokenIndex = position962, tokenIndex962
+					if buffer[position] != rune('N') {
+						goto l930
+					}
+					position++
+				}
+			l962:
+				{
+					position964, tokenIndex964 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l965
+					}
+					position++
+					goto l964
+				l965:
+					position, tokenIndex = position964, tokenIndex964
+					if buffer[position] != rune('D') {
+						goto l930
+					}
+					position++
+				}
+			l964:
+				{
+					position966, tokenIndex966 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l967
+					}
+					position++
+					goto l966
+				l967:
+					position, tokenIndex = position966, tokenIndex966
+					if buffer[position] != rune('S') {
+						goto l930
+					}
+					position++
+				}
+			l966:
+				if !_rules[ruleAction37]() {
+					goto l930
+				}
+				add(ruleTimeBasedSamplingMilliseconds, position931)
+			}
+			return true
+		l930:
+			position, tokenIndex = position930, tokenIndex930
+			return false
+		},
+		/* 48 DistinctOpt <- <(<(sp (('d' / 'D') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('i' / 'I') ('n' / 'N') ('c' / 'C') ('t' / 'T')) &sp)?> Action38)> */
+		func() bool {
+			position968, tokenIndex968 := position, tokenIndex
+			{
+				position969 := position
+				{
+					position970 := position
+					{
+						position971, tokenIndex971 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l971
 						}
-					l927:
 						{
-							position929, tokenIndex929 := position, tokenIndex
-							if buffer[position] != rune('u') {
-								goto l930
+							position973, tokenIndex973 := position, tokenIndex
+							if buffer[position] != rune('d') {
+								goto l974
 							}
 							position++
-							goto l929
-						l930:
-							position, tokenIndex = position929, tokenIndex929
-							if buffer[position] != rune('U') {
-								goto l925
+							goto l973
+						l974:
+							position, tokenIndex = position973, tokenIndex973
+							if buffer[position] != rune('D') {
+								goto l971
 							}
 							position++
 						}
-					l929:
+					l973:
 						{
-							position931, tokenIndex931 := position, tokenIndex
-							if buffer[position] != rune('f') {
-								goto l932
+							position975, tokenIndex975 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l976
 							}
 							position++
-							goto l931
-						l932:
-							position, tokenIndex = position931, tokenIndex931
-							if buffer[position] != rune('F') {
-								goto l925
+							goto l975
+						l976:
+							position, tokenIndex = position975, tokenIndex975
+							if buffer[position] != rune('I') {
+								goto l971
 							}
 							position++
 						}
-					l931:
+					l975:
 						{
-							position933, tokenIndex933 := position, tokenIndex
-							if buffer[position] != rune('f') {
-								goto l934
+							position977, tokenIndex977 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l978
 							}
 							position++
-							goto l933
-						l934:
-							position, tokenIndex = position933, tokenIndex933
-							if buffer[position] != rune('F') {
-								goto l925
+							goto l977
+						l978:
+							position, tokenIndex = position977, tokenIndex977
+							if buffer[position] != rune('S') {
+								goto l971
 							}
 							position++
 						}
-					l933:
+					l977:
 						{
-							position935, tokenIndex935 := position, tokenIndex
-							if buffer[position] != rune('e') {
-								goto l936
+							position979, tokenIndex979 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l980
 							}
 							position++
-							goto l935
-						l936:
-							position, tokenIndex = position935, tokenIndex935
-							if buffer[position] != rune('E') {
-								goto l925
+							goto l979
+						l980:
+							position, tokenIndex = position979, tokenIndex979
+							if buffer[position] != rune('T') {
+								goto l971
 							}
 							position++
 						}
-					l935:
+					l979:
 						{
-							position937, tokenIndex937 := position, tokenIndex
-							if buffer[position] != rune('r') {
-								goto l938
+							position981, tokenIndex981 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l982
 							}
 							position++
-							goto l937
-						l938:
-							position, tokenIndex = position937, tokenIndex937
-							if buffer[position] != rune('R') {
-								goto l925
+							goto l981
+						l982:
+							position, tokenIndex = position981, tokenIndex981
+							if buffer[position] != rune('I') {
+								goto l971
 							}
 							position++
 						}
-					l937:
-						if !_rules[rulesp]() {
-							goto l925
-						}
+					l981:
 						{
-							position939, tokenIndex939 := position, tokenIndex
-							if buffer[position] != rune('s') {
-								goto l940
+							position983, tokenIndex983 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l984
 							}
 							position++
-							goto l939
-						l940:
-							position, tokenIndex = position939, tokenIndex939
-							if buffer[position] != rune('S') {
-								goto l925
+							goto l983
+						l984:
+							position, tokenIndex = position983, tokenIndex983
+							if buffer[position] != rune('N') {
+								goto l971
 							}
 							position++
 						}
-					l939:
+					l983:
 						{
-							position941, tokenIndex941 := position, tokenIndex
-							if buffer[position] != rune('i') {
-								goto l942
+							position985, tokenIndex985 := position, tokenIndex
+							if buffer[position] != rune('c') {
+								goto l986
 							}
 							position++
-							goto l941
-						l942:
-							position, tokenIndex = position941, tokenIndex941
-							if buffer[position] != rune('I') {
-								goto l925
+							goto l985
+						l986:
+							position, tokenIndex = position985, tokenIndex985
+							if buffer[position] != rune('C') {
+								goto l971
 							}
 							position++
 						}
-					l941:
+					l985:
 						{
-							position943, tokenIndex943 := position, tokenIndex
-							if buffer[position] != rune('z') {
-								goto l944
+							position987, tokenIndex987 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l988
 							}
 							position++
-							goto l943
-						l944:
-							position, tokenIndex = position943, tokenIndex943
-							if buffer[position] != rune('Z') {
-								goto l925
+							goto l987
+						l988:
+							position, tokenIndex = position987, tokenIndex987
+							if buffer[position] != rune('T') {
+								goto l971
 							}
 							position++
 						}
-					l943:
+					l987:
 						{
-							position945, tokenIndex945 := position, tokenIndex
-							if buffer[position] != rune('e') {
-								goto l946
-							}
-							position++
-							goto l945
-						l946:
-							position, tokenIndex = position945, tokenIndex945
-							if buffer[position] != rune('E') {
-								goto l925
+							position989, tokenIndex989 := position, tokenIndex
+							if !_rules[rulesp]() {
+								goto l971
 							}
-							position++
-						}
-					l945:
-						if !_rules[rulesp]() {
-							goto l925
+							position, tokenIndex = position989, tokenIndex989
 						}
-						if !_rules[ruleNonNegativeNumericLiteral]() {
-							goto l925
-						}
-						goto l926
-					l925:
-						position, tokenIndex = position925, tokenIndex925
+						goto l972
+					l971:
+						position, tokenIndex = position971, tokenIndex971
 					}
-				l926:
-					add(rulePegText, position924)
+				l972:
+					add(rulePegText, position970)
 				}
-				if !_rules[ruleAction43]() {
-					goto l922
+				if !_rules[ruleAction38]() {
+					goto l968
 				}
-				add(ruleCapacitySpecOpt, position923)
+				add(ruleDistinctOpt, position969)
 			}
 			return true
-		l922:
-			position, tokenIndex = position922, tokenIndex922
+		l968:
+			position, tokenIndex = position968, tokenIndex968
 			return false
 		},
-		/* 58 SheddingSpecOpt <- <(<(spOpt ',' spOpt SheddingOption sp (('i' / 'I') ('f' / 'F')) sp (('f' / 'F') ('u' / 'U') ('l' / 'L') ('l' / 'L')))?> Action44)> */
+		/* 49 Projections <- <(<(sp Projection (spOpt ',' spOpt Projection)*)> Action39)> */
 		func() bool {
-			position947, tokenIndex947 := position, tokenIndex
+			position990, tokenIndex990 := position, tokenIndex
 			{
-				position948 := position
+				position991 := position
 				{
-					position949 := position
+					position992 := position
+					if !_rules[rulesp]() {
+						goto l990
+					}
+					if !_rules[ruleProjection]() {
+						goto l990
+					}
+				l993:
 					{
-						position950, tokenIndex950 := position, tokenIndex
+						position994, tokenIndex994 := position, tokenIndex
 						if !_rules[rulespOpt]() {
-							goto l950
+							goto l994
 						}
 						if buffer[position] != rune(',') {
-							goto l950
+							goto l994
 						}
 						position++
 						if !_rules[rulespOpt]() {
-							goto l950
-						}
-						if !_rules[ruleSheddingOption]() {
-							goto l950
-						}
-						if !_rules[rulesp]() {
-							goto l950
-						}
-						{
-							position952, tokenIndex952 := position, tokenIndex
-							if buffer[position] != rune('i') {
-								goto l953
-							}
-							position++
-							goto l952
-						l953:
-							position, tokenIndex = position952, tokenIndex952
-							if buffer[position] != rune('I') {
-								goto l950
-							}
-							position++
-						}
-					l952:
-						{
-							position954, tokenIndex954 := position, tokenIndex
-							if buffer[position] != rune('f') {
-								goto l955
-							}
-							position++
-							goto l954
-						l955:
-							position, tokenIndex = position954, tokenIndex954
-							if buffer[position] != rune('F') {
-								goto l950
-							}
-							position++
-						}
-					l954:
-						if !_rules[rulesp]() {
-							goto l950
-						}
-						{
-							position956, tokenIndex956 := position, tokenIndex
-							if buffer[position] != rune('f') {
-								goto l957
-							}
-							position++
-							goto l956
-						l957:
-							position, tokenIndex = position956, tokenIndex956
-							if buffer[position] != rune('F') {
-								goto l950
-							}
-							position++
-						}
-					l956:
-						{
-							position958, tokenIndex958 := position, tokenIndex
-							if buffer[position] != rune('u') {
-								goto l959
-							}
-							position++
-							goto l958
-						l959:
-							position, tokenIndex = position958, tokenIndex958
-							if buffer[position] != rune('U') {
-								goto l950
-							}
-							position++
-						}
-					l958:
-						{
-							position960, tokenIndex960 := position, tokenIndex
-							if buffer[position] != rune('l') {
-								goto l961
-							}
-							position++
-							goto l960
-						l961:
-							position, tokenIndex = position960, tokenIndex960
-							if buffer[position] != rune('L') {
-								goto l950
-							}
-							position++
+							goto l994
 						}
-					l960:
-						{
-							position962, tokenIndex962 := position, tokenIndex
-							if buffer[position] != rune('l') {
-								goto l963
-							}
-							position++
-							goto l962
-						l963:
-							position, tokenIndex = position962, tokenIndex962
-							if buffer[position] != rune('L') {
-								goto l950
-							}
-							position++
+						if !_rules[ruleProjection]() {
+							goto l994
 						}
-					l962:
-						goto l951
-					l950:
-						position, tokenIndex = position950, tokenIndex950
+						goto l993
+					l994:
+						position, tokenIndex = position994, tokenIndex994
 					}
-				l951:
-					add(rulePegText, position949)
+					add(rulePegText, position992)
 				}
-				if !_rules[ruleAction44]() {
-					goto l947
+				if !_rules[ruleAction39]() {
+					goto l990
+				}
+				add(ruleProjections, position991)
+			}
+			return true
+		l990:
+			position, tokenIndex = position990, tokenIndex990
+			return false
+		},
+		/* 50 Projection <- <(AliasExpression / ExpressionOrWildcard)> */
+		func() bool {
+			position995, tokenIndex995 := position, tokenIndex
+			{
+				position996 := position
+				{
+					position997, tokenIndex997 := position, tokenIndex
+					if !_rules[ruleAliasExpression]() {
+						goto l998
+					}
+					goto l997
+				l998:
+					position, tokenIndex = position997, tokenIndex997
+					if !_rules[ruleExpressionOrWildcard]() {
+						goto l995
+					}
 				}
-				add(ruleSheddingSpecOpt, position948)
+			l997:
+				add(ruleProjection, position996)
 			}
 			return true
-		l947:
-			position, tokenIndex = position947, tokenIndex947
+		l995:
+			position, tokenIndex = position995, tokenIndex995
 			return false
 		},
-		/* 59 SheddingOption <- <(Wait / DropOldest / DropNewest)> */
+		/* 51 AliasExpression <- <(ExpressionOrWildcard sp (('a' / 'A') ('s' / 'S')) sp TargetIdentifier Action40)> */
 		func() bool {
-			position964, tokenIndex964 := position, tokenIndex
+			position999, tokenIndex999 := position, tokenIndex
 			{
-				position965 := position
+				position1000 := position
+				if !_rules[ruleExpressionOrWildcard]() {
+					goto l999
+				}
+				if !_rules[rulesp]() {
+					goto l999
+				}
 				{
-					position966, tokenIndex966 := position, tokenIndex
-					if !_rules[ruleWait]() {
-						goto l967
+					position1001, tokenIndex1001 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l1002
 					}
-					goto l966
-				l967:
-					position, tokenIndex = position966, tokenIndex966
-					if !_rules[ruleDropOldest]() {
-						goto l968
+					position++
+					goto l1001
+				l1002:
+					position, tokenIndex = position1001, tokenIndex1001
+					if buffer[position] != rune('A') {
+						goto l999
 					}
-					goto l966
-				l968:
-					position, tokenIndex = position966, tokenIndex966
-					if !_rules[ruleDropNewest]() {
-						goto l964
+					position++
+				}
+			l1001:
+				{
+					position1003, tokenIndex1003 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1004
+					}
+					position++
+					goto l1003
+				l1004:
+					position, tokenIndex = position1003, tokenIndex1003
+					if buffer[position] != rune('S') {
+						goto l999
 					}
+					position++
 				}
-			l966:
-				add(ruleSheddingOption, position965)
+			l1003:
+				if !_rules[rulesp]() {
+					goto l999
+				}
+				if !_rules[ruleTargetIdentifier]() {
+					goto l999
+				}
+				if !_rules[ruleAction40]() {
+					goto l999
+				}
+				add(ruleAliasExpression, position1000)
 			}
 			return true
-		l964:
-			position, tokenIndex = position964, tokenIndex964
+		l999:
+			position, tokenIndex = position999, tokenIndex999
 			return false
 		},
-		/* 60 SourceSinkSpecs <- <(<(sp (('w' / 'W') ('i' / 'I') ('t' / 'T') ('h' / 'H')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)?> Action45)> */
+		/* 52 WindowedFrom <- <(<(sp (('f' / 'F') ('r' / 'R') ('o' / 'O') ('m' / 'M')) sp Relations)?> Action41)> */
 		func() bool {
-			position969, tokenIndex969 := position, tokenIndex
+			position1005, tokenIndex1005 := position, tokenIndex
 			{
-				position970 := position
+				position1006 := position
 				{
-					position971 := position
+					position1007 := position
 					{
-						position972, tokenIndex972 := position, tokenIndex
+						position1008, tokenIndex1008 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l972
+							goto l1008
 						}
 						{
-							position974, tokenIndex974 := position, tokenIndex
-							if buffer[position] != rune('w') {
-								goto l975
+							position1010, tokenIndex1010 := position, tokenIndex
+							if buffer[position] != rune('f') {
+								goto l1011
 							}
 							position++
-							goto l974
-						l975:
-							position, tokenIndex = position974, tokenIndex974
-							if buffer[position] != rune('W') {
-								goto l972
+							goto l1010
+						l1011:
+							position, tokenIndex = position1010, tokenIndex1010
+							if buffer[position] != rune('F') {
+								goto l1008
 							}
 							position++
 						}
-					l974:
+					l1010:
 						{
-							position976, tokenIndex976 := position, tokenIndex
-							if buffer[position] != rune('i') {
-								goto l977
+							position1012, tokenIndex1012 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1013
 							}
 							position++
-							goto l976
-						l977:
-							position, tokenIndex = position976, tokenIndex976
-							if buffer[position] != rune('I') {
-								goto l972
+							goto l1012
+						l1013:
+							position, tokenIndex = position1012, tokenIndex1012
+							if buffer[position] != rune('R') {
+								goto l1008
 							}
 							position++
 						}
-					l976:
+					l1012:
 						{
-							position978, tokenIndex978 := position, tokenIndex
-							if buffer[position] != rune('t') {
-								goto l979
+							position1014, tokenIndex1014 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1015
 							}
 							position++
-							goto l978
-						l979:
-							position, tokenIndex = position978, tokenIndex978
-							if buffer[position] != rune('T') {
-								goto l972
+							goto l1014
+						l1015:
+							position, tokenIndex = position1014, tokenIndex1014
+							if buffer[position] != rune('O') {
+								goto l1008
 							}
 							position++
 						}
-					l978:
+					l1014:
 						{
-							position980, tokenIndex980 := position, tokenIndex
-							if buffer[position] != rune('h') {
-								goto l981
+							position1016, tokenIndex1016 := position, tokenIndex
+							if buffer[position] != rune('m') {
+								goto l1017
 							}
 							position++
-							goto l980
-						l981:
-							position, tokenIndex = position980, tokenIndex980
-							if buffer[position] != rune('H') {
-								goto l972
+							goto l1016
+						l1017:
+							position, tokenIndex = position1016, tokenIndex1016
+							if buffer[position] != rune('M') {
+								goto l1008
 							}
 							position++
 						}
-					l980:
+					l1016:
 						if !_rules[rulesp]() {
-							goto l972
-						}
-						if !_rules[ruleSourceSinkParam]() {
-							goto l972
+							goto l1008
 						}
-					l982:
-						{
-							position983, tokenIndex983 := position, tokenIndex
-							if !_rules[rulespOpt]() {
-								goto l983
-							}
-							if buffer[position] != rune(',') {
-								goto l983
-							}
-							position++
-							if !_rules[rulespOpt]() {
-								goto l983
-							}
-							if !_rules[ruleSourceSinkParam]() {
-								goto l983
-							}
-							goto l982
-						l983:
-							position, tokenIndex = position983, tokenIndex983
+						if !_rules[ruleRelations]() {
+							goto l1008
 						}
-						goto l973
-					l972:
-						position, tokenIndex = position972, tokenIndex972
+						goto l1009
+					l1008:
+						position, tokenIndex = position1008, tokenIndex1008
 					}
-				l973:
-					add(rulePegText, position971)
+				l1009:
+					add(rulePegText, position1007)
 				}
-				if !_rules[ruleAction45]() {
-					goto l969
+				if !_rules[ruleAction41]() {
+					goto l1005
 				}
-				add(ruleSourceSinkSpecs, position970)
+				add(ruleWindowedFrom, position1006)
 			}
 			return true
-		l969:
-			position, tokenIndex = position969, tokenIndex969
+		l1005:
+			position, tokenIndex = position1005, tokenIndex1005
 			return false
 		},
-		/* 61 UpdateSourceSinkSpecs <- <(<(sp (('s' / 'S') ('e' / 'E') ('t' / 'T')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)> Action46)> */
+		/* 53 Interval <- <(TimeInterval / TuplesInterval)> */
 		func() bool {
-			position984, tokenIndex984 := position, tokenIndex
+			position1018, tokenIndex1018 := position, tokenIndex
 			{
-				position985 := position
+				position1019 := position
 				{
-					position986 := position
-					if !_rules[rulesp]() {
-						goto l984
+					position1020, tokenIndex1020 := position, tokenIndex
+					if !_rules[ruleTimeInterval]() {
+						goto l1021
 					}
-					{
-						position987, tokenIndex987 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l988
-						}
-						position++
-						goto l987
-					l988:
-						position, tokenIndex = position987, tokenIndex987
-						if buffer[position] != rune('S') {
-							goto l984
-						}
-						position++
+					goto l1020
+				l1021:
+					position, tokenIndex = position1020, tokenIndex1020
+					if !_rules[ruleTuplesInterval]() {
+						goto l1018
 					}
-				l987:
-					{
-						position989, tokenIndex989 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l990
-						}
-						position++
-						goto l989
-					l990:
-						position, tokenIndex = position989, tokenIndex989
-						if buffer[position] != rune('E') {
-							goto l984
-						}
-						position++
+				}
+			l1020:
+				add(ruleInterval, position1019)
+			}
+			return true
+		l1018:
+			position, tokenIndex = position1018, tokenIndex1018
+			return false
+		},
+		/* 54 TimeInterval <- <((FloatLiteral / NumericLiteral) sp (SECONDS / MILLISECONDS / MINUTES / HOURS) Action42)> */
+		func() bool {
+			position1022, tokenIndex1022 := position, tokenIndex
+			{
+				position1023 := position
+				{
+					position1024, tokenIndex1024 := position, tokenIndex
+					if !_rules[ruleFloatLiteral]() {
+						goto l1025
 					}
-				l989:
-					{
-						position991, tokenIndex991 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l992
-						}
-						position++
-						goto l991
-					l992:
-						position, tokenIndex = position991, tokenIndex991
-						if buffer[position] != rune('T') {
-							goto l984
-						}
-						position++
+					goto l1024
+				l1025:
+					position, tokenIndex = position1024, tokenIndex1024
+					if !_rules[ruleNumericLiteral]() {
+						goto l1022
 					}
-				l991:
-					if !_rules[rulesp]() {
-						goto l984
+				}
+			l1024:
+				if !_rules[rulesp]() {
+					goto l1022
+				}
+				{
+					position1026, tokenIndex1026 := position, tokenIndex
+					if !_rules[ruleSECONDS]() {
+						goto l1027
 					}
-					if !_rules[ruleSourceSinkParam]() {
-						goto l984
+					goto l1026
+				l1027:
+					position, tokenIndex = position1026, tokenIndex1026
+					if !_rules[ruleMILLISECONDS]() {
+						goto l1028
 					}
-				l993:
+					goto l1026
+				l1028:
+					position, tokenIndex = position1026, tokenIndex1026
+					if !_rules[ruleMINUTES]() {
+						goto l1029
+					}
+					goto l1026
+				l1029:
+					position, tokenIndex = position1026, tokenIndex1026
+					if !_rules[ruleHOURS]() {
+						goto l1022
+					}
+				}
+			l1026:
+				if !_rules[ruleAction42]() {
+					goto l1022
+				}
+				add(ruleTimeInterval, position1023)
+			}
+			return true
+		l1022:
+			position, tokenIndex = position1022, tokenIndex1022
+			return false
+		},
+		/* 55 TuplesInterval <- <(NumericLiteral sp TUPLES Action43)> */
+		func() bool {
+			position1030, tokenIndex1030 := position, tokenIndex
+			{
+				position1031 := position
+				if !_rules[ruleNumericLiteral]() {
+					goto l1030
+				}
+				if !_rules[rulesp]() {
+					goto l1030
+				}
+				if !_rules[ruleTUPLES]() {
+					goto l1030
+				}
+				if !_rules[ruleAction43]() {
+					goto l1030
+				}
+				add(ruleTuplesInterval, position1031)
+			}
+			return true
+		l1030:
+			position, tokenIndex = position1030, tokenIndex1030
+			return false
+		},
+		/* 56 Relations <- <(RelationLike (JoinedRelation / (spOpt ',' spOpt RelationLike))* (spOpt ',' spOpt Unnest)* StateJoin*)> */
+		func() bool {
+			position1032, tokenIndex1032 := position, tokenIndex
+			{
+				position1033 := position
+				if !_rules[ruleRelationLike]() {
+					goto l1032
+				}
+			l1034:
+				{
+					position1035, tokenIndex1035 := position, tokenIndex
 					{
-						position994, tokenIndex994 := position, tokenIndex
+						position1036, tokenIndex1036 := position, tokenIndex
+						if !_rules[ruleJoinedRelation]() {
+							goto l1037
+						}
+						goto l1036
+					l1037:
+						position, tokenIndex = position1036, tokenIndex1036
 						if !_rules[rulespOpt]() {
-							goto l994
+							goto l1035
 						}
 						if buffer[position] != rune(',') {
-							goto l994
+							goto l1035
 						}
 						position++
 						if !_rules[rulespOpt]() {
-							goto l994
+							goto l1035
 						}
-						if !_rules[ruleSourceSinkParam]() {
-							goto l994
+						if !_rules[ruleRelationLike]() {
+							goto l1035
 						}
-						goto l993
-					l994:
-						position, tokenIndex = position994, tokenIndex994
 					}
-					add(rulePegText, position986)
+				l1036:
+					goto l1034
+				l1035:
+					position, tokenIndex = position1035, tokenIndex1035
 				}
-				if !_rules[ruleAction46]() {
-					goto l984
+			l1038:
+				{
+					position1039, tokenIndex1039 := position, tokenIndex
+					if !_rules[rulespOpt]() {
+						goto l1039
+					}
+					if buffer[position] != rune(',') {
+						goto l1039
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1039
+					}
+					if !_rules[ruleUnnest]() {
+						goto l1039
+					}
+					goto l1038
+				l1039:
+					position, tokenIndex = position1039, tokenIndex1039
+				}
+			l1040:
+				{
+					position1041, tokenIndex1041 := position, tokenIndex
+					if !_rules[ruleStateJoin]() {
+						goto l1041
+					}
+					goto l1040
+				l1041:
+					position, tokenIndex = position1041, tokenIndex1041
 				}
-				add(ruleUpdateSourceSinkSpecs, position985)
+				add(ruleRelations, position1033)
 			}
 			return true
-		l984:
-			position, tokenIndex = position984, tokenIndex984
+		l1032:
+			position, tokenIndex = position1032, tokenIndex1032
 			return false
 		},
-		/* 62 SetOptSpecs <- <(<(sp (('s' / 'S') ('e' / 'E') ('t' / 'T')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)?> Action47)> */
+		/* 57 JoinedRelation <- <(sp JoinType sp RelationLike sp (('o' / 'O') ('n' / 'N')) sp Expression Action44)> */
 		func() bool {
-			position995, tokenIndex995 := position, tokenIndex
+			position1042, tokenIndex1042 := position, tokenIndex
 			{
-				position996 := position
-				{
-					position997 := position
-					{
-						position998, tokenIndex998 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l998
-						}
-						{
-							position1000, tokenIndex1000 := position, tokenIndex
-							if buffer[position] != rune('s') {
-								goto l1001
-							}
-							position++
-							goto l1000
-						l1001:
-							position, tokenIndex = position1000, tokenIndex1000
-							if buffer[position] != rune('S') {
-								goto l998
-							}
-							position++
-						}
-					l1000:
-						{
-							position1002, tokenIndex1002 := position, tokenIndex
-							if buffer[position] != rune('e') {
-								goto l1003
-							}
-							position++
-							goto l1002
-						l1003:
-							position, tokenIndex = position1002, tokenIndex1002
-							if buffer[position] != rune('E') {
-								goto l998
-							}
-							position++
-						}
-					l1002:
-						{
-							position1004, tokenIndex1004 := position, tokenIndex
-							if buffer[position] != rune('t') {
-								goto l1005
-							}
-							position++
-							goto l1004
-						l1005:
-							position, tokenIndex = position1004, tokenIndex1004
-							if buffer[position] != rune('T') {
-								goto l998
-							}
-							position++
-						}
-					l1004:
-						if !_rules[rulesp]() {
-							goto l998
-						}
-						if !_rules[ruleSourceSinkParam]() {
-							goto l998
-						}
-					l1006:
-						{
-							position1007, tokenIndex1007 := position, tokenIndex
-							if !_rules[rulespOpt]() {
-								goto l1007
-							}
-							if buffer[position] != rune(',') {
-								goto l1007
-							}
-							position++
-							if !_rules[rulespOpt]() {
-								goto l1007
-							}
-							if !_rules[ruleSourceSinkParam]() {
-								goto l1007
-							}
-							goto l1006
-						l1007:
-							position, tokenIndex = position1007, tokenIndex1007
-						}
-						goto l999
-					l998:
-						position, tokenIndex = position998, tokenIndex998
-					}
-				l999:
-					add(rulePegText, position997)
-				}
-				if !_rules[ruleAction47]() {
-					goto l995
+				position1043 := position
+				if !_rules[rulesp]() {
+					goto l1042
 				}
-				add(ruleSetOptSpecs, position996)
-			}
-			return true
-		l995:
-			position, tokenIndex = position995, tokenIndex995
-			return false
-		},
-		/* 63 StateTagOpt <- <(<(sp (('t' / 'T') ('a' / 'A') ('g' / 'G')) sp Identifier)?> Action48)> */
-		func() bool {
-			position1008, tokenIndex1008 := position, tokenIndex
-			{
-				position1009 := position
-				{
-					position1010 := position
-					{
-						position1011, tokenIndex1011 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l1011
-						}
-						{
-							position1013, tokenIndex1013 := position, tokenIndex
-							if buffer[position] != rune('t') {
-								goto l1014
-							}
-							position++
-							goto l1013
-						l1014:
-							position, tokenIndex = position1013, tokenIndex1013
-							if buffer[position] != rune('T') {
-								goto l1011
-							}
-							position++
-						}
-					l1013:
-						{
-							position1015, tokenIndex1015 := position, tokenIndex
-							if buffer[position] != rune('a') {
-								goto l1016
-							}
-							position++
-							goto l1015
-						l1016:
-							position, tokenIndex = position1015, tokenIndex1015
-							if buffer[position] != rune('A') {
-								goto l1011
-							}
-							position++
-						}
-					l1015:
-						{
-							position1017, tokenIndex1017 := position, tokenIndex
-							if buffer[position] != rune('g') {
-								goto l1018
-							}
-							position++
-							goto l1017
-						l1018:
-							position, tokenIndex = position1017, tokenIndex1017
-							if buffer[position] != rune('G') {
-								goto l1011
-							}
-							position++
-						}
-					l1017:
-						if !_rules[rulesp]() {
-							goto l1011
-						}
-						if !_rules[ruleIdentifier]() {
-							goto l1011
-						}
-						goto l1012
-					l1011:
-						position, tokenIndex = position1011, tokenIndex1011
-					}
-				l1012:
-					add(rulePegText, position1010)
+				if !_rules[ruleJoinType]() {
+					goto l1042
 				}
-				if !_rules[ruleAction48]() {
-					goto l1008
+				if !_rules[rulesp]() {
+					goto l1042
 				}
-				add(ruleStateTagOpt, position1009)
-			}
-			return true
-		l1008:
-			position, tokenIndex = position1008, tokenIndex1008
-			return false
-		},
-		/* 64 SourceSinkParam <- <(SourceSinkParamKey spOpt '=' spOpt SourceSinkParamVal Action49)> */
-		func() bool {
-			position1019, tokenIndex1019 := position, tokenIndex
-			{
-				position1020 := position
-				if !_rules[ruleSourceSinkParamKey]() {
-					goto l1019
+				if !_rules[ruleRelationLike]() {
+					goto l1042
 				}
-				if !_rules[rulespOpt]() {
-					goto l1019
+				if !_rules[rulesp]() {
+					goto l1042
 				}
-				if buffer[position] != rune('=') {
-					goto l1019
+				{
+					position1044, tokenIndex1044 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l1045
+					}
+					position++
+					goto l1044
+				l1045:
+					position, tokenIndex = position1044, tokenIndex1044
+					if buffer[position] != rune('O') {
+						goto l1042
+					}
+					position++
 				}
-				position++
-				if !_rules[rulespOpt]() {
-					goto l1019
+			l1044:
+				{
+					position1046, tokenIndex1046 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l1047
+					}
+					position++
+					goto l1046
+				l1047:
+					position, tokenIndex = position1046, tokenIndex1046
+					if buffer[position] != rune('N') {
+						goto l1042
+					}
+					position++
 				}
-				if !_rules[ruleSourceSinkParamVal]() {
-					goto l1019
+			l1046:
+				if !_rules[rulesp]() {
+					goto l1042
 				}
-				if !_rules[ruleAction49]() {
-					goto l1019
+				if !_rules[ruleExpression]() {
+					goto l1042
 				}
-				add(ruleSourceSinkParam, position1020)
-			}
-			return true
-		l1019:
-			position, tokenIndex = position1019, tokenIndex1019
-			return false
-		},
-		/* 65 SourceSinkParamVal <- <ParamLiteral> */
-		func() bool {
-			position1021, tokenIndex1021 := position, tokenIndex
-			{
-				position1022 := position
-				if !_rules[ruleParamLiteral]() {
-					goto l1021
+				if !_rules[ruleAction44]() {
+					goto l1042
 				}
-				add(ruleSourceSinkParamVal, position1022)
+				add(ruleJoinedRelation, position1043)
 			}
 			return true
-		l1021:
-			position, tokenIndex = position1021, tokenIndex1021
+		l1042:
+			position, tokenIndex = position1042, tokenIndex1042
 			return false
 		},
-		/* 66 ParamLiteral <- <(BooleanLiteral / Literal / ParamArrayExpr / ParamMapExpr)> */
+		/* 58 Unnest <- <(('u' / 'U') ('n' / 'N') ('n' / 'N') ('e' / 'E') ('s' / 'S') ('t' / 'T') spOpt '(' spOpt Expression spOpt ')' WithOrdinalityOpt sp (('a' / 'A') ('s' / 'S')) sp Identifier Action45)> */
 		func() bool {
-			position1023, tokenIndex1023 := position, tokenIndex
+			position1048, tokenIndex1048 := position, tokenIndex
 			{
-				position1024 := position
+				position1049 := position
 				{
-					position1025, tokenIndex1025 := position, tokenIndex
-					if !_rules[ruleBooleanLiteral]() {
-						goto l1026
-					}
-					goto l1025
-				l1026:
-					position, tokenIndex = position1025, tokenIndex1025
-					if !_rules[ruleLiteral]() {
-						goto l1027
-					}
-					goto l1025
-				l1027:
-					position, tokenIndex = position1025, tokenIndex1025
-					if !_rules[ruleParamArrayExpr]() {
-						goto l1028
+					position1050, tokenIndex1050 := position, tokenIndex
+					if buffer[position] != rune('u') {
+						goto l1051
 					}
-					goto l1025
-				l1028:
-					position, tokenIndex = position1025, tokenIndex1025
-					if !_rules[ruleParamMapExpr]() {
-						goto l1023
+					position++
+					goto l1050
+				l1051:
+					position, tokenIndex = position1050, tokenIndex1050
+					if buffer[position] != rune('U') {
+						goto l1048
 					}
+					position++
 				}
-			l1025:
-				add(ruleParamLiteral, position1024)
-			}
-			return true
-		l1023:
-			position, tokenIndex = position1023, tokenIndex1023
-			return false
-		},
-		/* 67 ParamArrayExpr <- <(<('[' spOpt (ParamLiteral (',' spOpt ParamLiteral)*)? spOpt ','? spOpt ']')> Action50)> */
-		func() bool {
-			position1029, tokenIndex1029 := position, tokenIndex
-			{
-				position1030 := position
+			l1050:
 				{
-					position1031 := position
-					if buffer[position] != rune('[') {
-						goto l1029
+					position1052, tokenIndex1052 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l1053
 					}
 					position++
-					if !_rules[rulespOpt]() {
-						goto l1029
-					}
-					{
-						position1032, tokenIndex1032 := position, tokenIndex
-						if !_rules[ruleParamLiteral]() {
-							goto l1032
-						}
-					l1034:
-						{
-							position1035, tokenIndex1035 := position, tokenIndex
-							if buffer[position] != rune(',') {
-								goto l1035
-							}
-							position++
-							if !_rules[rulespOpt]() {
-								goto l1035
-							}
-							if !_rules[ruleParamLiteral]() {
-								goto l1035
-							}
-							goto l1034
-						l1035:
-							position, tokenIndex = position1035, tokenIndex1035
-						}
-						goto l1033
-					l1032:
-						position, tokenIndex = position1032, tokenIndex1032
-					}
-				l1033:
-					if !_rules[rulespOpt]() {
-						goto l1029
-					}
-					{
-						position1036, tokenIndex1036 := position, tokenIndex
-						if buffer[position] != rune(',') {
-							goto l1036
-						}
-						position++
-						goto l1037
-					l1036:
-						position, tokenIndex = position1036, tokenIndex1036
-					}
-				l1037:
-					if !_rules[rulespOpt]() {
-						goto l1029
-					}
-					if buffer[position] != rune(']') {
-						goto l1029
+					goto l1052
+				l1053:
+					position, tokenIndex = position1052, tokenIndex1052
+					if buffer[position] != rune('N') {
+						goto l1048
 					}
 					position++
-					add(rulePegText, position1031)
 				}
-				if !_rules[ruleAction50]() {
-					goto l1029
-				}
-				add(ruleParamArrayExpr, position1030)
-			}
-			return true
-		l1029:
-			position, tokenIndex = position1029, tokenIndex1029
-			return false
-		},
-		/* 68 ParamMapExpr <- <(<('{' spOpt (ParamKeyValuePair (spOpt ',' spOpt ParamKeyValuePair)*)? spOpt '}')> Action51)> */
-		func() bool {
-			position1038, tokenIndex1038 := position, tokenIndex
-			{
-				position1039 := position
+			l1052:
 				{
-					position1040 := position
-					if buffer[position] != rune('{') {
-						goto l1038
+					position1054, tokenIndex1054 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l1055
 					}
 					position++
-					if !_rules[rulespOpt]() {
-						goto l1038
-					}
-					{
-						position1041, tokenIndex1041 := position, tokenIndex
-						if !_rules[ruleParamKeyValuePair]() {
-							goto l1041
-						}
-					l1043:
-						{
-							position1044, tokenIndex1044 := position, tokenIndex
-							if !_rules[rulespOpt]() {
-								goto l1044
-							}
-							if buffer[position] != rune(',') {
-								goto l1044
-							}
-							position++
-							if !_rules[rulespOpt]() {
-								goto l1044
-							}
-							if !_rules[ruleParamKeyValuePair]() {
-								goto l1044
-							}
-							goto l1043
-						l1044:
-							position, tokenIndex = position1044, tokenIndex1044
-						}
-						goto l1042
-					l1041:
-						position, tokenIndex = position1041, tokenIndex1041
+					goto l1054
+				l1055:
+					position, tokenIndex = position1054, tokenIndex1054
+					if buffer[position] != rune('N') {
+						goto l1048
 					}
-				l1042:
-					if !_rules[rulespOpt]() {
-						goto l1038
+					position++
+				}
+			l1054:
+				{
+					position1056, tokenIndex1056 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1057
 					}
-					if buffer[position] != rune('}') {
-						goto l1038
+					position++
+					goto l1056
+				l1057:
+					position, tokenIndex = position1056, tokenIndex1056
+					if buffer[position] != rune('E') {
+						goto l1048
 					}
 					position++
-					add(rulePegText, position1040)
-				}
-				if !_rules[ruleAction51]() {
-					goto l1038
 				}
-				add(ruleParamMapExpr, position1039)
-			}
-			return true
-		l1038:
-			position, tokenIndex = position1038, tokenIndex1038
-			return false
-		},
-		/* 69 ParamKeyValuePair <- <(<(StringLiteral spOpt ':' spOpt ParamLiteral)> Action52)> */
-		func() bool {
-			position1045, tokenIndex1045 := position, tokenIndex
-			{
-				position1046 := position
+			l1056:
 				{
-					position1047 := position
-					if !_rules[ruleStringLiteral]() {
-						goto l1045
-					}
-					if !_rules[rulespOpt]() {
-						goto l1045
+					position1058, tokenIndex1058 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1059
 					}
-					if buffer[position] != rune(':') {
-						goto l1045
+					position++
+					goto l1058
+				l1059:
+					position, tokenIndex = position1058, tokenIndex1058
+					if buffer[position] != rune('S') {
+						goto l1048
 					}
 					position++
-					if !_rules[rulespOpt]() {
-						goto l1045
+				}
+			l1058:
+				{
+					position1060, tokenIndex1060 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l1061
 					}
-					if !_rules[ruleParamLiteral]() {
-						goto l1045
+					position++
+					goto l1060
+				l1061:
+					position, tokenIndex = position1060, tokenIndex1060
+					if buffer[position] != rune('T') {
+						goto l1048
 					}
-					add(rulePegText, position1047)
+					position++
 				}
-				if !_rules[ruleAction52]() {
-					goto l1045
+			l1060:
+				if !_rules[rulespOpt]() {
+					goto l1048
 				}
-				add(ruleParamKeyValuePair, position1046)
-			}
-			return true
-		l1045:
-			position, tokenIndex = position1045, tokenIndex1045
-			return false
-		},
-		/* 70 PausedOpt <- <(<(sp (Paused / Unpaused))?> Action53)> */
-		func() bool {
-			position1048, tokenIndex1048 := position, tokenIndex
-			{
-				position1049 := position
-				{
-					position1050 := position
-					{
-						position1051, tokenIndex1051 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l1051
-						}
-						{
-							position1053, tokenIndex1053 := position, tokenIndex
-							if !_rules[rulePaused]() {
-								goto l1054
-							}
-							goto l1053
-						l1054:
-							position, tokenIndex = position1053, tokenIndex1053
-							if !_rules[ruleUnpaused]() {
-								goto l1051
-							}
-						}
-					l1053:
-						goto l1052
-					l1051:
-						position, tokenIndex = position1051, tokenIndex1051
-					}
-				l1052:
-					add(rulePegText, position1050)
+				if buffer[position] != rune('(') {
+					goto l1048
 				}
-				if !_rules[ruleAction53]() {
+				position++
+				if !_rules[rulespOpt]() {
+					goto l1048
+				}
+				if !_rules[ruleExpression]() {
+					goto l1048
+				}
+				if !_rules[rulespOpt]() {
+					goto l1048
+				}
+				if buffer[position] != rune(')') {
+					goto l1048
+				}
+				position++
+				if !_rules[ruleWithOrdinalityOpt]() {
+					goto l1048
+				}
+				if !_rules[rulesp]() {
 					goto l1048
 				}
-				add(rulePausedOpt, position1049)
-			}
-			return true
-		l1048:
-			position, tokenIndex = position1048, tokenIndex1048
-			return false
-		},
-		/* 71 ExpressionOrWildcard <- <(Wildcard / Expression)> */
-		func() bool {
-			position1055, tokenIndex1055 := position, tokenIndex
-			{
-				position1056 := position
 				{
-					position1057, tokenIndex1057 := position, tokenIndex
-					if !_rules[ruleWildcard]() {
-						goto l1058
+					position1062, tokenIndex1062 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l1063
 					}
-					goto l1057
-				l1058:
-					position, tokenIndex = position1057, tokenIndex1057
-					if !_rules[ruleExpression]() {
-						goto l1055
+					position++
+					goto l1062
+				l1063:
+					position, tokenIndex = position1062, tokenIndex1062
+					if buffer[position] != rune('A') {
+						goto l1048
 					}
+					position++
 				}
-			l1057:
-				add(ruleExpressionOrWildcard, position1056)
-			}
-			return true
-		l1055:
-			position, tokenIndex = position1055, tokenIndex1055
-			return false
-		},
-		/* 72 Expression <- <orExpr> */
-		func() bool {
-			position1059, tokenIndex1059 := position, tokenIndex
-			{
-				position1060 := position
-				if !_rules[ruleorExpr]() {
-					goto l1059
-				}
-				add(ruleExpression, position1060)
-			}
-			return true
-		l1059:
-			position, tokenIndex = position1059, tokenIndex1059
-			return false
-		},
-		/* 73 orExpr <- <(<(andExpr (sp Or sp andExpr)*)> Action54)> */
-		func() bool {
-			position1061, tokenIndex1061 := position, tokenIndex
-			{
-				position1062 := position
+			l1062:
 				{
-					position1063 := position
-					if !_rules[ruleandExpr]() {
-						goto l1061
+					position1064, tokenIndex1064 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1065
 					}
-				l1064:
-					{
-						position1065, tokenIndex1065 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l1065
-						}
-						if !_rules[ruleOr]() {
-							goto l1065
-						}
-						if !_rules[rulesp]() {
-							goto l1065
-						}
-						if !_rules[ruleandExpr]() {
-							goto l1065
-						}
-						goto l1064
-					l1065:
-						position, tokenIndex = position1065, tokenIndex1065
+					position++
+					goto l1064
+				l1065:
+					position, tokenIndex = position1064, tokenIndex1064
+					if buffer[position] != rune('S') {
+						goto l1048
 					}
-					add(rulePegText, position1063)
+					position++
 				}
-				if !_rules[ruleAction54]() {
-					goto l1061
+			l1064:
+				if !_rules[rulesp]() {
+					goto l1048
 				}
-				add(ruleorExpr, position1062)
+				if !_rules[ruleIdentifier]() {
+					goto l1048
+				}
+				if !_rules[ruleAction45]() {
+					goto l1048
+				}
+				add(ruleUnnest, position1049)
 			}
 			return true
-		l1061:
-			position, tokenIndex = position1061, tokenIndex1061
+		l1048:
+			position, tokenIndex = position1048, tokenIndex1048
 			return false
 		},
-		/* 74 andExpr <- <(<(notExpr (sp And sp notExpr)*)> Action55)> */
+		/* 59 WithOrdinalityOpt <- <(<(sp WithOrdinality)?> Action46)> */
 		func() bool {
 			position1066, tokenIndex1066 := position, tokenIndex
 			{
 				position1067 := position
 				{
 					position1068 := position
-					if !_rules[rulenotExpr]() {
-						goto l1066
-					}
-				l1069:
 					{
-						position1070, tokenIndex1070 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l1070
-						}
-						if !_rules[ruleAnd]() {
-							goto l1070
-						}
+						position1069, tokenIndex1069 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l1070
+							goto l1069
 						}
-						if !_rules[rulenotExpr]() {
-							goto l1070
+						if !_rules[ruleWithOrdinality]() {
+							goto l1069
 						}
-						goto l1069
-					l1070:
-						position, tokenIndex = position1070, tokenIndex1070
+						goto l1070
+					l1069:
+						position, tokenIndex = position1069, tokenIndex1069
 					}
+				l1070:
 					add(rulePegText, position1068)
 				}
-				if !_rules[ruleAction55]() {
+				if !_rules[ruleAction46]() {
 					goto l1066
 				}
-				add(ruleandExpr, position1067)
+				add(ruleWithOrdinalityOpt, position1067)
 			}
 			return true
 		l1066:
 			position, tokenIndex = position1066, tokenIndex1066
 			return false
 		},
-		/* 75 notExpr <- <(<((Not sp)? comparisonExpr)> Action56)> */
+		/* 60 WithOrdinality <- <(<(('w' / 'W') ('i' / 'I') ('t' / 'T') ('h' / 'H') sp (('o' / 'O') ('r' / 'R') ('d' / 'D') ('i' / 'I') ('n' / 'N') ('a' / 'A') ('l' / 'L') ('i' / 'I') ('t' / 'T') ('y' / 'Y')))> Action47)> */
 		func() bool {
 			position1071, tokenIndex1071 := position, tokenIndex
 			{
@@ -9932,8121 +10521,17957 @@ func (p *bqlPegBackend) Init() {
 					position1073 := position
 					{
 						position1074, tokenIndex1074 := position, tokenIndex
-						if !_rules[ruleNot]() {
-							goto l1074
-						}
-						if !_rules[rulesp]() {
-							goto l1074
+						if buffer[position] != rune('w') {
+							goto l1075
 						}
-						goto l1075
-					l1074:
+						position++
+						goto l1074
+					l1075:
 						position, tokenIndex = position1074, tokenIndex1074
+						if buffer[position] != rune('W') {
+							goto l1071
+						}
+						position++
 					}
-				l1075:
-					if !_rules[rulecomparisonExpr]() {
-						goto l1071
-					}
-					add(rulePegText, position1073)
-				}
-				if !_rules[ruleAction56]() {
-					goto l1071
-				}
-				add(rulenotExpr, position1072)
-			}
-			return true
-		l1071:
-			position, tokenIndex = position1071, tokenIndex1071
-			return false
-		},
-		/* 76 comparisonExpr <- <(<(otherOpExpr (spOpt ComparisonOp spOpt otherOpExpr)?)> Action57)> */
-		func() bool {
-			position1076, tokenIndex1076 := position, tokenIndex
-			{
-				position1077 := position
-				{
-					position1078 := position
-					if !_rules[ruleotherOpExpr]() {
+				l1074:
+					{
+						position1076, tokenIndex1076 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l1077
+						}
+						position++
 						goto l1076
+					l1077:
+						position, tokenIndex = position1076, tokenIndex1076
+						if buffer[position] != rune('I') {
+							goto l1071
+						}
+						position++
 					}
+				l1076:
 					{
-						position1079, tokenIndex1079 := position, tokenIndex
-						if !_rules[rulespOpt]() {
-							goto l1079
-						}
-						if !_rules[ruleComparisonOp]() {
+						position1078, tokenIndex1078 := position, tokenIndex
+						if buffer[position] != rune('t') {
 							goto l1079
 						}
-						if !_rules[rulespOpt]() {
-							goto l1079
+						position++
+						goto l1078
+					l1079:
+						position, tokenIndex = position1078, tokenIndex1078
+						if buffer[position] != rune('T') {
+							goto l1071
 						}
-						if !_rules[ruleotherOpExpr]() {
-							goto l1079
+						position++
+					}
+				l1078:
+					{
+						position1080, tokenIndex1080 := position, tokenIndex
+						if buffer[position] != rune('h') {
+							goto l1081
 						}
+						position++
 						goto l1080
-					l1079:
-						position, tokenIndex = position1079, tokenIndex1079
+					l1081:
+						position, tokenIndex = position1080, tokenIndex1080
+						if buffer[position] != rune('H') {
+							goto l1071
+						}
+						position++
 					}
 				l1080:
-					add(rulePegText, position1078)
-				}
-				if !_rules[ruleAction57]() {
-					goto l1076
-				}
-				add(rulecomparisonExpr, position1077)
-			}
-			return true
-		l1076:
-			position, tokenIndex = position1076, tokenIndex1076
-			return false
-		},
-		/* 77 otherOpExpr <- <(<(isExpr (spOpt OtherOp spOpt isExpr)*)> Action58)> */
-		func() bool {
-			position1081, tokenIndex1081 := position, tokenIndex
-			{
-				position1082 := position
-				{
-					position1083 := position
-					if !_rules[ruleisExpr]() {
-						goto l1081
+					if !_rules[rulesp]() {
+						goto l1071
 					}
-				l1084:
 					{
-						position1085, tokenIndex1085 := position, tokenIndex
-						if !_rules[rulespOpt]() {
-							goto l1085
-						}
-						if !_rules[ruleOtherOp]() {
-							goto l1085
+						position1082, tokenIndex1082 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1083
 						}
-						if !_rules[rulespOpt]() {
-							goto l1085
+						position++
+						goto l1082
+					l1083:
+						position, tokenIndex = position1082, tokenIndex1082
+						if buffer[position] != rune('O') {
+							goto l1071
 						}
-						if !_rules[ruleisExpr]() {
+						position++
+					}
+				l1082:
+					{
+						position1084, tokenIndex1084 := position, tokenIndex
+						if buffer[position] != rune('r') {
 							goto l1085
 						}
+						position++
 						goto l1084
 					l1085:
-						position, tokenIndex = position1085, tokenIndex1085
+						position, tokenIndex = position1084, tokenIndex1084
+						if buffer[position] != rune('R') {
+							goto l1071
+						}
+						position++
 					}
-					add(rulePegText, position1083)
-				}
-				if !_rules[ruleAction58]() {
-					goto l1081
-				}
-				add(ruleotherOpExpr, position1082)
-			}
-			return true
-		l1081:
-			position, tokenIndex = position1081, tokenIndex1081
-			return false
-		},
-		/* 78 isExpr <- <(<((RowValue sp IsOp sp Missing) / (termExpr (sp IsOp sp NullLiteral)?))> Action59)> */
-		func() bool {
-			position1086, tokenIndex1086 := position, tokenIndex
-			{
-				position1087 := position
-				{
-					position1088 := position
+				l1084:
 					{
-						position1089, tokenIndex1089 := position, tokenIndex
-						if !_rules[ruleRowValue]() {
-							goto l1090
-						}
-						if !_rules[rulesp]() {
-							goto l1090
+						position1086, tokenIndex1086 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l1087
 						}
-						if !_rules[ruleIsOp]() {
-							goto l1090
+						position++
+						goto l1086
+					l1087:
+						position, tokenIndex = position1086, tokenIndex1086
+						if buffer[position] != rune('D') {
+							goto l1071
 						}
-						if !_rules[rulesp]() {
-							goto l1090
+						position++
+					}
+				l1086:
+					{
+						position1088, tokenIndex1088 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l1089
 						}
-						if !_rules[ruleMissing]() {
-							goto l1090
+						position++
+						goto l1088
+					l1089:
+						position, tokenIndex = position1088, tokenIndex1088
+						if buffer[position] != rune('I') {
+							goto l1071
 						}
-						goto l1089
-					l1090:
-						position, tokenIndex = position1089, tokenIndex1089
-						if !_rules[ruletermExpr]() {
-							goto l1086
+						position++
+					}
+				l1088:
+					{
+						position1090, tokenIndex1090 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1091
 						}
-						{
-							position1091, tokenIndex1091 := position, tokenIndex
-							if !_rules[rulesp]() {
-								goto l1091
-							}
-							if !_rules[ruleIsOp]() {
-								goto l1091
-							}
-							if !_rules[rulesp]() {
-								goto l1091
-							}
-							if !_rules[ruleNullLiteral]() {
-								goto l1091
-							}
-							goto l1092
-						l1091:
-							position, tokenIndex = position1091, tokenIndex1091
+						position++
+						goto l1090
+					l1091:
+						position, tokenIndex = position1090, tokenIndex1090
+						if buffer[position] != rune('N') {
+							goto l1071
 						}
-					l1092:
-					}
-				l1089:
-					add(rulePegText, position1088)
-				}
-				if !_rules[ruleAction59]() {
-					goto l1086
-				}
-				add(ruleisExpr, position1087)
-			}
-			return true
-		l1086:
-			position, tokenIndex = position1086, tokenIndex1086
-			return false
-		},
-		/* 79 termExpr <- <(<(productExpr (spOpt PlusMinusOp spOpt productExpr)*)> Action60)> */
-		func() bool {
-			position1093, tokenIndex1093 := position, tokenIndex
-			{
-				position1094 := position
-				{
-					position1095 := position
-					if !_rules[ruleproductExpr]() {
-						goto l1093
+						position++
 					}
-				l1096:
+				l1090:
 					{
-						position1097, tokenIndex1097 := position, tokenIndex
-						if !_rules[rulespOpt]() {
-							goto l1097
-						}
-						if !_rules[rulePlusMinusOp]() {
-							goto l1097
+						position1092, tokenIndex1092 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l1093
 						}
-						if !_rules[rulespOpt]() {
-							goto l1097
+						position++
+						goto l1092
+					l1093:
+						position, tokenIndex = position1092, tokenIndex1092
+						if buffer[position] != rune('A') {
+							goto l1071
 						}
-						if !_rules[ruleproductExpr]() {
+						position++
+					}
+				l1092:
+					{
+						position1094, tokenIndex1094 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l1095
+						}
+						position++
+						goto l1094
+					l1095:
+						position, tokenIndex = position1094, tokenIndex1094
+						if buffer[position] != rune('L') {
+							goto l1071
+						}
+						position++
+					}
+				l1094:
+					{
+						position1096, tokenIndex1096 := position, tokenIndex
+						if buffer[position] != rune('i') {
 							goto l1097
 						}
+						position++
 						goto l1096
 					l1097:
-						position, tokenIndex = position1097, tokenIndex1097
-					}
-					add(rulePegText, position1095)
-				}
-				if !_rules[ruleAction60]() {
-					goto l1093
-				}
-				add(ruletermExpr, position1094)
-			}
-			return true
-		l1093:
-			position, tokenIndex = position1093, tokenIndex1093
-			return false
-		},
-		/* 80 productExpr <- <(<(minusExpr (spOpt MultDivOp spOpt minusExpr)*)> Action61)> */
-		func() bool {
-			position1098, tokenIndex1098 := position, tokenIndex
-			{
-				position1099 := position
-				{
-					position1100 := position
-					if !_rules[ruleminusExpr]() {
-						goto l1098
+						position, tokenIndex = position1096, tokenIndex1096
+						if buffer[position] != rune('I') {
+							goto l1071
+						}
+						position++
 					}
-				l1101:
+				l1096:
 					{
-						position1102, tokenIndex1102 := position, tokenIndex
-						if !_rules[rulespOpt]() {
-							goto l1102
+						position1098, tokenIndex1098 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1099
 						}
-						if !_rules[ruleMultDivOp]() {
-							goto l1102
+						position++
+						goto l1098
+					l1099:
+						position, tokenIndex = position1098, tokenIndex1098
+						if buffer[position] != rune('T') {
+							goto l1071
 						}
-						if !_rules[rulespOpt]() {
-							goto l1102
+						position++
+					}
+				l1098:
+					{
+						position1100, tokenIndex1100 := position, tokenIndex
+						if buffer[position] != rune('y') {
+							goto l1101
 						}
-						if !_rules[ruleminusExpr]() {
-							goto l1102
+						position++
+						goto l1100
+					l1101:
+						position, tokenIndex = position1100, tokenIndex1100
+						if buffer[position] != rune('Y') {
+							goto l1071
 						}
-						goto l1101
-					l1102:
-						position, tokenIndex = position1102, tokenIndex1102
+						position++
 					}
-					add(rulePegText, position1100)
+				l1100:
+					add(rulePegText, position1073)
 				}
-				if !_rules[ruleAction61]() {
-					goto l1098
+				if !_rules[ruleAction47]() {
+					goto l1071
 				}
-				add(ruleproductExpr, position1099)
+				add(ruleWithOrdinality, position1072)
 			}
 			return true
-		l1098:
-			position, tokenIndex = position1098, tokenIndex1098
+		l1071:
+			position, tokenIndex = position1071, tokenIndex1071
 			return false
 		},
-		/* 81 minusExpr <- <(<((UnaryMinus spOpt)? castExpr)> Action62)> */
+		/* 61 StateJoin <- <(sp JoinType sp (('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp StreamIdentifier StateJoinAliasOpt sp (('o' / 'O') ('n' / 'N')) sp Expression Action48)> */
 		func() bool {
-			position1103, tokenIndex1103 := position, tokenIndex
+			position1102, tokenIndex1102 := position, tokenIndex
 			{
-				position1104 := position
+				position1103 := position
+				if !_rules[rulesp]() {
+					goto l1102
+				}
+				if !_rules[ruleJoinType]() {
+					goto l1102
+				}
+				if !_rules[rulesp]() {
+					goto l1102
+				}
 				{
-					position1105 := position
-					{
-						position1106, tokenIndex1106 := position, tokenIndex
-						if !_rules[ruleUnaryMinus]() {
-							goto l1106
-						}
-						if !_rules[rulespOpt]() {
-							goto l1106
-						}
+					position1104, tokenIndex1104 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1105
+					}
+					position++
+					goto l1104
+				l1105:
+					position, tokenIndex = position1104, tokenIndex1104
+					if buffer[position] != rune('S') {
+						goto l1102
+					}
+					position++
+				}
+			l1104:
+				{
+					position1106, tokenIndex1106 := position, tokenIndex
+					if buffer[position] != rune('t') {
 						goto l1107
-					l1106:
-						position, tokenIndex = position1106, tokenIndex1106
 					}
+					position++
+					goto l1106
 				l1107:
-					if !_rules[rulecastExpr]() {
-						goto l1103
+					position, tokenIndex = position1106, tokenIndex1106
+					if buffer[position] != rune('T') {
+						goto l1102
 					}
-					add(rulePegText, position1105)
+					position++
 				}
-				if !_rules[ruleAction62]() {
-					goto l1103
+			l1106:
+				{
+					position1108, tokenIndex1108 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l1109
+					}
+					position++
+					goto l1108
+				l1109:
+					position, tokenIndex = position1108, tokenIndex1108
+					if buffer[position] != rune('A') {
+						goto l1102
+					}
+					position++
+				}
+			l1108:
+				{
+					position1110, tokenIndex1110 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l1111
+					}
+					position++
+					goto l1110
+				l1111:
+					position, tokenIndex = position1110, tokenIndex1110
+					if buffer[position] != rune('T') {
+						goto l1102
+					}
+					position++
+				}
+			l1110:
+				{
+					position1112, tokenIndex1112 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1113
+					}
+					position++
+					goto l1112
+				l1113:
+					position, tokenIndex = position1112, tokenIndex1112
+					if buffer[position] != rune('E') {
+						goto l1102
+					}
+					position++
+				}
+			l1112:
+				if !_rules[rulesp]() {
+					goto l1102
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l1102
+				}
+				if !_rules[ruleStateJoinAliasOpt]() {
+					goto l1102
+				}
+				if !_rules[rulesp]() {
+					goto l1102
+				}
+				{
+					position1114, tokenIndex1114 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l1115
+					}
+					position++
+					goto l1114
+				l1115:
+					position, tokenIndex = position1114, tokenIndex1114
+					if buffer[position] != rune('O') {
+						goto l1102
+					}
+					position++
+				}
+			l1114:
+				{
+					position1116, tokenIndex1116 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l1117
+					}
+					position++
+					goto l1116
+				l1117:
+					position, tokenIndex = position1116, tokenIndex1116
+					if buffer[position] != rune('N') {
+						goto l1102
+					}
+					position++
+				}
+			l1116:
+				if !_rules[rulesp]() {
+					goto l1102
+				}
+				if !_rules[ruleExpression]() {
+					goto l1102
+				}
+				if !_rules[ruleAction48]() {
+					goto l1102
 				}
-				add(ruleminusExpr, position1104)
+				add(ruleStateJoin, position1103)
 			}
 			return true
-		l1103:
-			position, tokenIndex = position1103, tokenIndex1103
+		l1102:
+			position, tokenIndex = position1102, tokenIndex1102
 			return false
 		},
-		/* 82 castExpr <- <(<(baseExpr (spOpt (':' ':') spOpt Type)?)> Action63)> */
+		/* 62 StateJoinAliasOpt <- <(<(sp (('a' / 'A') ('s' / 'S')) sp Identifier)?> Action49)> */
 		func() bool {
-			position1108, tokenIndex1108 := position, tokenIndex
+			position1118, tokenIndex1118 := position, tokenIndex
 			{
-				position1109 := position
+				position1119 := position
 				{
-					position1110 := position
-					if !_rules[rulebaseExpr]() {
-						goto l1108
-					}
+					position1120 := position
 					{
-						position1111, tokenIndex1111 := position, tokenIndex
-						if !_rules[rulespOpt]() {
-							goto l1111
+						position1121, tokenIndex1121 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1121
 						}
-						if buffer[position] != rune(':') {
-							goto l1111
+						{
+							position1123, tokenIndex1123 := position, tokenIndex
+							if buffer[position] != rune('a') {
+								goto l1124
+							}
+							position++
+							goto l1123
+						l1124:
+							position, tokenIndex = position1123, tokenIndex1123
+							if buffer[position] != rune('A') {
+								goto l1121
+							}
+							position++
 						}
-						position++
-						if buffer[position] != rune(':') {
-							goto l1111
+					l1123:
+						{
+							position1125, tokenIndex1125 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1126
+							}
+							position++
+							goto l1125
+						l1126:
+							position, tokenIndex = position1125, tokenIndex1125
+							if buffer[position] != rune('S') {
+								goto l1121
+							}
+							position++
 						}
-						position++
-						if !_rules[rulespOpt]() {
-							goto l1111
+					l1125:
+						if !_rules[rulesp]() {
+							goto l1121
 						}
-						if !_rules[ruleType]() {
-							goto l1111
+						if !_rules[ruleIdentifier]() {
+							goto l1121
 						}
-						goto l1112
-					l1111:
-						position, tokenIndex = position1111, tokenIndex1111
+						goto l1122
+					l1121:
+						position, tokenIndex = position1121, tokenIndex1121
 					}
-				l1112:
-					add(rulePegText, position1110)
+				l1122:
+					add(rulePegText, position1120)
 				}
-				if !_rules[ruleAction63]() {
-					goto l1108
+				if !_rules[ruleAction49]() {
+					goto l1118
 				}
-				add(rulecastExpr, position1109)
+				add(ruleStateJoinAliasOpt, position1119)
 			}
 			return true
-		l1108:
-			position, tokenIndex = position1108, tokenIndex1108
+		l1118:
+			position, tokenIndex = position1118, tokenIndex1118
 			return false
 		},
-		/* 83 baseExpr <- <(('(' spOpt Expression spOpt ')') / MapExpr / BooleanLiteral / NullLiteral / Case / RowMeta / FuncTypeCast / FuncApp / RowValue / ArrayExpr / Literal)> */
+		/* 63 JoinType <- <(LeftOuterJoin / InnerJoin)> */
 		func() bool {
-			position1113, tokenIndex1113 := position, tokenIndex
+			position1127, tokenIndex1127 := position, tokenIndex
 			{
-				position1114 := position
+				position1128 := position
 				{
-					position1115, tokenIndex1115 := position, tokenIndex
-					if buffer[position] != rune('(') {
-						goto l1116
-					}
-					position++
-					if !_rules[rulespOpt]() {
-						goto l1116
-					}
-					if !_rules[ruleExpression]() {
-						goto l1116
-					}
-					if !_rules[rulespOpt]() {
-						goto l1116
-					}
-					if buffer[position] != rune(')') {
-						goto l1116
-					}
-					position++
-					goto l1115
-				l1116:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleMapExpr]() {
-						goto l1117
-					}
-					goto l1115
-				l1117:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleBooleanLiteral]() {
-						goto l1118
-					}
-					goto l1115
-				l1118:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleNullLiteral]() {
-						goto l1119
-					}
-					goto l1115
-				l1119:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleCase]() {
-						goto l1120
-					}
-					goto l1115
-				l1120:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleRowMeta]() {
-						goto l1121
-					}
-					goto l1115
-				l1121:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleFuncTypeCast]() {
-						goto l1122
-					}
-					goto l1115
-				l1122:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleFuncApp]() {
-						goto l1123
-					}
-					goto l1115
-				l1123:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleRowValue]() {
-						goto l1124
+					position1129, tokenIndex1129 := position, tokenIndex
+					if !_rules[ruleLeftOuterJoin]() {
+						goto l1130
 					}
-					goto l1115
-				l1124:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleArrayExpr]() {
-						goto l1125
-					}
-					goto l1115
-				l1125:
-					position, tokenIndex = position1115, tokenIndex1115
-					if !_rules[ruleLiteral]() {
-						goto l1113
+					goto l1129
+				l1130:
+					position, tokenIndex = position1129, tokenIndex1129
+					if !_rules[ruleInnerJoin]() {
+						goto l1127
 					}
 				}
-			l1115:
-				add(rulebaseExpr, position1114)
+			l1129:
+				add(ruleJoinType, position1128)
 			}
 			return true
-		l1113:
-			position, tokenIndex = position1113, tokenIndex1113
+		l1127:
+			position, tokenIndex = position1127, tokenIndex1127
 			return false
 		},
-		/* 84 FuncTypeCast <- <(<(('c' / 'C') ('a' / 'A') ('s' / 'S') ('t' / 'T') spOpt '(' spOpt Expression sp (('a' / 'A') ('s' / 'S')) sp Type spOpt ')')> Action64)> */
+		/* 64 Filter <- <(<(sp (('w' / 'W') ('h' / 'H') ('e' / 'E') ('r' / 'R') ('e' / 'E')) sp Expression)?> Action50)> */
 		func() bool {
-			position1126, tokenIndex1126 := position, tokenIndex
+			position1131, tokenIndex1131 := position, tokenIndex
 			{
-				position1127 := position
+				position1132 := position
 				{
-					position1128 := position
-					{
-						position1129, tokenIndex1129 := position, tokenIndex
-						if buffer[position] != rune('c') {
-							goto l1130
-						}
-						position++
-						goto l1129
-					l1130:
-						position, tokenIndex = position1129, tokenIndex1129
-						if buffer[position] != rune('C') {
-							goto l1126
-						}
-						position++
-					}
-				l1129:
-					{
-						position1131, tokenIndex1131 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1132
-						}
-						position++
-						goto l1131
-					l1132:
-						position, tokenIndex = position1131, tokenIndex1131
-						if buffer[position] != rune('A') {
-							goto l1126
-						}
-						position++
-					}
-				l1131:
+					position1133 := position
 					{
-						position1133, tokenIndex1133 := position, tokenIndex
-						if buffer[position] != rune('s') {
+						position1134, tokenIndex1134 := position, tokenIndex
+						if !_rules[rulesp]() {
 							goto l1134
 						}
-						position++
-						goto l1133
-					l1134:
-						position, tokenIndex = position1133, tokenIndex1133
-						if buffer[position] != rune('S') {
-							goto l1126
-						}
-						position++
-					}
-				l1133:
-					{
-						position1135, tokenIndex1135 := position, tokenIndex
-						if buffer[position] != rune('t') {
+						{
+							position1136, tokenIndex1136 := position, tokenIndex
+							if buffer[position] != rune('w') {
+								goto l1137
+							}
+							position++
 							goto l1136
+						l1137:
+							position, tokenIndex = position1136, tokenIndex1136
+							if buffer[position] != rune('W') {
+								goto l1134
+							}
+							position++
 						}
-						position++
-						goto l1135
 					l1136:
-						position, tokenIndex = position1135, tokenIndex1135
-						if buffer[position] != rune('T') {
-							goto l1126
-						}
-						position++
-					}
-				l1135:
-					if !_rules[rulespOpt]() {
-						goto l1126
-					}
-					if buffer[position] != rune('(') {
-						goto l1126
-					}
-					position++
-					if !_rules[rulespOpt]() {
-						goto l1126
-					}
-					if !_rules[ruleExpression]() {
-						goto l1126
-					}
-					if !_rules[rulesp]() {
-						goto l1126
-					}
-					{
-						position1137, tokenIndex1137 := position, tokenIndex
-						if buffer[position] != rune('a') {
+						{
+							position1138, tokenIndex1138 := position, tokenIndex
+							if buffer[position] != rune('h') {
+								goto l1139
+							}
+							position++
 							goto l1138
+						l1139:
+							position, tokenIndex = position1138, tokenIndex1138
+							if buffer[position] != rune('H') {
+								goto l1134
+							}
+							position++
 						}
-						position++
-						goto l1137
 					l1138:
-						position, tokenIndex = position1137, tokenIndex1137
-						if buffer[position] != rune('A') {
-							goto l1126
-						}
-						position++
-					}
-				l1137:
-					{
-						position1139, tokenIndex1139 := position, tokenIndex
-						if buffer[position] != rune('s') {
+						{
+							position1140, tokenIndex1140 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1141
+							}
+							position++
 							goto l1140
+						l1141:
+							position, tokenIndex = position1140, tokenIndex1140
+							if buffer[position] != rune('E') {
+								goto l1134
+							}
+							position++
 						}
-						position++
-						goto l1139
 					l1140:
-						position, tokenIndex = position1139, tokenIndex1139
-						if buffer[position] != rune('S') {
-							goto l1126
+						{
+							position1142, tokenIndex1142 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1143
+							}
+							position++
+							goto l1142
+						l1143:
+							position, tokenIndex = position1142, tokenIndex1142
+							if buffer[position] != rune('R') {
+								goto l1134
+							}
+							position++
 						}
-						position++
-					}
-				l1139:
-					if !_rules[rulesp]() {
-						goto l1126
-					}
-					if !_rules[ruleType]() {
-						goto l1126
-					}
-					if !_rules[rulespOpt]() {
-						goto l1126
-					}
-					if buffer[position] != rune(')') {
-						goto l1126
+					l1142:
+						{
+							position1144, tokenIndex1144 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1145
+							}
+							position++
+							goto l1144
+						l1145:
+							position, tokenIndex = position1144, tokenIndex1144
+							if buffer[position] != rune('E') {
+								goto l1134
+							}
+							position++
+						}
+					l1144:
+						if !_rules[rulesp]() {
+							goto l1134
+						}
+						if !_rules[ruleExpression]() {
+							goto l1134
+						}
+						goto l1135
+					l1134:
+						position, tokenIndex = position1134, tokenIndex1134
 					}
-					position++
-					add(rulePegText, position1128)
+				l1135:
+					add(rulePegText, position1133)
 				}
-				if !_rules[ruleAction64]() {
-					goto l1126
+				if !_rules[ruleAction50]() {
+					goto l1131
 				}
-				add(ruleFuncTypeCast, position1127)
+				add(ruleFilter, position1132)
 			}
 			return true
-		l1126:
-			position, tokenIndex = position1126, tokenIndex1126
+		l1131:
+			position, tokenIndex = position1131, tokenIndex1131
 			return false
 		},
-		/* 85 FuncApp <- <(FuncAppWithOrderBy / FuncAppWithoutOrderBy)> */
+		/* 65 Grouping <- <(<(sp (('g' / 'G') ('r' / 'R') ('o' / 'O') ('u' / 'U') ('p' / 'P')) sp (('b' / 'B') ('y' / 'Y')) sp (GroupingSets / GroupList))?> Action51)> */
 		func() bool {
-			position1141, tokenIndex1141 := position, tokenIndex
+			position1146, tokenIndex1146 := position, tokenIndex
 			{
-				position1142 := position
+				position1147 := position
 				{
-					position1143, tokenIndex1143 := position, tokenIndex
-					if !_rules[ruleFuncAppWithOrderBy]() {
-						goto l1144
-					}
-					goto l1143
-				l1144:
-					position, tokenIndex = position1143, tokenIndex1143
-					if !_rules[ruleFuncAppWithoutOrderBy]() {
-						goto l1141
+					position1148 := position
+					{
+						position1149, tokenIndex1149 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1149
+						}
+						{
+							position1151, tokenIndex1151 := position, tokenIndex
+							if buffer[position] != rune('g') {
+								goto l1152
+							}
+							position++
+							goto l1151
+						l1152:
+							position, tokenIndex = position1151, tokenIndex1151
+							if buffer[position] != rune('G') {
+								goto l1149
+							}
+							position++
+						}
+					l1151:
+						{
+							position1153, tokenIndex1153 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1154
+							}
+							position++
+							goto l1153
+						l1154:
+							position, tokenIndex = position1153, tokenIndex1153
+							if buffer[position] != rune('R') {
+								goto l1149
+							}
+							position++
+						}
+					l1153:
+						{
+							position1155, tokenIndex1155 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1156
+							}
+							position++
+							goto l1155
+						l1156:
+							position, tokenIndex = position1155, tokenIndex1155
+							if buffer[position] != rune('O') {
+								goto l1149
+							}
+							position++
+						}
+					l1155:
+						{
+							position1157, tokenIndex1157 := position, tokenIndex
+							if buffer[position] != rune('u') {
+								goto l1158
+							}
+							position++
+							goto l1157
+						l1158:
+							position, tokenIndex = position1157, tokenIndex1157
+							if buffer[position] != rune('U') {
+								goto l1149
+							}
+							position++
+						}
+					l1157:
+						{
+							position1159, tokenIndex1159 := position, tokenIndex
+							if buffer[position] != rune('p') {
+								goto l1160
+							}
+							position++
+							goto l1159
+						l1160:
+							position, tokenIndex = position1159, tokenIndex1159
+							if buffer[position] != rune('P') {
+								goto l1149
+							}
+							position++
+						}
+					l1159:
+						if !_rules[rulesp]() {
+							goto l1149
+						}
+						{
+							position1161, tokenIndex1161 := position, tokenIndex
+							if buffer[position] != rune('b') {
+								goto l1162
+							}
+							position++
+							goto l1161
+						l1162:
+							position, tokenIndex = position1161, tokenIndex1161
+							if buffer[position] != rune('B') {
+								goto l1149
+							}
+							position++
+						}
+					l1161:
+						{
+							position1163, tokenIndex1163 := position, tokenIndex
+							if buffer[position] != rune('y') {
+								goto l1164
+							}
+							position++
+							goto l1163
+						l1164:
+							position, tokenIndex = position1163, tokenIndex1163
+							if buffer[position] != rune('Y') {
+								goto l1149
+							}
+							position++
+						}
+					l1163:
+						if !_rules[rulesp]() {
+							goto l1149
+						}
+						{
+							position1165, tokenIndex1165 := position, tokenIndex
+							if !_rules[ruleGroupingSets]() {
+								goto l1166
+							}
+							goto l1165
+						l1166:
+							position, tokenIndex = position1165, tokenIndex1165
+							if !_rules[ruleGroupList]() {
+								goto l1149
+							}
+						}
+					l1165:
+						goto l1150
+					l1149:
+						position, tokenIndex = position1149, tokenIndex1149
 					}
+				l1150:
+					add(rulePegText, position1148)
+				}
+				if !_rules[ruleAction51]() {
+					goto l1146
 				}
-			l1143:
-				add(ruleFuncApp, position1142)
+				add(ruleGrouping, position1147)
 			}
 			return true
-		l1141:
-			position, tokenIndex = position1141, tokenIndex1141
+		l1146:
+			position, tokenIndex = position1146, tokenIndex1146
 			return false
 		},
-		/* 86 FuncAppWithOrderBy <- <(Function spOpt '(' spOpt FuncParams sp ParamsOrder spOpt ')' Action65)> */
+		/* 66 GroupList <- <(Expression (spOpt ',' spOpt Expression)*)> */
 		func() bool {
-			position1145, tokenIndex1145 := position, tokenIndex
+			position1167, tokenIndex1167 := position, tokenIndex
 			{
-				position1146 := position
-				if !_rules[ruleFunction]() {
-					goto l1145
-				}
-				if !_rules[rulespOpt]() {
-					goto l1145
-				}
-				if buffer[position] != rune('(') {
-					goto l1145
-				}
-				position++
-				if !_rules[rulespOpt]() {
-					goto l1145
-				}
-				if !_rules[ruleFuncParams]() {
-					goto l1145
-				}
-				if !_rules[rulesp]() {
-					goto l1145
-				}
-				if !_rules[ruleParamsOrder]() {
-					goto l1145
-				}
-				if !_rules[rulespOpt]() {
-					goto l1145
-				}
-				if buffer[position] != rune(')') {
-					goto l1145
+				position1168 := position
+				if !_rules[ruleExpression]() {
+					goto l1167
 				}
-				position++
-				if !_rules[ruleAction65]() {
-					goto l1145
+			l1169:
+				{
+					position1170, tokenIndex1170 := position, tokenIndex
+					if !_rules[rulespOpt]() {
+						goto l1170
+					}
+					if buffer[position] != rune(',') {
+						goto l1170
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1170
+					}
+					if !_rules[ruleExpression]() {
+						goto l1170
+					}
+					goto l1169
+				l1170:
+					position, tokenIndex = position1170, tokenIndex1170
 				}
-				add(ruleFuncAppWithOrderBy, position1146)
+				add(ruleGroupList, position1168)
 			}
 			return true
-		l1145:
-			position, tokenIndex = position1145, tokenIndex1145
+		l1167:
+			position, tokenIndex = position1167, tokenIndex1167
 			return false
 		},
-		/* 87 FuncAppWithoutOrderBy <- <(Function spOpt '(' spOpt FuncParams <spOpt> ')' Action66)> */
+		/* 67 GroupingSets <- <(((Rollup / Cube) spOpt '(' spOpt GroupList spOpt ')') / (ExplicitGroupingSets spOpt '(' spOpt GroupingSet (spOpt ',' spOpt GroupingSet)* spOpt ')'))> */
 		func() bool {
-			position1147, tokenIndex1147 := position, tokenIndex
+			position1171, tokenIndex1171 := position, tokenIndex
 			{
-				position1148 := position
-				if !_rules[ruleFunction]() {
-					goto l1147
-				}
-				if !_rules[rulespOpt]() {
-					goto l1147
-				}
-				if buffer[position] != rune('(') {
-					goto l1147
-				}
-				position++
-				if !_rules[rulespOpt]() {
-					goto l1147
-				}
-				if !_rules[ruleFuncParams]() {
-					goto l1147
-				}
+				position1172 := position
 				{
-					position1149 := position
+					position1173, tokenIndex1173 := position, tokenIndex
+					{
+						position1175, tokenIndex1175 := position, tokenIndex
+						if !_rules[ruleRollup]() {
+							goto l1176
+						}
+						goto l1175
+					l1176:
+						position, tokenIndex = position1175, tokenIndex1175
+						if !_rules[ruleCube]() {
+							goto l1174
+						}
+					}
+				l1175:
 					if !_rules[rulespOpt]() {
-						goto l1147
+						goto l1174
 					}
-					add(rulePegText, position1149)
-				}
-				if buffer[position] != rune(')') {
-					goto l1147
-				}
-				position++
-				if !_rules[ruleAction66]() {
-					goto l1147
+					if buffer[position] != rune('(') {
+						goto l1174
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1174
+					}
+					if !_rules[ruleGroupList]() {
+						goto l1174
+					}
+					if !_rules[rulespOpt]() {
+						goto l1174
+					}
+					if buffer[position] != rune(')') {
+						goto l1174
+					}
+					position++
+					goto l1173
+				l1174:
+					position, tokenIndex = position1173, tokenIndex1173
+					if !_rules[ruleExplicitGroupingSets]() {
+						goto l1171
+					}
+					if !_rules[rulespOpt]() {
+						goto l1171
+					}
+					if buffer[position] != rune('(') {
+						goto l1171
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1171
+					}
+					if !_rules[ruleGroupingSet]() {
+						goto l1171
+					}
+				l1177:
+					{
+						position1178, tokenIndex1178 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1178
+						}
+						if buffer[position] != rune(',') {
+							goto l1178
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1178
+						}
+						if !_rules[ruleGroupingSet]() {
+							goto l1178
+						}
+						goto l1177
+					l1178:
+						position, tokenIndex = position1178, tokenIndex1178
+					}
+					if !_rules[rulespOpt]() {
+						goto l1171
+					}
+					if buffer[position] != rune(')') {
+						goto l1171
+					}
+					position++
 				}
-				add(ruleFuncAppWithoutOrderBy, position1148)
+			l1173:
+				add(ruleGroupingSets, position1172)
 			}
 			return true
-		l1147:
-			position, tokenIndex = position1147, tokenIndex1147
+		l1171:
+			position, tokenIndex = position1171, tokenIndex1171
 			return false
 		},
-		/* 88 FuncParams <- <(<(ExpressionOrWildcard (spOpt ',' spOpt ExpressionOrWildcard)*)?> Action67)> */
+		/* 68 Rollup <- <(<(('r' / 'R') ('o' / 'O') ('l' / 'L') ('l' / 'L') ('u' / 'U') ('p' / 'P'))> Action52)> */
 		func() bool {
-			position1150, tokenIndex1150 := position, tokenIndex
+			position1179, tokenIndex1179 := position, tokenIndex
 			{
-				position1151 := position
+				position1180 := position
 				{
-					position1152 := position
+					position1181 := position
 					{
-						position1153, tokenIndex1153 := position, tokenIndex
-						if !_rules[ruleExpressionOrWildcard]() {
-							goto l1153
+						position1182, tokenIndex1182 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l1183
 						}
-					l1155:
-						{
-							position1156, tokenIndex1156 := position, tokenIndex
-							if !_rules[rulespOpt]() {
-								goto l1156
-							}
-							if buffer[position] != rune(',') {
-								goto l1156
-							}
-							position++
-							if !_rules[rulespOpt]() {
-								goto l1156
-							}
-							if !_rules[ruleExpressionOrWildcard]() {
-								goto l1156
-							}
-							goto l1155
-						l1156:
-							position, tokenIndex = position1156, tokenIndex1156
+						position++
+						goto l1182
+					l1183:
+						position, tokenIndex = position1182, tokenIndex1182
+						if buffer[position] != rune('R') {
+							goto l1179
 						}
-						goto l1154
-					l1153:
-						position, tokenIndex = position1153, tokenIndex1153
+						position++
+					}
+				l1182:
+					{
+						position1184, tokenIndex1184 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1185
+						}
+						position++
+						goto l1184
+					l1185:
+						position, tokenIndex = position1184, tokenIndex1184
+						if buffer[position] != rune('O') {
+							goto l1179
+						}
+						position++
+					}
+				l1184:
+					{
+						position1186, tokenIndex1186 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l1187
+						}
+						position++
+						goto l1186
+					l1187:
+						position, tokenIndex = position1186, tokenIndex1186
+						if buffer[position] != rune('L') {
+							goto l1179
+						}
+						position++
+					}
+				l1186:
+					{
+						position1188, tokenIndex1188 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l1189
+						}
+						position++
+						goto l1188
+					l1189:
+						position, tokenIndex = position1188, tokenIndex1188
+						if buffer[position] != rune('L') {
+							goto l1179
+						}
+						position++
+					}
+				l1188:
+					{
+						position1190, tokenIndex1190 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l1191
+						}
+						position++
+						goto l1190
+					l1191:
+						position, tokenIndex = position1190, tokenIndex1190
+						if buffer[position] != rune('U') {
+							goto l1179
+						}
+						position++
+					}
+				l1190:
+					{
+						position1192, tokenIndex1192 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l1193
+						}
+						position++
+						goto l1192
+					l1193:
+						position, tokenIndex = position1192, tokenIndex1192
+						if buffer[position] != rune('P') {
+							goto l1179
+						}
+						position++
 					}
-				l1154:
-					add(rulePegText, position1152)
+				l1192:
+					add(rulePegText, position1181)
 				}
-				if !_rules[ruleAction67]() {
-					goto l1150
+				if !_rules[ruleAction52]() {
+					goto l1179
 				}
-				add(ruleFuncParams, position1151)
+				add(ruleRollup, position1180)
 			}
 			return true
-		l1150:
-			position, tokenIndex = position1150, tokenIndex1150
+		l1179:
+			position, tokenIndex = position1179, tokenIndex1179
 			return false
 		},
-		/* 89 ParamsOrder <- <(<(('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R') sp (('b' / 'B') ('y' / 'Y')) sp SortedExpression (spOpt ',' spOpt SortedExpression)*)> Action68)> */
+		/* 69 Cube <- <(<(('c' / 'C') ('u' / 'U') ('b' / 'B') ('e' / 'E'))> Action53)> */
 		func() bool {
-			position1157, tokenIndex1157 := position, tokenIndex
+			position1194, tokenIndex1194 := position, tokenIndex
 			{
-				position1158 := position
+				position1195 := position
 				{
-					position1159 := position
+					position1196 := position
 					{
-						position1160, tokenIndex1160 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1161
+						position1197, tokenIndex1197 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l1198
 						}
 						position++
-						goto l1160
-					l1161:
-						position, tokenIndex = position1160, tokenIndex1160
-						if buffer[position] != rune('O') {
-							goto l1157
+						goto l1197
+					l1198:
+						position, tokenIndex = position1197, tokenIndex1197
+						if buffer[position] != rune('C') {
+							goto l1194
 						}
 						position++
 					}
-				l1160:
+				l1197:
 					{
-						position1162, tokenIndex1162 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1163
+						position1199, tokenIndex1199 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l1200
 						}
 						position++
-						goto l1162
-					l1163:
-						position, tokenIndex = position1162, tokenIndex1162
-						if buffer[position] != rune('R') {
-							goto l1157
+						goto l1199
+					l1200:
+						position, tokenIndex = position1199, tokenIndex1199
+						if buffer[position] != rune('U') {
+							goto l1194
 						}
 						position++
 					}
-				l1162:
+				l1199:
 					{
-						position1164, tokenIndex1164 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1165
+						position1201, tokenIndex1201 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l1202
 						}
 						position++
-						goto l1164
-					l1165:
-						position, tokenIndex = position1164, tokenIndex1164
-						if buffer[position] != rune('D') {
-							goto l1157
+						goto l1201
+					l1202:
+						position, tokenIndex = position1201, tokenIndex1201
+						if buffer[position] != rune('B') {
+							goto l1194
 						}
 						position++
 					}
-				l1164:
+				l1201:
 					{
-						position1166, tokenIndex1166 := position, tokenIndex
+						position1203, tokenIndex1203 := position, tokenIndex
 						if buffer[position] != rune('e') {
-							goto l1167
+							goto l1204
 						}
 						position++
-						goto l1166
-					l1167:
-						position, tokenIndex = position1166, tokenIndex1166
+						goto l1203
+					l1204:
+						position, tokenIndex = position1203, tokenIndex1203
 						if buffer[position] != rune('E') {
-							goto l1157
+							goto l1194
+						}
+						position++
+					}
+				l1203:
+					add(rulePegText, position1196)
+				}
+				if !_rules[ruleAction53]() {
+					goto l1194
+				}
+				add(ruleCube, position1195)
+			}
+			return true
+		l1194:
+			position, tokenIndex = position1194, tokenIndex1194
+			return false
+		},
+		/* 70 ExplicitGroupingSets <- <(<(('g' / 'G') ('r' / 'R') ('o' / 'O') ('u' / 'U') ('p' / 'P') ('i' / 'I') ('n' / 'N') ('g' / 'G') sp (('s' / 'S') ('e' / 'E') ('t' / 'T') ('s' / 'S')))> Action54)> */
+		func() bool {
+			position1205, tokenIndex1205 := position, tokenIndex
+			{
+				position1206 := position
+				{
+					position1207 := position
+					{
+						position1208, tokenIndex1208 := position, tokenIndex
+						if buffer[position] != rune('g') {
+							goto l1209
+						}
+						position++
+						goto l1208
+					l1209:
+						position, tokenIndex = position1208, tokenIndex1208
+						if buffer[position] != rune('G') {
+							goto l1205
 						}
 						position++
 					}
-				l1166:
+				l1208:
 					{
-						position1168, tokenIndex1168 := position, tokenIndex
+						position1210, tokenIndex1210 := position, tokenIndex
 						if buffer[position] != rune('r') {
-							goto l1169
+							goto l1211
 						}
 						position++
-						goto l1168
-					l1169:
-						position, tokenIndex = position1168, tokenIndex1168
+						goto l1210
+					l1211:
+						position, tokenIndex = position1210, tokenIndex1210
 						if buffer[position] != rune('R') {
-							goto l1157
+							goto l1205
 						}
 						position++
 					}
-				l1168:
-					if !_rules[rulesp]() {
-						goto l1157
+				l1210:
+					{
+						position1212, tokenIndex1212 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1213
+						}
+						position++
+						goto l1212
+					l1213:
+						position, tokenIndex = position1212, tokenIndex1212
+						if buffer[position] != rune('O') {
+							goto l1205
+						}
+						position++
 					}
+				l1212:
 					{
-						position1170, tokenIndex1170 := position, tokenIndex
-						if buffer[position] != rune('b') {
-							goto l1171
+						position1214, tokenIndex1214 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l1215
 						}
 						position++
-						goto l1170
-					l1171:
-						position, tokenIndex = position1170, tokenIndex1170
-						if buffer[position] != rune('B') {
-							goto l1157
+						goto l1214
+					l1215:
+						position, tokenIndex = position1214, tokenIndex1214
+						if buffer[position] != rune('U') {
+							goto l1205
 						}
 						position++
 					}
-				l1170:
+				l1214:
 					{
-						position1172, tokenIndex1172 := position, tokenIndex
-						if buffer[position] != rune('y') {
-							goto l1173
+						position1216, tokenIndex1216 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l1217
 						}
 						position++
-						goto l1172
-					l1173:
-						position, tokenIndex = position1172, tokenIndex1172
-						if buffer[position] != rune('Y') {
-							goto l1157
+						goto l1216
+					l1217:
+						position, tokenIndex = position1216, tokenIndex1216
+						if buffer[position] != rune('P') {
+							goto l1205
+						}
+						position++
+					}
+				l1216:
+					{
+						position1218, tokenIndex1218 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l1219
+						}
+						position++
+						goto l1218
+					l1219:
+						position, tokenIndex = position1218, tokenIndex1218
+						if buffer[position] != rune('I') {
+							goto l1205
+						}
+						position++
+					}
+				l1218:
+					{
+						position1220, tokenIndex1220 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1221
+						}
+						position++
+						goto l1220
+					l1221:
+						position, tokenIndex = position1220, tokenIndex1220
+						if buffer[position] != rune('N') {
+							goto l1205
+						}
+						position++
+					}
+				l1220:
+					{
+						position1222, tokenIndex1222 := position, tokenIndex
+						if buffer[position] != rune('g') {
+							goto l1223
+						}
+						position++
+						goto l1222
+					l1223:
+						position, tokenIndex = position1222, tokenIndex1222
+						if buffer[position] != rune('G') {
+							goto l1205
 						}
 						position++
 					}
-				l1172:
+				l1222:
 					if !_rules[rulesp]() {
-						goto l1157
+						goto l1205
 					}
-					if !_rules[ruleSortedExpression]() {
-						goto l1157
+					{
+						position1224, tokenIndex1224 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1225
+						}
+						position++
+						goto l1224
+					l1225:
+						position, tokenIndex = position1224, tokenIndex1224
+						if buffer[position] != rune('S') {
+							goto l1205
+						}
+						position++
 					}
-				l1174:
+				l1224:
 					{
-						position1175, tokenIndex1175 := position, tokenIndex
-						if !_rules[rulespOpt]() {
-							goto l1175
+						position1226, tokenIndex1226 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1227
 						}
-						if buffer[position] != rune(',') {
-							goto l1175
+						position++
+						goto l1226
+					l1227:
+						position, tokenIndex = position1226, tokenIndex1226
+						if buffer[position] != rune('E') {
+							goto l1205
 						}
 						position++
-						if !_rules[rulespOpt]() {
-							goto l1175
+					}
+				l1226:
+					{
+						position1228, tokenIndex1228 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1229
 						}
-						if !_rules[ruleSortedExpression]() {
-							goto l1175
+						position++
+						goto l1228
+					l1229:
+						position, tokenIndex = position1228, tokenIndex1228
+						if buffer[position] != rune('T') {
+							goto l1205
 						}
-						goto l1174
-					l1175:
-						position, tokenIndex = position1175, tokenIndex1175
+						position++
 					}
-					add(rulePegText, position1159)
-				}
-				if !_rules[ruleAction68]() {
-					goto l1157
-				}
-				add(ruleParamsOrder, position1158)
-			}
-			return true
-		l1157:
-			position, tokenIndex = position1157, tokenIndex1157
-			return false
-		},
-		/* 90 SortedExpression <- <(Expression OrderDirectionOpt Action69)> */
-		func() bool {
-			position1176, tokenIndex1176 := position, tokenIndex
-			{
-				position1177 := position
-				if !_rules[ruleExpression]() {
-					goto l1176
-				}
-				if !_rules[ruleOrderDirectionOpt]() {
-					goto l1176
+				l1228:
+					{
+						position1230, tokenIndex1230 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1231
+						}
+						position++
+						goto l1230
+					l1231:
+						position, tokenIndex = position1230, tokenIndex1230
+						if buffer[position] != rune('S') {
+							goto l1205
+						}
+						position++
+					}
+				l1230:
+					add(rulePegText, position1207)
 				}
-				if !_rules[ruleAction69]() {
-					goto l1176
+				if !_rules[ruleAction54]() {
+					goto l1205
 				}
-				add(ruleSortedExpression, position1177)
+				add(ruleExplicitGroupingSets, position1206)
 			}
 			return true
-		l1176:
-			position, tokenIndex = position1176, tokenIndex1176
+		l1205:
+			position, tokenIndex = position1205, tokenIndex1205
 			return false
 		},
-		/* 91 OrderDirectionOpt <- <(<(sp (Ascending / Descending))?> Action70)> */
+		/* 71 GroupingSet <- <(ParenGroupingSet / SingleGroupingSet)> */
 		func() bool {
-			position1178, tokenIndex1178 := position, tokenIndex
+			position1232, tokenIndex1232 := position, tokenIndex
 			{
-				position1179 := position
+				position1233 := position
 				{
-					position1180 := position
-					{
-						position1181, tokenIndex1181 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l1181
-						}
-						{
-							position1183, tokenIndex1183 := position, tokenIndex
-							if !_rules[ruleAscending]() {
-								goto l1184
-							}
-							goto l1183
-						l1184:
-							position, tokenIndex = position1183, tokenIndex1183
-							if !_rules[ruleDescending]() {
-								goto l1181
-							}
-						}
-					l1183:
-						goto l1182
-					l1181:
-						position, tokenIndex = position1181, tokenIndex1181
+					position1234, tokenIndex1234 := position, tokenIndex
+					if !_rules[ruleParenGroupingSet]() {
+						goto l1235
+					}
+					goto l1234
+				l1235:
+					position, tokenIndex = position1234, tokenIndex1234
+					if !_rules[ruleSingleGroupingSet]() {
+						goto l1232
 					}
-				l1182:
-					add(rulePegText, position1180)
-				}
-				if !_rules[ruleAction70]() {
-					goto l1178
 				}
-				add(ruleOrderDirectionOpt, position1179)
+			l1234:
+				add(ruleGroupingSet, position1233)
 			}
 			return true
-		l1178:
-			position, tokenIndex = position1178, tokenIndex1178
+		l1232:
+			position, tokenIndex = position1232, tokenIndex1232
 			return false
 		},
-		/* 92 ArrayExpr <- <(<('[' spOpt (ExpressionOrWildcard (spOpt ',' spOpt ExpressionOrWildcard)*)? spOpt ','? spOpt ']')> Action71)> */
+		/* 72 ParenGroupingSet <- <(<('(' spOpt (Expression (spOpt ',' spOpt Expression)*)? spOpt ')')> Action55)> */
 		func() bool {
-			position1185, tokenIndex1185 := position, tokenIndex
+			position1236, tokenIndex1236 := position, tokenIndex
 			{
-				position1186 := position
+				position1237 := position
 				{
-					position1187 := position
-					if buffer[position] != rune('[') {
-						goto l1185
+					position1238 := position
+					if buffer[position] != rune('(') {
+						goto l1236
 					}
 					position++
 					if !_rules[rulespOpt]() {
-						goto l1185
+						goto l1236
 					}
 					{
-						position1188, tokenIndex1188 := position, tokenIndex
-						if !_rules[ruleExpressionOrWildcard]() {
-							goto l1188
+						position1239, tokenIndex1239 := position, tokenIndex
+						if !_rules[ruleExpression]() {
+							goto l1239
 						}
-					l1190:
+					l1241:
 						{
-							position1191, tokenIndex1191 := position, tokenIndex
+							position1242, tokenIndex1242 := position, tokenIndex
 							if !_rules[rulespOpt]() {
-								goto l1191
+								goto l1242
 							}
 							if buffer[position] != rune(',') {
-								goto l1191
+								goto l1242
 							}
 							position++
 							if !_rules[rulespOpt]() {
-								goto l1191
+								goto l1242
 							}
-							if !_rules[ruleExpressionOrWildcard]() {
-								goto l1191
+							if !_rules[ruleExpression]() {
+								goto l1242
 							}
-							goto l1190
-						l1191:
-							position, tokenIndex = position1191, tokenIndex1191
-						}
-						goto l1189
-					l1188:
-						position, tokenIndex = position1188, tokenIndex1188
-					}
-				l1189:
-					if !_rules[rulespOpt]() {
-						goto l1185
-					}
-					{
-						position1192, tokenIndex1192 := position, tokenIndex
-						if buffer[position] != rune(',') {
-							goto l1192
+							goto l1241
+						l1242:
+							position, tokenIndex = position1242, tokenIndex1242
 						}
-						position++
-						goto l1193
-					l1192:
-						position, tokenIndex = position1192, tokenIndex1192
+						goto l1240
+					l1239:
+						position, tokenIndex = position1239, tokenIndex1239
 					}
-				l1193:
+				l1240:
 					if !_rules[rulespOpt]() {
-						goto l1185
+						goto l1236
 					}
-					if buffer[position] != rune(']') {
-						goto l1185
+					if buffer[position] != rune(')') {
+						goto l1236
 					}
 					position++
-					add(rulePegText, position1187)
+					add(rulePegText, position1238)
 				}
-				if !_rules[ruleAction71]() {
-					goto l1185
+				if !_rules[ruleAction55]() {
+					goto l1236
 				}
-				add(ruleArrayExpr, position1186)
+				add(ruleParenGroupingSet, position1237)
 			}
 			return true
-		l1185:
-			position, tokenIndex = position1185, tokenIndex1185
+		l1236:
+			position, tokenIndex = position1236, tokenIndex1236
 			return false
 		},
-		/* 93 MapExpr <- <(<('{' spOpt (KeyValuePair (spOpt ',' spOpt KeyValuePair)*)? spOpt '}')> Action72)> */
+		/* 73 SingleGroupingSet <- <(<Expression> Action56)> */
 		func() bool {
-			position1194, tokenIndex1194 := position, tokenIndex
+			position1243, tokenIndex1243 := position, tokenIndex
 			{
-				position1195 := position
+				position1244 := position
 				{
-					position1196 := position
-					if buffer[position] != rune('{') {
-						goto l1194
+					position1245 := position
+					if !_rules[ruleExpression]() {
+						goto l1243
 					}
-					position++
-					if !_rules[rulespOpt]() {
-						goto l1194
+					add(rulePegText, position1245)
+				}
+				if !_rules[ruleAction56]() {
+					goto l1243
+				}
+				add(ruleSingleGroupingSet, position1244)
+			}
+			return true
+		l1243:
+			position, tokenIndex = position1243, tokenIndex1243
+			return false
+		},
+		/* 74 Having <- <(<(sp (('h' / 'H') ('a' / 'A') ('v' / 'V') ('i' / 'I') ('n' / 'N') ('g' / 'G')) sp Expression)?> Action57)> */
+		func() bool {
+			position1246, tokenIndex1246 := position, tokenIndex
+			{
+				position1247 := position
+				{
+					position1248 := position
+					{
+						position1249, tokenIndex1249 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1249
+						}
+						{
+							position1251, tokenIndex1251 := position, tokenIndex
+							if buffer[position] != rune('h') {
+								goto l1252
+							}
+							position++
+							goto l1251
+						l1252:
+							position, tokenIndex = position1251, tokenIndex1251
+							if buffer[position] != rune('H') {
+								goto l1249
+							}
+							position++
+						}
+					l1251:
+						{
+							position1253, tokenIndex1253 := position, tokenIndex
+							if buffer[position] != rune('a') {
+								goto l1254
+							}
+							position++
+							goto l1253
+						l1254:
+							position, tokenIndex = position1253, tokenIndex1253
+							if buffer[position] != rune('A') {
+								goto l1249
+							}
+							position++
+						}
+					l1253:
+						{
+							position1255, tokenIndex1255 := position, tokenIndex
+							if buffer[position] != rune('v') {
+								goto l1256
+							}
+							position++
+							goto l1255
+						l1256:
+							position, tokenIndex = position1255, tokenIndex1255
+							if buffer[position] != rune('V') {
+								goto l1249
+							}
+							position++
+						}
+					l1255:
+						{
+							position1257, tokenIndex1257 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1258
+							}
+							position++
+							goto l1257
+						l1258:
+							position, tokenIndex = position1257, tokenIndex1257
+							if buffer[position] != rune('I') {
+								goto l1249
+							}
+							position++
+						}
+					l1257:
+						{
+							position1259, tokenIndex1259 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l1260
+							}
+							position++
+							goto l1259
+						l1260:
+							position, tokenIndex = position1259, tokenIndex1259
+							if buffer[position] != rune('N') {
+								goto l1249
+							}
+							position++
+						}
+					l1259:
+						{
+							position1261, tokenIndex1261 := position, tokenIndex
+							if buffer[position] != rune('g') {
+								goto l1262
+							}
+							position++
+							goto l1261
+						l1262:
+							position, tokenIndex = position1261, tokenIndex1261
+							if buffer[position] != rune('G') {
+								goto l1249
+							}
+							position++
+						}
+					l1261:
+						if !_rules[rulesp]() {
+							goto l1249
+						}
+						if !_rules[ruleExpression]() {
+							goto l1249
+						}
+						goto l1250
+					l1249:
+						position, tokenIndex = position1249, tokenIndex1249
 					}
+				l1250:
+					add(rulePegText, position1248)
+				}
+				if !_rules[ruleAction57]() {
+					goto l1246
+				}
+				add(ruleHaving, position1247)
+			}
+			return true
+		l1246:
+			position, tokenIndex = position1246, tokenIndex1246
+			return false
+		},
+		/* 75 OrderBy <- <(<(sp (('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R')) sp (('b' / 'B') ('y' / 'Y')) sp SortedExpression (spOpt ',' spOpt SortedExpression)*)?> Action58)> */
+		func() bool {
+			position1263, tokenIndex1263 := position, tokenIndex
+			{
+				position1264 := position
+				{
+					position1265 := position
 					{
-						position1197, tokenIndex1197 := position, tokenIndex
-						if !_rules[ruleKeyValuePair]() {
-							goto l1197
+						position1266, tokenIndex1266 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1266
+						}
+						{
+							position1268, tokenIndex1268 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1269
+							}
+							position++
+							goto l1268
+						l1269:
+							position, tokenIndex = position1268, tokenIndex1268
+							if buffer[position] != rune('O') {
+								goto l1266
+							}
+							position++
+						}
+					l1268:
+						{
+							position1270, tokenIndex1270 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1271
+							}
+							position++
+							goto l1270
+						l1271:
+							position, tokenIndex = position1270, tokenIndex1270
+							if buffer[position] != rune('R') {
+								goto l1266
+							}
+							position++
+						}
+					l1270:
+						{
+							position1272, tokenIndex1272 := position, tokenIndex
+							if buffer[position] != rune('d') {
+								goto l1273
+							}
+							position++
+							goto l1272
+						l1273:
+							position, tokenIndex = position1272, tokenIndex1272
+							if buffer[position] != rune('D') {
+								goto l1266
+							}
+							position++
+						}
+					l1272:
+						{
+							position1274, tokenIndex1274 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1275
+							}
+							position++
+							goto l1274
+						l1275:
+							position, tokenIndex = position1274, tokenIndex1274
+							if buffer[position] != rune('E') {
+								goto l1266
+							}
+							position++
+						}
+					l1274:
+						{
+							position1276, tokenIndex1276 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1277
+							}
+							position++
+							goto l1276
+						l1277:
+							position, tokenIndex = position1276, tokenIndex1276
+							if buffer[position] != rune('R') {
+								goto l1266
+							}
+							position++
 						}
-					l1199:
+					l1276:
+						if !_rules[rulesp]() {
+							goto l1266
+						}
+						{
+							position1278, tokenIndex1278 := position, tokenIndex
+							if buffer[position] != rune('b') {
+								goto l1279
+							}
+							position++
+							goto l1278
+						l1279:
+							position, tokenIndex = position1278, tokenIndex1278
+							if buffer[position] != rune('B') {
+								goto l1266
+							}
+							position++
+						}
+					l1278:
+						{
+							position1280, tokenIndex1280 := position, tokenIndex
+							if buffer[position] != rune('y') {
+								goto l1281
+							}
+							position++
+							goto l1280
+						l1281:
+							position, tokenIndex = position1280, tokenIndex1280
+							if buffer[position] != rune('Y') {
+								goto l1266
+							}
+							position++
+						}
+					l1280:
+						if !_rules[rulesp]() {
+							goto l1266
+						}
+						if !_rules[ruleSortedExpression]() {
+							goto l1266
+						}
+					l1282:
 						{
-							position1200, tokenIndex1200 := position, tokenIndex
+							position1283, tokenIndex1283 := position, tokenIndex
 							if !_rules[rulespOpt]() {
-								goto l1200
+								goto l1283
 							}
 							if buffer[position] != rune(',') {
-								goto l1200
+								goto l1283
 							}
 							position++
 							if !_rules[rulespOpt]() {
-								goto l1200
+								goto l1283
 							}
-							if !_rules[ruleKeyValuePair]() {
-								goto l1200
+							if !_rules[ruleSortedExpression]() {
+								goto l1283
 							}
-							goto l1199
-						l1200:
-							position, tokenIndex = position1200, tokenIndex1200
+							goto l1282
+						l1283:
+							position, tokenIndex = position1283, tokenIndex1283
 						}
-						goto l1198
-					l1197:
-						position, tokenIndex = position1197, tokenIndex1197
+						goto l1267
+					l1266:
+						position, tokenIndex = position1266, tokenIndex1266
 					}
-				l1198:
-					if !_rules[rulespOpt]() {
-						goto l1194
-					}
-					if buffer[position] != rune('}') {
-						goto l1194
-					}
-					position++
-					add(rulePegText, position1196)
+				l1267:
+					add(rulePegText, position1265)
 				}
-				if !_rules[ruleAction72]() {
-					goto l1194
+				if !_rules[ruleAction58]() {
+					goto l1263
 				}
-				add(ruleMapExpr, position1195)
+				add(ruleOrderBy, position1264)
 			}
 			return true
-		l1194:
-			position, tokenIndex = position1194, tokenIndex1194
+		l1263:
+			position, tokenIndex = position1263, tokenIndex1263
 			return false
 		},
-		/* 94 KeyValuePair <- <(<(StringLiteral spOpt ':' spOpt ExpressionOrWildcard)> Action73)> */
+		/* 76 Limit <- <(<(sp (('l' / 'L') ('i' / 'I') ('m' / 'M') ('i' / 'I') ('t' / 'T')) sp NonNegativeNumericLiteral)?> Action59)> */
 		func() bool {
-			position1201, tokenIndex1201 := position, tokenIndex
+			position1284, tokenIndex1284 := position, tokenIndex
 			{
-				position1202 := position
+				position1285 := position
 				{
-					position1203 := position
-					if !_rules[ruleStringLiteral]() {
-						goto l1201
-					}
-					if !_rules[rulespOpt]() {
-						goto l1201
-					}
-					if buffer[position] != rune(':') {
-						goto l1201
-					}
-					position++
-					if !_rules[rulespOpt]() {
-						goto l1201
-					}
-					if !_rules[ruleExpressionOrWildcard]() {
-						goto l1201
+					position1286 := position
+					{
+						position1287, tokenIndex1287 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1287
+						}
+						{
+							position1289, tokenIndex1289 := position, tokenIndex
+							if buffer[position] != rune('l') {
+								goto l1290
+							}
+							position++
+							goto l1289
+						l1290:
+							position, tokenIndex = position1289, tokenIndex1289
+							if buffer[position] != rune('L') {
+								goto l1287
+							}
+							position++
+						}
+					l1289:
+						{
+							position1291, tokenIndex1291 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1292
+							}
+							position++
+							goto l1291
+						l1292:
+							position, tokenIndex = position1291, tokenIndex1291
+							if buffer[position] != rune('I') {
+								goto l1287
+							}
+							position++
+						}
+					l1291:
+						{
+							position1293, tokenIndex1293 := position, tokenIndex
+							if buffer[position] != rune('m') {
+								goto l1294
+							}
+							position++
+							goto l1293
+						l1294:
+							position, tokenIndex = position1293, tokenIndex1293
+							if buffer[position] != rune('M') {
+								goto l1287
+							}
+							position++
+						}
+					l1293:
+						{
+							position1295, tokenIndex1295 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1296
+							}
+							position++
+							goto l1295
+						l1296:
+							position, tokenIndex = position1295, tokenIndex1295
+							if buffer[position] != rune('I') {
+								goto l1287
+							}
+							position++
+						}
+					l1295:
+						{
+							position1297, tokenIndex1297 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1298
+							}
+							position++
+							goto l1297
+						l1298:
+							position, tokenIndex = position1297, tokenIndex1297
+							if buffer[position] != rune('T') {
+								goto l1287
+							}
+							position++
+						}
+					l1297:
+						if !_rules[rulesp]() {
+							goto l1287
+						}
+						if !_rules[ruleNonNegativeNumericLiteral]() {
+							goto l1287
+						}
+						goto l1288
+					l1287:
+						position, tokenIndex = position1287, tokenIndex1287
 					}
-					add(rulePegText, position1203)
+				l1288:
+					add(rulePegText, position1286)
 				}
-				if !_rules[ruleAction73]() {
-					goto l1201
+				if !_rules[ruleAction59]() {
+					goto l1284
 				}
-				add(ruleKeyValuePair, position1202)
+				add(ruleLimit, position1285)
 			}
 			return true
-		l1201:
-			position, tokenIndex = position1201, tokenIndex1201
+		l1284:
+			position, tokenIndex = position1284, tokenIndex1284
 			return false
 		},
-		/* 95 Case <- <(ConditionCase / ExpressionCase)> */
+		/* 77 RelationLike <- <(AliasedStreamWindow / (StreamWindow Action60))> */
 		func() bool {
-			position1204, tokenIndex1204 := position, tokenIndex
+			position1299, tokenIndex1299 := position, tokenIndex
 			{
-				position1205 := position
+				position1300 := position
 				{
-					position1206, tokenIndex1206 := position, tokenIndex
-					if !_rules[ruleConditionCase]() {
-						goto l1207
+					position1301, tokenIndex1301 := position, tokenIndex
+					if !_rules[ruleAliasedStreamWindow]() {
+						goto l1302
 					}
-					goto l1206
-				l1207:
-					position, tokenIndex = position1206, tokenIndex1206
-					if !_rules[ruleExpressionCase]() {
-						goto l1204
+					goto l1301
+				l1302:
+					position, tokenIndex = position1301, tokenIndex1301
+					if !_rules[ruleStreamWindow]() {
+						goto l1299
+					}
+					if !_rules[ruleAction60]() {
+						goto l1299
 					}
 				}
-			l1206:
-				add(ruleCase, position1205)
+			l1301:
+				add(ruleRelationLike, position1300)
 			}
 			return true
-		l1204:
-			position, tokenIndex = position1204, tokenIndex1204
+		l1299:
+			position, tokenIndex = position1299, tokenIndex1299
 			return false
 		},
-		/* 96 ConditionCase <- <(('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') <((sp WhenThenPair)+ (sp (('e' / 'E') ('l' / 'L') ('s' / 'S') ('e' / 'E')) sp Expression)? sp (('e' / 'E') ('n' / 'N') ('d' / 'D')))> Action74)> */
+		/* 78 AliasedStreamWindow <- <(StreamWindow sp (('a' / 'A') ('s' / 'S')) sp Identifier Action61)> */
 		func() bool {
-			position1208, tokenIndex1208 := position, tokenIndex
+			position1303, tokenIndex1303 := position, tokenIndex
 			{
-				position1209 := position
-				{
-					position1210, tokenIndex1210 := position, tokenIndex
-					if buffer[position] != rune('c') {
-						goto l1211
-					}
-					position++
-					goto l1210
-				l1211:
-					position, tokenIndex = position1210, tokenIndex1210
-					if buffer[position] != rune('C') {
-						goto l1208
-					}
-					position++
+				position1304 := position
+				if !_rules[ruleStreamWindow]() {
+					goto l1303
+				}
+				if !_rules[rulesp]() {
+					goto l1303
 				}
-			l1210:
 				{
-					position1212, tokenIndex1212 := position, tokenIndex
+					position1305, tokenIndex1305 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l1213
+						goto l1306
 					}
 					position++
-					goto l1212
-				l1213:
-					position, tokenIndex = position1212, tokenIndex1212
+					goto l1305
+				l1306:
+					position, tokenIndex = position1305, tokenIndex1305
 					if buffer[position] != rune('A') {
-						goto l1208
+						goto l1303
 					}
 					position++
 				}
-			l1212:
+			l1305:
 				{
-					position1214, tokenIndex1214 := position, tokenIndex
+					position1307, tokenIndex1307 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l1215
+						goto l1308
 					}
 					position++
-					goto l1214
-				l1215:
-					position, tokenIndex = position1214, tokenIndex1214
+					goto l1307
+				l1308:
+					position, tokenIndex = position1307, tokenIndex1307
 					if buffer[position] != rune('S') {
-						goto l1208
+						goto l1303
 					}
 					position++
 				}
-			l1214:
-				{
-					position1216, tokenIndex1216 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l1217
+			l1307:
+				if !_rules[rulesp]() {
+					goto l1303
+				}
+				if !_rules[ruleIdentifier]() {
+					goto l1303
+				}
+				if !_rules[ruleAction61]() {
+					goto l1303
+				}
+				add(ruleAliasedStreamWindow, position1304)
+			}
+			return true
+		l1303:
+			position, tokenIndex = position1303, tokenIndex1303
+			return false
+		},
+		/* 79 StreamWindow <- <(StreamLike spOpt '[' spOpt (SessionWindow / RangeWindow) LatenessSpecOpt CapacitySpecOpt SheddingSpecOpt spOpt ']' Action62)> */
+		func() bool {
+			position1309, tokenIndex1309 := position, tokenIndex
+			{
+				position1310 := position
+				if !_rules[ruleStreamLike]() {
+					goto l1309
+				}
+				if !_rules[rulespOpt]() {
+					goto l1309
+				}
+				if buffer[position] != rune('[') {
+					goto l1309
+				}
+				position++
+				if !_rules[rulespOpt]() {
+					goto l1309
+				}
+				{
+					position1311, tokenIndex1311 := position, tokenIndex
+					if !_rules[ruleSessionWindow]() {
+						goto l1312
+					}
+					goto l1311
+				l1312:
+					position, tokenIndex = position1311, tokenIndex1311
+					if !_rules[ruleRangeWindow]() {
+						goto l1309
+					}
+				}
+			l1311:
+				if !_rules[ruleLatenessSpecOpt]() {
+					goto l1309
+				}
+				if !_rules[ruleCapacitySpecOpt]() {
+					goto l1309
+				}
+				if !_rules[ruleSheddingSpecOpt]() {
+					goto l1309
+				}
+				if !_rules[rulespOpt]() {
+					goto l1309
+				}
+				if buffer[position] != rune(']') {
+					goto l1309
+				}
+				position++
+				if !_rules[ruleAction62]() {
+					goto l1309
+				}
+				add(ruleStreamWindow, position1310)
+			}
+			return true
+		l1309:
+			position, tokenIndex = position1309, tokenIndex1309
+			return false
+		},
+		/* 80 StreamLike <- <(UDSFFuncApp / Stream)> */
+		func() bool {
+			position1313, tokenIndex1313 := position, tokenIndex
+			{
+				position1314 := position
+				{
+					position1315, tokenIndex1315 := position, tokenIndex
+					if !_rules[ruleUDSFFuncApp]() {
+						goto l1316
+					}
+					goto l1315
+				l1316:
+					position, tokenIndex = position1315, tokenIndex1315
+					if !_rules[ruleStream]() {
+						goto l1313
+					}
+				}
+			l1315:
+				add(ruleStreamLike, position1314)
+			}
+			return true
+		l1313:
+			position, tokenIndex = position1313, tokenIndex1313
+			return false
+		},
+		/* 81 UDSFFuncApp <- <(FuncAppWithoutOrderBy Action63)> */
+		func() bool {
+			position1317, tokenIndex1317 := position, tokenIndex
+			{
+				position1318 := position
+				if !_rules[ruleFuncAppWithoutOrderBy]() {
+					goto l1317
+				}
+				if !_rules[ruleAction63]() {
+					goto l1317
+				}
+				add(ruleUDSFFuncApp, position1318)
+			}
+			return true
+		l1317:
+			position, tokenIndex = position1317, tokenIndex1317
+			return false
+		},
+		/* 82 RangeWindow <- <(('r' / 'R') ('a' / 'A') ('n' / 'N') ('g' / 'G') ('e' / 'E') sp Interval SlideSpecOpt)> */
+		func() bool {
+			position1319, tokenIndex1319 := position, tokenIndex
+			{
+				position1320 := position
+				{
+					position1321, tokenIndex1321 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l1322
 					}
 					position++
-					goto l1216
-				l1217:
-					position, tokenIndex = position1216, tokenIndex1216
-					if buffer[position] != rune('E') {
-						goto l1208
+					goto l1321
+				l1322:
+					position, tokenIndex = position1321, tokenIndex1321
+					if buffer[position] != rune('R') {
+						goto l1319
 					}
 					position++
 				}
-			l1216:
+			l1321:
 				{
-					position1218 := position
-					if !_rules[rulesp]() {
-						goto l1208
+					position1323, tokenIndex1323 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l1324
 					}
-					if !_rules[ruleWhenThenPair]() {
-						goto l1208
+					position++
+					goto l1323
+				l1324:
+					position, tokenIndex = position1323, tokenIndex1323
+					if buffer[position] != rune('A') {
+						goto l1319
 					}
-				l1219:
-					{
-						position1220, tokenIndex1220 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l1220
-						}
-						if !_rules[ruleWhenThenPair]() {
-							goto l1220
-						}
-						goto l1219
-					l1220:
-						position, tokenIndex = position1220, tokenIndex1220
+					position++
+				}
+			l1323:
+				{
+					position1325, tokenIndex1325 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l1326
 					}
-					{
-						position1221, tokenIndex1221 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l1221
-						}
-						{
-							position1223, tokenIndex1223 := position, tokenIndex
-							if buffer[position] != rune('e') {
-								goto l1224
-							}
-							position++
-							goto l1223
-						l1224:
-							position, tokenIndex = position1223, tokenIndex1223
-							if buffer[position] != rune('E') {
-								goto l1221
-							}
-							position++
-						}
-					l1223:
-						{
-							position1225, tokenIndex1225 := position, tokenIndex
-							if buffer[position] != rune('l') {
-								goto l1226
-							}
-							position++
-							goto l1225
-						l1226:
-							position, tokenIndex = position1225, tokenIndex1225
-							if buffer[position] != rune('L') {
-								goto l1221
-							}
-							position++
-						}
-					l1225:
-						{
-							position1227, tokenIndex1227 := position, tokenIndex
-							if buffer[position] != rune('s') {
-								goto l1228
-							}
-							position++
-							goto l1227
-						l1228:
-							position, tokenIndex = position1227, tokenIndex1227
-							if buffer[position] != rune('S') {
-								goto l1221
-							}
-							position++
-						}
-					l1227:
-						{
-							position1229, tokenIndex1229 := position, tokenIndex
-							if buffer[position] != rune('e') {
-								goto l1230
-							}
-							position++
-							goto l1229
-						l1230:
-							position, tokenIndex = position1229, tokenIndex1229
-							if buffer[position] != rune('E') {
-								goto l1221
-							}
-							position++
-						}
-					l1229:
-						if !_rules[rulesp]() {
-							goto l1221
-						}
-						if !_rules[ruleExpression]() {
-							goto l1221
-						}
-						goto l1222
-					l1221:
-						position, tokenIndex = position1221, tokenIndex1221
+					position++
+					goto l1325
+				l1326:
+					position, tokenIndex = position1325, tokenIndex1325
+					if buffer[position] != rune('N') {
+						goto l1319
 					}
-				l1222:
-					if !_rules[rulesp]() {
-						goto l1208
+					position++
+				}
+			l1325:
+				{
+					position1327, tokenIndex1327 := position, tokenIndex
+					if buffer[position] != rune('g') {
+						goto l1328
 					}
-					{
-						position1231, tokenIndex1231 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1232
-						}
-						position++
-						goto l1231
-					l1232:
-						position, tokenIndex = position1231, tokenIndex1231
-						if buffer[position] != rune('E') {
-							goto l1208
-						}
-						position++
+					position++
+					goto l1327
+				l1328:
+					position, tokenIndex = position1327, tokenIndex1327
+					if buffer[position] != rune('G') {
+						goto l1319
 					}
-				l1231:
-					{
-						position1233, tokenIndex1233 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1234
-						}
-						position++
-						goto l1233
-					l1234:
-						position, tokenIndex = position1233, tokenIndex1233
-						if buffer[position] != rune('N') {
-							goto l1208
-						}
-						position++
+					position++
+				}
+			l1327:
+				{
+					position1329, tokenIndex1329 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1330
 					}
-				l1233:
-					{
-						position1235, tokenIndex1235 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1236
-						}
-						position++
-						goto l1235
-					l1236:
-						position, tokenIndex = position1235, tokenIndex1235
-						if buffer[position] != rune('D') {
-							goto l1208
-						}
-						position++
+					position++
+					goto l1329
+				l1330:
+					position, tokenIndex = position1329, tokenIndex1329
+					if buffer[position] != rune('E') {
+						goto l1319
 					}
-				l1235:
-					add(rulePegText, position1218)
+					position++
 				}
-				if !_rules[ruleAction74]() {
-					goto l1208
+			l1329:
+				if !_rules[rulesp]() {
+					goto l1319
+				}
+				if !_rules[ruleInterval]() {
+					goto l1319
 				}
-				add(ruleConditionCase, position1209)
+				if !_rules[ruleSlideSpecOpt]() {
+					goto l1319
+				}
+				add(ruleRangeWindow, position1320)
 			}
 			return true
-		l1208:
-			position, tokenIndex = position1208, tokenIndex1208
+		l1319:
+			position, tokenIndex = position1319, tokenIndex1319
 			return false
 		},
-		/* 97 ExpressionCase <- <(('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') sp Expression <((sp WhenThenPair)+ (sp (('e' / 'E') ('l' / 'L') ('s' / 'S') ('e' / 'E')) sp Expression)? sp (('e' / 'E') ('n' / 'N') ('d' / 'D')))> Action75)> */
+		/* 83 SessionWindow <- <(('s' / 'S') ('e' / 'E') ('s' / 'S') ('s' / 'S') ('i' / 'I') ('o' / 'O') ('n' / 'N') sp (('g' / 'G') ('a' / 'A') ('p' / 'P')) sp TimeInterval SessionKeyOpt)> */
 		func() bool {
-			position1237, tokenIndex1237 := position, tokenIndex
+			position1331, tokenIndex1331 := position, tokenIndex
 			{
-				position1238 := position
+				position1332 := position
 				{
-					position1239, tokenIndex1239 := position, tokenIndex
-					if buffer[position] != rune('c') {
-						goto l1240
+					position1333, tokenIndex1333 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1334
 					}
 					position++
-					goto l1239
-				l1240:
-					position, tokenIndex = position1239, tokenIndex1239
-					if buffer[position] != rune('C') {
-						goto l1237
+					goto l1333
+				l1334:
+					position, tokenIndex = position1333, tokenIndex1333
+					if buffer[position] != rune('S') {
+						goto l1331
 					}
 					position++
 				}
-			l1239:
+			l1333:
 				{
-					position1241, tokenIndex1241 := position, tokenIndex
-					if buffer[position] != rune('a') {
-						goto l1242
+					position1335, tokenIndex1335 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1336
 					}
 					position++
-					goto l1241
-				l1242:
-					position, tokenIndex = position1241, tokenIndex1241
-					if buffer[position] != rune('A') {
-						goto l1237
+					goto l1335
+				l1336:
+					position, tokenIndex = position1335, tokenIndex1335
+					if buffer[position] != rune('E') {
+						goto l1331
 					}
 					position++
 				}
-			l1241:
+			l1335:
 				{
-					position1243, tokenIndex1243 := position, tokenIndex
+					position1337, tokenIndex1337 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l1244
+						goto l1338
 					}
 					position++
-					goto l1243
-				l1244:
-					position, tokenIndex = position1243, tokenIndex1243
+					goto l1337
+				l1338:
+					position, tokenIndex = position1337, tokenIndex1337
 					if buffer[position] != rune('S') {
-						goto l1237
+						goto l1331
 					}
 					position++
 				}
-			l1243:
+			l1337:
 				{
-					position1245, tokenIndex1245 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l1246
+					position1339, tokenIndex1339 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1340
 					}
 					position++
-					goto l1245
-				l1246:
-					position, tokenIndex = position1245, tokenIndex1245
-					if buffer[position] != rune('E') {
-						goto l1237
+					goto l1339
+				l1340:
+					position, tokenIndex = position1339, tokenIndex1339
+					if buffer[position] != rune('S') {
+						goto l1331
 					}
 					position++
 				}
-			l1245:
-				if !_rules[rulesp]() {
-					goto l1237
+			l1339:
+				{
+					position1341, tokenIndex1341 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l1342
+					}
+					position++
+					goto l1341
+				l1342:
+					position, tokenIndex = position1341, tokenIndex1341
+					if buffer[position] != rune('I') {
+						goto l1331
+					}
+					position++
 				}
-				if !_rules[ruleExpression]() {
-					goto l1237
+			l1341:
+				{
+					position1343, tokenIndex1343 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l1344
+					}
+					position++
+					goto l1343
+				l1344:
+					position, tokenIndex = position1343, tokenIndex1343
+					if buffer[position] != rune('O') {
+						goto l1331
+					}
+					position++
 				}
+			l1343:
 				{
-					position1247 := position
-					if !_rules[rulesp]() {
-						goto l1237
+					position1345, tokenIndex1345 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l1346
 					}
-					if !_rules[ruleWhenThenPair]() {
-						goto l1237
+					position++
+					goto l1345
+				l1346:
+					position, tokenIndex = position1345, tokenIndex1345
+					if buffer[position] != rune('N') {
+						goto l1331
 					}
-				l1248:
-					{
-						position1249, tokenIndex1249 := position, tokenIndex
-						if !_rules[rulesp]() {
-							goto l1249
-						}
-						if !_rules[ruleWhenThenPair]() {
-							goto l1249
-						}
-						goto l1248
-					l1249:
-						position, tokenIndex = position1249, tokenIndex1249
+					position++
+				}
+			l1345:
+				if !_rules[rulesp]() {
+					goto l1331
+				}
+				{
+					position1347, tokenIndex1347 := position, tokenIndex
+					if buffer[position] != rune('g') {
+						goto l1348
 					}
+					position++
+					goto l1347
+				l1348:
+					position, tokenIndex = position1347, tokenIndex1347
+					if buffer[position] != rune('G') {
+						goto l1331
+					}
+					position++
+				}
+			l1347:
+				{
+					position1349, tokenIndex1349 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l1350
+					}
+					position++
+					goto l1349
+				l1350:
+					position, tokenIndex = position1349, tokenIndex1349
+					if buffer[position] != rune('A') {
+						goto l1331
+					}
+					position++
+				}
+			l1349:
+				{
+					position1351, tokenIndex1351 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l1352
+					}
+					position++
+					goto l1351
+				l1352:
+					position, tokenIndex = position1351, tokenIndex1351
+					if buffer[position] != rune('P') {
+						goto l1331
+					}
+					position++
+				}
+			l1351:
+				if !_rules[rulesp]() {
+					goto l1331
+				}
+				if !_rules[ruleTimeInterval]() {
+					goto l1331
+				}
+				if !_rules[ruleSessionKeyOpt]() {
+					goto l1331
+				}
+				add(ruleSessionWindow, position1332)
+			}
+			return true
+		l1331:
+			position, tokenIndex = position1331, tokenIndex1331
+			return false
+		},
+		/* 84 SessionKeyOpt <- <(<(sp (('b' / 'B') ('y' / 'Y')) sp Expression)?> Action64)> */
+		func() bool {
+			position1353, tokenIndex1353 := position, tokenIndex
+			{
+				position1354 := position
+				{
+					position1355 := position
 					{
-						position1250, tokenIndex1250 := position, tokenIndex
+						position1356, tokenIndex1356 := position, tokenIndex
 						if !_rules[rulesp]() {
-							goto l1250
-						}
-						{
-							position1252, tokenIndex1252 := position, tokenIndex
-							if buffer[position] != rune('e') {
-								goto l1253
-							}
-							position++
-							goto l1252
-						l1253:
-							position, tokenIndex = position1252, tokenIndex1252
-							if buffer[position] != rune('E') {
-								goto l1250
-							}
-							position++
-						}
-					l1252:
-						{
-							position1254, tokenIndex1254 := position, tokenIndex
-							if buffer[position] != rune('l') {
-								goto l1255
-							}
-							position++
-							goto l1254
-						l1255:
-							position, tokenIndex = position1254, tokenIndex1254
-							if buffer[position] != rune('L') {
-								goto l1250
-							}
-							position++
+							goto l1356
 						}
-					l1254:
 						{
-							position1256, tokenIndex1256 := position, tokenIndex
-							if buffer[position] != rune('s') {
-								goto l1257
+							position1358, tokenIndex1358 := position, tokenIndex
+							if buffer[position] != rune('b') {
+								goto l1359
 							}
 							position++
-							goto l1256
-						l1257:
-							position, tokenIndex = position1256, tokenIndex1256
-							if buffer[position] != rune('S') {
-								goto l1250
+							goto l1358
+						l1359:
+							position, tokenIndex = position1358, tokenIndex1358
+							if buffer[position] != rune('B') {
+								goto l1356
 							}
 							position++
 						}
-					l1256:
+					l1358:
 						{
-							position1258, tokenIndex1258 := position, tokenIndex
-							if buffer[position] != rune('e') {
-								goto l1259
+							position1360, tokenIndex1360 := position, tokenIndex
+							if buffer[position] != rune('y') {
+								goto l1361
 							}
 							position++
-							goto l1258
-						l1259:
-							position, tokenIndex = position1258, tokenIndex1258
-							if buffer[position] != rune('E') {
-								goto l1250
+							goto l1360
+						l1361:
+							position, tokenIndex = position1360, tokenIndex1360
+							if buffer[position] != rune('Y') {
+								goto l1356
 							}
 							position++
 						}
-					l1258:
+					l1360:
 						if !_rules[rulesp]() {
-							goto l1250
+							goto l1356
 						}
 						if !_rules[ruleExpression]() {
-							goto l1250
-						}
-						goto l1251
-					l1250:
-						position, tokenIndex = position1250, tokenIndex1250
-					}
-				l1251:
-					if !_rules[rulesp]() {
-						goto l1237
-					}
-					{
-						position1260, tokenIndex1260 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1261
-						}
-						position++
-						goto l1260
-					l1261:
-						position, tokenIndex = position1260, tokenIndex1260
-						if buffer[position] != rune('E') {
-							goto l1237
-						}
-						position++
-					}
-				l1260:
-					{
-						position1262, tokenIndex1262 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1263
-						}
-						position++
-						goto l1262
-					l1263:
-						position, tokenIndex = position1262, tokenIndex1262
-						if buffer[position] != rune('N') {
-							goto l1237
+							goto l1356
 						}
-						position++
+						goto l1357
+					l1356:
+						position, tokenIndex = position1356, tokenIndex1356
 					}
-				l1262:
+				l1357:
+					add(rulePegText, position1355)
+				}
+				if !_rules[ruleAction64]() {
+					goto l1353
+				}
+				add(ruleSessionKeyOpt, position1354)
+			}
+			return true
+		l1353:
+			position, tokenIndex = position1353, tokenIndex1353
+			return false
+		},
+		/* 85 SlideSpecOpt <- <(<(sp (SlideInterval / Tumbling))?> Action65)> */
+		func() bool {
+			position1362, tokenIndex1362 := position, tokenIndex
+			{
+				position1363 := position
+				{
+					position1364 := position
 					{
-						position1264, tokenIndex1264 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1265
+						position1365, tokenIndex1365 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1365
 						}
-						position++
-						goto l1264
-					l1265:
-						position, tokenIndex = position1264, tokenIndex1264
-						if buffer[position] != rune('D') {
-							goto l1237
+						{
+							position1367, tokenIndex1367 := position, tokenIndex
+							if !_rules[ruleSlideInterval]() {
+								goto l1368
+							}
+							goto l1367
+						l1368:
+							position, tokenIndex = position1367, tokenIndex1367
+							if !_rules[ruleTumbling]() {
+								goto l1365
+							}
 						}
-						position++
+					l1367:
+						goto l1366
+					l1365:
+						position, tokenIndex = position1365, tokenIndex1365
 					}
-				l1264:
-					add(rulePegText, position1247)
+				l1366:
+					add(rulePegText, position1364)
 				}
-				if !_rules[ruleAction75]() {
-					goto l1237
+				if !_rules[ruleAction65]() {
+					goto l1362
 				}
-				add(ruleExpressionCase, position1238)
+				add(ruleSlideSpecOpt, position1363)
 			}
 			return true
-		l1237:
-			position, tokenIndex = position1237, tokenIndex1237
+		l1362:
+			position, tokenIndex = position1362, tokenIndex1362
 			return false
 		},
-		/* 98 WhenThenPair <- <(('w' / 'W') ('h' / 'H') ('e' / 'E') ('n' / 'N') sp Expression sp (('t' / 'T') ('h' / 'H') ('e' / 'E') ('n' / 'N')) sp ExpressionOrWildcard Action76)> */
+		/* 86 SlideInterval <- <(('s' / 'S') ('l' / 'L') ('i' / 'I') ('d' / 'D') ('e' / 'E') sp Interval Action66)> */
 		func() bool {
-			position1266, tokenIndex1266 := position, tokenIndex
+			position1369, tokenIndex1369 := position, tokenIndex
 			{
-				position1267 := position
+				position1370 := position
 				{
-					position1268, tokenIndex1268 := position, tokenIndex
-					if buffer[position] != rune('w') {
-						goto l1269
+					position1371, tokenIndex1371 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1372
 					}
 					position++
-					goto l1268
-				l1269:
-					position, tokenIndex = position1268, tokenIndex1268
-					if buffer[position] != rune('W') {
-						goto l1266
+					goto l1371
+				l1372:
+					position, tokenIndex = position1371, tokenIndex1371
+					if buffer[position] != rune('S') {
+						goto l1369
 					}
 					position++
 				}
-			l1268:
+			l1371:
 				{
-					position1270, tokenIndex1270 := position, tokenIndex
-					if buffer[position] != rune('h') {
-						goto l1271
+					position1373, tokenIndex1373 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l1374
 					}
 					position++
-					goto l1270
-				l1271:
-					position, tokenIndex = position1270, tokenIndex1270
-					if buffer[position] != rune('H') {
-						goto l1266
+					goto l1373
+				l1374:
+					position, tokenIndex = position1373, tokenIndex1373
+					if buffer[position] != rune('L') {
+						goto l1369
 					}
 					position++
 				}
-			l1270:
+			l1373:
 				{
-					position1272, tokenIndex1272 := position, tokenIndex
-					if buffer[position] != rune('e') {
-						goto l1273
+					position1375, tokenIndex1375 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l1376
 					}
 					position++
-					goto l1272
-				l1273:
-					position, tokenIndex = position1272, tokenIndex1272
-					if buffer[position] != rune('E') {
-						goto l1266
+					goto l1375
+				l1376:
+					position, tokenIndex = position1375, tokenIndex1375
+					if buffer[position] != rune('I') {
+						goto l1369
 					}
 					position++
 				}
-			l1272:
+			l1375:
 				{
-					position1274, tokenIndex1274 := position, tokenIndex
-					if buffer[position] != rune('n') {
-						goto l1275
+					position1377, tokenIndex1377 := position, tokenIndex
+					if buffer[position] != rune('d') {
+						goto l1378
 					}
 					position++
-					goto l1274
-				l1275:
-					position, tokenIndex = position1274, tokenIndex1274
-					if buffer[position] != rune('N') {
-						goto l1266
+					goto l1377
+				l1378:
+					position, tokenIndex = position1377, tokenIndex1377
+					if buffer[position] != rune('D') {
+						goto l1369
+					}
+					position++
+				}
+			l1377:
+				{
+					position1379, tokenIndex1379 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1380
+					}
+					position++
+					goto l1379
+				l1380:
+					position, tokenIndex = position1379, tokenIndex1379
+					if buffer[position] != rune('E') {
+						goto l1369
 					}
 					position++
 				}
-			l1274:
+			l1379:
 				if !_rules[rulesp]() {
-					goto l1266
+					goto l1369
 				}
-				if !_rules[ruleExpression]() {
-					goto l1266
+				if !_rules[ruleInterval]() {
+					goto l1369
 				}
-				if !_rules[rulesp]() {
-					goto l1266
+				if !_rules[ruleAction66]() {
+					goto l1369
 				}
+				add(ruleSlideInterval, position1370)
+			}
+			return true
+		l1369:
+			position, tokenIndex = position1369, tokenIndex1369
+			return false
+		},
+		/* 87 LatenessSpecOpt <- <(<(spOpt ',' spOpt Lateness)?> Action67)> */
+		func() bool {
+			position1381, tokenIndex1381 := position, tokenIndex
+			{
+				position1382 := position
 				{
-					position1276, tokenIndex1276 := position, tokenIndex
-					if buffer[position] != rune('t') {
-						goto l1277
+					position1383 := position
+					{
+						position1384, tokenIndex1384 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1384
+						}
+						if buffer[position] != rune(',') {
+							goto l1384
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1384
+						}
+						if !_rules[ruleLateness]() {
+							goto l1384
+						}
+						goto l1385
+					l1384:
+						position, tokenIndex = position1384, tokenIndex1384
+					}
+				l1385:
+					add(rulePegText, position1383)
+				}
+				if !_rules[ruleAction67]() {
+					goto l1381
+				}
+				add(ruleLatenessSpecOpt, position1382)
+			}
+			return true
+		l1381:
+			position, tokenIndex = position1381, tokenIndex1381
+			return false
+		},
+		/* 88 Lateness <- <(('l' / 'L') ('a' / 'A') ('t' / 'T') ('e' / 'E') ('n' / 'N') ('e' / 'E') ('s' / 'S') ('s' / 'S') sp TimeInterval LatePolicyOpt Action68)> */
+		func() bool {
+			position1386, tokenIndex1386 := position, tokenIndex
+			{
+				position1387 := position
+				{
+					position1388, tokenIndex1388 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l1389
 					}
 					position++
-					goto l1276
-				l1277:
-					position, tokenIndex = position1276, tokenIndex1276
-					if buffer[position] != rune('T') {
-						goto l1266
+					goto l1388
+				l1389:
+					position, tokenIndex = position1388, tokenIndex1388
+					if buffer[position] != rune('L') {
+						goto l1386
 					}
 					position++
 				}
-			l1276:
+			l1388:
 				{
-					position1278, tokenIndex1278 := position, tokenIndex
-					if buffer[position] != rune('h') {
-						goto l1279
+					position1390, tokenIndex1390 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l1391
 					}
 					position++
-					goto l1278
-				l1279:
-					position, tokenIndex = position1278, tokenIndex1278
-					if buffer[position] != rune('H') {
-						goto l1266
+					goto l1390
+				l1391:
+					position, tokenIndex = position1390, tokenIndex1390
+					if buffer[position] != rune('A') {
+						goto l1386
+					}
+					position++
+				}
+			l1390:
+				{
+					position1392, tokenIndex1392 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l1393
+					}
+					position++
+					goto l1392
+				l1393:
+					position, tokenIndex = position1392, tokenIndex1392
+					if buffer[position] != rune('T') {
+						goto l1386
 					}
 					position++
 				}
-			l1278:
+			l1392:
 				{
-					position1280, tokenIndex1280 := position, tokenIndex
+					position1394, tokenIndex1394 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l1281
+						goto l1395
 					}
 					position++
-					goto l1280
-				l1281:
-					position, tokenIndex = position1280, tokenIndex1280
+					goto l1394
+				l1395:
+					position, tokenIndex = position1394, tokenIndex1394
 					if buffer[position] != rune('E') {
-						goto l1266
+						goto l1386
 					}
 					position++
 				}
-			l1280:
+			l1394:
 				{
-					position1282, tokenIndex1282 := position, tokenIndex
+					position1396, tokenIndex1396 := position, tokenIndex
 					if buffer[position] != rune('n') {
-						goto l1283
+						goto l1397
 					}
 					position++
-					goto l1282
-				l1283:
-					position, tokenIndex = position1282, tokenIndex1282
+					goto l1396
+				l1397:
+					position, tokenIndex = position1396, tokenIndex1396
 					if buffer[position] != rune('N') {
-						goto l1266
+						goto l1386
+					}
+					position++
+				}
+			l1396:
+				{
+					position1398, tokenIndex1398 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1399
+					}
+					position++
+					goto l1398
+				l1399:
+					position, tokenIndex = position1398, tokenIndex1398
+					if buffer[position] != rune('E') {
+						goto l1386
+					}
+					position++
+				}
+			l1398:
+				{
+					position1400, tokenIndex1400 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1401
+					}
+					position++
+					goto l1400
+				l1401:
+					position, tokenIndex = position1400, tokenIndex1400
+					if buffer[position] != rune('S') {
+						goto l1386
+					}
+					position++
+				}
+			l1400:
+				{
+					position1402, tokenIndex1402 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1403
+					}
+					position++
+					goto l1402
+				l1403:
+					position, tokenIndex = position1402, tokenIndex1402
+					if buffer[position] != rune('S') {
+						goto l1386
 					}
 					position++
 				}
-			l1282:
+			l1402:
 				if !_rules[rulesp]() {
-					goto l1266
+					goto l1386
 				}
-				if !_rules[ruleExpressionOrWildcard]() {
-					goto l1266
+				if !_rules[ruleTimeInterval]() {
+					goto l1386
 				}
-				if !_rules[ruleAction76]() {
-					goto l1266
+				if !_rules[ruleLatePolicyOpt]() {
+					goto l1386
+				}
+				if !_rules[ruleAction68]() {
+					goto l1386
 				}
-				add(ruleWhenThenPair, position1267)
+				add(ruleLateness, position1387)
 			}
 			return true
-		l1266:
-			position, tokenIndex = position1266, tokenIndex1266
+		l1386:
+			position, tokenIndex = position1386, tokenIndex1386
 			return false
 		},
-		/* 99 Literal <- <(FloatLiteral / NumericLiteral / StringLiteral)> */
+		/* 89 LatePolicyOpt <- <(<(sp LatePolicy)?> Action69)> */
 		func() bool {
-			position1284, tokenIndex1284 := position, tokenIndex
+			position1404, tokenIndex1404 := position, tokenIndex
 			{
-				position1285 := position
+				position1405 := position
 				{
-					position1286, tokenIndex1286 := position, tokenIndex
-					if !_rules[ruleFloatLiteral]() {
-						goto l1287
-					}
-					goto l1286
-				l1287:
-					position, tokenIndex = position1286, tokenIndex1286
-					if !_rules[ruleNumericLiteral]() {
-						goto l1288
-					}
-					goto l1286
-				l1288:
-					position, tokenIndex = position1286, tokenIndex1286
-					if !_rules[ruleStringLiteral]() {
-						goto l1284
+					position1406 := position
+					{
+						position1407, tokenIndex1407 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1407
+						}
+						if !_rules[ruleLatePolicy]() {
+							goto l1407
+						}
+						goto l1408
+					l1407:
+						position, tokenIndex = position1407, tokenIndex1407
 					}
+				l1408:
+					add(rulePegText, position1406)
 				}
-			l1286:
-				add(ruleLiteral, position1285)
+				if !_rules[ruleAction69]() {
+					goto l1404
+				}
+				add(ruleLatePolicyOpt, position1405)
 			}
 			return true
-		l1284:
-			position, tokenIndex = position1284, tokenIndex1284
+		l1404:
+			position, tokenIndex = position1404, tokenIndex1404
 			return false
 		},
-		/* 100 ComparisonOp <- <(Equal / NotEqual / LessOrEqual / Less / GreaterOrEqual / Greater / NotEqual)> */
+		/* 90 LatePolicy <- <(DropLateTuples / UpdateLateTuples / EmitLateTuples)> */
 		func() bool {
-			position1289, tokenIndex1289 := position, tokenIndex
+			position1409, tokenIndex1409 := position, tokenIndex
 			{
-				position1290 := position
+				position1410 := position
 				{
-					position1291, tokenIndex1291 := position, tokenIndex
-					if !_rules[ruleEqual]() {
-						goto l1292
-					}
-					goto l1291
-				l1292:
-					position, tokenIndex = position1291, tokenIndex1291
-					if !_rules[ruleNotEqual]() {
-						goto l1293
-					}
-					goto l1291
-				l1293:
-					position, tokenIndex = position1291, tokenIndex1291
-					if !_rules[ruleLessOrEqual]() {
-						goto l1294
-					}
-					goto l1291
-				l1294:
-					position, tokenIndex = position1291, tokenIndex1291
-					if !_rules[ruleLess]() {
-						goto l1295
-					}
-					goto l1291
-				l1295:
-					position, tokenIndex = position1291, tokenIndex1291
-					if !_rules[ruleGreaterOrEqual]() {
-						goto l1296
+					position1411, tokenIndex1411 := position, tokenIndex
+					if !_rules[ruleDropLateTuples]() {
+						goto l1412
 					}
-					goto l1291
-				l1296:
-					position, tokenIndex = position1291, tokenIndex1291
-					if !_rules[ruleGreater]() {
-						goto l1297
+					goto l1411
+				l1412:
+					position, tokenIndex = position1411, tokenIndex1411
+					if !_rules[ruleUpdateLateTuples]() {
+						goto l1413
 					}
-					goto l1291
-				l1297:
-					position, tokenIndex = position1291, tokenIndex1291
-					if !_rules[ruleNotEqual]() {
-						goto l1289
+					goto l1411
+				l1413:
+					position, tokenIndex = position1411, tokenIndex1411
+					if !_rules[ruleEmitLateTuples]() {
+						goto l1409
 					}
 				}
-			l1291:
-				add(ruleComparisonOp, position1290)
+			l1411:
+				add(ruleLatePolicy, position1410)
 			}
 			return true
-		l1289:
-			position, tokenIndex = position1289, tokenIndex1289
-			return false
-		},
-		/* 101 OtherOp <- <Concat> */
-		func() bool {
-			position1298, tokenIndex1298 := position, tokenIndex
-			{
-				position1299 := position
-				if !_rules[ruleConcat]() {
-					goto l1298
-				}
-				add(ruleOtherOp, position1299)
-			}
-			return true
-		l1298:
-			position, tokenIndex = position1298, tokenIndex1298
+		l1409:
+			position, tokenIndex = position1409, tokenIndex1409
 			return false
 		},
-		/* 102 IsOp <- <(IsNot / Is)> */
+		/* 91 EmitLateTuples <- <(('e' / 'E') ('m' / 'M') ('i' / 'I') ('t' / 'T') sp (('l' / 'L') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp (('t' / 'T') ('u' / 'U') ('p' / 'P') ('l' / 'L') ('e' / 'E') ('s' / 'S')) sp (('i' / 'I') ('n' / 'N') ('t' / 'T') ('o' / 'O')) sp StreamIdentifier Action70)> */
 		func() bool {
-			position1300, tokenIndex1300 := position, tokenIndex
+			position1414, tokenIndex1414 := position, tokenIndex
 			{
-				position1301 := position
+				position1415 := position
 				{
-					position1302, tokenIndex1302 := position, tokenIndex
-					if !_rules[ruleIsNot]() {
-						goto l1303
+					position1416, tokenIndex1416 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1417
 					}
-					goto l1302
-				l1303:
-					position, tokenIndex = position1302, tokenIndex1302
-					if !_rules[ruleIs]() {
-						goto l1300
+					position++
+					goto l1416
+				l1417:
+					position, tokenIndex = position1416, tokenIndex1416
+					if buffer[position] != rune('E') {
+						goto l1414
 					}
+					position++
 				}
-			l1302:
-				add(ruleIsOp, position1301)
-			}
-			return true
-		l1300:
-			position, tokenIndex = position1300, tokenIndex1300
-			return false
-		},
-		/* 103 PlusMinusOp <- <(Plus / Minus)> */
-		func() bool {
-			position1304, tokenIndex1304 := position, tokenIndex
-			{
-				position1305 := position
+			l1416:
 				{
-					position1306, tokenIndex1306 := position, tokenIndex
-					if !_rules[rulePlus]() {
-						goto l1307
+					position1418, tokenIndex1418 := position, tokenIndex
+					if buffer[position] != rune('m') {
+						goto l1419
 					}
-					goto l1306
-				l1307:
-					position, tokenIndex = position1306, tokenIndex1306
-					if !_rules[ruleMinus]() {
-						goto l1304
+					position++
+					goto l1418
+				l1419:
+					position, tokenIndex = position1418, tokenIndex1418
+					if buffer[position] != rune('M') {
+						goto l1414
 					}
+					position++
 				}
-			l1306:
-				add(rulePlusMinusOp, position1305)
-			}
-			return true
-		l1304:
-			position, tokenIndex = position1304, tokenIndex1304
-			return false
-		},
-		/* 104 MultDivOp <- <(Multiply / Divide / Modulo)> */
-		func() bool {
-			position1308, tokenIndex1308 := position, tokenIndex
-			{
-				position1309 := position
+			l1418:
 				{
-					position1310, tokenIndex1310 := position, tokenIndex
-					if !_rules[ruleMultiply]() {
-						goto l1311
-					}
-					goto l1310
-				l1311:
-					position, tokenIndex = position1310, tokenIndex1310
-					if !_rules[ruleDivide]() {
-						goto l1312
+					position1420, tokenIndex1420 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l1421
 					}
-					goto l1310
-				l1312:
-					position, tokenIndex = position1310, tokenIndex1310
-					if !_rules[ruleModulo]() {
-						goto l1308
+					position++
+					goto l1420
+				l1421:
+					position, tokenIndex = position1420, tokenIndex1420
+					if buffer[position] != rune('I') {
+						goto l1414
 					}
+					position++
 				}
-			l1310:
-				add(ruleMultDivOp, position1309)
-			}
-			return true
-		l1308:
-			position, tokenIndex = position1308, tokenIndex1308
-			return false
-		},
-		/* 105 Stream <- <(<ident> Action77)> */
-		func() bool {
-			position1313, tokenIndex1313 := position, tokenIndex
-			{
-				position1314 := position
+			l1420:
 				{
-					position1315 := position
-					if !_rules[ruleident]() {
-						goto l1313
+					position1422, tokenIndex1422 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l1423
+					}
+					position++
+					goto l1422
+				l1423:
+					position, tokenIndex = position1422, tokenIndex1422
+					if buffer[position] != rune('T') {
+						goto l1414
 					}
-					add(rulePegText, position1315)
+					position++
 				}
-				if !_rules[ruleAction77]() {
-					goto l1313
+			l1422:
+				if !_rules[rulesp]() {
+					goto l1414
 				}
-				add(ruleStream, position1314)
-			}
-			return true
-		l1313:
-			position, tokenIndex = position1313, tokenIndex1313
-			return false
-		},
-		/* 106 RowMeta <- <RowTimestamp> */
-		func() bool {
-			position1316, tokenIndex1316 := position, tokenIndex
-			{
-				position1317 := position
-				if !_rules[ruleRowTimestamp]() {
-					goto l1316
+				{
+					position1424, tokenIndex1424 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l1425
+					}
+					position++
+					goto l1424
+				l1425:
+					position, tokenIndex = position1424, tokenIndex1424
+					if buffer[position] != rune('L') {
+						goto l1414
+					}
+					position++
 				}
-				add(ruleRowMeta, position1317)
-			}
-			return true
-		l1316:
-			position, tokenIndex = position1316, tokenIndex1316
-			return false
-		},
-		/* 107 RowTimestamp <- <(<((ident ':')? ('t' 's' '(' ')'))> Action78)> */
-		func() bool {
-			position1318, tokenIndex1318 := position, tokenIndex
-			{
-				position1319 := position
+			l1424:
 				{
-					position1320 := position
-					{
-						position1321, tokenIndex1321 := position, tokenIndex
-						if !_rules[ruleident]() {
-							goto l1321
-						}
-						if buffer[position] != rune(':') {
-							goto l1321
-						}
-						position++
-						goto l1322
-					l1321:
-						position, tokenIndex = position1321, tokenIndex1321
+					position1426, tokenIndex1426 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l1427
 					}
-				l1322:
+					position++
+					goto l1426
+				l1427:
+					position, tokenIndex = position1426, tokenIndex1426
+					if buffer[position] != rune('A') {
+						goto l1414
+					}
+					position++
+				}
+			l1426:
+				{
+					position1428, tokenIndex1428 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l1318
+						goto l1429
 					}
 					position++
-					if buffer[position] != rune('s') {
-						goto l1318
+					goto l1428
+				l1429:
+					position, tokenIndex = position1428, tokenIndex1428
+					if buffer[position] != rune('T') {
+						goto l1414
 					}
 					position++
-					if buffer[position] != rune('(') {
-						goto l1318
+				}
+			l1428:
+				{
+					position1430, tokenIndex1430 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1431
 					}
 					position++
-					if buffer[position] != rune(')') {
-						goto l1318
+					goto l1430
+				l1431:
+					position, tokenIndex = position1430, tokenIndex1430
+					if buffer[position] != rune('E') {
+						goto l1414
 					}
 					position++
-					add(rulePegText, position1320)
 				}
-				if !_rules[ruleAction78]() {
-					goto l1318
+			l1430:
+				if !_rules[rulesp]() {
+					goto l1414
 				}
-				add(ruleRowTimestamp, position1319)
-			}
-			return true
-		l1318:
-			position, tokenIndex = position1318, tokenIndex1318
-			return false
-		},
-		/* 108 RowValue <- <(<((ident ':' !':')? jsonGetPath)> Action79)> */
-		func() bool {
-			position1323, tokenIndex1323 := position, tokenIndex
-			{
-				position1324 := position
 				{
-					position1325 := position
-					{
-						position1326, tokenIndex1326 := position, tokenIndex
-						if !_rules[ruleident]() {
-							goto l1326
-						}
-						if buffer[position] != rune(':') {
-							goto l1326
-						}
-						position++
-						{
-							position1328, tokenIndex1328 := position, tokenIndex
-							if buffer[position] != rune(':') {
-								goto l1328
-							}
-							position++
-							goto l1326
-						l1328:
-							position, tokenIndex = position1328, tokenIndex1328
-						}
-						goto l1327
-					l1326:
-						position, tokenIndex = position1326, tokenIndex1326
+					position1432, tokenIndex1432 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l1433
 					}
-				l1327:
-					if !_rules[rulejsonGetPath]() {
-						goto l1323
+					position++
+					goto l1432
+				l1433:
+					position, tokenIndex = position1432, tokenIndex1432
+					if buffer[position] != rune('T') {
+						goto l1414
 					}
-					add(rulePegText, position1325)
-				}
-				if !_rules[ruleAction79]() {
-					goto l1323
+					position++
 				}
-				add(ruleRowValue, position1324)
-			}
-			return true
-		l1323:
-			position, tokenIndex = position1323, tokenIndex1323
-			return false
-		},
-		/* 109 NumericLiteral <- <(<('-'? [0-9]+)> Action80)> */
-		func() bool {
-			position1329, tokenIndex1329 := position, tokenIndex
-			{
-				position1330 := position
+			l1432:
 				{
-					position1331 := position
-					{
-						position1332, tokenIndex1332 := position, tokenIndex
-						if buffer[position] != rune('-') {
-							goto l1332
-						}
-						position++
-						goto l1333
-					l1332:
-						position, tokenIndex = position1332, tokenIndex1332
-					}
-				l1333:
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1329
+					position1434, tokenIndex1434 := position, tokenIndex
+					if buffer[position] != rune('u') {
+						goto l1435
 					}
 					position++
-				l1334:
-					{
-						position1335, tokenIndex1335 := position, tokenIndex
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1335
-						}
-						position++
-						goto l1334
-					l1335:
-						position, tokenIndex = position1335, tokenIndex1335
+					goto l1434
+				l1435:
+					position, tokenIndex = position1434, tokenIndex1434
+					if buffer[position] != rune('U') {
+						goto l1414
 					}
-					add(rulePegText, position1331)
-				}
-				if !_rules[ruleAction80]() {
-					goto l1329
+					position++
 				}
-				add(ruleNumericLiteral, position1330)
-			}
-			return true
-		l1329:
-			position, tokenIndex = position1329, tokenIndex1329
-			return false
-		},
-		/* 110 NonNegativeNumericLiteral <- <(<[0-9]+> Action81)> */
-		func() bool {
-			position1336, tokenIndex1336 := position, tokenIndex
-			{
-				position1337 := position
+			l1434:
 				{
-					position1338 := position
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1336
+					position1436, tokenIndex1436 := position, tokenIndex
+					if buffer[position] != rune('p') {
+						goto l1437
 					}
 					position++
-				l1339:
-					{
-						position1340, tokenIndex1340 := position, tokenIndex
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1340
-						}
-						position++
-						goto l1339
-					l1340:
-						position, tokenIndex = position1340, tokenIndex1340
+					goto l1436
+				l1437:
+					position, tokenIndex = position1436, tokenIndex1436
+					if buffer[position] != rune('P') {
+						goto l1414
 					}
-					add(rulePegText, position1338)
-				}
-				if !_rules[ruleAction81]() {
-					goto l1336
+					position++
 				}
-				add(ruleNonNegativeNumericLiteral, position1337)
-			}
-			return true
-		l1336:
-			position, tokenIndex = position1336, tokenIndex1336
-			return false
-		},
-		/* 111 FloatLiteral <- <(<('-'? [0-9]+ '.' [0-9]+)> Action82)> */
-		func() bool {
-			position1341, tokenIndex1341 := position, tokenIndex
-			{
-				position1342 := position
+			l1436:
 				{
-					position1343 := position
-					{
-						position1344, tokenIndex1344 := position, tokenIndex
-						if buffer[position] != rune('-') {
-							goto l1344
-						}
-						position++
-						goto l1345
-					l1344:
-						position, tokenIndex = position1344, tokenIndex1344
+					position1438, tokenIndex1438 := position, tokenIndex
+					if buffer[position] != rune('l') {
+						goto l1439
 					}
-				l1345:
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1341
+					position++
+					goto l1438
+				l1439:
+					position, tokenIndex = position1438, tokenIndex1438
+					if buffer[position] != rune('L') {
+						goto l1414
 					}
 					position++
-				l1346:
-					{
-						position1347, tokenIndex1347 := position, tokenIndex
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1347
-						}
-						position++
-						goto l1346
-					l1347:
-						position, tokenIndex = position1347, tokenIndex1347
+				}
+			l1438:
+				{
+					position1440, tokenIndex1440 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1441
 					}
-					if buffer[position] != rune('.') {
-						goto l1341
+					position++
+					goto l1440
+				l1441:
+					position, tokenIndex = position1440, tokenIndex1440
+					if buffer[position] != rune('E') {
+						goto l1414
 					}
 					position++
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1341
+				}
+			l1440:
+				{
+					position1442, tokenIndex1442 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1443
 					}
 					position++
-				l1348:
-					{
-						position1349, tokenIndex1349 := position, tokenIndex
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1349
-						}
-						position++
-						goto l1348
-					l1349:
-						position, tokenIndex = position1349, tokenIndex1349
+					goto l1442
+				l1443:
+					position, tokenIndex = position1442, tokenIndex1442
+					if buffer[position] != rune('S') {
+						goto l1414
 					}
-					add(rulePegText, position1343)
+					position++
 				}
-				if !_rules[ruleAction82]() {
-					goto l1341
+			l1442:
+				if !_rules[rulesp]() {
+					goto l1414
 				}
-				add(ruleFloatLiteral, position1342)
-			}
-			return true
-		l1341:
-			position, tokenIndex = position1341, tokenIndex1341
-			return false
-		},
-		/* 112 Function <- <(<ident> Action83)> */
-		func() bool {
-			position1350, tokenIndex1350 := position, tokenIndex
-			{
-				position1351 := position
 				{
-					position1352 := position
-					if !_rules[ruleident]() {
-						goto l1350
+					position1444, tokenIndex1444 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l1445
+					}
+					position++
+					goto l1444
+				l1445:
+					position, tokenIndex = position1444, tokenIndex1444
+					if buffer[position] != rune('I') {
+						goto l1414
 					}
-					add(rulePegText, position1352)
+					position++
 				}
-				if !_rules[ruleAction83]() {
-					goto l1350
+			l1444:
+				{
+					position1446, tokenIndex1446 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l1447
+					}
+					position++
+					goto l1446
+				l1447:
+					position, tokenIndex = position1446, tokenIndex1446
+					if buffer[position] != rune('N') {
+						goto l1414
+					}
+					position++
 				}
-				add(ruleFunction, position1351)
-			}
-			return true
-		l1350:
-			position, tokenIndex = position1350, tokenIndex1350
-			return false
-		},
-		/* 113 NullLiteral <- <(<(('n' / 'N') ('u' / 'U') ('l' / 'L') ('l' / 'L'))> Action84)> */
-		func() bool {
-			position1353, tokenIndex1353 := position, tokenIndex
-			{
-				position1354 := position
+			l1446:
 				{
-					position1355 := position
-					{
-						position1356, tokenIndex1356 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1357
-						}
-						position++
-						goto l1356
-					l1357:
-						position, tokenIndex = position1356, tokenIndex1356
-						if buffer[position] != rune('N') {
-							goto l1353
-						}
-						position++
+					position1448, tokenIndex1448 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l1449
 					}
-				l1356:
-					{
-						position1358, tokenIndex1358 := position, tokenIndex
-						if buffer[position] != rune('u') {
-							goto l1359
-						}
-						position++
-						goto l1358
-					l1359:
-						position, tokenIndex = position1358, tokenIndex1358
-						if buffer[position] != rune('U') {
-							goto l1353
-						}
-						position++
+					position++
+					goto l1448
+				l1449:
+					position, tokenIndex = position1448, tokenIndex1448
+					if buffer[position] != rune('T') {
+						goto l1414
 					}
-				l1358:
-					{
-						position1360, tokenIndex1360 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l1361
-						}
-						position++
-						goto l1360
-					l1361:
-						position, tokenIndex = position1360, tokenIndex1360
-						if buffer[position] != rune('L') {
-							goto l1353
-						}
-						position++
+					position++
+				}
+			l1448:
+				{
+					position1450, tokenIndex1450 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l1451
 					}
-				l1360:
-					{
-						position1362, tokenIndex1362 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l1363
-						}
-						position++
-						goto l1362
-					l1363:
-						position, tokenIndex = position1362, tokenIndex1362
-						if buffer[position] != rune('L') {
-							goto l1353
-						}
-						position++
+					position++
+					goto l1450
+				l1451:
+					position, tokenIndex = position1450, tokenIndex1450
+					if buffer[position] != rune('O') {
+						goto l1414
 					}
-				l1362:
-					add(rulePegText, position1355)
+					position++
 				}
-				if !_rules[ruleAction84]() {
-					goto l1353
+			l1450:
+				if !_rules[rulesp]() {
+					goto l1414
+				}
+				if !_rules[ruleStreamIdentifier]() {
+					goto l1414
 				}
-				add(ruleNullLiteral, position1354)
+				if !_rules[ruleAction70]() {
+					goto l1414
+				}
+				add(ruleEmitLateTuples, position1415)
 			}
 			return true
-		l1353:
-			position, tokenIndex = position1353, tokenIndex1353
+		l1414:
+			position, tokenIndex = position1414, tokenIndex1414
 			return false
 		},
-		/* 114 Missing <- <(<(('m' / 'M') ('i' / 'I') ('s' / 'S') ('s' / 'S') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action85)> */
+		/* 92 CapacitySpecOpt <- <(<(spOpt ',' spOpt (('b' / 'B') ('u' / 'U') ('f' / 'F') ('f' / 'F') ('e' / 'E') ('r' / 'R')) sp (('s' / 'S') ('i' / 'I') ('z' / 'Z') ('e' / 'E')) sp NonNegativeNumericLiteral)?> Action71)> */
 		func() bool {
-			position1364, tokenIndex1364 := position, tokenIndex
+			position1452, tokenIndex1452 := position, tokenIndex
 			{
-				position1365 := position
+				position1453 := position
 				{
-					position1366 := position
+					position1454 := position
 					{
-						position1367, tokenIndex1367 := position, tokenIndex
-						if buffer[position] != rune('m') {
-							goto l1368
+						position1455, tokenIndex1455 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1455
 						}
-						position++
-						goto l1367
-					l1368:
-						position, tokenIndex = position1367, tokenIndex1367
-						if buffer[position] != rune('M') {
-							goto l1364
+						if buffer[position] != rune(',') {
+							goto l1455
 						}
 						position++
-					}
-				l1367:
-					{
-						position1369, tokenIndex1369 := position, tokenIndex
-						if buffer[position] != rune('i') {
-							goto l1370
+						if !_rules[rulespOpt]() {
+							goto l1455
 						}
-						position++
-						goto l1369
-					l1370:
-						position, tokenIndex = position1369, tokenIndex1369
-						if buffer[position] != rune('I') {
-							goto l1364
+						{
+							position1457, tokenIndex1457 := position, tokenIndex
+							if buffer[position] != rune('b') {
+								goto l1458
+							}
+							position++
+							goto l1457
+						l1458:
+							position, tokenIndex = position1457, tokenIndex1457
+							if buffer[position] != rune('B') {
+								goto l1455
+							}
+							position++
 						}
-						position++
-					}
-				l1369:
-					{
-						position1371, tokenIndex1371 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1372
+					l1457:
+						{
+							position1459, tokenIndex1459 := position, tokenIndex
+							if buffer[position] != rune('u') {
+								goto l1460
+							}
+							position++
+							goto l1459
+						l1460:
+							position, tokenIndex = position1459, tokenIndex1459
+							if buffer[position] != rune('U') {
+								goto l1455
+							}
+							position++
 						}
-						position++
-						goto l1371
-					l1372:
-						position, tokenIndex = position1371, tokenIndex1371
-						if buffer[position] != rune('S') {
-							goto l1364
+					l1459:
+						{
+							position1461, tokenIndex1461 := position, tokenIndex
+							if buffer[position] != rune('f') {
+								goto l1462
+							}
+							position++
+							goto l1461
+						l1462:
+							position, tokenIndex = position1461, tokenIndex1461
+							if buffer[position] != rune('F') {
+								goto l1455
+							}
+							position++
 						}
-						position++
-					}
-				l1371:
-					{
-						position1373, tokenIndex1373 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1374
+					l1461:
+						{
+							position1463, tokenIndex1463 := position, tokenIndex
+							if buffer[position] != rune('f') {
+								goto l1464
+							}
+							position++
+							goto l1463
+						l1464:
+							position, tokenIndex = position1463, tokenIndex1463
+							if buffer[position] != rune('F') {
+								goto l1455
+							}
+							position++
 						}
-						position++
-						goto l1373
-					l1374:
-						position, tokenIndex = position1373, tokenIndex1373
-						if buffer[position] != rune('S') {
-							goto l1364
+					l1463:
+						{
+							position1465, tokenIndex1465 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1466
+							}
+							position++
+							goto l1465
+						l1466:
+							position, tokenIndex = position1465, tokenIndex1465
+							if buffer[position] != rune('E') {
+								goto l1455
+							}
+							position++
 						}
-						position++
-					}
-				l1373:
-					{
-						position1375, tokenIndex1375 := position, tokenIndex
-						if buffer[position] != rune('i') {
-							goto l1376
+					l1465:
+						{
+							position1467, tokenIndex1467 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1468
+							}
+							position++
+							goto l1467
+						l1468:
+							position, tokenIndex = position1467, tokenIndex1467
+							if buffer[position] != rune('R') {
+								goto l1455
+							}
+							position++
 						}
-						position++
-						goto l1375
-					l1376:
-						position, tokenIndex = position1375, tokenIndex1375
-						if buffer[position] != rune('I') {
-							goto l1364
+					l1467:
+						if !_rules[rulesp]() {
+							goto l1455
 						}
-						position++
-					}
-				l1375:
-					{
-						position1377, tokenIndex1377 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1378
+						{
+							position1469, tokenIndex1469 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1470
+							}
+							position++
+							goto l1469
+						l1470:
+							position, tokenIndex = position1469, tokenIndex1469
+							if buffer[position] != rune('S') {
+								goto l1455
+							}
+							position++
 						}
-						position++
-						goto l1377
-					l1378:
-						position, tokenIndex = position1377, tokenIndex1377
-						if buffer[position] != rune('N') {
-							goto l1364
+					l1469:
+						{
+							position1471, tokenIndex1471 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1472
+							}
+							position++
+							goto l1471
+						l1472:
+							position, tokenIndex = position1471, tokenIndex1471
+							if buffer[position] != rune('I') {
+								goto l1455
+							}
+							position++
 						}
-						position++
-					}
-				l1377:
-					{
-						position1379, tokenIndex1379 := position, tokenIndex
-						if buffer[position] != rune('g') {
-							goto l1380
+					l1471:
+						{
+							position1473, tokenIndex1473 := position, tokenIndex
+							if buffer[position] != rune('z') {
+								goto l1474
+							}
+							position++
+							goto l1473
+						l1474:
+							position, tokenIndex = position1473, tokenIndex1473
+							if buffer[position] != rune('Z') {
+								goto l1455
+							}
+							position++
 						}
-						position++
-						goto l1379
-					l1380:
-						position, tokenIndex = position1379, tokenIndex1379
-						if buffer[position] != rune('G') {
-							goto l1364
+					l1473:
+						{
+							position1475, tokenIndex1475 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1476
+							}
+							position++
+							goto l1475
+						l1476:
+							position, tokenIndex = position1475, tokenIndex1475
+							if buffer[position] != rune('E') {
+								goto l1455
+							}
+							position++
 						}
-						position++
+					l1475:
+						if !_rules[rulesp]() {
+							goto l1455
+						}
+						if !_rules[ruleNonNegativeNumericLiteral]() {
+							goto l1455
+						}
+						goto l1456
+					l1455:
+						position, tokenIndex = position1455, tokenIndex1455
 					}
-				l1379:
-					add(rulePegText, position1366)
-				}
-				if !_rules[ruleAction85]() {
-					goto l1364
+				l1456:
+					add(rulePegText, position1454)
 				}
-				add(ruleMissing, position1365)
-			}
-			return true
-		l1364:
-			position, tokenIndex = position1364, tokenIndex1364
-			return false
-		},
-		/* 115 BooleanLiteral <- <(TRUE / FALSE)> */
-		func() bool {
-			position1381, tokenIndex1381 := position, tokenIndex
-			{
-				position1382 := position
-				{
-					position1383, tokenIndex1383 := position, tokenIndex
-					if !_rules[ruleTRUE]() {
-						goto l1384
-					}
-					goto l1383
-				l1384:
-					position, tokenIndex = position1383, tokenIndex1383
-					if !_rules[ruleFALSE]() {
-						goto l1381
-					}
+				if !_rules[ruleAction71]() {
+					goto l1452
 				}
-			l1383:
-				add(ruleBooleanLiteral, position1382)
+				add(ruleCapacitySpecOpt, position1453)
 			}
 			return true
-		l1381:
-			position, tokenIndex = position1381, tokenIndex1381
+		l1452:
+			position, tokenIndex = position1452, tokenIndex1452
 			return false
 		},
-		/* 116 TRUE <- <(<(('t' / 'T') ('r' / 'R') ('u' / 'U') ('e' / 'E'))> Action86)> */
+		/* 93 SheddingSpecOpt <- <(<(spOpt ',' spOpt SheddingOption sp (('i' / 'I') ('f' / 'F')) sp (('f' / 'F') ('u' / 'U') ('l' / 'L') ('l' / 'L')))?> Action72)> */
 		func() bool {
-			position1385, tokenIndex1385 := position, tokenIndex
+			position1477, tokenIndex1477 := position, tokenIndex
 			{
-				position1386 := position
+				position1478 := position
 				{
-					position1387 := position
+					position1479 := position
 					{
-						position1388, tokenIndex1388 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1389
+						position1480, tokenIndex1480 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1480
 						}
-						position++
-						goto l1388
-					l1389:
-						position, tokenIndex = position1388, tokenIndex1388
-						if buffer[position] != rune('T') {
-							goto l1385
+						if buffer[position] != rune(',') {
+							goto l1480
 						}
 						position++
-					}
-				l1388:
-					{
-						position1390, tokenIndex1390 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1391
+						if !_rules[rulespOpt]() {
+							goto l1480
 						}
-						position++
-						goto l1390
-					l1391:
-						position, tokenIndex = position1390, tokenIndex1390
-						if buffer[position] != rune('R') {
-							goto l1385
+						if !_rules[ruleSheddingOption]() {
+							goto l1480
 						}
-						position++
-					}
-				l1390:
-					{
-						position1392, tokenIndex1392 := position, tokenIndex
-						if buffer[position] != rune('u') {
-							goto l1393
+						if !_rules[rulesp]() {
+							goto l1480
 						}
-						position++
-						goto l1392
-					l1393:
-						position, tokenIndex = position1392, tokenIndex1392
-						if buffer[position] != rune('U') {
-							goto l1385
+						{
+							position1482, tokenIndex1482 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1483
+							}
+							position++
+							goto l1482
+						l1483:
+							position, tokenIndex = position1482, tokenIndex1482
+							if buffer[position] != rune('I') {
+								goto l1480
+							}
+							position++
 						}
-						position++
-					}
-				l1392:
-					{
-						position1394, tokenIndex1394 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1395
+					l1482:
+						{
+							position1484, tokenIndex1484 := position, tokenIndex
+							if buffer[position] != rune('f') {
+								goto l1485
+							}
+							position++
+							goto l1484
+						l1485:
+							position, tokenIndex = position1484, tokenIndex1484
+							if buffer[position] != rune('F') {
+								goto l1480
+							}
+							position++
 						}
-						position++
-						goto l1394
-					l1395:
-						position, tokenIndex = position1394, tokenIndex1394
-						if buffer[position] != rune('E') {
-							goto l1385
+					l1484:
+						if !_rules[rulesp]() {
+							goto l1480
 						}
-						position++
+						{
+							position1486, tokenIndex1486 := position, tokenIndex
+							if buffer[position] != rune('f') {
+								goto l1487
+							}
+							position++
+							goto l1486
+						l1487:
+							position, tokenIndex = position1486, tokenIndex1486
+							if buffer[position] != rune('F') {
+								goto l1480
+							}
+							position++
+						}
+					l1486:
+						{
+							position1488, tokenIndex1488 := position, tokenIndex
+							if buffer[position] != rune('u') {
+								goto l1489
+							}
+							position++
+							goto l1488
+						l1489:
+							position, tokenIndex = position1488, tokenIndex1488
+							if buffer[position] != rune('U') {
+								goto l1480
+							}
+							position++
+						}
+					l1488:
+						{
+							position1490, tokenIndex1490 := position, tokenIndex
+							if buffer[position] != rune('l') {
+								goto l1491
+							}
+							position++
+							goto l1490
+						l1491:
+							position, tokenIndex = position1490, tokenIndex1490
+							if buffer[position] != rune('L') {
+								goto l1480
+							}
+							position++
+						}
+					l1490:
+						{
+							position1492, tokenIndex1492 := position, tokenIndex
+							if buffer[position] != rune('l') {
+								goto l1493
+							}
+							position++
+							goto l1492
+						l1493:
+							position, tokenIndex = position1492, tokenIndex1492
+							if buffer[position] != rune('L') {
+								goto l1480
+							}
+							position++
+						}
+					l1492:
+						goto l1481
+					l1480:
+						position, tokenIndex = position1480, tokenIndex1480
 					}
-				l1394:
-					add(rulePegText, position1387)
+				l1481:
+					add(rulePegText, position1479)
 				}
-				if !_rules[ruleAction86]() {
-					goto l1385
+				if !_rules[ruleAction72]() {
+					goto l1477
 				}
-				add(ruleTRUE, position1386)
+				add(ruleSheddingSpecOpt, position1478)
 			}
 			return true
-		l1385:
-			position, tokenIndex = position1385, tokenIndex1385
+		l1477:
+			position, tokenIndex = position1477, tokenIndex1477
 			return false
 		},
-		/* 117 FALSE <- <(<(('f' / 'F') ('a' / 'A') ('l' / 'L') ('s' / 'S') ('e' / 'E'))> Action87)> */
+		/* 94 SheddingOption <- <(Wait / DropOldest / DropNewest)> */
 		func() bool {
-			position1396, tokenIndex1396 := position, tokenIndex
+			position1494, tokenIndex1494 := position, tokenIndex
 			{
-				position1397 := position
+				position1495 := position
 				{
-					position1398 := position
-					{
-						position1399, tokenIndex1399 := position, tokenIndex
-						if buffer[position] != rune('f') {
-							goto l1400
-						}
-						position++
-						goto l1399
-					l1400:
-						position, tokenIndex = position1399, tokenIndex1399
-						if buffer[position] != rune('F') {
-							goto l1396
-						}
-						position++
-					}
-				l1399:
-					{
-						position1401, tokenIndex1401 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1402
-						}
-						position++
-						goto l1401
-					l1402:
-						position, tokenIndex = position1401, tokenIndex1401
-						if buffer[position] != rune('A') {
-							goto l1396
-						}
-						position++
-					}
-				l1401:
-					{
-						position1403, tokenIndex1403 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l1404
-						}
-						position++
-						goto l1403
-					l1404:
-						position, tokenIndex = position1403, tokenIndex1403
-						if buffer[position] != rune('L') {
-							goto l1396
-						}
-						position++
+					position1496, tokenIndex1496 := position, tokenIndex
+					if !_rules[ruleWait]() {
+						goto l1497
 					}
-				l1403:
-					{
-						position1405, tokenIndex1405 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1406
-						}
-						position++
-						goto l1405
-					l1406:
-						position, tokenIndex = position1405, tokenIndex1405
-						if buffer[position] != rune('S') {
-							goto l1396
-						}
-						position++
+					goto l1496
+				l1497:
+					position, tokenIndex = position1496, tokenIndex1496
+					if !_rules[ruleDropOldest]() {
+						goto l1498
 					}
-				l1405:
-					{
-						position1407, tokenIndex1407 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1408
-						}
-						position++
-						goto l1407
-					l1408:
-						position, tokenIndex = position1407, tokenIndex1407
-						if buffer[position] != rune('E') {
-							goto l1396
-						}
-						position++
+					goto l1496
+				l1498:
+					position, tokenIndex = position1496, tokenIndex1496
+					if !_rules[ruleDropNewest]() {
+						goto l1494
 					}
-				l1407:
-					add(rulePegText, position1398)
 				}
-				if !_rules[ruleAction87]() {
-					goto l1396
-				}
-				add(ruleFALSE, position1397)
+			l1496:
+				add(ruleSheddingOption, position1495)
 			}
 			return true
-		l1396:
-			position, tokenIndex = position1396, tokenIndex1396
+		l1494:
+			position, tokenIndex = position1494, tokenIndex1494
 			return false
 		},
-		/* 118 Wildcard <- <(<((ident ':' !':')? '*')> Action88)> */
+		/* 95 SourceSinkSpecs <- <(<(sp (('w' / 'W') ('i' / 'I') ('t' / 'T') ('h' / 'H')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)?> Action73)> */
 		func() bool {
-			position1409, tokenIndex1409 := position, tokenIndex
+			position1499, tokenIndex1499 := position, tokenIndex
 			{
-				position1410 := position
+				position1500 := position
 				{
-					position1411 := position
+					position1501 := position
 					{
-						position1412, tokenIndex1412 := position, tokenIndex
-						if !_rules[ruleident]() {
-							goto l1412
+						position1502, tokenIndex1502 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1502
 						}
-						if buffer[position] != rune(':') {
-							goto l1412
+						{
+							position1504, tokenIndex1504 := position, tokenIndex
+							if buffer[position] != rune('w') {
+								goto l1505
+							}
+							position++
+							goto l1504
+						l1505:
+							position, tokenIndex = position1504, tokenIndex1504
+							if buffer[position] != rune('W') {
+								goto l1502
+							}
+							position++
 						}
-						position++
+					l1504:
 						{
-							position1414, tokenIndex1414 := position, tokenIndex
-							if buffer[position] != rune(':') {
-								goto l1414
+							position1506, tokenIndex1506 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1507
+							}
+							position++
+							goto l1506
+						l1507:
+							position, tokenIndex = position1506, tokenIndex1506
+							if buffer[position] != rune('I') {
+								goto l1502
 							}
 							position++
-							goto l1412
-						l1414:
-							position, tokenIndex = position1414, tokenIndex1414
 						}
-						goto l1413
-					l1412:
-						position, tokenIndex = position1412, tokenIndex1412
-					}
-				l1413:
-					if buffer[position] != rune('*') {
-						goto l1409
-					}
-					position++
-					add(rulePegText, position1411)
-				}
-				if !_rules[ruleAction88]() {
-					goto l1409
-				}
-				add(ruleWildcard, position1410)
-			}
-			return true
-		l1409:
-			position, tokenIndex = position1409, tokenIndex1409
-			return false
-		},
-		/* 119 StringLiteral <- <(<('"' (('"' '"') / (!'"' .))* '"')> Action89)> */
-		func() bool {
-			position1415, tokenIndex1415 := position, tokenIndex
-			{
-				position1416 := position
-				{
-					position1417 := position
-					if buffer[position] != rune('"') {
-						goto l1415
-					}
-					position++
-				l1418:
-					{
-						position1419, tokenIndex1419 := position, tokenIndex
+					l1506:
 						{
-							position1420, tokenIndex1420 := position, tokenIndex
-							if buffer[position] != rune('"') {
-								goto l1421
+							position1508, tokenIndex1508 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1509
 							}
 							position++
-							if buffer[position] != rune('"') {
-								goto l1421
+							goto l1508
+						l1509:
+							position, tokenIndex = position1508, tokenIndex1508
+							if buffer[position] != rune('T') {
+								goto l1502
 							}
 							position++
-							goto l1420
-						l1421:
-							position, tokenIndex = position1420, tokenIndex1420
-							{
-								position1422, tokenIndex1422 := position, tokenIndex
-								if buffer[position] != rune('"') {
-									goto l1422
-								}
-								position++
-								goto l1419
-							l1422:
-								position, tokenIndex = position1422, tokenIndex1422
+						}
+					l1508:
+						{
+							position1510, tokenIndex1510 := position, tokenIndex
+							if buffer[position] != rune('h') {
+								goto l1511
 							}
-							if !matchDot() {
-								goto l1419
+							position++
+							goto l1510
+						l1511:
+							position, tokenIndex = position1510, tokenIndex1510
+							if buffer[position] != rune('H') {
+								goto l1502
 							}
+							position++
 						}
-					l1420:
-						goto l1418
-					l1419:
-						position, tokenIndex = position1419, tokenIndex1419
-					}
-					if buffer[position] != rune('"') {
-						goto l1415
+					l1510:
+						if !_rules[rulesp]() {
+							goto l1502
+						}
+						if !_rules[ruleSourceSinkParam]() {
+							goto l1502
+						}
+					l1512:
+						{
+							position1513, tokenIndex1513 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l1513
+							}
+							if buffer[position] != rune(',') {
+								goto l1513
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1513
+							}
+							if !_rules[ruleSourceSinkParam]() {
+								goto l1513
+							}
+							goto l1512
+						l1513:
+							position, tokenIndex = position1513, tokenIndex1513
+						}
+						goto l1503
+					l1502:
+						position, tokenIndex = position1502, tokenIndex1502
 					}
-					position++
-					add(rulePegText, position1417)
+				l1503:
+					add(rulePegText, position1501)
 				}
-				if !_rules[ruleAction89]() {
-					goto l1415
+				if !_rules[ruleAction73]() {
+					goto l1499
 				}
-				add(ruleStringLiteral, position1416)
+				add(ruleSourceSinkSpecs, position1500)
 			}
 			return true
-		l1415:
-			position, tokenIndex = position1415, tokenIndex1415
+		l1499:
+			position, tokenIndex = position1499, tokenIndex1499
 			return false
 		},
-		/* 120 ISTREAM <- <(<(('i' / 'I') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action90)> */
+		/* 96 UpdateSourceSinkSpecs <- <(<(sp (('s' / 'S') ('e' / 'E') ('t' / 'T')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)> Action74)> */
 		func() bool {
-			position1423, tokenIndex1423 := position, tokenIndex
+			position1514, tokenIndex1514 := position, tokenIndex
 			{
-				position1424 := position
+				position1515 := position
 				{
-					position1425 := position
-					{
-						position1426, tokenIndex1426 := position, tokenIndex
-						if buffer[position] != rune('i') {
-							goto l1427
-						}
-						position++
-						goto l1426
-					l1427:
-						position, tokenIndex = position1426, tokenIndex1426
-						if buffer[position] != rune('I') {
-							goto l1423
-						}
-						position++
+					position1516 := position
+					if !_rules[rulesp]() {
+						goto l1514
 					}
-				l1426:
 					{
-						position1428, tokenIndex1428 := position, tokenIndex
+						position1517, tokenIndex1517 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1429
+							goto l1518
 						}
 						position++
-						goto l1428
-					l1429:
-						position, tokenIndex = position1428, tokenIndex1428
+						goto l1517
+					l1518:
+						position, tokenIndex = position1517, tokenIndex1517
 						if buffer[position] != rune('S') {
-							goto l1423
+							goto l1514
 						}
 						position++
 					}
-				l1428:
+				l1517:
 					{
-						position1430, tokenIndex1430 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1431
+						position1519, tokenIndex1519 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1520
 						}
 						position++
-						goto l1430
-					l1431:
-						position, tokenIndex = position1430, tokenIndex1430
-						if buffer[position] != rune('T') {
-							goto l1423
+						goto l1519
+					l1520:
+						position, tokenIndex = position1519, tokenIndex1519
+						if buffer[position] != rune('E') {
+							goto l1514
 						}
 						position++
 					}
-				l1430:
+				l1519:
 					{
-						position1432, tokenIndex1432 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1433
+						position1521, tokenIndex1521 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1522
 						}
 						position++
-						goto l1432
-					l1433:
-						position, tokenIndex = position1432, tokenIndex1432
-						if buffer[position] != rune('R') {
-							goto l1423
+						goto l1521
+					l1522:
+						position, tokenIndex = position1521, tokenIndex1521
+						if buffer[position] != rune('T') {
+							goto l1514
 						}
 						position++
 					}
-				l1432:
-					{
-						position1434, tokenIndex1434 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1435
-						}
-						position++
-						goto l1434
-					l1435:
-						position, tokenIndex = position1434, tokenIndex1434
-						if buffer[position] != rune('E') {
-							goto l1423
-						}
-						position++
+				l1521:
+					if !_rules[rulesp]() {
+						goto l1514
+					}
+					if !_rules[ruleSourceSinkParam]() {
+						goto l1514
 					}
-				l1434:
+				l1523:
 					{
-						position1436, tokenIndex1436 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1437
+						position1524, tokenIndex1524 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1524
 						}
-						position++
-						goto l1436
-					l1437:
-						position, tokenIndex = position1436, tokenIndex1436
-						if buffer[position] != rune('A') {
-							goto l1423
+						if buffer[position] != rune(',') {
+							goto l1524
 						}
 						position++
-					}
-				l1436:
-					{
-						position1438, tokenIndex1438 := position, tokenIndex
-						if buffer[position] != rune('m') {
-							goto l1439
+						if !_rules[rulespOpt]() {
+							goto l1524
 						}
-						position++
-						goto l1438
-					l1439:
-						position, tokenIndex = position1438, tokenIndex1438
-						if buffer[position] != rune('M') {
-							goto l1423
+						if !_rules[ruleSourceSinkParam]() {
+							goto l1524
 						}
-						position++
+						goto l1523
+					l1524:
+						position, tokenIndex = position1524, tokenIndex1524
 					}
-				l1438:
-					add(rulePegText, position1425)
+					add(rulePegText, position1516)
 				}
-				if !_rules[ruleAction90]() {
-					goto l1423
+				if !_rules[ruleAction74]() {
+					goto l1514
 				}
-				add(ruleISTREAM, position1424)
+				add(ruleUpdateSourceSinkSpecs, position1515)
 			}
 			return true
-		l1423:
-			position, tokenIndex = position1423, tokenIndex1423
+		l1514:
+			position, tokenIndex = position1514, tokenIndex1514
 			return false
 		},
-		/* 121 DSTREAM <- <(<(('d' / 'D') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action91)> */
+		/* 97 SetOptSpecs <- <(<(sp (('s' / 'S') ('e' / 'E') ('t' / 'T')) sp SourceSinkParam (spOpt ',' spOpt SourceSinkParam)*)?> Action75)> */
 		func() bool {
-			position1440, tokenIndex1440 := position, tokenIndex
+			position1525, tokenIndex1525 := position, tokenIndex
 			{
-				position1441 := position
+				position1526 := position
 				{
-					position1442 := position
+					position1527 := position
 					{
-						position1443, tokenIndex1443 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1444
-						}
-						position++
-						goto l1443
-					l1444:
-						position, tokenIndex = position1443, tokenIndex1443
-						if buffer[position] != rune('D') {
-							goto l1440
+						position1528, tokenIndex1528 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1528
 						}
-						position++
-					}
-				l1443:
-					{
-						position1445, tokenIndex1445 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1446
-						}
-						position++
-						goto l1445
-					l1446:
-						position, tokenIndex = position1445, tokenIndex1445
-						if buffer[position] != rune('S') {
-							goto l1440
-						}
-						position++
-					}
-				l1445:
-					{
-						position1447, tokenIndex1447 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1448
-						}
-						position++
-						goto l1447
-					l1448:
-						position, tokenIndex = position1447, tokenIndex1447
-						if buffer[position] != rune('T') {
-							goto l1440
-						}
-						position++
-					}
-				l1447:
-					{
-						position1449, tokenIndex1449 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1450
-						}
-						position++
-						goto l1449
-					l1450:
-						position, tokenIndex = position1449, tokenIndex1449
-						if buffer[position] != rune('R') {
-							goto l1440
-						}
-						position++
-					}
-				l1449:
-					{
-						position1451, tokenIndex1451 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1452
+						{
+							position1530, tokenIndex1530 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1531
+							}
+							position++
+							goto l1530
+						l1531:
+							position, tokenIndex = position1530, tokenIndex1530
+							if buffer[position] != rune('S') {
+								goto l1528
+							}
+							position++
 						}
-						position++
-						goto l1451
-					l1452:
-						position, tokenIndex = position1451, tokenIndex1451
-						if buffer[position] != rune('E') {
-							goto l1440
+					l1530:
+						{
+							position1532, tokenIndex1532 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1533
+							}
+							position++
+							goto l1532
+						l1533:
+							position, tokenIndex = position1532, tokenIndex1532
+							if buffer[position] != rune('E') {
+								goto l1528
+							}
+							position++
 						}
-						position++
-					}
-				l1451:
-					{
-						position1453, tokenIndex1453 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1454
+					l1532:
+						{
+							position1534, tokenIndex1534 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1535
+							}
+							position++
+							goto l1534
+						l1535:
+							position, tokenIndex = position1534, tokenIndex1534
+							if buffer[position] != rune('T') {
+								goto l1528
+							}
+							position++
 						}
-						position++
-						goto l1453
-					l1454:
-						position, tokenIndex = position1453, tokenIndex1453
-						if buffer[position] != rune('A') {
-							goto l1440
+					l1534:
+						if !_rules[rulesp]() {
+							goto l1528
 						}
-						position++
-					}
-				l1453:
-					{
-						position1455, tokenIndex1455 := position, tokenIndex
-						if buffer[position] != rune('m') {
-							goto l1456
+						if !_rules[ruleSourceSinkParam]() {
+							goto l1528
 						}
-						position++
-						goto l1455
-					l1456:
-						position, tokenIndex = position1455, tokenIndex1455
-						if buffer[position] != rune('M') {
-							goto l1440
+					l1536:
+						{
+							position1537, tokenIndex1537 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l1537
+							}
+							if buffer[position] != rune(',') {
+								goto l1537
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1537
+							}
+							if !_rules[ruleSourceSinkParam]() {
+								goto l1537
+							}
+							goto l1536
+						l1537:
+							position, tokenIndex = position1537, tokenIndex1537
 						}
-						position++
+						goto l1529
+					l1528:
+						position, tokenIndex = position1528, tokenIndex1528
 					}
-				l1455:
-					add(rulePegText, position1442)
+				l1529:
+					add(rulePegText, position1527)
 				}
-				if !_rules[ruleAction91]() {
-					goto l1440
+				if !_rules[ruleAction75]() {
+					goto l1525
 				}
-				add(ruleDSTREAM, position1441)
+				add(ruleSetOptSpecs, position1526)
 			}
 			return true
-		l1440:
-			position, tokenIndex = position1440, tokenIndex1440
+		l1525:
+			position, tokenIndex = position1525, tokenIndex1525
 			return false
 		},
-		/* 122 RSTREAM <- <(<(('r' / 'R') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action92)> */
+		/* 98 OnErrorOpt <- <(<(sp (('o' / 'O') ('n' / 'N')) sp (('e' / 'E') ('r' / 'R') ('r' / 'R') ('o' / 'O') ('r' / 'R')) sp (('i' / 'I') ('n' / 'N') ('s' / 'S') ('e' / 'E') ('r' / 'R') ('t' / 'T')) sp (('i' / 'I') ('n' / 'N') ('t' / 'T') ('o' / 'O')) sp StreamIdentifier)?> Action76)> */
 		func() bool {
-			position1457, tokenIndex1457 := position, tokenIndex
+			position1538, tokenIndex1538 := position, tokenIndex
 			{
-				position1458 := position
+				position1539 := position
 				{
-					position1459 := position
+					position1540 := position
 					{
-						position1460, tokenIndex1460 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1461
+						position1541, tokenIndex1541 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1541
 						}
-						position++
-						goto l1460
-					l1461:
-						position, tokenIndex = position1460, tokenIndex1460
-						if buffer[position] != rune('R') {
-							goto l1457
+						{
+							position1543, tokenIndex1543 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1544
+							}
+							position++
+							goto l1543
+						l1544:
+							position, tokenIndex = position1543, tokenIndex1543
+							if buffer[position] != rune('O') {
+								goto l1541
+							}
+							position++
 						}
-						position++
-					}
-				l1460:
-					{
-						position1462, tokenIndex1462 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1463
+					l1543:
+						{
+							position1545, tokenIndex1545 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l1546
+							}
+							position++
+							goto l1545
+						l1546:
+							position, tokenIndex = position1545, tokenIndex1545
+							if buffer[position] != rune('N') {
+								goto l1541
+							}
+							position++
 						}
-						position++
-						goto l1462
-					l1463:
-						position, tokenIndex = position1462, tokenIndex1462
-						if buffer[position] != rune('S') {
-							goto l1457
+					l1545:
+						if !_rules[rulesp]() {
+							goto l1541
 						}
-						position++
-					}
-				l1462:
-					{
-						position1464, tokenIndex1464 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1465
+						{
+							position1547, tokenIndex1547 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1548
+							}
+							position++
+							goto l1547
+						l1548:
+							position, tokenIndex = position1547, tokenIndex1547
+							if buffer[position] != rune('E') {
+								goto l1541
+							}
+							position++
 						}
-						position++
-						goto l1464
-					l1465:
-						position, tokenIndex = position1464, tokenIndex1464
-						if buffer[position] != rune('T') {
-							goto l1457
+					l1547:
+						{
+							position1549, tokenIndex1549 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1550
+							}
+							position++
+							goto l1549
+						l1550:
+							position, tokenIndex = position1549, tokenIndex1549
+							if buffer[position] != rune('R') {
+								goto l1541
+							}
+							position++
 						}
-						position++
-					}
-				l1464:
-					{
-						position1466, tokenIndex1466 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1467
+					l1549:
+						{
+							position1551, tokenIndex1551 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1552
+							}
+							position++
+							goto l1551
+						l1552:
+							position, tokenIndex = position1551, tokenIndex1551
+							if buffer[position] != rune('R') {
+								goto l1541
+							}
+							position++
 						}
-						position++
-						goto l1466
-					l1467:
-						position, tokenIndex = position1466, tokenIndex1466
-						if buffer[position] != rune('R') {
-							goto l1457
+					l1551:
+						{
+							position1553, tokenIndex1553 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1554
+							}
+							position++
+							goto l1553
+						l1554:
+							position, tokenIndex = position1553, tokenIndex1553
+							if buffer[position] != rune('O') {
+								goto l1541
+							}
+							position++
 						}
-						position++
-					}
-				l1466:
-					{
-						position1468, tokenIndex1468 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1469
+					l1553:
+						{
+							position1555, tokenIndex1555 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1556
+							}
+							position++
+							goto l1555
+						l1556:
+							position, tokenIndex = position1555, tokenIndex1555
+							if buffer[position] != rune('R') {
+								goto l1541
+							}
+							position++
 						}
-						position++
-						goto l1468
-					l1469:
-						position, tokenIndex = position1468, tokenIndex1468
-						if buffer[position] != rune('E') {
-							goto l1457
+					l1555:
+						if !_rules[rulesp]() {
+							goto l1541
 						}
-						position++
-					}
-				l1468:
-					{
-						position1470, tokenIndex1470 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1471
+						{
+							position1557, tokenIndex1557 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1558
+							}
+							position++
+							goto l1557
+						l1558:
+							position, tokenIndex = position1557, tokenIndex1557
+							if buffer[position] != rune('I') {
+								goto l1541
+							}
+							position++
 						}
-						position++
-						goto l1470
-					l1471:
-						position, tokenIndex = position1470, tokenIndex1470
-						if buffer[position] != rune('A') {
-							goto l1457
+					l1557:
+						{
+							position1559, tokenIndex1559 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l1560
+							}
+							position++
+							goto l1559
+						l1560:
+							position, tokenIndex = position1559, tokenIndex1559
+							if buffer[position] != rune('N') {
+								goto l1541
+							}
+							position++
 						}
-						position++
-					}
-				l1470:
-					{
-						position1472, tokenIndex1472 := position, tokenIndex
-						if buffer[position] != rune('m') {
-							goto l1473
+					l1559:
+						{
+							position1561, tokenIndex1561 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1562
+							}
+							position++
+							goto l1561
+						l1562:
+							position, tokenIndex = position1561, tokenIndex1561
+							if buffer[position] != rune('S') {
+								goto l1541
+							}
+							position++
 						}
-						position++
-						goto l1472
-					l1473:
-						position, tokenIndex = position1472, tokenIndex1472
-						if buffer[position] != rune('M') {
-							goto l1457
+					l1561:
+						{
+							position1563, tokenIndex1563 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1564
+							}
+							position++
+							goto l1563
+						l1564:
+							position, tokenIndex = position1563, tokenIndex1563
+							if buffer[position] != rune('E') {
+								goto l1541
+							}
+							position++
 						}
-						position++
-					}
-				l1472:
-					add(rulePegText, position1459)
-				}
-				if !_rules[ruleAction92]() {
-					goto l1457
-				}
-				add(ruleRSTREAM, position1458)
-			}
-			return true
-		l1457:
-			position, tokenIndex = position1457, tokenIndex1457
-			return false
-		},
-		/* 123 TUPLES <- <(<(('t' / 'T') ('u' / 'U') ('p' / 'P') ('l' / 'L') ('e' / 'E') ('s' / 'S'))> Action93)> */
-		func() bool {
-			position1474, tokenIndex1474 := position, tokenIndex
-			{
-				position1475 := position
-				{
-					position1476 := position
-					{
-						position1477, tokenIndex1477 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1478
-						}
-						position++
-						goto l1477
-					l1478:
-						position, tokenIndex = position1477, tokenIndex1477
-						if buffer[position] != rune('T') {
-							goto l1474
-						}
-						position++
-					}
-				l1477:
-					{
-						position1479, tokenIndex1479 := position, tokenIndex
-						if buffer[position] != rune('u') {
-							goto l1480
-						}
-						position++
-						goto l1479
-					l1480:
-						position, tokenIndex = position1479, tokenIndex1479
-						if buffer[position] != rune('U') {
-							goto l1474
+					l1563:
+						{
+							position1565, tokenIndex1565 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1566
+							}
+							position++
+							goto l1565
+						l1566:
+							position, tokenIndex = position1565, tokenIndex1565
+							if buffer[position] != rune('R') {
+								goto l1541
+							}
+							position++
 						}
-						position++
-					}
-				l1479:
-					{
-						position1481, tokenIndex1481 := position, tokenIndex
-						if buffer[position] != rune('p') {
-							goto l1482
+					l1565:
+						{
+							position1567, tokenIndex1567 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1568
+							}
+							position++
+							goto l1567
+						l1568:
+							position, tokenIndex = position1567, tokenIndex1567
+							if buffer[position] != rune('T') {
+								goto l1541
+							}
+							position++
 						}
-						position++
-						goto l1481
-					l1482:
-						position, tokenIndex = position1481, tokenIndex1481
-						if buffer[position] != rune('P') {
-							goto l1474
+					l1567:
+						if !_rules[rulesp]() {
+							goto l1541
 						}
-						position++
-					}
-				l1481:
-					{
-						position1483, tokenIndex1483 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l1484
+						{
+							position1569, tokenIndex1569 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1570
+							}
+							position++
+							goto l1569
+						l1570:
+							position, tokenIndex = position1569, tokenIndex1569
+							if buffer[position] != rune('I') {
+								goto l1541
+							}
+							position++
 						}
-						position++
-						goto l1483
-					l1484:
-						position, tokenIndex = position1483, tokenIndex1483
-						if buffer[position] != rune('L') {
-							goto l1474
+					l1569:
+						{
+							position1571, tokenIndex1571 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l1572
+							}
+							position++
+							goto l1571
+						l1572:
+							position, tokenIndex = position1571, tokenIndex1571
+							if buffer[position] != rune('N') {
+								goto l1541
+							}
+							position++
 						}
-						position++
-					}
-				l1483:
-					{
-						position1485, tokenIndex1485 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1486
+					l1571:
+						{
+							position1573, tokenIndex1573 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1574
+							}
+							position++
+							goto l1573
+						l1574:
+							position, tokenIndex = position1573, tokenIndex1573
+							if buffer[position] != rune('T') {
+								goto l1541
+							}
+							position++
 						}
-						position++
-						goto l1485
-					l1486:
-						position, tokenIndex = position1485, tokenIndex1485
-						if buffer[position] != rune('E') {
-							goto l1474
+					l1573:
+						{
+							position1575, tokenIndex1575 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1576
+							}
+							position++
+							goto l1575
+						l1576:
+							position, tokenIndex = position1575, tokenIndex1575
+							if buffer[position] != rune('O') {
+								goto l1541
+							}
+							position++
 						}
-						position++
-					}
-				l1485:
-					{
-						position1487, tokenIndex1487 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1488
+					l1575:
+						if !_rules[rulesp]() {
+							goto l1541
 						}
-						position++
-						goto l1487
-					l1488:
-						position, tokenIndex = position1487, tokenIndex1487
-						if buffer[position] != rune('S') {
-							goto l1474
+						if !_rules[ruleStreamIdentifier]() {
+							goto l1541
 						}
-						position++
+						goto l1542
+					l1541:
+						position, tokenIndex = position1541, tokenIndex1541
 					}
-				l1487:
-					add(rulePegText, position1476)
+				l1542:
+					add(rulePegText, position1540)
 				}
-				if !_rules[ruleAction93]() {
-					goto l1474
+				if !_rules[ruleAction76]() {
+					goto l1538
 				}
-				add(ruleTUPLES, position1475)
+				add(ruleOnErrorOpt, position1539)
 			}
 			return true
-		l1474:
-			position, tokenIndex = position1474, tokenIndex1474
+		l1538:
+			position, tokenIndex = position1538, tokenIndex1538
 			return false
 		},
-		/* 124 SECONDS <- <(<(('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S'))> Action94)> */
+		/* 99 StateTagOpt <- <(<(sp (('t' / 'T') ('a' / 'A') ('g' / 'G')) sp Identifier)?> Action77)> */
 		func() bool {
-			position1489, tokenIndex1489 := position, tokenIndex
+			position1577, tokenIndex1577 := position, tokenIndex
 			{
-				position1490 := position
+				position1578 := position
 				{
-					position1491 := position
+					position1579 := position
 					{
-						position1492, tokenIndex1492 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1493
+						position1580, tokenIndex1580 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1580
 						}
-						position++
-						goto l1492
-					l1493:
-						position, tokenIndex = position1492, tokenIndex1492
-						if buffer[position] != rune('S') {
-							goto l1489
+						{
+							position1582, tokenIndex1582 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1583
+							}
+							position++
+							goto l1582
+						l1583:
+							position, tokenIndex = position1582, tokenIndex1582
+							if buffer[position] != rune('T') {
+								goto l1580
+							}
+							position++
 						}
-						position++
-					}
-				l1492:
-					{
-						position1494, tokenIndex1494 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1495
+					l1582:
+						{
+							position1584, tokenIndex1584 := position, tokenIndex
+							if buffer[position] != rune('a') {
+								goto l1585
+							}
+							position++
+							goto l1584
+						l1585:
+							position, tokenIndex = position1584, tokenIndex1584
+							if buffer[position] != rune('A') {
+								goto l1580
+							}
+							position++
 						}
-						position++
-						goto l1494
-					l1495:
-						position, tokenIndex = position1494, tokenIndex1494
-						if buffer[position] != rune('E') {
-							goto l1489
+					l1584:
+						{
+							position1586, tokenIndex1586 := position, tokenIndex
+							if buffer[position] != rune('g') {
+								goto l1587
+							}
+							position++
+							goto l1586
+						l1587:
+							position, tokenIndex = position1586, tokenIndex1586
+							if buffer[position] != rune('G') {
+								goto l1580
+							}
+							position++
 						}
-						position++
-					}
-				l1494:
-					{
-						position1496, tokenIndex1496 := position, tokenIndex
-						if buffer[position] != rune('c') {
-							goto l1497
+					l1586:
+						if !_rules[rulesp]() {
+							goto l1580
 						}
-						position++
-						goto l1496
-					l1497:
-						position, tokenIndex = position1496, tokenIndex1496
-						if buffer[position] != rune('C') {
-							goto l1489
+						if !_rules[ruleIdentifier]() {
+							goto l1580
 						}
-						position++
+						goto l1581
+					l1580:
+						position, tokenIndex = position1580, tokenIndex1580
 					}
-				l1496:
-					{
-						position1498, tokenIndex1498 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1499
-						}
-						position++
-						goto l1498
-					l1499:
-						position, tokenIndex = position1498, tokenIndex1498
-						if buffer[position] != rune('O') {
-							goto l1489
-						}
-						position++
+				l1581:
+					add(rulePegText, position1579)
+				}
+				if !_rules[ruleAction77]() {
+					goto l1577
+				}
+				add(ruleStateTagOpt, position1578)
+			}
+			return true
+		l1577:
+			position, tokenIndex = position1577, tokenIndex1577
+			return false
+		},
+		/* 100 SourceSinkParam <- <(SourceSinkParamKey spOpt '=' spOpt SourceSinkParamVal Action78)> */
+		func() bool {
+			position1588, tokenIndex1588 := position, tokenIndex
+			{
+				position1589 := position
+				if !_rules[ruleSourceSinkParamKey]() {
+					goto l1588
+				}
+				if !_rules[rulespOpt]() {
+					goto l1588
+				}
+				if buffer[position] != rune('=') {
+					goto l1588
+				}
+				position++
+				if !_rules[rulespOpt]() {
+					goto l1588
+				}
+				if !_rules[ruleSourceSinkParamVal]() {
+					goto l1588
+				}
+				if !_rules[ruleAction78]() {
+					goto l1588
+				}
+				add(ruleSourceSinkParam, position1589)
+			}
+			return true
+		l1588:
+			position, tokenIndex = position1588, tokenIndex1588
+			return false
+		},
+		/* 101 SourceSinkParamVal <- <ParamLiteral> */
+		func() bool {
+			position1590, tokenIndex1590 := position, tokenIndex
+			{
+				position1591 := position
+				if !_rules[ruleParamLiteral]() {
+					goto l1590
+				}
+				add(ruleSourceSinkParamVal, position1591)
+			}
+			return true
+		l1590:
+			position, tokenIndex = position1590, tokenIndex1590
+			return false
+		},
+		/* 102 ParamLiteral <- <(BooleanLiteral / Literal / ParamArrayExpr / ParamMapExpr)> */
+		func() bool {
+			position1592, tokenIndex1592 := position, tokenIndex
+			{
+				position1593 := position
+				{
+					position1594, tokenIndex1594 := position, tokenIndex
+					if !_rules[ruleBooleanLiteral]() {
+						goto l1595
 					}
-				l1498:
-					{
-						position1500, tokenIndex1500 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1501
-						}
-						position++
-						goto l1500
-					l1501:
-						position, tokenIndex = position1500, tokenIndex1500
-						if buffer[position] != rune('N') {
-							goto l1489
-						}
-						position++
+					goto l1594
+				l1595:
+					position, tokenIndex = position1594, tokenIndex1594
+					if !_rules[ruleLiteral]() {
+						goto l1596
 					}
-				l1500:
-					{
-						position1502, tokenIndex1502 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1503
-						}
-						position++
-						goto l1502
-					l1503:
-						position, tokenIndex = position1502, tokenIndex1502
-						if buffer[position] != rune('D') {
-							goto l1489
-						}
-						position++
+					goto l1594
+				l1596:
+					position, tokenIndex = position1594, tokenIndex1594
+					if !_rules[ruleParamArrayExpr]() {
+						goto l1597
 					}
-				l1502:
-					{
-						position1504, tokenIndex1504 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1505
-						}
-						position++
-						goto l1504
-					l1505:
-						position, tokenIndex = position1504, tokenIndex1504
-						if buffer[position] != rune('S') {
-							goto l1489
-						}
-						position++
+					goto l1594
+				l1597:
+					position, tokenIndex = position1594, tokenIndex1594
+					if !_rules[ruleParamMapExpr]() {
+						goto l1592
 					}
-				l1504:
-					add(rulePegText, position1491)
-				}
-				if !_rules[ruleAction94]() {
-					goto l1489
 				}
-				add(ruleSECONDS, position1490)
+			l1594:
+				add(ruleParamLiteral, position1593)
 			}
 			return true
-		l1489:
-			position, tokenIndex = position1489, tokenIndex1489
+		l1592:
+			position, tokenIndex = position1592, tokenIndex1592
 			return false
 		},
-		/* 125 MILLISECONDS <- <(<(('m' / 'M') ('i' / 'I') ('l' / 'L') ('l' / 'L') ('i' / 'I') ('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S'))> Action95)> */
+		/* 103 ParamArrayExpr <- <(<('[' spOpt (ParamLiteral (',' spOpt ParamLiteral)*)? spOpt ','? spOpt ']')> Action79)> */
 		func() bool {
-			position1506, tokenIndex1506 := position, tokenIndex
+			position1598, tokenIndex1598 := position, tokenIndex
 			{
-				position1507 := position
+				position1599 := position
 				{
-					position1508 := position
+					position1600 := position
+					if buffer[position] != rune('[') {
+						goto l1598
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1598
+					}
 					{
-						position1509, tokenIndex1509 := position, tokenIndex
-						if buffer[position] != rune('m') {
-							goto l1510
+						position1601, tokenIndex1601 := position, tokenIndex
+						if !_rules[ruleParamLiteral]() {
+							goto l1601
 						}
-						position++
-						goto l1509
-					l1510:
-						position, tokenIndex = position1509, tokenIndex1509
-						if buffer[position] != rune('M') {
-							goto l1506
+					l1603:
+						{
+							position1604, tokenIndex1604 := position, tokenIndex
+							if buffer[position] != rune(',') {
+								goto l1604
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1604
+							}
+							if !_rules[ruleParamLiteral]() {
+								goto l1604
+							}
+							goto l1603
+						l1604:
+							position, tokenIndex = position1604, tokenIndex1604
 						}
-						position++
+						goto l1602
+					l1601:
+						position, tokenIndex = position1601, tokenIndex1601
 					}
-				l1509:
-					{
-						position1511, tokenIndex1511 := position, tokenIndex
-						if buffer[position] != rune('i') {
-							goto l1512
-						}
-						position++
-						goto l1511
-					l1512:
-						position, tokenIndex = position1511, tokenIndex1511
-						if buffer[position] != rune('I') {
-							goto l1506
-						}
-						position++
-					}
-				l1511:
-					{
-						position1513, tokenIndex1513 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l1514
-						}
-						position++
-						goto l1513
-					l1514:
-						position, tokenIndex = position1513, tokenIndex1513
-						if buffer[position] != rune('L') {
-							goto l1506
-						}
-						position++
-					}
-				l1513:
-					{
-						position1515, tokenIndex1515 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l1516
-						}
-						position++
-						goto l1515
-					l1516:
-						position, tokenIndex = position1515, tokenIndex1515
-						if buffer[position] != rune('L') {
-							goto l1506
-						}
-						position++
+				l1602:
+					if !_rules[rulespOpt]() {
+						goto l1598
 					}
-				l1515:
 					{
-						position1517, tokenIndex1517 := position, tokenIndex
-						if buffer[position] != rune('i') {
-							goto l1518
-						}
-						position++
-						goto l1517
-					l1518:
-						position, tokenIndex = position1517, tokenIndex1517
-						if buffer[position] != rune('I') {
-							goto l1506
+						position1605, tokenIndex1605 := position, tokenIndex
+						if buffer[position] != rune(',') {
+							goto l1605
 						}
 						position++
+						goto l1606
+					l1605:
+						position, tokenIndex = position1605, tokenIndex1605
 					}
-				l1517:
-					{
-						position1519, tokenIndex1519 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1520
-						}
-						position++
-						goto l1519
-					l1520:
-						position, tokenIndex = position1519, tokenIndex1519
-						if buffer[position] != rune('S') {
-							goto l1506
-						}
-						position++
+				l1606:
+					if !_rules[rulespOpt]() {
+						goto l1598
 					}
-				l1519:
-					{
-						position1521, tokenIndex1521 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1522
-						}
-						position++
-						goto l1521
-					l1522:
-						position, tokenIndex = position1521, tokenIndex1521
-						if buffer[position] != rune('E') {
-							goto l1506
-						}
-						position++
+					if buffer[position] != rune(']') {
+						goto l1598
 					}
-				l1521:
-					{
-						position1523, tokenIndex1523 := position, tokenIndex
-						if buffer[position] != rune('c') {
-							goto l1524
-						}
-						position++
-						goto l1523
-					l1524:
-						position, tokenIndex = position1523, tokenIndex1523
-						if buffer[position] != rune('C') {
-							goto l1506
-						}
-						position++
+					position++
+					add(rulePegText, position1600)
+				}
+				if !_rules[ruleAction79]() {
+					goto l1598
+				}
+				add(ruleParamArrayExpr, position1599)
+			}
+			return true
+		l1598:
+			position, tokenIndex = position1598, tokenIndex1598
+			return false
+		},
+		/* 104 ParamMapExpr <- <(<('{' spOpt (ParamKeyValuePair (spOpt ',' spOpt ParamKeyValuePair)*)? spOpt '}')> Action80)> */
+		func() bool {
+			position1607, tokenIndex1607 := position, tokenIndex
+			{
+				position1608 := position
+				{
+					position1609 := position
+					if buffer[position] != rune('{') {
+						goto l1607
 					}
-				l1523:
-					{
-						position1525, tokenIndex1525 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1526
-						}
-						position++
-						goto l1525
-					l1526:
-						position, tokenIndex = position1525, tokenIndex1525
-						if buffer[position] != rune('O') {
-							goto l1506
-						}
-						position++
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1607
 					}
-				l1525:
 					{
-						position1527, tokenIndex1527 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1528
+						position1610, tokenIndex1610 := position, tokenIndex
+						if !_rules[ruleParamKeyValuePair]() {
+							goto l1610
 						}
-						position++
-						goto l1527
-					l1528:
-						position, tokenIndex = position1527, tokenIndex1527
-						if buffer[position] != rune('N') {
-							goto l1506
+					l1612:
+						{
+							position1613, tokenIndex1613 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l1613
+							}
+							if buffer[position] != rune(',') {
+								goto l1613
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1613
+							}
+							if !_rules[ruleParamKeyValuePair]() {
+								goto l1613
+							}
+							goto l1612
+						l1613:
+							position, tokenIndex = position1613, tokenIndex1613
 						}
-						position++
+						goto l1611
+					l1610:
+						position, tokenIndex = position1610, tokenIndex1610
 					}
-				l1527:
-					{
-						position1529, tokenIndex1529 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1530
-						}
-						position++
-						goto l1529
-					l1530:
-						position, tokenIndex = position1529, tokenIndex1529
-						if buffer[position] != rune('D') {
-							goto l1506
-						}
-						position++
+				l1611:
+					if !_rules[rulespOpt]() {
+						goto l1607
 					}
-				l1529:
-					{
-						position1531, tokenIndex1531 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1532
-						}
-						position++
-						goto l1531
-					l1532:
-						position, tokenIndex = position1531, tokenIndex1531
-						if buffer[position] != rune('S') {
-							goto l1506
-						}
-						position++
+					if buffer[position] != rune('}') {
+						goto l1607
 					}
-				l1531:
-					add(rulePegText, position1508)
+					position++
+					add(rulePegText, position1609)
 				}
-				if !_rules[ruleAction95]() {
-					goto l1506
+				if !_rules[ruleAction80]() {
+					goto l1607
 				}
-				add(ruleMILLISECONDS, position1507)
+				add(ruleParamMapExpr, position1608)
 			}
 			return true
-		l1506:
-			position, tokenIndex = position1506, tokenIndex1506
+		l1607:
+			position, tokenIndex = position1607, tokenIndex1607
 			return false
 		},
-		/* 126 Wait <- <(<(('w' / 'W') ('a' / 'A') ('i' / 'I') ('t' / 'T'))> Action96)> */
+		/* 105 ParamKeyValuePair <- <(<(StringLiteral spOpt ':' spOpt ParamLiteral)> Action81)> */
 		func() bool {
-			position1533, tokenIndex1533 := position, tokenIndex
+			position1614, tokenIndex1614 := position, tokenIndex
 			{
-				position1534 := position
+				position1615 := position
 				{
-					position1535 := position
-					{
-						position1536, tokenIndex1536 := position, tokenIndex
-						if buffer[position] != rune('w') {
-							goto l1537
-						}
-						position++
-						goto l1536
-					l1537:
-						position, tokenIndex = position1536, tokenIndex1536
-						if buffer[position] != rune('W') {
-							goto l1533
-						}
-						position++
+					position1616 := position
+					if !_rules[ruleStringLiteral]() {
+						goto l1614
 					}
-				l1536:
-					{
-						position1538, tokenIndex1538 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1539
-						}
-						position++
-						goto l1538
-					l1539:
-						position, tokenIndex = position1538, tokenIndex1538
-						if buffer[position] != rune('A') {
-							goto l1533
-						}
-						position++
+					if !_rules[rulespOpt]() {
+						goto l1614
 					}
-				l1538:
-					{
-						position1540, tokenIndex1540 := position, tokenIndex
-						if buffer[position] != rune('i') {
-							goto l1541
-						}
-						position++
-						goto l1540
-					l1541:
-						position, tokenIndex = position1540, tokenIndex1540
-						if buffer[position] != rune('I') {
-							goto l1533
-						}
-						position++
+					if buffer[position] != rune(':') {
+						goto l1614
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1614
+					}
+					if !_rules[ruleParamLiteral]() {
+						goto l1614
 					}
-				l1540:
+					add(rulePegText, position1616)
+				}
+				if !_rules[ruleAction81]() {
+					goto l1614
+				}
+				add(ruleParamKeyValuePair, position1615)
+			}
+			return true
+		l1614:
+			position, tokenIndex = position1614, tokenIndex1614
+			return false
+		},
+		/* 106 PausedOpt <- <(<(sp (Paused / Unpaused))?> Action82)> */
+		func() bool {
+			position1617, tokenIndex1617 := position, tokenIndex
+			{
+				position1618 := position
+				{
+					position1619 := position
 					{
-						position1542, tokenIndex1542 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1543
+						position1620, tokenIndex1620 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1620
 						}
-						position++
-						goto l1542
-					l1543:
-						position, tokenIndex = position1542, tokenIndex1542
-						if buffer[position] != rune('T') {
-							goto l1533
+						{
+							position1622, tokenIndex1622 := position, tokenIndex
+							if !_rules[rulePaused]() {
+								goto l1623
+							}
+							goto l1622
+						l1623:
+							position, tokenIndex = position1622, tokenIndex1622
+							if !_rules[ruleUnpaused]() {
+								goto l1620
+							}
 						}
-						position++
+					l1622:
+						goto l1621
+					l1620:
+						position, tokenIndex = position1620, tokenIndex1620
 					}
-				l1542:
-					add(rulePegText, position1535)
+				l1621:
+					add(rulePegText, position1619)
 				}
-				if !_rules[ruleAction96]() {
-					goto l1533
+				if !_rules[ruleAction82]() {
+					goto l1617
 				}
-				add(ruleWait, position1534)
+				add(rulePausedOpt, position1618)
 			}
 			return true
-		l1533:
-			position, tokenIndex = position1533, tokenIndex1533
+		l1617:
+			position, tokenIndex = position1617, tokenIndex1617
 			return false
 		},
-		/* 127 DropOldest <- <(<(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('o' / 'O') ('l' / 'L') ('d' / 'D') ('e' / 'E') ('s' / 'S') ('t' / 'T')))> Action97)> */
+		/* 107 SchemaOpt <- <(<(spOpt '(' spOpt SchemaField (spOpt ',' spOpt SchemaField)* spOpt ')')?> Action83)> */
 		func() bool {
-			position1544, tokenIndex1544 := position, tokenIndex
+			position1624, tokenIndex1624 := position, tokenIndex
 			{
-				position1545 := position
+				position1625 := position
 				{
-					position1546 := position
+					position1626 := position
 					{
-						position1547, tokenIndex1547 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1548
+						position1627, tokenIndex1627 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1627
 						}
-						position++
-						goto l1547
-					l1548:
-						position, tokenIndex = position1547, tokenIndex1547
-						if buffer[position] != rune('D') {
-							goto l1544
+						if buffer[position] != rune('(') {
+							goto l1627
 						}
 						position++
-					}
-				l1547:
-					{
-						position1549, tokenIndex1549 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1550
+						if !_rules[rulespOpt]() {
+							goto l1627
 						}
-						position++
-						goto l1549
-					l1550:
-						position, tokenIndex = position1549, tokenIndex1549
-						if buffer[position] != rune('R') {
-							goto l1544
+						if !_rules[ruleSchemaField]() {
+							goto l1627
 						}
-						position++
-					}
-				l1549:
-					{
-						position1551, tokenIndex1551 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1552
+					l1629:
+						{
+							position1630, tokenIndex1630 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l1630
+							}
+							if buffer[position] != rune(',') {
+								goto l1630
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1630
+							}
+							if !_rules[ruleSchemaField]() {
+								goto l1630
+							}
+							goto l1629
+						l1630:
+							position, tokenIndex = position1630, tokenIndex1630
 						}
-						position++
-						goto l1551
-					l1552:
-						position, tokenIndex = position1551, tokenIndex1551
-						if buffer[position] != rune('O') {
-							goto l1544
+						if !_rules[rulespOpt]() {
+							goto l1627
+						}
+						if buffer[position] != rune(')') {
+							goto l1627
 						}
 						position++
+						goto l1628
+					l1627:
+						position, tokenIndex = position1627, tokenIndex1627
 					}
-				l1551:
-					{
-						position1553, tokenIndex1553 := position, tokenIndex
-						if buffer[position] != rune('p') {
-							goto l1554
-						}
-						position++
-						goto l1553
-					l1554:
-						position, tokenIndex = position1553, tokenIndex1553
-						if buffer[position] != rune('P') {
-							goto l1544
-						}
-						position++
-					}
-				l1553:
-					if !_rules[rulesp]() {
-						goto l1544
-					}
-					{
-						position1555, tokenIndex1555 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1556
-						}
-						position++
-						goto l1555
-					l1556:
-						position, tokenIndex = position1555, tokenIndex1555
-						if buffer[position] != rune('O') {
-							goto l1544
-						}
-						position++
-					}
-				l1555:
-					{
-						position1557, tokenIndex1557 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l1558
-						}
-						position++
-						goto l1557
-					l1558:
-						position, tokenIndex = position1557, tokenIndex1557
-						if buffer[position] != rune('L') {
-							goto l1544
-						}
-						position++
-					}
-				l1557:
-					{
-						position1559, tokenIndex1559 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1560
-						}
-						position++
-						goto l1559
-					l1560:
-						position, tokenIndex = position1559, tokenIndex1559
-						if buffer[position] != rune('D') {
-							goto l1544
-						}
-						position++
-					}
-				l1559:
-					{
-						position1561, tokenIndex1561 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1562
-						}
-						position++
-						goto l1561
-					l1562:
-						position, tokenIndex = position1561, tokenIndex1561
-						if buffer[position] != rune('E') {
-							goto l1544
-						}
-						position++
-					}
-				l1561:
-					{
-						position1563, tokenIndex1563 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1564
-						}
-						position++
-						goto l1563
-					l1564:
-						position, tokenIndex = position1563, tokenIndex1563
-						if buffer[position] != rune('S') {
-							goto l1544
-						}
-						position++
-					}
-				l1563:
-					{
-						position1565, tokenIndex1565 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1566
-						}
-						position++
-						goto l1565
-					l1566:
-						position, tokenIndex = position1565, tokenIndex1565
-						if buffer[position] != rune('T') {
-							goto l1544
-						}
-						position++
-					}
-				l1565:
-					add(rulePegText, position1546)
+				l1628:
+					add(rulePegText, position1626)
 				}
-				if !_rules[ruleAction97]() {
-					goto l1544
+				if !_rules[ruleAction83]() {
+					goto l1624
+				}
+				add(ruleSchemaOpt, position1625)
+			}
+			return true
+		l1624:
+			position, tokenIndex = position1624, tokenIndex1624
+			return false
+		},
+		/* 108 SchemaField <- <(SchemaFieldPath sp Type NotNullOpt Action84)> */
+		func() bool {
+			position1631, tokenIndex1631 := position, tokenIndex
+			{
+				position1632 := position
+				if !_rules[ruleSchemaFieldPath]() {
+					goto l1631
+				}
+				if !_rules[rulesp]() {
+					goto l1631
+				}
+				if !_rules[ruleType]() {
+					goto l1631
+				}
+				if !_rules[ruleNotNullOpt]() {
+					goto l1631
+				}
+				if !_rules[ruleAction84]() {
+					goto l1631
 				}
-				add(ruleDropOldest, position1545)
+				add(ruleSchemaField, position1632)
 			}
 			return true
-		l1544:
-			position, tokenIndex = position1544, tokenIndex1544
+		l1631:
+			position, tokenIndex = position1631, tokenIndex1631
 			return false
 		},
-		/* 128 DropNewest <- <(<(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('n' / 'N') ('e' / 'E') ('w' / 'W') ('e' / 'E') ('s' / 'S') ('t' / 'T')))> Action98)> */
+		/* 109 SchemaFieldPath <- <(<(jsonPathHead jsonMapSingleLevel*)> Action85)> */
 		func() bool {
-			position1567, tokenIndex1567 := position, tokenIndex
+			position1633, tokenIndex1633 := position, tokenIndex
 			{
-				position1568 := position
+				position1634 := position
 				{
-					position1569 := position
-					{
-						position1570, tokenIndex1570 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1571
-						}
-						position++
-						goto l1570
-					l1571:
-						position, tokenIndex = position1570, tokenIndex1570
-						if buffer[position] != rune('D') {
-							goto l1567
-						}
-						position++
-					}
-				l1570:
-					{
-						position1572, tokenIndex1572 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1573
-						}
-						position++
-						goto l1572
-					l1573:
-						position, tokenIndex = position1572, tokenIndex1572
-						if buffer[position] != rune('R') {
-							goto l1567
-						}
-						position++
-					}
-				l1572:
-					{
-						position1574, tokenIndex1574 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1575
-						}
-						position++
-						goto l1574
-					l1575:
-						position, tokenIndex = position1574, tokenIndex1574
-						if buffer[position] != rune('O') {
-							goto l1567
-						}
-						position++
-					}
-				l1574:
-					{
-						position1576, tokenIndex1576 := position, tokenIndex
-						if buffer[position] != rune('p') {
-							goto l1577
-						}
-						position++
-						goto l1576
-					l1577:
-						position, tokenIndex = position1576, tokenIndex1576
-						if buffer[position] != rune('P') {
-							goto l1567
-						}
-						position++
-					}
-				l1576:
-					if !_rules[rulesp]() {
-						goto l1567
-					}
-					{
-						position1578, tokenIndex1578 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1579
-						}
-						position++
-						goto l1578
-					l1579:
-						position, tokenIndex = position1578, tokenIndex1578
-						if buffer[position] != rune('N') {
-							goto l1567
-						}
-						position++
-					}
-				l1578:
-					{
-						position1580, tokenIndex1580 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1581
-						}
-						position++
-						goto l1580
-					l1581:
-						position, tokenIndex = position1580, tokenIndex1580
-						if buffer[position] != rune('E') {
-							goto l1567
-						}
-						position++
-					}
-				l1580:
-					{
-						position1582, tokenIndex1582 := position, tokenIndex
-						if buffer[position] != rune('w') {
-							goto l1583
-						}
-						position++
-						goto l1582
-					l1583:
-						position, tokenIndex = position1582, tokenIndex1582
-						if buffer[position] != rune('W') {
-							goto l1567
-						}
-						position++
-					}
-				l1582:
-					{
-						position1584, tokenIndex1584 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1585
-						}
-						position++
-						goto l1584
-					l1585:
-						position, tokenIndex = position1584, tokenIndex1584
-						if buffer[position] != rune('E') {
-							goto l1567
-						}
-						position++
+					position1635 := position
+					if !_rules[rulejsonPathHead]() {
+						goto l1633
 					}
-				l1584:
+				l1636:
 					{
-						position1586, tokenIndex1586 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1587
-						}
-						position++
-						goto l1586
-					l1587:
-						position, tokenIndex = position1586, tokenIndex1586
-						if buffer[position] != rune('S') {
-							goto l1567
+						position1637, tokenIndex1637 := position, tokenIndex
+						if !_rules[rulejsonMapSingleLevel]() {
+							goto l1637
 						}
-						position++
+						goto l1636
+					l1637:
+						position, tokenIndex = position1637, tokenIndex1637
 					}
-				l1586:
+					add(rulePegText, position1635)
+				}
+				if !_rules[ruleAction85]() {
+					goto l1633
+				}
+				add(ruleSchemaFieldPath, position1634)
+			}
+			return true
+		l1633:
+			position, tokenIndex = position1633, tokenIndex1633
+			return false
+		},
+		/* 110 NotNullOpt <- <(<(sp NotNull)?> Action86)> */
+		func() bool {
+			position1638, tokenIndex1638 := position, tokenIndex
+			{
+				position1639 := position
+				{
+					position1640 := position
 					{
-						position1588, tokenIndex1588 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1589
+						position1641, tokenIndex1641 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1641
 						}
-						position++
-						goto l1588
-					l1589:
-						position, tokenIndex = position1588, tokenIndex1588
-						if buffer[position] != rune('T') {
-							goto l1567
+						if !_rules[ruleNotNull]() {
+							goto l1641
 						}
-						position++
+						goto l1642
+					l1641:
+						position, tokenIndex = position1641, tokenIndex1641
 					}
-				l1588:
-					add(rulePegText, position1569)
+				l1642:
+					add(rulePegText, position1640)
 				}
-				if !_rules[ruleAction98]() {
-					goto l1567
+				if !_rules[ruleAction86]() {
+					goto l1638
 				}
-				add(ruleDropNewest, position1568)
+				add(ruleNotNullOpt, position1639)
 			}
 			return true
-		l1567:
-			position, tokenIndex = position1567, tokenIndex1567
+		l1638:
+			position, tokenIndex = position1638, tokenIndex1638
 			return false
 		},
-		/* 129 StreamIdentifier <- <(<ident> Action99)> */
+		/* 111 ExpressionOrWildcard <- <(Wildcard / Expression)> */
 		func() bool {
-			position1590, tokenIndex1590 := position, tokenIndex
+			position1643, tokenIndex1643 := position, tokenIndex
 			{
-				position1591 := position
+				position1644 := position
 				{
-					position1592 := position
-					if !_rules[ruleident]() {
-						goto l1590
+					position1645, tokenIndex1645 := position, tokenIndex
+					if !_rules[ruleWildcard]() {
+						goto l1646
+					}
+					goto l1645
+				l1646:
+					position, tokenIndex = position1645, tokenIndex1645
+					if !_rules[ruleExpression]() {
+						goto l1643
 					}
-					add(rulePegText, position1592)
 				}
-				if !_rules[ruleAction99]() {
-					goto l1590
+			l1645:
+				add(ruleExpressionOrWildcard, position1644)
+			}
+			return true
+		l1643:
+			position, tokenIndex = position1643, tokenIndex1643
+			return false
+		},
+		/* 112 Expression <- <orExpr> */
+		func() bool {
+			position1647, tokenIndex1647 := position, tokenIndex
+			{
+				position1648 := position
+				if !_rules[ruleorExpr]() {
+					goto l1647
 				}
-				add(ruleStreamIdentifier, position1591)
+				add(ruleExpression, position1648)
 			}
 			return true
-		l1590:
-			position, tokenIndex = position1590, tokenIndex1590
+		l1647:
+			position, tokenIndex = position1647, tokenIndex1647
 			return false
 		},
-		/* 130 SourceSinkType <- <(<ident> Action100)> */
+		/* 113 orExpr <- <(<(andExpr (sp Or sp andExpr)*)> Action87)> */
 		func() bool {
-			position1593, tokenIndex1593 := position, tokenIndex
+			position1649, tokenIndex1649 := position, tokenIndex
 			{
-				position1594 := position
+				position1650 := position
 				{
-					position1595 := position
-					if !_rules[ruleident]() {
-						goto l1593
+					position1651 := position
+					if !_rules[ruleandExpr]() {
+						goto l1649
+					}
+				l1652:
+					{
+						position1653, tokenIndex1653 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1653
+						}
+						if !_rules[ruleOr]() {
+							goto l1653
+						}
+						if !_rules[rulesp]() {
+							goto l1653
+						}
+						if !_rules[ruleandExpr]() {
+							goto l1653
+						}
+						goto l1652
+					l1653:
+						position, tokenIndex = position1653, tokenIndex1653
 					}
-					add(rulePegText, position1595)
+					add(rulePegText, position1651)
 				}
-				if !_rules[ruleAction100]() {
-					goto l1593
+				if !_rules[ruleAction87]() {
+					goto l1649
 				}
-				add(ruleSourceSinkType, position1594)
+				add(ruleorExpr, position1650)
 			}
 			return true
-		l1593:
-			position, tokenIndex = position1593, tokenIndex1593
+		l1649:
+			position, tokenIndex = position1649, tokenIndex1649
 			return false
 		},
-		/* 131 SourceSinkParamKey <- <(<ident> Action101)> */
+		/* 114 andExpr <- <(<(notExpr (sp And sp notExpr)*)> Action88)> */
 		func() bool {
-			position1596, tokenIndex1596 := position, tokenIndex
+			position1654, tokenIndex1654 := position, tokenIndex
 			{
-				position1597 := position
+				position1655 := position
 				{
-					position1598 := position
-					if !_rules[ruleident]() {
-						goto l1596
+					position1656 := position
+					if !_rules[rulenotExpr]() {
+						goto l1654
+					}
+				l1657:
+					{
+						position1658, tokenIndex1658 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1658
+						}
+						if !_rules[ruleAnd]() {
+							goto l1658
+						}
+						if !_rules[rulesp]() {
+							goto l1658
+						}
+						if !_rules[rulenotExpr]() {
+							goto l1658
+						}
+						goto l1657
+					l1658:
+						position, tokenIndex = position1658, tokenIndex1658
 					}
-					add(rulePegText, position1598)
+					add(rulePegText, position1656)
 				}
-				if !_rules[ruleAction101]() {
-					goto l1596
+				if !_rules[ruleAction88]() {
+					goto l1654
 				}
-				add(ruleSourceSinkParamKey, position1597)
+				add(ruleandExpr, position1655)
 			}
 			return true
-		l1596:
-			position, tokenIndex = position1596, tokenIndex1596
+		l1654:
+			position, tokenIndex = position1654, tokenIndex1654
 			return false
 		},
-		/* 132 Paused <- <(<(('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') ('d' / 'D'))> Action102)> */
+		/* 115 notExpr <- <(<((Not sp)? comparisonExpr)> Action89)> */
 		func() bool {
-			position1599, tokenIndex1599 := position, tokenIndex
+			position1659, tokenIndex1659 := position, tokenIndex
 			{
-				position1600 := position
+				position1660 := position
 				{
-					position1601 := position
+					position1661 := position
 					{
-						position1602, tokenIndex1602 := position, tokenIndex
-						if buffer[position] != rune('p') {
-							goto l1603
+						position1662, tokenIndex1662 := position, tokenIndex
+						if !_rules[ruleNot]() {
+							goto l1662
 						}
-						position++
-						goto l1602
-					l1603:
-						position, tokenIndex = position1602, tokenIndex1602
-						if buffer[position] != rune('P') {
-							goto l1599
+						if !_rules[rulesp]() {
+							goto l1662
 						}
-						position++
+						goto l1663
+					l1662:
+						position, tokenIndex = position1662, tokenIndex1662
 					}
-				l1602:
-					{
-						position1604, tokenIndex1604 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1605
-						}
-						position++
-						goto l1604
-					l1605:
-						position, tokenIndex = position1604, tokenIndex1604
-						if buffer[position] != rune('A') {
-							goto l1599
-						}
-						position++
-					}
-				l1604:
-					{
-						position1606, tokenIndex1606 := position, tokenIndex
-						if buffer[position] != rune('u') {
-							goto l1607
-						}
-						position++
-						goto l1606
-					l1607:
-						position, tokenIndex = position1606, tokenIndex1606
-						if buffer[position] != rune('U') {
-							goto l1599
-						}
-						position++
-					}
-				l1606:
-					{
-						position1608, tokenIndex1608 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1609
-						}
-						position++
-						goto l1608
-					l1609:
-						position, tokenIndex = position1608, tokenIndex1608
-						if buffer[position] != rune('S') {
-							goto l1599
-						}
-						position++
+				l1663:
+					if !_rules[rulecomparisonExpr]() {
+						goto l1659
 					}
-				l1608:
-					{
-						position1610, tokenIndex1610 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1611
-						}
-						position++
-						goto l1610
-					l1611:
-						position, tokenIndex = position1610, tokenIndex1610
-						if buffer[position] != rune('E') {
-							goto l1599
-						}
-						position++
+					add(rulePegText, position1661)
+				}
+				if !_rules[ruleAction89]() {
+					goto l1659
+				}
+				add(rulenotExpr, position1660)
+			}
+			return true
+		l1659:
+			position, tokenIndex = position1659, tokenIndex1659
+			return false
+		},
+		/* 116 comparisonExpr <- <(<(otherOpExpr ((spOpt ComparisonOp spOpt otherOpExpr) / (sp PatternMatchOp sp otherOpExpr) / (sp InOp spOpt InList) / (sp BetweenOp sp otherOpExpr sp (('a' / 'A') ('n' / 'N') ('d' / 'D')) sp otherOpExpr))?)> Action90)> */
+		func() bool {
+			position1664, tokenIndex1664 := position, tokenIndex
+			{
+				position1665 := position
+				{
+					position1666 := position
+					if !_rules[ruleotherOpExpr]() {
+						goto l1664
 					}
-				l1610:
 					{
-						position1612, tokenIndex1612 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1613
-						}
-						position++
-						goto l1612
-					l1613:
-						position, tokenIndex = position1612, tokenIndex1612
-						if buffer[position] != rune('D') {
-							goto l1599
+						position1667, tokenIndex1667 := position, tokenIndex
+						{
+							position1669, tokenIndex1669 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l1670
+							}
+							if !_rules[ruleComparisonOp]() {
+								goto l1670
+							}
+							if !_rules[rulespOpt]() {
+								goto l1670
+							}
+							if !_rules[ruleotherOpExpr]() {
+								goto l1670
+							}
+							goto l1669
+						l1670:
+							position, tokenIndex = position1669, tokenIndex1669
+							if !_rules[rulesp]() {
+								goto l1671
+							}
+							if !_rules[rulePatternMatchOp]() {
+								goto l1671
+							}
+							if !_rules[rulesp]() {
+								goto l1671
+							}
+							if !_rules[ruleotherOpExpr]() {
+								goto l1671
+							}
+							goto l1669
+						l1671:
+							position, tokenIndex = position1669, tokenIndex1669
+							if !_rules[rulesp]() {
+								goto l1672
+							}
+							if !_rules[ruleInOp]() {
+								goto l1672
+							}
+							if !_rules[rulespOpt]() {
+								goto l1672
+							}
+							if !_rules[ruleInList]() {
+								goto l1672
+							}
+							goto l1669
+						l1672:
+							position, tokenIndex = position1669, tokenIndex1669
+							if !_rules[rulesp]() {
+								goto l1667
+							}
+							if !_rules[ruleBetweenOp]() {
+								goto l1667
+							}
+							if !_rules[rulesp]() {
+								goto l1667
+							}
+							if !_rules[ruleotherOpExpr]() {
+								goto l1667
+							}
+							if !_rules[rulesp]() {
+								goto l1667
+							}
+							{
+								position1673, tokenIndex1673 := position, tokenIndex
+								if buffer[position] != rune('a') {
+									goto l1674
+								}
+								position++
+								goto l1673
+							l1674:
+								position, tokenIndex = position1673, tokenIndex1673
+								if buffer[position] != rune('A') {
+									goto l1667
+								}
+								position++
+							}
+						l1673:
+							{
+								position1675, tokenIndex1675 := position, tokenIndex
+								if buffer[position] != rune('n') {
+									goto l1676
+								}
+								position++
+								goto l1675
+							l1676:
+								position, tokenIndex = position1675, tokenIndex1675
+								if buffer[position] != rune('N') {
+									goto l1667
+								}
+								position++
+							}
+						l1675:
+							{
+								position1677, tokenIndex1677 := position, tokenIndex
+								if buffer[position] != rune('d') {
+									goto l1678
+								}
+								position++
+								goto l1677
+							l1678:
+								position, tokenIndex = position1677, tokenIndex1677
+								if buffer[position] != rune('D') {
+									goto l1667
+								}
+								position++
+							}
+						l1677:
+							if !_rules[rulesp]() {
+								goto l1667
+							}
+							if !_rules[ruleotherOpExpr]() {
+								goto l1667
+							}
 						}
-						position++
+					l1669:
+						goto l1668
+					l1667:
+						position, tokenIndex = position1667, tokenIndex1667
 					}
-				l1612:
-					add(rulePegText, position1601)
+				l1668:
+					add(rulePegText, position1666)
 				}
-				if !_rules[ruleAction102]() {
-					goto l1599
+				if !_rules[ruleAction90]() {
+					goto l1664
 				}
-				add(rulePaused, position1600)
+				add(rulecomparisonExpr, position1665)
 			}
 			return true
-		l1599:
-			position, tokenIndex = position1599, tokenIndex1599
+		l1664:
+			position, tokenIndex = position1664, tokenIndex1664
 			return false
 		},
-		/* 133 Unpaused <- <(<(('u' / 'U') ('n' / 'N') ('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') ('d' / 'D'))> Action103)> */
+		/* 117 InList <- <(<('(' spOpt Expression (spOpt ',' spOpt Expression)* spOpt ')')> Action91)> */
 		func() bool {
-			position1614, tokenIndex1614 := position, tokenIndex
+			position1679, tokenIndex1679 := position, tokenIndex
 			{
-				position1615 := position
+				position1680 := position
 				{
-					position1616 := position
-					{
-						position1617, tokenIndex1617 := position, tokenIndex
-						if buffer[position] != rune('u') {
-							goto l1618
-						}
-						position++
-						goto l1617
-					l1618:
-						position, tokenIndex = position1617, tokenIndex1617
-						if buffer[position] != rune('U') {
-							goto l1614
-						}
-						position++
-					}
-				l1617:
-					{
-						position1619, tokenIndex1619 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1620
-						}
-						position++
-						goto l1619
-					l1620:
-						position, tokenIndex = position1619, tokenIndex1619
-						if buffer[position] != rune('N') {
-							goto l1614
-						}
-						position++
+					position1681 := position
+					if buffer[position] != rune('(') {
+						goto l1679
 					}
-				l1619:
-					{
-						position1621, tokenIndex1621 := position, tokenIndex
-						if buffer[position] != rune('p') {
-							goto l1622
-						}
-						position++
-						goto l1621
-					l1622:
-						position, tokenIndex = position1621, tokenIndex1621
-						if buffer[position] != rune('P') {
-							goto l1614
-						}
-						position++
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1679
 					}
-				l1621:
-					{
-						position1623, tokenIndex1623 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1624
-						}
-						position++
-						goto l1623
-					l1624:
-						position, tokenIndex = position1623, tokenIndex1623
-						if buffer[position] != rune('A') {
-							goto l1614
-						}
-						position++
+					if !_rules[ruleExpression]() {
+						goto l1679
 					}
-				l1623:
+				l1682:
 					{
-						position1625, tokenIndex1625 := position, tokenIndex
-						if buffer[position] != rune('u') {
-							goto l1626
+						position1683, tokenIndex1683 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1683
 						}
-						position++
-						goto l1625
-					l1626:
-						position, tokenIndex = position1625, tokenIndex1625
-						if buffer[position] != rune('U') {
-							goto l1614
+						if buffer[position] != rune(',') {
+							goto l1683
 						}
 						position++
-					}
-				l1625:
-					{
-						position1627, tokenIndex1627 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1628
+						if !_rules[rulespOpt]() {
+							goto l1683
 						}
-						position++
-						goto l1627
-					l1628:
-						position, tokenIndex = position1627, tokenIndex1627
-						if buffer[position] != rune('S') {
-							goto l1614
+						if !_rules[ruleExpression]() {
+							goto l1683
 						}
-						position++
+						goto l1682
+					l1683:
+						position, tokenIndex = position1683, tokenIndex1683
 					}
-				l1627:
-					{
-						position1629, tokenIndex1629 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1630
-						}
-						position++
-						goto l1629
-					l1630:
-						position, tokenIndex = position1629, tokenIndex1629
-						if buffer[position] != rune('E') {
-							goto l1614
-						}
-						position++
+					if !_rules[rulespOpt]() {
+						goto l1679
 					}
-				l1629:
-					{
-						position1631, tokenIndex1631 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1632
-						}
-						position++
-						goto l1631
-					l1632:
-						position, tokenIndex = position1631, tokenIndex1631
-						if buffer[position] != rune('D') {
-							goto l1614
-						}
-						position++
+					if buffer[position] != rune(')') {
+						goto l1679
 					}
-				l1631:
-					add(rulePegText, position1616)
+					position++
+					add(rulePegText, position1681)
 				}
-				if !_rules[ruleAction103]() {
-					goto l1614
+				if !_rules[ruleAction91]() {
+					goto l1679
 				}
-				add(ruleUnpaused, position1615)
+				add(ruleInList, position1680)
 			}
 			return true
-		l1614:
-			position, tokenIndex = position1614, tokenIndex1614
+		l1679:
+			position, tokenIndex = position1679, tokenIndex1679
 			return false
 		},
-		/* 134 Ascending <- <(<(('a' / 'A') ('s' / 'S') ('c' / 'C'))> Action104)> */
+		/* 118 otherOpExpr <- <(<(isExpr (spOpt OtherOp spOpt isExpr)*)> Action92)> */
 		func() bool {
-			position1633, tokenIndex1633 := position, tokenIndex
+			position1684, tokenIndex1684 := position, tokenIndex
 			{
-				position1634 := position
+				position1685 := position
 				{
-					position1635 := position
-					{
-						position1636, tokenIndex1636 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1637
-						}
-						position++
-						goto l1636
-					l1637:
-						position, tokenIndex = position1636, tokenIndex1636
-						if buffer[position] != rune('A') {
-							goto l1633
-						}
-						position++
+					position1686 := position
+					if !_rules[ruleisExpr]() {
+						goto l1684
 					}
-				l1636:
+				l1687:
 					{
-						position1638, tokenIndex1638 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1639
+						position1688, tokenIndex1688 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1688
 						}
-						position++
-						goto l1638
-					l1639:
-						position, tokenIndex = position1638, tokenIndex1638
-						if buffer[position] != rune('S') {
-							goto l1633
+						if !_rules[ruleOtherOp]() {
+							goto l1688
 						}
-						position++
-					}
-				l1638:
-					{
-						position1640, tokenIndex1640 := position, tokenIndex
-						if buffer[position] != rune('c') {
-							goto l1641
+						if !_rules[rulespOpt]() {
+							goto l1688
 						}
-						position++
-						goto l1640
-					l1641:
-						position, tokenIndex = position1640, tokenIndex1640
-						if buffer[position] != rune('C') {
-							goto l1633
+						if !_rules[ruleisExpr]() {
+							goto l1688
 						}
-						position++
+						goto l1687
+					l1688:
+						position, tokenIndex = position1688, tokenIndex1688
 					}
-				l1640:
-					add(rulePegText, position1635)
+					add(rulePegText, position1686)
 				}
-				if !_rules[ruleAction104]() {
-					goto l1633
+				if !_rules[ruleAction92]() {
+					goto l1684
 				}
-				add(ruleAscending, position1634)
+				add(ruleotherOpExpr, position1685)
 			}
 			return true
-		l1633:
-			position, tokenIndex = position1633, tokenIndex1633
+		l1684:
+			position, tokenIndex = position1684, tokenIndex1684
 			return false
 		},
-		/* 135 Descending <- <(<(('d' / 'D') ('e' / 'E') ('s' / 'S') ('c' / 'C'))> Action105)> */
+		/* 119 isExpr <- <(<((RowValue sp IsOp sp Missing) / (termExpr (sp IsOp sp NullLiteral)?))> Action93)> */
 		func() bool {
-			position1642, tokenIndex1642 := position, tokenIndex
+			position1689, tokenIndex1689 := position, tokenIndex
 			{
-				position1643 := position
+				position1690 := position
 				{
-					position1644 := position
+					position1691 := position
 					{
-						position1645, tokenIndex1645 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1646
+						position1692, tokenIndex1692 := position, tokenIndex
+						if !_rules[ruleRowValue]() {
+							goto l1693
 						}
-						position++
-						goto l1645
-					l1646:
-						position, tokenIndex = position1645, tokenIndex1645
-						if buffer[position] != rune('D') {
-							goto l1642
+						if !_rules[rulesp]() {
+							goto l1693
 						}
-						position++
-					}
-				l1645:
-					{
-						position1647, tokenIndex1647 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1648
+						if !_rules[ruleIsOp]() {
+							goto l1693
 						}
-						position++
-						goto l1647
-					l1648:
-						position, tokenIndex = position1647, tokenIndex1647
-						if buffer[position] != rune('E') {
-							goto l1642
+						if !_rules[rulesp]() {
+							goto l1693
 						}
-						position++
-					}
-				l1647:
-					{
-						position1649, tokenIndex1649 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1650
-						}
-						position++
-						goto l1649
-					l1650:
-						position, tokenIndex = position1649, tokenIndex1649
-						if buffer[position] != rune('S') {
-							goto l1642
+						if !_rules[ruleMissing]() {
+							goto l1693
 						}
-						position++
-					}
-				l1649:
-					{
-						position1651, tokenIndex1651 := position, tokenIndex
-						if buffer[position] != rune('c') {
-							goto l1652
+						goto l1692
+					l1693:
+						position, tokenIndex = position1692, tokenIndex1692
+						if !_rules[ruletermExpr]() {
+							goto l1689
 						}
-						position++
-						goto l1651
-					l1652:
-						position, tokenIndex = position1651, tokenIndex1651
-						if buffer[position] != rune('C') {
-							goto l1642
+						{
+							position1694, tokenIndex1694 := position, tokenIndex
+							if !_rules[rulesp]() {
+								goto l1694
+							}
+							if !_rules[ruleIsOp]() {
+								goto l1694
+							}
+							if !_rules[rulesp]() {
+								goto l1694
+							}
+							if !_rules[ruleNullLiteral]() {
+								goto l1694
+							}
+							goto l1695
+						l1694:
+							position, tokenIndex = position1694, tokenIndex1694
 						}
-						position++
+					l1695:
 					}
-				l1651:
-					add(rulePegText, position1644)
+				l1692:
+					add(rulePegText, position1691)
 				}
-				if !_rules[ruleAction105]() {
-					goto l1642
+				if !_rules[ruleAction93]() {
+					goto l1689
 				}
-				add(ruleDescending, position1643)
+				add(ruleisExpr, position1690)
 			}
 			return true
-		l1642:
-			position, tokenIndex = position1642, tokenIndex1642
+		l1689:
+			position, tokenIndex = position1689, tokenIndex1689
 			return false
 		},
-		/* 136 Type <- <(Bool / Int / Float / String / Blob / Timestamp / Array / Map)> */
+		/* 120 termExpr <- <(<(productExpr (spOpt PlusMinusOp spOpt productExpr)*)> Action94)> */
 		func() bool {
-			position1653, tokenIndex1653 := position, tokenIndex
+			position1696, tokenIndex1696 := position, tokenIndex
 			{
-				position1654 := position
+				position1697 := position
 				{
-					position1655, tokenIndex1655 := position, tokenIndex
-					if !_rules[ruleBool]() {
-						goto l1656
-					}
-					goto l1655
-				l1656:
-					position, tokenIndex = position1655, tokenIndex1655
-					if !_rules[ruleInt]() {
-						goto l1657
-					}
-					goto l1655
-				l1657:
-					position, tokenIndex = position1655, tokenIndex1655
-					if !_rules[ruleFloat]() {
-						goto l1658
-					}
-					goto l1655
-				l1658:
-					position, tokenIndex = position1655, tokenIndex1655
-					if !_rules[ruleString]() {
-						goto l1659
-					}
-					goto l1655
-				l1659:
-					position, tokenIndex = position1655, tokenIndex1655
-					if !_rules[ruleBlob]() {
-						goto l1660
-					}
-					goto l1655
-				l1660:
-					position, tokenIndex = position1655, tokenIndex1655
-					if !_rules[ruleTimestamp]() {
-						goto l1661
-					}
-					goto l1655
-				l1661:
-					position, tokenIndex = position1655, tokenIndex1655
-					if !_rules[ruleArray]() {
-						goto l1662
+					position1698 := position
+					if !_rules[ruleproductExpr]() {
+						goto l1696
 					}
-					goto l1655
-				l1662:
-					position, tokenIndex = position1655, tokenIndex1655
-					if !_rules[ruleMap]() {
-						goto l1653
+				l1699:
+					{
+						position1700, tokenIndex1700 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1700
+						}
+						if !_rules[rulePlusMinusOp]() {
+							goto l1700
+						}
+						if !_rules[rulespOpt]() {
+							goto l1700
+						}
+						if !_rules[ruleproductExpr]() {
+							goto l1700
+						}
+						goto l1699
+					l1700:
+						position, tokenIndex = position1700, tokenIndex1700
 					}
+					add(rulePegText, position1698)
+				}
+				if !_rules[ruleAction94]() {
+					goto l1696
 				}
-			l1655:
-				add(ruleType, position1654)
+				add(ruletermExpr, position1697)
 			}
 			return true
-		l1653:
-			position, tokenIndex = position1653, tokenIndex1653
+		l1696:
+			position, tokenIndex = position1696, tokenIndex1696
 			return false
 		},
-		/* 137 Bool <- <(<(('b' / 'B') ('o' / 'O') ('o' / 'O') ('l' / 'L'))> Action106)> */
+		/* 121 productExpr <- <(<(minusExpr (spOpt MultDivOp spOpt minusExpr)*)> Action95)> */
 		func() bool {
-			position1663, tokenIndex1663 := position, tokenIndex
+			position1701, tokenIndex1701 := position, tokenIndex
 			{
-				position1664 := position
+				position1702 := position
 				{
-					position1665 := position
-					{
-						position1666, tokenIndex1666 := position, tokenIndex
-						if buffer[position] != rune('b') {
-							goto l1667
-						}
-						position++
-						goto l1666
-					l1667:
-						position, tokenIndex = position1666, tokenIndex1666
-						if buffer[position] != rune('B') {
-							goto l1663
-						}
-						position++
+					position1703 := position
+					if !_rules[ruleminusExpr]() {
+						goto l1701
 					}
-				l1666:
+				l1704:
 					{
-						position1668, tokenIndex1668 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1669
+						position1705, tokenIndex1705 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1705
 						}
-						position++
-						goto l1668
-					l1669:
-						position, tokenIndex = position1668, tokenIndex1668
-						if buffer[position] != rune('O') {
-							goto l1663
+						if !_rules[ruleMultDivOp]() {
+							goto l1705
 						}
-						position++
-					}
-				l1668:
-					{
-						position1670, tokenIndex1670 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1671
+						if !_rules[rulespOpt]() {
+							goto l1705
 						}
-						position++
-						goto l1670
-					l1671:
-						position, tokenIndex = position1670, tokenIndex1670
-						if buffer[position] != rune('O') {
-							goto l1663
+						if !_rules[ruleminusExpr]() {
+							goto l1705
 						}
-						position++
+						goto l1704
+					l1705:
+						position, tokenIndex = position1705, tokenIndex1705
 					}
-				l1670:
+					add(rulePegText, position1703)
+				}
+				if !_rules[ruleAction95]() {
+					goto l1701
+				}
+				add(ruleproductExpr, position1702)
+			}
+			return true
+		l1701:
+			position, tokenIndex = position1701, tokenIndex1701
+			return false
+		},
+		/* 122 minusExpr <- <(<((UnaryMinus spOpt)? castExpr)> Action96)> */
+		func() bool {
+			position1706, tokenIndex1706 := position, tokenIndex
+			{
+				position1707 := position
+				{
+					position1708 := position
 					{
-						position1672, tokenIndex1672 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l1673
+						position1709, tokenIndex1709 := position, tokenIndex
+						if !_rules[ruleUnaryMinus]() {
+							goto l1709
 						}
-						position++
-						goto l1672
-					l1673:
-						position, tokenIndex = position1672, tokenIndex1672
-						if buffer[position] != rune('L') {
-							goto l1663
+						if !_rules[rulespOpt]() {
+							goto l1709
 						}
-						position++
+						goto l1710
+					l1709:
+						position, tokenIndex = position1709, tokenIndex1709
+					}
+				l1710:
+					if !_rules[rulecastExpr]() {
+						goto l1706
 					}
-				l1672:
-					add(rulePegText, position1665)
+					add(rulePegText, position1708)
 				}
-				if !_rules[ruleAction106]() {
-					goto l1663
+				if !_rules[ruleAction96]() {
+					goto l1706
 				}
-				add(ruleBool, position1664)
+				add(ruleminusExpr, position1707)
 			}
 			return true
-		l1663:
-			position, tokenIndex = position1663, tokenIndex1663
+		l1706:
+			position, tokenIndex = position1706, tokenIndex1706
 			return false
 		},
-		/* 138 Int <- <(<(('i' / 'I') ('n' / 'N') ('t' / 'T'))> Action107)> */
+		/* 123 castExpr <- <(<(baseExpr (spOpt (':' ':') spOpt Type)?)> Action97)> */
 		func() bool {
-			position1674, tokenIndex1674 := position, tokenIndex
+			position1711, tokenIndex1711 := position, tokenIndex
 			{
-				position1675 := position
+				position1712 := position
 				{
-					position1676 := position
-					{
-						position1677, tokenIndex1677 := position, tokenIndex
-						if buffer[position] != rune('i') {
-							goto l1678
-						}
-						position++
-						goto l1677
-					l1678:
-						position, tokenIndex = position1677, tokenIndex1677
-						if buffer[position] != rune('I') {
-							goto l1674
-						}
-						position++
+					position1713 := position
+					if !_rules[rulebaseExpr]() {
+						goto l1711
 					}
-				l1677:
 					{
-						position1679, tokenIndex1679 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1680
+						position1714, tokenIndex1714 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1714
 						}
-						position++
-						goto l1679
-					l1680:
-						position, tokenIndex = position1679, tokenIndex1679
-						if buffer[position] != rune('N') {
-							goto l1674
+						if buffer[position] != rune(':') {
+							goto l1714
 						}
 						position++
-					}
-				l1679:
-					{
-						position1681, tokenIndex1681 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1682
+						if buffer[position] != rune(':') {
+							goto l1714
 						}
 						position++
-						goto l1681
-					l1682:
-						position, tokenIndex = position1681, tokenIndex1681
-						if buffer[position] != rune('T') {
-							goto l1674
+						if !_rules[rulespOpt]() {
+							goto l1714
 						}
-						position++
+						if !_rules[ruleType]() {
+							goto l1714
+						}
+						goto l1715
+					l1714:
+						position, tokenIndex = position1714, tokenIndex1714
 					}
-				l1681:
-					add(rulePegText, position1676)
+				l1715:
+					add(rulePegText, position1713)
 				}
-				if !_rules[ruleAction107]() {
-					goto l1674
+				if !_rules[ruleAction97]() {
+					goto l1711
+				}
+				add(rulecastExpr, position1712)
+			}
+			return true
+		l1711:
+			position, tokenIndex = position1711, tokenIndex1711
+			return false
+		},
+		/* 124 baseExpr <- <(('(' spOpt Expression spOpt ')') / MapExpr / BooleanLiteral / NullLiteral / Case / RowMeta / IntervalLiteral / FuncTypeCast / FuncAppOrAnalytic / RowValue / ArrayExpr / Literal)> */
+		func() bool {
+			position1716, tokenIndex1716 := position, tokenIndex
+			{
+				position1717 := position
+				{
+					position1718, tokenIndex1718 := position, tokenIndex
+					if buffer[position] != rune('(') {
+						goto l1719
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1719
+					}
+					if !_rules[ruleExpression]() {
+						goto l1719
+					}
+					if !_rules[rulespOpt]() {
+						goto l1719
+					}
+					if buffer[position] != rune(')') {
+						goto l1719
+					}
+					position++
+					goto l1718
+				l1719:
+					position, tokenIndex = position1718, tokenIndex1718
+					if !_rules[ruleMapExpr]() {
+						goto l1720
+					}
+					goto l1718
+				l1720:
+					position, tokenIndex = position1718, tokenIndex1718
+					if !_rules[ruleBooleanLiteral]() {
+						goto l1721
+					}
+					goto l1718
+				l1721:
+					position, tokenIndex = position1718, tokenIndex1718
+					if !_rules[ruleNullLiteral]() {
+						goto l1722
+					}
+					goto l1718
+				l1722:
+					position, tokenIndex = position1718, tokenIndex1718
+					if !_rules[ruleCase]() {
+						goto l1723
+					}
+					goto l1718
+				l1723:
+					position, tokenIndex = position1718, tokenIndex1718
+					if !_rules[ruleRowMeta]() {
+						goto l1724
+					}
+					goto l1718
+				l1724:
+					position, tokenIndex = position1718, tokenIndex1718
+					if !_rules[ruleIntervalLiteral]() {
+						goto l1725
+					}
+					goto l1718
+				l1725:
+					position, tokenIndex = position1718, tokenIndex1718
+					if !_rules[ruleFuncTypeCast]() {
+						goto l1726
+					}
+					goto l1718
+				l1726:
+					position, tokenIndex = position1718, tokenIndex1718
+					if !_rules[ruleFuncAppOrAnalytic]() {
+						goto l1727
+					}
+					goto l1718
+				l1727:
+					position, tokenIndex = position1718, tokenIndex1718
+					if !_rules[ruleRowValue]() {
+						goto l1728
+					}
+					goto l1718
+				l1728:
+					position, tokenIndex = position1718, tokenIndex1718
+					if !_rules[ruleArrayExpr]() {
+						goto l1729
+					}
+					goto l1718
+				l1729:
+					position, tokenIndex = position1718, tokenIndex1718
+					if !_rules[ruleLiteral]() {
+						goto l1716
+					}
 				}
-				add(ruleInt, position1675)
+			l1718:
+				add(rulebaseExpr, position1717)
 			}
 			return true
-		l1674:
-			position, tokenIndex = position1674, tokenIndex1674
+		l1716:
+			position, tokenIndex = position1716, tokenIndex1716
 			return false
 		},
-		/* 139 Float <- <(<(('f' / 'F') ('l' / 'L') ('o' / 'O') ('a' / 'A') ('t' / 'T'))> Action108)> */
+		/* 125 FuncTypeCast <- <(<(('c' / 'C') ('a' / 'A') ('s' / 'S') ('t' / 'T') spOpt '(' spOpt Expression sp (('a' / 'A') ('s' / 'S')) sp Type spOpt ')')> Action98)> */
 		func() bool {
-			position1683, tokenIndex1683 := position, tokenIndex
+			position1730, tokenIndex1730 := position, tokenIndex
 			{
-				position1684 := position
+				position1731 := position
 				{
-					position1685 := position
+					position1732 := position
 					{
-						position1686, tokenIndex1686 := position, tokenIndex
-						if buffer[position] != rune('f') {
-							goto l1687
+						position1733, tokenIndex1733 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l1734
 						}
 						position++
-						goto l1686
-					l1687:
-						position, tokenIndex = position1686, tokenIndex1686
-						if buffer[position] != rune('F') {
-							goto l1683
+						goto l1733
+					l1734:
+						position, tokenIndex = position1733, tokenIndex1733
+						if buffer[position] != rune('C') {
+							goto l1730
 						}
 						position++
 					}
-				l1686:
+				l1733:
 					{
-						position1688, tokenIndex1688 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l1689
+						position1735, tokenIndex1735 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l1736
 						}
 						position++
-						goto l1688
-					l1689:
-						position, tokenIndex = position1688, tokenIndex1688
-						if buffer[position] != rune('L') {
-							goto l1683
+						goto l1735
+					l1736:
+						position, tokenIndex = position1735, tokenIndex1735
+						if buffer[position] != rune('A') {
+							goto l1730
 						}
 						position++
 					}
-				l1688:
+				l1735:
 					{
-						position1690, tokenIndex1690 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1691
+						position1737, tokenIndex1737 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l1738
 						}
 						position++
-						goto l1690
-					l1691:
-						position, tokenIndex = position1690, tokenIndex1690
-						if buffer[position] != rune('O') {
-							goto l1683
+						goto l1737
+					l1738:
+						position, tokenIndex = position1737, tokenIndex1737
+						if buffer[position] != rune('S') {
+							goto l1730
 						}
 						position++
 					}
-				l1690:
+				l1737:
 					{
-						position1692, tokenIndex1692 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1693
+						position1739, tokenIndex1739 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1740
 						}
 						position++
-						goto l1692
-					l1693:
-						position, tokenIndex = position1692, tokenIndex1692
-						if buffer[position] != rune('A') {
-							goto l1683
+						goto l1739
+					l1740:
+						position, tokenIndex = position1739, tokenIndex1739
+						if buffer[position] != rune('T') {
+							goto l1730
 						}
 						position++
 					}
-				l1692:
+				l1739:
+					if !_rules[rulespOpt]() {
+						goto l1730
+					}
+					if buffer[position] != rune('(') {
+						goto l1730
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1730
+					}
+					if !_rules[ruleExpression]() {
+						goto l1730
+					}
+					if !_rules[rulesp]() {
+						goto l1730
+					}
 					{
-						position1694, tokenIndex1694 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1695
+						position1741, tokenIndex1741 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l1742
 						}
 						position++
-						goto l1694
-					l1695:
-						position, tokenIndex = position1694, tokenIndex1694
-						if buffer[position] != rune('T') {
-							goto l1683
+						goto l1741
+					l1742:
+						position, tokenIndex = position1741, tokenIndex1741
+						if buffer[position] != rune('A') {
+							goto l1730
 						}
 						position++
 					}
-				l1694:
-					add(rulePegText, position1685)
-				}
-				if !_rules[ruleAction108]() {
-					goto l1683
-				}
-				add(ruleFloat, position1684)
-			}
-			return true
-		l1683:
-			position, tokenIndex = position1683, tokenIndex1683
-			return false
-		},
-		/* 140 String <- <(<(('s' / 'S') ('t' / 'T') ('r' / 'R') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action109)> */
-		func() bool {
-			position1696, tokenIndex1696 := position, tokenIndex
-			{
-				position1697 := position
-				{
-					position1698 := position
+				l1741:
 					{
-						position1699, tokenIndex1699 := position, tokenIndex
+						position1743, tokenIndex1743 := position, tokenIndex
 						if buffer[position] != rune('s') {
-							goto l1700
+							goto l1744
 						}
 						position++
-						goto l1699
-					l1700:
-						position, tokenIndex = position1699, tokenIndex1699
+						goto l1743
+					l1744:
+						position, tokenIndex = position1743, tokenIndex1743
 						if buffer[position] != rune('S') {
-							goto l1696
-						}
-						position++
-					}
-				l1699:
-					{
-						position1701, tokenIndex1701 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1702
-						}
-						position++
-						goto l1701
-					l1702:
-						position, tokenIndex = position1701, tokenIndex1701
-						if buffer[position] != rune('T') {
-							goto l1696
+							goto l1730
 						}
 						position++
 					}
-				l1701:
-					{
-						position1703, tokenIndex1703 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1704
-						}
-						position++
-						goto l1703
-					l1704:
-						position, tokenIndex = position1703, tokenIndex1703
-						if buffer[position] != rune('R') {
-							goto l1696
-						}
-						position++
+				l1743:
+					if !_rules[rulesp]() {
+						goto l1730
 					}
-				l1703:
-					{
-						position1705, tokenIndex1705 := position, tokenIndex
-						if buffer[position] != rune('i') {
-							goto l1706
-						}
-						position++
-						goto l1705
-					l1706:
-						position, tokenIndex = position1705, tokenIndex1705
-						if buffer[position] != rune('I') {
-							goto l1696
-						}
-						position++
+					if !_rules[ruleType]() {
+						goto l1730
 					}
-				l1705:
-					{
-						position1707, tokenIndex1707 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1708
-						}
-						position++
-						goto l1707
-					l1708:
-						position, tokenIndex = position1707, tokenIndex1707
-						if buffer[position] != rune('N') {
-							goto l1696
-						}
-						position++
+					if !_rules[rulespOpt]() {
+						goto l1730
 					}
-				l1707:
-					{
-						position1709, tokenIndex1709 := position, tokenIndex
-						if buffer[position] != rune('g') {
-							goto l1710
-						}
-						position++
-						goto l1709
-					l1710:
-						position, tokenIndex = position1709, tokenIndex1709
-						if buffer[position] != rune('G') {
-							goto l1696
-						}
-						position++
+					if buffer[position] != rune(')') {
+						goto l1730
 					}
-				l1709:
-					add(rulePegText, position1698)
+					position++
+					add(rulePegText, position1732)
 				}
-				if !_rules[ruleAction109]() {
-					goto l1696
+				if !_rules[ruleAction98]() {
+					goto l1730
 				}
-				add(ruleString, position1697)
+				add(ruleFuncTypeCast, position1731)
 			}
 			return true
-		l1696:
-			position, tokenIndex = position1696, tokenIndex1696
+		l1730:
+			position, tokenIndex = position1730, tokenIndex1730
 			return false
 		},
-		/* 141 Blob <- <(<(('b' / 'B') ('l' / 'L') ('o' / 'O') ('b' / 'B'))> Action110)> */
+		/* 126 FuncAppOrAnalytic <- <(FuncApp OverSpecOpt)> */
 		func() bool {
-			position1711, tokenIndex1711 := position, tokenIndex
+			position1745, tokenIndex1745 := position, tokenIndex
 			{
-				position1712 := position
+				position1746 := position
+				if !_rules[ruleFuncApp]() {
+					goto l1745
+				}
+				if !_rules[ruleOverSpecOpt]() {
+					goto l1745
+				}
+				add(ruleFuncAppOrAnalytic, position1746)
+			}
+			return true
+		l1745:
+			position, tokenIndex = position1745, tokenIndex1745
+			return false
+		},
+		/* 127 OverSpecOpt <- <(<(sp (('o' / 'O') ('v' / 'V') ('e' / 'E') ('r' / 'R')) spOpt '(' spOpt PartitionByOpt OverOrderByOpt spOpt ')')?> Action99)> */
+		func() bool {
+			position1747, tokenIndex1747 := position, tokenIndex
+			{
+				position1748 := position
 				{
-					position1713 := position
+					position1749 := position
 					{
-						position1714, tokenIndex1714 := position, tokenIndex
-						if buffer[position] != rune('b') {
-							goto l1715
+						position1750, tokenIndex1750 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1750
 						}
-						position++
-						goto l1714
-					l1715:
-						position, tokenIndex = position1714, tokenIndex1714
-						if buffer[position] != rune('B') {
-							goto l1711
+						{
+							position1752, tokenIndex1752 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1753
+							}
+							position++
+							goto l1752
+						l1753:
+							position, tokenIndex = position1752, tokenIndex1752
+							if buffer[position] != rune('O') {
+								goto l1750
+							}
+							position++
 						}
-						position++
-					}
-				l1714:
-					{
-						position1716, tokenIndex1716 := position, tokenIndex
-						if buffer[position] != rune('l') {
-							goto l1717
+					l1752:
+						{
+							position1754, tokenIndex1754 := position, tokenIndex
+							if buffer[position] != rune('v') {
+								goto l1755
+							}
+							position++
+							goto l1754
+						l1755:
+							position, tokenIndex = position1754, tokenIndex1754
+							if buffer[position] != rune('V') {
+								goto l1750
+							}
+							position++
 						}
-						position++
-						goto l1716
-					l1717:
-						position, tokenIndex = position1716, tokenIndex1716
-						if buffer[position] != rune('L') {
-							goto l1711
+					l1754:
+						{
+							position1756, tokenIndex1756 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1757
+							}
+							position++
+							goto l1756
+						l1757:
+							position, tokenIndex = position1756, tokenIndex1756
+							if buffer[position] != rune('E') {
+								goto l1750
+							}
+							position++
 						}
-						position++
-					}
-				l1716:
-					{
-						position1718, tokenIndex1718 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1719
+					l1756:
+						{
+							position1758, tokenIndex1758 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1759
+							}
+							position++
+							goto l1758
+						l1759:
+							position, tokenIndex = position1758, tokenIndex1758
+							if buffer[position] != rune('R') {
+								goto l1750
+							}
+							position++
 						}
-						position++
-						goto l1718
-					l1719:
-						position, tokenIndex = position1718, tokenIndex1718
-						if buffer[position] != rune('O') {
-							goto l1711
+					l1758:
+						if !_rules[rulespOpt]() {
+							goto l1750
 						}
-						position++
-					}
-				l1718:
-					{
-						position1720, tokenIndex1720 := position, tokenIndex
-						if buffer[position] != rune('b') {
-							goto l1721
+						if buffer[position] != rune('(') {
+							goto l1750
 						}
 						position++
-						goto l1720
-					l1721:
-						position, tokenIndex = position1720, tokenIndex1720
-						if buffer[position] != rune('B') {
-							goto l1711
+						if !_rules[rulespOpt]() {
+							goto l1750
+						}
+						if !_rules[rulePartitionByOpt]() {
+							goto l1750
+						}
+						if !_rules[ruleOverOrderByOpt]() {
+							goto l1750
+						}
+						if !_rules[rulespOpt]() {
+							goto l1750
+						}
+						if buffer[position] != rune(')') {
+							goto l1750
 						}
 						position++
+						goto l1751
+					l1750:
+						position, tokenIndex = position1750, tokenIndex1750
 					}
-				l1720:
-					add(rulePegText, position1713)
+				l1751:
+					add(rulePegText, position1749)
 				}
-				if !_rules[ruleAction110]() {
-					goto l1711
+				if !_rules[ruleAction99]() {
+					goto l1747
 				}
-				add(ruleBlob, position1712)
+				add(ruleOverSpecOpt, position1748)
 			}
 			return true
-		l1711:
-			position, tokenIndex = position1711, tokenIndex1711
+		l1747:
+			position, tokenIndex = position1747, tokenIndex1747
 			return false
 		},
-		/* 142 Timestamp <- <(<(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ('s' / 'S') ('t' / 'T') ('a' / 'A') ('m' / 'M') ('p' / 'P'))> Action111)> */
+		/* 128 PartitionByOpt <- <(<(('p' / 'P') ('a' / 'A') ('r' / 'R') ('t' / 'T') ('i' / 'I') ('t' / 'T') ('i' / 'I') ('o' / 'O') ('n' / 'N') sp (('b' / 'B') ('y' / 'Y')) sp Expression (spOpt ',' spOpt Expression)*)?> Action100)> */
 		func() bool {
-			position1722, tokenIndex1722 := position, tokenIndex
+			position1760, tokenIndex1760 := position, tokenIndex
 			{
-				position1723 := position
+				position1761 := position
 				{
-					position1724 := position
+					position1762 := position
 					{
-						position1725, tokenIndex1725 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1726
+						position1763, tokenIndex1763 := position, tokenIndex
+						{
+							position1765, tokenIndex1765 := position, tokenIndex
+							if buffer[position] != rune('p') {
+								goto l1766
+							}
+							position++
+							goto l1765
+						l1766:
+							position, tokenIndex = position1765, tokenIndex1765
+							if buffer[position] != rune('P') {
+								goto l1763
+							}
+							position++
 						}
-						position++
-						goto l1725
-					l1726:
-						position, tokenIndex = position1725, tokenIndex1725
-						if buffer[position] != rune('T') {
-							goto l1722
+					l1765:
+						{
+							position1767, tokenIndex1767 := position, tokenIndex
+							if buffer[position] != rune('a') {
+								goto l1768
+							}
+							position++
+							goto l1767
+						l1768:
+							position, tokenIndex = position1767, tokenIndex1767
+							if buffer[position] != rune('A') {
+								goto l1763
+							}
+							position++
 						}
-						position++
-					}
-				l1725:
-					{
-						position1727, tokenIndex1727 := position, tokenIndex
-						if buffer[position] != rune('i') {
-							goto l1728
+					l1767:
+						{
+							position1769, tokenIndex1769 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1770
+							}
+							position++
+							goto l1769
+						l1770:
+							position, tokenIndex = position1769, tokenIndex1769
+							if buffer[position] != rune('R') {
+								goto l1763
+							}
+							position++
 						}
-						position++
-						goto l1727
-					l1728:
-						position, tokenIndex = position1727, tokenIndex1727
-						if buffer[position] != rune('I') {
-							goto l1722
+					l1769:
+						{
+							position1771, tokenIndex1771 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1772
+							}
+							position++
+							goto l1771
+						l1772:
+							position, tokenIndex = position1771, tokenIndex1771
+							if buffer[position] != rune('T') {
+								goto l1763
+							}
+							position++
 						}
-						position++
-					}
-				l1727:
-					{
-						position1729, tokenIndex1729 := position, tokenIndex
-						if buffer[position] != rune('m') {
-							goto l1730
+					l1771:
+						{
+							position1773, tokenIndex1773 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1774
+							}
+							position++
+							goto l1773
+						l1774:
+							position, tokenIndex = position1773, tokenIndex1773
+							if buffer[position] != rune('I') {
+								goto l1763
+							}
+							position++
 						}
-						position++
-						goto l1729
-					l1730:
-						position, tokenIndex = position1729, tokenIndex1729
-						if buffer[position] != rune('M') {
-							goto l1722
+					l1773:
+						{
+							position1775, tokenIndex1775 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1776
+							}
+							position++
+							goto l1775
+						l1776:
+							position, tokenIndex = position1775, tokenIndex1775
+							if buffer[position] != rune('T') {
+								goto l1763
+							}
+							position++
 						}
-						position++
-					}
-				l1729:
-					{
-						position1731, tokenIndex1731 := position, tokenIndex
-						if buffer[position] != rune('e') {
-							goto l1732
-						}
-						position++
-						goto l1731
-					l1732:
-						position, tokenIndex = position1731, tokenIndex1731
-						if buffer[position] != rune('E') {
-							goto l1722
-						}
-						position++
-					}
-				l1731:
-					{
-						position1733, tokenIndex1733 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1734
-						}
-						position++
-						goto l1733
-					l1734:
-						position, tokenIndex = position1733, tokenIndex1733
-						if buffer[position] != rune('S') {
-							goto l1722
+					l1775:
+						{
+							position1777, tokenIndex1777 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1778
+							}
+							position++
+							goto l1777
+						l1778:
+							position, tokenIndex = position1777, tokenIndex1777
+							if buffer[position] != rune('I') {
+								goto l1763
+							}
+							position++
 						}
-						position++
-					}
-				l1733:
-					{
-						position1735, tokenIndex1735 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1736
+					l1777:
+						{
+							position1779, tokenIndex1779 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1780
+							}
+							position++
+							goto l1779
+						l1780:
+							position, tokenIndex = position1779, tokenIndex1779
+							if buffer[position] != rune('O') {
+								goto l1763
+							}
+							position++
 						}
-						position++
-						goto l1735
-					l1736:
-						position, tokenIndex = position1735, tokenIndex1735
-						if buffer[position] != rune('T') {
-							goto l1722
+					l1779:
+						{
+							position1781, tokenIndex1781 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l1782
+							}
+							position++
+							goto l1781
+						l1782:
+							position, tokenIndex = position1781, tokenIndex1781
+							if buffer[position] != rune('N') {
+								goto l1763
+							}
+							position++
 						}
-						position++
-					}
-				l1735:
-					{
-						position1737, tokenIndex1737 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1738
+					l1781:
+						if !_rules[rulesp]() {
+							goto l1763
 						}
-						position++
-						goto l1737
-					l1738:
-						position, tokenIndex = position1737, tokenIndex1737
-						if buffer[position] != rune('A') {
-							goto l1722
+						{
+							position1783, tokenIndex1783 := position, tokenIndex
+							if buffer[position] != rune('b') {
+								goto l1784
+							}
+							position++
+							goto l1783
+						l1784:
+							position, tokenIndex = position1783, tokenIndex1783
+							if buffer[position] != rune('B') {
+								goto l1763
+							}
+							position++
 						}
-						position++
-					}
-				l1737:
-					{
-						position1739, tokenIndex1739 := position, tokenIndex
-						if buffer[position] != rune('m') {
-							goto l1740
+					l1783:
+						{
+							position1785, tokenIndex1785 := position, tokenIndex
+							if buffer[position] != rune('y') {
+								goto l1786
+							}
+							position++
+							goto l1785
+						l1786:
+							position, tokenIndex = position1785, tokenIndex1785
+							if buffer[position] != rune('Y') {
+								goto l1763
+							}
+							position++
 						}
-						position++
-						goto l1739
-					l1740:
-						position, tokenIndex = position1739, tokenIndex1739
-						if buffer[position] != rune('M') {
-							goto l1722
+					l1785:
+						if !_rules[rulesp]() {
+							goto l1763
 						}
-						position++
-					}
-				l1739:
-					{
-						position1741, tokenIndex1741 := position, tokenIndex
-						if buffer[position] != rune('p') {
-							goto l1742
+						if !_rules[ruleExpression]() {
+							goto l1763
 						}
-						position++
-						goto l1741
-					l1742:
-						position, tokenIndex = position1741, tokenIndex1741
-						if buffer[position] != rune('P') {
-							goto l1722
+					l1787:
+						{
+							position1788, tokenIndex1788 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l1788
+							}
+							if buffer[position] != rune(',') {
+								goto l1788
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1788
+							}
+							if !_rules[ruleExpression]() {
+								goto l1788
+							}
+							goto l1787
+						l1788:
+							position, tokenIndex = position1788, tokenIndex1788
 						}
-						position++
+						goto l1764
+					l1763:
+						position, tokenIndex = position1763, tokenIndex1763
 					}
-				l1741:
-					add(rulePegText, position1724)
+				l1764:
+					add(rulePegText, position1762)
 				}
-				if !_rules[ruleAction111]() {
-					goto l1722
+				if !_rules[ruleAction100]() {
+					goto l1760
 				}
-				add(ruleTimestamp, position1723)
+				add(rulePartitionByOpt, position1761)
 			}
 			return true
-		l1722:
-			position, tokenIndex = position1722, tokenIndex1722
+		l1760:
+			position, tokenIndex = position1760, tokenIndex1760
 			return false
 		},
-		/* 143 Array <- <(<(('a' / 'A') ('r' / 'R') ('r' / 'R') ('a' / 'A') ('y' / 'Y'))> Action112)> */
+		/* 129 OverOrderByOpt <- <(<(spOpt (('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R')) sp (('b' / 'B') ('y' / 'Y')) sp SortedExpression (spOpt ',' spOpt SortedExpression)*)?> Action101)> */
 		func() bool {
-			position1743, tokenIndex1743 := position, tokenIndex
+			position1789, tokenIndex1789 := position, tokenIndex
 			{
-				position1744 := position
+				position1790 := position
 				{
-					position1745 := position
+					position1791 := position
 					{
-						position1746, tokenIndex1746 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1747
+						position1792, tokenIndex1792 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1792
 						}
-						position++
-						goto l1746
-					l1747:
-						position, tokenIndex = position1746, tokenIndex1746
-						if buffer[position] != rune('A') {
-							goto l1743
+						{
+							position1794, tokenIndex1794 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l1795
+							}
+							position++
+							goto l1794
+						l1795:
+							position, tokenIndex = position1794, tokenIndex1794
+							if buffer[position] != rune('O') {
+								goto l1792
+							}
+							position++
 						}
-						position++
-					}
-				l1746:
-					{
-						position1748, tokenIndex1748 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1749
+					l1794:
+						{
+							position1796, tokenIndex1796 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1797
+							}
+							position++
+							goto l1796
+						l1797:
+							position, tokenIndex = position1796, tokenIndex1796
+							if buffer[position] != rune('R') {
+								goto l1792
+							}
+							position++
 						}
-						position++
-						goto l1748
-					l1749:
-						position, tokenIndex = position1748, tokenIndex1748
-						if buffer[position] != rune('R') {
-							goto l1743
+					l1796:
+						{
+							position1798, tokenIndex1798 := position, tokenIndex
+							if buffer[position] != rune('d') {
+								goto l1799
+							}
+							position++
+							goto l1798
+						l1799:
+							position, tokenIndex = position1798, tokenIndex1798
+							if buffer[position] != rune('D') {
+								goto l1792
+							}
+							position++
 						}
-						position++
-					}
-				l1748:
-					{
-						position1750, tokenIndex1750 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1751
+					l1798:
+						{
+							position1800, tokenIndex1800 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1801
+							}
+							position++
+							goto l1800
+						l1801:
+							position, tokenIndex = position1800, tokenIndex1800
+							if buffer[position] != rune('E') {
+								goto l1792
+							}
+							position++
 						}
-						position++
-						goto l1750
-					l1751:
-						position, tokenIndex = position1750, tokenIndex1750
-						if buffer[position] != rune('R') {
-							goto l1743
+					l1800:
+						{
+							position1802, tokenIndex1802 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l1803
+							}
+							position++
+							goto l1802
+						l1803:
+							position, tokenIndex = position1802, tokenIndex1802
+							if buffer[position] != rune('R') {
+								goto l1792
+							}
+							position++
 						}
-						position++
-					}
-				l1750:
-					{
-						position1752, tokenIndex1752 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1753
+					l1802:
+						if !_rules[rulesp]() {
+							goto l1792
 						}
-						position++
-						goto l1752
-					l1753:
-						position, tokenIndex = position1752, tokenIndex1752
-						if buffer[position] != rune('A') {
-							goto l1743
+						{
+							position1804, tokenIndex1804 := position, tokenIndex
+							if buffer[position] != rune('b') {
+								goto l1805
+							}
+							position++
+							goto l1804
+						l1805:
+							position, tokenIndex = position1804, tokenIndex1804
+							if buffer[position] != rune('B') {
+								goto l1792
+							}
+							position++
 						}
-						position++
-					}
-				l1752:
-					{
-						position1754, tokenIndex1754 := position, tokenIndex
-						if buffer[position] != rune('y') {
-							goto l1755
+					l1804:
+						{
+							position1806, tokenIndex1806 := position, tokenIndex
+							if buffer[position] != rune('y') {
+								goto l1807
+							}
+							position++
+							goto l1806
+						l1807:
+							position, tokenIndex = position1806, tokenIndex1806
+							if buffer[position] != rune('Y') {
+								goto l1792
+							}
+							position++
 						}
-						position++
-						goto l1754
-					l1755:
-						position, tokenIndex = position1754, tokenIndex1754
-						if buffer[position] != rune('Y') {
-							goto l1743
+					l1806:
+						if !_rules[rulesp]() {
+							goto l1792
 						}
-						position++
+						if !_rules[ruleSortedExpression]() {
+							goto l1792
+						}
+					l1808:
+						{
+							position1809, tokenIndex1809 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l1809
+							}
+							if buffer[position] != rune(',') {
+								goto l1809
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1809
+							}
+							if !_rules[ruleSortedExpression]() {
+								goto l1809
+							}
+							goto l1808
+						l1809:
+							position, tokenIndex = position1809, tokenIndex1809
+						}
+						goto l1793
+					l1792:
+						position, tokenIndex = position1792, tokenIndex1792
 					}
-				l1754:
-					add(rulePegText, position1745)
+				l1793:
+					add(rulePegText, position1791)
 				}
-				if !_rules[ruleAction112]() {
-					goto l1743
+				if !_rules[ruleAction101]() {
+					goto l1789
 				}
-				add(ruleArray, position1744)
+				add(ruleOverOrderByOpt, position1790)
 			}
 			return true
-		l1743:
-			position, tokenIndex = position1743, tokenIndex1743
+		l1789:
+			position, tokenIndex = position1789, tokenIndex1789
 			return false
 		},
-		/* 144 Map <- <(<(('m' / 'M') ('a' / 'A') ('p' / 'P'))> Action113)> */
+		/* 130 FuncApp <- <(FuncAppWithOrderBy / FuncAppWithoutOrderBy)> */
 		func() bool {
-			position1756, tokenIndex1756 := position, tokenIndex
+			position1810, tokenIndex1810 := position, tokenIndex
 			{
-				position1757 := position
+				position1811 := position
 				{
-					position1758 := position
-					{
-						position1759, tokenIndex1759 := position, tokenIndex
-						if buffer[position] != rune('m') {
-							goto l1760
-						}
-						position++
-						goto l1759
-					l1760:
-						position, tokenIndex = position1759, tokenIndex1759
-						if buffer[position] != rune('M') {
-							goto l1756
-						}
-						position++
-					}
-				l1759:
-					{
-						position1761, tokenIndex1761 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1762
-						}
-						position++
-						goto l1761
-					l1762:
-						position, tokenIndex = position1761, tokenIndex1761
-						if buffer[position] != rune('A') {
-							goto l1756
-						}
-						position++
+					position1812, tokenIndex1812 := position, tokenIndex
+					if !_rules[ruleFuncAppWithOrderBy]() {
+						goto l1813
 					}
-				l1761:
-					{
-						position1763, tokenIndex1763 := position, tokenIndex
-						if buffer[position] != rune('p') {
-							goto l1764
-						}
-						position++
-						goto l1763
-					l1764:
-						position, tokenIndex = position1763, tokenIndex1763
-						if buffer[position] != rune('P') {
-							goto l1756
-						}
-						position++
+					goto l1812
+				l1813:
+					position, tokenIndex = position1812, tokenIndex1812
+					if !_rules[ruleFuncAppWithoutOrderBy]() {
+						goto l1810
 					}
-				l1763:
-					add(rulePegText, position1758)
-				}
-				if !_rules[ruleAction113]() {
-					goto l1756
 				}
-				add(ruleMap, position1757)
+			l1812:
+				add(ruleFuncApp, position1811)
 			}
 			return true
-		l1756:
-			position, tokenIndex = position1756, tokenIndex1756
-			return false
+		l1810:
+			position, tokenIndex = position1810, tokenIndex1810
+			return false
+		},
+		/* 131 FuncAppWithOrderBy <- <(Function spOpt '(' spOpt FuncDistinctOpt FuncParams sp ParamsOrder spOpt ')' Action102)> */
+		func() bool {
+			position1814, tokenIndex1814 := position, tokenIndex
+			{
+				position1815 := position
+				if !_rules[ruleFunction]() {
+					goto l1814
+				}
+				if !_rules[rulespOpt]() {
+					goto l1814
+				}
+				if buffer[position] != rune('(') {
+					goto l1814
+				}
+				position++
+				if !_rules[rulespOpt]() {
+					goto l1814
+				}
+				if !_rules[ruleFuncDistinctOpt]() {
+					goto l1814
+				}
+				if !_rules[ruleFuncParams]() {
+					goto l1814
+				}
+				if !_rules[rulesp]() {
+					goto l1814
+				}
+				if !_rules[ruleParamsOrder]() {
+					goto l1814
+				}
+				if !_rules[rulespOpt]() {
+					goto l1814
+				}
+				if buffer[position] != rune(')') {
+					goto l1814
+				}
+				position++
+				if !_rules[ruleAction102]() {
+					goto l1814
+				}
+				add(ruleFuncAppWithOrderBy, position1815)
+			}
+			return true
+		l1814:
+			position, tokenIndex = position1814, tokenIndex1814
+			return false
 		},
-		/* 145 Or <- <(<(('o' / 'O') ('r' / 'R'))> Action114)> */
+		/* 132 FuncAppWithoutOrderBy <- <(Function spOpt '(' spOpt FuncDistinctOpt FuncParams <spOpt> ')' Action103)> */
 		func() bool {
-			position1765, tokenIndex1765 := position, tokenIndex
+			position1816, tokenIndex1816 := position, tokenIndex
 			{
-				position1766 := position
+				position1817 := position
+				if !_rules[ruleFunction]() {
+					goto l1816
+				}
+				if !_rules[rulespOpt]() {
+					goto l1816
+				}
+				if buffer[position] != rune('(') {
+					goto l1816
+				}
+				position++
+				if !_rules[rulespOpt]() {
+					goto l1816
+				}
+				if !_rules[ruleFuncDistinctOpt]() {
+					goto l1816
+				}
+				if !_rules[ruleFuncParams]() {
+					goto l1816
+				}
+				{
+					position1818 := position
+					if !_rules[rulespOpt]() {
+						goto l1816
+					}
+					add(rulePegText, position1818)
+				}
+				if buffer[position] != rune(')') {
+					goto l1816
+				}
+				position++
+				if !_rules[ruleAction103]() {
+					goto l1816
+				}
+				add(ruleFuncAppWithoutOrderBy, position1817)
+			}
+			return true
+		l1816:
+			position, tokenIndex = position1816, tokenIndex1816
+			return false
+		},
+		/* 133 FuncDistinctOpt <- <(<(('d' / 'D') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('i' / 'I') ('n' / 'N') ('c' / 'C') ('t' / 'T') &(sp / '(') spOpt)?> Action104)> */
+		func() bool {
+			position1819, tokenIndex1819 := position, tokenIndex
+			{
+				position1820 := position
 				{
-					position1767 := position
+					position1821 := position
 					{
-						position1768, tokenIndex1768 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1769
+						position1822, tokenIndex1822 := position, tokenIndex
+						{
+							position1824, tokenIndex1824 := position, tokenIndex
+							if buffer[position] != rune('d') {
+								goto l1825
+							}
+							position++
+							goto l1824
+						l1825:
+							position, tokenIndex = position1824, tokenIndex1824
+							if buffer[position] != rune('D') {
+								goto l1822
+							}
+							position++
 						}
-						position++
-						goto l1768
-					l1769:
-						position, tokenIndex = position1768, tokenIndex1768
-						if buffer[position] != rune('O') {
-							goto l1765
+					l1824:
+						{
+							position1826, tokenIndex1826 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1827
+							}
+							position++
+							goto l1826
+						l1827:
+							position, tokenIndex = position1826, tokenIndex1826
+							if buffer[position] != rune('I') {
+								goto l1822
+							}
+							position++
 						}
-						position++
-					}
-				l1768:
-					{
-						position1770, tokenIndex1770 := position, tokenIndex
-						if buffer[position] != rune('r') {
-							goto l1771
+					l1826:
+						{
+							position1828, tokenIndex1828 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1829
+							}
+							position++
+							goto l1828
+						l1829:
+							position, tokenIndex = position1828, tokenIndex1828
+							if buffer[position] != rune('S') {
+								goto l1822
+							}
+							position++
 						}
-						position++
-						goto l1770
-					l1771:
-						position, tokenIndex = position1770, tokenIndex1770
-						if buffer[position] != rune('R') {
-							goto l1765
+					l1828:
+						{
+							position1830, tokenIndex1830 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1831
+							}
+							position++
+							goto l1830
+						l1831:
+							position, tokenIndex = position1830, tokenIndex1830
+							if buffer[position] != rune('T') {
+								goto l1822
+							}
+							position++
 						}
-						position++
+					l1830:
+						{
+							position1832, tokenIndex1832 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l1833
+							}
+							position++
+							goto l1832
+						l1833:
+							position, tokenIndex = position1832, tokenIndex1832
+							if buffer[position] != rune('I') {
+								goto l1822
+							}
+							position++
+						}
+					l1832:
+						{
+							position1834, tokenIndex1834 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l1835
+							}
+							position++
+							goto l1834
+						l1835:
+							position, tokenIndex = position1834, tokenIndex1834
+							if buffer[position] != rune('N') {
+								goto l1822
+							}
+							position++
+						}
+					l1834:
+						{
+							position1836, tokenIndex1836 := position, tokenIndex
+							if buffer[position] != rune('c') {
+								goto l1837
+							}
+							position++
+							goto l1836
+						l1837:
+							position, tokenIndex = position1836, tokenIndex1836
+							if buffer[position] != rune('C') {
+								goto l1822
+							}
+							position++
+						}
+					l1836:
+						{
+							position1838, tokenIndex1838 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l1839
+							}
+							position++
+							goto l1838
+						l1839:
+							position, tokenIndex = position1838, tokenIndex1838
+							if buffer[position] != rune('T') {
+								goto l1822
+							}
+							position++
+						}
+					l1838:
+						{
+							position1840, tokenIndex1840 := position, tokenIndex
+							{
+								position1841, tokenIndex1841 := position, tokenIndex
+								if !_rules[rulesp]() {
+									goto l1842
+								}
+								goto l1841
+							l1842:
+								position, tokenIndex = position1841, tokenIndex1841
+								if buffer[position] != rune('(') {
+									goto l1822
+								}
+								position++
+							}
+						l1841:
+							position, tokenIndex = position1840, tokenIndex1840
+						}
+						if !_rules[rulespOpt]() {
+							goto l1822
+						}
+						goto l1823
+					l1822:
+						position, tokenIndex = position1822, tokenIndex1822
 					}
-				l1770:
-					add(rulePegText, position1767)
+				l1823:
+					add(rulePegText, position1821)
 				}
-				if !_rules[ruleAction114]() {
-					goto l1765
+				if !_rules[ruleAction104]() {
+					goto l1819
 				}
-				add(ruleOr, position1766)
+				add(ruleFuncDistinctOpt, position1820)
 			}
 			return true
-		l1765:
-			position, tokenIndex = position1765, tokenIndex1765
+		l1819:
+			position, tokenIndex = position1819, tokenIndex1819
 			return false
 		},
-		/* 146 And <- <(<(('a' / 'A') ('n' / 'N') ('d' / 'D'))> Action115)> */
+		/* 134 FuncParams <- <(<(FuncParam (spOpt ',' spOpt FuncParam)*)?> Action105)> */
 		func() bool {
-			position1772, tokenIndex1772 := position, tokenIndex
+			position1843, tokenIndex1843 := position, tokenIndex
 			{
-				position1773 := position
+				position1844 := position
 				{
-					position1774 := position
+					position1845 := position
 					{
-						position1775, tokenIndex1775 := position, tokenIndex
-						if buffer[position] != rune('a') {
-							goto l1776
+						position1846, tokenIndex1846 := position, tokenIndex
+						if !_rules[ruleFuncParam]() {
+							goto l1846
 						}
-						position++
-						goto l1775
-					l1776:
-						position, tokenIndex = position1775, tokenIndex1775
-						if buffer[position] != rune('A') {
-							goto l1772
+					l1848:
+						{
+							position1849, tokenIndex1849 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l1849
+							}
+							if buffer[position] != rune(',') {
+								goto l1849
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1849
+							}
+							if !_rules[ruleFuncParam]() {
+								goto l1849
+							}
+							goto l1848
+						l1849:
+							position, tokenIndex = position1849, tokenIndex1849
 						}
-						position++
+						goto l1847
+					l1846:
+						position, tokenIndex = position1846, tokenIndex1846
 					}
-				l1775:
-					{
-						position1777, tokenIndex1777 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1778
-						}
-						position++
-						goto l1777
-					l1778:
-						position, tokenIndex = position1777, tokenIndex1777
-						if buffer[position] != rune('N') {
-							goto l1772
-						}
-						position++
+				l1847:
+					add(rulePegText, position1845)
+				}
+				if !_rules[ruleAction105]() {
+					goto l1843
+				}
+				add(ruleFuncParams, position1844)
+			}
+			return true
+		l1843:
+			position, tokenIndex = position1843, tokenIndex1843
+			return false
+		},
+		/* 135 FuncParam <- <(Lambda / ExpressionOrWildcard)> */
+		func() bool {
+			position1850, tokenIndex1850 := position, tokenIndex
+			{
+				position1851 := position
+				{
+					position1852, tokenIndex1852 := position, tokenIndex
+					if !_rules[ruleLambda]() {
+						goto l1853
 					}
-				l1777:
-					{
-						position1779, tokenIndex1779 := position, tokenIndex
-						if buffer[position] != rune('d') {
-							goto l1780
-						}
-						position++
-						goto l1779
-					l1780:
-						position, tokenIndex = position1779, tokenIndex1779
-						if buffer[position] != rune('D') {
-							goto l1772
-						}
-						position++
+					goto l1852
+				l1853:
+					position, tokenIndex = position1852, tokenIndex1852
+					if !_rules[ruleExpressionOrWildcard]() {
+						goto l1850
 					}
-				l1779:
-					add(rulePegText, position1774)
 				}
-				if !_rules[ruleAction115]() {
-					goto l1772
+			l1852:
+				add(ruleFuncParam, position1851)
+			}
+			return true
+		l1850:
+			position, tokenIndex = position1850, tokenIndex1850
+			return false
+		},
+		/* 136 Lambda <- <(Identifier spOpt ('-' '>') spOpt Expression Action106)> */
+		func() bool {
+			position1854, tokenIndex1854 := position, tokenIndex
+			{
+				position1855 := position
+				if !_rules[ruleIdentifier]() {
+					goto l1854
+				}
+				if !_rules[rulespOpt]() {
+					goto l1854
+				}
+				if buffer[position] != rune('-') {
+					goto l1854
+				}
+				position++
+				if buffer[position] != rune('>') {
+					goto l1854
+				}
+				position++
+				if !_rules[rulespOpt]() {
+					goto l1854
+				}
+				if !_rules[ruleExpression]() {
+					goto l1854
 				}
-				add(ruleAnd, position1773)
+				if !_rules[ruleAction106]() {
+					goto l1854
+				}
+				add(ruleLambda, position1855)
 			}
 			return true
-		l1772:
-			position, tokenIndex = position1772, tokenIndex1772
+		l1854:
+			position, tokenIndex = position1854, tokenIndex1854
 			return false
 		},
-		/* 147 Not <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T'))> Action116)> */
+		/* 137 ParamsOrder <- <(<(('o' / 'O') ('r' / 'R') ('d' / 'D') ('e' / 'E') ('r' / 'R') sp (('b' / 'B') ('y' / 'Y')) sp SortedExpression (spOpt ',' spOpt SortedExpression)*)> Action107)> */
 		func() bool {
-			position1781, tokenIndex1781 := position, tokenIndex
+			position1856, tokenIndex1856 := position, tokenIndex
 			{
-				position1782 := position
+				position1857 := position
 				{
-					position1783 := position
+					position1858 := position
 					{
-						position1784, tokenIndex1784 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1785
+						position1859, tokenIndex1859 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l1860
 						}
 						position++
-						goto l1784
-					l1785:
-						position, tokenIndex = position1784, tokenIndex1784
-						if buffer[position] != rune('N') {
-							goto l1781
+						goto l1859
+					l1860:
+						position, tokenIndex = position1859, tokenIndex1859
+						if buffer[position] != rune('O') {
+							goto l1856
 						}
 						position++
 					}
-				l1784:
+				l1859:
 					{
-						position1786, tokenIndex1786 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1787
+						position1861, tokenIndex1861 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l1862
 						}
 						position++
-						goto l1786
-					l1787:
-						position, tokenIndex = position1786, tokenIndex1786
-						if buffer[position] != rune('O') {
-							goto l1781
+						goto l1861
+					l1862:
+						position, tokenIndex = position1861, tokenIndex1861
+						if buffer[position] != rune('R') {
+							goto l1856
 						}
 						position++
 					}
-				l1786:
+				l1861:
 					{
-						position1788, tokenIndex1788 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1789
+						position1863, tokenIndex1863 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l1864
 						}
 						position++
-						goto l1788
-					l1789:
-						position, tokenIndex = position1788, tokenIndex1788
-						if buffer[position] != rune('T') {
-							goto l1781
+						goto l1863
+					l1864:
+						position, tokenIndex = position1863, tokenIndex1863
+						if buffer[position] != rune('D') {
+							goto l1856
 						}
 						position++
 					}
-				l1788:
-					add(rulePegText, position1783)
-				}
-				if !_rules[ruleAction116]() {
-					goto l1781
-				}
-				add(ruleNot, position1782)
+				l1863:
+					{
+						position1865, tokenIndex1865 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1866
+						}
+						position++
+						goto l1865
+					l1866:
+						position, tokenIndex = position1865, tokenIndex1865
+						if buffer[position] != rune('E') {
+							goto l1856
+						}
+						position++
+					}
+				l1865:
+					{
+						position1867, tokenIndex1867 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l1868
+						}
+						position++
+						goto l1867
+					l1868:
+						position, tokenIndex = position1867, tokenIndex1867
+						if buffer[position] != rune('R') {
+							goto l1856
+						}
+						position++
+					}
+				l1867:
+					if !_rules[rulesp]() {
+						goto l1856
+					}
+					{
+						position1869, tokenIndex1869 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l1870
+						}
+						position++
+						goto l1869
+					l1870:
+						position, tokenIndex = position1869, tokenIndex1869
+						if buffer[position] != rune('B') {
+							goto l1856
+						}
+						position++
+					}
+				l1869:
+					{
+						position1871, tokenIndex1871 := position, tokenIndex
+						if buffer[position] != rune('y') {
+							goto l1872
+						}
+						position++
+						goto l1871
+					l1872:
+						position, tokenIndex = position1871, tokenIndex1871
+						if buffer[position] != rune('Y') {
+							goto l1856
+						}
+						position++
+					}
+				l1871:
+					if !_rules[rulesp]() {
+						goto l1856
+					}
+					if !_rules[ruleSortedExpression]() {
+						goto l1856
+					}
+				l1873:
+					{
+						position1874, tokenIndex1874 := position, tokenIndex
+						if !_rules[rulespOpt]() {
+							goto l1874
+						}
+						if buffer[position] != rune(',') {
+							goto l1874
+						}
+						position++
+						if !_rules[rulespOpt]() {
+							goto l1874
+						}
+						if !_rules[ruleSortedExpression]() {
+							goto l1874
+						}
+						goto l1873
+					l1874:
+						position, tokenIndex = position1874, tokenIndex1874
+					}
+					add(rulePegText, position1858)
+				}
+				if !_rules[ruleAction107]() {
+					goto l1856
+				}
+				add(ruleParamsOrder, position1857)
+			}
+			return true
+		l1856:
+			position, tokenIndex = position1856, tokenIndex1856
+			return false
+		},
+		/* 138 SortedExpression <- <(Expression OrderDirectionOpt Action108)> */
+		func() bool {
+			position1875, tokenIndex1875 := position, tokenIndex
+			{
+				position1876 := position
+				if !_rules[ruleExpression]() {
+					goto l1875
+				}
+				if !_rules[ruleOrderDirectionOpt]() {
+					goto l1875
+				}
+				if !_rules[ruleAction108]() {
+					goto l1875
+				}
+				add(ruleSortedExpression, position1876)
+			}
+			return true
+		l1875:
+			position, tokenIndex = position1875, tokenIndex1875
+			return false
+		},
+		/* 139 OrderDirectionOpt <- <(<(sp (Ascending / Descending))?> Action109)> */
+		func() bool {
+			position1877, tokenIndex1877 := position, tokenIndex
+			{
+				position1878 := position
+				{
+					position1879 := position
+					{
+						position1880, tokenIndex1880 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1880
+						}
+						{
+							position1882, tokenIndex1882 := position, tokenIndex
+							if !_rules[ruleAscending]() {
+								goto l1883
+							}
+							goto l1882
+						l1883:
+							position, tokenIndex = position1882, tokenIndex1882
+							if !_rules[ruleDescending]() {
+								goto l1880
+							}
+						}
+					l1882:
+						goto l1881
+					l1880:
+						position, tokenIndex = position1880, tokenIndex1880
+					}
+				l1881:
+					add(rulePegText, position1879)
+				}
+				if !_rules[ruleAction109]() {
+					goto l1877
+				}
+				add(ruleOrderDirectionOpt, position1878)
+			}
+			return true
+		l1877:
+			position, tokenIndex = position1877, tokenIndex1877
+			return false
+		},
+		/* 140 ArrayExpr <- <(<('[' spOpt (ExpressionOrWildcard (spOpt ',' spOpt ExpressionOrWildcard)*)? spOpt ','? spOpt ']')> Action110)> */
+		func() bool {
+			position1884, tokenIndex1884 := position, tokenIndex
+			{
+				position1885 := position
+				{
+					position1886 := position
+					if buffer[position] != rune('[') {
+						goto l1884
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1884
+					}
+					{
+						position1887, tokenIndex1887 := position, tokenIndex
+						if !_rules[ruleExpressionOrWildcard]() {
+							goto l1887
+						}
+					l1889:
+						{
+							position1890, tokenIndex1890 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l1890
+							}
+							if buffer[position] != rune(',') {
+								goto l1890
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1890
+							}
+							if !_rules[ruleExpressionOrWildcard]() {
+								goto l1890
+							}
+							goto l1889
+						l1890:
+							position, tokenIndex = position1890, tokenIndex1890
+						}
+						goto l1888
+					l1887:
+						position, tokenIndex = position1887, tokenIndex1887
+					}
+				l1888:
+					if !_rules[rulespOpt]() {
+						goto l1884
+					}
+					{
+						position1891, tokenIndex1891 := position, tokenIndex
+						if buffer[position] != rune(',') {
+							goto l1891
+						}
+						position++
+						goto l1892
+					l1891:
+						position, tokenIndex = position1891, tokenIndex1891
+					}
+				l1892:
+					if !_rules[rulespOpt]() {
+						goto l1884
+					}
+					if buffer[position] != rune(']') {
+						goto l1884
+					}
+					position++
+					add(rulePegText, position1886)
+				}
+				if !_rules[ruleAction110]() {
+					goto l1884
+				}
+				add(ruleArrayExpr, position1885)
+			}
+			return true
+		l1884:
+			position, tokenIndex = position1884, tokenIndex1884
+			return false
+		},
+		/* 141 MapExpr <- <(<('{' spOpt (KeyValuePair (spOpt ',' spOpt KeyValuePair)*)? spOpt '}')> Action111)> */
+		func() bool {
+			position1893, tokenIndex1893 := position, tokenIndex
+			{
+				position1894 := position
+				{
+					position1895 := position
+					if buffer[position] != rune('{') {
+						goto l1893
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1893
+					}
+					{
+						position1896, tokenIndex1896 := position, tokenIndex
+						if !_rules[ruleKeyValuePair]() {
+							goto l1896
+						}
+					l1898:
+						{
+							position1899, tokenIndex1899 := position, tokenIndex
+							if !_rules[rulespOpt]() {
+								goto l1899
+							}
+							if buffer[position] != rune(',') {
+								goto l1899
+							}
+							position++
+							if !_rules[rulespOpt]() {
+								goto l1899
+							}
+							if !_rules[ruleKeyValuePair]() {
+								goto l1899
+							}
+							goto l1898
+						l1899:
+							position, tokenIndex = position1899, tokenIndex1899
+						}
+						goto l1897
+					l1896:
+						position, tokenIndex = position1896, tokenIndex1896
+					}
+				l1897:
+					if !_rules[rulespOpt]() {
+						goto l1893
+					}
+					if buffer[position] != rune('}') {
+						goto l1893
+					}
+					position++
+					add(rulePegText, position1895)
+				}
+				if !_rules[ruleAction111]() {
+					goto l1893
+				}
+				add(ruleMapExpr, position1894)
+			}
+			return true
+		l1893:
+			position, tokenIndex = position1893, tokenIndex1893
+			return false
+		},
+		/* 142 KeyValuePair <- <(<(StringLiteral spOpt ':' spOpt ExpressionOrWildcard)> Action112)> */
+		func() bool {
+			position1900, tokenIndex1900 := position, tokenIndex
+			{
+				position1901 := position
+				{
+					position1902 := position
+					if !_rules[ruleStringLiteral]() {
+						goto l1900
+					}
+					if !_rules[rulespOpt]() {
+						goto l1900
+					}
+					if buffer[position] != rune(':') {
+						goto l1900
+					}
+					position++
+					if !_rules[rulespOpt]() {
+						goto l1900
+					}
+					if !_rules[ruleExpressionOrWildcard]() {
+						goto l1900
+					}
+					add(rulePegText, position1902)
+				}
+				if !_rules[ruleAction112]() {
+					goto l1900
+				}
+				add(ruleKeyValuePair, position1901)
+			}
+			return true
+		l1900:
+			position, tokenIndex = position1900, tokenIndex1900
+			return false
+		},
+		/* 143 Case <- <(ConditionCase / ExpressionCase)> */
+		func() bool {
+			position1903, tokenIndex1903 := position, tokenIndex
+			{
+				position1904 := position
+				{
+					position1905, tokenIndex1905 := position, tokenIndex
+					if !_rules[ruleConditionCase]() {
+						goto l1906
+					}
+					goto l1905
+				l1906:
+					position, tokenIndex = position1905, tokenIndex1905
+					if !_rules[ruleExpressionCase]() {
+						goto l1903
+					}
+				}
+			l1905:
+				add(ruleCase, position1904)
+			}
+			return true
+		l1903:
+			position, tokenIndex = position1903, tokenIndex1903
+			return false
+		},
+		/* 144 ConditionCase <- <(('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') <((sp WhenThenPair)+ (sp (('e' / 'E') ('l' / 'L') ('s' / 'S') ('e' / 'E')) sp Expression)? sp (('e' / 'E') ('n' / 'N') ('d' / 'D')))> Action113)> */
+		func() bool {
+			position1907, tokenIndex1907 := position, tokenIndex
+			{
+				position1908 := position
+				{
+					position1909, tokenIndex1909 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l1910
+					}
+					position++
+					goto l1909
+				l1910:
+					position, tokenIndex = position1909, tokenIndex1909
+					if buffer[position] != rune('C') {
+						goto l1907
+					}
+					position++
+				}
+			l1909:
+				{
+					position1911, tokenIndex1911 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l1912
+					}
+					position++
+					goto l1911
+				l1912:
+					position, tokenIndex = position1911, tokenIndex1911
+					if buffer[position] != rune('A') {
+						goto l1907
+					}
+					position++
+				}
+			l1911:
+				{
+					position1913, tokenIndex1913 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1914
+					}
+					position++
+					goto l1913
+				l1914:
+					position, tokenIndex = position1913, tokenIndex1913
+					if buffer[position] != rune('S') {
+						goto l1907
+					}
+					position++
+				}
+			l1913:
+				{
+					position1915, tokenIndex1915 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1916
+					}
+					position++
+					goto l1915
+				l1916:
+					position, tokenIndex = position1915, tokenIndex1915
+					if buffer[position] != rune('E') {
+						goto l1907
+					}
+					position++
+				}
+			l1915:
+				{
+					position1917 := position
+					if !_rules[rulesp]() {
+						goto l1907
+					}
+					if !_rules[ruleWhenThenPair]() {
+						goto l1907
+					}
+				l1918:
+					{
+						position1919, tokenIndex1919 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1919
+						}
+						if !_rules[ruleWhenThenPair]() {
+							goto l1919
+						}
+						goto l1918
+					l1919:
+						position, tokenIndex = position1919, tokenIndex1919
+					}
+					{
+						position1920, tokenIndex1920 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1920
+						}
+						{
+							position1922, tokenIndex1922 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1923
+							}
+							position++
+							goto l1922
+						l1923:
+							position, tokenIndex = position1922, tokenIndex1922
+							if buffer[position] != rune('E') {
+								goto l1920
+							}
+							position++
+						}
+					l1922:
+						{
+							position1924, tokenIndex1924 := position, tokenIndex
+							if buffer[position] != rune('l') {
+								goto l1925
+							}
+							position++
+							goto l1924
+						l1925:
+							position, tokenIndex = position1924, tokenIndex1924
+							if buffer[position] != rune('L') {
+								goto l1920
+							}
+							position++
+						}
+					l1924:
+						{
+							position1926, tokenIndex1926 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1927
+							}
+							position++
+							goto l1926
+						l1927:
+							position, tokenIndex = position1926, tokenIndex1926
+							if buffer[position] != rune('S') {
+								goto l1920
+							}
+							position++
+						}
+					l1926:
+						{
+							position1928, tokenIndex1928 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1929
+							}
+							position++
+							goto l1928
+						l1929:
+							position, tokenIndex = position1928, tokenIndex1928
+							if buffer[position] != rune('E') {
+								goto l1920
+							}
+							position++
+						}
+					l1928:
+						if !_rules[rulesp]() {
+							goto l1920
+						}
+						if !_rules[ruleExpression]() {
+							goto l1920
+						}
+						goto l1921
+					l1920:
+						position, tokenIndex = position1920, tokenIndex1920
+					}
+				l1921:
+					if !_rules[rulesp]() {
+						goto l1907
+					}
+					{
+						position1930, tokenIndex1930 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1931
+						}
+						position++
+						goto l1930
+					l1931:
+						position, tokenIndex = position1930, tokenIndex1930
+						if buffer[position] != rune('E') {
+							goto l1907
+						}
+						position++
+					}
+				l1930:
+					{
+						position1932, tokenIndex1932 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1933
+						}
+						position++
+						goto l1932
+					l1933:
+						position, tokenIndex = position1932, tokenIndex1932
+						if buffer[position] != rune('N') {
+							goto l1907
+						}
+						position++
+					}
+				l1932:
+					{
+						position1934, tokenIndex1934 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l1935
+						}
+						position++
+						goto l1934
+					l1935:
+						position, tokenIndex = position1934, tokenIndex1934
+						if buffer[position] != rune('D') {
+							goto l1907
+						}
+						position++
+					}
+				l1934:
+					add(rulePegText, position1917)
+				}
+				if !_rules[ruleAction113]() {
+					goto l1907
+				}
+				add(ruleConditionCase, position1908)
+			}
+			return true
+		l1907:
+			position, tokenIndex = position1907, tokenIndex1907
+			return false
+		},
+		/* 145 ExpressionCase <- <(('c' / 'C') ('a' / 'A') ('s' / 'S') ('e' / 'E') sp Expression <((sp WhenThenPair)+ (sp (('e' / 'E') ('l' / 'L') ('s' / 'S') ('e' / 'E')) sp Expression)? sp (('e' / 'E') ('n' / 'N') ('d' / 'D')))> Action114)> */
+		func() bool {
+			position1936, tokenIndex1936 := position, tokenIndex
+			{
+				position1937 := position
+				{
+					position1938, tokenIndex1938 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l1939
+					}
+					position++
+					goto l1938
+				l1939:
+					position, tokenIndex = position1938, tokenIndex1938
+					if buffer[position] != rune('C') {
+						goto l1936
+					}
+					position++
+				}
+			l1938:
+				{
+					position1940, tokenIndex1940 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l1941
+					}
+					position++
+					goto l1940
+				l1941:
+					position, tokenIndex = position1940, tokenIndex1940
+					if buffer[position] != rune('A') {
+						goto l1936
+					}
+					position++
+				}
+			l1940:
+				{
+					position1942, tokenIndex1942 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l1943
+					}
+					position++
+					goto l1942
+				l1943:
+					position, tokenIndex = position1942, tokenIndex1942
+					if buffer[position] != rune('S') {
+						goto l1936
+					}
+					position++
+				}
+			l1942:
+				{
+					position1944, tokenIndex1944 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1945
+					}
+					position++
+					goto l1944
+				l1945:
+					position, tokenIndex = position1944, tokenIndex1944
+					if buffer[position] != rune('E') {
+						goto l1936
+					}
+					position++
+				}
+			l1944:
+				if !_rules[rulesp]() {
+					goto l1936
+				}
+				if !_rules[ruleExpression]() {
+					goto l1936
+				}
+				{
+					position1946 := position
+					if !_rules[rulesp]() {
+						goto l1936
+					}
+					if !_rules[ruleWhenThenPair]() {
+						goto l1936
+					}
+				l1947:
+					{
+						position1948, tokenIndex1948 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1948
+						}
+						if !_rules[ruleWhenThenPair]() {
+							goto l1948
+						}
+						goto l1947
+					l1948:
+						position, tokenIndex = position1948, tokenIndex1948
+					}
+					{
+						position1949, tokenIndex1949 := position, tokenIndex
+						if !_rules[rulesp]() {
+							goto l1949
+						}
+						{
+							position1951, tokenIndex1951 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1952
+							}
+							position++
+							goto l1951
+						l1952:
+							position, tokenIndex = position1951, tokenIndex1951
+							if buffer[position] != rune('E') {
+								goto l1949
+							}
+							position++
+						}
+					l1951:
+						{
+							position1953, tokenIndex1953 := position, tokenIndex
+							if buffer[position] != rune('l') {
+								goto l1954
+							}
+							position++
+							goto l1953
+						l1954:
+							position, tokenIndex = position1953, tokenIndex1953
+							if buffer[position] != rune('L') {
+								goto l1949
+							}
+							position++
+						}
+					l1953:
+						{
+							position1955, tokenIndex1955 := position, tokenIndex
+							if buffer[position] != rune('s') {
+								goto l1956
+							}
+							position++
+							goto l1955
+						l1956:
+							position, tokenIndex = position1955, tokenIndex1955
+							if buffer[position] != rune('S') {
+								goto l1949
+							}
+							position++
+						}
+					l1955:
+						{
+							position1957, tokenIndex1957 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l1958
+							}
+							position++
+							goto l1957
+						l1958:
+							position, tokenIndex = position1957, tokenIndex1957
+							if buffer[position] != rune('E') {
+								goto l1949
+							}
+							position++
+						}
+					l1957:
+						if !_rules[rulesp]() {
+							goto l1949
+						}
+						if !_rules[ruleExpression]() {
+							goto l1949
+						}
+						goto l1950
+					l1949:
+						position, tokenIndex = position1949, tokenIndex1949
+					}
+				l1950:
+					if !_rules[rulesp]() {
+						goto l1936
+					}
+					{
+						position1959, tokenIndex1959 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1960
+						}
+						position++
+						goto l1959
+					l1960:
+						position, tokenIndex = position1959, tokenIndex1959
+						if buffer[position] != rune('E') {
+							goto l1936
+						}
+						position++
+					}
+				l1959:
+					{
+						position1961, tokenIndex1961 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1962
+						}
+						position++
+						goto l1961
+					l1962:
+						position, tokenIndex = position1961, tokenIndex1961
+						if buffer[position] != rune('N') {
+							goto l1936
+						}
+						position++
+					}
+				l1961:
+					{
+						position1963, tokenIndex1963 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l1964
+						}
+						position++
+						goto l1963
+					l1964:
+						position, tokenIndex = position1963, tokenIndex1963
+						if buffer[position] != rune('D') {
+							goto l1936
+						}
+						position++
+					}
+				l1963:
+					add(rulePegText, position1946)
+				}
+				if !_rules[ruleAction114]() {
+					goto l1936
+				}
+				add(ruleExpressionCase, position1937)
+			}
+			return true
+		l1936:
+			position, tokenIndex = position1936, tokenIndex1936
+			return false
+		},
+		/* 146 WhenThenPair <- <(('w' / 'W') ('h' / 'H') ('e' / 'E') ('n' / 'N') sp Expression sp (('t' / 'T') ('h' / 'H') ('e' / 'E') ('n' / 'N')) sp ExpressionOrWildcard Action115)> */
+		func() bool {
+			position1965, tokenIndex1965 := position, tokenIndex
+			{
+				position1966 := position
+				{
+					position1967, tokenIndex1967 := position, tokenIndex
+					if buffer[position] != rune('w') {
+						goto l1968
+					}
+					position++
+					goto l1967
+				l1968:
+					position, tokenIndex = position1967, tokenIndex1967
+					if buffer[position] != rune('W') {
+						goto l1965
+					}
+					position++
+				}
+			l1967:
+				{
+					position1969, tokenIndex1969 := position, tokenIndex
+					if buffer[position] != rune('h') {
+						goto l1970
+					}
+					position++
+					goto l1969
+				l1970:
+					position, tokenIndex = position1969, tokenIndex1969
+					if buffer[position] != rune('H') {
+						goto l1965
+					}
+					position++
+				}
+			l1969:
+				{
+					position1971, tokenIndex1971 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1972
+					}
+					position++
+					goto l1971
+				l1972:
+					position, tokenIndex = position1971, tokenIndex1971
+					if buffer[position] != rune('E') {
+						goto l1965
+					}
+					position++
+				}
+			l1971:
+				{
+					position1973, tokenIndex1973 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l1974
+					}
+					position++
+					goto l1973
+				l1974:
+					position, tokenIndex = position1973, tokenIndex1973
+					if buffer[position] != rune('N') {
+						goto l1965
+					}
+					position++
+				}
+			l1973:
+				if !_rules[rulesp]() {
+					goto l1965
+				}
+				if !_rules[ruleExpression]() {
+					goto l1965
+				}
+				if !_rules[rulesp]() {
+					goto l1965
+				}
+				{
+					position1975, tokenIndex1975 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l1976
+					}
+					position++
+					goto l1975
+				l1976:
+					position, tokenIndex = position1975, tokenIndex1975
+					if buffer[position] != rune('T') {
+						goto l1965
+					}
+					position++
+				}
+			l1975:
+				{
+					position1977, tokenIndex1977 := position, tokenIndex
+					if buffer[position] != rune('h') {
+						goto l1978
+					}
+					position++
+					goto l1977
+				l1978:
+					position, tokenIndex = position1977, tokenIndex1977
+					if buffer[position] != rune('H') {
+						goto l1965
+					}
+					position++
+				}
+			l1977:
+				{
+					position1979, tokenIndex1979 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l1980
+					}
+					position++
+					goto l1979
+				l1980:
+					position, tokenIndex = position1979, tokenIndex1979
+					if buffer[position] != rune('E') {
+						goto l1965
+					}
+					position++
+				}
+			l1979:
+				{
+					position1981, tokenIndex1981 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l1982
+					}
+					position++
+					goto l1981
+				l1982:
+					position, tokenIndex = position1981, tokenIndex1981
+					if buffer[position] != rune('N') {
+						goto l1965
+					}
+					position++
+				}
+			l1981:
+				if !_rules[rulesp]() {
+					goto l1965
+				}
+				if !_rules[ruleExpressionOrWildcard]() {
+					goto l1965
+				}
+				if !_rules[ruleAction115]() {
+					goto l1965
+				}
+				add(ruleWhenThenPair, position1966)
+			}
+			return true
+		l1965:
+			position, tokenIndex = position1965, tokenIndex1965
+			return false
+		},
+		/* 147 Literal <- <(FloatLiteral / NumericLiteral / StringLiteral)> */
+		func() bool {
+			position1983, tokenIndex1983 := position, tokenIndex
+			{
+				position1984 := position
+				{
+					position1985, tokenIndex1985 := position, tokenIndex
+					if !_rules[ruleFloatLiteral]() {
+						goto l1986
+					}
+					goto l1985
+				l1986:
+					position, tokenIndex = position1985, tokenIndex1985
+					if !_rules[ruleNumericLiteral]() {
+						goto l1987
+					}
+					goto l1985
+				l1987:
+					position, tokenIndex = position1985, tokenIndex1985
+					if !_rules[ruleStringLiteral]() {
+						goto l1983
+					}
+				}
+			l1985:
+				add(ruleLiteral, position1984)
+			}
+			return true
+		l1983:
+			position, tokenIndex = position1983, tokenIndex1983
+			return false
+		},
+		/* 148 IntervalLiteral <- <(<(('i' / 'I') ('n' / 'N') ('t' / 'T') ('e' / 'E') ('r' / 'R') ('v' / 'V') ('a' / 'A') ('l' / 'L') sp StringLiteral)> Action116)> */
+		func() bool {
+			position1988, tokenIndex1988 := position, tokenIndex
+			{
+				position1989 := position
+				{
+					position1990 := position
+					{
+						position1991, tokenIndex1991 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l1992
+						}
+						position++
+						goto l1991
+					l1992:
+						position, tokenIndex = position1991, tokenIndex1991
+						if buffer[position] != rune('I') {
+							goto l1988
+						}
+						position++
+					}
+				l1991:
+					{
+						position1993, tokenIndex1993 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l1994
+						}
+						position++
+						goto l1993
+					l1994:
+						position, tokenIndex = position1993, tokenIndex1993
+						if buffer[position] != rune('N') {
+							goto l1988
+						}
+						position++
+					}
+				l1993:
+					{
+						position1995, tokenIndex1995 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l1996
+						}
+						position++
+						goto l1995
+					l1996:
+						position, tokenIndex = position1995, tokenIndex1995
+						if buffer[position] != rune('T') {
+							goto l1988
+						}
+						position++
+					}
+				l1995:
+					{
+						position1997, tokenIndex1997 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l1998
+						}
+						position++
+						goto l1997
+					l1998:
+						position, tokenIndex = position1997, tokenIndex1997
+						if buffer[position] != rune('E') {
+							goto l1988
+						}
+						position++
+					}
+				l1997:
+					{
+						position1999, tokenIndex1999 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2000
+						}
+						position++
+						goto l1999
+					l2000:
+						position, tokenIndex = position1999, tokenIndex1999
+						if buffer[position] != rune('R') {
+							goto l1988
+						}
+						position++
+					}
+				l1999:
+					{
+						position2001, tokenIndex2001 := position, tokenIndex
+						if buffer[position] != rune('v') {
+							goto l2002
+						}
+						position++
+						goto l2001
+					l2002:
+						position, tokenIndex = position2001, tokenIndex2001
+						if buffer[position] != rune('V') {
+							goto l1988
+						}
+						position++
+					}
+				l2001:
+					{
+						position2003, tokenIndex2003 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2004
+						}
+						position++
+						goto l2003
+					l2004:
+						position, tokenIndex = position2003, tokenIndex2003
+						if buffer[position] != rune('A') {
+							goto l1988
+						}
+						position++
+					}
+				l2003:
+					{
+						position2005, tokenIndex2005 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2006
+						}
+						position++
+						goto l2005
+					l2006:
+						position, tokenIndex = position2005, tokenIndex2005
+						if buffer[position] != rune('L') {
+							goto l1988
+						}
+						position++
+					}
+				l2005:
+					if !_rules[rulesp]() {
+						goto l1988
+					}
+					if !_rules[ruleStringLiteral]() {
+						goto l1988
+					}
+					add(rulePegText, position1990)
+				}
+				if !_rules[ruleAction116]() {
+					goto l1988
+				}
+				add(ruleIntervalLiteral, position1989)
+			}
+			return true
+		l1988:
+			position, tokenIndex = position1988, tokenIndex1988
+			return false
+		},
+		/* 149 ComparisonOp <- <(Equal / NotEqual / LessOrEqual / Less / GreaterOrEqual / Greater / NotEqual / NotRegexMatch / RegexMatch)> */
+		func() bool {
+			position2007, tokenIndex2007 := position, tokenIndex
+			{
+				position2008 := position
+				{
+					position2009, tokenIndex2009 := position, tokenIndex
+					if !_rules[ruleEqual]() {
+						goto l2010
+					}
+					goto l2009
+				l2010:
+					position, tokenIndex = position2009, tokenIndex2009
+					if !_rules[ruleNotEqual]() {
+						goto l2011
+					}
+					goto l2009
+				l2011:
+					position, tokenIndex = position2009, tokenIndex2009
+					if !_rules[ruleLessOrEqual]() {
+						goto l2012
+					}
+					goto l2009
+				l2012:
+					position, tokenIndex = position2009, tokenIndex2009
+					if !_rules[ruleLess]() {
+						goto l2013
+					}
+					goto l2009
+				l2013:
+					position, tokenIndex = position2009, tokenIndex2009
+					if !_rules[ruleGreaterOrEqual]() {
+						goto l2014
+					}
+					goto l2009
+				l2014:
+					position, tokenIndex = position2009, tokenIndex2009
+					if !_rules[ruleGreater]() {
+						goto l2015
+					}
+					goto l2009
+				l2015:
+					position, tokenIndex = position2009, tokenIndex2009
+					if !_rules[ruleNotEqual]() {
+						goto l2016
+					}
+					goto l2009
+				l2016:
+					position, tokenIndex = position2009, tokenIndex2009
+					if !_rules[ruleNotRegexMatch]() {
+						goto l2017
+					}
+					goto l2009
+				l2017:
+					position, tokenIndex = position2009, tokenIndex2009
+					if !_rules[ruleRegexMatch]() {
+						goto l2007
+					}
+				}
+			l2009:
+				add(ruleComparisonOp, position2008)
+			}
+			return true
+		l2007:
+			position, tokenIndex = position2007, tokenIndex2007
+			return false
+		},
+		/* 150 PatternMatchOp <- <(NotLike / Like / NotILike / ILike)> */
+		func() bool {
+			position2018, tokenIndex2018 := position, tokenIndex
+			{
+				position2019 := position
+				{
+					position2020, tokenIndex2020 := position, tokenIndex
+					if !_rules[ruleNotLike]() {
+						goto l2021
+					}
+					goto l2020
+				l2021:
+					position, tokenIndex = position2020, tokenIndex2020
+					if !_rules[ruleLike]() {
+						goto l2022
+					}
+					goto l2020
+				l2022:
+					position, tokenIndex = position2020, tokenIndex2020
+					if !_rules[ruleNotILike]() {
+						goto l2023
+					}
+					goto l2020
+				l2023:
+					position, tokenIndex = position2020, tokenIndex2020
+					if !_rules[ruleILike]() {
+						goto l2018
+					}
+				}
+			l2020:
+				add(rulePatternMatchOp, position2019)
+			}
+			return true
+		l2018:
+			position, tokenIndex = position2018, tokenIndex2018
+			return false
+		},
+		/* 151 InOp <- <(NotIn / In)> */
+		func() bool {
+			position2024, tokenIndex2024 := position, tokenIndex
+			{
+				position2025 := position
+				{
+					position2026, tokenIndex2026 := position, tokenIndex
+					if !_rules[ruleNotIn]() {
+						goto l2027
+					}
+					goto l2026
+				l2027:
+					position, tokenIndex = position2026, tokenIndex2026
+					if !_rules[ruleIn]() {
+						goto l2024
+					}
+				}
+			l2026:
+				add(ruleInOp, position2025)
+			}
+			return true
+		l2024:
+			position, tokenIndex = position2024, tokenIndex2024
+			return false
+		},
+		/* 152 BetweenOp <- <(NotBetween / Between)> */
+		func() bool {
+			position2028, tokenIndex2028 := position, tokenIndex
+			{
+				position2029 := position
+				{
+					position2030, tokenIndex2030 := position, tokenIndex
+					if !_rules[ruleNotBetween]() {
+						goto l2031
+					}
+					goto l2030
+				l2031:
+					position, tokenIndex = position2030, tokenIndex2030
+					if !_rules[ruleBetween]() {
+						goto l2028
+					}
+				}
+			l2030:
+				add(ruleBetweenOp, position2029)
+			}
+			return true
+		l2028:
+			position, tokenIndex = position2028, tokenIndex2028
+			return false
+		},
+		/* 153 OtherOp <- <Concat> */
+		func() bool {
+			position2032, tokenIndex2032 := position, tokenIndex
+			{
+				position2033 := position
+				if !_rules[ruleConcat]() {
+					goto l2032
+				}
+				add(ruleOtherOp, position2033)
+			}
+			return true
+		l2032:
+			position, tokenIndex = position2032, tokenIndex2032
+			return false
+		},
+		/* 154 IsOp <- <(IsNot / Is)> */
+		func() bool {
+			position2034, tokenIndex2034 := position, tokenIndex
+			{
+				position2035 := position
+				{
+					position2036, tokenIndex2036 := position, tokenIndex
+					if !_rules[ruleIsNot]() {
+						goto l2037
+					}
+					goto l2036
+				l2037:
+					position, tokenIndex = position2036, tokenIndex2036
+					if !_rules[ruleIs]() {
+						goto l2034
+					}
+				}
+			l2036:
+				add(ruleIsOp, position2035)
+			}
+			return true
+		l2034:
+			position, tokenIndex = position2034, tokenIndex2034
+			return false
+		},
+		/* 155 PlusMinusOp <- <(Plus / Minus)> */
+		func() bool {
+			position2038, tokenIndex2038 := position, tokenIndex
+			{
+				position2039 := position
+				{
+					position2040, tokenIndex2040 := position, tokenIndex
+					if !_rules[rulePlus]() {
+						goto l2041
+					}
+					goto l2040
+				l2041:
+					position, tokenIndex = position2040, tokenIndex2040
+					if !_rules[ruleMinus]() {
+						goto l2038
+					}
+				}
+			l2040:
+				add(rulePlusMinusOp, position2039)
+			}
+			return true
+		l2038:
+			position, tokenIndex = position2038, tokenIndex2038
+			return false
+		},
+		/* 156 MultDivOp <- <(Multiply / Divide / Modulo)> */
+		func() bool {
+			position2042, tokenIndex2042 := position, tokenIndex
+			{
+				position2043 := position
+				{
+					position2044, tokenIndex2044 := position, tokenIndex
+					if !_rules[ruleMultiply]() {
+						goto l2045
+					}
+					goto l2044
+				l2045:
+					position, tokenIndex = position2044, tokenIndex2044
+					if !_rules[ruleDivide]() {
+						goto l2046
+					}
+					goto l2044
+				l2046:
+					position, tokenIndex = position2044, tokenIndex2044
+					if !_rules[ruleModulo]() {
+						goto l2042
+					}
+				}
+			l2044:
+				add(ruleMultDivOp, position2043)
+			}
+			return true
+		l2042:
+			position, tokenIndex = position2042, tokenIndex2042
+			return false
+		},
+		/* 157 Stream <- <(<ident> Action117)> */
+		func() bool {
+			position2047, tokenIndex2047 := position, tokenIndex
+			{
+				position2048 := position
+				{
+					position2049 := position
+					if !_rules[ruleident]() {
+						goto l2047
+					}
+					add(rulePegText, position2049)
+				}
+				if !_rules[ruleAction117]() {
+					goto l2047
+				}
+				add(ruleStream, position2048)
+			}
+			return true
+		l2047:
+			position, tokenIndex = position2047, tokenIndex2047
+			return false
+		},
+		/* 158 RowMeta <- <RowTimestamp> */
+		func() bool {
+			position2050, tokenIndex2050 := position, tokenIndex
+			{
+				position2051 := position
+				if !_rules[ruleRowTimestamp]() {
+					goto l2050
+				}
+				add(ruleRowMeta, position2051)
+			}
+			return true
+		l2050:
+			position, tokenIndex = position2050, tokenIndex2050
+			return false
+		},
+		/* 159 RowTimestamp <- <(<((ident ':')? ('t' 's' '(' ')'))> Action118)> */
+		func() bool {
+			position2052, tokenIndex2052 := position, tokenIndex
+			{
+				position2053 := position
+				{
+					position2054 := position
+					{
+						position2055, tokenIndex2055 := position, tokenIndex
+						if !_rules[ruleident]() {
+							goto l2055
+						}
+						if buffer[position] != rune(':') {
+							goto l2055
+						}
+						position++
+						goto l2056
+					l2055:
+						position, tokenIndex = position2055, tokenIndex2055
+					}
+				l2056:
+					if buffer[position] != rune('t') {
+						goto l2052
+					}
+					position++
+					if buffer[position] != rune('s') {
+						goto l2052
+					}
+					position++
+					if buffer[position] != rune('(') {
+						goto l2052
+					}
+					position++
+					if buffer[position] != rune(')') {
+						goto l2052
+					}
+					position++
+					add(rulePegText, position2054)
+				}
+				if !_rules[ruleAction118]() {
+					goto l2052
+				}
+				add(ruleRowTimestamp, position2053)
+			}
+			return true
+		l2052:
+			position, tokenIndex = position2052, tokenIndex2052
+			return false
+		},
+		/* 160 RowValue <- <(<((ident ':' !':')? jsonGetPath)> Action119)> */
+		func() bool {
+			position2057, tokenIndex2057 := position, tokenIndex
+			{
+				position2058 := position
+				{
+					position2059 := position
+					{
+						position2060, tokenIndex2060 := position, tokenIndex
+						if !_rules[ruleident]() {
+							goto l2060
+						}
+						if buffer[position] != rune(':') {
+							goto l2060
+						}
+						position++
+						{
+							position2062, tokenIndex2062 := position, tokenIndex
+							if buffer[position] != rune(':') {
+								goto l2062
+							}
+							position++
+							goto l2060
+						l2062:
+							position, tokenIndex = position2062, tokenIndex2062
+						}
+						goto l2061
+					l2060:
+						position, tokenIndex = position2060, tokenIndex2060
+					}
+				l2061:
+					if !_rules[rulejsonGetPath]() {
+						goto l2057
+					}
+					add(rulePegText, position2059)
+				}
+				if !_rules[ruleAction119]() {
+					goto l2057
+				}
+				add(ruleRowValue, position2058)
+			}
+			return true
+		l2057:
+			position, tokenIndex = position2057, tokenIndex2057
+			return false
+		},
+		/* 161 NumericLiteral <- <(<('-'? [0-9]+)> Action120)> */
+		func() bool {
+			position2063, tokenIndex2063 := position, tokenIndex
+			{
+				position2064 := position
+				{
+					position2065 := position
+					{
+						position2066, tokenIndex2066 := position, tokenIndex
+						if buffer[position] != rune('-') {
+							goto l2066
+						}
+						position++
+						goto l2067
+					l2066:
+						position, tokenIndex = position2066, tokenIndex2066
+					}
+				l2067:
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l2063
+					}
+					position++
+				l2068:
+					{
+						position2069, tokenIndex2069 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l2069
+						}
+						position++
+						goto l2068
+					l2069:
+						position, tokenIndex = position2069, tokenIndex2069
+					}
+					add(rulePegText, position2065)
+				}
+				if !_rules[ruleAction120]() {
+					goto l2063
+				}
+				add(ruleNumericLiteral, position2064)
+			}
+			return true
+		l2063:
+			position, tokenIndex = position2063, tokenIndex2063
+			return false
+		},
+		/* 162 NonNegativeNumericLiteral <- <(<[0-9]+> Action121)> */
+		func() bool {
+			position2070, tokenIndex2070 := position, tokenIndex
+			{
+				position2071 := position
+				{
+					position2072 := position
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l2070
+					}
+					position++
+				l2073:
+					{
+						position2074, tokenIndex2074 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l2074
+						}
+						position++
+						goto l2073
+					l2074:
+						position, tokenIndex = position2074, tokenIndex2074
+					}
+					add(rulePegText, position2072)
+				}
+				if !_rules[ruleAction121]() {
+					goto l2070
+				}
+				add(ruleNonNegativeNumericLiteral, position2071)
+			}
+			return true
+		l2070:
+			position, tokenIndex = position2070, tokenIndex2070
+			return false
+		},
+		/* 163 FloatLiteral <- <(<('-'? [0-9]+ '.' [0-9]+)> Action122)> */
+		func() bool {
+			position2075, tokenIndex2075 := position, tokenIndex
+			{
+				position2076 := position
+				{
+					position2077 := position
+					{
+						position2078, tokenIndex2078 := position, tokenIndex
+						if buffer[position] != rune('-') {
+							goto l2078
+						}
+						position++
+						goto l2079
+					l2078:
+						position, tokenIndex = position2078, tokenIndex2078
+					}
+				l2079:
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l2075
+					}
+					position++
+				l2080:
+					{
+						position2081, tokenIndex2081 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l2081
+						}
+						position++
+						goto l2080
+					l2081:
+						position, tokenIndex = position2081, tokenIndex2081
+					}
+					if buffer[position] != rune('.') {
+						goto l2075
+					}
+					position++
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l2075
+					}
+					position++
+				l2082:
+					{
+						position2083, tokenIndex2083 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l2083
+						}
+						position++
+						goto l2082
+					l2083:
+						position, tokenIndex = position2083, tokenIndex2083
+					}
+					add(rulePegText, position2077)
+				}
+				if !_rules[ruleAction122]() {
+					goto l2075
+				}
+				add(ruleFloatLiteral, position2076)
+			}
+			return true
+		l2075:
+			position, tokenIndex = position2075, tokenIndex2075
+			return false
+		},
+		/* 164 Function <- <(<ident> Action123)> */
+		func() bool {
+			position2084, tokenIndex2084 := position, tokenIndex
+			{
+				position2085 := position
+				{
+					position2086 := position
+					if !_rules[ruleident]() {
+						goto l2084
+					}
+					add(rulePegText, position2086)
+				}
+				if !_rules[ruleAction123]() {
+					goto l2084
+				}
+				add(ruleFunction, position2085)
+			}
+			return true
+		l2084:
+			position, tokenIndex = position2084, tokenIndex2084
+			return false
+		},
+		/* 165 NullLiteral <- <(<(('n' / 'N') ('u' / 'U') ('l' / 'L') ('l' / 'L'))> Action124)> */
+		func() bool {
+			position2087, tokenIndex2087 := position, tokenIndex
+			{
+				position2088 := position
+				{
+					position2089 := position
+					{
+						position2090, tokenIndex2090 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2091
+						}
+						position++
+						goto l2090
+					l2091:
+						position, tokenIndex = position2090, tokenIndex2090
+						if buffer[position] != rune('N') {
+							goto l2087
+						}
+						position++
+					}
+				l2090:
+					{
+						position2092, tokenIndex2092 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2093
+						}
+						position++
+						goto l2092
+					l2093:
+						position, tokenIndex = position2092, tokenIndex2092
+						if buffer[position] != rune('U') {
+							goto l2087
+						}
+						position++
+					}
+				l2092:
+					{
+						position2094, tokenIndex2094 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2095
+						}
+						position++
+						goto l2094
+					l2095:
+						position, tokenIndex = position2094, tokenIndex2094
+						if buffer[position] != rune('L') {
+							goto l2087
+						}
+						position++
+					}
+				l2094:
+					{
+						position2096, tokenIndex2096 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2097
+						}
+						position++
+						goto l2096
+					l2097:
+						position, tokenIndex = position2096, tokenIndex2096
+						if buffer[position] != rune('L') {
+							goto l2087
+						}
+						position++
+					}
+				l2096:
+					add(rulePegText, position2089)
+				}
+				if !_rules[ruleAction124]() {
+					goto l2087
+				}
+				add(ruleNullLiteral, position2088)
+			}
+			return true
+		l2087:
+			position, tokenIndex = position2087, tokenIndex2087
+			return false
+		},
+		/* 166 Missing <- <(<(('m' / 'M') ('i' / 'I') ('s' / 'S') ('s' / 'S') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action125)> */
+		func() bool {
+			position2098, tokenIndex2098 := position, tokenIndex
+			{
+				position2099 := position
+				{
+					position2100 := position
+					{
+						position2101, tokenIndex2101 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2102
+						}
+						position++
+						goto l2101
+					l2102:
+						position, tokenIndex = position2101, tokenIndex2101
+						if buffer[position] != rune('M') {
+							goto l2098
+						}
+						position++
+					}
+				l2101:
+					{
+						position2103, tokenIndex2103 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2104
+						}
+						position++
+						goto l2103
+					l2104:
+						position, tokenIndex = position2103, tokenIndex2103
+						if buffer[position] != rune('I') {
+							goto l2098
+						}
+						position++
+					}
+				l2103:
+					{
+						position2105, tokenIndex2105 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2106
+						}
+						position++
+						goto l2105
+					l2106:
+						position, tokenIndex = position2105, tokenIndex2105
+						if buffer[position] != rune('S') {
+							goto l2098
+						}
+						position++
+					}
+				l2105:
+					{
+						position2107, tokenIndex2107 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2108
+						}
+						position++
+						goto l2107
+					l2108:
+						position, tokenIndex = position2107, tokenIndex2107
+						if buffer[position] != rune('S') {
+							goto l2098
+						}
+						position++
+					}
+				l2107:
+					{
+						position2109, tokenIndex2109 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2110
+						}
+						position++
+						goto l2109
+					l2110:
+						position, tokenIndex = position2109, tokenIndex2109
+						if buffer[position] != rune('I') {
+							goto l2098
+						}
+						position++
+					}
+				l2109:
+					{
+						position2111, tokenIndex2111 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2112
+						}
+						position++
+						goto l2111
+					l2112:
+						position, tokenIndex = position2111, tokenIndex2111
+						if buffer[position] != rune('N') {
+							goto l2098
+						}
+						position++
+					}
+				l2111:
+					{
+						position2113, tokenIndex2113 := position, tokenIndex
+						if buffer[position] != rune('g') {
+							goto l2114
+						}
+						position++
+						goto l2113
+					l2114:
+						position, tokenIndex = position2113, tokenIndex2113
+						if buffer[position] != rune('G') {
+							goto l2098
+						}
+						position++
+					}
+				l2113:
+					add(rulePegText, position2100)
+				}
+				if !_rules[ruleAction125]() {
+					goto l2098
+				}
+				add(ruleMissing, position2099)
+			}
+			return true
+		l2098:
+			position, tokenIndex = position2098, tokenIndex2098
+			return false
+		},
+		/* 167 BooleanLiteral <- <(TRUE / FALSE)> */
+		func() bool {
+			position2115, tokenIndex2115 := position, tokenIndex
+			{
+				position2116 := position
+				{
+					position2117, tokenIndex2117 := position, tokenIndex
+					if !_rules[ruleTRUE]() {
+						goto l2118
+					}
+					goto l2117
+				l2118:
+					position, tokenIndex = position2117, tokenIndex2117
+					if !_rules[ruleFALSE]() {
+						goto l2115
+					}
+				}
+			l2117:
+				add(ruleBooleanLiteral, position2116)
+			}
+			return true
+		l2115:
+			position, tokenIndex = position2115, tokenIndex2115
+			return false
+		},
+		/* 168 TRUE <- <(<(('t' / 'T') ('r' / 'R') ('u' / 'U') ('e' / 'E'))> Action126)> */
+		func() bool {
+			position2119, tokenIndex2119 := position, tokenIndex
+			{
+				position2120 := position
+				{
+					position2121 := position
+					{
+						position2122, tokenIndex2122 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2123
+						}
+						position++
+						goto l2122
+					l2123:
+						position, tokenIndex = position2122, tokenIndex2122
+						if buffer[position] != rune('T') {
+							goto l2119
+						}
+						position++
+					}
+				l2122:
+					{
+						position2124, tokenIndex2124 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2125
+						}
+						position++
+						goto l2124
+					l2125:
+						position, tokenIndex = position2124, tokenIndex2124
+						if buffer[position] != rune('R') {
+							goto l2119
+						}
+						position++
+					}
+				l2124:
+					{
+						position2126, tokenIndex2126 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2127
+						}
+						position++
+						goto l2126
+					l2127:
+						position, tokenIndex = position2126, tokenIndex2126
+						if buffer[position] != rune('U') {
+							goto l2119
+						}
+						position++
+					}
+				l2126:
+					{
+						position2128, tokenIndex2128 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2129
+						}
+						position++
+						goto l2128
+					l2129:
+						position, tokenIndex = position2128, tokenIndex2128
+						if buffer[position] != rune('E') {
+							goto l2119
+						}
+						position++
+					}
+				l2128:
+					add(rulePegText, position2121)
+				}
+				if !_rules[ruleAction126]() {
+					goto l2119
+				}
+				add(ruleTRUE, position2120)
+			}
+			return true
+		l2119:
+			position, tokenIndex = position2119, tokenIndex2119
+			return false
+		},
+		/* 169 FALSE <- <(<(('f' / 'F') ('a' / 'A') ('l' / 'L') ('s' / 'S') ('e' / 'E'))> Action127)> */
+		func() bool {
+			position2130, tokenIndex2130 := position, tokenIndex
+			{
+				position2131 := position
+				{
+					position2132 := position
+					{
+						position2133, tokenIndex2133 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l2134
+						}
+						position++
+						goto l2133
+					l2134:
+						position, tokenIndex = position2133, tokenIndex2133
+						if buffer[position] != rune('F') {
+							goto l2130
+						}
+						position++
+					}
+				l2133:
+					{
+						position2135, tokenIndex2135 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2136
+						}
+						position++
+						goto l2135
+					l2136:
+						position, tokenIndex = position2135, tokenIndex2135
+						if buffer[position] != rune('A') {
+							goto l2130
+						}
+						position++
+					}
+				l2135:
+					{
+						position2137, tokenIndex2137 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2138
+						}
+						position++
+						goto l2137
+					l2138:
+						position, tokenIndex = position2137, tokenIndex2137
+						if buffer[position] != rune('L') {
+							goto l2130
+						}
+						position++
+					}
+				l2137:
+					{
+						position2139, tokenIndex2139 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2140
+						}
+						position++
+						goto l2139
+					l2140:
+						position, tokenIndex = position2139, tokenIndex2139
+						if buffer[position] != rune('S') {
+							goto l2130
+						}
+						position++
+					}
+				l2139:
+					{
+						position2141, tokenIndex2141 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2142
+						}
+						position++
+						goto l2141
+					l2142:
+						position, tokenIndex = position2141, tokenIndex2141
+						if buffer[position] != rune('E') {
+							goto l2130
+						}
+						position++
+					}
+				l2141:
+					add(rulePegText, position2132)
+				}
+				if !_rules[ruleAction127]() {
+					goto l2130
+				}
+				add(ruleFALSE, position2131)
+			}
+			return true
+		l2130:
+			position, tokenIndex = position2130, tokenIndex2130
+			return false
+		},
+		/* 170 Wildcard <- <(<((ident ':' !':')? '*')> Action128)> */
+		func() bool {
+			position2143, tokenIndex2143 := position, tokenIndex
+			{
+				position2144 := position
+				{
+					position2145 := position
+					{
+						position2146, tokenIndex2146 := position, tokenIndex
+						if !_rules[ruleident]() {
+							goto l2146
+						}
+						if buffer[position] != rune(':') {
+							goto l2146
+						}
+						position++
+						{
+							position2148, tokenIndex2148 := position, tokenIndex
+							if buffer[position] != rune(':') {
+								goto l2148
+							}
+							position++
+							goto l2146
+						l2148:
+							position, tokenIndex = position2148, tokenIndex2148
+						}
+						goto l2147
+					l2146:
+						position, tokenIndex = position2146, tokenIndex2146
+					}
+				l2147:
+					if buffer[position] != rune('*') {
+						goto l2143
+					}
+					position++
+					add(rulePegText, position2145)
+				}
+				if !_rules[ruleAction128]() {
+					goto l2143
+				}
+				add(ruleWildcard, position2144)
+			}
+			return true
+		l2143:
+			position, tokenIndex = position2143, tokenIndex2143
+			return false
+		},
+		/* 171 StringLiteral <- <(<('"' (('"' '"') / (!'"' .))* '"')> Action129)> */
+		func() bool {
+			position2149, tokenIndex2149 := position, tokenIndex
+			{
+				position2150 := position
+				{
+					position2151 := position
+					if buffer[position] != rune('"') {
+						goto l2149
+					}
+					position++
+				l2152:
+					{
+						position2153, tokenIndex2153 := position, tokenIndex
+						{
+							position2154, tokenIndex2154 := position, tokenIndex
+							if buffer[position] != rune('"') {
+								goto l2155
+							}
+							position++
+							if buffer[position] != rune('"') {
+								goto l2155
+							}
+							position++
+							goto l2154
+						l2155:
+							position, tokenIndex = position2154, tokenIndex2154
+							{
+								position2156, tokenIndex2156 := position, tokenIndex
+								if buffer[position] != rune('"') {
+									goto l2156
+								}
+								position++
+								goto l2153
+							l2156:
+								position, tokenIndex = position2156, tokenIndex2156
+							}
+							if !matchDot() {
+								goto l2153
+							}
+						}
+					l2154:
+						goto l2152
+					l2153:
+						position, tokenIndex = position2153, tokenIndex2153
+					}
+					if buffer[position] != rune('"') {
+						goto l2149
+					}
+					position++
+					add(rulePegText, position2151)
+				}
+				if !_rules[ruleAction129]() {
+					goto l2149
+				}
+				add(ruleStringLiteral, position2150)
+			}
+			return true
+		l2149:
+			position, tokenIndex = position2149, tokenIndex2149
+			return false
+		},
+		/* 172 ShowTarget <- <(ShowSourceTypes / ShowSinkTypes / ShowStateTypes / ShowSources / ShowStreams / ShowSinks / ShowStates / ShowFunctions)> */
+		func() bool {
+			position2157, tokenIndex2157 := position, tokenIndex
+			{
+				position2158 := position
+				{
+					position2159, tokenIndex2159 := position, tokenIndex
+					if !_rules[ruleShowSourceTypes]() {
+						goto l2160
+					}
+					goto l2159
+				l2160:
+					position, tokenIndex = position2159, tokenIndex2159
+					if !_rules[ruleShowSinkTypes]() {
+						goto l2161
+					}
+					goto l2159
+				l2161:
+					position, tokenIndex = position2159, tokenIndex2159
+					if !_rules[ruleShowStateTypes]() {
+						goto l2162
+					}
+					goto l2159
+				l2162:
+					position, tokenIndex = position2159, tokenIndex2159
+					if !_rules[ruleShowSources]() {
+						goto l2163
+					}
+					goto l2159
+				l2163:
+					position, tokenIndex = position2159, tokenIndex2159
+					if !_rules[ruleShowStreams]() {
+						goto l2164
+					}
+					goto l2159
+				l2164:
+					position, tokenIndex = position2159, tokenIndex2159
+					if !_rules[ruleShowSinks]() {
+						goto l2165
+					}
+					goto l2159
+				l2165:
+					position, tokenIndex = position2159, tokenIndex2159
+					if !_rules[ruleShowStates]() {
+						goto l2166
+					}
+					goto l2159
+				l2166:
+					position, tokenIndex = position2159, tokenIndex2159
+					if !_rules[ruleShowFunctions]() {
+						goto l2157
+					}
+				}
+			l2159:
+				add(ruleShowTarget, position2158)
+			}
+			return true
+		l2157:
+			position, tokenIndex = position2157, tokenIndex2157
+			return false
+		},
+		/* 173 ShowSources <- <(<(('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E') ('s' / 'S'))> Action130)> */
+		func() bool {
+			position2167, tokenIndex2167 := position, tokenIndex
+			{
+				position2168 := position
+				{
+					position2169 := position
+					{
+						position2170, tokenIndex2170 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2171
+						}
+						position++
+						goto l2170
+					l2171:
+						position, tokenIndex = position2170, tokenIndex2170
+						if buffer[position] != rune('S') {
+							goto l2167
+						}
+						position++
+					}
+				l2170:
+					{
+						position2172, tokenIndex2172 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2173
+						}
+						position++
+						goto l2172
+					l2173:
+						position, tokenIndex = position2172, tokenIndex2172
+						if buffer[position] != rune('O') {
+							goto l2167
+						}
+						position++
+					}
+				l2172:
+					{
+						position2174, tokenIndex2174 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2175
+						}
+						position++
+						goto l2174
+					l2175:
+						position, tokenIndex = position2174, tokenIndex2174
+						if buffer[position] != rune('U') {
+							goto l2167
+						}
+						position++
+					}
+				l2174:
+					{
+						position2176, tokenIndex2176 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2177
+						}
+						position++
+						goto l2176
+					l2177:
+						position, tokenIndex = position2176, tokenIndex2176
+						if buffer[position] != rune('R') {
+							goto l2167
+						}
+						position++
+					}
+				l2176:
+					{
+						position2178, tokenIndex2178 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l2179
+						}
+						position++
+						goto l2178
+					l2179:
+						position, tokenIndex = position2178, tokenIndex2178
+						if buffer[position] != rune('C') {
+							goto l2167
+						}
+						position++
+					}
+				l2178:
+					{
+						position2180, tokenIndex2180 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2181
+						}
+						position++
+						goto l2180
+					l2181:
+						position, tokenIndex = position2180, tokenIndex2180
+						if buffer[position] != rune('E') {
+							goto l2167
+						}
+						position++
+					}
+				l2180:
+					{
+						position2182, tokenIndex2182 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2183
+						}
+						position++
+						goto l2182
+					l2183:
+						position, tokenIndex = position2182, tokenIndex2182
+						if buffer[position] != rune('S') {
+							goto l2167
+						}
+						position++
+					}
+				l2182:
+					add(rulePegText, position2169)
+				}
+				if !_rules[ruleAction130]() {
+					goto l2167
+				}
+				add(ruleShowSources, position2168)
+			}
+			return true
+		l2167:
+			position, tokenIndex = position2167, tokenIndex2167
+			return false
+		},
+		/* 174 ShowStreams <- <(<(('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M') ('s' / 'S'))> Action131)> */
+		func() bool {
+			position2184, tokenIndex2184 := position, tokenIndex
+			{
+				position2185 := position
+				{
+					position2186 := position
+					{
+						position2187, tokenIndex2187 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2188
+						}
+						position++
+						goto l2187
+					l2188:
+						position, tokenIndex = position2187, tokenIndex2187
+						if buffer[position] != rune('S') {
+							goto l2184
+						}
+						position++
+					}
+				l2187:
+					{
+						position2189, tokenIndex2189 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2190
+						}
+						position++
+						goto l2189
+					l2190:
+						position, tokenIndex = position2189, tokenIndex2189
+						if buffer[position] != rune('T') {
+							goto l2184
+						}
+						position++
+					}
+				l2189:
+					{
+						position2191, tokenIndex2191 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2192
+						}
+						position++
+						goto l2191
+					l2192:
+						position, tokenIndex = position2191, tokenIndex2191
+						if buffer[position] != rune('R') {
+							goto l2184
+						}
+						position++
+					}
+				l2191:
+					{
+						position2193, tokenIndex2193 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2194
+						}
+						position++
+						goto l2193
+					l2194:
+						position, tokenIndex = position2193, tokenIndex2193
+						if buffer[position] != rune('E') {
+							goto l2184
+						}
+						position++
+					}
+				l2193:
+					{
+						position2195, tokenIndex2195 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2196
+						}
+						position++
+						goto l2195
+					l2196:
+						position, tokenIndex = position2195, tokenIndex2195
+						if buffer[position] != rune('A') {
+							goto l2184
+						}
+						position++
+					}
+				l2195:
+					{
+						position2197, tokenIndex2197 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2198
+						}
+						position++
+						goto l2197
+					l2198:
+						position, tokenIndex = position2197, tokenIndex2197
+						if buffer[position] != rune('M') {
+							goto l2184
+						}
+						position++
+					}
+				l2197:
+					{
+						position2199, tokenIndex2199 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2200
+						}
+						position++
+						goto l2199
+					l2200:
+						position, tokenIndex = position2199, tokenIndex2199
+						if buffer[position] != rune('S') {
+							goto l2184
+						}
+						position++
+					}
+				l2199:
+					add(rulePegText, position2186)
+				}
+				if !_rules[ruleAction131]() {
+					goto l2184
+				}
+				add(ruleShowStreams, position2185)
+			}
+			return true
+		l2184:
+			position, tokenIndex = position2184, tokenIndex2184
+			return false
+		},
+		/* 175 ShowSinks <- <(<(('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K') ('s' / 'S'))> Action132)> */
+		func() bool {
+			position2201, tokenIndex2201 := position, tokenIndex
+			{
+				position2202 := position
+				{
+					position2203 := position
+					{
+						position2204, tokenIndex2204 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2205
+						}
+						position++
+						goto l2204
+					l2205:
+						position, tokenIndex = position2204, tokenIndex2204
+						if buffer[position] != rune('S') {
+							goto l2201
+						}
+						position++
+					}
+				l2204:
+					{
+						position2206, tokenIndex2206 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2207
+						}
+						position++
+						goto l2206
+					l2207:
+						position, tokenIndex = position2206, tokenIndex2206
+						if buffer[position] != rune('I') {
+							goto l2201
+						}
+						position++
+					}
+				l2206:
+					{
+						position2208, tokenIndex2208 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2209
+						}
+						position++
+						goto l2208
+					l2209:
+						position, tokenIndex = position2208, tokenIndex2208
+						if buffer[position] != rune('N') {
+							goto l2201
+						}
+						position++
+					}
+				l2208:
+					{
+						position2210, tokenIndex2210 := position, tokenIndex
+						if buffer[position] != rune('k') {
+							goto l2211
+						}
+						position++
+						goto l2210
+					l2211:
+						position, tokenIndex = position2210, tokenIndex2210
+						if buffer[position] != rune('K') {
+							goto l2201
+						}
+						position++
+					}
+				l2210:
+					{
+						position2212, tokenIndex2212 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2213
+						}
+						position++
+						goto l2212
+					l2213:
+						position, tokenIndex = position2212, tokenIndex2212
+						if buffer[position] != rune('S') {
+							goto l2201
+						}
+						position++
+					}
+				l2212:
+					add(rulePegText, position2203)
+				}
+				if !_rules[ruleAction132]() {
+					goto l2201
+				}
+				add(ruleShowSinks, position2202)
+			}
+			return true
+		l2201:
+			position, tokenIndex = position2201, tokenIndex2201
+			return false
+		},
+		/* 176 ShowStates <- <(<(('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E') ('s' / 'S'))> Action133)> */
+		func() bool {
+			position2214, tokenIndex2214 := position, tokenIndex
+			{
+				position2215 := position
+				{
+					position2216 := position
+					{
+						position2217, tokenIndex2217 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2218
+						}
+						position++
+						goto l2217
+					l2218:
+						position, tokenIndex = position2217, tokenIndex2217
+						if buffer[position] != rune('S') {
+							goto l2214
+						}
+						position++
+					}
+				l2217:
+					{
+						position2219, tokenIndex2219 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2220
+						}
+						position++
+						goto l2219
+					l2220:
+						position, tokenIndex = position2219, tokenIndex2219
+						if buffer[position] != rune('T') {
+							goto l2214
+						}
+						position++
+					}
+				l2219:
+					{
+						position2221, tokenIndex2221 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2222
+						}
+						position++
+						goto l2221
+					l2222:
+						position, tokenIndex = position2221, tokenIndex2221
+						if buffer[position] != rune('A') {
+							goto l2214
+						}
+						position++
+					}
+				l2221:
+					{
+						position2223, tokenIndex2223 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2224
+						}
+						position++
+						goto l2223
+					l2224:
+						position, tokenIndex = position2223, tokenIndex2223
+						if buffer[position] != rune('T') {
+							goto l2214
+						}
+						position++
+					}
+				l2223:
+					{
+						position2225, tokenIndex2225 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2226
+						}
+						position++
+						goto l2225
+					l2226:
+						position, tokenIndex = position2225, tokenIndex2225
+						if buffer[position] != rune('E') {
+							goto l2214
+						}
+						position++
+					}
+				l2225:
+					{
+						position2227, tokenIndex2227 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2228
+						}
+						position++
+						goto l2227
+					l2228:
+						position, tokenIndex = position2227, tokenIndex2227
+						if buffer[position] != rune('S') {
+							goto l2214
+						}
+						position++
+					}
+				l2227:
+					add(rulePegText, position2216)
+				}
+				if !_rules[ruleAction133]() {
+					goto l2214
+				}
+				add(ruleShowStates, position2215)
+			}
+			return true
+		l2214:
+			position, tokenIndex = position2214, tokenIndex2214
+			return false
+		},
+		/* 177 ShowFunctions <- <(<(('f' / 'F') ('u' / 'U') ('n' / 'N') ('c' / 'C') ('t' / 'T') ('i' / 'I') ('o' / 'O') ('n' / 'N') ('s' / 'S'))> Action134)> */
+		func() bool {
+			position2229, tokenIndex2229 := position, tokenIndex
+			{
+				position2230 := position
+				{
+					position2231 := position
+					{
+						position2232, tokenIndex2232 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l2233
+						}
+						position++
+						goto l2232
+					l2233:
+						position, tokenIndex = position2232, tokenIndex2232
+						if buffer[position] != rune('F') {
+							goto l2229
+						}
+						position++
+					}
+				l2232:
+					{
+						position2234, tokenIndex2234 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2235
+						}
+						position++
+						goto l2234
+					l2235:
+						position, tokenIndex = position2234, tokenIndex2234
+						if buffer[position] != rune('U') {
+							goto l2229
+						}
+						position++
+					}
+				l2234:
+					{
+						position2236, tokenIndex2236 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2237
+						}
+						position++
+						goto l2236
+					l2237:
+						position, tokenIndex = position2236, tokenIndex2236
+						if buffer[position] != rune('N') {
+							goto l2229
+						}
+						position++
+					}
+				l2236:
+					{
+						position2238, tokenIndex2238 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l2239
+						}
+						position++
+						goto l2238
+					l2239:
+						position, tokenIndex = position2238, tokenIndex2238
+						if buffer[position] != rune('C') {
+							goto l2229
+						}
+						position++
+					}
+				l2238:
+					{
+						position2240, tokenIndex2240 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2241
+						}
+						position++
+						goto l2240
+					l2241:
+						position, tokenIndex = position2240, tokenIndex2240
+						if buffer[position] != rune('T') {
+							goto l2229
+						}
+						position++
+					}
+				l2240:
+					{
+						position2242, tokenIndex2242 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2243
+						}
+						position++
+						goto l2242
+					l2243:
+						position, tokenIndex = position2242, tokenIndex2242
+						if buffer[position] != rune('I') {
+							goto l2229
+						}
+						position++
+					}
+				l2242:
+					{
+						position2244, tokenIndex2244 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2245
+						}
+						position++
+						goto l2244
+					l2245:
+						position, tokenIndex = position2244, tokenIndex2244
+						if buffer[position] != rune('O') {
+							goto l2229
+						}
+						position++
+					}
+				l2244:
+					{
+						position2246, tokenIndex2246 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2247
+						}
+						position++
+						goto l2246
+					l2247:
+						position, tokenIndex = position2246, tokenIndex2246
+						if buffer[position] != rune('N') {
+							goto l2229
+						}
+						position++
+					}
+				l2246:
+					{
+						position2248, tokenIndex2248 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2249
+						}
+						position++
+						goto l2248
+					l2249:
+						position, tokenIndex = position2248, tokenIndex2248
+						if buffer[position] != rune('S') {
+							goto l2229
+						}
+						position++
+					}
+				l2248:
+					add(rulePegText, position2231)
+				}
+				if !_rules[ruleAction134]() {
+					goto l2229
+				}
+				add(ruleShowFunctions, position2230)
+			}
+			return true
+		l2229:
+			position, tokenIndex = position2229, tokenIndex2229
+			return false
+		},
+		/* 178 ShowSourceTypes <- <(<(('s' / 'S') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('c' / 'C') ('e' / 'E') sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E') ('s' / 'S')))> Action135)> */
+		func() bool {
+			position2250, tokenIndex2250 := position, tokenIndex
+			{
+				position2251 := position
+				{
+					position2252 := position
+					{
+						position2253, tokenIndex2253 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2254
+						}
+						position++
+						goto l2253
+					l2254:
+						position, tokenIndex = position2253, tokenIndex2253
+						if buffer[position] != rune('S') {
+							goto l2250
+						}
+						position++
+					}
+				l2253:
+					{
+						position2255, tokenIndex2255 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2256
+						}
+						position++
+						goto l2255
+					l2256:
+						position, tokenIndex = position2255, tokenIndex2255
+						if buffer[position] != rune('O') {
+							goto l2250
+						}
+						position++
+					}
+				l2255:
+					{
+						position2257, tokenIndex2257 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2258
+						}
+						position++
+						goto l2257
+					l2258:
+						position, tokenIndex = position2257, tokenIndex2257
+						if buffer[position] != rune('U') {
+							goto l2250
+						}
+						position++
+					}
+				l2257:
+					{
+						position2259, tokenIndex2259 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2260
+						}
+						position++
+						goto l2259
+					l2260:
+						position, tokenIndex = position2259, tokenIndex2259
+						if buffer[position] != rune('R') {
+							goto l2250
+						}
+						position++
+					}
+				l2259:
+					{
+						position2261, tokenIndex2261 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l2262
+						}
+						position++
+						goto l2261
+					l2262:
+						position, tokenIndex = position2261, tokenIndex2261
+						if buffer[position] != rune('C') {
+							goto l2250
+						}
+						position++
+					}
+				l2261:
+					{
+						position2263, tokenIndex2263 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2264
+						}
+						position++
+						goto l2263
+					l2264:
+						position, tokenIndex = position2263, tokenIndex2263
+						if buffer[position] != rune('E') {
+							goto l2250
+						}
+						position++
+					}
+				l2263:
+					if !_rules[rulesp]() {
+						goto l2250
+					}
+					{
+						position2265, tokenIndex2265 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2266
+						}
+						position++
+						goto l2265
+					l2266:
+						position, tokenIndex = position2265, tokenIndex2265
+						if buffer[position] != rune('T') {
+							goto l2250
+						}
+						position++
+					}
+				l2265:
+					{
+						position2267, tokenIndex2267 := position, tokenIndex
+						if buffer[position] != rune('y') {
+							goto l2268
+						}
+						position++
+						goto l2267
+					l2268:
+						position, tokenIndex = position2267, tokenIndex2267
+						if buffer[position] != rune('Y') {
+							goto l2250
+						}
+						position++
+					}
+				l2267:
+					{
+						position2269, tokenIndex2269 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2270
+						}
+						position++
+						goto l2269
+					l2270:
+						position, tokenIndex = position2269, tokenIndex2269
+						if buffer[position] != rune('P') {
+							goto l2250
+						}
+						position++
+					}
+				l2269:
+					{
+						position2271, tokenIndex2271 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2272
+						}
+						position++
+						goto l2271
+					l2272:
+						position, tokenIndex = position2271, tokenIndex2271
+						if buffer[position] != rune('E') {
+							goto l2250
+						}
+						position++
+					}
+				l2271:
+					{
+						position2273, tokenIndex2273 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2274
+						}
+						position++
+						goto l2273
+					l2274:
+						position, tokenIndex = position2273, tokenIndex2273
+						if buffer[position] != rune('S') {
+							goto l2250
+						}
+						position++
+					}
+				l2273:
+					add(rulePegText, position2252)
+				}
+				if !_rules[ruleAction135]() {
+					goto l2250
+				}
+				add(ruleShowSourceTypes, position2251)
+			}
+			return true
+		l2250:
+			position, tokenIndex = position2250, tokenIndex2250
+			return false
+		},
+		/* 179 ShowSinkTypes <- <(<(('s' / 'S') ('i' / 'I') ('n' / 'N') ('k' / 'K') sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E') ('s' / 'S')))> Action136)> */
+		func() bool {
+			position2275, tokenIndex2275 := position, tokenIndex
+			{
+				position2276 := position
+				{
+					position2277 := position
+					{
+						position2278, tokenIndex2278 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2279
+						}
+						position++
+						goto l2278
+					l2279:
+						position, tokenIndex = position2278, tokenIndex2278
+						if buffer[position] != rune('S') {
+							goto l2275
+						}
+						position++
+					}
+				l2278:
+					{
+						position2280, tokenIndex2280 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2281
+						}
+						position++
+						goto l2280
+					l2281:
+						position, tokenIndex = position2280, tokenIndex2280
+						if buffer[position] != rune('I') {
+							goto l2275
+						}
+						position++
+					}
+				l2280:
+					{
+						position2282, tokenIndex2282 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2283
+						}
+						position++
+						goto l2282
+					l2283:
+						position, tokenIndex = position2282, tokenIndex2282
+						if buffer[position] != rune('N') {
+							goto l2275
+						}
+						position++
+					}
+				l2282:
+					{
+						position2284, tokenIndex2284 := position, tokenIndex
+						if buffer[position] != rune('k') {
+							goto l2285
+						}
+						position++
+						goto l2284
+					l2285:
+						position, tokenIndex = position2284, tokenIndex2284
+						if buffer[position] != rune('K') {
+							goto l2275
+						}
+						position++
+					}
+				l2284:
+					if !_rules[rulesp]() {
+						goto l2275
+					}
+					{
+						position2286, tokenIndex2286 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2287
+						}
+						position++
+						goto l2286
+					l2287:
+						position, tokenIndex = position2286, tokenIndex2286
+						if buffer[position] != rune('T') {
+							goto l2275
+						}
+						position++
+					}
+				l2286:
+					{
+						position2288, tokenIndex2288 := position, tokenIndex
+						if buffer[position] != rune('y') {
+							goto l2289
+						}
+						position++
+						goto l2288
+					l2289:
+						position, tokenIndex = position2288, tokenIndex2288
+						if buffer[position] != rune('Y') {
+							goto l2275
+						}
+						position++
+					}
+				l2288:
+					{
+						position2290, tokenIndex2290 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2291
+						}
+						position++
+						goto l2290
+					l2291:
+						position, tokenIndex = position2290, tokenIndex2290
+						if buffer[position] != rune('P') {
+							goto l2275
+						}
+						position++
+					}
+				l2290:
+					{
+						position2292, tokenIndex2292 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2293
+						}
+						position++
+						goto l2292
+					l2293:
+						position, tokenIndex = position2292, tokenIndex2292
+						if buffer[position] != rune('E') {
+							goto l2275
+						}
+						position++
+					}
+				l2292:
+					{
+						position2294, tokenIndex2294 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2295
+						}
+						position++
+						goto l2294
+					l2295:
+						position, tokenIndex = position2294, tokenIndex2294
+						if buffer[position] != rune('S') {
+							goto l2275
+						}
+						position++
+					}
+				l2294:
+					add(rulePegText, position2277)
+				}
+				if !_rules[ruleAction136]() {
+					goto l2275
+				}
+				add(ruleShowSinkTypes, position2276)
+			}
+			return true
+		l2275:
+			position, tokenIndex = position2275, tokenIndex2275
+			return false
+		},
+		/* 180 ShowStateTypes <- <(<(('s' / 'S') ('t' / 'T') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('t' / 'T') ('y' / 'Y') ('p' / 'P') ('e' / 'E') ('s' / 'S')))> Action137)> */
+		func() bool {
+			position2296, tokenIndex2296 := position, tokenIndex
+			{
+				position2297 := position
+				{
+					position2298 := position
+					{
+						position2299, tokenIndex2299 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2300
+						}
+						position++
+						goto l2299
+					l2300:
+						position, tokenIndex = position2299, tokenIndex2299
+						if buffer[position] != rune('S') {
+							goto l2296
+						}
+						position++
+					}
+				l2299:
+					{
+						position2301, tokenIndex2301 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2302
+						}
+						position++
+						goto l2301
+					l2302:
+						position, tokenIndex = position2301, tokenIndex2301
+						if buffer[position] != rune('T') {
+							goto l2296
+						}
+						position++
+					}
+				l2301:
+					{
+						position2303, tokenIndex2303 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2304
+						}
+						position++
+						goto l2303
+					l2304:
+						position, tokenIndex = position2303, tokenIndex2303
+						if buffer[position] != rune('A') {
+							goto l2296
+						}
+						position++
+					}
+				l2303:
+					{
+						position2305, tokenIndex2305 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2306
+						}
+						position++
+						goto l2305
+					l2306:
+						position, tokenIndex = position2305, tokenIndex2305
+						if buffer[position] != rune('T') {
+							goto l2296
+						}
+						position++
+					}
+				l2305:
+					{
+						position2307, tokenIndex2307 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2308
+						}
+						position++
+						goto l2307
+					l2308:
+						position, tokenIndex = position2307, tokenIndex2307
+						if buffer[position] != rune('E') {
+							goto l2296
+						}
+						position++
+					}
+				l2307:
+					if !_rules[rulesp]() {
+						goto l2296
+					}
+					{
+						position2309, tokenIndex2309 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2310
+						}
+						position++
+						goto l2309
+					l2310:
+						position, tokenIndex = position2309, tokenIndex2309
+						if buffer[position] != rune('T') {
+							goto l2296
+						}
+						position++
+					}
+				l2309:
+					{
+						position2311, tokenIndex2311 := position, tokenIndex
+						if buffer[position] != rune('y') {
+							goto l2312
+						}
+						position++
+						goto l2311
+					l2312:
+						position, tokenIndex = position2311, tokenIndex2311
+						if buffer[position] != rune('Y') {
+							goto l2296
+						}
+						position++
+					}
+				l2311:
+					{
+						position2313, tokenIndex2313 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2314
+						}
+						position++
+						goto l2313
+					l2314:
+						position, tokenIndex = position2313, tokenIndex2313
+						if buffer[position] != rune('P') {
+							goto l2296
+						}
+						position++
+					}
+				l2313:
+					{
+						position2315, tokenIndex2315 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2316
+						}
+						position++
+						goto l2315
+					l2316:
+						position, tokenIndex = position2315, tokenIndex2315
+						if buffer[position] != rune('E') {
+							goto l2296
+						}
+						position++
+					}
+				l2315:
+					{
+						position2317, tokenIndex2317 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2318
+						}
+						position++
+						goto l2317
+					l2318:
+						position, tokenIndex = position2317, tokenIndex2317
+						if buffer[position] != rune('S') {
+							goto l2296
+						}
+						position++
+					}
+				l2317:
+					add(rulePegText, position2298)
+				}
+				if !_rules[ruleAction137]() {
+					goto l2296
+				}
+				add(ruleShowStateTypes, position2297)
+			}
+			return true
+		l2296:
+			position, tokenIndex = position2296, tokenIndex2296
+			return false
+		},
+		/* 181 ISTREAM <- <(<(('i' / 'I') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action138)> */
+		func() bool {
+			position2319, tokenIndex2319 := position, tokenIndex
+			{
+				position2320 := position
+				{
+					position2321 := position
+					{
+						position2322, tokenIndex2322 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2323
+						}
+						position++
+						goto l2322
+					l2323:
+						position, tokenIndex = position2322, tokenIndex2322
+						if buffer[position] != rune('I') {
+							goto l2319
+						}
+						position++
+					}
+				l2322:
+					{
+						position2324, tokenIndex2324 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2325
+						}
+						position++
+						goto l2324
+					l2325:
+						position, tokenIndex = position2324, tokenIndex2324
+						if buffer[position] != rune('S') {
+							goto l2319
+						}
+						position++
+					}
+				l2324:
+					{
+						position2326, tokenIndex2326 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2327
+						}
+						position++
+						goto l2326
+					l2327:
+						position, tokenIndex = position2326, tokenIndex2326
+						if buffer[position] != rune('T') {
+							goto l2319
+						}
+						position++
+					}
+				l2326:
+					{
+						position2328, tokenIndex2328 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2329
+						}
+						position++
+						goto l2328
+					l2329:
+						position, tokenIndex = position2328, tokenIndex2328
+						if buffer[position] != rune('R') {
+							goto l2319
+						}
+						position++
+					}
+				l2328:
+					{
+						position2330, tokenIndex2330 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2331
+						}
+						position++
+						goto l2330
+					l2331:
+						position, tokenIndex = position2330, tokenIndex2330
+						if buffer[position] != rune('E') {
+							goto l2319
+						}
+						position++
+					}
+				l2330:
+					{
+						position2332, tokenIndex2332 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2333
+						}
+						position++
+						goto l2332
+					l2333:
+						position, tokenIndex = position2332, tokenIndex2332
+						if buffer[position] != rune('A') {
+							goto l2319
+						}
+						position++
+					}
+				l2332:
+					{
+						position2334, tokenIndex2334 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2335
+						}
+						position++
+						goto l2334
+					l2335:
+						position, tokenIndex = position2334, tokenIndex2334
+						if buffer[position] != rune('M') {
+							goto l2319
+						}
+						position++
+					}
+				l2334:
+					add(rulePegText, position2321)
+				}
+				if !_rules[ruleAction138]() {
+					goto l2319
+				}
+				add(ruleISTREAM, position2320)
+			}
+			return true
+		l2319:
+			position, tokenIndex = position2319, tokenIndex2319
+			return false
+		},
+		/* 182 DSTREAM <- <(<(('d' / 'D') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action139)> */
+		func() bool {
+			position2336, tokenIndex2336 := position, tokenIndex
+			{
+				position2337 := position
+				{
+					position2338 := position
+					{
+						position2339, tokenIndex2339 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2340
+						}
+						position++
+						goto l2339
+					l2340:
+						position, tokenIndex = position2339, tokenIndex2339
+						if buffer[position] != rune('D') {
+							goto l2336
+						}
+						position++
+					}
+				l2339:
+					{
+						position2341, tokenIndex2341 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2342
+						}
+						position++
+						goto l2341
+					l2342:
+						position, tokenIndex = position2341, tokenIndex2341
+						if buffer[position] != rune('S') {
+							goto l2336
+						}
+						position++
+					}
+				l2341:
+					{
+						position2343, tokenIndex2343 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2344
+						}
+						position++
+						goto l2343
+					l2344:
+						position, tokenIndex = position2343, tokenIndex2343
+						if buffer[position] != rune('T') {
+							goto l2336
+						}
+						position++
+					}
+				l2343:
+					{
+						position2345, tokenIndex2345 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2346
+						}
+						position++
+						goto l2345
+					l2346:
+						position, tokenIndex = position2345, tokenIndex2345
+						if buffer[position] != rune('R') {
+							goto l2336
+						}
+						position++
+					}
+				l2345:
+					{
+						position2347, tokenIndex2347 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2348
+						}
+						position++
+						goto l2347
+					l2348:
+						position, tokenIndex = position2347, tokenIndex2347
+						if buffer[position] != rune('E') {
+							goto l2336
+						}
+						position++
+					}
+				l2347:
+					{
+						position2349, tokenIndex2349 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2350
+						}
+						position++
+						goto l2349
+					l2350:
+						position, tokenIndex = position2349, tokenIndex2349
+						if buffer[position] != rune('A') {
+							goto l2336
+						}
+						position++
+					}
+				l2349:
+					{
+						position2351, tokenIndex2351 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2352
+						}
+						position++
+						goto l2351
+					l2352:
+						position, tokenIndex = position2351, tokenIndex2351
+						if buffer[position] != rune('M') {
+							goto l2336
+						}
+						position++
+					}
+				l2351:
+					add(rulePegText, position2338)
+				}
+				if !_rules[ruleAction139]() {
+					goto l2336
+				}
+				add(ruleDSTREAM, position2337)
+			}
+			return true
+		l2336:
+			position, tokenIndex = position2336, tokenIndex2336
+			return false
+		},
+		/* 183 RSTREAM <- <(<(('r' / 'R') ('s' / 'S') ('t' / 'T') ('r' / 'R') ('e' / 'E') ('a' / 'A') ('m' / 'M'))> Action140)> */
+		func() bool {
+			position2353, tokenIndex2353 := position, tokenIndex
+			{
+				position2354 := position
+				{
+					position2355 := position
+					{
+						position2356, tokenIndex2356 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2357
+						}
+						position++
+						goto l2356
+					l2357:
+						position, tokenIndex = position2356, tokenIndex2356
+						if buffer[position] != rune('R') {
+							goto l2353
+						}
+						position++
+					}
+				l2356:
+					{
+						position2358, tokenIndex2358 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2359
+						}
+						position++
+						goto l2358
+					l2359:
+						position, tokenIndex = position2358, tokenIndex2358
+						if buffer[position] != rune('S') {
+							goto l2353
+						}
+						position++
+					}
+				l2358:
+					{
+						position2360, tokenIndex2360 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2361
+						}
+						position++
+						goto l2360
+					l2361:
+						position, tokenIndex = position2360, tokenIndex2360
+						if buffer[position] != rune('T') {
+							goto l2353
+						}
+						position++
+					}
+				l2360:
+					{
+						position2362, tokenIndex2362 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2363
+						}
+						position++
+						goto l2362
+					l2363:
+						position, tokenIndex = position2362, tokenIndex2362
+						if buffer[position] != rune('R') {
+							goto l2353
+						}
+						position++
+					}
+				l2362:
+					{
+						position2364, tokenIndex2364 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2365
+						}
+						position++
+						goto l2364
+					l2365:
+						position, tokenIndex = position2364, tokenIndex2364
+						if buffer[position] != rune('E') {
+							goto l2353
+						}
+						position++
+					}
+				l2364:
+					{
+						position2366, tokenIndex2366 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2367
+						}
+						position++
+						goto l2366
+					l2367:
+						position, tokenIndex = position2366, tokenIndex2366
+						if buffer[position] != rune('A') {
+							goto l2353
+						}
+						position++
+					}
+				l2366:
+					{
+						position2368, tokenIndex2368 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2369
+						}
+						position++
+						goto l2368
+					l2369:
+						position, tokenIndex = position2368, tokenIndex2368
+						if buffer[position] != rune('M') {
+							goto l2353
+						}
+						position++
+					}
+				l2368:
+					add(rulePegText, position2355)
+				}
+				if !_rules[ruleAction140]() {
+					goto l2353
+				}
+				add(ruleRSTREAM, position2354)
+			}
+			return true
+		l2353:
+			position, tokenIndex = position2353, tokenIndex2353
+			return false
+		},
+		/* 184 TUPLES <- <(<(('t' / 'T') ('u' / 'U') ('p' / 'P') ('l' / 'L') ('e' / 'E') ('s' / 'S'))> Action141)> */
+		func() bool {
+			position2370, tokenIndex2370 := position, tokenIndex
+			{
+				position2371 := position
+				{
+					position2372 := position
+					{
+						position2373, tokenIndex2373 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2374
+						}
+						position++
+						goto l2373
+					l2374:
+						position, tokenIndex = position2373, tokenIndex2373
+						if buffer[position] != rune('T') {
+							goto l2370
+						}
+						position++
+					}
+				l2373:
+					{
+						position2375, tokenIndex2375 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2376
+						}
+						position++
+						goto l2375
+					l2376:
+						position, tokenIndex = position2375, tokenIndex2375
+						if buffer[position] != rune('U') {
+							goto l2370
+						}
+						position++
+					}
+				l2375:
+					{
+						position2377, tokenIndex2377 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2378
+						}
+						position++
+						goto l2377
+					l2378:
+						position, tokenIndex = position2377, tokenIndex2377
+						if buffer[position] != rune('P') {
+							goto l2370
+						}
+						position++
+					}
+				l2377:
+					{
+						position2379, tokenIndex2379 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2380
+						}
+						position++
+						goto l2379
+					l2380:
+						position, tokenIndex = position2379, tokenIndex2379
+						if buffer[position] != rune('L') {
+							goto l2370
+						}
+						position++
+					}
+				l2379:
+					{
+						position2381, tokenIndex2381 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2382
+						}
+						position++
+						goto l2381
+					l2382:
+						position, tokenIndex = position2381, tokenIndex2381
+						if buffer[position] != rune('E') {
+							goto l2370
+						}
+						position++
+					}
+				l2381:
+					{
+						position2383, tokenIndex2383 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2384
+						}
+						position++
+						goto l2383
+					l2384:
+						position, tokenIndex = position2383, tokenIndex2383
+						if buffer[position] != rune('S') {
+							goto l2370
+						}
+						position++
+					}
+				l2383:
+					add(rulePegText, position2372)
+				}
+				if !_rules[ruleAction141]() {
+					goto l2370
+				}
+				add(ruleTUPLES, position2371)
+			}
+			return true
+		l2370:
+			position, tokenIndex = position2370, tokenIndex2370
+			return false
+		},
+		/* 185 SECONDS <- <(<(('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S'))> Action142)> */
+		func() bool {
+			position2385, tokenIndex2385 := position, tokenIndex
+			{
+				position2386 := position
+				{
+					position2387 := position
+					{
+						position2388, tokenIndex2388 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2389
+						}
+						position++
+						goto l2388
+					l2389:
+						position, tokenIndex = position2388, tokenIndex2388
+						if buffer[position] != rune('S') {
+							goto l2385
+						}
+						position++
+					}
+				l2388:
+					{
+						position2390, tokenIndex2390 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2391
+						}
+						position++
+						goto l2390
+					l2391:
+						position, tokenIndex = position2390, tokenIndex2390
+						if buffer[position] != rune('E') {
+							goto l2385
+						}
+						position++
+					}
+				l2390:
+					{
+						position2392, tokenIndex2392 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l2393
+						}
+						position++
+						goto l2392
+					l2393:
+						position, tokenIndex = position2392, tokenIndex2392
+						if buffer[position] != rune('C') {
+							goto l2385
+						}
+						position++
+					}
+				l2392:
+					{
+						position2394, tokenIndex2394 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2395
+						}
+						position++
+						goto l2394
+					l2395:
+						position, tokenIndex = position2394, tokenIndex2394
+						if buffer[position] != rune('O') {
+							goto l2385
+						}
+						position++
+					}
+				l2394:
+					{
+						position2396, tokenIndex2396 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2397
+						}
+						position++
+						goto l2396
+					l2397:
+						position, tokenIndex = position2396, tokenIndex2396
+						if buffer[position] != rune('N') {
+							goto l2385
+						}
+						position++
+					}
+				l2396:
+					{
+						position2398, tokenIndex2398 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2399
+						}
+						position++
+						goto l2398
+					l2399:
+						position, tokenIndex = position2398, tokenIndex2398
+						if buffer[position] != rune('D') {
+							goto l2385
+						}
+						position++
+					}
+				l2398:
+					{
+						position2400, tokenIndex2400 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2401
+						}
+						position++
+						goto l2400
+					l2401:
+						position, tokenIndex = position2400, tokenIndex2400
+						if buffer[position] != rune('S') {
+							goto l2385
+						}
+						position++
+					}
+				l2400:
+					add(rulePegText, position2387)
+				}
+				if !_rules[ruleAction142]() {
+					goto l2385
+				}
+				add(ruleSECONDS, position2386)
+			}
+			return true
+		l2385:
+			position, tokenIndex = position2385, tokenIndex2385
+			return false
+		},
+		/* 186 MILLISECONDS <- <(<(('m' / 'M') ('i' / 'I') ('l' / 'L') ('l' / 'L') ('i' / 'I') ('s' / 'S') ('e' / 'E') ('c' / 'C') ('o' / 'O') ('n' / 'N') ('d' / 'D') ('s' / 'S'))> Action143)> */
+		func() bool {
+			position2402, tokenIndex2402 := position, tokenIndex
+			{
+				position2403 := position
+				{
+					position2404 := position
+					{
+						position2405, tokenIndex2405 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2406
+						}
+						position++
+						goto l2405
+					l2406:
+						position, tokenIndex = position2405, tokenIndex2405
+						if buffer[position] != rune('M') {
+							goto l2402
+						}
+						position++
+					}
+				l2405:
+					{
+						position2407, tokenIndex2407 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2408
+						}
+						position++
+						goto l2407
+					l2408:
+						position, tokenIndex = position2407, tokenIndex2407
+						if buffer[position] != rune('I') {
+							goto l2402
+						}
+						position++
+					}
+				l2407:
+					{
+						position2409, tokenIndex2409 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2410
+						}
+						position++
+						goto l2409
+					l2410:
+						position, tokenIndex = position2409, tokenIndex2409
+						if buffer[position] != rune('L') {
+							goto l2402
+						}
+						position++
+					}
+				l2409:
+					{
+						position2411, tokenIndex2411 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2412
+						}
+						position++
+						goto l2411
+					l2412:
+						position, tokenIndex = position2411, tokenIndex2411
+						if buffer[position] != rune('L') {
+							goto l2402
+						}
+						position++
+					}
+				l2411:
+					{
+						position2413, tokenIndex2413 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2414
+						}
+						position++
+						goto l2413
+					l2414:
+						position, tokenIndex = position2413, tokenIndex2413
+						if buffer[position] != rune('I') {
+							goto l2402
+						}
+						position++
+					}
+				l2413:
+					{
+						position2415, tokenIndex2415 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2416
+						}
+						position++
+						goto l2415
+					l2416:
+						position, tokenIndex = position2415, tokenIndex2415
+						if buffer[position] != rune('S') {
+							goto l2402
+						}
+						position++
+					}
+				l2415:
+					{
+						position2417, tokenIndex2417 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2418
+						}
+						position++
+						goto l2417
+					l2418:
+						position, tokenIndex = position2417, tokenIndex2417
+						if buffer[position] != rune('E') {
+							goto l2402
+						}
+						position++
+					}
+				l2417:
+					{
+						position2419, tokenIndex2419 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l2420
+						}
+						position++
+						goto l2419
+					l2420:
+						position, tokenIndex = position2419, tokenIndex2419
+						if buffer[position] != rune('C') {
+							goto l2402
+						}
+						position++
+					}
+				l2419:
+					{
+						position2421, tokenIndex2421 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2422
+						}
+						position++
+						goto l2421
+					l2422:
+						position, tokenIndex = position2421, tokenIndex2421
+						if buffer[position] != rune('O') {
+							goto l2402
+						}
+						position++
+					}
+				l2421:
+					{
+						position2423, tokenIndex2423 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2424
+						}
+						position++
+						goto l2423
+					l2424:
+						position, tokenIndex = position2423, tokenIndex2423
+						if buffer[position] != rune('N') {
+							goto l2402
+						}
+						position++
+					}
+				l2423:
+					{
+						position2425, tokenIndex2425 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2426
+						}
+						position++
+						goto l2425
+					l2426:
+						position, tokenIndex = position2425, tokenIndex2425
+						if buffer[position] != rune('D') {
+							goto l2402
+						}
+						position++
+					}
+				l2425:
+					{
+						position2427, tokenIndex2427 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2428
+						}
+						position++
+						goto l2427
+					l2428:
+						position, tokenIndex = position2427, tokenIndex2427
+						if buffer[position] != rune('S') {
+							goto l2402
+						}
+						position++
+					}
+				l2427:
+					add(rulePegText, position2404)
+				}
+				if !_rules[ruleAction143]() {
+					goto l2402
+				}
+				add(ruleMILLISECONDS, position2403)
+			}
+			return true
+		l2402:
+			position, tokenIndex = position2402, tokenIndex2402
+			return false
+		},
+		/* 187 MINUTES <- <(<(('m' / 'M') ('i' / 'I') ('n' / 'N') ('u' / 'U') ('t' / 'T') ('e' / 'E') ('s' / 'S'))> Action144)> */
+		func() bool {
+			position2429, tokenIndex2429 := position, tokenIndex
+			{
+				position2430 := position
+				{
+					position2431 := position
+					{
+						position2432, tokenIndex2432 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2433
+						}
+						position++
+						goto l2432
+					l2433:
+						position, tokenIndex = position2432, tokenIndex2432
+						if buffer[position] != rune('M') {
+							goto l2429
+						}
+						position++
+					}
+				l2432:
+					{
+						position2434, tokenIndex2434 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2435
+						}
+						position++
+						goto l2434
+					l2435:
+						position, tokenIndex = position2434, tokenIndex2434
+						if buffer[position] != rune('I') {
+							goto l2429
+						}
+						position++
+					}
+				l2434:
+					{
+						position2436, tokenIndex2436 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2437
+						}
+						position++
+						goto l2436
+					l2437:
+						position, tokenIndex = position2436, tokenIndex2436
+						if buffer[position] != rune('N') {
+							goto l2429
+						}
+						position++
+					}
+				l2436:
+					{
+						position2438, tokenIndex2438 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2439
+						}
+						position++
+						goto l2438
+					l2439:
+						position, tokenIndex = position2438, tokenIndex2438
+						if buffer[position] != rune('U') {
+							goto l2429
+						}
+						position++
+					}
+				l2438:
+					{
+						position2440, tokenIndex2440 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2441
+						}
+						position++
+						goto l2440
+					l2441:
+						position, tokenIndex = position2440, tokenIndex2440
+						if buffer[position] != rune('T') {
+							goto l2429
+						}
+						position++
+					}
+				l2440:
+					{
+						position2442, tokenIndex2442 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2443
+						}
+						position++
+						goto l2442
+					l2443:
+						position, tokenIndex = position2442, tokenIndex2442
+						if buffer[position] != rune('E') {
+							goto l2429
+						}
+						position++
+					}
+				l2442:
+					{
+						position2444, tokenIndex2444 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2445
+						}
+						position++
+						goto l2444
+					l2445:
+						position, tokenIndex = position2444, tokenIndex2444
+						if buffer[position] != rune('S') {
+							goto l2429
+						}
+						position++
+					}
+				l2444:
+					add(rulePegText, position2431)
+				}
+				if !_rules[ruleAction144]() {
+					goto l2429
+				}
+				add(ruleMINUTES, position2430)
+			}
+			return true
+		l2429:
+			position, tokenIndex = position2429, tokenIndex2429
+			return false
+		},
+		/* 188 HOURS <- <(<(('h' / 'H') ('o' / 'O') ('u' / 'U') ('r' / 'R') ('s' / 'S'))> Action145)> */
+		func() bool {
+			position2446, tokenIndex2446 := position, tokenIndex
+			{
+				position2447 := position
+				{
+					position2448 := position
+					{
+						position2449, tokenIndex2449 := position, tokenIndex
+						if buffer[position] != rune('h') {
+							goto l2450
+						}
+						position++
+						goto l2449
+					l2450:
+						position, tokenIndex = position2449, tokenIndex2449
+						if buffer[position] != rune('H') {
+							goto l2446
+						}
+						position++
+					}
+				l2449:
+					{
+						position2451, tokenIndex2451 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2452
+						}
+						position++
+						goto l2451
+					l2452:
+						position, tokenIndex = position2451, tokenIndex2451
+						if buffer[position] != rune('O') {
+							goto l2446
+						}
+						position++
+					}
+				l2451:
+					{
+						position2453, tokenIndex2453 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2454
+						}
+						position++
+						goto l2453
+					l2454:
+						position, tokenIndex = position2453, tokenIndex2453
+						if buffer[position] != rune('U') {
+							goto l2446
+						}
+						position++
+					}
+				l2453:
+					{
+						position2455, tokenIndex2455 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2456
+						}
+						position++
+						goto l2455
+					l2456:
+						position, tokenIndex = position2455, tokenIndex2455
+						if buffer[position] != rune('R') {
+							goto l2446
+						}
+						position++
+					}
+				l2455:
+					{
+						position2457, tokenIndex2457 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2458
+						}
+						position++
+						goto l2457
+					l2458:
+						position, tokenIndex = position2457, tokenIndex2457
+						if buffer[position] != rune('S') {
+							goto l2446
+						}
+						position++
+					}
+				l2457:
+					add(rulePegText, position2448)
+				}
+				if !_rules[ruleAction145]() {
+					goto l2446
+				}
+				add(ruleHOURS, position2447)
+			}
+			return true
+		l2446:
+			position, tokenIndex = position2446, tokenIndex2446
+			return false
+		},
+		/* 189 Tumbling <- <(<(('t' / 'T') ('u' / 'U') ('m' / 'M') ('b' / 'B') ('l' / 'L') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action146)> */
+		func() bool {
+			position2459, tokenIndex2459 := position, tokenIndex
+			{
+				position2460 := position
+				{
+					position2461 := position
+					{
+						position2462, tokenIndex2462 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2463
+						}
+						position++
+						goto l2462
+					l2463:
+						position, tokenIndex = position2462, tokenIndex2462
+						if buffer[position] != rune('T') {
+							goto l2459
+						}
+						position++
+					}
+				l2462:
+					{
+						position2464, tokenIndex2464 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2465
+						}
+						position++
+						goto l2464
+					l2465:
+						position, tokenIndex = position2464, tokenIndex2464
+						if buffer[position] != rune('U') {
+							goto l2459
+						}
+						position++
+					}
+				l2464:
+					{
+						position2466, tokenIndex2466 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2467
+						}
+						position++
+						goto l2466
+					l2467:
+						position, tokenIndex = position2466, tokenIndex2466
+						if buffer[position] != rune('M') {
+							goto l2459
+						}
+						position++
+					}
+				l2466:
+					{
+						position2468, tokenIndex2468 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l2469
+						}
+						position++
+						goto l2468
+					l2469:
+						position, tokenIndex = position2468, tokenIndex2468
+						if buffer[position] != rune('B') {
+							goto l2459
+						}
+						position++
+					}
+				l2468:
+					{
+						position2470, tokenIndex2470 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2471
+						}
+						position++
+						goto l2470
+					l2471:
+						position, tokenIndex = position2470, tokenIndex2470
+						if buffer[position] != rune('L') {
+							goto l2459
+						}
+						position++
+					}
+				l2470:
+					{
+						position2472, tokenIndex2472 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2473
+						}
+						position++
+						goto l2472
+					l2473:
+						position, tokenIndex = position2472, tokenIndex2472
+						if buffer[position] != rune('I') {
+							goto l2459
+						}
+						position++
+					}
+				l2472:
+					{
+						position2474, tokenIndex2474 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2475
+						}
+						position++
+						goto l2474
+					l2475:
+						position, tokenIndex = position2474, tokenIndex2474
+						if buffer[position] != rune('N') {
+							goto l2459
+						}
+						position++
+					}
+				l2474:
+					{
+						position2476, tokenIndex2476 := position, tokenIndex
+						if buffer[position] != rune('g') {
+							goto l2477
+						}
+						position++
+						goto l2476
+					l2477:
+						position, tokenIndex = position2476, tokenIndex2476
+						if buffer[position] != rune('G') {
+							goto l2459
+						}
+						position++
+					}
+				l2476:
+					add(rulePegText, position2461)
+				}
+				if !_rules[ruleAction146]() {
+					goto l2459
+				}
+				add(ruleTumbling, position2460)
+			}
+			return true
+		l2459:
+			position, tokenIndex = position2459, tokenIndex2459
+			return false
+		},
+		/* 190 DropLateTuples <- <(<(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('l' / 'L') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp (('t' / 'T') ('u' / 'U') ('p' / 'P') ('l' / 'L') ('e' / 'E') ('s' / 'S')))> Action147)> */
+		func() bool {
+			position2478, tokenIndex2478 := position, tokenIndex
+			{
+				position2479 := position
+				{
+					position2480 := position
+					{
+						position2481, tokenIndex2481 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2482
+						}
+						position++
+						goto l2481
+					l2482:
+						position, tokenIndex = position2481, tokenIndex2481
+						if buffer[position] != rune('D') {
+							goto l2478
+						}
+						position++
+					}
+				l2481:
+					{
+						position2483, tokenIndex2483 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2484
+						}
+						position++
+						goto l2483
+					l2484:
+						position, tokenIndex = position2483, tokenIndex2483
+						if buffer[position] != rune('R') {
+							goto l2478
+						}
+						position++
+					}
+				l2483:
+					{
+						position2485, tokenIndex2485 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2486
+						}
+						position++
+						goto l2485
+					l2486:
+						position, tokenIndex = position2485, tokenIndex2485
+						if buffer[position] != rune('O') {
+							goto l2478
+						}
+						position++
+					}
+				l2485:
+					{
+						position2487, tokenIndex2487 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2488
+						}
+						position++
+						goto l2487
+					l2488:
+						position, tokenIndex = position2487, tokenIndex2487
+						if buffer[position] != rune('P') {
+							goto l2478
+						}
+						position++
+					}
+				l2487:
+					if !_rules[rulesp]() {
+						goto l2478
+					}
+					{
+						position2489, tokenIndex2489 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2490
+						}
+						position++
+						goto l2489
+					l2490:
+						position, tokenIndex = position2489, tokenIndex2489
+						if buffer[position] != rune('L') {
+							goto l2478
+						}
+						position++
+					}
+				l2489:
+					{
+						position2491, tokenIndex2491 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2492
+						}
+						position++
+						goto l2491
+					l2492:
+						position, tokenIndex = position2491, tokenIndex2491
+						if buffer[position] != rune('A') {
+							goto l2478
+						}
+						position++
+					}
+				l2491:
+					{
+						position2493, tokenIndex2493 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2494
+						}
+						position++
+						goto l2493
+					l2494:
+						position, tokenIndex = position2493, tokenIndex2493
+						if buffer[position] != rune('T') {
+							goto l2478
+						}
+						position++
+					}
+				l2493:
+					{
+						position2495, tokenIndex2495 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2496
+						}
+						position++
+						goto l2495
+					l2496:
+						position, tokenIndex = position2495, tokenIndex2495
+						if buffer[position] != rune('E') {
+							goto l2478
+						}
+						position++
+					}
+				l2495:
+					if !_rules[rulesp]() {
+						goto l2478
+					}
+					{
+						position2497, tokenIndex2497 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2498
+						}
+						position++
+						goto l2497
+					l2498:
+						position, tokenIndex = position2497, tokenIndex2497
+						if buffer[position] != rune('T') {
+							goto l2478
+						}
+						position++
+					}
+				l2497:
+					{
+						position2499, tokenIndex2499 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2500
+						}
+						position++
+						goto l2499
+					l2500:
+						position, tokenIndex = position2499, tokenIndex2499
+						if buffer[position] != rune('U') {
+							goto l2478
+						}
+						position++
+					}
+				l2499:
+					{
+						position2501, tokenIndex2501 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2502
+						}
+						position++
+						goto l2501
+					l2502:
+						position, tokenIndex = position2501, tokenIndex2501
+						if buffer[position] != rune('P') {
+							goto l2478
+						}
+						position++
+					}
+				l2501:
+					{
+						position2503, tokenIndex2503 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2504
+						}
+						position++
+						goto l2503
+					l2504:
+						position, tokenIndex = position2503, tokenIndex2503
+						if buffer[position] != rune('L') {
+							goto l2478
+						}
+						position++
+					}
+				l2503:
+					{
+						position2505, tokenIndex2505 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2506
+						}
+						position++
+						goto l2505
+					l2506:
+						position, tokenIndex = position2505, tokenIndex2505
+						if buffer[position] != rune('E') {
+							goto l2478
+						}
+						position++
+					}
+				l2505:
+					{
+						position2507, tokenIndex2507 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2508
+						}
+						position++
+						goto l2507
+					l2508:
+						position, tokenIndex = position2507, tokenIndex2507
+						if buffer[position] != rune('S') {
+							goto l2478
+						}
+						position++
+					}
+				l2507:
+					add(rulePegText, position2480)
+				}
+				if !_rules[ruleAction147]() {
+					goto l2478
+				}
+				add(ruleDropLateTuples, position2479)
+			}
+			return true
+		l2478:
+			position, tokenIndex = position2478, tokenIndex2478
+			return false
+		},
+		/* 191 UpdateLateTuples <- <(<(('u' / 'U') ('p' / 'P') ('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') sp (('l' / 'L') ('a' / 'A') ('t' / 'T') ('e' / 'E')) sp (('t' / 'T') ('u' / 'U') ('p' / 'P') ('l' / 'L') ('e' / 'E') ('s' / 'S')))> Action148)> */
+		func() bool {
+			position2509, tokenIndex2509 := position, tokenIndex
+			{
+				position2510 := position
+				{
+					position2511 := position
+					{
+						position2512, tokenIndex2512 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2513
+						}
+						position++
+						goto l2512
+					l2513:
+						position, tokenIndex = position2512, tokenIndex2512
+						if buffer[position] != rune('U') {
+							goto l2509
+						}
+						position++
+					}
+				l2512:
+					{
+						position2514, tokenIndex2514 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2515
+						}
+						position++
+						goto l2514
+					l2515:
+						position, tokenIndex = position2514, tokenIndex2514
+						if buffer[position] != rune('P') {
+							goto l2509
+						}
+						position++
+					}
+				l2514:
+					{
+						position2516, tokenIndex2516 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2517
+						}
+						position++
+						goto l2516
+					l2517:
+						position, tokenIndex = position2516, tokenIndex2516
+						if buffer[position] != rune('D') {
+							goto l2509
+						}
+						position++
+					}
+				l2516:
+					{
+						position2518, tokenIndex2518 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2519
+						}
+						position++
+						goto l2518
+					l2519:
+						position, tokenIndex = position2518, tokenIndex2518
+						if buffer[position] != rune('A') {
+							goto l2509
+						}
+						position++
+					}
+				l2518:
+					{
+						position2520, tokenIndex2520 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2521
+						}
+						position++
+						goto l2520
+					l2521:
+						position, tokenIndex = position2520, tokenIndex2520
+						if buffer[position] != rune('T') {
+							goto l2509
+						}
+						position++
+					}
+				l2520:
+					{
+						position2522, tokenIndex2522 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2523
+						}
+						position++
+						goto l2522
+					l2523:
+						position, tokenIndex = position2522, tokenIndex2522
+						if buffer[position] != rune('E') {
+							goto l2509
+						}
+						position++
+					}
+				l2522:
+					if !_rules[rulesp]() {
+						goto l2509
+					}
+					{
+						position2524, tokenIndex2524 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2525
+						}
+						position++
+						goto l2524
+					l2525:
+						position, tokenIndex = position2524, tokenIndex2524
+						if buffer[position] != rune('L') {
+							goto l2509
+						}
+						position++
+					}
+				l2524:
+					{
+						position2526, tokenIndex2526 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2527
+						}
+						position++
+						goto l2526
+					l2527:
+						position, tokenIndex = position2526, tokenIndex2526
+						if buffer[position] != rune('A') {
+							goto l2509
+						}
+						position++
+					}
+				l2526:
+					{
+						position2528, tokenIndex2528 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2529
+						}
+						position++
+						goto l2528
+					l2529:
+						position, tokenIndex = position2528, tokenIndex2528
+						if buffer[position] != rune('T') {
+							goto l2509
+						}
+						position++
+					}
+				l2528:
+					{
+						position2530, tokenIndex2530 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2531
+						}
+						position++
+						goto l2530
+					l2531:
+						position, tokenIndex = position2530, tokenIndex2530
+						if buffer[position] != rune('E') {
+							goto l2509
+						}
+						position++
+					}
+				l2530:
+					if !_rules[rulesp]() {
+						goto l2509
+					}
+					{
+						position2532, tokenIndex2532 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2533
+						}
+						position++
+						goto l2532
+					l2533:
+						position, tokenIndex = position2532, tokenIndex2532
+						if buffer[position] != rune('T') {
+							goto l2509
+						}
+						position++
+					}
+				l2532:
+					{
+						position2534, tokenIndex2534 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2535
+						}
+						position++
+						goto l2534
+					l2535:
+						position, tokenIndex = position2534, tokenIndex2534
+						if buffer[position] != rune('U') {
+							goto l2509
+						}
+						position++
+					}
+				l2534:
+					{
+						position2536, tokenIndex2536 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2537
+						}
+						position++
+						goto l2536
+					l2537:
+						position, tokenIndex = position2536, tokenIndex2536
+						if buffer[position] != rune('P') {
+							goto l2509
+						}
+						position++
+					}
+				l2536:
+					{
+						position2538, tokenIndex2538 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2539
+						}
+						position++
+						goto l2538
+					l2539:
+						position, tokenIndex = position2538, tokenIndex2538
+						if buffer[position] != rune('L') {
+							goto l2509
+						}
+						position++
+					}
+				l2538:
+					{
+						position2540, tokenIndex2540 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2541
+						}
+						position++
+						goto l2540
+					l2541:
+						position, tokenIndex = position2540, tokenIndex2540
+						if buffer[position] != rune('E') {
+							goto l2509
+						}
+						position++
+					}
+				l2540:
+					{
+						position2542, tokenIndex2542 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2543
+						}
+						position++
+						goto l2542
+					l2543:
+						position, tokenIndex = position2542, tokenIndex2542
+						if buffer[position] != rune('S') {
+							goto l2509
+						}
+						position++
+					}
+				l2542:
+					add(rulePegText, position2511)
+				}
+				if !_rules[ruleAction148]() {
+					goto l2509
+				}
+				add(ruleUpdateLateTuples, position2510)
+			}
+			return true
+		l2509:
+			position, tokenIndex = position2509, tokenIndex2509
+			return false
+		},
+		/* 192 Wait <- <(<(('w' / 'W') ('a' / 'A') ('i' / 'I') ('t' / 'T'))> Action149)> */
+		func() bool {
+			position2544, tokenIndex2544 := position, tokenIndex
+			{
+				position2545 := position
+				{
+					position2546 := position
+					{
+						position2547, tokenIndex2547 := position, tokenIndex
+						if buffer[position] != rune('w') {
+							goto l2548
+						}
+						position++
+						goto l2547
+					l2548:
+						position, tokenIndex = position2547, tokenIndex2547
+						if buffer[position] != rune('W') {
+							goto l2544
+						}
+						position++
+					}
+				l2547:
+					{
+						position2549, tokenIndex2549 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2550
+						}
+						position++
+						goto l2549
+					l2550:
+						position, tokenIndex = position2549, tokenIndex2549
+						if buffer[position] != rune('A') {
+							goto l2544
+						}
+						position++
+					}
+				l2549:
+					{
+						position2551, tokenIndex2551 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2552
+						}
+						position++
+						goto l2551
+					l2552:
+						position, tokenIndex = position2551, tokenIndex2551
+						if buffer[position] != rune('I') {
+							goto l2544
+						}
+						position++
+					}
+				l2551:
+					{
+						position2553, tokenIndex2553 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2554
+						}
+						position++
+						goto l2553
+					l2554:
+						position, tokenIndex = position2553, tokenIndex2553
+						if buffer[position] != rune('T') {
+							goto l2544
+						}
+						position++
+					}
+				l2553:
+					add(rulePegText, position2546)
+				}
+				if !_rules[ruleAction149]() {
+					goto l2544
+				}
+				add(ruleWait, position2545)
+			}
+			return true
+		l2544:
+			position, tokenIndex = position2544, tokenIndex2544
+			return false
+		},
+		/* 193 DropOldest <- <(<(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('o' / 'O') ('l' / 'L') ('d' / 'D') ('e' / 'E') ('s' / 'S') ('t' / 'T')))> Action150)> */
+		func() bool {
+			position2555, tokenIndex2555 := position, tokenIndex
+			{
+				position2556 := position
+				{
+					position2557 := position
+					{
+						position2558, tokenIndex2558 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2559
+						}
+						position++
+						goto l2558
+					l2559:
+						position, tokenIndex = position2558, tokenIndex2558
+						if buffer[position] != rune('D') {
+							goto l2555
+						}
+						position++
+					}
+				l2558:
+					{
+						position2560, tokenIndex2560 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2561
+						}
+						position++
+						goto l2560
+					l2561:
+						position, tokenIndex = position2560, tokenIndex2560
+						if buffer[position] != rune('R') {
+							goto l2555
+						}
+						position++
+					}
+				l2560:
+					{
+						position2562, tokenIndex2562 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2563
+						}
+						position++
+						goto l2562
+					l2563:
+						position, tokenIndex = position2562, tokenIndex2562
+						if buffer[position] != rune('O') {
+							goto l2555
+						}
+						position++
+					}
+				l2562:
+					{
+						position2564, tokenIndex2564 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2565
+						}
+						position++
+						goto l2564
+					l2565:
+						position, tokenIndex = position2564, tokenIndex2564
+						if buffer[position] != rune('P') {
+							goto l2555
+						}
+						position++
+					}
+				l2564:
+					if !_rules[rulesp]() {
+						goto l2555
+					}
+					{
+						position2566, tokenIndex2566 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2567
+						}
+						position++
+						goto l2566
+					l2567:
+						position, tokenIndex = position2566, tokenIndex2566
+						if buffer[position] != rune('O') {
+							goto l2555
+						}
+						position++
+					}
+				l2566:
+					{
+						position2568, tokenIndex2568 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2569
+						}
+						position++
+						goto l2568
+					l2569:
+						position, tokenIndex = position2568, tokenIndex2568
+						if buffer[position] != rune('L') {
+							goto l2555
+						}
+						position++
+					}
+				l2568:
+					{
+						position2570, tokenIndex2570 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2571
+						}
+						position++
+						goto l2570
+					l2571:
+						position, tokenIndex = position2570, tokenIndex2570
+						if buffer[position] != rune('D') {
+							goto l2555
+						}
+						position++
+					}
+				l2570:
+					{
+						position2572, tokenIndex2572 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2573
+						}
+						position++
+						goto l2572
+					l2573:
+						position, tokenIndex = position2572, tokenIndex2572
+						if buffer[position] != rune('E') {
+							goto l2555
+						}
+						position++
+					}
+				l2572:
+					{
+						position2574, tokenIndex2574 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2575
+						}
+						position++
+						goto l2574
+					l2575:
+						position, tokenIndex = position2574, tokenIndex2574
+						if buffer[position] != rune('S') {
+							goto l2555
+						}
+						position++
+					}
+				l2574:
+					{
+						position2576, tokenIndex2576 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2577
+						}
+						position++
+						goto l2576
+					l2577:
+						position, tokenIndex = position2576, tokenIndex2576
+						if buffer[position] != rune('T') {
+							goto l2555
+						}
+						position++
+					}
+				l2576:
+					add(rulePegText, position2557)
+				}
+				if !_rules[ruleAction150]() {
+					goto l2555
+				}
+				add(ruleDropOldest, position2556)
+			}
+			return true
+		l2555:
+			position, tokenIndex = position2555, tokenIndex2555
+			return false
+		},
+		/* 194 DropNewest <- <(<(('d' / 'D') ('r' / 'R') ('o' / 'O') ('p' / 'P') sp (('n' / 'N') ('e' / 'E') ('w' / 'W') ('e' / 'E') ('s' / 'S') ('t' / 'T')))> Action151)> */
+		func() bool {
+			position2578, tokenIndex2578 := position, tokenIndex
+			{
+				position2579 := position
+				{
+					position2580 := position
+					{
+						position2581, tokenIndex2581 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2582
+						}
+						position++
+						goto l2581
+					l2582:
+						position, tokenIndex = position2581, tokenIndex2581
+						if buffer[position] != rune('D') {
+							goto l2578
+						}
+						position++
+					}
+				l2581:
+					{
+						position2583, tokenIndex2583 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2584
+						}
+						position++
+						goto l2583
+					l2584:
+						position, tokenIndex = position2583, tokenIndex2583
+						if buffer[position] != rune('R') {
+							goto l2578
+						}
+						position++
+					}
+				l2583:
+					{
+						position2585, tokenIndex2585 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2586
+						}
+						position++
+						goto l2585
+					l2586:
+						position, tokenIndex = position2585, tokenIndex2585
+						if buffer[position] != rune('O') {
+							goto l2578
+						}
+						position++
+					}
+				l2585:
+					{
+						position2587, tokenIndex2587 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2588
+						}
+						position++
+						goto l2587
+					l2588:
+						position, tokenIndex = position2587, tokenIndex2587
+						if buffer[position] != rune('P') {
+							goto l2578
+						}
+						position++
+					}
+				l2587:
+					if !_rules[rulesp]() {
+						goto l2578
+					}
+					{
+						position2589, tokenIndex2589 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2590
+						}
+						position++
+						goto l2589
+					l2590:
+						position, tokenIndex = position2589, tokenIndex2589
+						if buffer[position] != rune('N') {
+							goto l2578
+						}
+						position++
+					}
+				l2589:
+					{
+						position2591, tokenIndex2591 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2592
+						}
+						position++
+						goto l2591
+					l2592:
+						position, tokenIndex = position2591, tokenIndex2591
+						if buffer[position] != rune('E') {
+							goto l2578
+						}
+						position++
+					}
+				l2591:
+					{
+						position2593, tokenIndex2593 := position, tokenIndex
+						if buffer[position] != rune('w') {
+							goto l2594
+						}
+						position++
+						goto l2593
+					l2594:
+						position, tokenIndex = position2593, tokenIndex2593
+						if buffer[position] != rune('W') {
+							goto l2578
+						}
+						position++
+					}
+				l2593:
+					{
+						position2595, tokenIndex2595 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2596
+						}
+						position++
+						goto l2595
+					l2596:
+						position, tokenIndex = position2595, tokenIndex2595
+						if buffer[position] != rune('E') {
+							goto l2578
+						}
+						position++
+					}
+				l2595:
+					{
+						position2597, tokenIndex2597 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2598
+						}
+						position++
+						goto l2597
+					l2598:
+						position, tokenIndex = position2597, tokenIndex2597
+						if buffer[position] != rune('S') {
+							goto l2578
+						}
+						position++
+					}
+				l2597:
+					{
+						position2599, tokenIndex2599 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2600
+						}
+						position++
+						goto l2599
+					l2600:
+						position, tokenIndex = position2599, tokenIndex2599
+						if buffer[position] != rune('T') {
+							goto l2578
+						}
+						position++
+					}
+				l2599:
+					add(rulePegText, position2580)
+				}
+				if !_rules[ruleAction151]() {
+					goto l2578
+				}
+				add(ruleDropNewest, position2579)
+			}
+			return true
+		l2578:
+			position, tokenIndex = position2578, tokenIndex2578
+			return false
+		},
+		/* 195 InnerJoin <- <(<((('i' / 'I') ('n' / 'N') ('n' / 'N') ('e' / 'E') ('r' / 'R') sp)? (('j' / 'J') ('o' / 'O') ('i' / 'I') ('n' / 'N')))> Action152)> */
+		func() bool {
+			position2601, tokenIndex2601 := position, tokenIndex
+			{
+				position2602 := position
+				{
+					position2603 := position
+					{
+						position2604, tokenIndex2604 := position, tokenIndex
+						{
+							position2606, tokenIndex2606 := position, tokenIndex
+							if buffer[position] != rune('i') {
+								goto l2607
+							}
+							position++
+							goto l2606
+						l2607:
+							position, tokenIndex = position2606, tokenIndex2606
+							if buffer[position] != rune('I') {
+								goto l2604
+							}
+							position++
+						}
+					l2606:
+						{
+							position2608, tokenIndex2608 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l2609
+							}
+							position++
+							goto l2608
+						l2609:
+							position, tokenIndex = position2608, tokenIndex2608
+							if buffer[position] != rune('N') {
+								goto l2604
+							}
+							position++
+						}
+					l2608:
+						{
+							position2610, tokenIndex2610 := position, tokenIndex
+							if buffer[position] != rune('n') {
+								goto l2611
+							}
+							position++
+							goto l2610
+						l2611:
+							position, tokenIndex = position2610, tokenIndex2610
+							if buffer[position] != rune('N') {
+								goto l2604
+							}
+							position++
+						}
+					l2610:
+						{
+							position2612, tokenIndex2612 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l2613
+							}
+							position++
+							goto l2612
+						l2613:
+							position, tokenIndex = position2612, tokenIndex2612
+							if buffer[position] != rune('E') {
+								goto l2604
+							}
+							position++
+						}
+					l2612:
+						{
+							position2614, tokenIndex2614 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l2615
+							}
+							position++
+							goto l2614
+						l2615:
+							position, tokenIndex = position2614, tokenIndex2614
+							if buffer[position] != rune('R') {
+								goto l2604
+							}
+							position++
+						}
+					l2614:
+						if !_rules[rulesp]() {
+							goto l2604
+						}
+						goto l2605
+					l2604:
+						position, tokenIndex = position2604, tokenIndex2604
+					}
+				l2605:
+					{
+						position2616, tokenIndex2616 := position, tokenIndex
+						if buffer[position] != rune('j') {
+							goto l2617
+						}
+						position++
+						goto l2616
+					l2617:
+						position, tokenIndex = position2616, tokenIndex2616
+						if buffer[position] != rune('J') {
+							goto l2601
+						}
+						position++
+					}
+				l2616:
+					{
+						position2618, tokenIndex2618 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2619
+						}
+						position++
+						goto l2618
+					l2619:
+						position, tokenIndex = position2618, tokenIndex2618
+						if buffer[position] != rune('O') {
+							goto l2601
+						}
+						position++
+					}
+				l2618:
+					{
+						position2620, tokenIndex2620 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2621
+						}
+						position++
+						goto l2620
+					l2621:
+						position, tokenIndex = position2620, tokenIndex2620
+						if buffer[position] != rune('I') {
+							goto l2601
+						}
+						position++
+					}
+				l2620:
+					{
+						position2622, tokenIndex2622 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2623
+						}
+						position++
+						goto l2622
+					l2623:
+						position, tokenIndex = position2622, tokenIndex2622
+						if buffer[position] != rune('N') {
+							goto l2601
+						}
+						position++
+					}
+				l2622:
+					add(rulePegText, position2603)
+				}
+				if !_rules[ruleAction152]() {
+					goto l2601
+				}
+				add(ruleInnerJoin, position2602)
+			}
+			return true
+		l2601:
+			position, tokenIndex = position2601, tokenIndex2601
+			return false
+		},
+		/* 196 LeftOuterJoin <- <(<(('l' / 'L') ('e' / 'E') ('f' / 'F') ('t' / 'T') sp (('o' / 'O') ('u' / 'U') ('t' / 'T') ('e' / 'E') ('r' / 'R') sp)? (('j' / 'J') ('o' / 'O') ('i' / 'I') ('n' / 'N')))> Action153)> */
+		func() bool {
+			position2624, tokenIndex2624 := position, tokenIndex
+			{
+				position2625 := position
+				{
+					position2626 := position
+					{
+						position2627, tokenIndex2627 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2628
+						}
+						position++
+						goto l2627
+					l2628:
+						position, tokenIndex = position2627, tokenIndex2627
+						if buffer[position] != rune('L') {
+							goto l2624
+						}
+						position++
+					}
+				l2627:
+					{
+						position2629, tokenIndex2629 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2630
+						}
+						position++
+						goto l2629
+					l2630:
+						position, tokenIndex = position2629, tokenIndex2629
+						if buffer[position] != rune('E') {
+							goto l2624
+						}
+						position++
+					}
+				l2629:
+					{
+						position2631, tokenIndex2631 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l2632
+						}
+						position++
+						goto l2631
+					l2632:
+						position, tokenIndex = position2631, tokenIndex2631
+						if buffer[position] != rune('F') {
+							goto l2624
+						}
+						position++
+					}
+				l2631:
+					{
+						position2633, tokenIndex2633 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2634
+						}
+						position++
+						goto l2633
+					l2634:
+						position, tokenIndex = position2633, tokenIndex2633
+						if buffer[position] != rune('T') {
+							goto l2624
+						}
+						position++
+					}
+				l2633:
+					if !_rules[rulesp]() {
+						goto l2624
+					}
+					{
+						position2635, tokenIndex2635 := position, tokenIndex
+						{
+							position2637, tokenIndex2637 := position, tokenIndex
+							if buffer[position] != rune('o') {
+								goto l2638
+							}
+							position++
+							goto l2637
+						l2638:
+							position, tokenIndex = position2637, tokenIndex2637
+							if buffer[position] != rune('O') {
+								goto l2635
+							}
+							position++
+						}
+					l2637:
+						{
+							position2639, tokenIndex2639 := position, tokenIndex
+							if buffer[position] != rune('u') {
+								goto l2640
+							}
+							position++
+							goto l2639
+						l2640:
+							position, tokenIndex = position2639, tokenIndex2639
+							if buffer[position] != rune('U') {
+								goto l2635
+							}
+							position++
+						}
+					l2639:
+						{
+							position2641, tokenIndex2641 := position, tokenIndex
+							if buffer[position] != rune('t') {
+								goto l2642
+							}
+							position++
+							goto l2641
+						l2642:
+							position, tokenIndex = position2641, tokenIndex2641
+							if buffer[position] != rune('T') {
+								goto l2635
+							}
+							position++
+						}
+					l2641:
+						{
+							position2643, tokenIndex2643 := position, tokenIndex
+							if buffer[position] != rune('e') {
+								goto l2644
+							}
+							position++
+							goto l2643
+						l2644:
+							position, tokenIndex = position2643, tokenIndex2643
+							if buffer[position] != rune('E') {
+								goto l2635
+							}
+							position++
+						}
+					l2643:
+						{
+							position2645, tokenIndex2645 := position, tokenIndex
+							if buffer[position] != rune('r') {
+								goto l2646
+							}
+							position++
+							goto l2645
+						l2646:
+							position, tokenIndex = position2645, tokenIndex2645
+							if buffer[position] != rune('R') {
+								goto l2635
+							}
+							position++
+						}
+					l2645:
+						if !_rules[rulesp]() {
+							goto l2635
+						}
+						goto l2636
+					l2635:
+						position, tokenIndex = position2635, tokenIndex2635
+					}
+				l2636:
+					{
+						position2647, tokenIndex2647 := position, tokenIndex
+						if buffer[position] != rune('j') {
+							goto l2648
+						}
+						position++
+						goto l2647
+					l2648:
+						position, tokenIndex = position2647, tokenIndex2647
+						if buffer[position] != rune('J') {
+							goto l2624
+						}
+						position++
+					}
+				l2647:
+					{
+						position2649, tokenIndex2649 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2650
+						}
+						position++
+						goto l2649
+					l2650:
+						position, tokenIndex = position2649, tokenIndex2649
+						if buffer[position] != rune('O') {
+							goto l2624
+						}
+						position++
+					}
+				l2649:
+					{
+						position2651, tokenIndex2651 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2652
+						}
+						position++
+						goto l2651
+					l2652:
+						position, tokenIndex = position2651, tokenIndex2651
+						if buffer[position] != rune('I') {
+							goto l2624
+						}
+						position++
+					}
+				l2651:
+					{
+						position2653, tokenIndex2653 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2654
+						}
+						position++
+						goto l2653
+					l2654:
+						position, tokenIndex = position2653, tokenIndex2653
+						if buffer[position] != rune('N') {
+							goto l2624
+						}
+						position++
+					}
+				l2653:
+					add(rulePegText, position2626)
+				}
+				if !_rules[ruleAction153]() {
+					goto l2624
+				}
+				add(ruleLeftOuterJoin, position2625)
+			}
+			return true
+		l2624:
+			position, tokenIndex = position2624, tokenIndex2624
+			return false
+		},
+		/* 197 StreamIdentifier <- <(<ident> Action154)> */
+		func() bool {
+			position2655, tokenIndex2655 := position, tokenIndex
+			{
+				position2656 := position
+				{
+					position2657 := position
+					if !_rules[ruleident]() {
+						goto l2655
+					}
+					add(rulePegText, position2657)
+				}
+				if !_rules[ruleAction154]() {
+					goto l2655
+				}
+				add(ruleStreamIdentifier, position2656)
+			}
+			return true
+		l2655:
+			position, tokenIndex = position2655, tokenIndex2655
+			return false
+		},
+		/* 198 SourceSinkType <- <(<ident> Action155)> */
+		func() bool {
+			position2658, tokenIndex2658 := position, tokenIndex
+			{
+				position2659 := position
+				{
+					position2660 := position
+					if !_rules[ruleident]() {
+						goto l2658
+					}
+					add(rulePegText, position2660)
+				}
+				if !_rules[ruleAction155]() {
+					goto l2658
+				}
+				add(ruleSourceSinkType, position2659)
+			}
+			return true
+		l2658:
+			position, tokenIndex = position2658, tokenIndex2658
+			return false
+		},
+		/* 199 SourceSinkParamKey <- <(<ident> Action156)> */
+		func() bool {
+			position2661, tokenIndex2661 := position, tokenIndex
+			{
+				position2662 := position
+				{
+					position2663 := position
+					if !_rules[ruleident]() {
+						goto l2661
+					}
+					add(rulePegText, position2663)
+				}
+				if !_rules[ruleAction156]() {
+					goto l2661
+				}
+				add(ruleSourceSinkParamKey, position2662)
+			}
+			return true
+		l2661:
+			position, tokenIndex = position2661, tokenIndex2661
+			return false
+		},
+		/* 200 Paused <- <(<(('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') ('d' / 'D'))> Action157)> */
+		func() bool {
+			position2664, tokenIndex2664 := position, tokenIndex
+			{
+				position2665 := position
+				{
+					position2666 := position
+					{
+						position2667, tokenIndex2667 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2668
+						}
+						position++
+						goto l2667
+					l2668:
+						position, tokenIndex = position2667, tokenIndex2667
+						if buffer[position] != rune('P') {
+							goto l2664
+						}
+						position++
+					}
+				l2667:
+					{
+						position2669, tokenIndex2669 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2670
+						}
+						position++
+						goto l2669
+					l2670:
+						position, tokenIndex = position2669, tokenIndex2669
+						if buffer[position] != rune('A') {
+							goto l2664
+						}
+						position++
+					}
+				l2669:
+					{
+						position2671, tokenIndex2671 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2672
+						}
+						position++
+						goto l2671
+					l2672:
+						position, tokenIndex = position2671, tokenIndex2671
+						if buffer[position] != rune('U') {
+							goto l2664
+						}
+						position++
+					}
+				l2671:
+					{
+						position2673, tokenIndex2673 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2674
+						}
+						position++
+						goto l2673
+					l2674:
+						position, tokenIndex = position2673, tokenIndex2673
+						if buffer[position] != rune('S') {
+							goto l2664
+						}
+						position++
+					}
+				l2673:
+					{
+						position2675, tokenIndex2675 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2676
+						}
+						position++
+						goto l2675
+					l2676:
+						position, tokenIndex = position2675, tokenIndex2675
+						if buffer[position] != rune('E') {
+							goto l2664
+						}
+						position++
+					}
+				l2675:
+					{
+						position2677, tokenIndex2677 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2678
+						}
+						position++
+						goto l2677
+					l2678:
+						position, tokenIndex = position2677, tokenIndex2677
+						if buffer[position] != rune('D') {
+							goto l2664
+						}
+						position++
+					}
+				l2677:
+					add(rulePegText, position2666)
+				}
+				if !_rules[ruleAction157]() {
+					goto l2664
+				}
+				add(rulePaused, position2665)
+			}
+			return true
+		l2664:
+			position, tokenIndex = position2664, tokenIndex2664
+			return false
+		},
+		/* 201 Unpaused <- <(<(('u' / 'U') ('n' / 'N') ('p' / 'P') ('a' / 'A') ('u' / 'U') ('s' / 'S') ('e' / 'E') ('d' / 'D'))> Action158)> */
+		func() bool {
+			position2679, tokenIndex2679 := position, tokenIndex
+			{
+				position2680 := position
+				{
+					position2681 := position
+					{
+						position2682, tokenIndex2682 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2683
+						}
+						position++
+						goto l2682
+					l2683:
+						position, tokenIndex = position2682, tokenIndex2682
+						if buffer[position] != rune('U') {
+							goto l2679
+						}
+						position++
+					}
+				l2682:
+					{
+						position2684, tokenIndex2684 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2685
+						}
+						position++
+						goto l2684
+					l2685:
+						position, tokenIndex = position2684, tokenIndex2684
+						if buffer[position] != rune('N') {
+							goto l2679
+						}
+						position++
+					}
+				l2684:
+					{
+						position2686, tokenIndex2686 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2687
+						}
+						position++
+						goto l2686
+					l2687:
+						position, tokenIndex = position2686, tokenIndex2686
+						if buffer[position] != rune('P') {
+							goto l2679
+						}
+						position++
+					}
+				l2686:
+					{
+						position2688, tokenIndex2688 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2689
+						}
+						position++
+						goto l2688
+					l2689:
+						position, tokenIndex = position2688, tokenIndex2688
+						if buffer[position] != rune('A') {
+							goto l2679
+						}
+						position++
+					}
+				l2688:
+					{
+						position2690, tokenIndex2690 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2691
+						}
+						position++
+						goto l2690
+					l2691:
+						position, tokenIndex = position2690, tokenIndex2690
+						if buffer[position] != rune('U') {
+							goto l2679
+						}
+						position++
+					}
+				l2690:
+					{
+						position2692, tokenIndex2692 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2693
+						}
+						position++
+						goto l2692
+					l2693:
+						position, tokenIndex = position2692, tokenIndex2692
+						if buffer[position] != rune('S') {
+							goto l2679
+						}
+						position++
+					}
+				l2692:
+					{
+						position2694, tokenIndex2694 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2695
+						}
+						position++
+						goto l2694
+					l2695:
+						position, tokenIndex = position2694, tokenIndex2694
+						if buffer[position] != rune('E') {
+							goto l2679
+						}
+						position++
+					}
+				l2694:
+					{
+						position2696, tokenIndex2696 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2697
+						}
+						position++
+						goto l2696
+					l2697:
+						position, tokenIndex = position2696, tokenIndex2696
+						if buffer[position] != rune('D') {
+							goto l2679
+						}
+						position++
+					}
+				l2696:
+					add(rulePegText, position2681)
+				}
+				if !_rules[ruleAction158]() {
+					goto l2679
+				}
+				add(ruleUnpaused, position2680)
+			}
+			return true
+		l2679:
+			position, tokenIndex = position2679, tokenIndex2679
+			return false
+		},
+		/* 202 NotNull <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T') sp (('n' / 'N') ('u' / 'U') ('l' / 'L') ('l' / 'L')))> Action159)> */
+		func() bool {
+			position2698, tokenIndex2698 := position, tokenIndex
+			{
+				position2699 := position
+				{
+					position2700 := position
+					{
+						position2701, tokenIndex2701 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2702
+						}
+						position++
+						goto l2701
+					l2702:
+						position, tokenIndex = position2701, tokenIndex2701
+						if buffer[position] != rune('N') {
+							goto l2698
+						}
+						position++
+					}
+				l2701:
+					{
+						position2703, tokenIndex2703 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2704
+						}
+						position++
+						goto l2703
+					l2704:
+						position, tokenIndex = position2703, tokenIndex2703
+						if buffer[position] != rune('O') {
+							goto l2698
+						}
+						position++
+					}
+				l2703:
+					{
+						position2705, tokenIndex2705 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2706
+						}
+						position++
+						goto l2705
+					l2706:
+						position, tokenIndex = position2705, tokenIndex2705
+						if buffer[position] != rune('T') {
+							goto l2698
+						}
+						position++
+					}
+				l2705:
+					if !_rules[rulesp]() {
+						goto l2698
+					}
+					{
+						position2707, tokenIndex2707 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2708
+						}
+						position++
+						goto l2707
+					l2708:
+						position, tokenIndex = position2707, tokenIndex2707
+						if buffer[position] != rune('N') {
+							goto l2698
+						}
+						position++
+					}
+				l2707:
+					{
+						position2709, tokenIndex2709 := position, tokenIndex
+						if buffer[position] != rune('u') {
+							goto l2710
+						}
+						position++
+						goto l2709
+					l2710:
+						position, tokenIndex = position2709, tokenIndex2709
+						if buffer[position] != rune('U') {
+							goto l2698
+						}
+						position++
+					}
+				l2709:
+					{
+						position2711, tokenIndex2711 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2712
+						}
+						position++
+						goto l2711
+					l2712:
+						position, tokenIndex = position2711, tokenIndex2711
+						if buffer[position] != rune('L') {
+							goto l2698
+						}
+						position++
+					}
+				l2711:
+					{
+						position2713, tokenIndex2713 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2714
+						}
+						position++
+						goto l2713
+					l2714:
+						position, tokenIndex = position2713, tokenIndex2713
+						if buffer[position] != rune('L') {
+							goto l2698
+						}
+						position++
+					}
+				l2713:
+					add(rulePegText, position2700)
+				}
+				if !_rules[ruleAction159]() {
+					goto l2698
+				}
+				add(ruleNotNull, position2699)
+			}
+			return true
+		l2698:
+			position, tokenIndex = position2698, tokenIndex2698
+			return false
+		},
+		/* 203 Ascending <- <(<(('a' / 'A') ('s' / 'S') ('c' / 'C'))> Action160)> */
+		func() bool {
+			position2715, tokenIndex2715 := position, tokenIndex
+			{
+				position2716 := position
+				{
+					position2717 := position
+					{
+						position2718, tokenIndex2718 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2719
+						}
+						position++
+						goto l2718
+					l2719:
+						position, tokenIndex = position2718, tokenIndex2718
+						if buffer[position] != rune('A') {
+							goto l2715
+						}
+						position++
+					}
+				l2718:
+					{
+						position2720, tokenIndex2720 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2721
+						}
+						position++
+						goto l2720
+					l2721:
+						position, tokenIndex = position2720, tokenIndex2720
+						if buffer[position] != rune('S') {
+							goto l2715
+						}
+						position++
+					}
+				l2720:
+					{
+						position2722, tokenIndex2722 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l2723
+						}
+						position++
+						goto l2722
+					l2723:
+						position, tokenIndex = position2722, tokenIndex2722
+						if buffer[position] != rune('C') {
+							goto l2715
+						}
+						position++
+					}
+				l2722:
+					add(rulePegText, position2717)
+				}
+				if !_rules[ruleAction160]() {
+					goto l2715
+				}
+				add(ruleAscending, position2716)
+			}
+			return true
+		l2715:
+			position, tokenIndex = position2715, tokenIndex2715
+			return false
+		},
+		/* 204 Descending <- <(<(('d' / 'D') ('e' / 'E') ('s' / 'S') ('c' / 'C'))> Action161)> */
+		func() bool {
+			position2724, tokenIndex2724 := position, tokenIndex
+			{
+				position2725 := position
+				{
+					position2726 := position
+					{
+						position2727, tokenIndex2727 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2728
+						}
+						position++
+						goto l2727
+					l2728:
+						position, tokenIndex = position2727, tokenIndex2727
+						if buffer[position] != rune('D') {
+							goto l2724
+						}
+						position++
+					}
+				l2727:
+					{
+						position2729, tokenIndex2729 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2730
+						}
+						position++
+						goto l2729
+					l2730:
+						position, tokenIndex = position2729, tokenIndex2729
+						if buffer[position] != rune('E') {
+							goto l2724
+						}
+						position++
+					}
+				l2729:
+					{
+						position2731, tokenIndex2731 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2732
+						}
+						position++
+						goto l2731
+					l2732:
+						position, tokenIndex = position2731, tokenIndex2731
+						if buffer[position] != rune('S') {
+							goto l2724
+						}
+						position++
+					}
+				l2731:
+					{
+						position2733, tokenIndex2733 := position, tokenIndex
+						if buffer[position] != rune('c') {
+							goto l2734
+						}
+						position++
+						goto l2733
+					l2734:
+						position, tokenIndex = position2733, tokenIndex2733
+						if buffer[position] != rune('C') {
+							goto l2724
+						}
+						position++
+					}
+				l2733:
+					add(rulePegText, position2726)
+				}
+				if !_rules[ruleAction161]() {
+					goto l2724
+				}
+				add(ruleDescending, position2725)
+			}
+			return true
+		l2724:
+			position, tokenIndex = position2724, tokenIndex2724
+			return false
+		},
+		/* 205 Type <- <(Bool / Int / Float / String / Blob / Timestamp / Array / Map)> */
+		func() bool {
+			position2735, tokenIndex2735 := position, tokenIndex
+			{
+				position2736 := position
+				{
+					position2737, tokenIndex2737 := position, tokenIndex
+					if !_rules[ruleBool]() {
+						goto l2738
+					}
+					goto l2737
+				l2738:
+					position, tokenIndex = position2737, tokenIndex2737
+					if !_rules[ruleInt]() {
+						goto l2739
+					}
+					goto l2737
+				l2739:
+					position, tokenIndex = position2737, tokenIndex2737
+					if !_rules[ruleFloat]() {
+						goto l2740
+					}
+					goto l2737
+				l2740:
+					position, tokenIndex = position2737, tokenIndex2737
+					if !_rules[ruleString]() {
+						goto l2741
+					}
+					goto l2737
+				l2741:
+					position, tokenIndex = position2737, tokenIndex2737
+					if !_rules[ruleBlob]() {
+						goto l2742
+					}
+					goto l2737
+				l2742:
+					position, tokenIndex = position2737, tokenIndex2737
+					if !_rules[ruleTimestamp]() {
+						goto l2743
+					}
+					goto l2737
+				l2743:
+					position, tokenIndex = position2737, tokenIndex2737
+					if !_rules[ruleArray]() {
+						goto l2744
+					}
+					goto l2737
+				l2744:
+					position, tokenIndex = position2737, tokenIndex2737
+					if !_rules[ruleMap]() {
+						goto l2735
+					}
+				}
+			l2737:
+				add(ruleType, position2736)
+			}
+			return true
+		l2735:
+			position, tokenIndex = position2735, tokenIndex2735
+			return false
+		},
+		/* 206 Bool <- <(<(('b' / 'B') ('o' / 'O') ('o' / 'O') ('l' / 'L'))> Action162)> */
+		func() bool {
+			position2745, tokenIndex2745 := position, tokenIndex
+			{
+				position2746 := position
+				{
+					position2747 := position
+					{
+						position2748, tokenIndex2748 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l2749
+						}
+						position++
+						goto l2748
+					l2749:
+						position, tokenIndex = position2748, tokenIndex2748
+						if buffer[position] != rune('B') {
+							goto l2745
+						}
+						position++
+					}
+				l2748:
+					{
+						position2750, tokenIndex2750 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2751
+						}
+						position++
+						goto l2750
+					l2751:
+						position, tokenIndex = position2750, tokenIndex2750
+						if buffer[position] != rune('O') {
+							goto l2745
+						}
+						position++
+					}
+				l2750:
+					{
+						position2752, tokenIndex2752 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2753
+						}
+						position++
+						goto l2752
+					l2753:
+						position, tokenIndex = position2752, tokenIndex2752
+						if buffer[position] != rune('O') {
+							goto l2745
+						}
+						position++
+					}
+				l2752:
+					{
+						position2754, tokenIndex2754 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2755
+						}
+						position++
+						goto l2754
+					l2755:
+						position, tokenIndex = position2754, tokenIndex2754
+						if buffer[position] != rune('L') {
+							goto l2745
+						}
+						position++
+					}
+				l2754:
+					add(rulePegText, position2747)
+				}
+				if !_rules[ruleAction162]() {
+					goto l2745
+				}
+				add(ruleBool, position2746)
+			}
+			return true
+		l2745:
+			position, tokenIndex = position2745, tokenIndex2745
+			return false
+		},
+		/* 207 Int <- <(<(('i' / 'I') ('n' / 'N') ('t' / 'T'))> Action163)> */
+		func() bool {
+			position2756, tokenIndex2756 := position, tokenIndex
+			{
+				position2757 := position
+				{
+					position2758 := position
+					{
+						position2759, tokenIndex2759 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2760
+						}
+						position++
+						goto l2759
+					l2760:
+						position, tokenIndex = position2759, tokenIndex2759
+						if buffer[position] != rune('I') {
+							goto l2756
+						}
+						position++
+					}
+				l2759:
+					{
+						position2761, tokenIndex2761 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2762
+						}
+						position++
+						goto l2761
+					l2762:
+						position, tokenIndex = position2761, tokenIndex2761
+						if buffer[position] != rune('N') {
+							goto l2756
+						}
+						position++
+					}
+				l2761:
+					{
+						position2763, tokenIndex2763 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2764
+						}
+						position++
+						goto l2763
+					l2764:
+						position, tokenIndex = position2763, tokenIndex2763
+						if buffer[position] != rune('T') {
+							goto l2756
+						}
+						position++
+					}
+				l2763:
+					add(rulePegText, position2758)
+				}
+				if !_rules[ruleAction163]() {
+					goto l2756
+				}
+				add(ruleInt, position2757)
+			}
+			return true
+		l2756:
+			position, tokenIndex = position2756, tokenIndex2756
+			return false
+		},
+		/* 208 Float <- <(<(('f' / 'F') ('l' / 'L') ('o' / 'O') ('a' / 'A') ('t' / 'T'))> Action164)> */
+		func() bool {
+			position2765, tokenIndex2765 := position, tokenIndex
+			{
+				position2766 := position
+				{
+					position2767 := position
+					{
+						position2768, tokenIndex2768 := position, tokenIndex
+						if buffer[position] != rune('f') {
+							goto l2769
+						}
+						position++
+						goto l2768
+					l2769:
+						position, tokenIndex = position2768, tokenIndex2768
+						if buffer[position] != rune('F') {
+							goto l2765
+						}
+						position++
+					}
+				l2768:
+					{
+						position2770, tokenIndex2770 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2771
+						}
+						position++
+						goto l2770
+					l2771:
+						position, tokenIndex = position2770, tokenIndex2770
+						if buffer[position] != rune('L') {
+							goto l2765
+						}
+						position++
+					}
+				l2770:
+					{
+						position2772, tokenIndex2772 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2773
+						}
+						position++
+						goto l2772
+					l2773:
+						position, tokenIndex = position2772, tokenIndex2772
+						if buffer[position] != rune('O') {
+							goto l2765
+						}
+						position++
+					}
+				l2772:
+					{
+						position2774, tokenIndex2774 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2775
+						}
+						position++
+						goto l2774
+					l2775:
+						position, tokenIndex = position2774, tokenIndex2774
+						if buffer[position] != rune('A') {
+							goto l2765
+						}
+						position++
+					}
+				l2774:
+					{
+						position2776, tokenIndex2776 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2777
+						}
+						position++
+						goto l2776
+					l2777:
+						position, tokenIndex = position2776, tokenIndex2776
+						if buffer[position] != rune('T') {
+							goto l2765
+						}
+						position++
+					}
+				l2776:
+					add(rulePegText, position2767)
+				}
+				if !_rules[ruleAction164]() {
+					goto l2765
+				}
+				add(ruleFloat, position2766)
+			}
+			return true
+		l2765:
+			position, tokenIndex = position2765, tokenIndex2765
+			return false
+		},
+		/* 209 String <- <(<(('s' / 'S') ('t' / 'T') ('r' / 'R') ('i' / 'I') ('n' / 'N') ('g' / 'G'))> Action165)> */
+		func() bool {
+			position2778, tokenIndex2778 := position, tokenIndex
+			{
+				position2779 := position
+				{
+					position2780 := position
+					{
+						position2781, tokenIndex2781 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2782
+						}
+						position++
+						goto l2781
+					l2782:
+						position, tokenIndex = position2781, tokenIndex2781
+						if buffer[position] != rune('S') {
+							goto l2778
+						}
+						position++
+					}
+				l2781:
+					{
+						position2783, tokenIndex2783 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2784
+						}
+						position++
+						goto l2783
+					l2784:
+						position, tokenIndex = position2783, tokenIndex2783
+						if buffer[position] != rune('T') {
+							goto l2778
+						}
+						position++
+					}
+				l2783:
+					{
+						position2785, tokenIndex2785 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2786
+						}
+						position++
+						goto l2785
+					l2786:
+						position, tokenIndex = position2785, tokenIndex2785
+						if buffer[position] != rune('R') {
+							goto l2778
+						}
+						position++
+					}
+				l2785:
+					{
+						position2787, tokenIndex2787 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2788
+						}
+						position++
+						goto l2787
+					l2788:
+						position, tokenIndex = position2787, tokenIndex2787
+						if buffer[position] != rune('I') {
+							goto l2778
+						}
+						position++
+					}
+				l2787:
+					{
+						position2789, tokenIndex2789 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2790
+						}
+						position++
+						goto l2789
+					l2790:
+						position, tokenIndex = position2789, tokenIndex2789
+						if buffer[position] != rune('N') {
+							goto l2778
+						}
+						position++
+					}
+				l2789:
+					{
+						position2791, tokenIndex2791 := position, tokenIndex
+						if buffer[position] != rune('g') {
+							goto l2792
+						}
+						position++
+						goto l2791
+					l2792:
+						position, tokenIndex = position2791, tokenIndex2791
+						if buffer[position] != rune('G') {
+							goto l2778
+						}
+						position++
+					}
+				l2791:
+					add(rulePegText, position2780)
+				}
+				if !_rules[ruleAction165]() {
+					goto l2778
+				}
+				add(ruleString, position2779)
+			}
+			return true
+		l2778:
+			position, tokenIndex = position2778, tokenIndex2778
+			return false
+		},
+		/* 210 Blob <- <(<(('b' / 'B') ('l' / 'L') ('o' / 'O') ('b' / 'B'))> Action166)> */
+		func() bool {
+			position2793, tokenIndex2793 := position, tokenIndex
+			{
+				position2794 := position
+				{
+					position2795 := position
+					{
+						position2796, tokenIndex2796 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l2797
+						}
+						position++
+						goto l2796
+					l2797:
+						position, tokenIndex = position2796, tokenIndex2796
+						if buffer[position] != rune('B') {
+							goto l2793
+						}
+						position++
+					}
+				l2796:
+					{
+						position2798, tokenIndex2798 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2799
+						}
+						position++
+						goto l2798
+					l2799:
+						position, tokenIndex = position2798, tokenIndex2798
+						if buffer[position] != rune('L') {
+							goto l2793
+						}
+						position++
+					}
+				l2798:
+					{
+						position2800, tokenIndex2800 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2801
+						}
+						position++
+						goto l2800
+					l2801:
+						position, tokenIndex = position2800, tokenIndex2800
+						if buffer[position] != rune('O') {
+							goto l2793
+						}
+						position++
+					}
+				l2800:
+					{
+						position2802, tokenIndex2802 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l2803
+						}
+						position++
+						goto l2802
+					l2803:
+						position, tokenIndex = position2802, tokenIndex2802
+						if buffer[position] != rune('B') {
+							goto l2793
+						}
+						position++
+					}
+				l2802:
+					add(rulePegText, position2795)
+				}
+				if !_rules[ruleAction166]() {
+					goto l2793
+				}
+				add(ruleBlob, position2794)
+			}
+			return true
+		l2793:
+			position, tokenIndex = position2793, tokenIndex2793
+			return false
+		},
+		/* 211 Timestamp <- <(<(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ('s' / 'S') ('t' / 'T') ('a' / 'A') ('m' / 'M') ('p' / 'P'))> Action167)> */
+		func() bool {
+			position2804, tokenIndex2804 := position, tokenIndex
+			{
+				position2805 := position
+				{
+					position2806 := position
+					{
+						position2807, tokenIndex2807 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2808
+						}
+						position++
+						goto l2807
+					l2808:
+						position, tokenIndex = position2807, tokenIndex2807
+						if buffer[position] != rune('T') {
+							goto l2804
+						}
+						position++
+					}
+				l2807:
+					{
+						position2809, tokenIndex2809 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2810
+						}
+						position++
+						goto l2809
+					l2810:
+						position, tokenIndex = position2809, tokenIndex2809
+						if buffer[position] != rune('I') {
+							goto l2804
+						}
+						position++
+					}
+				l2809:
+					{
+						position2811, tokenIndex2811 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2812
+						}
+						position++
+						goto l2811
+					l2812:
+						position, tokenIndex = position2811, tokenIndex2811
+						if buffer[position] != rune('M') {
+							goto l2804
+						}
+						position++
+					}
+				l2811:
+					{
+						position2813, tokenIndex2813 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2814
+						}
+						position++
+						goto l2813
+					l2814:
+						position, tokenIndex = position2813, tokenIndex2813
+						if buffer[position] != rune('E') {
+							goto l2804
+						}
+						position++
+					}
+				l2813:
+					{
+						position2815, tokenIndex2815 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l2816
+						}
+						position++
+						goto l2815
+					l2816:
+						position, tokenIndex = position2815, tokenIndex2815
+						if buffer[position] != rune('S') {
+							goto l2804
+						}
+						position++
+					}
+				l2815:
+					{
+						position2817, tokenIndex2817 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2818
+						}
+						position++
+						goto l2817
+					l2818:
+						position, tokenIndex = position2817, tokenIndex2817
+						if buffer[position] != rune('T') {
+							goto l2804
+						}
+						position++
+					}
+				l2817:
+					{
+						position2819, tokenIndex2819 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2820
+						}
+						position++
+						goto l2819
+					l2820:
+						position, tokenIndex = position2819, tokenIndex2819
+						if buffer[position] != rune('A') {
+							goto l2804
+						}
+						position++
+					}
+				l2819:
+					{
+						position2821, tokenIndex2821 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2822
+						}
+						position++
+						goto l2821
+					l2822:
+						position, tokenIndex = position2821, tokenIndex2821
+						if buffer[position] != rune('M') {
+							goto l2804
+						}
+						position++
+					}
+				l2821:
+					{
+						position2823, tokenIndex2823 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2824
+						}
+						position++
+						goto l2823
+					l2824:
+						position, tokenIndex = position2823, tokenIndex2823
+						if buffer[position] != rune('P') {
+							goto l2804
+						}
+						position++
+					}
+				l2823:
+					add(rulePegText, position2806)
+				}
+				if !_rules[ruleAction167]() {
+					goto l2804
+				}
+				add(ruleTimestamp, position2805)
+			}
+			return true
+		l2804:
+			position, tokenIndex = position2804, tokenIndex2804
+			return false
+		},
+		/* 212 Array <- <(<(('a' / 'A') ('r' / 'R') ('r' / 'R') ('a' / 'A') ('y' / 'Y'))> Action168)> */
+		func() bool {
+			position2825, tokenIndex2825 := position, tokenIndex
+			{
+				position2826 := position
+				{
+					position2827 := position
+					{
+						position2828, tokenIndex2828 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2829
+						}
+						position++
+						goto l2828
+					l2829:
+						position, tokenIndex = position2828, tokenIndex2828
+						if buffer[position] != rune('A') {
+							goto l2825
+						}
+						position++
+					}
+				l2828:
+					{
+						position2830, tokenIndex2830 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2831
+						}
+						position++
+						goto l2830
+					l2831:
+						position, tokenIndex = position2830, tokenIndex2830
+						if buffer[position] != rune('R') {
+							goto l2825
+						}
+						position++
+					}
+				l2830:
+					{
+						position2832, tokenIndex2832 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2833
+						}
+						position++
+						goto l2832
+					l2833:
+						position, tokenIndex = position2832, tokenIndex2832
+						if buffer[position] != rune('R') {
+							goto l2825
+						}
+						position++
+					}
+				l2832:
+					{
+						position2834, tokenIndex2834 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2835
+						}
+						position++
+						goto l2834
+					l2835:
+						position, tokenIndex = position2834, tokenIndex2834
+						if buffer[position] != rune('A') {
+							goto l2825
+						}
+						position++
+					}
+				l2834:
+					{
+						position2836, tokenIndex2836 := position, tokenIndex
+						if buffer[position] != rune('y') {
+							goto l2837
+						}
+						position++
+						goto l2836
+					l2837:
+						position, tokenIndex = position2836, tokenIndex2836
+						if buffer[position] != rune('Y') {
+							goto l2825
+						}
+						position++
+					}
+				l2836:
+					add(rulePegText, position2827)
+				}
+				if !_rules[ruleAction168]() {
+					goto l2825
+				}
+				add(ruleArray, position2826)
+			}
+			return true
+		l2825:
+			position, tokenIndex = position2825, tokenIndex2825
+			return false
+		},
+		/* 213 Map <- <(<(('m' / 'M') ('a' / 'A') ('p' / 'P'))> Action169)> */
+		func() bool {
+			position2838, tokenIndex2838 := position, tokenIndex
+			{
+				position2839 := position
+				{
+					position2840 := position
+					{
+						position2841, tokenIndex2841 := position, tokenIndex
+						if buffer[position] != rune('m') {
+							goto l2842
+						}
+						position++
+						goto l2841
+					l2842:
+						position, tokenIndex = position2841, tokenIndex2841
+						if buffer[position] != rune('M') {
+							goto l2838
+						}
+						position++
+					}
+				l2841:
+					{
+						position2843, tokenIndex2843 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2844
+						}
+						position++
+						goto l2843
+					l2844:
+						position, tokenIndex = position2843, tokenIndex2843
+						if buffer[position] != rune('A') {
+							goto l2838
+						}
+						position++
+					}
+				l2843:
+					{
+						position2845, tokenIndex2845 := position, tokenIndex
+						if buffer[position] != rune('p') {
+							goto l2846
+						}
+						position++
+						goto l2845
+					l2846:
+						position, tokenIndex = position2845, tokenIndex2845
+						if buffer[position] != rune('P') {
+							goto l2838
+						}
+						position++
+					}
+				l2845:
+					add(rulePegText, position2840)
+				}
+				if !_rules[ruleAction169]() {
+					goto l2838
+				}
+				add(ruleMap, position2839)
+			}
+			return true
+		l2838:
+			position, tokenIndex = position2838, tokenIndex2838
+			return false
+		},
+		/* 214 Or <- <(<(('o' / 'O') ('r' / 'R'))> Action170)> */
+		func() bool {
+			position2847, tokenIndex2847 := position, tokenIndex
+			{
+				position2848 := position
+				{
+					position2849 := position
+					{
+						position2850, tokenIndex2850 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2851
+						}
+						position++
+						goto l2850
+					l2851:
+						position, tokenIndex = position2850, tokenIndex2850
+						if buffer[position] != rune('O') {
+							goto l2847
+						}
+						position++
+					}
+				l2850:
+					{
+						position2852, tokenIndex2852 := position, tokenIndex
+						if buffer[position] != rune('r') {
+							goto l2853
+						}
+						position++
+						goto l2852
+					l2853:
+						position, tokenIndex = position2852, tokenIndex2852
+						if buffer[position] != rune('R') {
+							goto l2847
+						}
+						position++
+					}
+				l2852:
+					add(rulePegText, position2849)
+				}
+				if !_rules[ruleAction170]() {
+					goto l2847
+				}
+				add(ruleOr, position2848)
+			}
+			return true
+		l2847:
+			position, tokenIndex = position2847, tokenIndex2847
+			return false
+		},
+		/* 215 And <- <(<(('a' / 'A') ('n' / 'N') ('d' / 'D'))> Action171)> */
+		func() bool {
+			position2854, tokenIndex2854 := position, tokenIndex
+			{
+				position2855 := position
+				{
+					position2856 := position
+					{
+						position2857, tokenIndex2857 := position, tokenIndex
+						if buffer[position] != rune('a') {
+							goto l2858
+						}
+						position++
+						goto l2857
+					l2858:
+						position, tokenIndex = position2857, tokenIndex2857
+						if buffer[position] != rune('A') {
+							goto l2854
+						}
+						position++
+					}
+				l2857:
+					{
+						position2859, tokenIndex2859 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2860
+						}
+						position++
+						goto l2859
+					l2860:
+						position, tokenIndex = position2859, tokenIndex2859
+						if buffer[position] != rune('N') {
+							goto l2854
+						}
+						position++
+					}
+				l2859:
+					{
+						position2861, tokenIndex2861 := position, tokenIndex
+						if buffer[position] != rune('d') {
+							goto l2862
+						}
+						position++
+						goto l2861
+					l2862:
+						position, tokenIndex = position2861, tokenIndex2861
+						if buffer[position] != rune('D') {
+							goto l2854
+						}
+						position++
+					}
+				l2861:
+					add(rulePegText, position2856)
+				}
+				if !_rules[ruleAction171]() {
+					goto l2854
+				}
+				add(ruleAnd, position2855)
+			}
+			return true
+		l2854:
+			position, tokenIndex = position2854, tokenIndex2854
+			return false
+		},
+		/* 216 Not <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T'))> Action172)> */
+		func() bool {
+			position2863, tokenIndex2863 := position, tokenIndex
+			{
+				position2864 := position
+				{
+					position2865 := position
+					{
+						position2866, tokenIndex2866 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2867
+						}
+						position++
+						goto l2866
+					l2867:
+						position, tokenIndex = position2866, tokenIndex2866
+						if buffer[position] != rune('N') {
+							goto l2863
+						}
+						position++
+					}
+				l2866:
+					{
+						position2868, tokenIndex2868 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2869
+						}
+						position++
+						goto l2868
+					l2869:
+						position, tokenIndex = position2868, tokenIndex2868
+						if buffer[position] != rune('O') {
+							goto l2863
+						}
+						position++
+					}
+				l2868:
+					{
+						position2870, tokenIndex2870 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2871
+						}
+						position++
+						goto l2870
+					l2871:
+						position, tokenIndex = position2870, tokenIndex2870
+						if buffer[position] != rune('T') {
+							goto l2863
+						}
+						position++
+					}
+				l2870:
+					add(rulePegText, position2865)
+				}
+				if !_rules[ruleAction172]() {
+					goto l2863
+				}
+				add(ruleNot, position2864)
+			}
+			return true
+		l2863:
+			position, tokenIndex = position2863, tokenIndex2863
+			return false
+		},
+		/* 217 Equal <- <(<'='> Action173)> */
+		func() bool {
+			position2872, tokenIndex2872 := position, tokenIndex
+			{
+				position2873 := position
+				{
+					position2874 := position
+					if buffer[position] != rune('=') {
+						goto l2872
+					}
+					position++
+					add(rulePegText, position2874)
+				}
+				if !_rules[ruleAction173]() {
+					goto l2872
+				}
+				add(ruleEqual, position2873)
+			}
+			return true
+		l2872:
+			position, tokenIndex = position2872, tokenIndex2872
+			return false
+		},
+		/* 218 Less <- <(<'<'> Action174)> */
+		func() bool {
+			position2875, tokenIndex2875 := position, tokenIndex
+			{
+				position2876 := position
+				{
+					position2877 := position
+					if buffer[position] != rune('<') {
+						goto l2875
+					}
+					position++
+					add(rulePegText, position2877)
+				}
+				if !_rules[ruleAction174]() {
+					goto l2875
+				}
+				add(ruleLess, position2876)
+			}
+			return true
+		l2875:
+			position, tokenIndex = position2875, tokenIndex2875
+			return false
+		},
+		/* 219 LessOrEqual <- <(<('<' '=')> Action175)> */
+		func() bool {
+			position2878, tokenIndex2878 := position, tokenIndex
+			{
+				position2879 := position
+				{
+					position2880 := position
+					if buffer[position] != rune('<') {
+						goto l2878
+					}
+					position++
+					if buffer[position] != rune('=') {
+						goto l2878
+					}
+					position++
+					add(rulePegText, position2880)
+				}
+				if !_rules[ruleAction175]() {
+					goto l2878
+				}
+				add(ruleLessOrEqual, position2879)
+			}
+			return true
+		l2878:
+			position, tokenIndex = position2878, tokenIndex2878
+			return false
+		},
+		/* 220 Greater <- <(<'>'> Action176)> */
+		func() bool {
+			position2881, tokenIndex2881 := position, tokenIndex
+			{
+				position2882 := position
+				{
+					position2883 := position
+					if buffer[position] != rune('>') {
+						goto l2881
+					}
+					position++
+					add(rulePegText, position2883)
+				}
+				if !_rules[ruleAction176]() {
+					goto l2881
+				}
+				add(ruleGreater, position2882)
+			}
+			return true
+		l2881:
+			position, tokenIndex = position2881, tokenIndex2881
+			return false
+		},
+		/* 221 GreaterOrEqual <- <(<('>' '=')> Action177)> */
+		func() bool {
+			position2884, tokenIndex2884 := position, tokenIndex
+			{
+				position2885 := position
+				{
+					position2886 := position
+					if buffer[position] != rune('>') {
+						goto l2884
+					}
+					position++
+					if buffer[position] != rune('=') {
+						goto l2884
+					}
+					position++
+					add(rulePegText, position2886)
+				}
+				if !_rules[ruleAction177]() {
+					goto l2884
+				}
+				add(ruleGreaterOrEqual, position2885)
+			}
+			return true
+		l2884:
+			position, tokenIndex = position2884, tokenIndex2884
+			return false
+		},
+		/* 222 NotEqual <- <(<(('!' '=') / ('<' '>'))> Action178)> */
+		func() bool {
+			position2887, tokenIndex2887 := position, tokenIndex
+			{
+				position2888 := position
+				{
+					position2889 := position
+					{
+						position2890, tokenIndex2890 := position, tokenIndex
+						if buffer[position] != rune('!') {
+							goto l2891
+						}
+						position++
+						if buffer[position] != rune('=') {
+							goto l2891
+						}
+						position++
+						goto l2890
+					l2891:
+						position, tokenIndex = position2890, tokenIndex2890
+						if buffer[position] != rune('<') {
+							goto l2887
+						}
+						position++
+						if buffer[position] != rune('>') {
+							goto l2887
+						}
+						position++
+					}
+				l2890:
+					add(rulePegText, position2889)
+				}
+				if !_rules[ruleAction178]() {
+					goto l2887
+				}
+				add(ruleNotEqual, position2888)
+			}
+			return true
+		l2887:
+			position, tokenIndex = position2887, tokenIndex2887
+			return false
+		},
+		/* 223 Like <- <(<(('l' / 'L') ('i' / 'I') ('k' / 'K') ('e' / 'E'))> Action179)> */
+		func() bool {
+			position2892, tokenIndex2892 := position, tokenIndex
+			{
+				position2893 := position
+				{
+					position2894 := position
+					{
+						position2895, tokenIndex2895 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2896
+						}
+						position++
+						goto l2895
+					l2896:
+						position, tokenIndex = position2895, tokenIndex2895
+						if buffer[position] != rune('L') {
+							goto l2892
+						}
+						position++
+					}
+				l2895:
+					{
+						position2897, tokenIndex2897 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2898
+						}
+						position++
+						goto l2897
+					l2898:
+						position, tokenIndex = position2897, tokenIndex2897
+						if buffer[position] != rune('I') {
+							goto l2892
+						}
+						position++
+					}
+				l2897:
+					{
+						position2899, tokenIndex2899 := position, tokenIndex
+						if buffer[position] != rune('k') {
+							goto l2900
+						}
+						position++
+						goto l2899
+					l2900:
+						position, tokenIndex = position2899, tokenIndex2899
+						if buffer[position] != rune('K') {
+							goto l2892
+						}
+						position++
+					}
+				l2899:
+					{
+						position2901, tokenIndex2901 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2902
+						}
+						position++
+						goto l2901
+					l2902:
+						position, tokenIndex = position2901, tokenIndex2901
+						if buffer[position] != rune('E') {
+							goto l2892
+						}
+						position++
+					}
+				l2901:
+					add(rulePegText, position2894)
+				}
+				if !_rules[ruleAction179]() {
+					goto l2892
+				}
+				add(ruleLike, position2893)
+			}
+			return true
+		l2892:
+			position, tokenIndex = position2892, tokenIndex2892
+			return false
+		},
+		/* 224 NotLike <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T') sp (('l' / 'L') ('i' / 'I') ('k' / 'K') ('e' / 'E')))> Action180)> */
+		func() bool {
+			position2903, tokenIndex2903 := position, tokenIndex
+			{
+				position2904 := position
+				{
+					position2905 := position
+					{
+						position2906, tokenIndex2906 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2907
+						}
+						position++
+						goto l2906
+					l2907:
+						position, tokenIndex = position2906, tokenIndex2906
+						if buffer[position] != rune('N') {
+							goto l2903
+						}
+						position++
+					}
+				l2906:
+					{
+						position2908, tokenIndex2908 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2909
+						}
+						position++
+						goto l2908
+					l2909:
+						position, tokenIndex = position2908, tokenIndex2908
+						if buffer[position] != rune('O') {
+							goto l2903
+						}
+						position++
+					}
+				l2908:
+					{
+						position2910, tokenIndex2910 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2911
+						}
+						position++
+						goto l2910
+					l2911:
+						position, tokenIndex = position2910, tokenIndex2910
+						if buffer[position] != rune('T') {
+							goto l2903
+						}
+						position++
+					}
+				l2910:
+					if !_rules[rulesp]() {
+						goto l2903
+					}
+					{
+						position2912, tokenIndex2912 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2913
+						}
+						position++
+						goto l2912
+					l2913:
+						position, tokenIndex = position2912, tokenIndex2912
+						if buffer[position] != rune('L') {
+							goto l2903
+						}
+						position++
+					}
+				l2912:
+					{
+						position2914, tokenIndex2914 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2915
+						}
+						position++
+						goto l2914
+					l2915:
+						position, tokenIndex = position2914, tokenIndex2914
+						if buffer[position] != rune('I') {
+							goto l2903
+						}
+						position++
+					}
+				l2914:
+					{
+						position2916, tokenIndex2916 := position, tokenIndex
+						if buffer[position] != rune('k') {
+							goto l2917
+						}
+						position++
+						goto l2916
+					l2917:
+						position, tokenIndex = position2916, tokenIndex2916
+						if buffer[position] != rune('K') {
+							goto l2903
+						}
+						position++
+					}
+				l2916:
+					{
+						position2918, tokenIndex2918 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2919
+						}
+						position++
+						goto l2918
+					l2919:
+						position, tokenIndex = position2918, tokenIndex2918
+						if buffer[position] != rune('E') {
+							goto l2903
+						}
+						position++
+					}
+				l2918:
+					add(rulePegText, position2905)
+				}
+				if !_rules[ruleAction180]() {
+					goto l2903
+				}
+				add(ruleNotLike, position2904)
+			}
+			return true
+		l2903:
+			position, tokenIndex = position2903, tokenIndex2903
+			return false
+		},
+		/* 225 ILike <- <(<(('i' / 'I') ('l' / 'L') ('i' / 'I') ('k' / 'K') ('e' / 'E'))> Action181)> */
+		func() bool {
+			position2920, tokenIndex2920 := position, tokenIndex
+			{
+				position2921 := position
+				{
+					position2922 := position
+					{
+						position2923, tokenIndex2923 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2924
+						}
+						position++
+						goto l2923
+					l2924:
+						position, tokenIndex = position2923, tokenIndex2923
+						if buffer[position] != rune('I') {
+							goto l2920
+						}
+						position++
+					}
+				l2923:
+					{
+						position2925, tokenIndex2925 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2926
+						}
+						position++
+						goto l2925
+					l2926:
+						position, tokenIndex = position2925, tokenIndex2925
+						if buffer[position] != rune('L') {
+							goto l2920
+						}
+						position++
+					}
+				l2925:
+					{
+						position2927, tokenIndex2927 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2928
+						}
+						position++
+						goto l2927
+					l2928:
+						position, tokenIndex = position2927, tokenIndex2927
+						if buffer[position] != rune('I') {
+							goto l2920
+						}
+						position++
+					}
+				l2927:
+					{
+						position2929, tokenIndex2929 := position, tokenIndex
+						if buffer[position] != rune('k') {
+							goto l2930
+						}
+						position++
+						goto l2929
+					l2930:
+						position, tokenIndex = position2929, tokenIndex2929
+						if buffer[position] != rune('K') {
+							goto l2920
+						}
+						position++
+					}
+				l2929:
+					{
+						position2931, tokenIndex2931 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2932
+						}
+						position++
+						goto l2931
+					l2932:
+						position, tokenIndex = position2931, tokenIndex2931
+						if buffer[position] != rune('E') {
+							goto l2920
+						}
+						position++
+					}
+				l2931:
+					add(rulePegText, position2922)
+				}
+				if !_rules[ruleAction181]() {
+					goto l2920
+				}
+				add(ruleILike, position2921)
+			}
+			return true
+		l2920:
+			position, tokenIndex = position2920, tokenIndex2920
+			return false
+		},
+		/* 226 NotILike <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T') sp (('i' / 'I') ('l' / 'L') ('i' / 'I') ('k' / 'K') ('e' / 'E')))> Action182)> */
+		func() bool {
+			position2933, tokenIndex2933 := position, tokenIndex
+			{
+				position2934 := position
+				{
+					position2935 := position
+					{
+						position2936, tokenIndex2936 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2937
+						}
+						position++
+						goto l2936
+					l2937:
+						position, tokenIndex = position2936, tokenIndex2936
+						if buffer[position] != rune('N') {
+							goto l2933
+						}
+						position++
+					}
+				l2936:
+					{
+						position2938, tokenIndex2938 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2939
+						}
+						position++
+						goto l2938
+					l2939:
+						position, tokenIndex = position2938, tokenIndex2938
+						if buffer[position] != rune('O') {
+							goto l2933
+						}
+						position++
+					}
+				l2938:
+					{
+						position2940, tokenIndex2940 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2941
+						}
+						position++
+						goto l2940
+					l2941:
+						position, tokenIndex = position2940, tokenIndex2940
+						if buffer[position] != rune('T') {
+							goto l2933
+						}
+						position++
+					}
+				l2940:
+					if !_rules[rulesp]() {
+						goto l2933
+					}
+					{
+						position2942, tokenIndex2942 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2943
+						}
+						position++
+						goto l2942
+					l2943:
+						position, tokenIndex = position2942, tokenIndex2942
+						if buffer[position] != rune('I') {
+							goto l2933
+						}
+						position++
+					}
+				l2942:
+					{
+						position2944, tokenIndex2944 := position, tokenIndex
+						if buffer[position] != rune('l') {
+							goto l2945
+						}
+						position++
+						goto l2944
+					l2945:
+						position, tokenIndex = position2944, tokenIndex2944
+						if buffer[position] != rune('L') {
+							goto l2933
+						}
+						position++
+					}
+				l2944:
+					{
+						position2946, tokenIndex2946 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2947
+						}
+						position++
+						goto l2946
+					l2947:
+						position, tokenIndex = position2946, tokenIndex2946
+						if buffer[position] != rune('I') {
+							goto l2933
+						}
+						position++
+					}
+				l2946:
+					{
+						position2948, tokenIndex2948 := position, tokenIndex
+						if buffer[position] != rune('k') {
+							goto l2949
+						}
+						position++
+						goto l2948
+					l2949:
+						position, tokenIndex = position2948, tokenIndex2948
+						if buffer[position] != rune('K') {
+							goto l2933
+						}
+						position++
+					}
+				l2948:
+					{
+						position2950, tokenIndex2950 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2951
+						}
+						position++
+						goto l2950
+					l2951:
+						position, tokenIndex = position2950, tokenIndex2950
+						if buffer[position] != rune('E') {
+							goto l2933
+						}
+						position++
+					}
+				l2950:
+					add(rulePegText, position2935)
+				}
+				if !_rules[ruleAction182]() {
+					goto l2933
+				}
+				add(ruleNotILike, position2934)
+			}
+			return true
+		l2933:
+			position, tokenIndex = position2933, tokenIndex2933
+			return false
+		},
+		/* 227 RegexMatch <- <(<'~'> Action183)> */
+		func() bool {
+			position2952, tokenIndex2952 := position, tokenIndex
+			{
+				position2953 := position
+				{
+					position2954 := position
+					if buffer[position] != rune('~') {
+						goto l2952
+					}
+					position++
+					add(rulePegText, position2954)
+				}
+				if !_rules[ruleAction183]() {
+					goto l2952
+				}
+				add(ruleRegexMatch, position2953)
+			}
+			return true
+		l2952:
+			position, tokenIndex = position2952, tokenIndex2952
+			return false
+		},
+		/* 228 NotRegexMatch <- <(<('!' '~')> Action184)> */
+		func() bool {
+			position2955, tokenIndex2955 := position, tokenIndex
+			{
+				position2956 := position
+				{
+					position2957 := position
+					if buffer[position] != rune('!') {
+						goto l2955
+					}
+					position++
+					if buffer[position] != rune('~') {
+						goto l2955
+					}
+					position++
+					add(rulePegText, position2957)
+				}
+				if !_rules[ruleAction184]() {
+					goto l2955
+				}
+				add(ruleNotRegexMatch, position2956)
+			}
+			return true
+		l2955:
+			position, tokenIndex = position2955, tokenIndex2955
+			return false
+		},
+		/* 229 In <- <(<(('i' / 'I') ('n' / 'N'))> Action185)> */
+		func() bool {
+			position2958, tokenIndex2958 := position, tokenIndex
+			{
+				position2959 := position
+				{
+					position2960 := position
+					{
+						position2961, tokenIndex2961 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2962
+						}
+						position++
+						goto l2961
+					l2962:
+						position, tokenIndex = position2961, tokenIndex2961
+						if buffer[position] != rune('I') {
+							goto l2958
+						}
+						position++
+					}
+				l2961:
+					{
+						position2963, tokenIndex2963 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2964
+						}
+						position++
+						goto l2963
+					l2964:
+						position, tokenIndex = position2963, tokenIndex2963
+						if buffer[position] != rune('N') {
+							goto l2958
+						}
+						position++
+					}
+				l2963:
+					add(rulePegText, position2960)
+				}
+				if !_rules[ruleAction185]() {
+					goto l2958
+				}
+				add(ruleIn, position2959)
+			}
+			return true
+		l2958:
+			position, tokenIndex = position2958, tokenIndex2958
+			return false
+		},
+		/* 230 NotIn <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T') sp (('i' / 'I') ('n' / 'N')))> Action186)> */
+		func() bool {
+			position2965, tokenIndex2965 := position, tokenIndex
+			{
+				position2966 := position
+				{
+					position2967 := position
+					{
+						position2968, tokenIndex2968 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2969
+						}
+						position++
+						goto l2968
+					l2969:
+						position, tokenIndex = position2968, tokenIndex2968
+						if buffer[position] != rune('N') {
+							goto l2965
+						}
+						position++
+					}
+				l2968:
+					{
+						position2970, tokenIndex2970 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l2971
+						}
+						position++
+						goto l2970
+					l2971:
+						position, tokenIndex = position2970, tokenIndex2970
+						if buffer[position] != rune('O') {
+							goto l2965
+						}
+						position++
+					}
+				l2970:
+					{
+						position2972, tokenIndex2972 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2973
+						}
+						position++
+						goto l2972
+					l2973:
+						position, tokenIndex = position2972, tokenIndex2972
+						if buffer[position] != rune('T') {
+							goto l2965
+						}
+						position++
+					}
+				l2972:
+					if !_rules[rulesp]() {
+						goto l2965
+					}
+					{
+						position2974, tokenIndex2974 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l2975
+						}
+						position++
+						goto l2974
+					l2975:
+						position, tokenIndex = position2974, tokenIndex2974
+						if buffer[position] != rune('I') {
+							goto l2965
+						}
+						position++
+					}
+				l2974:
+					{
+						position2976, tokenIndex2976 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2977
+						}
+						position++
+						goto l2976
+					l2977:
+						position, tokenIndex = position2976, tokenIndex2976
+						if buffer[position] != rune('N') {
+							goto l2965
+						}
+						position++
+					}
+				l2976:
+					add(rulePegText, position2967)
+				}
+				if !_rules[ruleAction186]() {
+					goto l2965
+				}
+				add(ruleNotIn, position2966)
+			}
+			return true
+		l2965:
+			position, tokenIndex = position2965, tokenIndex2965
+			return false
+		},
+		/* 231 Between <- <(<(('b' / 'B') ('e' / 'E') ('t' / 'T') ('w' / 'W') ('e' / 'E') ('e' / 'E') ('n' / 'N'))> Action187)> */
+		func() bool {
+			position2978, tokenIndex2978 := position, tokenIndex
+			{
+				position2979 := position
+				{
+					position2980 := position
+					{
+						position2981, tokenIndex2981 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l2982
+						}
+						position++
+						goto l2981
+					l2982:
+						position, tokenIndex = position2981, tokenIndex2981
+						if buffer[position] != rune('B') {
+							goto l2978
+						}
+						position++
+					}
+				l2981:
+					{
+						position2983, tokenIndex2983 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2984
+						}
+						position++
+						goto l2983
+					l2984:
+						position, tokenIndex = position2983, tokenIndex2983
+						if buffer[position] != rune('E') {
+							goto l2978
+						}
+						position++
+					}
+				l2983:
+					{
+						position2985, tokenIndex2985 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l2986
+						}
+						position++
+						goto l2985
+					l2986:
+						position, tokenIndex = position2985, tokenIndex2985
+						if buffer[position] != rune('T') {
+							goto l2978
+						}
+						position++
+					}
+				l2985:
+					{
+						position2987, tokenIndex2987 := position, tokenIndex
+						if buffer[position] != rune('w') {
+							goto l2988
+						}
+						position++
+						goto l2987
+					l2988:
+						position, tokenIndex = position2987, tokenIndex2987
+						if buffer[position] != rune('W') {
+							goto l2978
+						}
+						position++
+					}
+				l2987:
+					{
+						position2989, tokenIndex2989 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2990
+						}
+						position++
+						goto l2989
+					l2990:
+						position, tokenIndex = position2989, tokenIndex2989
+						if buffer[position] != rune('E') {
+							goto l2978
+						}
+						position++
+					}
+				l2989:
+					{
+						position2991, tokenIndex2991 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l2992
+						}
+						position++
+						goto l2991
+					l2992:
+						position, tokenIndex = position2991, tokenIndex2991
+						if buffer[position] != rune('E') {
+							goto l2978
+						}
+						position++
+					}
+				l2991:
+					{
+						position2993, tokenIndex2993 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2994
+						}
+						position++
+						goto l2993
+					l2994:
+						position, tokenIndex = position2993, tokenIndex2993
+						if buffer[position] != rune('N') {
+							goto l2978
+						}
+						position++
+					}
+				l2993:
+					add(rulePegText, position2980)
+				}
+				if !_rules[ruleAction187]() {
+					goto l2978
+				}
+				add(ruleBetween, position2979)
+			}
+			return true
+		l2978:
+			position, tokenIndex = position2978, tokenIndex2978
+			return false
+		},
+		/* 232 NotBetween <- <(<(('n' / 'N') ('o' / 'O') ('t' / 'T') sp (('b' / 'B') ('e' / 'E') ('t' / 'T') ('w' / 'W') ('e' / 'E') ('e' / 'E') ('n' / 'N')))> Action188)> */
+		func() bool {
+			position2995, tokenIndex2995 := position, tokenIndex
+			{
+				position2996 := position
+				{
+					position2997 := position
+					{
+						position2998, tokenIndex2998 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l2999
+						}
+						position++
+						goto l2998
+					l2999:
+						position, tokenIndex = position2998, tokenIndex2998
+						if buffer[position] != rune('N') {
+							goto l2995
+						}
+						position++
+					}
+				l2998:
+					{
+						position3000, tokenIndex3000 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l3001
+						}
+						position++
+						goto l3000
+					l3001:
+						position, tokenIndex = position3000, tokenIndex3000
+						if buffer[position] != rune('O') {
+							goto l2995
+						}
+						position++
+					}
+				l3000:
+					{
+						position3002, tokenIndex3002 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l3003
+						}
+						position++
+						goto l3002
+					l3003:
+						position, tokenIndex = position3002, tokenIndex3002
+						if buffer[position] != rune('T') {
+							goto l2995
+						}
+						position++
+					}
+				l3002:
+					if !_rules[rulesp]() {
+						goto l2995
+					}
+					{
+						position3004, tokenIndex3004 := position, tokenIndex
+						if buffer[position] != rune('b') {
+							goto l3005
+						}
+						position++
+						goto l3004
+					l3005:
+						position, tokenIndex = position3004, tokenIndex3004
+						if buffer[position] != rune('B') {
+							goto l2995
+						}
+						position++
+					}
+				l3004:
+					{
+						position3006, tokenIndex3006 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l3007
+						}
+						position++
+						goto l3006
+					l3007:
+						position, tokenIndex = position3006, tokenIndex3006
+						if buffer[position] != rune('E') {
+							goto l2995
+						}
+						position++
+					}
+				l3006:
+					{
+						position3008, tokenIndex3008 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l3009
+						}
+						position++
+						goto l3008
+					l3009:
+						position, tokenIndex = position3008, tokenIndex3008
+						if buffer[position] != rune('T') {
+							goto l2995
+						}
+						position++
+					}
+				l3008:
+					{
+						position3010, tokenIndex3010 := position, tokenIndex
+						if buffer[position] != rune('w') {
+							goto l3011
+						}
+						position++
+						goto l3010
+					l3011:
+						position, tokenIndex = position3010, tokenIndex3010
+						if buffer[position] != rune('W') {
+							goto l2995
+						}
+						position++
+					}
+				l3010:
+					{
+						position3012, tokenIndex3012 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l3013
+						}
+						position++
+						goto l3012
+					l3013:
+						position, tokenIndex = position3012, tokenIndex3012
+						if buffer[position] != rune('E') {
+							goto l2995
+						}
+						position++
+					}
+				l3012:
+					{
+						position3014, tokenIndex3014 := position, tokenIndex
+						if buffer[position] != rune('e') {
+							goto l3015
+						}
+						position++
+						goto l3014
+					l3015:
+						position, tokenIndex = position3014, tokenIndex3014
+						if buffer[position] != rune('E') {
+							goto l2995
+						}
+						position++
+					}
+				l3014:
+					{
+						position3016, tokenIndex3016 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l3017
+						}
+						position++
+						goto l3016
+					l3017:
+						position, tokenIndex = position3016, tokenIndex3016
+						if buffer[position] != rune('N') {
+							goto l2995
+						}
+						position++
+					}
+				l3016:
+					add(rulePegText, position2997)
+				}
+				if !_rules[ruleAction188]() {
+					goto l2995
+				}
+				add(ruleNotBetween, position2996)
+			}
+			return true
+		l2995:
+			position, tokenIndex = position2995, tokenIndex2995
+			return false
+		},
+		/* 233 Concat <- <(<('|' '|')> Action189)> */
+		func() bool {
+			position3018, tokenIndex3018 := position, tokenIndex
+			{
+				position3019 := position
+				{
+					position3020 := position
+					if buffer[position] != rune('|') {
+						goto l3018
+					}
+					position++
+					if buffer[position] != rune('|') {
+						goto l3018
+					}
+					position++
+					add(rulePegText, position3020)
+				}
+				if !_rules[ruleAction189]() {
+					goto l3018
+				}
+				add(ruleConcat, position3019)
+			}
+			return true
+		l3018:
+			position, tokenIndex = position3018, tokenIndex3018
+			return false
+		},
+		/* 234 Is <- <(<(('i' / 'I') ('s' / 'S'))> Action190)> */
+		func() bool {
+			position3021, tokenIndex3021 := position, tokenIndex
+			{
+				position3022 := position
+				{
+					position3023 := position
+					{
+						position3024, tokenIndex3024 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l3025
+						}
+						position++
+						goto l3024
+					l3025:
+						position, tokenIndex = position3024, tokenIndex3024
+						if buffer[position] != rune('I') {
+							goto l3021
+						}
+						position++
+					}
+				l3024:
+					{
+						position3026, tokenIndex3026 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l3027
+						}
+						position++
+						goto l3026
+					l3027:
+						position, tokenIndex = position3026, tokenIndex3026
+						if buffer[position] != rune('S') {
+							goto l3021
+						}
+						position++
+					}
+				l3026:
+					add(rulePegText, position3023)
+				}
+				if !_rules[ruleAction190]() {
+					goto l3021
+				}
+				add(ruleIs, position3022)
+			}
+			return true
+		l3021:
+			position, tokenIndex = position3021, tokenIndex3021
+			return false
+		},
+		/* 235 IsNot <- <(<(('i' / 'I') ('s' / 'S') sp (('n' / 'N') ('o' / 'O') ('t' / 'T')))> Action191)> */
+		func() bool {
+			position3028, tokenIndex3028 := position, tokenIndex
+			{
+				position3029 := position
+				{
+					position3030 := position
+					{
+						position3031, tokenIndex3031 := position, tokenIndex
+						if buffer[position] != rune('i') {
+							goto l3032
+						}
+						position++
+						goto l3031
+					l3032:
+						position, tokenIndex = position3031, tokenIndex3031
+						if buffer[position] != rune('I') {
+							goto l3028
+						}
+						position++
+					}
+				l3031:
+					{
+						position3033, tokenIndex3033 := position, tokenIndex
+						if buffer[position] != rune('s') {
+							goto l3034
+						}
+						position++
+						goto l3033
+					l3034:
+						position, tokenIndex = position3033, tokenIndex3033
+						if buffer[position] != rune('S') {
+							goto l3028
+						}
+						position++
+					}
+				l3033:
+					if !_rules[rulesp]() {
+						goto l3028
+					}
+					{
+						position3035, tokenIndex3035 := position, tokenIndex
+						if buffer[position] != rune('n') {
+							goto l3036
+						}
+						position++
+						goto l3035
+					l3036:
+						position, tokenIndex = position3035, tokenIndex3035
+						if buffer[position] != rune('N') {
+							goto l3028
+						}
+						position++
+					}
+				l3035:
+					{
+						position3037, tokenIndex3037 := position, tokenIndex
+						if buffer[position] != rune('o') {
+							goto l3038
+						}
+						position++
+						goto l3037
+					l3038:
+						position, tokenIndex = position3037, tokenIndex3037
+						if buffer[position] != rune('O') {
+							goto l3028
+						}
+						position++
+					}
+				l3037:
+					{
+						position3039, tokenIndex3039 := position, tokenIndex
+						if buffer[position] != rune('t') {
+							goto l3040
+						}
+						position++
+						goto l3039
+					l3040:
+						position, tokenIndex = position3039, tokenIndex3039
+						if buffer[position] != rune('T') {
+							goto l3028
+						}
+						position++
+					}
+				l3039:
+					add(rulePegText, position3030)
+				}
+				if !_rules[ruleAction191]() {
+					goto l3028
+				}
+				add(ruleIsNot, position3029)
+			}
+			return true
+		l3028:
+			position, tokenIndex = position3028, tokenIndex3028
+			return false
+		},
+		/* 236 Plus <- <(<'+'> Action192)> */
+		func() bool {
+			position3041, tokenIndex3041 := position, tokenIndex
+			{
+				position3042 := position
+				{
+					position3043 := position
+					if buffer[position] != rune('+') {
+						goto l3041
+					}
+					position++
+					add(rulePegText, position3043)
+				}
+				if !_rules[ruleAction192]() {
+					goto l3041
+				}
+				add(rulePlus, position3042)
+			}
+			return true
+		l3041:
+			position, tokenIndex = position3041, tokenIndex3041
+			return false
+		},
+		/* 237 Minus <- <(<'-'> Action193)> */
+		func() bool {
+			position3044, tokenIndex3044 := position, tokenIndex
+			{
+				position3045 := position
+				{
+					position3046 := position
+					if buffer[position] != rune('-') {
+						goto l3044
+					}
+					position++
+					add(rulePegText, position3046)
+				}
+				if !_rules[ruleAction193]() {
+					goto l3044
+				}
+				add(ruleMinus, position3045)
+			}
+			return true
+		l3044:
+			position, tokenIndex = position3044, tokenIndex3044
+			return false
+		},
+		/* 238 Multiply <- <(<'*'> Action194)> */
+		func() bool {
+			position3047, tokenIndex3047 := position, tokenIndex
+			{
+				position3048 := position
+				{
+					position3049 := position
+					if buffer[position] != rune('*') {
+						goto l3047
+					}
+					position++
+					add(rulePegText, position3049)
+				}
+				if !_rules[ruleAction194]() {
+					goto l3047
+				}
+				add(ruleMultiply, position3048)
+			}
+			return true
+		l3047:
+			position, tokenIndex = position3047, tokenIndex3047
+			return false
+		},
+		/* 239 Divide <- <(<'/'> Action195)> */
+		func() bool {
+			position3050, tokenIndex3050 := position, tokenIndex
+			{
+				position3051 := position
+				{
+					position3052 := position
+					if buffer[position] != rune('/') {
+						goto l3050
+					}
+					position++
+					add(rulePegText, position3052)
+				}
+				if !_rules[ruleAction195]() {
+					goto l3050
+				}
+				add(ruleDivide, position3051)
+			}
+			return true
+		l3050:
+			position, tokenIndex = position3050, tokenIndex3050
+			return false
+		},
+		/* 240 Modulo <- <(<'%'> Action196)> */
+		func() bool {
+			position3053, tokenIndex3053 := position, tokenIndex
+			{
+				position3054 := position
+				{
+					position3055 := position
+					if buffer[position] != rune('%') {
+						goto l3053
+					}
+					position++
+					add(rulePegText, position3055)
+				}
+				if !_rules[ruleAction196]() {
+					goto l3053
+				}
+				add(ruleModulo, position3054)
+			}
+			return true
+		l3053:
+			position, tokenIndex = position3053, tokenIndex3053
+			return false
+		},
+		/* 241 UnaryMinus <- <(<'-'> Action197)> */
+		func() bool {
+			position3056, tokenIndex3056 := position, tokenIndex
+			{
+				position3057 := position
+				{
+					position3058 := position
+					if buffer[position] != rune('-') {
+						goto l3056
+					}
+					position++
+					add(rulePegText, position3058)
+				}
+				if !_rules[ruleAction197]() {
+					goto l3056
+				}
+				add(ruleUnaryMinus, position3057)
+			}
+			return true
+		l3056:
+			position, tokenIndex = position3056, tokenIndex3056
+			return false
+		},
+		/* 242 Identifier <- <(<ident> Action198)> */
+		func() bool {
+			position3059, tokenIndex3059 := position, tokenIndex
+			{
+				position3060 := position
+				{
+					position3061 := position
+					if !_rules[ruleident]() {
+						goto l3059
+					}
+					add(rulePegText, position3061)
+				}
+				if !_rules[ruleAction198]() {
+					goto l3059
+				}
+				add(ruleIdentifier, position3060)
+			}
+			return true
+		l3059:
+			position, tokenIndex = position3059, tokenIndex3059
+			return false
+		},
+		/* 243 TargetIdentifier <- <(<('*' / jsonSetPath)> Action199)> */
+		func() bool {
+			position3062, tokenIndex3062 := position, tokenIndex
+			{
+				position3063 := position
+				{
+					position3064 := position
+					{
+						position3065, tokenIndex3065 := position, tokenIndex
+						if buffer[position] != rune('*') {
+							goto l3066
+						}
+						position++
+						goto l3065
+					l3066:
+						position, tokenIndex = position3065, tokenIndex3065
+						if !_rules[rulejsonSetPath]() {
+							goto l3062
+						}
+					}
+				l3065:
+					add(rulePegText, position3064)
+				}
+				if !_rules[ruleAction199]() {
+					goto l3062
+				}
+				add(ruleTargetIdentifier, position3063)
+			}
+			return true
+		l3062:
+			position, tokenIndex = position3062, tokenIndex3062
+			return false
+		},
+		/* 244 ident <- <(([a-z] / [A-Z]) ([a-z] / [A-Z] / [0-9] / '_')*)> */
+		func() bool {
+			position3067, tokenIndex3067 := position, tokenIndex
+			{
+				position3068 := position
+				{
+					position3069, tokenIndex3069 := position, tokenIndex
+					if c := buffer[position]; c < rune('a') || c > rune('z') {
+						goto l3070
+					}
+					position++
+					goto l3069
+				l3070:
+					position, tokenIndex = position3069, tokenIndex3069
+					if c := buffer[position]; c < rune('A') || c > rune('Z') {
+						goto l3067
+					}
+					position++
+				}
+			l3069:
+			l3071:
+				{
+					position3072, tokenIndex3072 := position, tokenIndex
+					{
+						position3073, tokenIndex3073 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l3074
+						}
+						position++
+						goto l3073
+					l3074:
+						position, tokenIndex = position3073, tokenIndex3073
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l3075
+						}
+						position++
+						goto l3073
+					l3075:
+						position, tokenIndex = position3073, tokenIndex3073
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l3076
+						}
+						position++
+						goto l3073
+					l3076:
+						position, tokenIndex = position3073, tokenIndex3073
+						if buffer[position] != rune('_') {
+							goto l3072
+						}
+						position++
+					}
+				l3073:
+					goto l3071
+				l3072:
+					position, tokenIndex = position3072, tokenIndex3072
+				}
+				add(ruleident, position3068)
+			}
+			return true
+		l3067:
+			position, tokenIndex = position3067, tokenIndex3067
+			return false
+		},
+		/* 245 jsonGetPath <- <(jsonPathHead jsonGetPathNonHead*)> */
+		func() bool {
+			position3077, tokenIndex3077 := position, tokenIndex
+			{
+				position3078 := position
+				if !_rules[rulejsonPathHead]() {
+					goto l3077
+				}
+			l3079:
+				{
+					position3080, tokenIndex3080 := position, tokenIndex
+					if !_rules[rulejsonGetPathNonHead]() {
+						goto l3080
+					}
+					goto l3079
+				l3080:
+					position, tokenIndex = position3080, tokenIndex3080
+				}
+				add(rulejsonGetPath, position3078)
+			}
+			return true
+		l3077:
+			position, tokenIndex = position3077, tokenIndex3077
+			return false
+		},
+		/* 246 jsonSetPath <- <(jsonPathHead jsonSetPathNonHead*)> */
+		func() bool {
+			position3081, tokenIndex3081 := position, tokenIndex
+			{
+				position3082 := position
+				if !_rules[rulejsonPathHead]() {
+					goto l3081
+				}
+			l3083:
+				{
+					position3084, tokenIndex3084 := position, tokenIndex
+					if !_rules[rulejsonSetPathNonHead]() {
+						goto l3084
+					}
+					goto l3083
+				l3084:
+					position, tokenIndex = position3084, tokenIndex3084
+				}
+				add(rulejsonSetPath, position3082)
+			}
+			return true
+		l3081:
+			position, tokenIndex = position3081, tokenIndex3081
+			return false
+		},
+		/* 247 jsonPathHead <- <(jsonMapAccessString / jsonMapAccessBracket)> */
+		func() bool {
+			position3085, tokenIndex3085 := position, tokenIndex
+			{
+				position3086 := position
+				{
+					position3087, tokenIndex3087 := position, tokenIndex
+					if !_rules[rulejsonMapAccessString]() {
+						goto l3088
+					}
+					goto l3087
+				l3088:
+					position, tokenIndex = position3087, tokenIndex3087
+					if !_rules[rulejsonMapAccessBracket]() {
+						goto l3085
+					}
+				}
+			l3087:
+				add(rulejsonPathHead, position3086)
+			}
+			return true
+		l3085:
+			position, tokenIndex = position3085, tokenIndex3085
+			return false
+		},
+		/* 248 jsonGetPathNonHead <- <(jsonMapMultipleLevel / jsonMapSingleLevel / jsonArrayFullSlice / jsonArrayPartialSlice / jsonArraySlice / jsonArrayAccess)> */
+		func() bool {
+			position3089, tokenIndex3089 := position, tokenIndex
+			{
+				position3090 := position
+				{
+					position3091, tokenIndex3091 := position, tokenIndex
+					if !_rules[rulejsonMapMultipleLevel]() {
+						goto l3092
+					}
+					goto l3091
+				l3092:
+					position, tokenIndex = position3091, tokenIndex3091
+					if !_rules[rulejsonMapSingleLevel]() {
+						goto l3093
+					}
+					goto l3091
+				l3093:
+					position, tokenIndex = position3091, tokenIndex3091
+					if !_rules[rulejsonArrayFullSlice]() {
+						goto l3094
+					}
+					goto l3091
+				l3094:
+					position, tokenIndex = position3091, tokenIndex3091
+					if !_rules[rulejsonArrayPartialSlice]() {
+						goto l3095
+					}
+					goto l3091
+				l3095:
+					position, tokenIndex = position3091, tokenIndex3091
+					if !_rules[rulejsonArraySlice]() {
+						goto l3096
+					}
+					goto l3091
+				l3096:
+					position, tokenIndex = position3091, tokenIndex3091
+					if !_rules[rulejsonArrayAccess]() {
+						goto l3089
+					}
+				}
+			l3091:
+				add(rulejsonGetPathNonHead, position3090)
+			}
+			return true
+		l3089:
+			position, tokenIndex = position3089, tokenIndex3089
+			return false
+		},
+		/* 249 jsonSetPathNonHead <- <(jsonMapSingleLevel / jsonNonNegativeArrayAccess)> */
+		func() bool {
+			position3097, tokenIndex3097 := position, tokenIndex
+			{
+				position3098 := position
+				{
+					position3099, tokenIndex3099 := position, tokenIndex
+					if !_rules[rulejsonMapSingleLevel]() {
+						goto l3100
+					}
+					goto l3099
+				l3100:
+					position, tokenIndex = position3099, tokenIndex3099
+					if !_rules[rulejsonNonNegativeArrayAccess]() {
+						goto l3097
+					}
+				}
+			l3099:
+				add(rulejsonSetPathNonHead, position3098)
+			}
+			return true
+		l3097:
+			position, tokenIndex = position3097, tokenIndex3097
+			return false
+		},
+		/* 250 jsonMapSingleLevel <- <(('.' jsonMapAccessString) / jsonMapAccessBracket)> */
+		func() bool {
+			position3101, tokenIndex3101 := position, tokenIndex
+			{
+				position3102 := position
+				{
+					position3103, tokenIndex3103 := position, tokenIndex
+					if buffer[position] != rune('.') {
+						goto l3104
+					}
+					position++
+					if !_rules[rulejsonMapAccessString]() {
+						goto l3104
+					}
+					goto l3103
+				l3104:
+					position, tokenIndex = position3103, tokenIndex3103
+					if !_rules[rulejsonMapAccessBracket]() {
+						goto l3101
+					}
+				}
+			l3103:
+				add(rulejsonMapSingleLevel, position3102)
+			}
+			return true
+		l3101:
+			position, tokenIndex = position3101, tokenIndex3101
+			return false
+		},
+		/* 251 jsonMapMultipleLevel <- <('.' '.' (jsonMapAccessString / jsonMapAccessBracket))> */
+		func() bool {
+			position3105, tokenIndex3105 := position, tokenIndex
+			{
+				position3106 := position
+				if buffer[position] != rune('.') {
+					goto l3105
+				}
+				position++
+				if buffer[position] != rune('.') {
+					goto l3105
+				}
+				position++
+				{
+					position3107, tokenIndex3107 := position, tokenIndex
+					if !_rules[rulejsonMapAccessString]() {
+						goto l3108
+					}
+					goto l3107
+				l3108:
+					position, tokenIndex = position3107, tokenIndex3107
+					if !_rules[rulejsonMapAccessBracket]() {
+						goto l3105
+					}
+				}
+			l3107:
+				add(rulejsonMapMultipleLevel, position3106)
+			}
+			return true
+		l3105:
+			position, tokenIndex = position3105, tokenIndex3105
+			return false
+		},
+		/* 252 jsonMapAccessString <- <<(([a-z] / [A-Z]) ([a-z] / [A-Z] / [0-9] / '_')*)>> */
+		func() bool {
+			position3109, tokenIndex3109 := position, tokenIndex
+			{
+				position3110 := position
+				{
+					position3111 := position
+					{
+						position3112, tokenIndex3112 := position, tokenIndex
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l3113
+						}
+						position++
+						goto l3112
+					l3113:
+						position, tokenIndex = position3112, tokenIndex3112
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l3109
+						}
+						position++
+					}
+				l3112:
+				l3114:
+					{
+						position3115, tokenIndex3115 := position, tokenIndex
+						{
+							position3116, tokenIndex3116 := position, tokenIndex
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								goto l3117
+							}
+							position++
+							goto l3116
+						l3117:
+							position, tokenIndex = position3116, tokenIndex3116
+							if c := buffer[position]; c < rune('A') || c > rune('Z') {
+								goto l3118
+							}
+							position++
+							goto l3116
+						l3118:
+							position, tokenIndex = position3116, tokenIndex3116
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l3119
+							}
+							position++
+							goto l3116
+						l3119:
+							position, tokenIndex = position3116, tokenIndex3116
+							if buffer[position] != rune('_') {
+								goto l3115
+							}
+							position++
+						}
+					l3116:
+						goto l3114
+					l3115:
+						position, tokenIndex = position3115, tokenIndex3115
+					}
+					add(rulePegText, position3111)
+				}
+				add(rulejsonMapAccessString, position3110)
+			}
+			return true
+		l3109:
+			position, tokenIndex = position3109, tokenIndex3109
+			return false
+		},
+		/* 253 jsonMapAccessBracket <- <('[' doubleQuotedString ']')> */
+		func() bool {
+			position3120, tokenIndex3120 := position, tokenIndex
+			{
+				position3121 := position
+				if buffer[position] != rune('[') {
+					goto l3120
+				}
+				position++
+				if !_rules[ruledoubleQuotedString]() {
+					goto l3120
+				}
+				if buffer[position] != rune(']') {
+					goto l3120
+				}
+				position++
+				add(rulejsonMapAccessBracket, position3121)
+			}
+			return true
+		l3120:
+			position, tokenIndex = position3120, tokenIndex3120
+			return false
+		},
+		/* 254 doubleQuotedString <- <('"' <(('"' '"') / (!'"' .))*> '"')> */
+		func() bool {
+			position3122, tokenIndex3122 := position, tokenIndex
+			{
+				position3123 := position
+				if buffer[position] != rune('"') {
+					goto l3122
+				}
+				position++
+				{
+					position3124 := position
+				l3125:
+					{
+						position3126, tokenIndex3126 := position, tokenIndex
+						{
+							position3127, tokenIndex3127 := position, tokenIndex
+							if buffer[position] != rune('"') {
+								goto l3128
+							}
+							position++
+							if buffer[position] != rune('"') {
+								goto l3128
+							}
+							position++
+							goto l3127
+						l3128:
+							position, tokenIndex = position3127, tokenIndex3127
+							{
+								position3129, tokenIndex3129 := position, tokenIndex
+								if buffer[position] != rune('"') {
+									goto l3129
+								}
+								position++
+								goto l3126
+							l3129:
+								position, tokenIndex = position3129, tokenIndex3129
+							}
+							if !matchDot() {
+								goto l3126
+							}
+						}
+					l3127:
+						goto l3125
+					l3126:
+						position, tokenIndex = position3126, tokenIndex3126
+					}
+					add(rulePegText, position3124)
+				}
+				if buffer[position] != rune('"') {
+					goto l3122
+				}
+				position++
+				add(ruledoubleQuotedString, position3123)
+			}
+			return true
+		l3122:
+			position, tokenIndex = position3122, tokenIndex3122
+			return false
+		},
+		/* 255 jsonArrayAccess <- <('[' <('-'? [0-9]+)> ']')> */
+		func() bool {
+			position3130, tokenIndex3130 := position, tokenIndex
+			{
+				position3131 := position
+				if buffer[position] != rune('[') {
+					goto l3130
+				}
+				position++
+				{
+					position3132 := position
+					{
+						position3133, tokenIndex3133 := position, tokenIndex
+						if buffer[position] != rune('-') {
+							goto l3133
+						}
+						position++
+						goto l3134
+					l3133:
+						position, tokenIndex = position3133, tokenIndex3133
+					}
+				l3134:
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l3130
+					}
+					position++
+				l3135:
+					{
+						position3136, tokenIndex3136 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l3136
+						}
+						position++
+						goto l3135
+					l3136:
+						position, tokenIndex = position3136, tokenIndex3136
+					}
+					add(rulePegText, position3132)
+				}
+				if buffer[position] != rune(']') {
+					goto l3130
+				}
+				position++
+				add(rulejsonArrayAccess, position3131)
+			}
+			return true
+		l3130:
+			position, tokenIndex = position3130, tokenIndex3130
+			return false
+		},
+		/* 256 jsonNonNegativeArrayAccess <- <('[' <[0-9]+> ']')> */
+		func() bool {
+			position3137, tokenIndex3137 := position, tokenIndex
+			{
+				position3138 := position
+				if buffer[position] != rune('[') {
+					goto l3137
+				}
+				position++
+				{
+					position3139 := position
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l3137
+					}
+					position++
+				l3140:
+					{
+						position3141, tokenIndex3141 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l3141
+						}
+						position++
+						goto l3140
+					l3141:
+						position, tokenIndex = position3141, tokenIndex3141
+					}
+					add(rulePegText, position3139)
+				}
+				if buffer[position] != rune(']') {
+					goto l3137
+				}
+				position++
+				add(rulejsonNonNegativeArrayAccess, position3138)
+			}
+			return true
+		l3137:
+			position, tokenIndex = position3137, tokenIndex3137
+			return false
+		},
+		/* 257 jsonArraySlice <- <('[' <('-'? [0-9]+ ':' '-'? [0-9]+ (':' '-'? [0-9]+)?)> ']')> */
+		func() bool {
+			position3142, tokenIndex3142 := position, tokenIndex
+			{
+				position3143 := position
+				if buffer[position] != rune('[') {
+					goto l3142
+				}
+				position++
+				{
+					position3144 := position
+					{
+						position3145, tokenIndex3145 := position, tokenIndex
+						if buffer[position] != rune('-') {
+							goto l3145
+						}
+						position++
+						goto l3146
+					l3145:
+						position, tokenIndex = position3145, tokenIndex3145
+					}
+				l3146:
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l3142
+					}
+					position++
+				l3147:
+					{
+						position3148, tokenIndex3148 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l3148
+						}
+						position++
+						goto l3147
+					l3148:
+						position, tokenIndex = position3148, tokenIndex3148
+					}
+					if buffer[position] != rune(':') {
+						goto l3142
+					}
+					position++
+					{
+						position3149, tokenIndex3149 := position, tokenIndex
+						if buffer[position] != rune('-') {
+							goto l3149
+						}
+						position++
+						goto l3150
+					l3149:
+						position, tokenIndex = position3149, tokenIndex3149
+					}
+				l3150:
+					if c := buffer[position]; c < rune('0') || c > rune('9') {
+						goto l3142
+					}
+					position++
+				l3151:
+					{
+						position3152, tokenIndex3152 := position, tokenIndex
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l3152
+						}
+						position++
+						goto l3151
+					l3152:
+						position, tokenIndex = position3152, tokenIndex3152
+					}
+					{
+						position3153, tokenIndex3153 := position, tokenIndex
+						if buffer[position] != rune(':') {
+							goto l3153
+						}
+						position++
+						{
+							position3155, tokenIndex3155 := position, tokenIndex
+							if buffer[position] != rune('-') {
+								goto l3155
+							}
+							position++
+							goto l3156
+						l3155:
+							position, tokenIndex = position3155, tokenIndex3155
+						}
+					l3156:
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l3153
+						}
+						position++
+					l3157:
+						{
+							position3158, tokenIndex3158 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l3158
+							}
+							position++
+							goto l3157
+						l3158:
+							position, tokenIndex = position3158, tokenIndex3158
+						}
+						goto l3154
+					l3153:
+						position, tokenIndex = position3153, tokenIndex3153
+					}
+				l3154:
+					add(rulePegText, position3144)
+				}
+				if buffer[position] != rune(']') {
+					goto l3142
+				}
+				position++
+				add(rulejsonArraySlice, position3143)
+			}
+			return true
+		l3142:
+			position, tokenIndex = position3142, tokenIndex3142
+			return false
+		},
+		/* 258 jsonArrayPartialSlice <- <('[' <((':' '-'? [0-9]+) / ('-'? [0-9]+ ':'))> ']')> */
+		func() bool {
+			position3159, tokenIndex3159 := position, tokenIndex
+			{
+				position3160 := position
+				if buffer[position] != rune('[') {
+					goto l3159
+				}
+				position++
+				{
+					position3161 := position
+					{
+						position3162, tokenIndex3162 := position, tokenIndex
+						if buffer[position] != rune(':') {
+							goto l3163
+						}
+						position++
+						{
+							position3164, tokenIndex3164 := position, tokenIndex
+							if buffer[position] != rune('-') {
+								goto l3164
+							}
+							position++
+							goto l3165
+						l3164:
+							position, tokenIndex = position3164, tokenIndex3164
+						}
+					l3165:
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l3163
+						}
+						position++
+					l3166:
+						{
+							position3167, tokenIndex3167 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l3167
+							}
+							position++
+							goto l3166
+						l3167:
+							position, tokenIndex = position3167, tokenIndex3167
+						}
+						goto l3162
+					l3163:
+						position, tokenIndex = position3162, tokenIndex3162
+						{
+							position3168, tokenIndex3168 := position, tokenIndex
+							if buffer[position] != rune('-') {
+								goto l3168
+							}
+							position++
+							goto l3169
+						l3168:
+							position, tokenIndex = position3168, tokenIndex3168
+						}
+					l3169:
+						if c := buffer[position]; c < rune('0') || c > rune('9') {
+							goto l3159
+						}
+						position++
+					l3170:
+						{
+							position3171, tokenIndex3171 := position, tokenIndex
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l3171
+							}
+							position++
+							goto l3170
+						l3171:
+							position, tokenIndex = position3171, tokenIndex3171
+						}
+						if buffer[position] != rune(':') {
+							goto l3159
+						}
+						position++
+					}
+				l3162:
+					add(rulePegText, position3161)
+				}
+				if buffer[position] != rune(']') {
+					goto l3159
+				}
+				position++
+				add(rulejsonArrayPartialSlice, position3160)
+			}
+			return true
+		l3159:
+			position, tokenIndex = position3159, tokenIndex3159
+			return false
+		},
+		/* 259 jsonArrayFullSlice <- <('[' ':' ']')> */
+		func() bool {
+			position3172, tokenIndex3172 := position, tokenIndex
+			{
+				position3173 := position
+				if buffer[position] != rune('[') {
+					goto l3172
+				}
+				position++
+				if buffer[position] != rune(':') {
+					goto l3172
+				}
+				position++
+				if buffer[position] != rune(']') {
+					goto l3172
+				}
+				position++
+				add(rulejsonArrayFullSlice, position3173)
+			}
+			return true
+		l3172:
+			position, tokenIndex = position3172, tokenIndex3172
+			return false
+		},
+		/* 260 spElem <- <(' ' / '\t' / '\n' / '\r' / comment / finalComment)> */
+		func() bool {
+			position3174, tokenIndex3174 := position, tokenIndex
+			{
+				position3175 := position
+				{
+					position3176, tokenIndex3176 := position, tokenIndex
+					if buffer[position] != rune(' ') {
+						goto l3177
+					}
+					position++
+					goto l3176
+				l3177:
+					position, tokenIndex = position3176, tokenIndex3176
+					if buffer[position] != rune('\t') {
+						goto l3178
+					}
+					position++
+					goto l3176
+				l3178:
+					position, tokenIndex = position3176, tokenIndex3176
+					if buffer[position] != rune('\n') {
+						goto l3179
+					}
+					position++
+					goto l3176
+				l3179:
+					position, tokenIndex = position3176, tokenIndex3176
+					if buffer[position] != rune('\r') {
+						goto l3180
+					}
+					position++
+					goto l3176
+				l3180:
+					position, tokenIndex = position3176, tokenIndex3176
+					if !_rules[rulecomment]() {
+						goto l3181
+					}
+					goto l3176
+				l3181:
+					position, tokenIndex = position3176, tokenIndex3176
+					if !_rules[rulefinalComment]() {
+						goto l3174
+					}
+				}
+			l3176:
+				add(rulespElem, position3175)
+			}
+			return true
+		l3174:
+			position, tokenIndex = position3174, tokenIndex3174
+			return false
+		},
+		/* 261 sp <- <spElem+> */
+		func() bool {
+			position3182, tokenIndex3182 := position, tokenIndex
+			{
+				position3183 := position
+				if !_rules[rulespElem]() {
+					goto l3182
+				}
+			l3184:
+				{
+					position3185, tokenIndex3185 := position, tokenIndex
+					if !_rules[rulespElem]() {
+						goto l3185
+					}
+					goto l3184
+				l3185:
+					position, tokenIndex = position3185, tokenIndex3185
+				}
+				add(rulesp, position3183)
+			}
+			return true
+		l3182:
+			position, tokenIndex = position3182, tokenIndex3182
+			return false
+		},
+		/* 262 spOpt <- <spElem*> */
+		func() bool {
+			{
+				position3187 := position
+			l3188:
+				{
+					position3189, tokenIndex3189 := position, tokenIndex
+					if !_rules[rulespElem]() {
+						goto l3189
+					}
+					goto l3188
+				l3189:
+					position, tokenIndex = position3189, tokenIndex3189
+				}
+				add(rulespOpt, position3187)
+			}
+			return true
+		},
+		/* 263 comment <- <('-' '-' (!('\r' / '\n') .)* ('\r' / '\n'))> */
+		func() bool {
+			position3190, tokenIndex3190 := position, tokenIndex
+			{
+				position3191 := position
+				if buffer[position] != rune('-') {
+					goto l3190
+				}
+				position++
+				if buffer[position] != rune('-') {
+					goto l3190
+				}
+				position++
+			l3192:
+				{
+					position3193, tokenIndex3193 := position, tokenIndex
+					{
+						position3194, tokenIndex3194 := position, tokenIndex
+						{
+							position3195, tokenIndex3195 := position, tokenIndex
+							if buffer[position] != rune('\r') {
+								goto l3196
+							}
+							position++
+							goto l3195
+						l3196:
+							position, tokenIndex = position3195, tokenIndex3195
+							if buffer[position] != rune('\n') {
+								goto l3194
+							}
+							position++
+						}
+					l3195:
+						goto l3193
+					l3194:
+						position, tokenIndex = position3194, tokenIndex3194
+					}
+					if !matchDot() {
+						goto l3193
+					}
+					goto l3192
+				l3193:
+					position, tokenIndex = position3193, tokenIndex3193
+				}
+				{
+					position3197, tokenIndex3197 := position, tokenIndex
+					if buffer[position] != rune('\r') {
+						goto l3198
+					}
+					position++
+					goto l3197
+				l3198:
+					position, tokenIndex = position3197, tokenIndex3197
+					if buffer[position] != rune('\n') {
+						goto l3190
+					}
+					position++
+				}
+			l3197:
+				add(rulecomment, position3191)
+			}
+			return true
+		l3190:
+			position, tokenIndex = position3190, tokenIndex3190
+			return false
+		},
+		/* 264 finalComment <- <('-' '-' (!('\r' / '\n') .)* !.)> */
+		func() bool {
+			position3199, tokenIndex3199 := position, tokenIndex
+			{
+				position3200 := position
+				if buffer[position] != rune('-') {
+					goto l3199
+				}
+				position++
+				if buffer[position] != rune('-') {
+					goto l3199
+				}
+				position++
+			l3201:
+				{
+					position3202, tokenIndex3202 := position, tokenIndex
+					{
+						position3203, tokenIndex3203 := position, tokenIndex
+						{
+							position3204, tokenIndex3204 := position, tokenIndex
+							if buffer[position] != rune('\r') {
+								goto l3205
+							}
+							position++
+							goto l3204
+						l3205:
+							position, tokenIndex = position3204, tokenIndex3204
+							if buffer[position] != rune('\n') {
+								goto l3203
+							}
+							position++
+						}
+					l3204:
+						goto l3202
+					l3203:
+						position, tokenIndex = position3203, tokenIndex3203
+					}
+					if !matchDot() {
+						goto l3202
+					}
+					goto l3201
+				l3202:
+					position, tokenIndex = position3202, tokenIndex3202
+				}
+				{
+					position3206, tokenIndex3206 := position, tokenIndex
+					if !matchDot() {
+						goto l3206
+					}
+					goto l3199
+				l3206:
+					position, tokenIndex = position3206, tokenIndex3206
+				}
+				add(rulefinalComment, position3200)
+			}
+			return true
+		l3199:
+			position, tokenIndex = position3199, tokenIndex3199
+			return false
+		},
+		nil,
+		/* 267 Action0 <- <{
+		    p.IncludeTrailingWhitespace(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction0, position)
+			}
+			return true
+		},
+		/* 268 Action1 <- <{
+		    p.IncludeTrailingWhitespace(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction1, position)
+			}
+			return true
+		},
+		/* 269 Action2 <- <{
+		    p.AssembleSelect()
+		}> */
+		func() bool {
+			{
+				add(ruleAction2, position)
+			}
+			return true
+		},
+		/* 270 Action3 <- <{
+		    p.AssembleSelectUnion(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction3, position)
+			}
+			return true
+		},
+		/* 271 Action4 <- <{
+		    p.AssembleCreateStreamAsSelect()
+		}> */
+		func() bool {
+			{
+				add(ruleAction4, position)
+			}
+			return true
+		},
+		/* 272 Action5 <- <{
+		    p.AssembleCreateOrReplaceStreamAsSelect()
+		}> */
+		func() bool {
+			{
+				add(ruleAction5, position)
+			}
+			return true
+		},
+		/* 273 Action6 <- <{
+		    p.AssembleCreateStreamAsSelectUnion()
+		}> */
+		func() bool {
+			{
+				add(ruleAction6, position)
+			}
+			return true
+		},
+		/* 274 Action7 <- <{
+		    p.AssembleCreateSource()
+		}> */
+		func() bool {
+			{
+				add(ruleAction7, position)
+			}
+			return true
+		},
+		/* 275 Action8 <- <{
+		    p.AssembleCreateSink()
+		}> */
+		func() bool {
+			{
+				add(ruleAction8, position)
+			}
+			return true
+		},
+		/* 276 Action9 <- <{
+		    p.AssembleCreateState()
+		}> */
+		func() bool {
+			{
+				add(ruleAction9, position)
+			}
+			return true
+		},
+		/* 277 Action10 <- <{
+		    p.AssembleUpdateState()
+		}> */
+		func() bool {
+			{
+				add(ruleAction10, position)
+			}
+			return true
+		},
+		/* 278 Action11 <- <{
+		    p.AssembleUpdateSource()
+		}> */
+		func() bool {
+			{
+				add(ruleAction11, position)
+			}
+			return true
+		},
+		/* 279 Action12 <- <{
+		    p.AssembleUpdateSink()
+		}> */
+		func() bool {
+			{
+				add(ruleAction12, position)
+			}
+			return true
+		},
+		/* 280 Action13 <- <{
+		    p.AssembleInsertIntoFrom()
+		}> */
+		func() bool {
+			{
+				add(ruleAction13, position)
+			}
+			return true
+		},
+		/* 281 Action14 <- <{
+		    p.AssemblePauseSource()
+		}> */
+		func() bool {
+			{
+				add(ruleAction14, position)
+			}
+			return true
+		},
+		/* 282 Action15 <- <{
+		    p.AssembleResumeSource()
+		}> */
+		func() bool {
+			{
+				add(ruleAction15, position)
+			}
+			return true
+		},
+		/* 283 Action16 <- <{
+		    p.AssembleRewindSource()
+		}> */
+		func() bool {
+			{
+				add(ruleAction16, position)
+			}
+			return true
+		},
+		/* 284 Action17 <- <{
+		    p.AssembleDropSource()
+		}> */
+		func() bool {
+			{
+				add(ruleAction17, position)
+			}
+			return true
+		},
+		/* 285 Action18 <- <{
+		    p.AssembleDropStream()
+		}> */
+		func() bool {
+			{
+				add(ruleAction18, position)
+			}
+			return true
+		},
+		/* 286 Action19 <- <{
+		    p.AssembleDropSink()
+		}> */
+		func() bool {
+			{
+				add(ruleAction19, position)
+			}
+			return true
+		},
+		/* 287 Action20 <- <{
+		    p.AssembleDropState()
+		}> */
+		func() bool {
+			{
+				add(ruleAction20, position)
+			}
+			return true
+		},
+		/* 288 Action21 <- <{
+		    p.AssembleDropFunction()
+		}> */
+		func() bool {
+			{
+				add(ruleAction21, position)
+			}
+			return true
+		},
+		/* 289 Action22 <- <{
+		    p.AssembleLoadState()
+		}> */
+		func() bool {
+			{
+				add(ruleAction22, position)
+			}
+			return true
+		},
+		/* 290 Action23 <- <{
+		    p.AssembleLoadStateOrCreate()
+		}> */
+		func() bool {
+			{
+				add(ruleAction23, position)
+			}
+			return true
+		},
+		/* 291 Action24 <- <{
+		    p.AssembleSaveState()
+		}> */
+		func() bool {
+			{
+				add(ruleAction24, position)
+			}
+			return true
+		},
+		/* 292 Action25 <- <{
+		    p.AssembleEval(begin, end)
+		}> */
+		func() bool {
+			{
+				add(ruleAction25, position)
 			}
 			return true
-		l1781:
-			position, tokenIndex = position1781, tokenIndex1781
-			return false
 		},
-		/* 148 Equal <- <(<'='> Action117)> */
+		/* 293 Action26 <- <{
+		    p.AssembleExplain()
+		}> */
 		func() bool {
-			position1790, tokenIndex1790 := position, tokenIndex
 			{
-				position1791 := position
-				{
-					position1792 := position
-					if buffer[position] != rune('=') {
-						goto l1790
-					}
-					position++
-					add(rulePegText, position1792)
-				}
-				if !_rules[ruleAction117]() {
-					goto l1790
-				}
-				add(ruleEqual, position1791)
+				add(ruleAction26, position)
 			}
 			return true
-		l1790:
-			position, tokenIndex = position1790, tokenIndex1790
-			return false
 		},
-		/* 149 Less <- <(<'<'> Action118)> */
+		/* 294 Action27 <- <{
+		    p.AssembleShow()
+		}> */
 		func() bool {
-			position1793, tokenIndex1793 := position, tokenIndex
 			{
-				position1794 := position
-				{
-					position1795 := position
-					if buffer[position] != rune('<') {
-						goto l1793
-					}
-					position++
-					add(rulePegText, position1795)
-				}
-				if !_rules[ruleAction118]() {
-					goto l1793
-				}
-				add(ruleLess, position1794)
+				add(ruleAction27, position)
 			}
 			return true
-		l1793:
-			position, tokenIndex = position1793, tokenIndex1793
-			return false
 		},
-		/* 150 LessOrEqual <- <(<('<' '=')> Action119)> */
+		/* 295 Action28 <- <{
+		    p.AssembleDescribe()
+		}> */
 		func() bool {
-			position1796, tokenIndex1796 := position, tokenIndex
 			{
-				position1797 := position
-				{
-					position1798 := position
-					if buffer[position] != rune('<') {
-						goto l1796
-					}
-					position++
-					if buffer[position] != rune('=') {
-						goto l1796
-					}
-					position++
-					add(rulePegText, position1798)
-				}
-				if !_rules[ruleAction119]() {
-					goto l1796
-				}
-				add(ruleLessOrEqual, position1797)
+				add(ruleAction28, position)
 			}
 			return true
-		l1796:
-			position, tokenIndex = position1796, tokenIndex1796
-			return false
 		},
-		/* 151 Greater <- <(<'>'> Action120)> */
+		/* 296 Action29 <- <{
+		    p.AssembleCreateFunction()
+		}> */
 		func() bool {
-			position1799, tokenIndex1799 := position, tokenIndex
 			{
-				position1800 := position
-				{
-					position1801 := position
-					if buffer[position] != rune('>') {
-						goto l1799
-					}
-					position++
-					add(rulePegText, position1801)
-				}
-				if !_rules[ruleAction120]() {
-					goto l1799
-				}
-				add(ruleGreater, position1800)
+				add(ruleAction29, position)
 			}
 			return true
-		l1799:
-			position, tokenIndex = position1799, tokenIndex1799
-			return false
 		},
-		/* 152 GreaterOrEqual <- <(<('>' '=')> Action121)> */
+		/* 297 Action30 <- <{
+		    p.AssembleFunctionParams(begin, end)
+		}> */
 		func() bool {
-			position1802, tokenIndex1802 := position, tokenIndex
 			{
-				position1803 := position
-				{
-					position1804 := position
-					if buffer[position] != rune('>') {
-						goto l1802
-					}
-					position++
-					if buffer[position] != rune('=') {
-						goto l1802
-					}
-					position++
-					add(rulePegText, position1804)
-				}
-				if !_rules[ruleAction121]() {
-					goto l1802
-				}
-				add(ruleGreaterOrEqual, position1803)
+				add(ruleAction30, position)
 			}
 			return true
-		l1802:
-			position, tokenIndex = position1802, tokenIndex1802
-			return false
 		},
-		/* 153 NotEqual <- <(<(('!' '=') / ('<' '>'))> Action122)> */
+		/* 298 Action31 <- <{
+		    p.AssembleEmitter()
+		}> */
 		func() bool {
-			position1805, tokenIndex1805 := position, tokenIndex
 			{
-				position1806 := position
-				{
-					position1807 := position
-					{
-						position1808, tokenIndex1808 := position, tokenIndex
-						if buffer[position] != rune('!') {
-							goto l1809
-						}
-						position++
-						if buffer[position] != rune('=') {
-							goto l1809
-						}
-						position++
-						goto l1808
-					l1809:
-						position, tokenIndex = position1808, tokenIndex1808
-						if buffer[position] != rune('<') {
-							goto l1805
-						}
-						position++
-						if buffer[position] != rune('>') {
-							goto l1805
-						}
-						position++
-					}
-				l1808:
-					add(rulePegText, position1807)
-				}
-				if !_rules[ruleAction122]() {
-					goto l1805
-				}
-				add(ruleNotEqual, position1806)
+				add(ruleAction31, position)
 			}
 			return true
-		l1805:
-			position, tokenIndex = position1805, tokenIndex1805
-			return false
 		},
-		/* 154 Concat <- <(<('|' '|')> Action123)> */
+		/* 299 Action32 <- <{
+		    p.AssembleEmitterOptions(begin, end)
+		}> */
 		func() bool {
-			position1810, tokenIndex1810 := position, tokenIndex
 			{
-				position1811 := position
-				{
-					position1812 := position
-					if buffer[position] != rune('|') {
-						goto l1810
-					}
-					position++
-					if buffer[position] != rune('|') {
-						goto l1810
-					}
-					position++
-					add(rulePegText, position1812)
-				}
-				if !_rules[ruleAction123]() {
-					goto l1810
-				}
-				add(ruleConcat, position1811)
+				add(ruleAction32, position)
 			}
 			return true
-		l1810:
-			position, tokenIndex = position1810, tokenIndex1810
-			return false
 		},
-		/* 155 Is <- <(<(('i' / 'I') ('s' / 'S'))> Action124)> */
+		/* 300 Action33 <- <{
+		    p.AssembleEmitterLimit()
+		}> */
 		func() bool {
-			position1813, tokenIndex1813 := position, tokenIndex
 			{
-				position1814 := position
-				{
-					position1815 := position
-					{
-						position1816, tokenIndex1816 := position, tokenIndex
-						if buffer[position] != rune('i') {
-							goto l1817
-						}
-						position++
-						goto l1816
-					l1817:
-						position, tokenIndex = position1816, tokenIndex1816
-						if buffer[position] != rune('I') {
-							goto l1813
-						}
-						position++
-					}
-				l1816:
-					{
-						position1818, tokenIndex1818 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1819
-						}
-						position++
-						goto l1818
-					l1819:
-						position, tokenIndex = position1818, tokenIndex1818
-						if buffer[position] != rune('S') {
-							goto l1813
-						}
-						position++
-					}
-				l1818:
-					add(rulePegText, position1815)
-				}
-				if !_rules[ruleAction124]() {
-					goto l1813
-				}
-				add(ruleIs, position1814)
+				add(ruleAction33, position)
 			}
 			return true
-		l1813:
-			position, tokenIndex = position1813, tokenIndex1813
-			return false
 		},
-		/* 156 IsNot <- <(<(('i' / 'I') ('s' / 'S') sp (('n' / 'N') ('o' / 'O') ('t' / 'T')))> Action125)> */
+		/* 301 Action34 <- <{
+		    p.AssembleEmitterSampling(CountBasedSampling, 1)
+		}> */
 		func() bool {
-			position1820, tokenIndex1820 := position, tokenIndex
 			{
-				position1821 := position
-				{
-					position1822 := position
-					{
-						position1823, tokenIndex1823 := position, tokenIndex
-						if buffer[position] != rune('i') {
-							goto l1824
-						}
-						position++
-						goto l1823
-					l1824:
-						position, tokenIndex = position1823, tokenIndex1823
-						if buffer[position] != rune('I') {
-							goto l1820
-						}
-						position++
-					}
-				l1823:
-					{
-						position1825, tokenIndex1825 := position, tokenIndex
-						if buffer[position] != rune('s') {
-							goto l1826
-						}
-						position++
-						goto l1825
-					l1826:
-						position, tokenIndex = position1825, tokenIndex1825
-						if buffer[position] != rune('S') {
-							goto l1820
-						}
-						position++
-					}
-				l1825:
-					if !_rules[rulesp]() {
-						goto l1820
-					}
-					{
-						position1827, tokenIndex1827 := position, tokenIndex
-						if buffer[position] != rune('n') {
-							goto l1828
-						}
-						position++
-						goto l1827
-					l1828:
-						position, tokenIndex = position1827, tokenIndex1827
-						if buffer[position] != rune('N') {
-							goto l1820
-						}
-						position++
-					}
-				l1827:
-					{
-						position1829, tokenIndex1829 := position, tokenIndex
-						if buffer[position] != rune('o') {
-							goto l1830
-						}
-						position++
-						goto l1829
-					l1830:
-						position, tokenIndex = position1829, tokenIndex1829
-						if buffer[position] != rune('O') {
-							goto l1820
-						}
-						position++
-					}
-				l1829:
-					{
-						position1831, tokenIndex1831 := position, tokenIndex
-						if buffer[position] != rune('t') {
-							goto l1832
-						}
-						position++
-						goto l1831
-					l1832:
-						position, tokenIndex = position1831, tokenIndex1831
-						if buffer[position] != rune('T') {
-							goto l1820
-						}
-						position++
-					}
-				l1831:
-					add(rulePegText, position1822)
-				}
-				if !_rules[ruleAction125]() {
-					goto l1820
-				}
-				add(ruleIsNot, position1821)
+				add(ruleAction34, position)
 			}
 			return true
-		l1820:
-			position, tokenIndex = position1820, tokenIndex1820
-			return false
 		},
-		/* 157 Plus <- <(<'+'> Action126)> */
+		/* 302 Action35 <- <{
+		    p.AssembleEmitterSampling(RandomizedSampling, 1)
+		}> */
 		func() bool {
-			position1833, tokenIndex1833 := position, tokenIndex
 			{
-				position1834 := position
-				{
-					position1835 := position
-					if buffer[position] != rune('+') {
-						goto l1833
-					}
-					position++
-					add(rulePegText, position1835)
-				}
-				if !_rules[ruleAction126]() {
-					goto l1833
-				}
-				add(rulePlus, position1834)
+				add(ruleAction35, position)
 			}
 			return true
-		l1833:
-			position, tokenIndex = position1833, tokenIndex1833
-			return false
 		},
-		/* 158 Minus <- <(<'-'> Action127)> */
+		/* 303 Action36 <- <{
+		    p.AssembleEmitterSampling(TimeBasedSampling, 1)
+		}> */
 		func() bool {
-			position1836, tokenIndex1836 := position, tokenIndex
 			{
-				position1837 := position
-				{
-					position1838 := position
-					if buffer[position] != rune('-') {
-						goto l1836
-					}
-					position++
-					add(rulePegText, position1838)
-				}
-				if !_rules[ruleAction127]() {
-					goto l1836
-				}
-				add(ruleMinus, position1837)
+				add(ruleAction36, position)
 			}
 			return true
-		l1836:
-			position, tokenIndex = position1836, tokenIndex1836
-			return false
 		},
-		/* 159 Multiply <- <(<'*'> Action128)> */
+		/* 304 Action37 <- <{
+		    p.AssembleEmitterSampling(TimeBasedSampling, 0.001)
+		}> */
 		func() bool {
-			position1839, tokenIndex1839 := position, tokenIndex
 			{
-				position1840 := position
-				{
-					position1841 := position
-					if buffer[position] != rune('*') {
-						goto l1839
-					}
-					position++
-					add(rulePegText, position1841)
-				}
-				if !_rules[ruleAction128]() {
-					goto l1839
-				}
-				add(ruleMultiply, position1840)
+				add(ruleAction37, position)
 			}
 			return true
-		l1839:
-			position, tokenIndex = position1839, tokenIndex1839
-			return false
 		},
-		/* 160 Divide <- <(<'/'> Action129)> */
+		/* 305 Action38 <- <{
+		    p.AssembleDistinct(begin, end)
+		}> */
 		func() bool {
-			position1842, tokenIndex1842 := position, tokenIndex
 			{
-				position1843 := position
-				{
-					position1844 := position
-					if buffer[position] != rune('/') {
-						goto l1842
-					}
-					position++
-					add(rulePegText, position1844)
-				}
-				if !_rules[ruleAction129]() {
-					goto l1842
-				}
-				add(ruleDivide, position1843)
+				add(ruleAction38, position)
 			}
 			return true
-		l1842:
-			position, tokenIndex = position1842, tokenIndex1842
-			return false
 		},
-		/* 161 Modulo <- <(<'%'> Action130)> */
+		/* 306 Action39 <- <{
+		    p.AssembleProjections(begin, end)
+		}> */
 		func() bool {
-			position1845, tokenIndex1845 := position, tokenIndex
 			{
-				position1846 := position
-				{
-					position1847 := position
-					if buffer[position] != rune('%') {
-						goto l1845
-					}
-					position++
-					add(rulePegText, position1847)
-				}
-				if !_rules[ruleAction130]() {
-					goto l1845
-				}
-				add(ruleModulo, position1846)
+				add(ruleAction39, position)
 			}
 			return true
-		l1845:
-			position, tokenIndex = position1845, tokenIndex1845
-			return false
 		},
-		/* 162 UnaryMinus <- <(<'-'> Action131)> */
+		/* 307 Action40 <- <{
+		    p.AssembleAlias()
+		}> */
 		func() bool {
-			position1848, tokenIndex1848 := position, tokenIndex
 			{
-				position1849 := position
-				{
-					position1850 := position
-					if buffer[position] != rune('-') {
-						goto l1848
-					}
-					position++
-					add(rulePegText, position1850)
-				}
-				if !_rules[ruleAction131]() {
-					goto l1848
-				}
-				add(ruleUnaryMinus, position1849)
+				add(ruleAction40, position)
 			}
 			return true
-		l1848:
-			position, tokenIndex = position1848, tokenIndex1848
-			return false
 		},
-		/* 163 Identifier <- <(<ident> Action132)> */
+		/* 308 Action41 <- <{
+		    // This is *always* executed, even if there is no
+		    // FROM clause present in the statement.
+		    p.AssembleWindowedFrom(begin, end)
+		}> */
 		func() bool {
-			position1851, tokenIndex1851 := position, tokenIndex
 			{
-				position1852 := position
-				{
-					position1853 := position
-					if !_rules[ruleident]() {
-						goto l1851
-					}
-					add(rulePegText, position1853)
-				}
-				if !_rules[ruleAction132]() {
-					goto l1851
-				}
-				add(ruleIdentifier, position1852)
+				add(ruleAction41, position)
 			}
 			return true
-		l1851:
-			position, tokenIndex = position1851, tokenIndex1851
-			return false
 		},
-		/* 164 TargetIdentifier <- <(<('*' / jsonSetPath)> Action133)> */
+		/* 309 Action42 <- <{
+		    p.AssembleInterval()
+		}> */
 		func() bool {
-			position1854, tokenIndex1854 := position, tokenIndex
 			{
-				position1855 := position
-				{
-					position1856 := position
-					{
-						position1857, tokenIndex1857 := position, tokenIndex
-						if buffer[position] != rune('*') {
-							goto l1858
-						}
-						position++
-						goto l1857
-					l1858:
-						position, tokenIndex = position1857, tokenIndex1857
-						if !_rules[rulejsonSetPath]() {
-							goto l1854
-						}
-					}
-				l1857:
-					add(rulePegText, position1856)
-				}
-				if !_rules[ruleAction133]() {
-					goto l1854
-				}
-				add(ruleTargetIdentifier, position1855)
+				add(ruleAction42, position)
 			}
 			return true
-		l1854:
-			position, tokenIndex = position1854, tokenIndex1854
-			return false
 		},
-		/* 165 ident <- <(([a-z] / [A-Z]) ([a-z] / [A-Z] / [0-9] / '_')*)> */
+		/* 310 Action43 <- <{
+		    p.AssembleInterval()
+		}> */
 		func() bool {
-			position1859, tokenIndex1859 := position, tokenIndex
 			{
-				position1860 := position
-				{
-					position1861, tokenIndex1861 := position, tokenIndex
-					if c := buffer[position]; c < rune('a') || c > rune('z') {
-						goto l1862
-					}
-					position++
-					goto l1861
-				l1862:
-					position, tokenIndex = position1861, tokenIndex1861
-					if c := buffer[position]; c < rune('A') || c > rune('Z') {
-						goto l1859
-					}
-					position++
-				}
-			l1861:
-			l1863:
-				{
-					position1864, tokenIndex1864 := position, tokenIndex
-					{
-						position1865, tokenIndex1865 := position, tokenIndex
-						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l1866
-						}
-						position++
-						goto l1865
-					l1866:
-						position, tokenIndex = position1865, tokenIndex1865
-						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l1867
-						}
-						position++
-						goto l1865
-					l1867:
-						position, tokenIndex = position1865, tokenIndex1865
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1868
-						}
-						position++
-						goto l1865
-					l1868:
-						position, tokenIndex = position1865, tokenIndex1865
-						if buffer[position] != rune('_') {
-							goto l1864
-						}
-						position++
-					}
-				l1865:
-					goto l1863
-				l1864:
-					position, tokenIndex = position1864, tokenIndex1864
-				}
-				add(ruleident, position1860)
+				add(ruleAction43, position)
 			}
 			return true
-		l1859:
-			position, tokenIndex = position1859, tokenIndex1859
-			return false
 		},
-		/* 166 jsonGetPath <- <(jsonPathHead jsonGetPathNonHead*)> */
+		/* 311 Action44 <- <{
+		    p.AssembleJoin()
+		}> */
 		func() bool {
-			position1869, tokenIndex1869 := position, tokenIndex
 			{
-				position1870 := position
-				if !_rules[rulejsonPathHead]() {
-					goto l1869
-				}
-			l1871:
-				{
-					position1872, tokenIndex1872 := position, tokenIndex
-					if !_rules[rulejsonGetPathNonHead]() {
-						goto l1872
-					}
-					goto l1871
-				l1872:
-					position, tokenIndex = position1872, tokenIndex1872
-				}
-				add(rulejsonGetPath, position1870)
+				add(ruleAction44, position)
 			}
 			return true
-		l1869:
-			position, tokenIndex = position1869, tokenIndex1869
-			return false
 		},
-		/* 167 jsonSetPath <- <(jsonPathHead jsonSetPathNonHead*)> */
+		/* 312 Action45 <- <{
+		    p.AssembleUnnest()
+		}> */
 		func() bool {
-			position1873, tokenIndex1873 := position, tokenIndex
 			{
-				position1874 := position
-				if !_rules[rulejsonPathHead]() {
-					goto l1873
-				}
-			l1875:
-				{
-					position1876, tokenIndex1876 := position, tokenIndex
-					if !_rules[rulejsonSetPathNonHead]() {
-						goto l1876
-					}
-					goto l1875
-				l1876:
-					position, tokenIndex = position1876, tokenIndex1876
-				}
-				add(rulejsonSetPath, position1874)
+				add(ruleAction45, position)
 			}
 			return true
-		l1873:
-			position, tokenIndex = position1873, tokenIndex1873
-			return false
 		},
-		/* 168 jsonPathHead <- <(jsonMapAccessString / jsonMapAccessBracket)> */
+		/* 313 Action46 <- <{
+		    p.EnsureKeywordPresent(begin, end)
+		}> */
 		func() bool {
-			position1877, tokenIndex1877 := position, tokenIndex
 			{
-				position1878 := position
-				{
-					position1879, tokenIndex1879 := position, tokenIndex
-					if !_rules[rulejsonMapAccessString]() {
-						goto l1880
-					}
-					goto l1879
-				l1880:
-					position, tokenIndex = position1879, tokenIndex1879
-					if !_rules[rulejsonMapAccessBracket]() {
-						goto l1877
-					}
-				}
-			l1879:
-				add(rulejsonPathHead, position1878)
+				add(ruleAction46, position)
 			}
 			return true
-		l1877:
-			position, tokenIndex = position1877, tokenIndex1877
-			return false
 		},
-		/* 169 jsonGetPathNonHead <- <(jsonMapMultipleLevel / jsonMapSingleLevel / jsonArrayFullSlice / jsonArrayPartialSlice / jsonArraySlice / jsonArrayAccess)> */
+		/* 314 Action47 <- <{
+		    p.PushComponent(begin, end, Yes)
+		}> */
 		func() bool {
-			position1881, tokenIndex1881 := position, tokenIndex
 			{
-				position1882 := position
-				{
-					position1883, tokenIndex1883 := position, tokenIndex
-					if !_rules[rulejsonMapMultipleLevel]() {
-						goto l1884
-					}
-					goto l1883
-				l1884:
-					position, tokenIndex = position1883, tokenIndex1883
-					if !_rules[rulejsonMapSingleLevel]() {
-						goto l1885
-					}
-					goto l1883
-				l1885:
-					position, tokenIndex = position1883, tokenIndex1883
-					if !_rules[rulejsonArrayFullSlice]() {
-						goto l1886
-					}
-					goto l1883
-				l1886:
-					position, tokenIndex = position1883, tokenIndex1883
-					if !_rules[rulejsonArrayPartialSlice]() {
-						goto l1887
-					}
-					goto l1883
-				l1887:
-					position, tokenIndex = position1883, tokenIndex1883
-					if !_rules[rulejsonArraySlice]() {
-						goto l1888
-					}
-					goto l1883
-				l1888:
-					position, tokenIndex = position1883, tokenIndex1883
-					if !_rules[rulejsonArrayAccess]() {
-						goto l1881
-					}
-				}
-			l1883:
-				add(rulejsonGetPathNonHead, position1882)
+				add(ruleAction47, position)
 			}
 			return true
-		l1881:
-			position, tokenIndex = position1881, tokenIndex1881
-			return false
 		},
-		/* 170 jsonSetPathNonHead <- <(jsonMapSingleLevel / jsonNonNegativeArrayAccess)> */
+		/* 315 Action48 <- <{
+		    p.AssembleStateJoin()
+		}> */
 		func() bool {
-			position1889, tokenIndex1889 := position, tokenIndex
 			{
-				position1890 := position
-				{
-					position1891, tokenIndex1891 := position, tokenIndex
-					if !_rules[rulejsonMapSingleLevel]() {
-						goto l1892
-					}
-					goto l1891
-				l1892:
-					position, tokenIndex = position1891, tokenIndex1891
-					if !_rules[rulejsonNonNegativeArrayAccess]() {
-						goto l1889
-					}
-				}
-			l1891:
-				add(rulejsonSetPathNonHead, position1890)
+				add(ruleAction48, position)
 			}
 			return true
-		l1889:
-			position, tokenIndex = position1889, tokenIndex1889
-			return false
 		},
-		/* 171 jsonMapSingleLevel <- <(('.' jsonMapAccessString) / jsonMapAccessBracket)> */
+		/* 316 Action49 <- <{
+		    p.EnsureIdentifier(begin, end)
+		}> */
 		func() bool {
-			position1893, tokenIndex1893 := position, tokenIndex
 			{
-				position1894 := position
-				{
-					position1895, tokenIndex1895 := position, tokenIndex
-					if buffer[position] != rune('.') {
-						goto l1896
-					}
-					position++
-					if !_rules[rulejsonMapAccessString]() {
-						goto l1896
-					}
-					goto l1895
-				l1896:
-					position, tokenIndex = position1895, tokenIndex1895
-					if !_rules[rulejsonMapAccessBracket]() {
-						goto l1893
-					}
-				}
-			l1895:
-				add(rulejsonMapSingleLevel, position1894)
+				add(ruleAction49, position)
 			}
 			return true
-		l1893:
-			position, tokenIndex = position1893, tokenIndex1893
-			return false
 		},
-		/* 172 jsonMapMultipleLevel <- <('.' '.' (jsonMapAccessString / jsonMapAccessBracket))> */
+		/* 317 Action50 <- <{
+		    // This is *always* executed, even if there is no
+		    // WHERE clause present in the statement.
+		    p.AssembleFilter(begin, end)
+		}> */
 		func() bool {
-			position1897, tokenIndex1897 := position, tokenIndex
 			{
-				position1898 := position
-				if buffer[position] != rune('.') {
-					goto l1897
-				}
-				position++
-				if buffer[position] != rune('.') {
-					goto l1897
-				}
-				position++
-				{
-					position1899, tokenIndex1899 := position, tokenIndex
-					if !_rules[rulejsonMapAccessString]() {
-						goto l1900
-					}
-					goto l1899
-				l1900:
-					position, tokenIndex = position1899, tokenIndex1899
-					if !_rules[rulejsonMapAccessBracket]() {
-						goto l1897
-					}
-				}
-			l1899:
-				add(rulejsonMapMultipleLevel, position1898)
+				add(ruleAction50, position)
 			}
 			return true
-		l1897:
-			position, tokenIndex = position1897, tokenIndex1897
-			return false
 		},
-		/* 173 jsonMapAccessString <- <<(([a-z] / [A-Z]) ([a-z] / [A-Z] / [0-9] / '_')*)>> */
+		/* 318 Action51 <- <{
+		    // This is *always* executed, even if there is no
+		    // GROUP BY clause present in the statement.
+		    p.AssembleGrouping(begin, end)
+		}> */
 		func() bool {
-			position1901, tokenIndex1901 := position, tokenIndex
 			{
-				position1902 := position
-				{
-					position1903 := position
-					{
-						position1904, tokenIndex1904 := position, tokenIndex
-						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l1905
-						}
-						position++
-						goto l1904
-					l1905:
-						position, tokenIndex = position1904, tokenIndex1904
-						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l1901
-						}
-						position++
-					}
-				l1904:
-				l1906:
-					{
-						position1907, tokenIndex1907 := position, tokenIndex
-						{
-							position1908, tokenIndex1908 := position, tokenIndex
-							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l1909
-							}
-							position++
-							goto l1908
-						l1909:
-							position, tokenIndex = position1908, tokenIndex1908
-							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l1910
-							}
-							position++
-							goto l1908
-						l1910:
-							position, tokenIndex = position1908, tokenIndex1908
-							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l1911
-							}
-							position++
-							goto l1908
-						l1911:
-							position, tokenIndex = position1908, tokenIndex1908
-							if buffer[position] != rune('_') {
-								goto l1907
-							}
-							position++
-						}
-					l1908:
-						goto l1906
-					l1907:
-						position, tokenIndex = position1907, tokenIndex1907
-					}
-					add(rulePegText, position1903)
-				}
-				add(rulejsonMapAccessString, position1902)
+				add(ruleAction51, position)
 			}
 			return true
-		l1901:
-			position, tokenIndex = position1901, tokenIndex1901
-			return false
 		},
-		/* 174 jsonMapAccessBracket <- <('[' doubleQuotedString ']')> */
+		/* 319 Action52 <- <{
+		    p.PushComponent(begin, end, RollupGroupingSets)
+		}> */
 		func() bool {
-			position1912, tokenIndex1912 := position, tokenIndex
 			{
-				position1913 := position
-				if buffer[position] != rune('[') {
-					goto l1912
-				}
-				position++
-				if !_rules[ruledoubleQuotedString]() {
-					goto l1912
-				}
-				if buffer[position] != rune(']') {
-					goto l1912
-				}
-				position++
-				add(rulejsonMapAccessBracket, position1913)
+				add(ruleAction52, position)
 			}
 			return true
-		l1912:
-			position, tokenIndex = position1912, tokenIndex1912
-			return false
 		},
-		/* 175 doubleQuotedString <- <('"' <(('"' '"') / (!'"' .))*> '"')> */
+		/* 320 Action53 <- <{
+		    p.PushComponent(begin, end, CubeGroupingSets)
+		}> */
 		func() bool {
-			position1914, tokenIndex1914 := position, tokenIndex
 			{
-				position1915 := position
-				if buffer[position] != rune('"') {
-					goto l1914
-				}
-				position++
-				{
-					position1916 := position
-				l1917:
-					{
-						position1918, tokenIndex1918 := position, tokenIndex
-						{
-							position1919, tokenIndex1919 := position, tokenIndex
-							if buffer[position] != rune('"') {
-								goto l1920
-							}
-							position++
-							if buffer[position] != rune('"') {
-								goto l1920
-							}
-							position++
-							goto l1919
-						l1920:
-							position, tokenIndex = position1919, tokenIndex1919
-							{
-								position1921, tokenIndex1921 := position, tokenIndex
-								if buffer[position] != rune('"') {
-									goto l1921
-								}
-								position++
-								goto l1918
-							l1921:
-								position, tokenIndex = position1921, tokenIndex1921
-							}
-							if !matchDot() {
-								goto l1918
-							}
-						}
-					l1919:
-						goto l1917
-					l1918:
-						position, tokenIndex = position1918, tokenIndex1918
-					}
-					add(rulePegText, position1916)
-				}
-				if buffer[position] != rune('"') {
-					goto l1914
-				}
-				position++
-				add(ruledoubleQuotedString, position1915)
+				add(ruleAction53, position)
+			}
+			return true
+		},
+		/* 321 Action54 <- <{
+		    p.PushComponent(begin, end, ExplicitGroupingSets)
+		}> */
+		func() bool {
+			{
+				add(ruleAction54, position)
 			}
 			return true
-		l1914:
-			position, tokenIndex = position1914, tokenIndex1914
-			return false
 		},
-		/* 176 jsonArrayAccess <- <('[' <('-'? [0-9]+)> ']')> */
+		/* 322 Action55 <- <{
+		    p.AssembleExpressions(begin, end)
+		}> */
 		func() bool {
-			position1922, tokenIndex1922 := position, tokenIndex
 			{
-				position1923 := position
-				if buffer[position] != rune('[') {
-					goto l1922
-				}
-				position++
-				{
-					position1924 := position
-					{
-						position1925, tokenIndex1925 := position, tokenIndex
-						if buffer[position] != rune('-') {
-							goto l1925
-						}
-						position++
-						goto l1926
-					l1925:
-						position, tokenIndex = position1925, tokenIndex1925
-					}
-				l1926:
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1922
-					}
-					position++
-				l1927:
-					{
-						position1928, tokenIndex1928 := position, tokenIndex
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1928
-						}
-						position++
-						goto l1927
-					l1928:
-						position, tokenIndex = position1928, tokenIndex1928
-					}
-					add(rulePegText, position1924)
-				}
-				if buffer[position] != rune(']') {
-					goto l1922
-				}
-				position++
-				add(rulejsonArrayAccess, position1923)
+				add(ruleAction55, position)
 			}
 			return true
-		l1922:
-			position, tokenIndex = position1922, tokenIndex1922
-			return false
 		},
-		/* 177 jsonNonNegativeArrayAccess <- <('[' <[0-9]+> ']')> */
+		/* 323 Action56 <- <{
+		    p.AssembleExpressions(begin, end)
+		}> */
 		func() bool {
-			position1929, tokenIndex1929 := position, tokenIndex
 			{
-				position1930 := position
-				if buffer[position] != rune('[') {
-					goto l1929
-				}
-				position++
-				{
-					position1931 := position
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1929
-					}
-					position++
-				l1932:
-					{
-						position1933, tokenIndex1933 := position, tokenIndex
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1933
-						}
-						position++
-						goto l1932
-					l1933:
-						position, tokenIndex = position1933, tokenIndex1933
-					}
-					add(rulePegText, position1931)
-				}
-				if buffer[position] != rune(']') {
-					goto l1929
-				}
-				position++
-				add(rulejsonNonNegativeArrayAccess, position1930)
+				add(ruleAction56, position)
 			}
 			return true
-		l1929:
-			position, tokenIndex = position1929, tokenIndex1929
-			return false
 		},
-		/* 178 jsonArraySlice <- <('[' <('-'? [0-9]+ ':' '-'? [0-9]+ (':' '-'? [0-9]+)?)> ']')> */
+		/* 324 Action57 <- <{
+		    // This is *always* executed, even if there is no
+		    // HAVING clause present in the statement.
+		    p.AssembleHaving(begin, end)
+		}> */
 		func() bool {
-			position1934, tokenIndex1934 := position, tokenIndex
 			{
-				position1935 := position
-				if buffer[position] != rune('[') {
-					goto l1934
-				}
-				position++
-				{
-					position1936 := position
-					{
-						position1937, tokenIndex1937 := position, tokenIndex
-						if buffer[position] != rune('-') {
-							goto l1937
-						}
-						position++
-						goto l1938
-					l1937:
-						position, tokenIndex = position1937, tokenIndex1937
-					}
-				l1938:
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1934
-					}
-					position++
-				l1939:
-					{
-						position1940, tokenIndex1940 := position, tokenIndex
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1940
-						}
-						position++
-						goto l1939
-					l1940:
-						position, tokenIndex = position1940, tokenIndex1940
-					}
-					if buffer[position] != rune(':') {
-						goto l1934
-					}
-					position++
-					{
-						position1941, tokenIndex1941 := position, tokenIndex
-						if buffer[position] != rune('-') {
-							goto l1941
-						}
-						position++
-						goto l1942
-					l1941:
-						position, tokenIndex = position1941, tokenIndex1941
-					}
-				l1942:
-					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l1934
-					}
-					position++
-				l1943:
-					{
-						position1944, tokenIndex1944 := position, tokenIndex
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1944
-						}
-						position++
-						goto l1943
-					l1944:
-						position, tokenIndex = position1944, tokenIndex1944
-					}
-					{
-						position1945, tokenIndex1945 := position, tokenIndex
-						if buffer[position] != rune(':') {
-							goto l1945
-						}
-						position++
-						{
-							position1947, tokenIndex1947 := position, tokenIndex
-							if buffer[position] != rune('-') {
-								goto l1947
-							}
-							position++
-							goto l1948
-						l1947:
-							position, tokenIndex = position1947, tokenIndex1947
-						}
-					l1948:
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1945
-						}
-						position++
-					l1949:
-						{
-							position1950, tokenIndex1950 := position, tokenIndex
-							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l1950
-							}
-							position++
-							goto l1949
-						l1950:
-							position, tokenIndex = position1950, tokenIndex1950
-						}
-						goto l1946
-					l1945:
-						position, tokenIndex = position1945, tokenIndex1945
-					}
-				l1946:
-					add(rulePegText, position1936)
-				}
-				if buffer[position] != rune(']') {
-					goto l1934
-				}
-				position++
-				add(rulejsonArraySlice, position1935)
+				add(ruleAction57, position)
 			}
 			return true
-		l1934:
-			position, tokenIndex = position1934, tokenIndex1934
-			return false
 		},
-		/* 179 jsonArrayPartialSlice <- <('[' <((':' '-'? [0-9]+) / ('-'? [0-9]+ ':'))> ']')> */
+		/* 325 Action58 <- <{
+		    // This is *always* executed, even if there is no
+		    // ORDER BY clause present in the statement.
+		    p.AssembleOrderBy(begin, end)
+		}> */
 		func() bool {
-			position1951, tokenIndex1951 := position, tokenIndex
 			{
-				position1952 := position
-				if buffer[position] != rune('[') {
-					goto l1951
-				}
-				position++
-				{
-					position1953 := position
-					{
-						position1954, tokenIndex1954 := position, tokenIndex
-						if buffer[position] != rune(':') {
-							goto l1955
-						}
-						position++
-						{
-							position1956, tokenIndex1956 := position, tokenIndex
-							if buffer[position] != rune('-') {
-								goto l1956
-							}
-							position++
-							goto l1957
-						l1956:
-							position, tokenIndex = position1956, tokenIndex1956
-						}
-					l1957:
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1955
-						}
-						position++
-					l1958:
-						{
-							position1959, tokenIndex1959 := position, tokenIndex
-							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l1959
-							}
-							position++
-							goto l1958
-						l1959:
-							position, tokenIndex = position1959, tokenIndex1959
-						}
-						goto l1954
-					l1955:
-						position, tokenIndex = position1954, tokenIndex1954
-						{
-							position1960, tokenIndex1960 := position, tokenIndex
-							if buffer[position] != rune('-') {
-								goto l1960
-							}
-							position++
-							goto l1961
-						l1960:
-							position, tokenIndex = position1960, tokenIndex1960
-						}
-					l1961:
-						if c := buffer[position]; c < rune('0') || c > rune('9') {
-							goto l1951
-						}
-						position++
-					l1962:
-						{
-							position1963, tokenIndex1963 := position, tokenIndex
-							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l1963
-							}
-							position++
-							goto l1962
-						l1963:
-							position, tokenIndex = position1963, tokenIndex1963
-						}
-						if buffer[position] != rune(':') {
-							goto l1951
-						}
-						position++
-					}
-				l1954:
-					add(rulePegText, position1953)
-				}
-				if buffer[position] != rune(']') {
-					goto l1951
-				}
-				position++
-				add(rulejsonArrayPartialSlice, position1952)
+				add(ruleAction58, position)
 			}
 			return true
-		l1951:
-			position, tokenIndex = position1951, tokenIndex1951
-			return false
 		},
-		/* 180 jsonArrayFullSlice <- <('[' ':' ']')> */
+		/* 326 Action59 <- <{
+		    // This is *always* executed, even if there is no
+		    // LIMIT clause present in the statement.
+		    p.AssembleLimit(begin, end)
+		}> */
 		func() bool {
-			position1964, tokenIndex1964 := position, tokenIndex
 			{
-				position1965 := position
-				if buffer[position] != rune('[') {
-					goto l1964
-				}
-				position++
-				if buffer[position] != rune(':') {
-					goto l1964
-				}
-				position++
-				if buffer[position] != rune(']') {
-					goto l1964
-				}
-				position++
-				add(rulejsonArrayFullSlice, position1965)
+				add(ruleAction59, position)
 			}
 			return true
-		l1964:
-			position, tokenIndex = position1964, tokenIndex1964
-			return false
 		},
-		/* 181 spElem <- <(' ' / '\t' / '\n' / '\r' / comment / finalComment)> */
+		/* 327 Action60 <- <{
+		    p.EnsureAliasedStreamWindow()
+		}> */
 		func() bool {
-			position1966, tokenIndex1966 := position, tokenIndex
 			{
-				position1967 := position
-				{
-					position1968, tokenIndex1968 := position, tokenIndex
-					if buffer[position] != rune(' ') {
-						goto l1969
-					}
-					position++
-					goto l1968
-				l1969:
-					position, tokenIndex = position1968, tokenIndex1968
-					if buffer[position] != rune('\t') {
-						goto l1970
-					}
-					position++
-					goto l1968
-				l1970:
-					position, tokenIndex = position1968, tokenIndex1968
-					if buffer[position] != rune('\n') {
-						goto l1971
-					}
-					position++
-					goto l1968
-				l1971:
-					position, tokenIndex = position1968, tokenIndex1968
-					if buffer[position] != rune('\r') {
-						goto l1972
-					}
-					position++
-					goto l1968
-				l1972:
-					position, tokenIndex = position1968, tokenIndex1968
-					if !_rules[rulecomment]() {
-						goto l1973
-					}
-					goto l1968
-				l1973:
-					position, tokenIndex = position1968, tokenIndex1968
-					if !_rules[rulefinalComment]() {
-						goto l1966
-					}
-				}
-			l1968:
-				add(rulespElem, position1967)
+				add(ruleAction60, position)
 			}
 			return true
-		l1966:
-			position, tokenIndex = position1966, tokenIndex1966
-			return false
 		},
-		/* 182 sp <- <spElem+> */
+		/* 328 Action61 <- <{
+		    p.AssembleAliasedStreamWindow()
+		}> */
 		func() bool {
-			position1974, tokenIndex1974 := position, tokenIndex
 			{
-				position1975 := position
-				if !_rules[rulespElem]() {
-					goto l1974
-				}
-			l1976:
-				{
-					position1977, tokenIndex1977 := position, tokenIndex
-					if !_rules[rulespElem]() {
-						goto l1977
-					}
-					goto l1976
-				l1977:
-					position, tokenIndex = position1977, tokenIndex1977
-				}
-				add(rulesp, position1975)
+				add(ruleAction61, position)
+			}
+			return true
+		},
+		/* 329 Action62 <- <{
+		    p.AssembleStreamWindow()
+		}> */
+		func() bool {
+			{
+				add(ruleAction62, position)
 			}
 			return true
-		l1974:
-			position, tokenIndex = position1974, tokenIndex1974
-			return false
 		},
-		/* 183 spOpt <- <spElem*> */
+		/* 330 Action63 <- <{
+		    p.AssembleUDSFFuncApp()
+		}> */
 		func() bool {
 			{
-				position1979 := position
-			l1980:
-				{
-					position1981, tokenIndex1981 := position, tokenIndex
-					if !_rules[rulespElem]() {
-						goto l1981
-					}
-					goto l1980
-				l1981:
-					position, tokenIndex = position1981, tokenIndex1981
-				}
-				add(rulespOpt, position1979)
+				add(ruleAction63, position)
 			}
 			return true
 		},
-		/* 184 comment <- <('-' '-' (!('\r' / '\n') .)* ('\r' / '\n'))> */
+		/* 331 Action64 <- <{
+		    p.AssembleSession(begin, end)
+		}> */
 		func() bool {
-			position1982, tokenIndex1982 := position, tokenIndex
 			{
-				position1983 := position
-				if buffer[position] != rune('-') {
-					goto l1982
-				}
-				position++
-				if buffer[position] != rune('-') {
-					goto l1982
-				}
-				position++
-			l1984:
-				{
-					position1985, tokenIndex1985 := position, tokenIndex
-					{
-						position1986, tokenIndex1986 := position, tokenIndex
-						{
-							position1987, tokenIndex1987 := position, tokenIndex
-							if buffer[position] != rune('\r') {
-								goto l1988
-							}
-							position++
-							goto l1987
-						l1988:
-							position, tokenIndex = position1987, tokenIndex1987
-							if buffer[position] != rune('\n') {
-								goto l1986
-							}
-							position++
-						}
-					l1987:
-						goto l1985
-					l1986:
-						position, tokenIndex = position1986, tokenIndex1986
-					}
-					if !matchDot() {
-						goto l1985
-					}
-					goto l1984
-				l1985:
-					position, tokenIndex = position1985, tokenIndex1985
-				}
-				{
-					position1989, tokenIndex1989 := position, tokenIndex
-					if buffer[position] != rune('\r') {
-						goto l1990
-					}
-					position++
-					goto l1989
-				l1990:
-					position, tokenIndex = position1989, tokenIndex1989
-					if buffer[position] != rune('\n') {
-						goto l1982
-					}
-					position++
-				}
-			l1989:
-				add(rulecomment, position1983)
+				add(ruleAction64, position)
 			}
 			return true
-		l1982:
-			position, tokenIndex = position1982, tokenIndex1982
-			return false
 		},
-		/* 185 finalComment <- <('-' '-' (!('\r' / '\n') .)* !.)> */
+		/* 332 Action65 <- <{
+		    p.EnsureSlideSpec(begin, end)
+		}> */
 		func() bool {
-			position1991, tokenIndex1991 := position, tokenIndex
 			{
-				position1992 := position
-				if buffer[position] != rune('-') {
-					goto l1991
-				}
-				position++
-				if buffer[position] != rune('-') {
-					goto l1991
-				}
-				position++
-			l1993:
-				{
-					position1994, tokenIndex1994 := position, tokenIndex
-					{
-						position1995, tokenIndex1995 := position, tokenIndex
-						{
-							position1996, tokenIndex1996 := position, tokenIndex
-							if buffer[position] != rune('\r') {
-								goto l1997
-							}
-							position++
-							goto l1996
-						l1997:
-							position, tokenIndex = position1996, tokenIndex1996
-							if buffer[position] != rune('\n') {
-								goto l1995
-							}
-							position++
-						}
-					l1996:
-						goto l1994
-					l1995:
-						position, tokenIndex = position1995, tokenIndex1995
-					}
-					if !matchDot() {
-						goto l1994
-					}
-					goto l1993
-				l1994:
-					position, tokenIndex = position1994, tokenIndex1994
-				}
-				{
-					position1998, tokenIndex1998 := position, tokenIndex
-					if !matchDot() {
-						goto l1998
-					}
-					goto l1991
-				l1998:
-					position, tokenIndex = position1998, tokenIndex1998
-				}
-				add(rulefinalComment, position1992)
+				add(ruleAction65, position)
 			}
 			return true
-		l1991:
-			position, tokenIndex = position1991, tokenIndex1991
-			return false
 		},
-		nil,
-		/* 188 Action0 <- <{
-		    p.IncludeTrailingWhitespace(begin, end)
+		/* 333 Action66 <- <{
+		    p.AssembleSlide()
 		}> */
 		func() bool {
 			{
-				add(ruleAction0, position)
+				add(ruleAction66, position)
 			}
 			return true
 		},
-		/* 189 Action1 <- <{
-		    p.IncludeTrailingWhitespace(begin, end)
+		/* 334 Action67 <- <{
+		    p.EnsureLatenessSpec(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction1, position)
+				add(ruleAction67, position)
 			}
 			return true
 		},
-		/* 190 Action2 <- <{
-		    p.AssembleSelect()
+		/* 335 Action68 <- <{
+		    p.AssembleLateness()
 		}> */
 		func() bool {
 			{
-				add(ruleAction2, position)
+				add(ruleAction68, position)
 			}
 			return true
 		},
-		/* 191 Action3 <- <{
-		    p.AssembleSelectUnion(begin, end)
+		/* 336 Action69 <- <{
+		    p.EnsureLatePolicy(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction3, position)
+				add(ruleAction69, position)
 			}
 			return true
 		},
-		/* 192 Action4 <- <{
-		    p.AssembleCreateStreamAsSelect()
+		/* 337 Action70 <- <{
+		    p.AssembleEmitLateTuples()
 		}> */
 		func() bool {
 			{
-				add(ruleAction4, position)
+				add(ruleAction70, position)
 			}
 			return true
 		},
-		/* 193 Action5 <- <{
-		    p.AssembleCreateStreamAsSelectUnion()
+		/* 338 Action71 <- <{
+		    p.EnsureCapacitySpec(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction5, position)
+				add(ruleAction71, position)
 			}
 			return true
 		},
-		/* 194 Action6 <- <{
-		    p.AssembleCreateSource()
+		/* 339 Action72 <- <{
+		    p.EnsureSheddingSpec(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction6, position)
+				add(ruleAction72, position)
 			}
 			return true
 		},
-		/* 195 Action7 <- <{
-		    p.AssembleCreateSink()
+		/* 340 Action73 <- <{
+		    p.AssembleSourceSinkSpecs(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction7, position)
+				add(ruleAction73, position)
 			}
 			return true
 		},
-		/* 196 Action8 <- <{
-		    p.AssembleCreateState()
+		/* 341 Action74 <- <{
+		    p.AssembleSourceSinkSpecs(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction8, position)
+				add(ruleAction74, position)
 			}
 			return true
 		},
-		/* 197 Action9 <- <{
-		    p.AssembleUpdateState()
+		/* 342 Action75 <- <{
+		    p.AssembleSourceSinkSpecs(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction9, position)
+				add(ruleAction75, position)
 			}
 			return true
 		},
-		/* 198 Action10 <- <{
-		    p.AssembleUpdateSource()
+		/* 343 Action76 <- <{
+		    p.AssembleOnError(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction10, position)
+				add(ruleAction76, position)
 			}
 			return true
 		},
-		/* 199 Action11 <- <{
-		    p.AssembleUpdateSink()
+		/* 344 Action77 <- <{
+		    p.EnsureIdentifier(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction11, position)
+				add(ruleAction77, position)
 			}
 			return true
 		},
-		/* 200 Action12 <- <{
-		    p.AssembleInsertIntoFrom()
+		/* 345 Action78 <- <{
+		    p.AssembleSourceSinkParam()
 		}> */
 		func() bool {
 			{
-				add(ruleAction12, position)
+				add(ruleAction78, position)
 			}
 			return true
 		},
-		/* 201 Action13 <- <{
-		    p.AssemblePauseSource()
+		/* 346 Action79 <- <{
+		    p.AssembleExpressions(begin, end)
+		    p.AssembleArray()
 		}> */
 		func() bool {
 			{
-				add(ruleAction13, position)
+				add(ruleAction79, position)
 			}
 			return true
 		},
-		/* 202 Action14 <- <{
-		    p.AssembleResumeSource()
+		/* 347 Action80 <- <{
+		    p.AssembleMap(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction14, position)
+				add(ruleAction80, position)
 			}
 			return true
 		},
-		/* 203 Action15 <- <{
-		    p.AssembleRewindSource()
+		/* 348 Action81 <- <{
+		    p.AssembleKeyValuePair()
 		}> */
 		func() bool {
 			{
-				add(ruleAction15, position)
+				add(ruleAction81, position)
 			}
 			return true
 		},
-		/* 204 Action16 <- <{
-		    p.AssembleDropSource()
+		/* 349 Action82 <- <{
+		    p.EnsureKeywordPresent(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction16, position)
+				add(ruleAction82, position)
 			}
 			return true
 		},
-		/* 205 Action17 <- <{
-		    p.AssembleDropStream()
+		/* 350 Action83 <- <{
+		    // This is *always* executed, even if there is no
+		    // schema present in the statement.
+		    p.AssembleSchema(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction17, position)
+				add(ruleAction83, position)
 			}
 			return true
 		},
-		/* 206 Action18 <- <{
-		    p.AssembleDropSink()
+		/* 351 Action84 <- <{
+		    p.AssembleSchemaField()
 		}> */
 		func() bool {
 			{
-				add(ruleAction18, position)
+				add(ruleAction84, position)
 			}
 			return true
 		},
-		/* 207 Action19 <- <{
-		    p.AssembleDropState()
+		/* 352 Action85 <- <{
+		    substr := string([]rune(buffer)[begin:end])
+		    p.PushComponent(begin, end, Identifier(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction19, position)
+				add(ruleAction85, position)
 			}
 			return true
 		},
-		/* 208 Action20 <- <{
-		    p.AssembleLoadState()
+		/* 353 Action86 <- <{
+		    p.EnsureKeywordPresent(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction20, position)
+				add(ruleAction86, position)
 			}
 			return true
 		},
-		/* 209 Action21 <- <{
-		    p.AssembleLoadStateOrCreate()
+		/* 354 Action87 <- <{
+		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction21, position)
+				add(ruleAction87, position)
 			}
 			return true
 		},
-		/* 210 Action22 <- <{
-		    p.AssembleSaveState()
+		/* 355 Action88 <- <{
+		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction22, position)
+				add(ruleAction88, position)
 			}
 			return true
 		},
-		/* 211 Action23 <- <{
-		    p.AssembleEval(begin, end)
+		/* 356 Action89 <- <{
+		    p.AssembleUnaryPrefixOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction23, position)
+				add(ruleAction89, position)
 			}
 			return true
 		},
-		/* 212 Action24 <- <{
-		    p.AssembleEmitter()
+		/* 357 Action90 <- <{
+		    p.AssembleComparison(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction24, position)
+				add(ruleAction90, position)
 			}
 			return true
 		},
-		/* 213 Action25 <- <{
-		    p.AssembleEmitterOptions(begin, end)
+		/* 358 Action91 <- <{
+		    p.AssembleExpressions(begin, end)
+		    p.AssembleArray()
 		}> */
 		func() bool {
 			{
-				add(ruleAction25, position)
+				add(ruleAction91, position)
 			}
 			return true
 		},
-		/* 214 Action26 <- <{
-		    p.AssembleEmitterLimit()
+		/* 359 Action92 <- <{
+		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction26, position)
+				add(ruleAction92, position)
 			}
 			return true
 		},
-		/* 215 Action27 <- <{
-		    p.AssembleEmitterSampling(CountBasedSampling, 1)
+		/* 360 Action93 <- <{
+		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction27, position)
+				add(ruleAction93, position)
 			}
 			return true
 		},
-		/* 216 Action28 <- <{
-		    p.AssembleEmitterSampling(RandomizedSampling, 1)
+		/* 361 Action94 <- <{
+		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction28, position)
+				add(ruleAction94, position)
 			}
 			return true
 		},
-		/* 217 Action29 <- <{
-		    p.AssembleEmitterSampling(TimeBasedSampling, 1)
+		/* 362 Action95 <- <{
+		    p.AssembleBinaryOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction29, position)
+				add(ruleAction95, position)
 			}
 			return true
 		},
-		/* 218 Action30 <- <{
-		    p.AssembleEmitterSampling(TimeBasedSampling, 0.001)
+		/* 363 Action96 <- <{
+		    p.AssembleUnaryPrefixOperation(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction30, position)
+				add(ruleAction96, position)
 			}
 			return true
 		},
-		/* 219 Action31 <- <{
-		    p.AssembleProjections(begin, end)
+		/* 364 Action97 <- <{
+		    p.AssembleTypeCast(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction31, position)
+				add(ruleAction97, position)
 			}
 			return true
 		},
-		/* 220 Action32 <- <{
-		    p.AssembleAlias()
+		/* 365 Action98 <- <{
+		    p.AssembleTypeCast(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction32, position)
+				add(ruleAction98, position)
 			}
 			return true
 		},
-		/* 221 Action33 <- <{
-		    // This is *always* executed, even if there is no
-		    // FROM clause present in the statement.
-		    p.AssembleWindowedFrom(begin, end)
+		/* 366 Action99 <- <{
+		    p.AssembleAnalyticFuncApp(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction33, position)
+				add(ruleAction99, position)
 			}
 			return true
 		},
-		/* 222 Action34 <- <{
-		    p.AssembleInterval()
+		/* 367 Action100 <- <{
+		    p.AssembleExpressions(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction34, position)
+				add(ruleAction100, position)
 			}
 			return true
 		},
-		/* 223 Action35 <- <{
-		    p.AssembleInterval()
+		/* 368 Action101 <- <{
+		    p.AssembleExpressions(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction35, position)
+				add(ruleAction101, position)
 			}
 			return true
 		},
-		/* 224 Action36 <- <{
-		    // This is *always* executed, even if there is no
-		    // WHERE clause present in the statement.
-		    p.AssembleFilter(begin, end)
+		/* 369 Action102 <- <{
+		    p.AssembleFuncApp()
 		}> */
 		func() bool {
 			{
-				add(ruleAction36, position)
+				add(ruleAction102, position)
 			}
 			return true
 		},
-		/* 225 Action37 <- <{
-		    // This is *always* executed, even if there is no
-		    // GROUP BY clause present in the statement.
-		    p.AssembleGrouping(begin, end)
+		/* 370 Action103 <- <{
+		    p.AssembleExpressions(begin, end)
+		    p.AssembleFuncApp()
 		}> */
 		func() bool {
 			{
-				add(ruleAction37, position)
+				add(ruleAction103, position)
 			}
 			return true
 		},
-		/* 226 Action38 <- <{
-		    // This is *always* executed, even if there is no
-		    // HAVING clause present in the statement.
-		    p.AssembleHaving(begin, end)
+		/* 371 Action104 <- <{
+		    p.AssembleDistinct(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction38, position)
+				add(ruleAction104, position)
 			}
 			return true
 		},
-		/* 227 Action39 <- <{
-		    p.EnsureAliasedStreamWindow()
+		/* 372 Action105 <- <{
+		    p.AssembleExpressions(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction39, position)
+				add(ruleAction105, position)
 			}
 			return true
 		},
-		/* 228 Action40 <- <{
-		    p.AssembleAliasedStreamWindow()
+		/* 373 Action106 <- <{
+		    p.AssembleLambda()
 		}> */
 		func() bool {
 			{
-				add(ruleAction40, position)
+				add(ruleAction106, position)
 			}
 			return true
 		},
-		/* 229 Action41 <- <{
-		    p.AssembleStreamWindow()
+		/* 374 Action107 <- <{
+		    p.AssembleExpressions(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction41, position)
+				add(ruleAction107, position)
 			}
 			return true
 		},
-		/* 230 Action42 <- <{
-		    p.AssembleUDSFFuncApp()
+		/* 375 Action108 <- <{
+		    p.AssembleSortedExpression()
 		}> */
 		func() bool {
 			{
-				add(ruleAction42, position)
+				add(ruleAction108, position)
 			}
 			return true
 		},
-		/* 231 Action43 <- <{
-		    p.EnsureCapacitySpec(begin, end)
+		/* 376 Action109 <- <{
+		    p.EnsureKeywordPresent(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction43, position)
+				add(ruleAction109, position)
 			}
 			return true
 		},
-		/* 232 Action44 <- <{
-		    p.EnsureSheddingSpec(begin, end)
+		/* 377 Action110 <- <{
+		    p.AssembleExpressions(begin, end)
+		    p.AssembleArray()
 		}> */
 		func() bool {
 			{
-				add(ruleAction44, position)
+				add(ruleAction110, position)
 			}
 			return true
 		},
-		/* 233 Action45 <- <{
-		    p.AssembleSourceSinkSpecs(begin, end)
+		/* 378 Action111 <- <{
+		    p.AssembleMap(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction45, position)
+				add(ruleAction111, position)
 			}
 			return true
 		},
-		/* 234 Action46 <- <{
-		    p.AssembleSourceSinkSpecs(begin, end)
+		/* 379 Action112 <- <{
+		    p.AssembleKeyValuePair()
 		}> */
 		func() bool {
 			{
-				add(ruleAction46, position)
+				add(ruleAction112, position)
 			}
 			return true
 		},
-		/* 235 Action47 <- <{
-		    p.AssembleSourceSinkSpecs(begin, end)
+		/* 380 Action113 <- <{
+		    p.AssembleConditionCase(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction47, position)
+				add(ruleAction113, position)
 			}
 			return true
 		},
-		/* 236 Action48 <- <{
-		    p.EnsureIdentifier(begin, end)
+		/* 381 Action114 <- <{
+		    p.AssembleExpressionCase(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction48, position)
+				add(ruleAction114, position)
 			}
 			return true
 		},
-		/* 237 Action49 <- <{
-		    p.AssembleSourceSinkParam()
+		/* 382 Action115 <- <{
+		    p.AssembleWhenThenPair()
 		}> */
 		func() bool {
 			{
-				add(ruleAction49, position)
+				add(ruleAction115, position)
 			}
 			return true
 		},
-		/* 238 Action50 <- <{
-		    p.AssembleExpressions(begin, end)
-		    p.AssembleArray()
+		/* 383 Action116 <- <{
+		    p.AssembleIntervalLiteral(begin, end)
 		}> */
 		func() bool {
 			{
-				add(ruleAction50, position)
+				add(ruleAction116, position)
 			}
 			return true
 		},
-		/* 239 Action51 <- <{
-		    p.AssembleMap(begin, end)
+		/* 384 Action117 <- <{
+		    substr := string([]rune(buffer)[begin:end])
+		    p.PushComponent(begin, end, NewStream(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction51, position)
+				add(ruleAction117, position)
 			}
 			return true
 		},
-		/* 240 Action52 <- <{
-		    p.AssembleKeyValuePair()
+		/* 385 Action118 <- <{
+		    substr := string([]rune(buffer)[begin:end])
+		    p.PushComponent(begin, end, NewRowMeta(substr, TimestampMeta))
 		}> */
 		func() bool {
 			{
-				add(ruleAction52, position)
+				add(ruleAction118, position)
 			}
 			return true
 		},
-		/* 241 Action53 <- <{
-		    p.EnsureKeywordPresent(begin, end)
+		/* 386 Action119 <- <{
+		    substr := string([]rune(buffer)[begin:end])
+		    p.PushComponent(begin, end, NewRowValue(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction53, position)
+				add(ruleAction119, position)
 			}
 			return true
 		},
-		/* 242 Action54 <- <{
-		    p.AssembleBinaryOperation(begin, end)
+		/* 387 Action120 <- <{
+		    substr := string([]rune(buffer)[begin:end])
+		    p.PushComponent(begin, end, NewNumericLiteral(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction54, position)
+				add(ruleAction120, position)
 			}
 			return true
 		},
-		/* 243 Action55 <- <{
-		    p.AssembleBinaryOperation(begin, end)
+		/* 388 Action121 <- <{
+		    substr := string([]rune(buffer)[begin:end])
+		    p.PushComponent(begin, end, NewNumericLiteral(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction55, position)
+				add(ruleAction121, position)
 			}
 			return true
 		},
-		/* 244 Action56 <- <{
-		    p.AssembleUnaryPrefixOperation(begin, end)
+		/* 389 Action122 <- <{
+		    substr := string([]rune(buffer)[begin:end])
+		    p.PushComponent(begin, end, NewFloatLiteral(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction56, position)
+				add(ruleAction122, position)
 			}
 			return true
 		},
-		/* 245 Action57 <- <{
-		    p.AssembleBinaryOperation(begin, end)
+		/* 390 Action123 <- <{
+		    substr := string([]rune(buffer)[begin:end])
+		    p.PushComponent(begin, end, FuncName(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction57, position)
+				add(ruleAction123, position)
 			}
 			return true
 		},
-		/* 246 Action58 <- <{
-		    p.AssembleBinaryOperation(begin, end)
+		/* 391 Action124 <- <{
+		    p.PushComponent(begin, end, NewNullLiteral())
 		}> */
 		func() bool {
 			{
-				add(ruleAction58, position)
+				add(ruleAction124, position)
 			}
 			return true
 		},
-		/* 247 Action59 <- <{
-		    p.AssembleBinaryOperation(begin, end)
+		/* 392 Action125 <- <{
+		    p.PushComponent(begin, end, NewMissing())
 		}> */
 		func() bool {
 			{
-				add(ruleAction59, position)
+				add(ruleAction125, position)
 			}
 			return true
 		},
-		/* 248 Action60 <- <{
-		    p.AssembleBinaryOperation(begin, end)
+		/* 393 Action126 <- <{
+		    p.PushComponent(begin, end, NewBoolLiteral(true))
 		}> */
 		func() bool {
 			{
-				add(ruleAction60, position)
+				add(ruleAction126, position)
 			}
 			return true
 		},
-		/* 249 Action61 <- <{
-		    p.AssembleBinaryOperation(begin, end)
+		/* 394 Action127 <- <{
+		    p.PushComponent(begin, end, NewBoolLiteral(false))
 		}> */
 		func() bool {
 			{
-				add(ruleAction61, position)
+				add(ruleAction127, position)
 			}
 			return true
 		},
-		/* 250 Action62 <- <{
-		    p.AssembleUnaryPrefixOperation(begin, end)
+		/* 395 Action128 <- <{
+		    substr := string([]rune(buffer)[begin:end])
+		    p.PushComponent(begin, end, NewWildcard(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction62, position)
+				add(ruleAction128, position)
 			}
 			return true
 		},
-		/* 251 Action63 <- <{
-		    p.AssembleTypeCast(begin, end)
+		/* 396 Action129 <- <{
+		    substr := string([]rune(buffer)[begin:end])
+		    p.PushComponent(begin, end, NewStringLiteral(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction63, position)
+				add(ruleAction129, position)
 			}
 			return true
 		},
-		/* 252 Action64 <- <{
-		    p.AssembleTypeCast(begin, end)
+		/* 397 Action130 <- <{
+		    p.PushComponent(begin, end, ShowSources)
 		}> */
 		func() bool {
 			{
-				add(ruleAction64, position)
+				add(ruleAction130, position)
 			}
 			return true
 		},
-		/* 253 Action65 <- <{
-		    p.AssembleFuncApp()
+		/* 398 Action131 <- <{
+		    p.PushComponent(begin, end, ShowStreams)
 		}> */
 		func() bool {
 			{
-				add(ruleAction65, position)
+				add(ruleAction131, position)
 			}
 			return true
 		},
-		/* 254 Action66 <- <{
-		    p.AssembleExpressions(begin, end)
-		    p.AssembleFuncApp()
+		/* 399 Action132 <- <{
+		    p.PushComponent(begin, end, ShowSinks)
 		}> */
 		func() bool {
 			{
-				add(ruleAction66, position)
+				add(ruleAction132, position)
 			}
 			return true
 		},
-		/* 255 Action67 <- <{
-		    p.AssembleExpressions(begin, end)
+		/* 400 Action133 <- <{
+		    p.PushComponent(begin, end, ShowStates)
 		}> */
 		func() bool {
 			{
-				add(ruleAction67, position)
+				add(ruleAction133, position)
 			}
 			return true
 		},
-		/* 256 Action68 <- <{
-		    p.AssembleExpressions(begin, end)
+		/* 401 Action134 <- <{
+		    p.PushComponent(begin, end, ShowFunctions)
 		}> */
 		func() bool {
 			{
-				add(ruleAction68, position)
+				add(ruleAction134, position)
 			}
 			return true
 		},
-		/* 257 Action69 <- <{
-		    p.AssembleSortedExpression()
+		/* 402 Action135 <- <{
+		    p.PushComponent(begin, end, ShowSourceTypes)
 		}> */
 		func() bool {
 			{
-				add(ruleAction69, position)
+				add(ruleAction135, position)
 			}
 			return true
 		},
-		/* 258 Action70 <- <{
-		    p.EnsureKeywordPresent(begin, end)
+		/* 403 Action136 <- <{
+		    p.PushComponent(begin, end, ShowSinkTypes)
 		}> */
 		func() bool {
 			{
-				add(ruleAction70, position)
+				add(ruleAction136, position)
 			}
 			return true
 		},
-		/* 259 Action71 <- <{
-		    p.AssembleExpressions(begin, end)
-		    p.AssembleArray()
+		/* 404 Action137 <- <{
+		    p.PushComponent(begin, end, ShowStateTypes)
 		}> */
 		func() bool {
 			{
-				add(ruleAction71, position)
+				add(ruleAction137, position)
 			}
 			return true
 		},
-		/* 260 Action72 <- <{
-		    p.AssembleMap(begin, end)
+		/* 405 Action138 <- <{
+		    p.PushComponent(begin, end, Istream)
 		}> */
 		func() bool {
 			{
-				add(ruleAction72, position)
+				add(ruleAction138, position)
 			}
 			return true
 		},
-		/* 261 Action73 <- <{
-		    p.AssembleKeyValuePair()
+		/* 406 Action139 <- <{
+		    p.PushComponent(begin, end, Dstream)
 		}> */
 		func() bool {
 			{
-				add(ruleAction73, position)
+				add(ruleAction139, position)
 			}
 			return true
 		},
-		/* 262 Action74 <- <{
-		    p.AssembleConditionCase(begin, end)
+		/* 407 Action140 <- <{
+		    p.PushComponent(begin, end, Rstream)
 		}> */
 		func() bool {
 			{
-				add(ruleAction74, position)
+				add(ruleAction140, position)
 			}
 			return true
 		},
-		/* 263 Action75 <- <{
-		    p.AssembleExpressionCase(begin, end)
+		/* 408 Action141 <- <{
+		    p.PushComponent(begin, end, Tuples)
 		}> */
 		func() bool {
 			{
-				add(ruleAction75, position)
+				add(ruleAction141, position)
 			}
 			return true
 		},
-		/* 264 Action76 <- <{
-		    p.AssembleWhenThenPair()
+		/* 409 Action142 <- <{
+		    p.PushComponent(begin, end, Seconds)
 		}> */
 		func() bool {
 			{
-				add(ruleAction76, position)
+				add(ruleAction142, position)
 			}
 			return true
 		},
-		/* 265 Action77 <- <{
-		    substr := string([]rune(buffer)[begin:end])
-		    p.PushComponent(begin, end, NewStream(substr))
+		/* 410 Action143 <- <{
+		    p.PushComponent(begin, end, Milliseconds)
 		}> */
 		func() bool {
 			{
-				add(ruleAction77, position)
+				add(ruleAction143, position)
 			}
 			return true
 		},
-		/* 266 Action78 <- <{
-		    substr := string([]rune(buffer)[begin:end])
-		    p.PushComponent(begin, end, NewRowMeta(substr, TimestampMeta))
+		/* 411 Action144 <- <{
+		    p.PushComponent(begin, end, Minutes)
 		}> */
 		func() bool {
 			{
-				add(ruleAction78, position)
+				add(ruleAction144, position)
 			}
 			return true
 		},
-		/* 267 Action79 <- <{
-		    substr := string([]rune(buffer)[begin:end])
-		    p.PushComponent(begin, end, NewRowValue(substr))
+		/* 412 Action145 <- <{
+		    p.PushComponent(begin, end, Hours)
 		}> */
 		func() bool {
 			{
-				add(ruleAction79, position)
+				add(ruleAction145, position)
 			}
 			return true
 		},
-		/* 268 Action80 <- <{
-		    substr := string([]rune(buffer)[begin:end])
-		    p.PushComponent(begin, end, NewNumericLiteral(substr))
+		/* 413 Action146 <- <{
+		    p.PushComponent(begin, end, SlideAST{Tumbling: true})
 		}> */
 		func() bool {
 			{
-				add(ruleAction80, position)
+				add(ruleAction146, position)
 			}
 			return true
 		},
-		/* 269 Action81 <- <{
-		    substr := string([]rune(buffer)[begin:end])
-		    p.PushComponent(begin, end, NewNumericLiteral(substr))
+		/* 414 Action147 <- <{
+		    p.PushComponent(begin, end, LatePolicyAST{Policy: DropLateTuples})
 		}> */
 		func() bool {
 			{
-				add(ruleAction81, position)
+				add(ruleAction147, position)
 			}
 			return true
 		},
-		/* 270 Action82 <- <{
-		    substr := string([]rune(buffer)[begin:end])
-		    p.PushComponent(begin, end, NewFloatLiteral(substr))
+		/* 415 Action148 <- <{
+		    p.PushComponent(begin, end, LatePolicyAST{Policy: UpdateLateTuples})
 		}> */
 		func() bool {
 			{
-				add(ruleAction82, position)
+				add(ruleAction148, position)
 			}
 			return true
 		},
-		/* 271 Action83 <- <{
-		    substr := string([]rune(buffer)[begin:end])
-		    p.PushComponent(begin, end, FuncName(substr))
+		/* 416 Action149 <- <{
+		    p.PushComponent(begin, end, Wait)
 		}> */
 		func() bool {
 			{
-				add(ruleAction83, position)
+				add(ruleAction149, position)
 			}
 			return true
 		},
-		/* 272 Action84 <- <{
-		    p.PushComponent(begin, end, NewNullLiteral())
+		/* 417 Action150 <- <{
+		    p.PushComponent(begin, end, DropOldest)
 		}> */
 		func() bool {
 			{
-				add(ruleAction84, position)
+				add(ruleAction150, position)
 			}
 			return true
 		},
-		/* 273 Action85 <- <{
-		    p.PushComponent(begin, end, NewMissing())
+		/* 418 Action151 <- <{
+		    p.PushComponent(begin, end, DropNewest)
 		}> */
 		func() bool {
 			{
-				add(ruleAction85, position)
+				add(ruleAction151, position)
 			}
 			return true
 		},
-		/* 274 Action86 <- <{
-		    p.PushComponent(begin, end, NewBoolLiteral(true))
+		/* 419 Action152 <- <{
+		    p.PushComponent(begin, end, InnerJoin)
 		}> */
 		func() bool {
 			{
-				add(ruleAction86, position)
+				add(ruleAction152, position)
 			}
 			return true
 		},
-		/* 275 Action87 <- <{
-		    p.PushComponent(begin, end, NewBoolLiteral(false))
+		/* 420 Action153 <- <{
+		    p.PushComponent(begin, end, LeftOuterJoin)
 		}> */
 		func() bool {
 			{
-				add(ruleAction87, position)
+				add(ruleAction153, position)
 			}
 			return true
 		},
-		/* 276 Action88 <- <{
+		/* 421 Action154 <- <{
 		    substr := string([]rune(buffer)[begin:end])
-		    p.PushComponent(begin, end, NewWildcard(substr))
+		    p.PushComponent(begin, end, StreamIdentifier(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction88, position)
+				add(ruleAction154, position)
 			}
 			return true
 		},
-		/* 277 Action89 <- <{
+		/* 422 Action155 <- <{
 		    substr := string([]rune(buffer)[begin:end])
-		    p.PushComponent(begin, end, NewStringLiteral(substr))
+		    p.PushComponent(begin, end, SourceSinkType(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction89, position)
+				add(ruleAction155, position)
 			}
 			return true
 		},
-		/* 278 Action90 <- <{
-		    p.PushComponent(begin, end, Istream)
+		/* 423 Action156 <- <{
+		    substr := string([]rune(buffer)[begin:end])
+		    p.PushComponent(begin, end, SourceSinkParamKey(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction90, position)
+				add(ruleAction156, position)
 			}
 			return true
 		},
-		/* 279 Action91 <- <{
-		    p.PushComponent(begin, end, Dstream)
+		/* 424 Action157 <- <{
+		    p.PushComponent(begin, end, Yes)
 		}> */
 		func() bool {
 			{
-				add(ruleAction91, position)
+				add(ruleAction157, position)
 			}
 			return true
 		},
-		/* 280 Action92 <- <{
-		    p.PushComponent(begin, end, Rstream)
+		/* 425 Action158 <- <{
+		    p.PushComponent(begin, end, No)
 		}> */
 		func() bool {
 			{
-				add(ruleAction92, position)
+				add(ruleAction158, position)
 			}
 			return true
 		},
-		/* 281 Action93 <- <{
-		    p.PushComponent(begin, end, Tuples)
+		/* 426 Action159 <- <{
+		    p.PushComponent(begin, end, Yes)
 		}> */
 		func() bool {
 			{
-				add(ruleAction93, position)
+				add(ruleAction159, position)
 			}
 			return true
 		},
-		/* 282 Action94 <- <{
-		    p.PushComponent(begin, end, Seconds)
+		/* 427 Action160 <- <{
+		    p.PushComponent(begin, end, Yes)
 		}> */
 		func() bool {
 			{
-				add(ruleAction94, position)
+				add(ruleAction160, position)
 			}
 			return true
 		},
-		/* 283 Action95 <- <{
-		    p.PushComponent(begin, end, Milliseconds)
+		/* 428 Action161 <- <{
+		    p.PushComponent(begin, end, No)
 		}> */
 		func() bool {
 			{
-				add(ruleAction95, position)
+				add(ruleAction161, position)
 			}
 			return true
 		},
-		/* 284 Action96 <- <{
-		    p.PushComponent(begin, end, Wait)
+		/* 429 Action162 <- <{
+		    p.PushComponent(begin, end, Bool)
 		}> */
 		func() bool {
 			{
-				add(ruleAction96, position)
+				add(ruleAction162, position)
 			}
 			return true
 		},
-		/* 285 Action97 <- <{
-		    p.PushComponent(begin, end, DropOldest)
+		/* 430 Action163 <- <{
+		    p.PushComponent(begin, end, Int)
 		}> */
 		func() bool {
 			{
-				add(ruleAction97, position)
+				add(ruleAction163, position)
 			}
 			return true
 		},
-		/* 286 Action98 <- <{
-		    p.PushComponent(begin, end, DropNewest)
+		/* 431 Action164 <- <{
+		    p.PushComponent(begin, end, Float)
 		}> */
 		func() bool {
 			{
-				add(ruleAction98, position)
+				add(ruleAction164, position)
 			}
 			return true
 		},
-		/* 287 Action99 <- <{
-		    substr := string([]rune(buffer)[begin:end])
-		    p.PushComponent(begin, end, StreamIdentifier(substr))
+		/* 432 Action165 <- <{
+		    p.PushComponent(begin, end, String)
 		}> */
 		func() bool {
 			{
-				add(ruleAction99, position)
+				add(ruleAction165, position)
 			}
 			return true
 		},
-		/* 288 Action100 <- <{
-		    substr := string([]rune(buffer)[begin:end])
-		    p.PushComponent(begin, end, SourceSinkType(substr))
+		/* 433 Action166 <- <{
+		    p.PushComponent(begin, end, Blob)
 		}> */
 		func() bool {
 			{
-				add(ruleAction100, position)
+				add(ruleAction166, position)
 			}
 			return true
 		},
-		/* 289 Action101 <- <{
-		    substr := string([]rune(buffer)[begin:end])
-		    p.PushComponent(begin, end, SourceSinkParamKey(substr))
+		/* 434 Action167 <- <{
+		    p.PushComponent(begin, end, Timestamp)
 		}> */
 		func() bool {
 			{
-				add(ruleAction101, position)
+				add(ruleAction167, position)
 			}
 			return true
 		},
-		/* 290 Action102 <- <{
-		    p.PushComponent(begin, end, Yes)
+		/* 435 Action168 <- <{
+		    p.PushComponent(begin, end, Array)
 		}> */
 		func() bool {
 			{
-				add(ruleAction102, position)
+				add(ruleAction168, position)
 			}
 			return true
 		},
-		/* 291 Action103 <- <{
-		    p.PushComponent(begin, end, No)
+		/* 436 Action169 <- <{
+		    p.PushComponent(begin, end, Map)
 		}> */
 		func() bool {
 			{
-				add(ruleAction103, position)
+				add(ruleAction169, position)
 			}
 			return true
 		},
-		/* 292 Action104 <- <{
-		    p.PushComponent(begin, end, Yes)
+		/* 437 Action170 <- <{
+		    p.PushComponent(begin, end, Or)
 		}> */
 		func() bool {
 			{
-				add(ruleAction104, position)
+				add(ruleAction170, position)
 			}
 			return true
 		},
-		/* 293 Action105 <- <{
-		    p.PushComponent(begin, end, No)
+		/* 438 Action171 <- <{
+		    p.PushComponent(begin, end, And)
 		}> */
 		func() bool {
 			{
-				add(ruleAction105, position)
+				add(ruleAction171, position)
 			}
 			return true
 		},
-		/* 294 Action106 <- <{
-		    p.PushComponent(begin, end, Bool)
+		/* 439 Action172 <- <{
+		    p.PushComponent(begin, end, Not)
 		}> */
 		func() bool {
 			{
-				add(ruleAction106, position)
+				add(ruleAction172, position)
 			}
 			return true
 		},
-		/* 295 Action107 <- <{
-		    p.PushComponent(begin, end, Int)
+		/* 440 Action173 <- <{
+		    p.PushComponent(begin, end, Equal)
 		}> */
 		func() bool {
 			{
-				add(ruleAction107, position)
+				add(ruleAction173, position)
 			}
 			return true
 		},
-		/* 296 Action108 <- <{
-		    p.PushComponent(begin, end, Float)
+		/* 441 Action174 <- <{
+		    p.PushComponent(begin, end, Less)
 		}> */
 		func() bool {
 			{
-				add(ruleAction108, position)
+				add(ruleAction174, position)
 			}
 			return true
 		},
-		/* 297 Action109 <- <{
-		    p.PushComponent(begin, end, String)
+		/* 442 Action175 <- <{
+		    p.PushComponent(begin, end, LessOrEqual)
 		}> */
 		func() bool {
 			{
-				add(ruleAction109, position)
+				add(ruleAction175, position)
 			}
 			return true
 		},
-		/* 298 Action110 <- <{
-		    p.PushComponent(begin, end, Blob)
+		/* 443 Action176 <- <{
+		    p.PushComponent(begin, end, Greater)
 		}> */
 		func() bool {
 			{
-				add(ruleAction110, position)
+				add(ruleAction176, position)
 			}
 			return true
 		},
-		/* 299 Action111 <- <{
-		    p.PushComponent(begin, end, Timestamp)
+		/* 444 Action177 <- <{
+		    p.PushComponent(begin, end, GreaterOrEqual)
 		}> */
 		func() bool {
 			{
-				add(ruleAction111, position)
+				add(ruleAction177, position)
 			}
 			return true
 		},
-		/* 300 Action112 <- <{
-		    p.PushComponent(begin, end, Array)
+		/* 445 Action178 <- <{
+		    p.PushComponent(begin, end, NotEqual)
 		}> */
 		func() bool {
 			{
-				add(ruleAction112, position)
+				add(ruleAction178, position)
 			}
 			return true
 		},
-		/* 301 Action113 <- <{
-		    p.PushComponent(begin, end, Map)
+		/* 446 Action179 <- <{
+		    p.PushComponent(begin, end, Like)
 		}> */
 		func() bool {
 			{
-				add(ruleAction113, position)
+				add(ruleAction179, position)
 			}
 			return true
 		},
-		/* 302 Action114 <- <{
-		    p.PushComponent(begin, end, Or)
+		/* 447 Action180 <- <{
+		    p.PushComponent(begin, end, NotLike)
 		}> */
 		func() bool {
 			{
-				add(ruleAction114, position)
+				add(ruleAction180, position)
 			}
 			return true
 		},
-		/* 303 Action115 <- <{
-		    p.PushComponent(begin, end, And)
+		/* 448 Action181 <- <{
+		    p.PushComponent(begin, end, ILike)
 		}> */
 		func() bool {
 			{
-				add(ruleAction115, position)
+				add(ruleAction181, position)
 			}
 			return true
 		},
-		/* 304 Action116 <- <{
-		    p.PushComponent(begin, end, Not)
+		/* 449 Action182 <- <{
+		    p.PushComponent(begin, end, NotILike)
 		}> */
 		func() bool {
 			{
-				add(ruleAction116, position)
+				add(ruleAction182, position)
 			}
 			return true
 		},
-		/* 305 Action117 <- <{
-		    p.PushComponent(begin, end, Equal)
+		/* 450 Action183 <- <{
+		    p.PushComponent(begin, end, RegexMatch)
 		}> */
 		func() bool {
 			{
-				add(ruleAction117, position)
+				add(ruleAction183, position)
 			}
 			return true
 		},
-		/* 306 Action118 <- <{
-		    p.PushComponent(begin, end, Less)
+		/* 451 Action184 <- <{
+		    p.PushComponent(begin, end, NotRegexMatch)
 		}> */
 		func() bool {
 			{
-				add(ruleAction118, position)
+				add(ruleAction184, position)
 			}
 			return true
 		},
-		/* 307 Action119 <- <{
-		    p.PushComponent(begin, end, LessOrEqual)
+		/* 452 Action185 <- <{
+		    p.PushComponent(begin, end, In)
 		}> */
 		func() bool {
 			{
-				add(ruleAction119, position)
+				add(ruleAction185, position)
 			}
 			return true
 		},
-		/* 308 Action120 <- <{
-		    p.PushComponent(begin, end, Greater)
+		/* 453 Action186 <- <{
+		    p.PushComponent(begin, end, NotIn)
 		}> */
 		func() bool {
 			{
-				add(ruleAction120, position)
+				add(ruleAction186, position)
 			}
 			return true
 		},
-		/* 309 Action121 <- <{
-		    p.PushComponent(begin, end, GreaterOrEqual)
+		/* 454 Action187 <- <{
+		    p.PushComponent(begin, end, Between)
 		}> */
 		func() bool {
 			{
-				add(ruleAction121, position)
+				add(ruleAction187, position)
 			}
 			return true
 		},
-		/* 310 Action122 <- <{
-		    p.PushComponent(begin, end, NotEqual)
+		/* 455 Action188 <- <{
+		    p.PushComponent(begin, end, NotBetween)
 		}> */
 		func() bool {
 			{
-				add(ruleAction122, position)
+				add(ruleAction188, position)
 			}
 			return true
 		},
-		/* 311 Action123 <- <{
+		/* 456 Action189 <- <{
 		    p.PushComponent(begin, end, Concat)
 		}> */
 		func() bool {
 			{
-				add(ruleAction123, position)
+				add(ruleAction189, position)
 			}
 			return true
 		},
-		/* 312 Action124 <- <{
+		/* 457 Action190 <- <{
 		    p.PushComponent(begin, end, Is)
 		}> */
 		func() bool {
 			{
-				add(ruleAction124, position)
+				add(ruleAction190, position)
 			}
 			return true
 		},
-		/* 313 Action125 <- <{
+		/* 458 Action191 <- <{
 		    p.PushComponent(begin, end, IsNot)
 		}> */
 		func() bool {
 			{
-				add(ruleAction125, position)
+				add(ruleAction191, position)
 			}
 			return true
 		},
-		/* 314 Action126 <- <{
+		/* 459 Action192 <- <{
 		    p.PushComponent(begin, end, Plus)
 		}> */
 		func() bool {
 			{
-				add(ruleAction126, position)
+				add(ruleAction192, position)
 			}
 			return true
 		},
-		/* 315 Action127 <- <{
+		/* 460 Action193 <- <{
 		    p.PushComponent(begin, end, Minus)
 		}> */
 		func() bool {
 			{
-				add(ruleAction127, position)
+				add(ruleAction193, position)
 			}
 			return true
 		},
-		/* 316 Action128 <- <{
+		/* 461 Action194 <- <{
 		    p.PushComponent(begin, end, Multiply)
 		}> */
 		func() bool {
 			{
-				add(ruleAction128, position)
+				add(ruleAction194, position)
 			}
 			return true
 		},
-		/* 317 Action129 <- <{
+		/* 462 Action195 <- <{
 		    p.PushComponent(begin, end, Divide)
 		}> */
 		func() bool {
 			{
-				add(ruleAction129, position)
+				add(ruleAction195, position)
 			}
 			return true
 		},
-		/* 318 Action130 <- <{
+		/* 463 Action196 <- <{
 		    p.PushComponent(begin, end, Modulo)
 		}> */
 		func() bool {
 			{
-				add(ruleAction130, position)
+				add(ruleAction196, position)
 			}
 			return true
 		},
-		/* 319 Action131 <- <{
+		/* 464 Action197 <- <{
 		    p.PushComponent(begin, end, UnaryMinus)
 		}> */
 		func() bool {
 			{
-				add(ruleAction131, position)
+				add(ruleAction197, position)
 			}
 			return true
 		},
-		/* 320 Action132 <- <{
+		/* 465 Action198 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, Identifier(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction132, position)
+				add(ruleAction198, position)
 			}
 			return true
 		},
-		/* 321 Action133 <- <{
+		/* 466 Action199 <- <{
 		    substr := string([]rune(buffer)[begin:end])
 		    p.PushComponent(begin, end, Identifier(substr))
 		}> */
 		func() bool {
 			{
-				add(ruleAction133, position)
+				add(ruleAction199, position)
 			}
 			return true
 		},
@@ -20,27 +20,69 @@ func TestExpressionParser(t *testing.T) {
 		"NULL": {[]Expression{NullLiteral{}}, "NULL"},
 		// Function Application
 		"f()": {[]Expression{FuncAppAST{FuncName("f"),
-			ExpressionsAST{[]Expression{}}, nil}}, "f()"},
+			ExpressionsAST{[]Expression{}}, nil, false}}, "f()"},
 		"now()": {[]Expression{FuncAppAST{FuncName("now"),
-			ExpressionsAST{[]Expression{}}, nil}}, "now()"},
+			ExpressionsAST{[]Expression{}}, nil, false}}, "now()"},
 		"f(a)": {[]Expression{FuncAppAST{FuncName("f"),
-			ExpressionsAST{[]Expression{RowValue{"", "a"}}}, nil}}, "f(a)"},
+			ExpressionsAST{[]Expression{RowValue{"", "a"}}}, nil, false}}, "f(a)"},
 		"f(*)": {[]Expression{FuncAppAST{FuncName("f"),
-			ExpressionsAST{[]Expression{Wildcard{}}}, nil}}, "f(*)"},
+			ExpressionsAST{[]Expression{Wildcard{}}}, nil, false}}, "f(*)"},
 		"f(x:*)": {[]Expression{FuncAppAST{FuncName("f"),
-			ExpressionsAST{[]Expression{Wildcard{"x"}}}, nil}}, "f(x:*)"},
+			ExpressionsAST{[]Expression{Wildcard{"x"}}}, nil, false}}, "f(x:*)"},
 		"f(x:* ORDER BY a)": {[]Expression{FuncAppAST{FuncName("f"),
 			ExpressionsAST{[]Expression{Wildcard{"x"}}},
-			[]SortedExpressionAST{{RowValue{"", "a"}, UnspecifiedKeyword}}}}, "f(x:* ORDER BY a)"},
+			[]SortedExpressionAST{{RowValue{"", "a"}, UnspecifiedKeyword}}, false}}, "f(x:* ORDER BY a)"},
 		"f(a ORDER BY a DESC, b, c ASC)": {[]Expression{FuncAppAST{FuncName("f"),
 			ExpressionsAST{[]Expression{RowValue{"", "a"}}},
-			[]SortedExpressionAST{{RowValue{"", "a"}, No}, {RowValue{"", "b"}, UnspecifiedKeyword}, {RowValue{"", "c"}, Yes}}}}, "f(a ORDER BY a DESC, b, c ASC)"},
+			[]SortedExpressionAST{{RowValue{"", "a"}, No}, {RowValue{"", "b"}, UnspecifiedKeyword}, {RowValue{"", "c"}, Yes}}, false}}, "f(a ORDER BY a DESC, b, c ASC)"},
 		"count(a ORDER BY count(b))": {[]Expression{FuncAppAST{FuncName("count"),
 			ExpressionsAST{[]Expression{RowValue{"", "a"}}},
 			[]SortedExpressionAST{{FuncAppAST{FuncName("count"),
-				ExpressionsAST{[]Expression{RowValue{"", "b"}}}, nil}, UnspecifiedKeyword}}}}, "count(a ORDER BY count(b))"},
+				ExpressionsAST{[]Expression{RowValue{"", "b"}}}, nil, false}, UnspecifiedKeyword}}, false}}, "count(a ORDER BY count(b))"},
+		"count(DISTINCT a)": {[]Expression{FuncAppAST{FuncName("count"),
+			ExpressionsAST{[]Expression{RowValue{"", "a"}}}, nil, true}}, "count(DISTINCT a)"},
+		"f(distinct a, b ORDER BY b)": {[]Expression{FuncAppAST{FuncName("f"),
+			ExpressionsAST{[]Expression{RowValue{"", "a"}, RowValue{"", "b"}}},
+			[]SortedExpressionAST{{RowValue{"", "b"}, UnspecifiedKeyword}}, true}}, "f(DISTINCT a, b ORDER BY b)"},
+		"f(distinct_a)": {[]Expression{FuncAppAST{FuncName("f"),
+			ExpressionsAST{[]Expression{RowValue{"", "distinct_a"}}}, nil, false}}, "f(distinct_a)"},
+		"count(distinct_count)": {[]Expression{FuncAppAST{FuncName("count"),
+			ExpressionsAST{[]Expression{RowValue{"", "distinct_count"}}}, nil, false}}, "count(distinct_count)"},
+		"count(DISTINCT(a))": {[]Expression{FuncAppAST{FuncName("count"),
+			ExpressionsAST{[]Expression{RowValue{"", "a"}}}, nil, true}}, "count(DISTINCT a)"},
+		// Analytic Functions
+		"row_number() OVER ()": {[]Expression{AnalyticFuncAST{FuncAppAST{FuncName("row_number"),
+			ExpressionsAST{[]Expression{}}, nil, false}, OverAST{}}}, "row_number() OVER ()"},
+		"lag(a, 2) over (partition by b, c order by d desc)": {[]Expression{AnalyticFuncAST{FuncAppAST{FuncName("lag"),
+			ExpressionsAST{[]Expression{RowValue{"", "a"}, NumericLiteral{2}}}, nil, false},
+			OverAST{[]Expression{RowValue{"", "b"}, RowValue{"", "c"}},
+				[]SortedExpressionAST{{RowValue{"", "d"}, No}}}}},
+			"lag(a, 2) OVER (PARTITION BY b, c ORDER BY d DESC)"},
+		"sum(a) OVER (ORDER BY ts()) - a": {[]Expression{BinaryOpAST{Minus,
+			AnalyticFuncAST{FuncAppAST{FuncName("sum"),
+				ExpressionsAST{[]Expression{RowValue{"", "a"}}}, nil, false},
+				OverAST{nil, []SortedExpressionAST{{RowMeta{"", TimestampMeta}, UnspecifiedKeyword}}}},
+			RowValue{"", "a"}}},
+			"sum(a) OVER (ORDER BY ts()) - a"},
 		`f(2.1, "a")`: {[]Expression{FuncAppAST{FuncName("f"),
-			ExpressionsAST{[]Expression{FloatLiteral{2.1}, StringLiteral{"a"}}}, nil}}, `f(2.1, "a")`},
+			ExpressionsAST{[]Expression{FloatLiteral{2.1}, StringLiteral{"a"}}}, nil, false}}, `f(2.1, "a")`},
+		// Lambda Expressions
+		"filter(a, x -> x > 0)": {[]Expression{FuncAppAST{FuncName("filter"),
+			ExpressionsAST{[]Expression{RowValue{"", "a"},
+				LambdaAST{"x", BinaryOpAST{Greater, LambdaParam{"x"}, NumericLiteral{0}}}}}, nil, false}},
+			"filter(a, x -> x > 0)"},
+		"transform(a,x->x.b[0] + xs + s:x)": {[]Expression{FuncAppAST{FuncName("transform"),
+			ExpressionsAST{[]Expression{RowValue{"", "a"},
+				LambdaAST{"x", BinaryOpAST{Plus, BinaryOpAST{Plus, LambdaParam{"x.b[0]"}, RowValue{"", "xs"}},
+					RowValue{"s", "x"}}}}}, nil, false}},
+			"transform(a, x -> (x.b[0] + xs) + s:x)"},
+		"transform(a, x -> filter(x, y -> y < x[0]))": {[]Expression{FuncAppAST{FuncName("transform"),
+			ExpressionsAST{[]Expression{RowValue{"", "a"},
+				LambdaAST{"x", FuncAppAST{FuncName("filter"),
+					ExpressionsAST{[]Expression{LambdaParam{"x"},
+						LambdaAST{"y", BinaryOpAST{Less, LambdaParam{"y"}, LambdaParam{"x[0]"}}}}}, nil, false}}}}, nil, false}},
+			"transform(a, x -> filter(x, y -> y < x[0]))"},
+		"x -> x": {nil, ""},
 		// Type Cast
 		"CAST(2.1 AS BOOL)":    {[]Expression{TypeCastAST{FloatLiteral{2.1}, Bool}}, "CAST(2.1 AS BOOL)"},
 		"CAST(2.1 AS INT)":     {[]Expression{TypeCastAST{FloatLiteral{2.1}, Int}}, "CAST(2.1 AS INT)"},
@@ -130,6 +172,22 @@ func TestExpressionParser(t *testing.T) {
 		`"Peter""s"`: {[]Expression{StringLiteral{`Peter"s`}}, `"Peter""s"`},
 		`"Peter's"`:  {[]Expression{StringLiteral{`Peter's`}}, `"Peter's"`},
 		`"日本語"`:      {[]Expression{StringLiteral{"日本語"}}, `"日本語"`},
+		// IntervalLiteral
+		`INTERVAL "5 minutes"`:         {[]Expression{IntervalLiteral{300000000}}, `INTERVAL "5 minutes"`},
+		`interval "1 hour 30 mins"`:    {[]Expression{IntervalLiteral{5400000000}}, `INTERVAL "1 hour 30 minutes"`},
+		`INTERVAL "1.5 s"`:             {[]Expression{IntervalLiteral{1500000}}, `INTERVAL "1 second 500 milliseconds"`},
+		`INTERVAL "-2 days"`:           {[]Expression{IntervalLiteral{-172800000000}}, `INTERVAL "-2 days"`},
+		`INTERVAL "1 week 1 us"`:       {[]Expression{IntervalLiteral{604800000001}}, `INTERVAL "7 days 1 microsecond"`},
+		`INTERVAL "0 seconds"`:         {[]Expression{IntervalLiteral{0}}, `INTERVAL "0 seconds"`},
+		`INTERVAL "1 month"`:           {nil, ""},
+		`INTERVAL "5"`:                 {nil, ""},
+		`INTERVAL ""`:                  {nil, ""},
+		`INTERVAL 5`:                   {nil, ""},
+		`ts() - INTERVAL "10 seconds"`: {[]Expression{BinaryOpAST{Minus, RowMeta{"", TimestampMeta}, IntervalLiteral{10000000}}}, `ts() - INTERVAL "10 seconds"`},
+		// intervals out of the range of int64
+		`INTERVAL "99999999999 weeks"`:             {nil, ""},
+		`INTERVAL "15250000 weeks 15250000 weeks"`: {nil, ""},
+		`INTERVAL "-99999999999.5 weeks"`:          {nil, ""},
 		// Alias
 		"1.2 AS x": {[]Expression{AliasAST{FloatLiteral{1.2}, "x"}}, "1.2 AS x"},
 		"b AS *":   {[]Expression{AliasAST{RowValue{"", "b"}, "*"}}, "b AS *"},
@@ -150,6 +208,33 @@ func TestExpressionParser(t *testing.T) {
 		"a <> 2": {[]Expression{BinaryOpAST{NotEqual, RowValue{"", "a"}, NumericLiteral{2}}}, "a != 2"},
 		// Other operators
 		"a || 2": {[]Expression{BinaryOpAST{Concat, RowValue{"", "a"}, NumericLiteral{2}}}, "a || 2"},
+		// Pattern Matching
+		`a LIKE "x%"`:       {[]Expression{BinaryOpAST{Like, RowValue{"", "a"}, StringLiteral{"x%"}}}, `a LIKE "x%"`},
+		`a not like "x_"`:   {[]Expression{BinaryOpAST{NotLike, RowValue{"", "a"}, StringLiteral{"x_"}}}, `a NOT LIKE "x_"`},
+		`a ILIKE b`:         {[]Expression{BinaryOpAST{ILike, RowValue{"", "a"}, RowValue{"", "b"}}}, `a ILIKE b`},
+		`a NOT ILIKE b`:     {[]Expression{BinaryOpAST{NotILike, RowValue{"", "a"}, RowValue{"", "b"}}}, `a NOT ILIKE b`},
+		`a~"^x+$"`:          {[]Expression{BinaryOpAST{RegexMatch, RowValue{"", "a"}, StringLiteral{"^x+$"}}}, `a ~ "^x+$"`},
+		`a !~ "^x+$"`:       {[]Expression{BinaryOpAST{NotRegexMatch, RowValue{"", "a"}, StringLiteral{"^x+$"}}}, `a !~ "^x+$"`},
+		`a LIKE`:            {nil, ""},
+		`a LIKE "x" LIKE b`: {nil, ""},
+		// IN Lists
+		"a IN (1, b)": {[]Expression{BinaryOpAST{In, RowValue{"", "a"},
+			ArrayAST{ExpressionsAST{[]Expression{NumericLiteral{1}, RowValue{"", "b"}}}}}}, "a IN (1, b)"},
+		"a not in(2)": {[]Expression{BinaryOpAST{NotIn, RowValue{"", "a"},
+			ArrayAST{ExpressionsAST{[]Expression{NumericLiteral{2}}}}}}, "a NOT IN (2)"},
+		"a IN ()":     {nil, ""},
+		"a IN [1, 2]": {nil, ""},
+		// BETWEEN
+		"a BETWEEN 1 AND b": {[]Expression{BetweenAST{Between, RowValue{"", "a"},
+			NumericLiteral{1}, RowValue{"", "b"}}}, "a BETWEEN 1 AND b"},
+		"a NOT BETWEEN b + 1 AND 2 AND c": {[]Expression{BinaryOpAST{And,
+			BetweenAST{NotBetween, RowValue{"", "a"},
+				BinaryOpAST{Plus, RowValue{"", "b"}, NumericLiteral{1}}, NumericLiteral{2}},
+			RowValue{"", "c"}}}, "(a NOT BETWEEN b + 1 AND 2) AND c"},
+		"NOT a BETWEEN 1 AND 2": {[]Expression{UnaryOpAST{Not,
+			BetweenAST{Between, RowValue{"", "a"}, NumericLiteral{1}, NumericLiteral{2}}}},
+			"NOT (a BETWEEN 1 AND 2)"},
+		"a BETWEEN 1": {nil, ""},
 		// IS Expressions
 		"a IS NULL":        {[]Expression{BinaryOpAST{Is, RowValue{"", "a"}, NullLiteral{}}}, "a IS NULL"},
 		"a IS NOT NULL":    {[]Expression{BinaryOpAST{IsNot, RowValue{"", "a"}, NullLiteral{}}}, "a IS NOT NULL"},
@@ -277,7 +362,7 @@ func TestExpressionParser(t *testing.T) {
 		"a, 3.1, false,-2": {[]Expression{RowValue{"", "a"}, FloatLiteral{3.1}, BoolLiteral{false}, UnaryOpAST{UnaryMinus, NumericLiteral{2}}}, "a, 3.1, FALSE, -2"},
 		`"日本語", 13`:        {[]Expression{StringLiteral{"日本語"}, NumericLiteral{13}}, `"日本語", 13`},
 		`concat(a, "Pi", 3.1), b`: {[]Expression{FuncAppAST{FuncName("concat"), ExpressionsAST{
-			[]Expression{RowValue{"", "a"}, StringLiteral{"Pi"}, FloatLiteral{3.1}}}, nil},
+			[]Expression{RowValue{"", "a"}, StringLiteral{"Pi"}, FloatLiteral{3.1}}}, nil, false},
 			RowValue{"", "b"}}, `concat(a, "Pi", 3.1), b`},
 	}
 
@@ -67,30 +67,38 @@ func (ps *parseStack) Peek() (value *ParsedComponent) {
 // they are components of a SELECT statement, and replaces them by
 // a single SelectStmt element.
 //
-//  EmitterAST
+//  LimitAST
+//  OrderByAST
 //  HavingAST
 //  GroupingAST
 //  FilterAST
 //  WindowedFromAST
 //  ProjectionsAST
+//  DistinctAST
+//  EmitterAST
 //   =>
-//  SelectStmt{EmitterAST, ProjectionsAST, WindowedFromAST, FilterAST, GroupingAST, HavingAST}
+//  SelectStmt{EmitterAST, DistinctAST, ProjectionsAST, WindowedFromAST, FilterAST,
+//    GroupingAST, HavingAST, OrderByAST, LimitAST}
 func (ps *parseStack) AssembleSelect() {
 	// pop the components from the stack in reverse order
-	_having, _grouping, _filter, _from, _projections, _emitter := ps.pop6()
+	_limit, _orderBy, _having, _grouping, _filter, _from, _projections, _distinct, _emitter := ps.pop9()
 
 	// extract and convert the contained structure
 	// (if this fails, this is a fundamental parser bug => panic ok)
+	limit := _limit.comp.(LimitAST)
+	orderBy := _orderBy.comp.(OrderByAST)
 	having := _having.comp.(HavingAST)
 	grouping := _grouping.comp.(GroupingAST)
 	filter := _filter.comp.(FilterAST)
 	from := _from.comp.(WindowedFromAST)
 	projections := _projections.comp.(ProjectionsAST)
+	distinct := _distinct.comp.(DistinctAST)
 	emitter := _emitter.comp.(EmitterAST)
 
 	// assemble the SelectStmt and push it back
-	s := SelectStmt{emitter, projections, from, filter, grouping, having}
-	se := ParsedComponent{_emitter.begin, _having.end, s}
+	s := SelectStmt{emitter, distinct, projections, from, filter, grouping,
+		having, orderBy, limit}
+	se := ParsedComponent{_emitter.begin, _limit.end, s}
 	ps.Push(&se)
 }
 
@@ -117,22 +125,52 @@ func (ps *parseStack) AssembleSelectUnion(begin int, end int) {
 // assuming they are components of a CREATE STREAM statement, and
 // replaces them by a single CreateStreamAsSelectStmt element.
 //
+//  OnErrorAST
 //  SelectStmt
+//  SchemaAST
 //  StreamIdentifier
 //   =>
-//  CreateStreamAsSelectStmt{StreamIdentifier, SelectStmt}
+//  CreateStreamAsSelectStmt{StreamIdentifier, SchemaAST, SelectStmt, OnErrorAST}
 func (ps *parseStack) AssembleCreateStreamAsSelect() {
 	// now pop the components from the stack in reverse order
-	_select, _name := ps.pop2()
+	_onError, _select, _schema, _name := ps.pop4()
 
 	// extract and convert the contained structure
 	// (if this fails, this is a fundamental parser bug => panic ok)
+	onError := _onError.comp.(OnErrorAST)
 	s := _select.comp.(SelectStmt)
+	schema := _schema.comp.(SchemaAST)
 	name := _name.comp.(StreamIdentifier)
 
 	// assemble the SelectStmt and push it back
-	css := CreateStreamAsSelectStmt{name, s}
-	se := ParsedComponent{_name.begin, _select.end, css}
+	css := CreateStreamAsSelectStmt{name, schema, s, onError}
+	se := ParsedComponent{_name.begin, _onError.end, css}
+	ps.Push(&se)
+}
+
+// AssembleCreateOrReplaceStreamAsSelect takes the topmost elements from
+// the stack, assuming they are components of a CREATE OR REPLACE STREAM
+// statement, and replaces them by a single
+// CreateOrReplaceStreamAsSelectStmt element.
+//
+//  OnErrorAST
+//  SelectStmt
+//  SchemaAST
+//  StreamIdentifier
+//   =>
+//  CreateOrReplaceStreamAsSelectStmt{StreamIdentifier, SchemaAST, SelectStmt,
+//    OnErrorAST}
+func (ps *parseStack) AssembleCreateOrReplaceStreamAsSelect() {
+	// now pop the components from the stack in reverse order
+	_onError, _select, _schema, _name := ps.pop4()
+
+	onError := _onError.comp.(OnErrorAST)
+	s := _select.comp.(SelectStmt)
+	schema := _schema.comp.(SchemaAST)
+	name := _name.comp.(StreamIdentifier)
+
+	css := CreateOrReplaceStreamAsSelectStmt{name, schema, s, onError}
+	se := ParsedComponent{_name.begin, _onError.end, css}
 	ps.Push(&se)
 }
 
@@ -166,23 +204,25 @@ func (ps *parseStack) AssembleCreateStreamAsSelectUnion() {
 //  BinaryKeyword
 //  SourceSinkSpecsAST
 //  SourceSinkType
+//  SchemaAST
 //  StreamIdentifier
 //   =>
-//  CreateSourceStmt{BinaryKeyword, StreamIdentifier, SourceSinkType,
-//    SourceSinkSpecsAST}
+//  CreateSourceStmt{BinaryKeyword, StreamIdentifier, SchemaAST,
+//    SourceSinkType, SourceSinkSpecsAST}
 func (ps *parseStack) AssembleCreateSource() {
 	// pop the components from the stack in reverse order
-	_specs, _sourceType, _name, _paused := ps.pop4()
+	_specs, _sourceType, _schema, _name, _paused := ps.pop5()
 
 	// extract and convert the contained structure
 	// (if this fails, this is a fundamental parser bug => panic ok)
 	specs := _specs.comp.(SourceSinkSpecsAST)
 	sourceType := _sourceType.comp.(SourceSinkType)
+	schema := _schema.comp.(SchemaAST)
 	name := _name.comp.(StreamIdentifier)
 	paused := _paused.comp.(BinaryKeyword)
 
 	// assemble the CreateSourceStmt and push it back
-	s := CreateSourceStmt{paused, name, sourceType, specs}
+	s := CreateSourceStmt{paused, name, schema, sourceType, specs}
 	se := ParsedComponent{_paused.begin, _specs.end, s}
 	ps.Push(&se)
 }
@@ -529,6 +569,107 @@ func (ps *parseStack) AssembleEval(begin, end int) {
 	ps.Push(&se)
 }
 
+// AssembleExplain takes the topmost element from the stack, assuming
+// it is the statement of an EXPLAIN statement, and replaces it by a
+// single ExplainStmt element.
+//
+//  Any
+//   =>
+//  ExplainStmt{Any}
+func (ps *parseStack) AssembleExplain() {
+	_stmt := ps.Pop()
+
+	se := ParsedComponent{_stmt.begin, _stmt.end, ExplainStmt{_stmt.comp}}
+	ps.Push(&se)
+}
+
+// AssembleShow takes the topmost element from the stack, assuming
+// it is the target of a SHOW statement, and replaces it by a single
+// ShowStmt element.
+//
+//  ShowTarget
+//   =>
+//  ShowStmt{ShowTarget}
+func (ps *parseStack) AssembleShow() {
+	_target := ps.Pop()
+
+	target := _target.comp.(ShowTarget)
+
+	se := ParsedComponent{_target.begin, _target.end, ShowStmt{target}}
+	ps.Push(&se)
+}
+
+// AssembleDescribe takes the topmost element from the stack, assuming
+// it is the node name of a DESCRIBE statement, and replaces it by a
+// single DescribeStmt element.
+//
+//  StreamIdentifier
+//   =>
+//  DescribeStmt{StreamIdentifier}
+func (ps *parseStack) AssembleDescribe() {
+	_name := ps.Pop()
+
+	name := _name.comp.(StreamIdentifier)
+
+	se := ParsedComponent{_name.begin, _name.end, DescribeStmt{name}}
+	ps.Push(&se)
+}
+
+// AssembleCreateFunction takes the topmost elements from the stack,
+// assuming they are components of a CREATE FUNCTION statement, and
+// replaces them by a single CreateFunctionStmt element.
+//
+//  Expression
+//  []string
+//  FuncName
+//   =>
+//  CreateFunctionStmt{FuncName, []string, Expression}
+func (ps *parseStack) AssembleCreateFunction() {
+	// pop the components from the stack in reverse order
+	_body, _params, _name := ps.pop3()
+
+	body := _body.comp.(Expression)
+	params := _params.comp.([]string)
+	name := _name.comp.(FuncName)
+
+	s := CreateFunctionStmt{name, params, body}
+	ps.PushComponent(_name.begin, _body.end, s)
+}
+
+// AssembleFunctionParams takes the elements from the stack that
+// correspond to the input[begin:end] string, makes sure they are
+// all Identifier elements and pushes a slice of their names.
+//
+//  Identifier
+//  Identifier
+//   =>
+//  []string{Identifier, Identifier}
+func (ps *parseStack) AssembleFunctionParams(begin int, end int) {
+	elems := ps.collectElements(begin, end)
+	params := make([]string, len(elems))
+	for i, elem := range elems {
+		// (if this conversion fails, this is a fundamental parser bug)
+		params[i] = string(elem.(Identifier))
+	}
+	ps.PushComponent(begin, end, params)
+}
+
+// AssembleDropFunction takes the topmost element from the stack,
+// assuming it is the function name of a DROP FUNCTION statement, and
+// replaces it by a single DropFunctionStmt element.
+//
+//  FuncName
+//   =>
+//  DropFunctionStmt{FuncName}
+func (ps *parseStack) AssembleDropFunction() {
+	_name := ps.Pop()
+
+	name := _name.comp.(FuncName)
+
+	se := ParsedComponent{_name.begin, _name.end, DropFunctionStmt{name}}
+	ps.Push(&se)
+}
+
 /* Projections/Columns */
 
 // AssembleEmitter takes the topmost elements from the stack, assuming
@@ -646,31 +787,127 @@ func (ps *parseStack) AssembleAlias() {
 
 // AssembleWindowedFrom takes the elements from the stack that
 // correspond to the input[begin:end] string, makes sure they are all
-// AliasedStreamWindowAST elements and wraps a WindowedFromAST struct
-// around them. If there are no such elements, adds an
-// empty WindowedFromAST struct to the stack.
+// AliasedStreamWindowAST elements (each optionally followed by a
+// JoinAST) and wraps a WindowedFromAST struct around them. If there
+// are no such elements, adds an empty WindowedFromAST struct to the
+// stack.
 //
 //  AliasedStreamWindowAST
 //  AliasedStreamWindowAST
+//  JoinAST
+//  UnnestAST
+//  StateJoinAST
 //   =>
-//  WindowedFromAST{[AliasedStreamWindowAST, AliasedStreamWindowAST]}
+//  WindowedFromAST{[AliasedStreamWindowAST, AliasedStreamWindowAST],
+//    [JoinAST{}, JoinAST], [UnnestAST], [StateJoinAST]}
 func (ps *parseStack) AssembleWindowedFrom(begin int, end int) {
 	if begin == end {
 		// push an empty FROM clause
 		ps.PushComponent(begin, end, WindowedFromAST{})
 	} else {
 		elems := ps.collectElements(begin, end)
-		rels := make([]AliasedStreamWindowAST, len(elems), len(elems))
-		for i, elem := range elems {
+		rels := make([]AliasedStreamWindowAST, 0, len(elems))
+		joins := make([]JoinAST, 0, len(elems))
+		hasJoin := false
+		var unnests []UnnestAST
+		var stateJoins []StateJoinAST
+		for _, elem := range elems {
+			if u, ok := elem.(UnnestAST); ok {
+				unnests = append(unnests, u)
+				continue
+			}
+			if j, ok := elem.(StateJoinAST); ok {
+				stateJoins = append(stateJoins, j)
+				continue
+			}
+			if j, ok := elem.(JoinAST); ok {
+				// a JoinAST always follows the relation it belongs to
+				// (if this fails, this is a fundamental parser bug)
+				if len(rels) < 2 {
+					panic("JoinAST must follow a joined relation")
+				}
+				joins[len(joins)-1] = j
+				hasJoin = true
+				continue
+			}
 			// (if this conversion fails, this is a fundamental parser bug)
 			e := elem.(AliasedStreamWindowAST)
-			rels[i] = e
+			rels = append(rels, e)
+			joins = append(joins, JoinAST{})
+		}
+		if !hasJoin {
+			joins = nil
 		}
 		// push the grouped list back
-		ps.PushComponent(begin, end, WindowedFromAST{rels})
+		ps.PushComponent(begin, end, WindowedFromAST{rels, joins, unnests, stateJoins})
 	}
 }
 
+// AssembleJoin takes the topmost elements from the stack, assuming
+// they are components of a JOIN clause, and replaces them by the
+// joined relation followed by a JoinAST element.
+//
+//  Expression
+//  AliasedStreamWindowAST
+//  JoinType
+//   =>
+//  AliasedStreamWindowAST
+//  JoinAST{JoinType, Expression}
+func (ps *parseStack) AssembleJoin() {
+	// pop the components from the stack in reverse order
+	_on, _rel, _joinType := ps.pop3()
+
+	on := _on.comp.(Expression)
+	joinType := _joinType.comp.(JoinType)
+
+	ps.Push(_rel)
+	ps.PushComponent(_on.begin, _on.end, JoinAST{joinType, on})
+}
+
+// AssembleUnnest takes the topmost elements from the stack, assuming
+// they are components of an UNNEST clause, and replaces them by a
+// single UnnestAST element.
+//
+//  Identifier
+//  BinaryKeyword
+//  Expression
+//   =>
+//  UnnestAST{Expression, BinaryKeyword, Identifier}
+func (ps *parseStack) AssembleUnnest() {
+	// pop the components from the stack in reverse order
+	_alias, _ordinality, _expr := ps.pop3()
+
+	alias := _alias.comp.(Identifier)
+	ordinality := _ordinality.comp.(BinaryKeyword)
+	expr := _expr.comp.(Expression)
+
+	ps.PushComponent(_expr.begin, _alias.end,
+		UnnestAST{expr, ordinality, string(alias)})
+}
+
+// AssembleStateJoin takes the topmost elements from the stack, assuming
+// they are components of a JOIN STATE clause, and replaces them by a
+// single StateJoinAST element.
+//
+//  Expression
+//  Identifier
+//  StreamIdentifier
+//  JoinType
+//   =>
+//  StateJoinAST{JoinType, StreamIdentifier, Identifier, Expression}
+func (ps *parseStack) AssembleStateJoin() {
+	// pop the components from the stack in reverse order
+	_key, _alias, _state, _joinType := ps.pop4()
+
+	key := _key.comp.(Expression)
+	alias := _alias.comp.(Identifier)
+	state := _state.comp.(StreamIdentifier)
+	joinType := _joinType.comp.(JoinType)
+
+	ps.PushComponent(_joinType.begin, _key.end,
+		StateJoinAST{joinType, state, string(alias), key})
+}
+
 // AssembleInterval takes the topmost elements from the stack, assuming
 // they are components of a RANGE clause, and replaces them by
 // a single IntervalAST element.
@@ -739,9 +976,53 @@ func (ps *parseStack) AssembleFilter(begin int, end int) {
 //  Any
 //  Any
 //   =>
-//  GroupingAST{[Any, Any, Any]}
+//  GroupingAST{[Any, Any, Any], GroupingSetsAST{}}
+// or
+//  GroupingSetsType
+//  Any
+//  Any
+//   =>
+//  GroupingAST{[Any, Any], GroupingSetsAST{GroupingSetsType, nil}}
+// or
+//  GroupingSetsType
+//  ExpressionsAST{[Any, Any]}
+//  ExpressionsAST{[Any]}
+//   =>
+//  GroupingAST{[Any, Any], GroupingSetsAST{GroupingSetsType, [[0, 1], [0]]}}
 func (ps *parseStack) AssembleGrouping(begin int, end int) {
 	elems := ps.collectElements(begin, end)
+	var sets GroupingSetsAST
+	if len(elems) > 0 {
+		if t, ok := elems[0].(GroupingSetsType); ok {
+			sets.Type = t
+			elems = elems[1:]
+		}
+	}
+
+	if sets.Type == ExplicitGroupingSets {
+		// GroupList holds each distinct expression used in the sets
+		// only once, in the order of first appearance
+		var exprs []Expression
+		indexes := map[string]int{}
+		sets.Sets = make([][]int, len(elems))
+		for i := range elems {
+			setExprs := elems[i].(ExpressionsAST).Expressions
+			set := make([]int, len(setExprs))
+			for j, e := range setExprs {
+				idx, ok := indexes[e.String()]
+				if !ok {
+					idx = len(exprs)
+					indexes[e.String()] = idx
+					exprs = append(exprs, e)
+				}
+				set[j] = idx
+			}
+			sets.Sets[i] = set
+		}
+		ps.PushComponent(begin, end, GroupingAST{exprs, sets})
+		return
+	}
+
 	var exprs []Expression
 	if len(elems) > 0 {
 		exprs = make([]Expression, len(elems))
@@ -750,7 +1031,56 @@ func (ps *parseStack) AssembleGrouping(begin int, end int) {
 		exprs[i] = elems[i].(Expression)
 	}
 	// push the grouped list back
-	ps.PushComponent(begin, end, GroupingAST{exprs})
+	ps.PushComponent(begin, end, GroupingAST{exprs, sets})
+}
+
+/* ORDER BY and LIMIT clauses */
+
+// AssembleOrderBy takes the elements from the stack that
+// correspond to the input[begin:end] string and wraps an
+// OrderByAST struct around them. If there are no such elements,
+// adds an empty OrderByAST struct to the stack.
+//
+//  SortedExpressionAST
+//  SortedExpressionAST
+//   =>
+//  OrderByAST{[SortedExpressionAST, SortedExpressionAST]}
+func (ps *parseStack) AssembleOrderBy(begin int, end int) {
+	elems := ps.collectElements(begin, end)
+	var exprs []SortedExpressionAST
+	if len(elems) > 0 {
+		exprs = make([]SortedExpressionAST, len(elems))
+	}
+	for i := range elems {
+		exprs[i] = elems[i].(SortedExpressionAST)
+	}
+	ps.PushComponent(begin, end, OrderByAST{exprs})
+}
+
+// AssembleDistinct pushes a DistinctAST struct that records whether
+// there is a DISTINCT keyword in the SELECT clause or in the
+// parameter list of a function call.
+//
+//   =>
+//  DistinctAST{begin != end}
+func (ps *parseStack) AssembleDistinct(begin int, end int) {
+	ps.PushComponent(begin, end, DistinctAST{begin != end})
+}
+
+// AssembleLimit takes the number on top of the stack (if there
+// is a LIMIT clause) and wraps a LimitAST struct around it. If there
+// is no LIMIT clause, an empty LimitAST struct is used.
+//
+//  NumericLiteral
+//   =>
+//  LimitAST{true, NumericLiteral.Value}
+func (ps *parseStack) AssembleLimit(begin int, end int) {
+	if begin == end {
+		ps.PushComponent(begin, end, LimitAST{})
+	} else {
+		l := ps.Pop()
+		ps.PushComponent(begin, end, LimitAST{true, l.comp.(NumericLiteral).Value})
+	}
 }
 
 /* HAVING clause */
@@ -819,21 +1149,157 @@ func (ps *parseStack) EnsureAliasedStreamWindow() {
 // they are components of an AS clause, and replaces them by
 // a single StreamWindowAST element.
 //
+//  SheddingOption
+//  NumericLiteral
+//  LatenessAST
+//  SlideAST or SessionAST
 //  IntervalAST
 //  Stream
 //   =>
-//  StreamWindowAST{Stream, IntervalAST}
+//  StreamWindowAST{Stream, IntervalAST, NumericLiteral.Value, SheddingOption,
+//    SlideAST, LatenessAST, SessionAST}
 func (ps *parseStack) AssembleStreamWindow() {
 	// pop the components from the stack in reverse order
-	_shedding, _capacity, _range, _rel := ps.pop4()
+	_shedding, _capacity, _lateness, _slide, _range, _rel := ps.pop6()
 
 	rel := _rel.comp.(Stream)
 	rangeAst := _range.comp.(IntervalAST)
+	lateness := _lateness.comp.(LatenessAST)
 	capacity := _capacity.comp.(NumericLiteral)
 	shedding := _shedding.comp.(SheddingOption)
+	// a session window has a SessionAST instead of a SlideAST
+	var slide SlideAST
+	var session SessionAST
+	switch c := _slide.comp.(type) {
+	case SlideAST:
+		slide = c
+	case SessionAST:
+		session = c
+	}
 
 	ps.PushComponent(_rel.begin, _shedding.end, StreamWindowAST{rel, rangeAst,
-		capacity.Value, shedding})
+		capacity.Value, shedding, slide, lateness, session})
+}
+
+// AssembleSession takes the topmost element from the stack, assuming
+// it is the key expression of a session window, if there is one in
+// the given range, and pushes a SessionAST element.
+//
+//  Any
+//   =>
+//  SessionAST{true, Any}
+// or
+//  (empty)
+//   =>
+//  SessionAST{true, nil}
+func (ps *parseStack) AssembleSession(begin int, end int) {
+	top := ps.Peek()
+	if top == nil || top.end <= begin {
+		// there is no key in the given range
+		ps.PushComponent(begin, end, SessionAST{Enabled: true})
+		return
+	}
+	_key := ps.Pop()
+	key := _key.comp.(Expression)
+	ps.PushComponent(_key.begin, _key.end, SessionAST{true, key})
+}
+
+// AssembleSlide takes the topmost element from the stack, assuming
+// it is the interval of a SLIDE clause, and replaces it by a single
+// SlideAST element.
+//
+//  IntervalAST
+//   =>
+//  SlideAST{IntervalAST, false}
+func (ps *parseStack) AssembleSlide() {
+	_interval := ps.Pop()
+
+	interval := _interval.comp.(IntervalAST)
+
+	ps.PushComponent(_interval.begin, _interval.end, SlideAST{interval, false})
+}
+
+// EnsureSlideSpec makes sure that the top element of the stack
+// is a SlideAST element.
+func (ps *parseStack) EnsureSlideSpec(begin int, end int) {
+	top := ps.Peek()
+	if top == nil || top.end <= begin {
+		// there is no item in the given range
+		ps.PushComponent(begin, end, SlideAST{})
+	} else {
+		// there is an item in the given range
+		_, ok := top.comp.(SlideAST)
+		if !ok {
+			panic(fmt.Sprintf("begin (%d) != end (%d), but there "+
+				"was a %T on the stack", begin, end, top.comp))
+		}
+	}
+}
+
+// AssembleLateness takes the topmost elements from the stack, assuming
+// they are components of a LATENESS clause, and replaces them by a
+// single LatenessAST element.
+//
+//  IntervalAST
+//  LatePolicyAST
+//   =>
+//  LatenessAST{IntervalAST, LatePolicyAST}
+func (ps *parseStack) AssembleLateness() {
+	_policy, _interval := ps.pop2()
+
+	interval := _interval.comp.(IntervalAST)
+	policy := _policy.comp.(LatePolicyAST)
+
+	ps.PushComponent(_interval.begin, _policy.end, LatenessAST{interval, policy})
+}
+
+// EnsureLatenessSpec makes sure that the top element of the stack
+// is a LatenessAST element.
+func (ps *parseStack) EnsureLatenessSpec(begin int, end int) {
+	top := ps.Peek()
+	if top == nil || top.end <= begin {
+		// there is no item in the given range
+		ps.PushComponent(begin, end, LatenessAST{})
+	} else {
+		// there is an item in the given range
+		_, ok := top.comp.(LatenessAST)
+		if !ok {
+			panic(fmt.Sprintf("begin (%d) != end (%d), but there "+
+				"was a %T on the stack", begin, end, top.comp))
+		}
+	}
+}
+
+// AssembleEmitLateTuples takes the topmost element from the stack,
+// assuming it is the name of the stream that late tuples are emitted
+// to, and replaces it by a single LatePolicyAST element.
+//
+//  StreamIdentifier
+//   =>
+//  LatePolicyAST{EmitLateTuples, StreamIdentifier}
+func (ps *parseStack) AssembleEmitLateTuples() {
+	_name := ps.Pop()
+
+	name := _name.comp.(StreamIdentifier)
+
+	ps.PushComponent(_name.begin, _name.end, LatePolicyAST{EmitLateTuples, name})
+}
+
+// EnsureLatePolicy makes sure that the top element of the stack
+// is a LatePolicyAST element.
+func (ps *parseStack) EnsureLatePolicy(begin int, end int) {
+	top := ps.Peek()
+	if top == nil || top.end <= begin {
+		// there is no item in the given range
+		ps.PushComponent(begin, end, LatePolicyAST{})
+	} else {
+		// there is an item in the given range
+		_, ok := top.comp.(LatePolicyAST)
+		if !ok {
+			panic(fmt.Sprintf("begin (%d) != end (%d), but there "+
+				"was a %T on the stack", begin, end, top.comp))
+		}
+	}
 }
 
 // AssembleUDSFFuncApp takes the topmost elements from the stack,
@@ -887,6 +1353,47 @@ func (ps *parseStack) EnsureSheddingSpec(begin int, end int) {
 	}
 }
 
+// AssembleSchema takes the elements from the stack that correspond
+// to the input[begin:end] string, makes sure they are all SchemaFieldAST
+// elements and wraps a SchemaAST struct around them. If there are no
+// such elements, adds an empty SchemaAST struct to the stack.
+//
+//  SchemaFieldAST
+//  SchemaFieldAST
+//   =>
+//  SchemaAST{[SchemaFieldAST, SchemaFieldAST]}
+func (ps *parseStack) AssembleSchema(begin int, end int) {
+	elems := ps.collectElements(begin, end)
+	var fields []SchemaFieldAST
+	if len(elems) > 0 {
+		fields = make([]SchemaFieldAST, len(elems))
+		for i, elem := range elems {
+			// (if this conversion fails, this is a fundamental parser bug)
+			fields[i] = elem.(SchemaFieldAST)
+		}
+	}
+	ps.PushComponent(begin, end, SchemaAST{fields})
+}
+
+// AssembleSchemaField takes the topmost elements from the stack,
+// assuming they are components of a field declaration in a schema,
+// and replaces them by a single SchemaFieldAST element.
+//
+//  BinaryKeyword
+//  Type
+//  Identifier
+//   =>
+//  SchemaFieldAST{Identifier, Type, BinaryKeyword}
+func (ps *parseStack) AssembleSchemaField() {
+	_notNull, _type, _path := ps.pop3()
+
+	notNull := _notNull.comp.(BinaryKeyword)
+	t := _type.comp.(Type)
+	path := _path.comp.(Identifier)
+
+	ps.PushComponent(_path.begin, _notNull.end, SchemaFieldAST{string(path), t, notNull})
+}
+
 // AssembleSourceSinkSpecs takes the elements from the stack that
 // correspond to the input[begin:end] string, makes sure
 // they are all SourceSinkParamAST elements and wraps a SourceSinkSpecsAST
@@ -963,6 +1470,26 @@ func (ps *parseStack) AssembleSourceSinkParam() {
 	ps.PushComponent(_key.begin, _value.end, ss)
 }
 
+// AssembleOnError takes the StreamIdentifier of an ON ERROR clause
+// corresponding to the input[begin:end] string from the stack, if
+// there is one, and pushes an OnErrorAST element. If there is no such
+// clause, an empty OnErrorAST is pushed.
+//
+//  StreamIdentifier
+//   =>
+//  OnErrorAST{StreamIdentifier}
+func (ps *parseStack) AssembleOnError(begin int, end int) {
+	top := ps.Peek()
+	if top == nil || top.end <= begin {
+		// there is no item in the given range
+		ps.PushComponent(begin, end, OnErrorAST{})
+		return
+	}
+	_name := ps.Pop()
+	name := _name.comp.(StreamIdentifier)
+	ps.PushComponent(begin, end, OnErrorAST{name})
+}
+
 // EnsureIdentifier makes sure that the top element of the stack
 // is an Identifier element. If there was no Identifier on top,
 // an empty Identifier is pushed there. Note that since regular
@@ -1048,6 +1575,46 @@ func (ps *parseStack) AssembleBinaryOperation(begin int, end int) {
 	}
 }
 
+// AssembleComparison takes the elements from the stack that
+// correspond to the input[begin:end] string and assembles a
+// comparison from them. In addition to what AssembleBinaryOperation
+// does for a single binary operator, it handles BETWEEN, which has
+// two right operands.
+//
+//  Any
+//   =>
+//  Any
+// or
+//  Any
+//  Operator
+//  Any
+//   =>
+//  BinaryOpAST{Operator, Any, Any}
+// or
+//  Any
+//  Operator (Between or NotBetween)
+//  Any
+//  Any
+//   =>
+//  BetweenAST{Operator, Any, Any, Any}
+func (ps *parseStack) AssembleComparison(begin int, end int) {
+	elems := ps.collectElements(begin, end)
+	switch len(elems) {
+	case 1:
+		// there is no comparison, push back the single element
+		ps.PushComponent(begin, end, elems[0])
+	case 3:
+		op := elems[1].(Operator)
+		ps.PushComponent(begin, end, BinaryOpAST{op, elems[0].(Expression), elems[2].(Expression)})
+	case 4:
+		op := elems[1].(Operator)
+		ps.PushComponent(begin, end, BetweenAST{op, elems[0].(Expression),
+			elems[2].(Expression), elems[3].(Expression)})
+	default:
+		panic(fmt.Sprintf("cannot turn %+v into a comparison", elems))
+	}
+}
+
 // AssembleUnaryPrefixOperation takes the two elements from the stack that
 // correspond to the input[begin:end] string and adds the given
 // unary operator. If there is just one element, push it back unmodified.
@@ -1108,16 +1675,18 @@ func (ps *parseStack) AssembleTypeCast(begin int, end int) {
 //
 //  ExpressionsAST
 //  ExpressionsAST
+//  DistinctAST
 //  FuncName
 //   =>
-//  FuncAppAST{FuncName, ExpressionsAST}
+//  FuncAppAST{FuncName, ExpressionsAST, Ordering, Distinct}
 func (ps *parseStack) AssembleFuncApp() {
-	_ordering, _exprs, _funcName := ps.pop3()
+	_ordering, _exprs, _distinct, _funcName := ps.pop4()
 
 	// extract and convert the contained structure
 	// (if this fails, this is a fundamental parser bug => panic ok)
 	ordering := _ordering.comp.(ExpressionsAST)
 	exprs := _exprs.comp.(ExpressionsAST)
+	distinct := _distinct.comp.(DistinctAST)
 	funcName := _funcName.comp.(FuncName)
 
 	orderExprs := make([]SortedExpressionAST, len(ordering.Expressions))
@@ -1129,7 +1698,48 @@ func (ps *parseStack) AssembleFuncApp() {
 	}
 
 	// assemble the FuncAppAST and push it back
-	ps.PushComponent(_funcName.begin, _exprs.end, FuncAppAST{funcName, exprs, orderExprs})
+	ps.PushComponent(_funcName.begin, _exprs.end, FuncAppAST{funcName, exprs, orderExprs,
+		distinct.Distinct})
+}
+
+// AssembleAnalyticFuncApp takes the topmost elements from the stack
+// (if there is an OVER clause), assuming they are components of an
+// analytic function application, and replaces them by a single
+// AnalyticFuncAST element. If there is no OVER clause, the stack
+// is left unchanged.
+//
+//  ExpressionsAST
+//  ExpressionsAST
+//  FuncAppAST
+//   =>
+//  AnalyticFuncAST{FuncAppAST, OverAST{PartitionList, OrderList}}
+func (ps *parseStack) AssembleAnalyticFuncApp(begin int, end int) {
+	if begin == end {
+		return
+	}
+	_ordering, _partition, _funcApp := ps.pop3()
+
+	// extract and convert the contained structure
+	// (if this fails, this is a fundamental parser bug => panic ok)
+	ordering := _ordering.comp.(ExpressionsAST)
+	partition := _partition.comp.(ExpressionsAST)
+	funcApp := _funcApp.comp.(FuncAppAST)
+
+	var orderExprs []SortedExpressionAST
+	if len(ordering.Expressions) > 0 {
+		orderExprs = make([]SortedExpressionAST, len(ordering.Expressions))
+		for i, e := range ordering.Expressions {
+			orderExprs[i] = e.(SortedExpressionAST)
+		}
+	}
+	var partitionExprs []Expression
+	if len(partition.Expressions) > 0 {
+		partitionExprs = partition.Expressions
+	}
+
+	// assemble the AnalyticFuncAST and push it back
+	ps.PushComponent(_funcApp.begin, end, AnalyticFuncAST{funcApp,
+		OverAST{partitionExprs, orderExprs}})
 }
 
 // AssembleSortedExpression takes the topmost elements from the stack,
@@ -1185,6 +1795,36 @@ func (ps *parseStack) AssembleMap(begin int, end int) {
 	ps.PushComponent(begin, end, MapAST{pairs})
 }
 
+// AssembleIntervalLiteral takes the topmost element from the stack,
+// assuming it is the string of an interval literal, and replaces it by
+// an IntervalLiteral element.
+//
+//  StringLiteral
+//   =>
+//  IntervalLiteral
+func (ps *parseStack) AssembleIntervalLiteral(begin int, end int) {
+	_str := ps.Pop()
+	str := _str.comp.(StringLiteral)
+	ps.PushComponent(begin, end, NewIntervalLiteral(str.Value))
+}
+
+// AssembleLambda takes the topmost elements from the stack, assuming
+// they are components of a lambda expression, and replaces them by
+// a single LambdaAST element.
+//
+//  Expression
+//  Identifier
+//   =>
+//  LambdaAST{string, Expression}
+func (ps *parseStack) AssembleLambda() {
+	_body, _param := ps.pop2()
+
+	body := _body.comp.(Expression)
+	param := _param.comp.(Identifier)
+
+	ps.PushComponent(_param.begin, _body.end, NewLambdaAST(string(param), body))
+}
+
 // AssembleKeyValuePair takes the topmost elements from the stack,
 // assuming they are components of a key-value pair expression, and
 // replaces them by a single KeyValuePairAST element.
@@ -1413,3 +2053,12 @@ func (ps *parseStack) pop8() (*ParsedComponent, *ParsedComponent,
 	}
 	return ps.Pop(), ps.Pop(), ps.Pop(), ps.Pop(), ps.Pop(), ps.Pop(), ps.Pop(), ps.Pop()
 }
+
+func (ps *parseStack) pop9() (*ParsedComponent, *ParsedComponent,
+	*ParsedComponent, *ParsedComponent, *ParsedComponent, *ParsedComponent,
+	*ParsedComponent, *ParsedComponent, *ParsedComponent) {
+	if ps.Len() < 9 {
+		panic("not enough elements on stack to pop 9 of them")
+	}
+	return ps.Pop(), ps.Pop(), ps.Pop(), ps.Pop(), ps.Pop(), ps.Pop(), ps.Pop(), ps.Pop(), ps.Pop()
+}
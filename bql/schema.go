@@ -0,0 +1,305 @@
+package bql
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/execution"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// schema is a declared schema of a source or a stream. It validates the
+// tuples emitted by the node and is stored as the meta information of the
+// node so that statements reading from the node can be checked.
+type schema struct {
+	fields []schemaField
+	// convert is true when values are converted to the declared types
+	// like CAST does. Otherwise, only INT values are converted to FLOAT.
+	convert bool
+}
+
+type schemaField struct {
+	parser.SchemaFieldAST
+	path data.Path
+	keys []string
+}
+
+var (
+	_ core.TupleValidator = &schema{}
+)
+
+// newSchema compiles the given schema. It returns nil when the schema
+// doesn't have any field.
+func newSchema(ast parser.SchemaAST, convert bool) (*schema, error) {
+	if len(ast.Fields) == 0 {
+		return nil, nil
+	}
+	s := &schema{
+		fields:  make([]schemaField, len(ast.Fields)),
+		convert: convert,
+	}
+	for i, f := range ast.Fields {
+		path, err := data.CompilePath(f.Path)
+		if err != nil {
+			return nil, err
+		}
+		keys, complete := data.MapKeys(path)
+		if !complete {
+			return nil, fmt.Errorf("the path of field '%s' can only have map keys", f.Path)
+		}
+		for _, prev := range s.fields[:i] {
+			switch {
+			case len(prev.keys) == len(keys) && hasKeyPrefix(keys, prev.keys):
+				return nil, fmt.Errorf("field '%s' is declared more than once", f.Path)
+			case hasKeyPrefix(keys, prev.keys) && prev.Type != parser.Map:
+				return nil, fmt.Errorf("field '%s' must be MAP to have field '%s'", prev.Path, f.Path)
+			case hasKeyPrefix(prev.keys, keys) && f.Type != parser.Map:
+				return nil, fmt.Errorf("field '%s' must be MAP to have field '%s'", f.Path, prev.Path)
+			}
+		}
+		s.fields[i] = schemaField{f, path, keys}
+	}
+	return s, nil
+}
+
+// hasKeyPrefix returns true when keys starts with prefix.
+func hasKeyPrefix(keys, prefix []string) bool {
+	if len(prefix) > len(keys) {
+		return false
+	}
+	for i, k := range prefix {
+		if keys[i] != k {
+			return false
+		}
+	}
+	return true
+}
+
+// Validate checks that the tuple has all NOT NULL fields and that the
+// values of the declared fields have the declared types.
+func (s *schema) Validate(t *core.Tuple) error {
+	for _, f := range s.fields {
+		v, err := t.Data.Get(f.path)
+		if err != nil || v.Type() == data.TypeNull {
+			// Get also fails when the field is missing
+			if f.NotNull == parser.Yes {
+				return fmt.Errorf("field '%s' must not be null", f.Path)
+			}
+			continue
+		}
+		conv, err := s.conform(v, f.Type)
+		if err != nil {
+			return fmt.Errorf("field '%s' must be %s: %v", f.Path, f.Type, err)
+		}
+		if conv != nil {
+			if err := t.Data.Set(f.path, conv); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// conform returns the value converted to the given type, or nil when
+// the value already has the type.
+func (s *schema) conform(v data.Value, t parser.Type) (data.Value, error) {
+	var typ data.TypeID
+	switch t {
+	case parser.Bool:
+		typ = data.TypeBool
+	case parser.Int:
+		typ = data.TypeInt
+	case parser.Float:
+		typ = data.TypeFloat
+	case parser.String:
+		typ = data.TypeString
+	case parser.Blob:
+		typ = data.TypeBlob
+	case parser.Timestamp:
+		typ = data.TypeTimestamp
+	case parser.Array:
+		typ = data.TypeArray
+	case parser.Map:
+		typ = data.TypeMap
+	default:
+		return nil, fmt.Errorf("unsupported type %s", t)
+	}
+	if v.Type() == typ {
+		return nil, nil
+	}
+
+	if !s.convert {
+		if t == parser.Float && v.Type() == data.TypeInt {
+			i, _ := data.AsInt(v)
+			return data.Float(i), nil
+		}
+		return nil, fmt.Errorf("the value is %s", v.Type())
+	}
+	switch t {
+	case parser.Bool:
+		x, err := data.ToBool(v)
+		if err != nil {
+			return nil, err
+		}
+		return data.Bool(x), nil
+	case parser.Int:
+		x, err := data.ToInt(v)
+		if err != nil {
+			return nil, err
+		}
+		return data.Int(x), nil
+	case parser.Float:
+		x, err := data.ToFloat(v)
+		if err != nil {
+			return nil, err
+		}
+		return data.Float(x), nil
+	case parser.String:
+		x, err := data.ToString(v)
+		if err != nil {
+			return nil, err
+		}
+		return data.String(x), nil
+	case parser.Blob:
+		x, err := data.ToBlob(v)
+		if err != nil {
+			return nil, err
+		}
+		return data.Blob(x), nil
+	case parser.Timestamp:
+		x, err := data.ToTimestamp(v)
+		if err != nil {
+			return nil, err
+		}
+		return data.Timestamp(x), nil
+	}
+	return nil, fmt.Errorf("the value is %s", v.Type())
+}
+
+// declares returns true when the column having the given path can be
+// read from tuples conforming to the schema. This is the case when the
+// column is a declared field, a parent of a declared field, or a child
+// of a declared MAP or ARRAY field.
+func (s *schema) declares(col string) bool {
+	path, err := data.CompilePath(col)
+	if err != nil {
+		return false
+	}
+	keys, _ := data.MapKeys(path)
+	for _, f := range s.fields {
+		if hasKeyPrefix(f.keys, keys) {
+			return true
+		}
+		if hasKeyPrefix(keys, f.keys) && (f.Type == parser.Map || f.Type == parser.Array) {
+			return true
+		}
+	}
+	return false
+}
+
+// equal returns true when both schemas declare the same fields.
+func (s *schema) equal(o *schema) bool {
+	if s == nil || o == nil {
+		return s == o
+	}
+	if len(s.fields) != len(o.fields) {
+		return false
+	}
+	for i, f := range s.fields {
+		if f.SchemaFieldAST != o.fields[i].SchemaFieldAST {
+			return false
+		}
+	}
+	return true
+}
+
+// toValue returns the fields of the schema to be shown by DESCRIBE.
+func (s *schema) toValue() data.Value {
+	fields := make(data.Array, len(s.fields))
+	for i, f := range s.fields {
+		fields[i] = data.Map{
+			"path":     data.String(f.Path),
+			"type":     data.String(f.Type.String()),
+			"not_null": data.Bool(f.NotNull == parser.Yes),
+		}
+	}
+	return fields
+}
+
+// nodeSchema returns the schema of the given node, or nil if the node
+// doesn't have one.
+func nodeSchema(n core.Node) *schema {
+	s, _ := n.Meta().(*schema)
+	return s
+}
+
+// checkSchemas checks that the statement only refers to columns declared
+// in the schemas of its input streams. When out isn't nil, it also checks
+// that the result rows conform to that schema of the stream name.
+func (tb *TopologyBuilder) checkSchemas(stmt *parser.SelectStmt, name string, out *schema) error {
+	ins := map[string]*schema{}
+	for _, rel := range stmt.Relations {
+		if rel.Type != parser.ActualStream {
+			continue
+		}
+		n, err := tb.topology.Node(rel.Name)
+		if err != nil {
+			// missing inputs are reported when connecting them
+			continue
+		}
+		if s := nodeSchema(n); s != nil {
+			ins[rel.Name] = s
+		}
+	}
+	if len(ins) == 0 && out == nil {
+		return nil
+	}
+
+	lp, err := execution.Analyze(*stmt, tb.Reg)
+	if err != nil {
+		return err
+	}
+	cols := lp.InputColumns()
+	for _, rel := range lp.Relations {
+		s := ins[rel.Name]
+		if s == nil || rel.Type != parser.ActualStream {
+			continue
+		}
+		for _, col := range cols[rel.Alias] {
+			if !s.declares(col) {
+				return fmt.Errorf("column '%s' is not declared in the schema of '%s'", col, rel.Name)
+			}
+		}
+	}
+
+	if out == nil {
+		return nil
+	}
+	outCols, ok := lp.OutputColumns()
+	if !ok {
+		// a wildcard can emit any column
+		return nil
+	}
+	for _, col := range outCols {
+		if !out.declares(col) {
+			return fmt.Errorf("column '%s' is not declared in the schema of '%s'", col, name)
+		}
+	}
+	for _, f := range out.fields {
+		if f.NotNull != parser.Yes {
+			continue
+		}
+		found := false
+		for _, col := range outCols {
+			keys, _ := data.MapKeys(data.MustCompilePath(col))
+			if hasKeyPrefix(f.keys, keys) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("field '%s' of '%s' must not be null but isn't selected", f.Path, name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,267 @@
+package bql
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestSchema(t *testing.T) {
+	parseSchema := func(fields string) parser.SchemaAST {
+		p := parser.New()
+		stmt, _, err := p.ParseStmt("CREATE SOURCE s " + fields + " TYPE t")
+		So(err, ShouldBeNil)
+		return stmt.(parser.CreateSourceStmt).Schema
+	}
+
+	Convey("Given invalid schemas", t, func() {
+		cases := map[string]string{
+			"(a INT, a FLOAT)":               "field 'a' is declared more than once",
+			"(a.b INT, a[\"b\"] FLOAT)":      "field 'a[\"b\"]' is declared more than once",
+			"(a INT, a.b INT)":               "field 'a' must be MAP to have field 'a.b'",
+			"(a.b INT, a STRING)":            "field 'a' must be MAP to have field 'a.b'",
+			"(a MAP, a.b INT, a.b.c STRING)": "field 'a.b' must be MAP to have field 'a.b.c'",
+		}
+		for fields, msg := range cases {
+			fields, msg := fields, msg
+			Convey("When compiling "+fields, func() {
+				_, err := newSchema(parseSchema(fields), false)
+
+				Convey("Then it should fail", func() {
+					So(err, ShouldNotBeNil)
+					So(err.Error(), ShouldEqual, msg)
+				})
+			})
+		}
+	})
+
+	Convey("Given a schema with nested fields", t, func() {
+		ast := parseSchema("(id INT NOT NULL, pos MAP, pos.x FLOAT NOT NULL, tags ARRAY)")
+
+		Convey("When validating tuples without conversion", func() {
+			s, err := newSchema(ast, false)
+			So(err, ShouldBeNil)
+			validate := func(m data.Map) (data.Map, error) {
+				t := core.NewTuple(m)
+				err := s.Validate(t)
+				return t.Data, err
+			}
+
+			Convey("Then conforming tuples should be accepted", func() {
+				d, err := validate(data.Map{
+					"id":   data.Int(1),
+					"pos":  data.Map{"x": data.Float(1.5)},
+					"tags": data.Array{data.String("a")},
+				})
+				So(err, ShouldBeNil)
+				So(d["pos"], ShouldResemble, data.Map{"x": data.Float(1.5)})
+			})
+
+			Convey("Then nullable fields can be missing or null", func() {
+				_, err := validate(data.Map{
+					"id":   data.Int(1),
+					"pos":  data.Map{"x": data.Float(1.5)},
+					"tags": data.Null{},
+				})
+				So(err, ShouldBeNil)
+			})
+
+			Convey("Then an INT value should be converted to FLOAT", func() {
+				d, err := validate(data.Map{
+					"id":  data.Int(1),
+					"pos": data.Map{"x": data.Int(2)},
+				})
+				So(err, ShouldBeNil)
+				So(d["pos"], ShouldResemble, data.Map{"x": data.Float(2)})
+			})
+
+			Convey("Then a missing NOT NULL field should be rejected", func() {
+				_, err := validate(data.Map{"id": data.Int(1)})
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "field 'pos.x' must not be null")
+			})
+
+			Convey("Then a value having a wrong type should be rejected", func() {
+				_, err := validate(data.Map{
+					"id":  data.String("1"),
+					"pos": data.Map{"x": data.Float(1.5)},
+				})
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "field 'id' must be INT: the value is string")
+			})
+		})
+
+		Convey("When validating tuples with conversion", func() {
+			s, err := newSchema(ast, true)
+			So(err, ShouldBeNil)
+
+			Convey("Then values should be converted like CAST", func() {
+				t := core.NewTuple(data.Map{
+					"id":  data.String("1"),
+					"pos": data.Map{"x": data.String("1.5")},
+				})
+				So(s.Validate(t), ShouldBeNil)
+				So(t.Data["id"], ShouldEqual, data.Int(1))
+				So(t.Data["pos"], ShouldResemble, data.Map{"x": data.Float(1.5)})
+			})
+
+			Convey("Then values which cannot be converted should be rejected", func() {
+				t := core.NewTuple(data.Map{
+					"id":  data.Int(1),
+					"pos": data.Map{"x": data.Float(1)},
+					// ARRAY isn't converted
+					"tags": data.String("a"),
+				})
+				So(s.Validate(t), ShouldNotBeNil)
+			})
+		})
+
+		Convey("When checking columns", func() {
+			s, err := newSchema(ast, false)
+			So(err, ShouldBeNil)
+
+			Convey("Then declared fields and their parents should be declared", func() {
+				So(s.declares("id"), ShouldBeTrue)
+				So(s.declares("pos"), ShouldBeTrue)
+				So(s.declares("pos.x"), ShouldBeTrue)
+				So(s.declares(`pos["x"]`), ShouldBeTrue)
+			})
+
+			Convey("Then children of MAP or ARRAY fields should be declared", func() {
+				So(s.declares("pos.y"), ShouldBeTrue)
+				So(s.declares("tags[0]"), ShouldBeTrue)
+			})
+
+			Convey("Then other fields should not be declared", func() {
+				So(s.declares("ID"), ShouldBeFalse)
+				So(s.declares("id.x"), ShouldBeFalse)
+				So(s.declares("name"), ShouldBeFalse)
+			})
+		})
+	})
+}
+
+func TestSchemaStmts(t *testing.T) {
+	Convey("Given a BQL TopologyBuilder with a source having a schema", t, func() {
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+		So(addBQLToTopology(tb, `CREATE PAUSED SOURCE s (int INT NOT NULL) TYPE dummy`), ShouldBeNil)
+
+		Convey("When creating a stream referring to a declared field", func() {
+			err := addBQLToTopology(tb, `CREATE STREAM b AS
+				SELECT RSTREAM int AS x FROM s [RANGE 1 TUPLES] WHERE int > 1`)
+
+			Convey("Then it should succeed", func() {
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("When creating a stream referring to an unknown field", func() {
+			err := addBQLToTopology(tb, `CREATE STREAM b AS
+				SELECT RSTREAM int FROM s [RANGE 1 TUPLES] WHERE itn > 1`)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "column 'itn' is not declared in the schema of 's'")
+			})
+		})
+
+		Convey("When creating a stream whose result doesn't match its schema", func() {
+			err := addBQLToTopology(tb, `CREATE STREAM b (x INT) AS
+				SELECT RSTREAM int AS y FROM s [RANGE 1 TUPLES]`)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "column 'y' is not declared in the schema of 'b'")
+			})
+		})
+
+		Convey("When creating a stream not selecting a NOT NULL field", func() {
+			err := addBQLToTopology(tb, `CREATE STREAM b (x INT, y INT NOT NULL) AS
+				SELECT RSTREAM int AS x FROM s [RANGE 1 TUPLES]`)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "field 'y' of 'b' must not be null but isn't selected")
+			})
+		})
+
+		Convey("When creating a stream having a schema", func() {
+			So(addBQLToTopology(tb, `
+				CREATE STREAM b (x STRING, y INT NOT NULL) AS
+					SELECT RSTREAM int AS x, CASE WHEN int % 2 = 0 THEN int END AS y
+					FROM s [RANGE 1 TUPLES];
+				CREATE SINK snk TYPE collector;
+				INSERT INTO snk FROM b;
+				RESUME SOURCE s;`), ShouldBeNil)
+
+			Convey("Then tuples should be converted and invalid ones should be dropped", func() {
+				sin, err := dt.Sink("snk")
+				So(err, ShouldBeNil)
+				si := sin.Sink().(*tupleCollectorSink)
+				si.Wait(2)
+				So(si.get(0).Data, ShouldResemble, data.Map{"x": data.String("2"), "y": data.Int(2)})
+				So(si.get(1).Data, ShouldResemble, data.Map{"x": data.String("4"), "y": data.Int(4)})
+			})
+
+			Convey("Then DESCRIBE should show the schema", func() {
+				istmt, _, err := parser.New().ParseStmt("DESCRIBE b")
+				So(err, ShouldBeNil)
+				stmt := istmt.(parser.DescribeStmt)
+				v, err := tb.RunDescribeStmt(&stmt)
+				So(err, ShouldBeNil)
+				m, err := data.AsMap(v)
+				So(err, ShouldBeNil)
+				So(m["schema"], ShouldResemble, data.Array{
+					data.Map{"path": data.String("x"), "type": data.String("STRING"), "not_null": data.False},
+					data.Map{"path": data.String("y"), "type": data.String("INT"), "not_null": data.True},
+				})
+			})
+
+			Convey("Then replacing it without a schema should keep the schema", func() {
+				err := addBQLToTopology(tb, `CREATE OR REPLACE STREAM b AS
+					SELECT RSTREAM int AS z FROM s [RANGE 1 TUPLES]`)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "column 'z' is not declared in the schema of 'b'")
+			})
+
+			Convey("Then replacing it with the same schema should succeed", func() {
+				So(addBQLToTopology(tb, `CREATE OR REPLACE STREAM b (x STRING, y INT NOT NULL) AS
+					SELECT RSTREAM int AS x, int AS y FROM s [RANGE 1 TUPLES]`), ShouldBeNil)
+			})
+
+			Convey("Then replacing it with a different schema should fail", func() {
+				err := addBQLToTopology(tb, `CREATE OR REPLACE STREAM b (x STRING, y INT) AS
+					SELECT RSTREAM int AS x, int AS y FROM s [RANGE 1 TUPLES]`)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "the schema of stream 'b' cannot be changed")
+			})
+		})
+
+		Convey("When creating a source whose tuples don't match its schema", func() {
+			So(addBQLToTopology(tb, `
+				CREATE PAUSED SOURCE s2 (int STRING) TYPE dummy;
+				CREATE SINK snk TYPE collector;
+				INSERT INTO snk FROM s2;
+				CREATE PAUSED SOURCE s3 (int FLOAT NOT NULL) TYPE dummy WITH num=1;
+				INSERT INTO snk FROM s3;
+				RESUME SOURCE s2;
+				RESUME SOURCE s3;`), ShouldBeNil)
+
+			Convey("Then only conforming tuples should be emitted", func() {
+				sin, err := dt.Sink("snk")
+				So(err, ShouldBeNil)
+				si := sin.Sink().(*tupleCollectorSink)
+				si.Wait(1)
+				So(si.len(), ShouldEqual, 1)
+				So(si.get(0).Data, ShouldResemble, data.Map{"int": data.Float(1)})
+			})
+		})
+	})
+}
@@ -0,0 +1,183 @@
+package bql
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sort"
+)
+
+// RunShowStmt returns an Array having one Map for each item of the
+// category given in the ShowStmt, sorted by name. Each Map has a
+// "name" key and the following additional keys depending on the
+// category:
+//
+//	SOURCES, STREAMS, SINKS: "node_type" and "state" of the node
+//	STATES:                  "type" of the shared state
+//	FUNCTIONS:               "kind", which is "udf" or "udsf", and
+//	                         "definition" of functions created by
+//	                         CREATE FUNCTION statements
+//
+// SOURCE TYPES, SINK TYPES, and STATE TYPES list the type names that
+// can be used in CREATE SOURCE, CREATE SINK, and CREATE STATE
+// statements, respectively.
+func (tb *TopologyBuilder) RunShowStmt(stmt *parser.ShowStmt) (data.Value, error) {
+	var rows data.Array
+	switch stmt.Target {
+	case parser.ShowSources:
+		for _, n := range tb.topology.Sources() {
+			rows = append(rows, showNode(n))
+		}
+
+	case parser.ShowStreams:
+		for _, n := range tb.topology.Boxes() {
+			rows = append(rows, showNode(n))
+		}
+
+	case parser.ShowSinks:
+		for _, n := range tb.topology.Sinks() {
+			rows = append(rows, showNode(n))
+		}
+
+	case parser.ShowStates:
+		states, err := tb.topology.Context().SharedStates.List()
+		if err != nil {
+			return nil, err
+		}
+		for name := range states {
+			typeName, err := tb.topology.Context().SharedStates.Type(name)
+			if err != nil {
+				if core.IsNotExist(err) {
+					continue // removed concurrently
+				}
+				return nil, err
+			}
+			rows = append(rows, data.Map{
+				"name": data.String(name),
+				"type": data.String(typeName),
+			})
+		}
+
+	case parser.ShowFunctions:
+		lister, ok := tb.Reg.(udf.FunctionLister)
+		if !ok {
+			return nil, fmt.Errorf("the function registry cannot list functions")
+		}
+		funcs, err := lister.List()
+		if err != nil {
+			return nil, err
+		}
+		for name, f := range funcs {
+			row := data.Map{
+				"name": data.String(name),
+				"kind": data.String("udf"),
+			}
+			if bf, ok := f.(*bqlFunction); ok {
+				row["definition"] = data.String(bf.stmt.String())
+			}
+			rows = append(rows, row)
+		}
+		udsfs, err := tb.UDSFCreators.List()
+		if err != nil {
+			return nil, err
+		}
+		for name := range udsfs {
+			rows = append(rows, data.Map{
+				"name": data.String(name),
+				"kind": data.String("udsf"),
+			})
+		}
+
+	case parser.ShowSourceTypes:
+		creators, err := tb.SourceCreators.List()
+		if err != nil {
+			return nil, err
+		}
+		for name := range creators {
+			rows = append(rows, data.Map{"name": data.String(name)})
+		}
+
+	case parser.ShowSinkTypes:
+		creators, err := tb.SinkCreators.List()
+		if err != nil {
+			return nil, err
+		}
+		for name := range creators {
+			rows = append(rows, data.Map{"name": data.String(name)})
+		}
+
+	case parser.ShowStateTypes:
+		creators, err := tb.UDSCreators.List()
+		if err != nil {
+			return nil, err
+		}
+		for name := range creators {
+			rows = append(rows, data.Map{"name": data.String(name)})
+		}
+
+	default:
+		return nil, fmt.Errorf("cannot show %v", stmt.Target)
+	}
+
+	if rows == nil {
+		rows = data.Array{}
+	}
+	sort.Sort(rowsByName(rows))
+	return rows, nil
+}
+
+// RunDescribeStmt returns the details of the node given in the
+// DescribeStmt. The returned Map has the "name", "node_type", "state",
+// and "status" of the node as well as its "meta" information if it can
+// be converted to a data.Value. When the node has a declared schema, its
+// fields are returned as "schema".
+func (tb *TopologyBuilder) RunDescribeStmt(stmt *parser.DescribeStmt) (data.Value, error) {
+	n, err := tb.topology.Node(string(stmt.Name))
+	if err != nil {
+		return nil, err
+	}
+	m := showNode(n)
+	m["status"] = n.Status()
+	if s := nodeSchema(n); s != nil {
+		m["schema"] = s.toValue()
+	} else if meta := n.Meta(); meta != nil {
+		if v, err := data.NewValue(meta); err == nil {
+			m["meta"] = v
+		}
+	}
+	return m, nil
+}
+
+func showNode(n core.Node) data.Map {
+	return data.Map{
+		"name":      data.String(n.Name()),
+		"node_type": data.String(n.Type().String()),
+		"state":     data.String(n.State().Get().String()),
+	}
+}
+
+// rowsByName sorts rows of RunShowStmt by their names. Rows of SHOW
+// FUNCTIONS can have the same name, in which case they are sorted by
+// their kinds.
+type rowsByName data.Array
+
+func (r rowsByName) Len() int {
+	return len(r)
+}
+
+func (r rowsByName) Less(i, j int) bool {
+	ri, rj := r[i].(data.Map), r[j].(data.Map)
+	ni, nj := ri["name"].(data.String), rj["name"].(data.String)
+	if ni != nj {
+		return ni < nj
+	}
+	ki, _ := ri["kind"].(data.String)
+	kj, _ := rj["kind"].(data.String)
+	return ki < kj
+}
+
+func (r rowsByName) Swap(i, j int) {
+	r[i], r[j] = r[j], r[i]
+}
@@ -102,6 +102,19 @@ func (tb *TopologyBuilder) AddStmt(stmt interface{}) (core.Node, error) {
 		// load params into map for faster access
 		paramsMap := tb.mkParamsMap(stmt.Params)
 
+		// tuples emitted by the source are validated with the schema
+		sch, err := newSchema(stmt.Schema, false)
+		if err != nil {
+			return nil, err
+		}
+		config := &core.SourceConfig{
+			PausedOnStartup: stmt.Paused == parser.Yes,
+		}
+		if sch != nil {
+			config.Validator = sch
+			config.Meta = sch
+		}
+
 		// check if we know this type of source
 		creator, err := tb.SourceCreators.Lookup(string(stmt.Type))
 		if err != nil {
@@ -116,13 +129,14 @@ func (tb *TopologyBuilder) AddStmt(stmt interface{}) (core.Node, error) {
 		if err != nil {
 			return nil, err
 		}
-		return tb.topology.AddSource(string(stmt.Name), source, &core.SourceConfig{
-			PausedOnStartup: stmt.Paused == parser.Yes,
-		})
+		return tb.topology.AddSource(string(stmt.Name), source, config)
 
 	case parser.CreateStreamAsSelectStmt:
 		return tb.createStreamAsSelectStmt(&stmt)
 
+	case parser.CreateOrReplaceStreamAsSelectStmt:
+		return tb.createOrReplaceStreamAsSelectStmt(&stmt)
+
 	case parser.CreateStreamAsSelectUnionStmt:
 		// idea: create an intermediate box for each SELECT substatement,
 		// then connect them with a simple forwarder box
@@ -138,7 +152,9 @@ func (tb *TopologyBuilder) AddStmt(stmt interface{}) (core.Node, error) {
 			tmpName := fmt.Sprintf("sensorbee_tmp_%v", topologyBuilderNextTemporaryID())
 			tmpStmt := parser.CreateStreamAsSelectStmt{
 				parser.StreamIdentifier(tmpName),
+				parser.SchemaAST{},
 				selStmt,
+				parser.OnErrorAST{},
 			}
 			box, err := tb.AddStmt(tmpStmt)
 			if err != nil {
@@ -300,6 +316,12 @@ func (tb *TopologyBuilder) AddStmt(stmt interface{}) (core.Node, error) {
 		_, err = ctx.SharedStates.Remove(string(stmt.State))
 		return nil, err
 
+	case parser.CreateFunctionStmt:
+		return nil, tb.createFunction(&stmt)
+
+	case parser.DropFunctionStmt:
+		return nil, tb.dropFunction(&stmt)
+
 	case parser.InsertIntoFromStmt:
 		// get the sink to add an input to
 		sink, err := tb.topology.Sink(string(stmt.Sink))
@@ -406,12 +428,77 @@ func (s *udsfSource) Stop(ctx *core.Context) error {
 	return s.f.Terminate(ctx)
 }
 
+// sideStreamSource is a core.Source which emits the tuples that another
+// node writes to it, such as late tuples of a bqlBox.
+type sideStreamSource struct {
+	m    sync.RWMutex
+	w    core.Writer
+	stop chan struct{}
+	once sync.Once
+}
+
+var (
+	_ core.Source = &sideStreamSource{}
+)
+
+func newSideStreamSource() *sideStreamSource {
+	return &sideStreamSource{
+		stop: make(chan struct{}),
+	}
+}
+
+func (s *sideStreamSource) GenerateStream(ctx *core.Context, w core.Writer) error {
+	s.m.Lock()
+	s.w = w
+	s.m.Unlock()
+
+	<-s.stop
+
+	s.m.Lock()
+	s.w = nil
+	s.m.Unlock()
+	return nil
+}
+
+func (s *sideStreamSource) Stop(ctx *core.Context) error {
+	s.once.Do(func() {
+		close(s.stop)
+	})
+	return nil
+}
+
+// Write emits the given tuple from the source. Tuples written before
+// the source has started or after it has stopped are discarded.
+func (s *sideStreamSource) Write(ctx *core.Context, t *core.Tuple) error {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	if s.w == nil {
+		return nil
+	}
+	return s.w.Write(ctx, t)
+}
+
 func (tb *TopologyBuilder) createStreamAsSelectStmt(stmt *parser.CreateStreamAsSelectStmt) (core.Node, error) {
 	// insert a bqlBox that executes the SELECT statement
 	outName := string(stmt.Name)
+	// the result rows are converted to the types in the schema
+	sch, err := newSchema(stmt.Schema, true)
+	if err != nil {
+		return nil, err
+	}
+	if err := tb.checkSchemas(&stmt.Select, outName, sch); err != nil {
+		return nil, err
+	}
+	config := &core.BoxConfig{}
+	if sch != nil {
+		config.Validator = sch
+		config.Meta = sch
+	}
 	box := NewBQLBox(&stmt.Select, tb.Reg)
+	box.name = outName
+	box.errorStream = string(stmt.OnError.Stream)
 	// add all the referenced relations as named inputs
-	dbox, err := tb.topology.AddBox(outName, box, nil)
+	dbox, err := tb.topology.AddBox(outName, box, config)
 	if err != nil {
 		return nil, err
 	}
@@ -431,9 +518,280 @@ func (tb *TopologyBuilder) createStreamAsSelectStmt(stmt *parser.CreateStreamAsS
 		tb.topology.Remove(outName)
 	}()
 
-	connected := map[string]bool{}
-	var pausedSources []core.SourceNode
+	// create a source that emits the late tuples of an event-time window
+	// if required (all relations have the same LATENESS specification)
+	if lateName := lateTuplesStream(&stmt.Select); lateName != "" {
+		if box.lateTuples, err = tb.addSideStreamSource(lateName); err != nil {
+			return nil, err
+		}
+		temporaryNodes = append(temporaryNodes, lateName)
+	}
+
+	// create a source that emits the tuples failing in the box
+	if box.errorStream != "" {
+		if box.errorTuples, err = tb.addSideStreamSource(box.errorStream); err != nil {
+			return nil, err
+		}
+		temporaryNodes = append(temporaryNodes, box.errorStream)
+	}
+
+	udsfNodes, pausedSources, err := tb.connectSelectInputs(dbox, box, map[string]bool{}, nil)
+	temporaryNodes = append(temporaryNodes, udsfNodes...)
+	if err != nil {
+		return nil, err
+	}
+	dbox.StopOnDisconnect(core.Inbound)
+
+	// Resume all UDSFs running in the source mode as fairly as possible.
+	for _, sn := range pausedSources {
+		if err := sn.Resume(); err != nil {
+			return nil, err
+		}
+	}
+	removeNodes = false
+	return dbox, nil
+}
+
+// createOrReplaceStreamAsSelectStmt replaces the bqlBox of an existing
+// stream with a new one executing the given SELECT statement. Nodes
+// connected to the stream stay connected. Inputs that the new statement
+// doesn't refer to any more are disconnected after the swap, and tuples
+// from them which haven't been processed by then are dropped. Inputs
+// used by both statements stay connected as they are, so an error is
+// returned when the new statement specifies a different capacity or
+// shedding option for one of them. When the stream doesn't exist, it is
+// created as with CREATE STREAM AS SELECT.
+func (tb *TopologyBuilder) createOrReplaceStreamAsSelectStmt(stmt *parser.CreateOrReplaceStreamAsSelectStmt) (core.Node, error) {
+	outName := string(stmt.Name)
+	bn, prev, err := tb.replaceableStream(outName)
+	if err != nil {
+		if !core.IsNotExist(err) {
+			return nil, err
+		}
+		return tb.createStreamAsSelectStmt(&parser.CreateStreamAsSelectStmt{
+			Name:    stmt.Name,
+			Schema:  stmt.Schema,
+			Select:  stmt.Select,
+			OnError: stmt.OnError,
+		})
+	}
+
+	// the schema is kept when the statement doesn't have one
+	sch := nodeSchema(bn)
+	if len(stmt.Schema.Fields) > 0 {
+		newSch, err := newSchema(stmt.Schema, true)
+		if err != nil {
+			return nil, err
+		}
+		if !newSch.equal(sch) {
+			return nil, fmt.Errorf("the schema of stream '%v' cannot be changed", outName)
+		}
+	}
+	if err := tb.checkSchemas(&stmt.Select, outName, sch); err != nil {
+		return nil, err
+	}
+
+	// validate inputs before replacing the box so that the topology is
+	// left unchanged in most error cases
 	for _, rel := range stmt.Select.Relations {
+		switch rel.Type {
+		case parser.ActualStream:
+			if rel.Name == outName {
+				return nil, fmt.Errorf("stream '%v' cannot refer to itself", outName)
+			}
+			if _, err := tb.topology.Node(rel.Name); err != nil {
+				return nil, err
+			}
+		case parser.UDSFStream:
+			if _, err := tb.UDSFCreators.Lookup(rel.Name, len(rel.Params)); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("input stream of type %s not implemented",
+				rel.Type)
+		}
+		if rel.Type == parser.ActualStream {
+			if err := checkKeptInput(prev.stmt, &stmt.Select, rel.Name, outName); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	box := NewBQLBox(&stmt.Select, tb.Reg)
+	box.removeMe = func() { go tb.topology.Remove(outName) }
+	box.name = outName
+	box.errorStream = string(stmt.OnError.Stream)
+	// the sources of the previous box are taken over by InheritState
+	// when the tuples are written to the same streams
+	var added []string
+	removeAdded := func() {
+		for _, n := range added {
+			tb.topology.Remove(n)
+		}
+	}
+	lateName := lateTuplesStream(&stmt.Select)
+	if lateName != "" && lateName != lateTuplesStream(prev.stmt) {
+		if box.lateTuples, err = tb.addSideStreamSource(lateName); err != nil {
+			return nil, err
+		}
+		added = append(added, lateName)
+	}
+	if box.errorStream != "" && box.errorStream != prev.errorStream {
+		if box.errorTuples, err = tb.addSideStreamSource(box.errorStream); err != nil {
+			removeAdded()
+			return nil, err
+		}
+		added = append(added, box.errorStream)
+	}
+	// UDSFs are created before the swap since creating them can fail
+	var udsfs []*udsfStream
+	for _, rel := range stmt.Select.Relations {
+		if rel.Type != parser.UDSFStream {
+			continue
+		}
+		u, err := tb.createUDSFStream(&rel)
+		if err != nil {
+			removeAdded()
+			return nil, err
+		}
+		udsfs = append(udsfs, u)
+		added = append(added, u.name)
+	}
+	if err := bn.ReplaceBox(box); err != nil {
+		removeAdded()
+		return nil, err
+	}
+
+	// connect new inputs before disconnecting obsolete ones so that the
+	// box isn't stopped by StopOnDisconnect
+	kept := map[string]bool{}
+	for _, rel := range stmt.Select.Relations {
+		if rel.Type == parser.ActualStream {
+			for _, in := range prev.inputs {
+				if in == rel.Name {
+					kept[rel.Name] = true
+				}
+			}
+		}
+	}
+	// restore puts the previous statement back when the new box cannot
+	// be connected. The window state of the previous box is only restored
+	// when both statements have the same FROM clause.
+	restore := func() {
+		for _, in := range box.inputs {
+			if !kept[in] {
+				bn.RemoveInput(in)
+			}
+		}
+		restored := NewBQLBox(prev.stmt, tb.Reg)
+		restored.removeMe = prev.removeMe
+		restored.name = prev.name
+		restored.errorStream = prev.errorStream
+		restored.inputs = prev.inputs
+		// ReplaceBox only fails when the node has already been stopped
+		if err := bn.ReplaceBox(restored); err == nil {
+			restored.commitReplacement(tb.topology.Context())
+		}
+		removeAdded()
+	}
+	_, pausedSources, err := tb.connectSelectInputs(bn, box, kept, udsfs)
+	if err != nil {
+		restore()
+		return nil, err
+	}
+	for _, sn := range pausedSources {
+		if err := sn.Resume(); err != nil {
+			restore()
+			return nil, err
+		}
+	}
+
+	// RemoveInput only fails when the input has already been disconnected
+	for _, in := range prev.inputs {
+		if !kept[in] {
+			bn.RemoveInput(in)
+		}
+	}
+	box.commitReplacement(tb.topology.Context())
+	return bn, nil
+}
+
+// replaceableStream returns the node of the stream having the given name
+// and its bqlBox, which CREATE OR REPLACE STREAM replaces. It returns
+// core.NotExistError when there's no such node.
+func (tb *TopologyBuilder) replaceableStream(name string) (core.BoxNode, *bqlBox, error) {
+	n, err := tb.topology.Node(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	bn, ok := n.(core.BoxNode)
+	if !ok {
+		return nil, nil, fmt.Errorf("node '%v' is not a stream", name)
+	}
+	box, ok := bn.Box().(*bqlBox)
+	if !ok {
+		return nil, nil, fmt.Errorf("stream '%v' wasn't created by a SELECT statement and cannot be replaced", name)
+	}
+	return bn, box, nil
+}
+
+// checkKeptInput returns an error when both the previous and the next
+// statement of a stream being replaced read from the given input and
+// they specify different capacities or shedding options for it. Such an
+// input stays connected as it is.
+func checkKeptInput(prev, next *parser.SelectStmt, input, stream string) error {
+	p, ok := inputRelation(prev, input)
+	if !ok {
+		return nil
+	}
+	r, _ := inputRelation(next, input)
+	if r.Capacity != p.Capacity || r.Shedding != p.Shedding {
+		return fmt.Errorf("the capacity and shedding option of input '%v' "+
+			"cannot be changed when replacing stream '%v'", input, stream)
+	}
+	return nil
+}
+
+// inputRelation returns the first relation in the FROM clause referring
+// to the given stream. The input of the box connected to the stream is
+// configured by that relation since a stream is only connected once, even
+// in self-joins.
+func inputRelation(stmt *parser.SelectStmt, name string) (parser.AliasedStreamWindowAST, bool) {
+	for _, rel := range stmt.Relations {
+		if rel.Type == parser.ActualStream && rel.Name == name {
+			return rel, true
+		}
+	}
+	return parser.AliasedStreamWindowAST{}, false
+}
+
+// addSideStreamSource adds a source emitting the tuples that a bqlBox
+// writes to it, such as late tuples or tuples failing in the box.
+func (tb *TopologyBuilder) addSideStreamSource(name string) (*sideStreamSource, error) {
+	s := newSideStreamSource()
+	sn, err := tb.topology.AddSource(name, s, &core.SourceConfig{})
+	if err != nil {
+		return nil, err
+	}
+	// the bqlBox stops the source when it terminates
+	sn.RemoveOnStop()
+	return s, nil
+}
+
+// connectSelectInputs connects the relations of the box's statement to
+// the box node and records them in box.inputs. Actual streams contained
+// in connected are assumed to be connected already. The UDSF relations
+// use the nodes in udsfs in order, and nodes are created for them when
+// udsfs is empty. It returns the names of the temporary nodes of UDSFs,
+// also when it fails, and the UDSF sources that have to be resumed by
+// the caller.
+func (tb *TopologyBuilder) connectSelectInputs(dbox core.BoxNode, box *bqlBox, connected map[string]bool, udsfs []*udsfStream) ([]string, []core.SourceNode, error) {
+	var temporaryNodes []string
+	var pausedSources []core.SourceNode
+	for name := range connected {
+		box.inputs = append(box.inputs, name)
+	}
+	for _, rel := range box.stmt.Relations {
 		switch rel.Type {
 		case parser.ActualStream:
 			if connected[rel.Name] {
@@ -472,42 +830,72 @@ func (tb *TopologyBuilder) createStreamAsSelectStmt(stmt *parser.CreateStreamAsS
 				conf.DropMode = core.DropNone
 			}
 			if err := dbox.Input(rel.Name, conf); err != nil {
-				return nil, err
+				return temporaryNodes, nil, err
 			}
 			connected[rel.Name] = true
+			box.inputs = append(box.inputs, rel.Name)
 
 		case parser.UDSFStream:
-			sn, name, err := tb.setUpUDSFStream(dbox, &rel)
-			if err != nil {
-				return nil, err
+			var u *udsfStream
+			if len(udsfs) > 0 {
+				u, udsfs = udsfs[0], udsfs[1:]
+			} else {
+				var err error
+				if u, err = tb.createUDSFStream(&rel); err != nil {
+					return temporaryNodes, nil, err
+				}
 			}
-			temporaryNodes = append(temporaryNodes, name)
-			if sn != nil {
-				pausedSources = append(pausedSources, sn)
+			temporaryNodes = append(temporaryNodes, u.name)
+			if err := u.connect(dbox); err != nil {
+				return temporaryNodes, nil, err
+			}
+			box.inputs = append(box.inputs, u.name)
+			if u.source != nil {
+				pausedSources = append(pausedSources, u.source)
 			}
 
 		default:
-			return nil, fmt.Errorf("input stream of type %s not implemented",
+			return temporaryNodes, nil, fmt.Errorf("input stream of type %s not implemented",
 				rel.Type)
 		}
 	}
-	dbox.(core.BoxNode).StopOnDisconnect(core.Inbound)
+	return temporaryNodes, pausedSources, nil
+}
 
-	// Resume all UDSFs running in the source mode as fairly as possible.
-	for _, sn := range pausedSources {
-		if err := sn.Resume(); err != nil {
-			return nil, err
-		}
+// udsfStream is a Source or a Box created from a UDSF. It's added to the
+// topology before it is connected to the box reading from it.
+type udsfStream struct {
+	// name is the temporary name of the UDSF node.
+	name string
+	// source is the node of a UDSF running in the source mode. It's
+	// paused until the caller resumes it. It's nil for a UDSF box.
+	source core.SourceNode
+	// box is the node of a UDSF box. It's nil for a UDSF source.
+	box core.BoxNode
+	// conf is the configuration of the input of the subsequent box.
+	conf *core.BoxInputConfig
+}
+
+// connect connects the UDSF node to the subsequent box.
+func (u *udsfStream) connect(subsequentBox core.BoxNode) error {
+	if err := subsequentBox.Input(u.name, u.conf); err != nil {
+		return err
 	}
-	removeNodes = false
-	return dbox, nil
+	if u.source != nil {
+		u.source.StopOnDisconnect()
+		u.source.RemoveOnStop()
+		return nil
+	}
+	u.box.StopOnDisconnect(core.Inbound | core.Outbound)
+	u.box.RemoveOnStop()
+	return nil
 }
 
-// setUpUDSFStream creates a Source or a Box from a UDSF. When it creates a
-// Source, it will return the corresponding core.SourceNode of it. Otherwise,
-// it returns nil for core.SourceNode. It also returns the temporary name of
-// the UDSF node.
-func (tb *TopologyBuilder) setUpUDSFStream(subsequentBox core.BoxNode, rel *parser.AliasedStreamWindowAST) (core.SourceNode, string, error) {
+// createUDSFStream creates a Source or a Box from a UDSF and adds it to
+// the topology. The node has to be connected to the subsequent box with
+// udsfStream.connect. A created Source is paused and will be resumed by
+// the caller.
+func (tb *TopologyBuilder) createUDSFStream(rel *parser.AliasedStreamWindowAST) (*udsfStream, error) {
 	// Compute the values of the UDSF parameters (if there was
 	// an unusable parameter, as in `udsf(7, col)` this will fail).
 	// Note: it doesn't feel exactly right to do this kind of
@@ -519,14 +907,50 @@ func (tb *TopologyBuilder) setUpUDSFStream(subsequentBox core.BoxNode, rel *pars
 	for i, expr := range rel.Params {
 		p, err := execution.EvaluateFoldable(expr, tb.Reg)
 		if err != nil {
-			return nil, "", err
+			return nil, err
 		}
 		params[i] = p
 	}
 
 	udsfc, err := tb.UDSFCreators.Lookup(rel.Name, len(params))
 	if err != nil {
-		return nil, "", err
+		return nil, err
+	}
+
+	alias := rel.Alias
+	if alias == "" {
+		alias = rel.Name
+	}
+	conf := &core.BoxInputConfig{
+		// As opposed to actual streams, for `udsf("s") AS a, udsf("s") AS b`,
+		// there will be *multiple* boxes and we will have one connection to
+		// udsf 1 (the one aliased to `a`) and udsf 2 (the one aliased to `b`).
+		// Therefore we need to have different InputNames for them. Note that
+		// we cannot just take the alias, as there would be the danger of
+		// overriding an input stream with that same name, as in
+		// `FROM x AS b, udsf("s") AS x`, and we should also use a string
+		// that does not possibly conflict with any input name.
+		// Note that `addTupleToBuffer` in defaultSelectExecutionPlan needs
+		// to use that same method.
+		InputName: fmt.Sprintf("%s/%s", rel.Name, alias),
+	}
+	// set capacity of input pipe
+	if rel.Capacity != parser.UnspecifiedCapacity {
+		if rel.Capacity > math.MaxInt32 {
+			return nil, fmt.Errorf("specified buffer capacity %d is too large", rel.Capacity)
+		} else if rel.Capacity < 0 {
+			// the parser should not allow this to happen, actually
+			return nil, fmt.Errorf("specified buffer capacity %d must not be negative", rel.Capacity)
+		}
+		conf.Capacity = int(rel.Capacity)
+	}
+	// set drop mode for box
+	if rel.Shedding == parser.DropOldest {
+		conf.DropMode = core.DropOldest
+	} else if rel.Shedding == parser.DropNewest {
+		conf.DropMode = core.DropLatest
+	} else if rel.Shedding == parser.Wait {
+		conf.DropMode = core.DropNone
 	}
 
 	decl := udf.NewUDSFDeclarer()
@@ -543,84 +967,35 @@ func (tb *TopologyBuilder) setUpUDSFStream(subsequentBox core.BoxNode, rel *pars
 		return udsfc.CreateUDSF(tb.topology.Context(), decl, params...)
 	}()
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 
 	temporaryName := fmt.Sprintf("sensorbee_tmp_udsf_%v", topologyBuilderNextTemporaryID())
-	addInput := func() error {
-		alias := rel.Alias
-		if alias == "" {
-			alias = rel.Name
-		}
-		conf := &core.BoxInputConfig{
-			// As opposed to actual streams, for `udsf("s") AS a, udsf("s") AS b`,
-			// there will be *multiple* boxes and we will have one connection to
-			// udsf 1 (the one aliased to `a`) and udsf 2 (the one aliased to `b`).
-			// Therefore we need to have different InputNames for them. Note that
-			// we cannot just take the alias, as there would be the danger of
-			// overriding an input stream with that same name, as in
-			// `FROM x AS b, udsf("s") AS x`, and we should also use a string
-			// that does not possibly conflict with any input name.
-			// Note that `addTupleToBuffer` in defaultSelectExecutionPlan needs
-			// to use that same method.
-			InputName: fmt.Sprintf("%s/%s", rel.Name, alias),
-		}
-		// set capacity of input pipe
-		if rel.Capacity != parser.UnspecifiedCapacity {
-			if rel.Capacity > math.MaxInt32 {
-				return fmt.Errorf("specified buffer capacity %d is too large", rel.Capacity)
-			} else if rel.Capacity < 0 {
-				// the parser should not allow this to happen, actually
-				return fmt.Errorf("specified buffer capacity %d must not be negative", rel.Capacity)
-			}
-			conf.Capacity = int(rel.Capacity)
-		}
-		// set drop mode for box
-		if rel.Shedding == parser.DropOldest {
-			conf.DropMode = core.DropOldest
-		} else if rel.Shedding == parser.DropNewest {
-			conf.DropMode = core.DropLatest
-		} else if rel.Shedding == parser.Wait {
-			conf.DropMode = core.DropNone
-		}
-		return subsequentBox.Input(temporaryName, conf)
-	}
-
 	if len(decl.ListInputs()) == 0 { // Source mode
 		sn, err := tb.topology.AddSource(temporaryName, newUDSFSource(udsf), &core.SourceConfig{
 			PausedOnStartup: true,
 		})
 		if err != nil {
-			return nil, "", err
-		}
-		if err := addInput(); err != nil {
-			return nil, "", err
+			return nil, err
 		}
-		sn.StopOnDisconnect()
-		sn.RemoveOnStop()
-		// The source will be resumed by the caller.
-		return sn, temporaryName, nil
+		return &udsfStream{name: temporaryName, source: sn, conf: conf}, nil
 	}
 
 	bn, err := tb.topology.AddBox(temporaryName, newUDSFBox(udsf), &core.BoxConfig{
 	// TODO: add information of the statement
 	})
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 	for input, config := range decl.ListInputs() {
 		if err := bn.Input(input, &core.BoxInputConfig{
 			InputName: config.InputName,
 		}); err != nil {
-			return nil, "", err
+			tb.topology.Remove(temporaryName)
+			return nil, err
 		}
 	}
-	if err := addInput(); err != nil {
-		return nil, "", err
-	}
-	bn.StopOnDisconnect(core.Inbound | core.Outbound)
-	bn.RemoveOnStop()
-	return nil, temporaryName, nil
+	return &udsfStream{name: temporaryName, box: bn, conf: conf}, nil
 }
 
 func (tb *TopologyBuilder) mkParamsMap(params []parser.SourceSinkParamAST) data.Map {
@@ -719,14 +1094,19 @@ func (tb *TopologyBuilder) AddSelectUnionStmt(stmts *parser.SelectUnionStmt) (co
 			tmpName := fmt.Sprintf("sensorbee_tmp_%v", topologyBuilderNextTemporaryID())
 			tmpStmt := parser.CreateStreamAsSelectStmt{
 				parser.StreamIdentifier(tmpName),
+				parser.SchemaAST{},
 				parser.SelectStmt{
 					stmt.EmitterAST,
+					stmt.DistinctAST,
 					stmt.ProjectionsAST,
 					stmt.WindowedFromAST,
 					stmt.FilterAST,
 					stmt.GroupingAST,
 					stmt.HavingAST,
+					stmt.OrderByAST,
+					stmt.LimitAST,
 				},
+				parser.OnErrorAST{},
 			}
 			box, err := tb.AddStmt(tmpStmt)
 			if err != nil {
@@ -3,6 +3,7 @@ package bql
 import (
 	. "github.com/smartystreets/goconvey/convey"
 	"gopkg.in/sensorbee/sensorbee.v0/bql/parser"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
 	"testing"
@@ -137,6 +138,84 @@ func TestCreateStreamAsSelectStmt(t *testing.T) {
 			})
 		})
 
+		Convey("When running CREATE STREAM AS SELECT emitting late tuples to a stream", func() {
+			err := addBQLToTopology(tb, `CREATE STREAM t AS SELECT ISTREAM int FROM
+                s [RANGE 2 SECONDS, LATENESS 1 SECONDS EMIT LATE TUPLES INTO late]`)
+
+			Convey("Then there should be no error", func() {
+				So(err, ShouldBeNil)
+			})
+
+			Convey("Then the stream for late tuples should be created", func() {
+				_, err := tb.topology.Source("late")
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("When running CREATE STREAM AS SELECT emitting late tuples to an existing node", func() {
+			err := addBQLToTopology(tb, `CREATE STREAM t AS SELECT ISTREAM int FROM
+                s [RANGE 2 SECONDS, LATENESS 1 SECONDS EMIT LATE TUPLES INTO s]`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "already")
+			})
+
+			Convey("Then the stream should not be created", func() {
+				_, err := tb.topology.Box("t")
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When running CREATE STREAM AS SELECT writing failed tuples to a stream", func() {
+			So(addBQLToTopology(tb, `
+				CREATE STREAM t AS SELECT RSTREAM 12 / (int - 2) AS x FROM
+                s [RANGE 1 TUPLES] ON ERROR INSERT INTO errs;
+				CREATE SINK snk TYPE collector;
+				INSERT INTO snk FROM t;
+				CREATE SINK esnk TYPE collector;
+				INSERT INTO esnk FROM errs;
+				RESUME SOURCE s;`), ShouldBeNil)
+
+			Convey("Then tuples processed successfully should be emitted", func() {
+				sin, err := tb.topology.Sink("snk")
+				So(err, ShouldBeNil)
+				si := sin.Sink().(*tupleCollectorSink)
+				si.Wait(3)
+				So(si.get(0).Data["x"], ShouldEqual, data.Int(-12))
+				So(si.get(1).Data["x"], ShouldEqual, data.Int(12))
+				So(si.get(2).Data["x"], ShouldEqual, data.Int(6))
+			})
+
+			Convey("Then the failed tuple should be written to the stream with the error", func() {
+				sin, err := tb.topology.Sink("esnk")
+				So(err, ShouldBeNil)
+				si := sin.Sink().(*tupleCollectorSink)
+				si.Wait(1)
+				d := si.get(0).Data
+				So(d["node_type"], ShouldEqual, data.String("box"))
+				So(d["node_name"], ShouldEqual, data.String("t"))
+				So(d["event_type"], ShouldEqual, data.String("input"))
+				So(d["error"], ShouldNotBeNil)
+				So(d["data"], ShouldResemble, data.Map{"int": data.Int(2)})
+			})
+		})
+
+		Convey("When running CREATE STREAM AS SELECT writing failed tuples to an existing node", func() {
+			err := addBQLToTopology(tb, `CREATE STREAM t AS SELECT ISTREAM int FROM
+                s [RANGE 1 TUPLES] ON ERROR INSERT INTO s`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "already")
+			})
+
+			Convey("Then the stream should not be created", func() {
+				_, err := tb.topology.Box("t")
+				So(err, ShouldNotBeNil)
+			})
+		})
+
 		Convey("When running CREATE STREAM AS SELECT with a tool arge buffer size", func() {
 			err := addBQLToTopology(tb, `CREATE STREAM t AS SELECT ISTREAM int FROM
                 s [RANGE 2 SECONDS, BUFFER SIZE 131072] WHERE int=2`)
@@ -334,6 +413,216 @@ func TestCreateStreamAsSelectUnionStmt(t *testing.T) {
 	})
 }
 
+func TestCreateOrReplaceStreamAsSelectStmt(t *testing.T) {
+	Convey("Given a BQL TopologyBuilder with a stream connected to a sink", t, func() {
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+		So(addBQLToTopology(tb, `
+			CREATE PAUSED SOURCE s TYPE dummy WITH num=4;
+			CREATE PAUSED SOURCE s2 TYPE dummy WITH num=4;
+			CREATE STREAM box AS SELECT RSTREAM count(*) AS c FROM s [RANGE 3 TUPLES];
+			CREATE SINK snk TYPE collector;
+			INSERT INTO snk FROM box;
+			RESUME SOURCE s;`), ShouldBeNil)
+
+		sin, err := dt.Sink("snk")
+		So(err, ShouldBeNil)
+		si := sin.Sink().(*tupleCollectorSink)
+		si.Wait(4)
+		So(si.get(3).Data["c"], ShouldEqual, data.Int(3))
+
+		bn, err := dt.Box("box")
+		So(err, ShouldBeNil)
+
+		Convey("When replacing the stream with the same FROM clause", func() {
+			So(addBQLToTopology(tb, `CREATE OR REPLACE STREAM box AS
+				SELECT RSTREAM sum(int) AS c FROM s [RANGE 3 TUPLES]`), ShouldBeNil)
+
+			Convey("Then the node should have the new box", func() {
+				n, err := dt.Box("box")
+				So(err, ShouldBeNil)
+				So(n, ShouldEqual, bn)
+				So(n.Box().(*bqlBox).stmt.String(), ShouldContainSubstring, "sum(int)")
+			})
+
+			Convey("Then the sink should receive results computed with the previous window", func() {
+				So(addBQLToTopology(tb, `REWIND SOURCE s;`), ShouldBeNil)
+				si.Wait(8)
+				// the window contains 3 and 4 from the previous box
+				So(si.get(4).Data["c"], ShouldEqual, data.Int(3+4+1))
+				So(si.get(5).Data["c"], ShouldEqual, data.Int(4+1+2))
+				So(si.get(6).Data["c"], ShouldEqual, data.Int(1+2+3))
+			})
+		})
+
+		Convey("When replacing the stream with a different window", func() {
+			So(addBQLToTopology(tb, `CREATE OR REPLACE STREAM box AS
+				SELECT RSTREAM sum(int) AS c FROM s [RANGE 2 TUPLES]`), ShouldBeNil)
+
+			Convey("Then the window should start empty", func() {
+				So(addBQLToTopology(tb, `REWIND SOURCE s;`), ShouldBeNil)
+				si.Wait(8)
+				So(si.get(4).Data["c"], ShouldEqual, data.Int(1))
+				So(si.get(5).Data["c"], ShouldEqual, data.Int(1+2))
+			})
+		})
+
+		Convey("When replacing the stream with a different input", func() {
+			So(addBQLToTopology(tb, `CREATE OR REPLACE STREAM box AS
+				SELECT RSTREAM int FROM s2 [RANGE 1 TUPLES]`), ShouldBeNil)
+
+			Convey("Then the box should only be connected to the new input", func() {
+				So(bn.Box().(*bqlBox).inputs, ShouldResemble, []string{"s2"})
+			})
+
+			Convey("Then the sink should receive tuples from the new input", func() {
+				So(addBQLToTopology(tb, `RESUME SOURCE s2;`), ShouldBeNil)
+				si.Wait(8)
+				So(si.get(4).Data["int"], ShouldEqual, data.Int(1))
+				So(si.get(7).Data["int"], ShouldEqual, data.Int(4))
+			})
+		})
+
+		Convey("When replacing the stream with a statement writing failed tuples to a stream", func() {
+			So(addBQLToTopology(tb, `CREATE OR REPLACE STREAM box AS
+				SELECT RSTREAM count(*) AS c FROM s [RANGE 3 TUPLES] ON ERROR INSERT INTO errs`), ShouldBeNil)
+
+			Convey("Then the stream for failed tuples should be created", func() {
+				_, err := dt.Source("errs")
+				So(err, ShouldBeNil)
+				So(bn.Box().(*bqlBox).errorStream, ShouldEqual, "errs")
+			})
+
+			Convey("Then replacing it again with the same clause should keep the stream", func() {
+				So(addBQLToTopology(tb, `CREATE OR REPLACE STREAM box AS
+					SELECT RSTREAM sum(int) AS c FROM s [RANGE 3 TUPLES] ON ERROR INSERT INTO errs`), ShouldBeNil)
+				_, err := dt.Source("errs")
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("When replacing the stream with a different capacity of the same input", func() {
+			err := addBQLToTopology(tb, `CREATE OR REPLACE STREAM box AS
+				SELECT RSTREAM sum(int) AS c FROM s [RANGE 3 TUPLES, BUFFER SIZE 5]`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "input 's'")
+			})
+
+			Convey("Then the previous box should be kept", func() {
+				So(bn.Box().(*bqlBox).stmt.String(), ShouldContainSubstring, "count(1)")
+			})
+		})
+
+		Convey("When replacing the stream with a different shedding option of the same input", func() {
+			err := addBQLToTopology(tb, `CREATE OR REPLACE STREAM box AS
+				SELECT RSTREAM sum(int) AS c FROM s [RANGE 3 TUPLES, DROP OLDEST IF FULL]`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When replacing the stream with a new input having a capacity", func() {
+			So(addBQLToTopology(tb, `CREATE OR REPLACE STREAM box AS
+				SELECT RSTREAM s:int FROM s [RANGE 1 TUPLES], s2 [RANGE 1 TUPLES, BUFFER SIZE 5]`), ShouldBeNil)
+
+			Convey("Then the box should be connected to both inputs", func() {
+				So(bn.Box().(*bqlBox).inputs, ShouldResemble, []string{"s", "s2"})
+			})
+		})
+
+		Convey("When replacing the stream with an input which doesn't exist", func() {
+			err := addBQLToTopology(tb, `CREATE OR REPLACE STREAM box AS
+				SELECT RSTREAM int FROM no_such_source [RANGE 1 TUPLES]`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then the previous box should be kept", func() {
+				So(bn.Box().(*bqlBox).stmt.String(), ShouldContainSubstring, "count(1)")
+			})
+		})
+
+		Convey("When replacing the stream with a UDSF which cannot be created", func() {
+			So(addBQLToTopology(tb, `CREATE OR REPLACE STREAM box AS
+				SELECT RSTREAM count(*) AS c FROM s [RANGE 3 TUPLES] ON ERROR INSERT INTO errs`), ShouldBeNil)
+			prevNodes := len(dt.Nodes())
+			err := addBQLToTopology(tb, `CREATE OR REPLACE STREAM box AS
+				SELECT RSTREAM d.int AS c FROM failing_duplicate("s", 2) [RANGE 1 TUPLES] AS d
+				ON ERROR INSERT INTO errs2`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldContainSubstring, "test UDSF creation failed")
+			})
+
+			Convey("Then the topology should be unchanged", func() {
+				So(len(dt.Nodes()), ShouldEqual, prevNodes)
+				_, err := dt.Source("errs2")
+				So(core.IsNotExist(err), ShouldBeTrue)
+				_, err = dt.Source("errs")
+				So(err, ShouldBeNil)
+				So(bn.Box().(*bqlBox).stmt.String(), ShouldContainSubstring, "count(1)")
+				So(bn.Box().(*bqlBox).inputs, ShouldResemble, []string{"s"})
+			})
+
+			Convey("Then the previous box should keep its window", func() {
+				So(addBQLToTopology(tb, `REWIND SOURCE s;`), ShouldBeNil)
+				si.Wait(5)
+				So(si.get(4).Data["c"], ShouldEqual, data.Int(3))
+			})
+		})
+
+		Convey("When replacing the stream with a statement referring to itself", func() {
+			err := addBQLToTopology(tb, `CREATE OR REPLACE STREAM box AS
+				SELECT RSTREAM c FROM box [RANGE 1 TUPLES]`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When replacing a stream which doesn't exist", func() {
+			err := addBQLToTopology(tb, `CREATE OR REPLACE STREAM box2 AS
+				SELECT RSTREAM int FROM s [RANGE 1 TUPLES]`)
+
+			Convey("Then the stream should be created", func() {
+				So(err, ShouldBeNil)
+				_, err := dt.Box("box2")
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("When replacing a sink", func() {
+			err := addBQLToTopology(tb, `CREATE OR REPLACE STREAM snk AS
+				SELECT RSTREAM int FROM s [RANGE 1 TUPLES]`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When replacing a stream created by UNION ALL", func() {
+			So(addBQLToTopology(tb, `CREATE STREAM u AS
+				SELECT RSTREAM int FROM s [RANGE 1 TUPLES]
+				UNION ALL SELECT RSTREAM int FROM s2 [RANGE 1 TUPLES]`), ShouldBeNil)
+			err := addBQLToTopology(tb, `CREATE OR REPLACE STREAM u AS
+				SELECT RSTREAM int FROM s [RANGE 1 TUPLES]`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
 func TestCreateSinkStmt(t *testing.T) {
 	Convey("Given a BQL TopologyBuilder", t, func() {
 		dt := newTestTopology()
@@ -1033,6 +1322,376 @@ func TestEvalStmt(t *testing.T) {
 	})
 }
 
+func TestExplainStmt(t *testing.T) {
+	Convey("Given a BQL TopologyBuilder with a source", t, func() {
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+		So(addBQLToTopology(tb, `CREATE PAUSED SOURCE s TYPE dummy`), ShouldBeNil)
+
+		explain := func(stmt string) (data.Map, error) {
+			bp := parser.New()
+			istmt, _, err := bp.ParseStmt(stmt)
+			So(err, ShouldBeNil)
+			s := istmt.(parser.ExplainStmt)
+			v, err := tb.RunExplainStmt(&s)
+			if err != nil {
+				return nil, err
+			}
+			return data.AsMap(v)
+		}
+
+		Convey("When explaining a CREATE STREAM statement", func() {
+			res, err := explain(`EXPLAIN CREATE STREAM box AS SELECT RSTREAM int + (1 + 2) AS x
+				FROM s [RANGE 1 TUPLES] WHERE int > 1`)
+			So(err, ShouldBeNil)
+
+			Convey("Then the chosen plan should be returned", func() {
+				So(res["selects"], ShouldHaveLength, 1)
+				plan := res["selects"].(data.Array)[0].(data.Map)
+				So(plan["physical_plan"], ShouldEqual, data.String("filter"))
+				So(plan["folded_constants"], ShouldResemble, data.Array{
+					data.Map{"expression": data.String("1 + 2"), "value": data.Int(3)},
+				})
+				lp := plan["logical_plan"].(data.Map)
+				So(lp["filter"], ShouldEqual, data.String("(s:int)>(1)"))
+			})
+
+			Convey("Then the nodes and edges should be returned", func() {
+				So(res["nodes"], ShouldResemble, data.Array{
+					data.Map{"name": data.String("box"), "node_type": data.String("box"),
+						"kind": data.String("bql")},
+				})
+				So(res["edges"], ShouldResemble, data.Array{
+					data.Map{"from": data.String("s"), "to": data.String("box"),
+						"input_name": data.String("s")},
+				})
+			})
+
+			Convey("Then the topology should not be modified", func() {
+				_, err := dt.Node("box")
+				So(err, ShouldNotBeNil)
+				So(dt.Nodes(), ShouldHaveLength, 1)
+			})
+		})
+
+		Convey("When explaining a SELECT statement with an aggregate", func() {
+			res, err := explain(`EXPLAIN SELECT RSTREAM count(*) FROM s [RANGE 2 TUPLES]`)
+			So(err, ShouldBeNil)
+
+			Convey("Then the chosen plan should be returned", func() {
+				plan := res["selects"].(data.Array)[0].(data.Map)
+				So(plan["physical_plan"], ShouldEqual, data.String("groupby"))
+			})
+
+			Convey("Then the temporary nodes should be returned", func() {
+				So(res["nodes"], ShouldResemble, data.Array{
+					data.Map{"name": data.String("sensorbee_tmp_select_sink_$1"),
+						"node_type": data.String("sink"), "kind": data.String("select_result"),
+						"temporary": data.True},
+					data.Map{"name": data.String("sensorbee_tmp_$2"),
+						"node_type": data.String("box"), "kind": data.String("bql"),
+						"temporary": data.True},
+				})
+				So(res["edges"], ShouldResemble, data.Array{
+					data.Map{"from": data.String("s"), "to": data.String("sensorbee_tmp_$2"),
+						"input_name": data.String("s")},
+					data.Map{"from": data.String("sensorbee_tmp_$2"),
+						"to":         data.String("sensorbee_tmp_select_sink_$1"),
+						"input_name": data.String("sensorbee_tmp_$2")},
+				})
+			})
+		})
+
+		Convey("When explaining a CREATE OR REPLACE STREAM statement replacing a stream", func() {
+			So(addBQLToTopology(tb, `CREATE PAUSED SOURCE s2 TYPE dummy;
+				CREATE STREAM box AS SELECT RSTREAM int FROM s [RANGE 1 TUPLES]`), ShouldBeNil)
+			res, err := explain(`EXPLAIN CREATE OR REPLACE STREAM box AS
+				SELECT RSTREAM s2:int FROM s2 [RANGE 1 TUPLES], duplicate("s", 2) [RANGE 1 TUPLES]`)
+			So(err, ShouldBeNil)
+
+			Convey("Then the box should be marked as replaced", func() {
+				So(res["nodes"], ShouldResemble, data.Array{
+					data.Map{"name": data.String("box"), "node_type": data.String("box"),
+						"kind": data.String("bql"), "replaced": data.True},
+					data.Map{"name": data.String("sensorbee_tmp_udsf_$1"),
+						"node_type": data.String("udsf"), "kind": data.String("duplicate"),
+						"temporary": data.True},
+				})
+			})
+
+			Convey("Then the inputs to be connected and disconnected should be returned", func() {
+				So(res["edges"], ShouldResemble, data.Array{
+					data.Map{"from": data.String("s2"), "to": data.String("box"),
+						"input_name": data.String("s2")},
+					data.Map{"from": data.String("sensorbee_tmp_udsf_$1"), "to": data.String("box"),
+						"input_name": data.String("duplicate/duplicate")},
+				})
+				So(res["removed_edges"], ShouldResemble, data.Array{
+					data.Map{"from": data.String("s"), "to": data.String("box")},
+				})
+			})
+
+			Convey("Then the box should not be replaced", func() {
+				bn, err := dt.Box("box")
+				So(err, ShouldBeNil)
+				So(bn.Box().(*bqlBox).inputs, ShouldResemble, []string{"s"})
+			})
+		})
+
+		Convey("When explaining a CREATE OR REPLACE STREAM statement keeping an input", func() {
+			So(addBQLToTopology(tb, `CREATE STREAM box AS SELECT RSTREAM int FROM s [RANGE 1 TUPLES]`), ShouldBeNil)
+			res, err := explain(`EXPLAIN CREATE OR REPLACE STREAM box AS
+				SELECT RSTREAM int + 1 AS x FROM s [RANGE 1 TUPLES]`)
+			So(err, ShouldBeNil)
+
+			Convey("Then no edges should be added or removed", func() {
+				So(res["edges"], ShouldBeEmpty)
+				So(res["removed_edges"], ShouldBeEmpty)
+			})
+
+			Convey("Then changing the capacity of the input should fail", func() {
+				_, err := explain(`EXPLAIN CREATE OR REPLACE STREAM box AS
+					SELECT RSTREAM int FROM s [RANGE 1 TUPLES, BUFFER SIZE 5]`)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When explaining a CREATE OR REPLACE STREAM statement creating a stream", func() {
+			res, err := explain(`EXPLAIN CREATE OR REPLACE STREAM box AS
+				SELECT RSTREAM int FROM s [RANGE 1 TUPLES]`)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should be explained like CREATE STREAM", func() {
+				So(res["nodes"], ShouldResemble, data.Array{
+					data.Map{"name": data.String("box"), "node_type": data.String("box"),
+						"kind": data.String("bql")},
+				})
+				So(res, ShouldNotContainKey, "removed_edges")
+			})
+		})
+
+		Convey("When explaining a CREATE OR REPLACE STREAM statement replacing a source", func() {
+			_, err := explain(`EXPLAIN CREATE OR REPLACE STREAM s AS SELECT RSTREAM 1 AS x FROM s [RANGE 1 TUPLES]`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When explaining a CREATE STREAM statement writing failed tuples to a stream", func() {
+			res, err := explain(`EXPLAIN CREATE STREAM box AS SELECT RSTREAM int / 0 AS x
+				FROM s [RANGE 1 TUPLES] ON ERROR INSERT INTO errs`)
+			So(err, ShouldBeNil)
+
+			Convey("Then the source of failed tuples should be returned", func() {
+				So(res["nodes"], ShouldResemble, data.Array{
+					data.Map{"name": data.String("box"), "node_type": data.String("box"),
+						"kind": data.String("bql")},
+					data.Map{"name": data.String("errs"), "node_type": data.String("source"),
+						"kind": data.String("error_tuples")},
+				})
+			})
+
+			Convey("Then an existing name for the stream should be rejected", func() {
+				_, err := explain(`EXPLAIN CREATE STREAM box AS SELECT RSTREAM int / 0 AS x
+					FROM s [RANGE 1 TUPLES] ON ERROR INSERT INTO s`)
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When explaining a replacement writing failed tuples to the same stream", func() {
+			So(addBQLToTopology(tb, `CREATE STREAM box AS SELECT RSTREAM int FROM s [RANGE 1 TUPLES]
+				ON ERROR INSERT INTO errs`), ShouldBeNil)
+			res, err := explain(`EXPLAIN CREATE OR REPLACE STREAM box AS
+				SELECT RSTREAM int / 0 AS x FROM s [RANGE 1 TUPLES] ON ERROR INSERT INTO errs`)
+			So(err, ShouldBeNil)
+
+			Convey("Then the existing source should be taken over", func() {
+				So(res["nodes"], ShouldResemble, data.Array{
+					data.Map{"name": data.String("box"), "node_type": data.String("box"),
+						"kind": data.String("bql"), "replaced": data.True},
+				})
+			})
+		})
+
+		Convey("When explaining a statement creating an existing node", func() {
+			_, err := explain(`EXPLAIN CREATE STREAM s AS SELECT ISTREAM * FROM s [RANGE 1 TUPLES]`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When explaining a statement reading from a missing stream", func() {
+			_, err := explain(`EXPLAIN SELECT ISTREAM * FROM t [RANGE 1 TUPLES]`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When explaining an invalid statement", func() {
+			_, err := explain(`EXPLAIN SELECT ISTREAM a FROM s [RANGE 1 TUPLES] GROUP BY b`)
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestShowStmt(t *testing.T) {
+	Convey("Given a BQL TopologyBuilder with some nodes and a state", t, func() {
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+		So(addBQLToTopology(tb, `CREATE PAUSED SOURCE s2 TYPE dummy;
+			CREATE PAUSED SOURCE s1 TYPE dummy;
+			CREATE STREAM box AS SELECT ISTREAM int FROM s1 [RANGE 1 TUPLES];
+			CREATE SINK snk TYPE collector;
+			CREATE STATE st TYPE dummy_uds WITH num=5;`), ShouldBeNil)
+
+		show := func(stmt string) data.Array {
+			bp := parser.New()
+			istmt, _, err := bp.ParseStmt(stmt)
+			So(err, ShouldBeNil)
+			s := istmt.(parser.ShowStmt)
+			v, err := tb.RunShowStmt(&s)
+			So(err, ShouldBeNil)
+			a, err := data.AsArray(v)
+			So(err, ShouldBeNil)
+			return a
+		}
+
+		Convey("When showing sources", func() {
+			rows := show("SHOW SOURCES")
+
+			Convey("Then they should be sorted by name", func() {
+				So(rows, ShouldResemble, data.Array{
+					data.Map{"name": data.String("s1"), "node_type": data.String("source"), "state": data.String("paused")},
+					data.Map{"name": data.String("s2"), "node_type": data.String("source"), "state": data.String("paused")},
+				})
+			})
+		})
+
+		Convey("When showing streams", func() {
+			rows := show("SHOW STREAMS")
+
+			Convey("Then the stream should be returned", func() {
+				So(rows, ShouldHaveLength, 1)
+				So(rows[0].(data.Map)["name"], ShouldEqual, data.String("box"))
+				So(rows[0].(data.Map)["node_type"], ShouldEqual, data.String("box"))
+			})
+		})
+
+		Convey("When showing sinks", func() {
+			rows := show("SHOW SINKS")
+
+			Convey("Then the sink should be returned", func() {
+				So(rows, ShouldHaveLength, 1)
+				So(rows[0].(data.Map)["name"], ShouldEqual, data.String("snk"))
+			})
+		})
+
+		Convey("When showing states", func() {
+			rows := show("SHOW STATES")
+
+			Convey("Then the state should be returned with its type", func() {
+				So(rows, ShouldResemble, data.Array{
+					data.Map{"name": data.String("st"), "type": data.String("dummy_uds")},
+				})
+			})
+		})
+
+		Convey("When showing functions", func() {
+			rows := show("SHOW FUNCTIONS")
+
+			Convey("Then UDFs and UDSFs should be returned", func() {
+				So(rows, ShouldContain, data.Map{"name": data.String("str"), "kind": data.String("udf")})
+				So(rows, ShouldContain, data.Map{"name": data.String("duplicate"), "kind": data.String("udsf")})
+			})
+		})
+
+		Convey("When showing functions with a registry which cannot list them", func() {
+			tb.Reg = struct{ udf.FunctionManager }{tb.Reg}
+			bp := parser.New()
+			istmt, _, err := bp.ParseStmt("SHOW FUNCTIONS")
+			So(err, ShouldBeNil)
+			s := istmt.(parser.ShowStmt)
+			_, err = tb.RunShowStmt(&s)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When showing types", func() {
+			Convey("Then registered source types should be returned", func() {
+				So(show("SHOW SOURCE TYPES"), ShouldContain, data.Map{"name": data.String("dummy")})
+			})
+
+			Convey("Then registered sink types should be returned", func() {
+				So(show("SHOW SINK TYPES"), ShouldContain, data.Map{"name": data.String("collector")})
+			})
+
+			Convey("Then registered state types should be returned", func() {
+				So(show("SHOW STATE TYPES"), ShouldContain, data.Map{"name": data.String("dummy_uds")})
+			})
+		})
+	})
+}
+
+func TestDescribeStmt(t *testing.T) {
+	Convey("Given a BQL TopologyBuilder with a source", t, func() {
+		dt := newTestTopology()
+		Reset(func() {
+			dt.Stop()
+		})
+		tb, err := NewTopologyBuilder(dt)
+		So(err, ShouldBeNil)
+		So(addBQLToTopology(tb, `CREATE PAUSED SOURCE s TYPE dummy`), ShouldBeNil)
+
+		describe := func(stmt string) (data.Map, error) {
+			bp := parser.New()
+			istmt, _, err := bp.ParseStmt(stmt)
+			So(err, ShouldBeNil)
+			s := istmt.(parser.DescribeStmt)
+			v, err := tb.RunDescribeStmt(&s)
+			if err != nil {
+				return nil, err
+			}
+			return data.AsMap(v)
+		}
+
+		Convey("When describing the source", func() {
+			res, err := describe("DESCRIBE s")
+			So(err, ShouldBeNil)
+
+			Convey("Then its details should be returned", func() {
+				So(res["name"], ShouldEqual, data.String("s"))
+				So(res["node_type"], ShouldEqual, data.String("source"))
+				So(res["state"], ShouldEqual, data.String("paused"))
+				So(res["status"], ShouldHaveSameTypeAs, data.Map{})
+			})
+		})
+
+		Convey("When describing a node that does not exist", func() {
+			_, err := describe("DESCRIBE t")
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
 func TestDropSourceStmt(t *testing.T) {
 	Convey("Given a BQL TopologyBuilder", t, func() {
 		dt := newTestTopology()
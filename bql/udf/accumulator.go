@@ -0,0 +1,44 @@
+package udf
+
+import (
+	"errors"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+var (
+	// ErrNotRetractable is returned from Accumulator.Retract or
+	// UDAF.Retract when the aggregate cannot remove a value from its
+	// state. The state is then computed again from the remaining values.
+	ErrNotRetractable = errors.New("the aggregate cannot retract values")
+)
+
+// Accumulator maintains the result of an aggregate function over a
+// window whose rows are added and retracted one at a time. The values of
+// all parameters of the aggregate function for a row are passed as args.
+type Accumulator interface {
+	// Add adds a row to the window.
+	Add(args ...data.Value) error
+
+	// Retract removes the oldest row from the window, which is passed
+	// as args. Rows are always retracted in the order in which they were
+	// added. It returns ErrNotRetractable if the row cannot be removed.
+	Retract(args ...data.Value) error
+
+	// Result returns the result of the aggregate function over the
+	// rows in the window.
+	Result() (data.Value, error)
+}
+
+// IncrementalUDF is an aggregate function whose parameters are all
+// aggregation parameters and whose result can be maintained by an
+// Accumulator while rows enter and leave a window, so that it doesn't
+// have to be computed from all rows in the window each time the window
+// changes. Aggregate functions which don't implement this interface are
+// called with all values instead.
+type IncrementalUDF interface {
+	UDF
+
+	// NewAccumulator returns an Accumulator for a window having no rows.
+	NewAccumulator(ctx *core.Context) (Accumulator, error)
+}
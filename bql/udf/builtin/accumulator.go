@@ -0,0 +1,264 @@
+package builtin
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+)
+
+// incrementalAggFunc is a template for aggregate functions that
+// have exactly one parameter and can be computed incrementally
+type incrementalAggFunc struct {
+	singleParamAggFunc
+	newAcc func() udf.Accumulator
+}
+
+func (f *incrementalAggFunc) NewAccumulator(ctx *core.Context) (udf.Accumulator, error) {
+	return f.newAcc(), nil
+}
+
+// countAccumulator is the accumulator of countFunc.
+type countAccumulator struct {
+	count int64
+}
+
+func newCountAccumulator() udf.Accumulator {
+	return &countAccumulator{}
+}
+
+func (a *countAccumulator) Add(args ...data.Value) error {
+	v := args[0]
+	if v.Type() != data.TypeNull {
+		a.count++
+	}
+	return nil
+}
+
+func (a *countAccumulator) Retract(args ...data.Value) error {
+	v := args[0]
+	if v.Type() != data.TypeNull {
+		a.count--
+	}
+	return nil
+}
+
+func (a *countAccumulator) Result() (data.Value, error) {
+	return data.Int(a.count), nil
+}
+
+// sumAccumulator is the accumulator of sumFunc and avgFunc. Int values
+// are summed up exactly. Float values are summed up with Neumaier's
+// compensated summation, which keeps the low-order bits lost in floatSum
+// in floatComp, so that retracting a large value doesn't also cancel the
+// small values added after it.
+type sumAccumulator struct {
+	avg       bool
+	intSum    int64
+	floatSum  float64
+	floatComp float64
+	// numFloats and numValues are the numbers of Float values and of
+	// all non-null values in the window
+	numFloats int64
+	numValues int64
+}
+
+func newSumAccumulator() udf.Accumulator {
+	return &sumAccumulator{}
+}
+
+func newAvgAccumulator() udf.Accumulator {
+	return &sumAccumulator{avg: true}
+}
+
+func (a *sumAccumulator) Add(args ...data.Value) error {
+	return a.update(args[0], 1)
+}
+
+func (a *sumAccumulator) Retract(args ...data.Value) error {
+	return a.update(args[0], -1)
+}
+
+func (a *sumAccumulator) update(v data.Value, sign int64) error {
+	switch v.Type() {
+	case data.TypeInt:
+		i, _ := data.AsInt(v)
+		// overflows are handled like in sumFunc and cancel out
+		// when the value is retracted
+		a.intSum += sign * i
+	case data.TypeFloat:
+		f, _ := data.AsFloat(v)
+		a.addFloat(float64(sign) * f)
+		a.numFloats += sign
+		if a.numFloats == 0 {
+			// drop the rounding errors left by retracted values
+			a.floatSum, a.floatComp = 0, 0
+		}
+	case data.TypeNull:
+		return nil
+	default:
+		return fmt.Errorf("cannot interpret %s (%T) as a number", v, v)
+	}
+	a.numValues += sign
+	return nil
+}
+
+func (a *sumAccumulator) addFloat(f float64) {
+	t := a.floatSum + f
+	if math.IsInf(t, 0) || math.IsNaN(t) {
+		// the compensation would become NaN
+		a.floatSum = t
+		return
+	}
+	if math.Abs(a.floatSum) >= math.Abs(f) {
+		a.floatComp += (a.floatSum - t) + f
+	} else {
+		a.floatComp += (f - t) + a.floatSum
+	}
+	a.floatSum = t
+}
+
+func (a *sumAccumulator) Result() (data.Value, error) {
+	if a.numValues == 0 {
+		return data.Null{}, nil
+	}
+	sum := float64(a.intSum) + (a.floatSum + a.floatComp)
+	if a.avg {
+		return data.Float(sum / float64(a.numValues)), nil
+	}
+	if a.numFloats == 0 {
+		return data.Int(a.intSum), nil
+	}
+	return data.Float(sum), nil
+}
+
+// extremumAccumulator is the accumulator of maxFunc and minFunc. It keeps
+// a monotonic deque of the values which can still become the result when
+// older values are retracted, so that each value is added to and removed
+// from the deque only once.
+type extremumAccumulator struct {
+	max bool
+	// deque has the values in the order in which they were added. Each
+	// value is greater (less for min) than or equal to all values after
+	// it, so the first one is the result.
+	deque []extremum
+	// added and retracted are the numbers of values added and retracted
+	// so far, which are used as sequence numbers of the values.
+	added     int64
+	retracted int64
+	// numTimestamps and numNumbers are the numbers of Timestamp values
+	// and numeric values in the window, which cannot be compared.
+	numTimestamps int64
+	numNumbers    int64
+}
+
+type extremum struct {
+	seq   int64
+	value data.Value
+}
+
+func newMaxAccumulator() udf.Accumulator {
+	return &extremumAccumulator{max: true}
+}
+
+func newMinAccumulator() udf.Accumulator {
+	return &extremumAccumulator{}
+}
+
+// compare returns a positive number if x is greater than y, a negative
+// number if it's less than y and 0 otherwise. Both values must be either
+// Timestamps or numbers.
+func (a *extremumAccumulator) compare(x, y data.Value) int {
+	if x.Type() == data.TypeTimestamp {
+		tx, _ := data.AsTimestamp(x)
+		ty, _ := data.AsTimestamp(y)
+		switch {
+		case tx.After(ty):
+			return 1
+		case tx.Before(ty):
+			return -1
+		}
+		return 0
+	}
+	fx, _ := data.ToFloat(x)
+	fy, _ := data.ToFloat(y)
+	switch {
+	case fx > fy:
+		return 1
+	case fx < fy:
+		return -1
+	}
+	return 0
+}
+
+// dominates returns true if x rather than y is the result.
+func (a *extremumAccumulator) dominates(x, y data.Value) bool {
+	if a.max {
+		return a.compare(x, y) > 0
+	}
+	return a.compare(x, y) < 0
+}
+
+func (a *extremumAccumulator) Add(args ...data.Value) error {
+	v := args[0]
+	switch v.Type() {
+	case data.TypeInt, data.TypeFloat:
+		if a.numTimestamps > 0 {
+			return fmt.Errorf("cannot interpret %s (%T) as a timestamp", v, v)
+		}
+		a.numNumbers++
+	case data.TypeTimestamp:
+		if a.numNumbers > 0 {
+			return fmt.Errorf("cannot interpret %s (%T) as a number", v, v)
+		}
+		a.numTimestamps++
+	case data.TypeNull:
+		a.added++
+		return nil
+	default:
+		return fmt.Errorf("cannot interpret %s (%T) as a number", v, v)
+	}
+	// values which are dominated by v will never become the result
+	// because they are retracted before v
+	n := len(a.deque)
+	for n > 0 && a.dominates(v, a.deque[n-1].value) {
+		n--
+	}
+	a.deque = append(a.deque[:n], extremum{a.added, v})
+	a.added++
+	return nil
+}
+
+func (a *extremumAccumulator) Retract(args ...data.Value) error {
+	v := args[0]
+	switch v.Type() {
+	case data.TypeInt, data.TypeFloat:
+		a.numNumbers--
+	case data.TypeTimestamp:
+		a.numTimestamps--
+	}
+	if len(a.deque) > 0 && a.deque[0].seq == a.retracted {
+		a.deque[0] = extremum{}
+		a.deque = a.deque[1:]
+	}
+	a.retracted++
+	return nil
+}
+
+func (a *extremumAccumulator) Result() (data.Value, error) {
+	if len(a.deque) == 0 {
+		return data.Null{}, nil
+	}
+	// like maxFunc and minFunc, prefer an Int to a Float having the
+	// same value
+	for _, e := range a.deque {
+		if a.compare(e.value, a.deque[0].value) != 0 {
+			break
+		}
+		if e.value.Type() == data.TypeInt {
+			return e.value, nil
+		}
+	}
+	return a.deque[0].value, nil
+}
@@ -0,0 +1,141 @@
+package builtin
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+	"time"
+)
+
+func TestAccumulators(t *testing.T) {
+	someTime := time.Date(2015, time.May, 1, 14, 27, 0, 0, time.UTC)
+	at := func(sec int) data.Value {
+		return data.Timestamp(someTime.Add(time.Duration(sec) * time.Second))
+	}
+
+	inputs := map[string][]data.Value{
+		"ints": {data.Int(3), data.Int(1), data.Null{}, data.Int(4), data.Int(1),
+			data.Int(5), data.Int(9), data.Int(2), data.Null{}, data.Int(6)},
+		"mixed numbers": {data.Float(2.5), data.Int(3), data.Float(3), data.Null{},
+			data.Int(-1), data.Float(-1), data.Int(7), data.Float(0.5)},
+		"nulls": {data.Null{}, data.Null{}, data.Int(1), data.Null{}, data.Null{}, data.Null{}},
+		// the small values must not be lost when the large one is retracted
+		"floats of different magnitudes": {data.Float(1e18), data.Float(1), data.Float(2),
+			data.Float(3), data.Float(4), data.Float(5)},
+	}
+	funcs := map[string]udf.UDF{
+		"count": countFunc,
+		"sum":   sumFunc,
+		"avg":   avgFunc,
+		"max":   maxFunc,
+		"min":   minFunc,
+	}
+
+	for name, f := range funcs {
+		name, f := name, f
+		Convey(fmt.Sprintf("Given the accumulator of %s", name), t, func() {
+			inc, ok := f.(udf.IncrementalUDF)
+			So(ok, ShouldBeTrue)
+
+			for desc, values := range inputs {
+				desc, values := desc, values
+				for size := 1; size <= 4; size++ {
+					size := size
+					Convey(fmt.Sprintf("When sliding a window of %d %s", size, desc), func() {
+						acc, err := inc.NewAccumulator(nil)
+						So(err, ShouldBeNil)
+
+						Convey("Then the result should be the same as the one of the function", func() {
+							for i, v := range values {
+								So(acc.Add(v), ShouldBeNil)
+								if i >= size {
+									So(acc.Retract(values[i-size]), ShouldBeNil)
+								}
+								start := i - size + 1
+								if start < 0 {
+									start = 0
+								}
+								expected, err := f.Call(nil, data.Array(values[start:i+1]))
+								So(err, ShouldBeNil)
+								actual, err := acc.Result()
+								So(err, ShouldBeNil)
+								So(actual, ShouldResemble, expected)
+							}
+						})
+					})
+				}
+			}
+
+			Convey("When all values are retracted", func() {
+				acc, err := inc.NewAccumulator(nil)
+				So(err, ShouldBeNil)
+				for _, v := range inputs["ints"] {
+					So(acc.Add(v), ShouldBeNil)
+				}
+				for _, v := range inputs["ints"] {
+					So(acc.Retract(v), ShouldBeNil)
+				}
+
+				Convey("Then the result should be the one for an empty input", func() {
+					expected, err := f.Call(nil, data.Array{})
+					So(err, ShouldBeNil)
+					actual, err := acc.Result()
+					So(err, ShouldBeNil)
+					So(actual, ShouldResemble, expected)
+				})
+			})
+		})
+	}
+
+	Convey("Given the accumulator of max", t, func() {
+		acc, err := maxFunc.(udf.IncrementalUDF).NewAccumulator(nil)
+		So(err, ShouldBeNil)
+
+		Convey("When adding timestamps", func() {
+			for _, sec := range []int{2, 5, 1, 3} {
+				So(acc.Add(at(sec)), ShouldBeNil)
+			}
+
+			Convey("Then the latest one should be the result", func() {
+				v, err := acc.Result()
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, at(5))
+			})
+
+			Convey("Then it should follow the window", func() {
+				So(acc.Retract(at(2)), ShouldBeNil)
+				So(acc.Retract(at(5)), ShouldBeNil)
+				v, err := acc.Result()
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, at(3))
+			})
+
+			Convey("Then adding a number should fail", func() {
+				So(acc.Add(data.Int(1)), ShouldNotBeNil)
+			})
+		})
+
+		Convey("When adding a value which cannot be compared", func() {
+			err := acc.Add(data.String("hoge"))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given the accumulator of sum", t, func() {
+		acc, err := sumFunc.(udf.IncrementalUDF).NewAccumulator(nil)
+		So(err, ShouldBeNil)
+
+		Convey("When adding a value which isn't a number", func() {
+			err := acc.Add(data.String("hoge"))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -71,17 +71,20 @@ func (f *twoParamAggFunc) Call(ctx *core.Context, args ...data.Value) (data.Valu
 //
 //  Input: anything (aggregated)
 //  Return Type: Int
-var countFunc udf.UDF = &singleParamAggFunc{
-	aggFun: func(arr []data.Value) (data.Value, error) {
-		// count() is O(n) in the spirit of PostgreSQL
-		c := int64(0)
-		for _, item := range arr {
-			if item.Type() != data.TypeNull {
-				c++
+var countFunc udf.UDF = &incrementalAggFunc{
+	singleParamAggFunc: singleParamAggFunc{
+		aggFun: func(arr []data.Value) (data.Value, error) {
+			// count() is O(n) in the spirit of PostgreSQL
+			c := int64(0)
+			for _, item := range arr {
+				if item.Type() != data.TypeNull {
+					c++
+				}
 			}
-		}
-		return data.Int(c), nil
+			return data.Int(c), nil
+		},
 	},
+	newAcc: newCountAccumulator,
 }
 
 // arrayAggFunc is an aggregate function that concatenates
@@ -108,35 +111,38 @@ var arrayAggFunc udf.UDF = &singleParamAggFunc{
 //
 //  Input: Int or Float (aggregated)
 //  Return Type: Float (Null on empty input)
-var avgFunc udf.UDF = &singleParamAggFunc{
-	aggFun: func(arr []data.Value) (data.Value, error) {
-		if len(arr) == 0 {
-			return data.Null{}, nil
-		}
-		sum := float64(0.0)
-		count := int64(0)
-		for _, item := range arr {
-			if item.Type() == data.TypeInt {
-				i, _ := data.AsInt(item)
-				sum += float64(i)
-				count++
-			} else if item.Type() == data.TypeFloat {
-				f, _ := data.AsFloat(item)
-				sum += f
-				count++
-			} else if item.Type() == data.TypeNull {
-				continue
-			} else {
-				return nil, fmt.Errorf("cannot interpret %s (%T) as a number",
-					item, item)
+var avgFunc udf.UDF = &incrementalAggFunc{
+	singleParamAggFunc: singleParamAggFunc{
+		aggFun: func(arr []data.Value) (data.Value, error) {
+			if len(arr) == 0 {
+				return data.Null{}, nil
 			}
-		}
-		if count == 0 {
-			// only null inputs
-			return data.Null{}, nil
-		}
-		return data.Float(sum / float64(count)), nil
+			sum := float64(0.0)
+			count := int64(0)
+			for _, item := range arr {
+				if item.Type() == data.TypeInt {
+					i, _ := data.AsInt(item)
+					sum += float64(i)
+					count++
+				} else if item.Type() == data.TypeFloat {
+					f, _ := data.AsFloat(item)
+					sum += f
+					count++
+				} else if item.Type() == data.TypeNull {
+					continue
+				} else {
+					return nil, fmt.Errorf("cannot interpret %s (%T) as a number",
+						item, item)
+				}
+			}
+			if count == 0 {
+				// only null inputs
+				return data.Null{}, nil
+			}
+			return data.Float(sum / float64(count)), nil
+		},
 	},
+	newAcc: newAvgAccumulator,
 }
 
 // medianFunc is an aggregate function that computes the median
@@ -322,66 +328,69 @@ var jsonObjectAggFunc udf.UDF = &twoParamAggFunc{
 //
 //  Input: Int or Float (aggregated)
 //  Return Type: same as maximal input value (Null on empty input)
-var maxFunc udf.UDF = &singleParamAggFunc{
-	aggFun: func(arr []data.Value) (data.Value, error) {
-		if len(arr) == 0 {
-			return data.Null{}, nil
-		}
-		// deal with the case of leading nulls and only nulls
-		firstNonNull := -1
-		for i, item := range arr {
-			if item.Type() != data.TypeNull {
-				firstNonNull = i
-				break
+var maxFunc udf.UDF = &incrementalAggFunc{
+	singleParamAggFunc: singleParamAggFunc{
+		aggFun: func(arr []data.Value) (data.Value, error) {
+			if len(arr) == 0 {
+				return data.Null{}, nil
 			}
-		}
-		if firstNonNull == -1 {
-			return data.Null{}, nil
-		}
-		// if we have timestamp-shaped data
-		if arr[firstNonNull].Type() == data.TypeTimestamp {
-			maxTime, _ := data.AsTimestamp(arr[firstNonNull])
+			// deal with the case of leading nulls and only nulls
+			firstNonNull := -1
+			for i, item := range arr {
+				if item.Type() != data.TypeNull {
+					firstNonNull = i
+					break
+				}
+			}
+			if firstNonNull == -1 {
+				return data.Null{}, nil
+			}
+			// if we have timestamp-shaped data
+			if arr[firstNonNull].Type() == data.TypeTimestamp {
+				maxTime, _ := data.AsTimestamp(arr[firstNonNull])
+				for _, item := range arr[firstNonNull:] {
+					if item.Type() == data.TypeTimestamp {
+						t, _ := data.AsTimestamp(item)
+						if maxTime.Sub(t).Seconds() < 0 {
+							maxTime = t
+						}
+					} else if item.Type() == data.TypeNull {
+						continue
+					} else {
+						return nil, fmt.Errorf("cannot interpret %s (%T) as a timestamp",
+							item, item)
+					}
+				}
+				return data.Timestamp(maxTime), nil
+			}
+			// else: numeric
+			maxFloat := -float64(math.MaxFloat64)
+			maxInt := int64(math.MinInt64)
 			for _, item := range arr[firstNonNull:] {
-				if item.Type() == data.TypeTimestamp {
-					t, _ := data.AsTimestamp(item)
-					if maxTime.Sub(t).Seconds() < 0 {
-						maxTime = t
+				if item.Type() == data.TypeInt {
+					i, _ := data.AsInt(item)
+					if i > maxInt {
+						maxInt = i
+					}
+				} else if item.Type() == data.TypeFloat {
+					f, _ := data.AsFloat(item)
+					if f > maxFloat {
+						maxFloat = f
 					}
 				} else if item.Type() == data.TypeNull {
 					continue
 				} else {
-					return nil, fmt.Errorf("cannot interpret %s (%T) as a timestamp",
+					return nil, fmt.Errorf("cannot interpret %s (%T) as a number",
 						item, item)
 				}
 			}
-			return data.Timestamp(maxTime), nil
-		}
-		// else: numeric
-		maxFloat := -float64(math.MaxFloat64)
-		maxInt := int64(math.MinInt64)
-		for _, item := range arr[firstNonNull:] {
-			if item.Type() == data.TypeInt {
-				i, _ := data.AsInt(item)
-				if i > maxInt {
-					maxInt = i
-				}
-			} else if item.Type() == data.TypeFloat {
-				f, _ := data.AsFloat(item)
-				if f > maxFloat {
-					maxFloat = f
-				}
-			} else if item.Type() == data.TypeNull {
-				continue
-			} else {
-				return nil, fmt.Errorf("cannot interpret %s (%T) as a number",
-					item, item)
+			if float64(maxInt) >= maxFloat {
+				return data.Int(maxInt), nil
 			}
-		}
-		if float64(maxInt) >= maxFloat {
-			return data.Int(maxInt), nil
-		}
-		return data.Float(maxFloat), nil
+			return data.Float(maxFloat), nil
+		},
 	},
+	newAcc: newMaxAccumulator,
 }
 
 // minFunc is an aggregate function that computes the minimum
@@ -392,66 +401,69 @@ var maxFunc udf.UDF = &singleParamAggFunc{
 //
 //  Input: Int or Float (aggregated)
 //  Return Type: same as minimal input value (Null on empty input)
-var minFunc udf.UDF = &singleParamAggFunc{
-	aggFun: func(arr []data.Value) (data.Value, error) {
-		if len(arr) == 0 {
-			return data.Null{}, nil
-		}
-		// deal with the case of leading nulls and only nulls
-		firstNonNull := -1
-		for i, item := range arr {
-			if item.Type() != data.TypeNull {
-				firstNonNull = i
-				break
+var minFunc udf.UDF = &incrementalAggFunc{
+	singleParamAggFunc: singleParamAggFunc{
+		aggFun: func(arr []data.Value) (data.Value, error) {
+			if len(arr) == 0 {
+				return data.Null{}, nil
 			}
-		}
-		if firstNonNull == -1 {
-			return data.Null{}, nil
-		}
-		// if we have timestamp-shaped data
-		if arr[firstNonNull].Type() == data.TypeTimestamp {
-			minTime, _ := data.AsTimestamp(arr[firstNonNull])
+			// deal with the case of leading nulls and only nulls
+			firstNonNull := -1
+			for i, item := range arr {
+				if item.Type() != data.TypeNull {
+					firstNonNull = i
+					break
+				}
+			}
+			if firstNonNull == -1 {
+				return data.Null{}, nil
+			}
+			// if we have timestamp-shaped data
+			if arr[firstNonNull].Type() == data.TypeTimestamp {
+				minTime, _ := data.AsTimestamp(arr[firstNonNull])
+				for _, item := range arr[firstNonNull:] {
+					if item.Type() == data.TypeTimestamp {
+						t, _ := data.AsTimestamp(item)
+						if minTime.Sub(t).Seconds() > 0 {
+							minTime = t
+						}
+					} else if item.Type() == data.TypeNull {
+						continue
+					} else {
+						return nil, fmt.Errorf("cannot interpret %s (%T) as a timestamp",
+							item, item)
+					}
+				}
+				return data.Timestamp(minTime), nil
+			}
+			// else: numeric
+			minFloat := float64(math.MaxFloat64)
+			minInt := int64(math.MaxInt64)
 			for _, item := range arr[firstNonNull:] {
-				if item.Type() == data.TypeTimestamp {
-					t, _ := data.AsTimestamp(item)
-					if minTime.Sub(t).Seconds() > 0 {
-						minTime = t
+				if item.Type() == data.TypeInt {
+					i, _ := data.AsInt(item)
+					if i < minInt {
+						minInt = i
+					}
+				} else if item.Type() == data.TypeFloat {
+					f, _ := data.AsFloat(item)
+					if f < minFloat {
+						minFloat = f
 					}
 				} else if item.Type() == data.TypeNull {
 					continue
 				} else {
-					return nil, fmt.Errorf("cannot interpret %s (%T) as a timestamp",
+					return nil, fmt.Errorf("cannot interpret %s (%T) as a number",
 						item, item)
 				}
 			}
-			return data.Timestamp(minTime), nil
-		}
-		// else: numeric
-		minFloat := float64(math.MaxFloat64)
-		minInt := int64(math.MaxInt64)
-		for _, item := range arr[firstNonNull:] {
-			if item.Type() == data.TypeInt {
-				i, _ := data.AsInt(item)
-				if i < minInt {
-					minInt = i
-				}
-			} else if item.Type() == data.TypeFloat {
-				f, _ := data.AsFloat(item)
-				if f < minFloat {
-					minFloat = f
-				}
-			} else if item.Type() == data.TypeNull {
-				continue
-			} else {
-				return nil, fmt.Errorf("cannot interpret %s (%T) as a number",
-					item, item)
+			if float64(minInt) <= minFloat {
+				return data.Int(minInt), nil
 			}
-		}
-		if float64(minInt) <= minFloat {
-			return data.Int(minInt), nil
-		}
-		return data.Float(minFloat), nil
+			return data.Float(minFloat), nil
+		},
 	},
+	newAcc: newMinAccumulator,
 }
 
 type stringAggFuncTmpl struct {
@@ -513,50 +525,53 @@ var stringAggFunc udf.UDF = &stringAggFuncTmpl{}
 //  Input: Int or Float (aggregated)
 //  Return Type: Float if the input contains a Float, Int otherwise
 //   (Null on empty input)
-var sumFunc udf.UDF = &singleParamAggFunc{
-	aggFun: func(arr []data.Value) (data.Value, error) {
-		if len(arr) == 0 {
-			return data.Null{}, nil
-		}
-		sum := float64(0.0)
-		intSum := int64(0)
-		hadFloat := false
-		onlyNulls := true
-		for _, item := range arr {
-			if item.Type() == data.TypeInt {
-				i, _ := data.AsInt(item)
-				// if intSum overflows here, so be it. maybe later
-				// additions will fix the situation again. if we
-				// try to detect this here and return an error, we
-				// become dependent on the input order of numbers.
-				intSum += i
-				f := float64(i)
-				sum += f
-				onlyNulls = false
-			} else if item.Type() == data.TypeFloat {
-				f, _ := data.AsFloat(item)
-				sum += f
-				hadFloat = true
-				onlyNulls = false
-			} else if item.Type() == data.TypeNull {
-				continue
-			} else {
-				return nil, fmt.Errorf("cannot interpret %s (%T) as a number",
-					item, item)
+var sumFunc udf.UDF = &incrementalAggFunc{
+	singleParamAggFunc: singleParamAggFunc{
+		aggFun: func(arr []data.Value) (data.Value, error) {
+			if len(arr) == 0 {
+				return data.Null{}, nil
 			}
-		}
-		if onlyNulls {
-			return data.Null{}, nil
-		}
-		if !hadFloat {
-			// if we had only integers, return the integer sum
-			// (this is better than converting the float sum
-			// back to int64 because we inherit Go's way of dealing
-			// with overflows)
-			return data.Int(intSum), nil
-		}
-		return data.Float(sum), nil
+			sum := float64(0.0)
+			intSum := int64(0)
+			hadFloat := false
+			onlyNulls := true
+			for _, item := range arr {
+				if item.Type() == data.TypeInt {
+					i, _ := data.AsInt(item)
+					// if intSum overflows here, so be it. maybe later
+					// additions will fix the situation again. if we
+					// try to detect this here and return an error, we
+					// become dependent on the input order of numbers.
+					intSum += i
+					f := float64(i)
+					sum += f
+					onlyNulls = false
+				} else if item.Type() == data.TypeFloat {
+					f, _ := data.AsFloat(item)
+					sum += f
+					hadFloat = true
+					onlyNulls = false
+				} else if item.Type() == data.TypeNull {
+					continue
+				} else {
+					return nil, fmt.Errorf("cannot interpret %s (%T) as a number",
+						item, item)
+				}
+			}
+			if onlyNulls {
+				return data.Null{}, nil
+			}
+			if !hadFloat {
+				// if we had only integers, return the integer sum
+				// (this is better than converting the float sum
+				// back to int64 because we inherit Go's way of dealing
+				// with overflows)
+				return data.Int(intSum), nil
+			}
+			return data.Float(sum), nil
+		},
 	},
+	newAcc: newSumAccumulator,
 }
 
 // skipping xmlagg here since we have no XML data type
@@ -5,6 +5,7 @@ import (
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sort"
 )
 
 // arrayLengthFunc returns the length of the given array.
@@ -23,3 +24,255 @@ var arrayLengthFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, arg data.Val
 	}
 	return nil, fmt.Errorf("%v is not an array", arg)
 })
+
+// asArrays returns the given values as arrays. It returns false if one
+// of them is NULL.
+func asArrays(args ...data.Value) ([]data.Array, bool, error) {
+	arrs := make([]data.Array, len(args))
+	for i, arg := range args {
+		if arg.Type() == data.TypeNull {
+			return nil, false, nil
+		}
+		a, err := data.AsArray(arg)
+		if err != nil {
+			return nil, false, fmt.Errorf("%v is not an array", arg)
+		}
+		arrs[i] = a
+	}
+	return arrs, true, nil
+}
+
+// arrayContainsFunc returns true if the given array has an element equal
+// to the given value. NULL elements are equal to NULL.
+//
+// It can be used in BQL as `array_contains`.
+//
+//  Input: Array, Any
+//  Return Type: Bool
+var arrayContainsFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, arr data.Value, v data.Value) (data.Value, error) {
+	arrs, ok, err := asArrays(arr)
+	if !ok {
+		return data.Null{}, err
+	}
+	for _, e := range arrs[0] {
+		if data.Equal(e, v) {
+			return data.Bool(true), nil
+		}
+	}
+	return data.Bool(false), nil
+})
+
+// arrayPositionFunc returns the 1-based position of the first element
+// equal to the given value in the given array, or NULL if there's no such
+// element. If the third argument is given, the search starts at that
+// position.
+// See also: PostgreSQL's `array_position`
+//
+// It can be used in BQL as `array_position`.
+//
+//  Input: Array, Any, [Int]
+//  Return Type: Int
+var arrayPositionFunc udf.UDF = &variadicFunc{
+	minParams: 2,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		if len(args) > 3 {
+			return nil, fmt.Errorf("function takes two or three arguments")
+		}
+		arrs, ok, err := asArrays(args[0])
+		if !ok {
+			return data.Null{}, err
+		}
+		start := int64(1)
+		if len(args) == 3 {
+			if args[2].Type() == data.TypeNull {
+				return data.Null{}, nil
+			}
+			start, err = data.AsInt(args[2])
+			if err != nil {
+				return nil, fmt.Errorf("cannot interpret %s as an integer", args[2])
+			}
+			if start < 1 {
+				start = 1
+			}
+		}
+		for i := start - 1; i < int64(len(arrs[0])); i++ {
+			if data.Equal(arrs[0][i], args[1]) {
+				return data.Int(i + 1), nil
+			}
+		}
+		return data.Null{}, nil
+	},
+}
+
+// arraySliceFunc returns the elements of the given array from the
+// position given by the second argument to the one given by the third
+// argument, both 1-based and inclusive. Negative positions are counted
+// from the end of the array, i.e., -1 is the last element. Positions
+// outside of the array are clamped.
+//
+// It can be used in BQL as `array_slice`.
+//
+//  Input: Array, Int, Int
+//  Return Type: Array
+var arraySliceFunc udf.UDF = udf.TernaryFunc(func(ctx *core.Context, arr data.Value, from data.Value, to data.Value) (data.Value, error) {
+	arrs, ok, err := asArrays(arr)
+	if !ok || from.Type() == data.TypeNull || to.Type() == data.TypeNull {
+		return data.Null{}, err
+	}
+	a := arrs[0]
+	pos := func(v data.Value) (int64, error) {
+		i, err := data.AsInt(v)
+		if err != nil {
+			return 0, fmt.Errorf("cannot interpret %s as an integer", v)
+		}
+		if i < 0 {
+			i += int64(len(a)) + 1
+		}
+		return i, nil
+	}
+	begin, err := pos(from)
+	if err != nil {
+		return nil, err
+	}
+	end, err := pos(to)
+	if err != nil {
+		return nil, err
+	}
+	if begin < 1 {
+		begin = 1
+	}
+	if end > int64(len(a)) {
+		end = int64(len(a))
+	}
+	if begin > end {
+		return data.Array{}, nil
+	}
+	return append(data.Array{}, a[begin-1:end]...), nil
+})
+
+// arrayAppendFunc returns a new array having the elements of the given
+// array followed by the given value.
+//
+// It can be used in BQL as `array_append`.
+//
+//  Input: Array, Any
+//  Return Type: Array
+var arrayAppendFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, arr data.Value, v data.Value) (data.Value, error) {
+	arrs, ok, err := asArrays(arr)
+	if !ok {
+		return data.Null{}, err
+	}
+	res := make(data.Array, len(arrs[0]), len(arrs[0])+1)
+	copy(res, arrs[0])
+	return append(res, v), nil
+})
+
+// arrayConcatFunc concatenates all arrays given as input arguments.
+// NULL values are ignored.
+//
+// It can be used in BQL as `array_concat`.
+//
+//  Input: n * Array
+//  Return Type: Array
+var arrayConcatFunc udf.UDF = &variadicFunc{
+	minParams: 1,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		res := data.Array{}
+		for _, arg := range args {
+			if arg.Type() == data.TypeNull {
+				continue
+			}
+			a, err := data.AsArray(arg)
+			if err != nil {
+				return nil, fmt.Errorf("%v is not an array", arg)
+			}
+			res = append(res, a...)
+		}
+		return res, nil
+	},
+}
+
+// arraySortFunc returns a new array having the elements of the given
+// array in ascending order. Values of different types are ordered as
+// NULL < Bool < Int/Float < String < Blob < Timestamp < Array < Map.
+//
+// It can be used in BQL as `array_sort`.
+//
+//  Input: Array
+//  Return Type: Array
+var arraySortFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, arr data.Value) (data.Value, error) {
+	arrs, ok, err := asArrays(arr)
+	if !ok {
+		return data.Null{}, err
+	}
+	res := append(data.Array{}, arrs[0]...)
+	sort.Stable(valuesAscending(res))
+	return res, nil
+})
+
+// valuesAscending sorts values in the order defined by data.Less.
+type valuesAscending data.Array
+
+func (v valuesAscending) Len() int {
+	return len(v)
+}
+
+func (v valuesAscending) Less(i, j int) bool {
+	return data.Less(v[i], v[j])
+}
+
+func (v valuesAscending) Swap(i, j int) {
+	v[i], v[j] = v[j], v[i]
+}
+
+// arrayDistinctFunc returns a new array having the elements of the given
+// array without duplicates, keeping the first occurrence of each value.
+//
+// It can be used in BQL as `array_distinct`.
+//
+//  Input: Array
+//  Return Type: Array
+var arrayDistinctFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, arr data.Value) (data.Value, error) {
+	arrs, ok, err := asArrays(arr)
+	if !ok {
+		return data.Null{}, err
+	}
+	seen := map[data.HashValue][]data.Value{}
+	res := data.Array{}
+	for _, e := range arrs[0] {
+		h := data.Hash(e)
+		dup := false
+		for _, s := range seen[h] {
+			if data.Equal(s, e) {
+				dup = true
+				break
+			}
+		}
+		if dup {
+			continue
+		}
+		seen[h] = append(seen[h], e)
+		res = append(res, e)
+	}
+	return res, nil
+})
+
+// arrayReverseFunc returns a new array having the elements of the given
+// array in reverse order.
+//
+// It can be used in BQL as `array_reverse`.
+//
+//  Input: Array
+//  Return Type: Array
+var arrayReverseFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, arr data.Value) (data.Value, error) {
+	arrs, ok, err := asArrays(arr)
+	if !ok {
+		return data.Null{}, err
+	}
+	a := arrs[0]
+	res := make(data.Array, len(a))
+	for i, e := range a {
+		res[len(a)-1-i] = e
+	}
+	return res, nil
+})
@@ -80,3 +80,126 @@ func TestUnaryArrayFuncs(t *testing.T) {
 		})
 	}
 }
+
+func TestVariadicArrayFuncs(t *testing.T) {
+	i := func(ints ...int64) data.Array {
+		arr := make(data.Array, len(ints))
+		for k, n := range ints {
+			arr[k] = data.Int(n)
+		}
+		return arr
+	}
+
+	udfVariadicTestCases := []udfVariadicTestCase{
+		{"array_contains", arrayContainsFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{i(1, 2, 3), data.Int(2)}, data.Bool(true)},
+			{[]data.Value{i(1, 2, 3), data.Float(3)}, data.Bool(true)},
+			{[]data.Value{i(1, 2, 3), data.String("2")}, data.Bool(false)},
+			{[]data.Value{data.Array{data.Null{}}, data.Null{}}, data.Bool(true)},
+			{[]data.Value{data.Array{}, data.Int(1)}, data.Bool(false)},
+			{[]data.Value{data.Null{}, data.Int(1)}, data.Null{}},
+			// invalid cases
+			{[]data.Value{data.Int(1), data.Int(1)}, nil},
+		}},
+		{"array_position", arrayPositionFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{i(1, 2, 3, 2), data.Int(2)}, data.Int(2)},
+			{[]data.Value{i(1, 2, 3, 2), data.Int(2), data.Int(3)}, data.Int(4)},
+			{[]data.Value{i(1, 2, 3, 2), data.Int(2), data.Int(-1)}, data.Int(2)},
+			{[]data.Value{i(1, 2, 3, 2), data.Int(5)}, data.Null{}},
+			{[]data.Value{i(1, 2, 3, 2), data.Int(2), data.Int(5)}, data.Null{}},
+			{[]data.Value{data.Null{}, data.Int(1)}, data.Null{}},
+			// invalid cases
+			{[]data.Value{i(1), data.Int(1), data.String("a")}, nil},
+			{[]data.Value{i(1), data.Int(1), data.Int(1), data.Int(1)}, nil},
+		}},
+		{"array_slice", arraySliceFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{i(1, 2, 3, 4), data.Int(2), data.Int(3)}, i(2, 3)},
+			{[]data.Value{i(1, 2, 3, 4), data.Int(-2), data.Int(-1)}, i(3, 4)},
+			{[]data.Value{i(1, 2, 3, 4), data.Int(0), data.Int(10)}, i(1, 2, 3, 4)},
+			{[]data.Value{i(1, 2, 3, 4), data.Int(3), data.Int(2)}, data.Array{}},
+			{[]data.Value{i(1, 2, 3, 4), data.Null{}, data.Int(2)}, data.Null{}},
+			// invalid cases
+			{[]data.Value{i(1, 2), data.Int(1), data.String("a")}, nil},
+		}},
+		{"array_append", arrayAppendFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{i(1, 2), data.Int(3)}, i(1, 2, 3)},
+			{[]data.Value{data.Array{}, data.Null{}}, data.Array{data.Null{}}},
+			{[]data.Value{data.Null{}, data.Int(3)}, data.Null{}},
+			// invalid cases
+			{[]data.Value{data.Map{}, data.Int(3)}, nil},
+		}},
+		{"array_concat", arrayConcatFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{i(1, 2), i(3), data.Null{}, i(4)}, i(1, 2, 3, 4)},
+			{[]data.Value{data.Null{}}, data.Array{}},
+			// invalid cases
+			{[]data.Value{i(1), data.Int(3)}, nil},
+		}},
+		{"array_sort", arraySortFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{data.Array{data.Int(3), data.String("a"), data.Float(1.5), data.Null{}, data.Int(2)}},
+				data.Array{data.Null{}, data.Float(1.5), data.Int(2), data.Int(3), data.String("a")}},
+			{[]data.Value{data.Null{}}, data.Null{}},
+			// invalid cases
+			{[]data.Value{data.Int(3)}, nil},
+		}},
+		{"array_distinct", arrayDistinctFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{data.Array{data.Int(3), data.Int(1), data.Float(3), data.Null{}, data.Int(1), data.Null{}}},
+				data.Array{data.Int(3), data.Int(1), data.Null{}}},
+			{[]data.Value{data.Null{}}, data.Null{}},
+			// invalid cases
+			{[]data.Value{data.Int(3)}, nil},
+		}},
+		{"array_reverse", arrayReverseFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{i(1, 2, 3)}, i(3, 2, 1)},
+			{[]data.Value{data.Array{}}, data.Array{}},
+			{[]data.Value{data.Null{}}, data.Null{}},
+			// invalid cases
+			{[]data.Value{data.String("abc")}, nil},
+		}},
+	}
+
+	for _, testCase := range udfVariadicTestCases {
+		f := testCase.f
+		allInputs := testCase.inputs
+		name := testCase.name
+
+		Convey(fmt.Sprintf("Given the %s function", name), t, func() {
+			for _, tc := range allInputs {
+				tc := tc
+
+				Convey(fmt.Sprintf("When evaluating it on %#v", tc.input), func() {
+					val, err := f.Call(nil, tc.input...)
+
+					if tc.expected == nil {
+						Convey("Then evaluation should fail", func() {
+							So(err, ShouldNotBeNil)
+						})
+					} else {
+						Convey(fmt.Sprintf("Then the result should be %s", tc.expected), func() {
+							So(err, ShouldBeNil)
+							So(val, ShouldResemble, tc.expected)
+						})
+					}
+				})
+			}
+
+			Convey("Then it should equal the one in the default registry", func() {
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(name, len(allInputs[0].input))
+				So(err, ShouldBeNil)
+				So(regFun, ShouldHaveSameTypeAs, f)
+			})
+		})
+	}
+
+	Convey("Given an array", t, func() {
+		arr := data.Array{data.Int(2), data.Int(1)}
+
+		Convey("When sorting it", func() {
+			_, err := arraySortFunc.Call(nil, arr)
+			So(err, ShouldBeNil)
+
+			Convey("Then it shouldn't be modified", func() {
+				So(arr, ShouldResemble, data.Array{data.Int(2), data.Int(1)})
+			})
+		})
+	})
+}
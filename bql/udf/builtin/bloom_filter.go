@@ -0,0 +1,264 @@
+package builtin
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io"
+	"math"
+	"sync"
+)
+
+// bloomFilterState is a Bloom filter of the values of a field written to
+// it. bloom_filter_contains reports whether a value might have been
+// written to the state. It never returns false for a value which has
+// been written, but it can return true for a value which hasn't with the
+// given false positive rate as long as the number of distinct values
+// doesn't exceed the capacity.
+//
+// It can be created in BQL as `bloom_filter` with the following
+// parameters:
+//
+//	key: the path of the field added to the filter (required)
+//	capacity: the expected number of distinct values (default: 10000)
+//	false_positive_rate: the expected false positive rate (default: 0.01)
+//
+// For example:
+//
+//	CREATE STATE seen_users TYPE bloom_filter WITH key = "user_id", capacity = 1000000;
+//	CREATE SINK seen_users_sink TYPE uds WITH name = "seen_users";
+//	INSERT INTO seen_users_sink FROM logins;
+type bloomFilterState struct {
+	key     string
+	keyPath data.Path
+	// numHashes is the number of bits set for each value.
+	numHashes int
+
+	m sync.RWMutex
+	// bits is the bit array of the filter. It is nil after the state is
+	// terminated.
+	bits    []uint64
+	numBits uint64
+}
+
+var (
+	_ core.LoadableSharedState = &bloomFilterState{}
+	_ core.Writer              = &bloomFilterState{}
+)
+
+var bloomFilterStateCreator udf.UDSCreator = &stateCreator{
+	typeName: "bloom_filter",
+	create:   createBloomFilterState,
+	restore:  restoreBloomFilterState,
+}
+
+func createBloomFilterState(ctx *core.Context, params data.Map) (core.SharedState, error) {
+	key, path, err := pathParam(params, "key", true)
+	if err != nil {
+		return nil, err
+	}
+
+	capacity := int64(10000)
+	if v, ok := params["capacity"]; ok {
+		c, err := data.AsInt(v)
+		if err != nil {
+			return nil, fmt.Errorf("'capacity' parameter must be an integer: %v", err)
+		}
+		if c <= 0 {
+			return nil, fmt.Errorf("'capacity' parameter must be greater than 0: %v", c)
+		}
+		capacity = c
+	}
+
+	rate := 0.01
+	if v, ok := params["false_positive_rate"]; ok {
+		r, err := data.ToFloat(v)
+		if err != nil {
+			return nil, fmt.Errorf("'false_positive_rate' parameter must be a number: %v", err)
+		}
+		if r <= 0 || r >= 1 {
+			return nil, fmt.Errorf("'false_positive_rate' parameter must be between 0 and 1 exclusive: %v", r)
+		}
+		rate = r
+	}
+
+	// the optimal number of bits and hash functions for the capacity
+	// and the false positive rate
+	n := float64(capacity)
+	m := math.Ceil(-n * math.Log(rate) / (math.Ln2 * math.Ln2))
+	k := int(math.Max(1, math.Floor(m/n*math.Ln2+0.5)))
+	return newBloomFilterState(key, path, uint64(m), k), nil
+}
+
+func newBloomFilterState(key string, path data.Path, numBits uint64, numHashes int) *bloomFilterState {
+	return &bloomFilterState{
+		key:       key,
+		keyPath:   path,
+		numHashes: numHashes,
+		bits:      make([]uint64, (numBits+63)/64),
+		numBits:   numBits,
+	}
+}
+
+func restoreBloomFilterState(d data.Map) (core.SharedState, error) {
+	if !hasKeys(d, "key", "num_bits", "num_hashes", "bits") {
+		return nil, errors.New("the saved bloom_filter state doesn't have required fields")
+	}
+	key, path, err := pathParam(d, "key", true)
+	if err != nil {
+		return nil, fmt.Errorf("the saved bloom_filter state doesn't have a valid key: %v", err)
+	}
+	numBits, err := data.AsInt(d["num_bits"])
+	if err != nil || numBits <= 0 {
+		return nil, fmt.Errorf("the saved bloom_filter state doesn't have a valid number of bits: %v", d["num_bits"])
+	}
+	numHashes, err := data.AsInt(d["num_hashes"])
+	if err != nil || numHashes <= 0 {
+		return nil, fmt.Errorf("the saved bloom_filter state doesn't have a valid number of hashes: %v", d["num_hashes"])
+	}
+	b, err := data.AsBlob(d["bits"])
+	if err != nil {
+		return nil, fmt.Errorf("the saved bloom_filter state doesn't have valid bits: %v", err)
+	}
+
+	s := newBloomFilterState(key, path, uint64(numBits), int(numHashes))
+	if len(b) != 8*len(s.bits) {
+		return nil, fmt.Errorf("the saved bloom_filter state has %v bytes of bits instead of %v", len(b), 8*len(s.bits))
+	}
+	for i := range s.bits {
+		s.bits[i] = binary.LittleEndian.Uint64(b[8*i:])
+	}
+	return s, nil
+}
+
+func (s *bloomFilterState) Terminate(ctx *core.Context) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.bits = nil
+	return nil
+}
+
+// Write adds the value of the tuple's key field to the filter. A NULL
+// value is ignored.
+func (s *bloomFilterState) Write(ctx *core.Context, t *core.Tuple) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.bits == nil {
+		return errors.New("the state is already terminated")
+	}
+	v, err := t.Data.Get(s.keyPath)
+	if err != nil {
+		return err
+	}
+	if v.Type() == data.TypeNull {
+		return nil
+	}
+	h1, h2 := bloomFilterHashes(v)
+	for i := 0; i < s.numHashes; i++ {
+		b := (h1 + uint64(i)*h2) % s.numBits
+		s.bits[b/64] |= 1 << (b % 64)
+	}
+	return nil
+}
+
+// bloomFilterHashes returns two hash values of the value. Bits of the
+// filter are chosen by double hashing, i.e. h1 + i*h2 for the i-th hash
+// function.
+func bloomFilterHashes(v data.Value) (uint64, uint64) {
+	h := hashForSketch(v)
+	// h2 is made odd so that it is never 0
+	return h, (h>>32 | h<<32) | 1
+}
+
+// contains returns true if the value might have been added to the
+// filter.
+func (s *bloomFilterState) contains(v data.Value) (bool, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	if s.bits == nil {
+		return false, errors.New("the state is already terminated")
+	}
+	h1, h2 := bloomFilterHashes(v)
+	for i := 0; i < s.numHashes; i++ {
+		b := (h1 + uint64(i)*h2) % s.numBits
+		if s.bits[b/64]&(1<<(b%64)) == 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// Save writes the parameters and the bits of the filter.
+func (s *bloomFilterState) Save(ctx *core.Context, w io.Writer, params data.Map) error {
+	s.m.RLock()
+	if s.bits == nil {
+		s.m.RUnlock()
+		return errors.New("the state is already terminated")
+	}
+	b := make(data.Blob, 8*len(s.bits))
+	for i, x := range s.bits {
+		binary.LittleEndian.PutUint64(b[8*i:], x)
+	}
+	d := data.Map{
+		"key":        data.String(s.key),
+		"num_bits":   data.Int(s.numBits),
+		"num_hashes": data.Int(s.numHashes),
+		"bits":       b,
+	}
+	s.m.RUnlock()
+
+	return saveStateData(w, "bloom_filter", d)
+}
+
+// Load replaces the parameters and the bits of the filter with the saved
+// ones.
+func (s *bloomFilterState) Load(ctx *core.Context, r io.Reader, params data.Map) error {
+	d, err := loadStateData(r, "bloom_filter")
+	if err != nil {
+		return err
+	}
+	st, err := restoreBloomFilterState(d)
+	if err != nil {
+		return err
+	}
+	n := st.(*bloomFilterState)
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.bits == nil {
+		return errors.New("the state is already terminated")
+	}
+	s.key, s.keyPath, s.numHashes = n.key, n.keyPath, n.numHashes
+	s.bits, s.numBits = n.bits, n.numBits
+	return nil
+}
+
+// bloomFilterContainsFunc returns true if the given value might have
+// been written to the bloom_filter state having the given name, and
+// false if it definitely hasn't. It returns Null for a NULL value.
+//
+// It can be used in BQL as `bloom_filter_contains`.
+//
+//  Input: String, any
+//  Return Type: Bool
+var bloomFilterContainsFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, name, v data.Value) (data.Value, error) {
+	st, n, err := lookupState(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := st.(*bloomFilterState)
+	if !ok {
+		return nil, fmt.Errorf("state '%s' isn't a bloom_filter state", n)
+	}
+	if v.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	c, err := s.contains(v)
+	if err != nil {
+		return nil, err
+	}
+	return data.Bool(c), nil
+})
@@ -0,0 +1,109 @@
+package builtin
+
+import (
+	"bytes"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestBloomFilterState(t *testing.T) {
+	ctx := core.NewContext(nil)
+
+	Convey("Given a bloom_filter state", t, func() {
+		st, err := createBloomFilterState(ctx, data.Map{
+			"key":      data.String("id"),
+			"capacity": data.Int(1000),
+		})
+		So(err, ShouldBeNil)
+		So(ctx.SharedStates.Add("seen", "bloom_filter", st), ShouldBeNil)
+		Reset(func() {
+			ctx.SharedStates.Remove("seen")
+		})
+		s := st.(*bloomFilterState)
+
+		Convey("Then it should have the optimal number of bits and hashes", func() {
+			So(s.numBits, ShouldEqual, 9586)
+			So(s.numHashes, ShouldEqual, 7)
+		})
+
+		Convey("When writing values", func() {
+			for i := 0; i < 1000; i++ {
+				So(s.Write(ctx, core.NewTuple(data.Map{"id": data.Int(i)})), ShouldBeNil)
+			}
+			So(s.Write(ctx, core.NewTuple(data.Map{"id": data.Null{}})), ShouldBeNil)
+
+			contains := func(f *bloomFilterState, v data.Value) bool {
+				c, err := f.contains(v)
+				So(err, ShouldBeNil)
+				return c
+			}
+
+			Convey("Then bloom_filter_contains should return true for all of them", func() {
+				for i := 0; i < 1000; i++ {
+					v, err := bloomFilterContainsFunc.Call(ctx, data.String("seen"), data.Int(i))
+					So(err, ShouldBeNil)
+					So(v, ShouldEqual, data.True)
+				}
+			})
+
+			Convey("Then the false positive rate should be close to the expected one", func() {
+				fp := 0
+				for i := 1000; i < 11000; i++ {
+					if contains(s, data.Int(i)) {
+						fp++
+					}
+				}
+				So(fp, ShouldBeLessThan, 200)
+			})
+
+			Convey("Then bloom_filter_contains should return null for null", func() {
+				v, err := bloomFilterContainsFunc.Call(ctx, data.String("seen"), data.Null{})
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.Null{})
+			})
+
+			Convey("Then a state loaded from the saved data should have the same bits", func() {
+				buf := bytes.NewBuffer(nil)
+				So(s.Save(ctx, buf, data.Map{}), ShouldBeNil)
+				l, err := bloomFilterStateCreator.(udf.UDSLoader).LoadState(ctx, buf, data.Map{})
+				So(err, ShouldBeNil)
+				loaded := l.(*bloomFilterState)
+				So(loaded.bits, ShouldResemble, s.bits)
+				for i := 0; i < 2000; i++ {
+					So(contains(loaded, data.Int(i)), ShouldEqual, contains(s, data.Int(i)))
+				}
+			})
+		})
+
+		Convey("When calling bloom_filter_contains with a state of another type", func() {
+			kv, err := createKVState(ctx, data.Map{"key": data.String("id")})
+			So(err, ShouldBeNil)
+			So(ctx.SharedStates.Add("kv", "kv", kv), ShouldBeNil)
+			Reset(func() {
+				ctx.SharedStates.Remove("kv")
+			})
+			_, err = bloomFilterContainsFunc.Call(ctx, data.String("kv"), data.Int(1))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given invalid parameters for a bloom_filter state", t, func() {
+		for _, params := range []data.Map{
+			{},
+			{"key": data.String("id"), "capacity": data.Int(0)},
+			{"key": data.String("id"), "false_positive_rate": data.Float(1)},
+			{"key": data.String("id"), "false_positive_rate": data.String("a")},
+		} {
+			Convey("Then creating the state should fail with "+params.String(), func() {
+				_, err := createBloomFilterState(ctx, params)
+				So(err, ShouldNotBeNil)
+			})
+		}
+	})
+}
@@ -0,0 +1,320 @@
+package builtin
+
+import (
+	"errors"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io"
+	"sync"
+)
+
+// counterState counts tuples written to it, optionally per value of a
+// key field. Each tuple increments the counter of its key by one or by
+// the value of its value field. Counters can be joined with streams by
+// JOIN STATE clauses, which look up a Map having "key" and "count", or
+// obtained by counter_get.
+//
+// It can be created in BQL as `counter` with the following parameters:
+//
+//	key: the path of the field whose values are counted separately
+//	     (optional, all tuples are counted together by default)
+//	value: the path of an Int field added to the counter (optional, 1 is
+//	       added by default)
+//
+// For example:
+//
+//	CREATE STATE bytes_per_user TYPE counter WITH key = "user", value = "bytes";
+//	CREATE SINK bytes_per_user_sink TYPE uds WITH name = "bytes_per_user";
+//	INSERT INTO bytes_per_user_sink FROM requests;
+type counterState struct {
+	key       string
+	keyPath   data.Path
+	value     string
+	valuePath data.Path
+
+	m sync.RWMutex
+	// counts holds the counters of keys having the same hash value. It
+	// is nil after the state is terminated. When the key parameter isn't
+	// given, Null is used as the key of all tuples.
+	counts map[data.HashValue][]counterEntry
+}
+
+type counterEntry struct {
+	key   data.Value
+	count int64
+}
+
+var (
+	_ core.LookupableSharedState = &counterState{}
+	_ core.LoadableSharedState   = &counterState{}
+	_ core.Writer                = &counterState{}
+)
+
+var counterStateCreator udf.UDSCreator = &stateCreator{
+	typeName: "counter",
+	create:   createCounterState,
+	restore:  restoreCounterState,
+}
+
+func createCounterState(ctx *core.Context, params data.Map) (core.SharedState, error) {
+	key, keyPath, err := pathParam(params, "key", false)
+	if err != nil {
+		return nil, err
+	}
+	value, valuePath, err := pathParam(params, "value", false)
+	if err != nil {
+		return nil, err
+	}
+	return &counterState{
+		key:       key,
+		keyPath:   keyPath,
+		value:     value,
+		valuePath: valuePath,
+		counts:    map[data.HashValue][]counterEntry{},
+	}, nil
+}
+
+func restoreCounterState(d data.Map) (core.SharedState, error) {
+	params := data.Map{}
+	for _, p := range []string{"key", "value"} {
+		if v, ok := d[p]; ok && v.Type() != data.TypeNull {
+			params[p] = v
+		}
+	}
+	st, err := createCounterState(nil, params)
+	if err != nil {
+		return nil, fmt.Errorf("the saved counter state doesn't have valid parameters: %v", err)
+	}
+	s := st.(*counterState)
+	counts, err := data.AsArray(d["counts"])
+	if err != nil {
+		return nil, fmt.Errorf("the saved counter state doesn't have valid counts: %v", err)
+	}
+	for _, c := range counts {
+		m, err := data.AsMap(c)
+		if err != nil || !hasKeys(m, "key", "count") {
+			return nil, fmt.Errorf("the saved counter state doesn't have valid counts: %v", c)
+		}
+		n, err := data.AsInt(m["count"])
+		if err != nil {
+			return nil, fmt.Errorf("the saved counter state doesn't have valid counts: %v", err)
+		}
+		s.add(m["key"], n)
+	}
+	return s, nil
+}
+
+func (s *counterState) Terminate(ctx *core.Context) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.counts = nil
+	return nil
+}
+
+// Write increments the counter of the tuple's key. It fails if the tuple
+// doesn't have the key field or the value field, if the key is NULL, or
+// if the value isn't an Int. A tuple having a NULL value is ignored.
+func (s *counterState) Write(ctx *core.Context, t *core.Tuple) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.counts == nil {
+		return errors.New("the state is already terminated")
+	}
+	var key data.Value = data.Null{}
+	if s.keyPath != nil {
+		k, err := t.Data.Get(s.keyPath)
+		if err != nil {
+			return err
+		}
+		if k.Type() == data.TypeNull {
+			return errors.New("the key of a counter state cannot be null")
+		}
+		key = copyValue(k)
+	}
+	n := int64(1)
+	if s.valuePath != nil {
+		v, err := t.Data.Get(s.valuePath)
+		if err != nil {
+			return err
+		}
+		if v.Type() == data.TypeNull {
+			return nil
+		}
+		if v.Type() != data.TypeInt {
+			return fmt.Errorf("the value of a counter state must be an integer: %v", v)
+		}
+		n, _ = data.AsInt(v)
+	}
+	s.add(key, n)
+	return nil
+}
+
+// add increments the counter of the key by n. The caller must hold the
+// lock.
+func (s *counterState) add(key data.Value, n int64) {
+	h := data.Hash(key)
+	bucket := s.counts[h]
+	for i, e := range bucket {
+		if data.Equal(e.key, key) {
+			bucket[i].count += n
+			return
+		}
+	}
+	s.counts[h] = append(bucket, counterEntry{key, n})
+}
+
+// get returns the counter of the key and false if the state doesn't
+// have the key.
+func (s *counterState) get(key data.Value) (int64, bool, error) {
+	h := data.Hash(key)
+
+	s.m.RLock()
+	defer s.m.RUnlock()
+	if s.counts == nil {
+		return 0, false, errors.New("the state is already terminated")
+	}
+	for _, e := range s.counts[h] {
+		if data.Equal(e.key, key) {
+			return e.count, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+// total returns the sum of all counters.
+func (s *counterState) total() (int64, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	if s.counts == nil {
+		return 0, errors.New("the state is already terminated")
+	}
+	sum := int64(0)
+	for _, bucket := range s.counts {
+		for _, e := range bucket {
+			sum += e.count
+		}
+	}
+	return sum, nil
+}
+
+func (s *counterState) Lookup(ctx *core.Context, key data.Value) (data.Map, error) {
+	n, ok, err := s.get(key)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, core.NotExistError(fmt.Errorf("the state doesn't have the key %v", key))
+	}
+	return data.Map{
+		"key":   key,
+		"count": data.Int(n),
+	}, nil
+}
+
+// Save writes the parameters and all counters of the state.
+func (s *counterState) Save(ctx *core.Context, w io.Writer, params data.Map) error {
+	s.m.RLock()
+	if s.counts == nil {
+		s.m.RUnlock()
+		return errors.New("the state is already terminated")
+	}
+	d := data.Map{"key": data.Null{}, "value": data.Null{}}
+	if s.keyPath != nil {
+		d["key"] = data.String(s.key)
+	}
+	if s.valuePath != nil {
+		d["value"] = data.String(s.value)
+	}
+	counts := data.Array{}
+	for _, bucket := range s.counts {
+		for _, e := range bucket {
+			counts = append(counts, data.Map{
+				"key":   e.key,
+				"count": data.Int(e.count),
+			})
+		}
+	}
+	s.m.RUnlock()
+
+	d["counts"] = counts
+	return saveStateData(w, "counter", d)
+}
+
+// Load replaces the parameters and all counters of the state with the
+// saved ones.
+func (s *counterState) Load(ctx *core.Context, r io.Reader, params data.Map) error {
+	d, err := loadStateData(r, "counter")
+	if err != nil {
+		return err
+	}
+	st, err := restoreCounterState(d)
+	if err != nil {
+		return err
+	}
+	n := st.(*counterState)
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.counts == nil {
+		return errors.New("the state is already terminated")
+	}
+	s.key, s.keyPath = n.key, n.keyPath
+	s.value, s.valuePath = n.value, n.valuePath
+	s.counts = n.counts
+	return nil
+}
+
+func lookupCounterState(ctx *core.Context, name data.Value) (*counterState, error) {
+	st, n, err := lookupState(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := st.(*counterState)
+	if !ok {
+		return nil, fmt.Errorf("state '%s' isn't a counter state", n)
+	}
+	return s, nil
+}
+
+// counterTotalFunc returns the sum of all counters of the counter state
+// having the given name.
+var counterTotalFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, name data.Value) (data.Value, error) {
+	s, err := lookupCounterState(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	n, err := s.total()
+	if err != nil {
+		return nil, err
+	}
+	return data.Int(n), nil
+})
+
+// counterKeyFunc returns the counter of the given key of the counter
+// state having the given name.
+var counterKeyFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, name, key data.Value) (data.Value, error) {
+	s, err := lookupCounterState(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	n, _, err := s.get(key)
+	if err != nil {
+		return nil, err
+	}
+	return data.Int(n), nil
+})
+
+// counterGetFunc returns the counter of the given key of the counter
+// state having the given name, or 0 if the state doesn't have the key.
+// When the key is omitted, it returns the sum of all counters.
+//
+// It can be used in BQL as `counter_get`.
+//
+//  Input: String, [any]
+//  Return Type: Int
+var counterGetFunc udf.UDF = &arityDispatcher{
+	unary:  counterTotalFunc,
+	binary: counterKeyFunc,
+}
@@ -0,0 +1,134 @@
+package builtin
+
+import (
+	"bytes"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestCounterState(t *testing.T) {
+	ctx := core.NewContext(nil)
+
+	Convey("Given a counter state without parameters", t, func() {
+		st, err := createCounterState(ctx, data.Map{})
+		So(err, ShouldBeNil)
+		So(ctx.SharedStates.Add("cnt", "counter", st), ShouldBeNil)
+		Reset(func() {
+			ctx.SharedStates.Remove("cnt")
+		})
+		s := st.(*counterState)
+
+		Convey("When writing tuples", func() {
+			for i := 0; i < 3; i++ {
+				So(s.Write(ctx, core.NewTuple(data.Map{"a": data.Int(i)})), ShouldBeNil)
+			}
+
+			Convey("Then counter_get should return the number of tuples", func() {
+				v, err := counterGetFunc.Call(ctx, data.String("cnt"))
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, data.Int(3))
+			})
+		})
+	})
+
+	Convey("Given a counter state with a key and a value", t, func() {
+		st, err := createCounterState(ctx, data.Map{
+			"key":   data.String("user"),
+			"value": data.String("bytes"),
+		})
+		So(err, ShouldBeNil)
+		So(ctx.SharedStates.Add("cnt", "counter", st), ShouldBeNil)
+		Reset(func() {
+			ctx.SharedStates.Remove("cnt")
+		})
+		s := st.(*counterState)
+
+		Convey("When writing tuples", func() {
+			for _, m := range []data.Map{
+				{"user": data.String("a"), "bytes": data.Int(10)},
+				{"user": data.String("b"), "bytes": data.Int(5)},
+				{"user": data.String("a"), "bytes": data.Int(7)},
+				{"user": data.String("b"), "bytes": data.Null{}},
+			} {
+				So(s.Write(ctx, core.NewTuple(m)), ShouldBeNil)
+			}
+
+			Convey("Then counter_get should return the counter of each key", func() {
+				v, err := counterGetFunc.Call(ctx, data.String("cnt"), data.String("a"))
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, data.Int(17))
+				v, err = counterGetFunc.Call(ctx, data.String("cnt"), data.String("c"))
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, data.Int(0))
+				v, err = counterGetFunc.Call(ctx, data.String("cnt"))
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, data.Int(22))
+			})
+
+			Convey("Then the counters should be looked up by keys", func() {
+				m, err := s.Lookup(ctx, data.String("b"))
+				So(err, ShouldBeNil)
+				So(m, ShouldResemble, data.Map{"key": data.String("b"), "count": data.Int(5)})
+				_, err = s.Lookup(ctx, data.String("c"))
+				So(core.IsNotExist(err), ShouldBeTrue)
+			})
+
+			Convey("Then a state loaded from the saved data should have the same counters", func() {
+				buf := bytes.NewBuffer(nil)
+				So(s.Save(ctx, buf, data.Map{}), ShouldBeNil)
+				l, err := counterStateCreator.(udf.UDSLoader).LoadState(ctx, buf, data.Map{})
+				So(err, ShouldBeNil)
+				loaded := l.(*counterState)
+				n, _, err := loaded.get(data.String("a"))
+				So(err, ShouldBeNil)
+				So(n, ShouldEqual, 17)
+				n, err = loaded.total()
+				So(err, ShouldBeNil)
+				So(n, ShouldEqual, 22)
+				So(loaded.Write(ctx, core.NewTuple(data.Map{"user": data.String("c"), "bytes": data.Int(1)})), ShouldBeNil)
+			})
+		})
+
+		Convey("When writing a tuple having a value which isn't an integer", func() {
+			err := s.Write(ctx, core.NewTuple(data.Map{"user": data.String("a"), "bytes": data.Float(1)}))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When writing a tuple having a null key", func() {
+			err := s.Write(ctx, core.NewTuple(data.Map{"user": data.Null{}, "bytes": data.Int(1)}))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When terminating it", func() {
+			So(s.Terminate(ctx), ShouldBeNil)
+
+			Convey("Then it should no longer be written or read", func() {
+				So(s.Write(ctx, core.NewTuple(data.Map{"user": data.String("a"), "bytes": data.Int(1)})), ShouldNotBeNil)
+				_, err := counterGetFunc.Call(ctx, data.String("cnt"))
+				So(err, ShouldNotBeNil)
+				So(s.Save(ctx, bytes.NewBuffer(nil), data.Map{}), ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given invalid parameters for a counter state", t, func() {
+		for _, params := range []data.Map{
+			{"key": data.Int(1)},
+			{"value": data.String("a..b[")},
+		} {
+			Convey("Then creating the state should fail with "+params.String(), func() {
+				_, err := createCounterState(ctx, params)
+				So(err, ShouldNotBeNil)
+			})
+		}
+	})
+}
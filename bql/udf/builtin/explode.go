@@ -0,0 +1,91 @@
+package builtin
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// explodeUDSF emits one tuple per element of an array field of each
+// input tuple. An emitted tuple is a copy of the input tuple where the
+// array is replaced with the element. When the name of an ordinality
+// field is given, the 1-based position of the element is written to
+// that field. A tuple whose array is NULL doesn't emit anything.
+//
+// It can be used in BQL as `explode`:
+//
+//	SELECT RSTREAM * FROM explode("sensors", "readings") [RANGE 1 TUPLES];
+//	SELECT RSTREAM * FROM explode("sensors", "readings", "idx") [RANGE 1 TUPLES];
+type explodeUDSF struct {
+	path       data.Path
+	ordinality data.Path
+}
+
+func createExplodeUDSF(decl udf.UDSFDeclarer, stream string, path string,
+	ordinality ...string) (udf.UDSF, error) {
+	if len(ordinality) > 1 {
+		return nil, fmt.Errorf("explode takes at most 3 arguments")
+	}
+	p, err := data.CompilePath(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid path of the array: %v", err)
+	}
+	e := &explodeUDSF{
+		path: p,
+	}
+	if len(ordinality) == 1 {
+		o, err := data.CompilePath(ordinality[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid path of the ordinality: %v", err)
+		}
+		e.ordinality = o
+	}
+
+	if err := decl.Input(stream, &udf.UDSFInputConfig{
+		InputName: "explode",
+	}); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (e *explodeUDSF) Process(ctx *core.Context, t *core.Tuple, w core.Writer) error {
+	v, err := t.Data.Get(e.path)
+	if err != nil {
+		return err
+	}
+	if v.Type() == data.TypeNull {
+		return nil
+	}
+	arr, err := data.AsArray(v)
+	if err != nil {
+		return err
+	}
+
+	// the array is removed from the template so that it isn't copied
+	// for each element
+	tmpl := t.Copy()
+	if err := tmpl.Data.Set(e.path, data.Null{}); err != nil {
+		return err
+	}
+	for i, elem := range arr {
+		out := tmpl.Copy()
+		if err := out.Data.Set(e.path, elem); err != nil {
+			return err
+		}
+		if e.ordinality != nil {
+			if err := out.Data.Set(e.ordinality, data.Int(i+1)); err != nil {
+				return err
+			}
+		}
+		if err := w.Write(ctx, out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *explodeUDSF) Terminate(ctx *core.Context) error {
+	return nil
+}
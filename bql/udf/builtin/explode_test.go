@@ -0,0 +1,95 @@
+package builtin
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestExplodeUDSF(t *testing.T) {
+	ctx := core.NewContext(nil)
+
+	Convey("Given the explode UDSF in a UDSF registry", t, func() {
+		r, err := udf.CopyGlobalUDSFCreatorRegistry()
+		So(err, ShouldBeNil)
+
+		c, err := r.Lookup("explode", 2)
+		So(err, ShouldBeNil)
+
+		var out []*core.Tuple
+		w := core.WriterFunc(func(ctx *core.Context, t *core.Tuple) error {
+			out = append(out, t)
+			return nil
+		})
+
+		Convey("When creating it without an ordinality", func() {
+			decl := udf.NewUDSFDeclarer()
+			f, err := c.CreateUDSF(ctx, decl, data.String("sensors"), data.String("readings"))
+			So(err, ShouldBeNil)
+
+			Convey("Then the declarer should have the input", func() {
+				So(decl.ListInputs(), ShouldContainKey, "sensors")
+			})
+
+			Convey("Then it should emit a tuple for each element", func() {
+				in := core.NewTuple(data.Map{
+					"id":       data.Int(1),
+					"readings": data.Array{data.Int(3), data.Int(4)},
+				})
+				So(f.Process(ctx, in, w), ShouldBeNil)
+				So(out, ShouldHaveLength, 2)
+				So(out[0].Data, ShouldResemble, data.Map{"id": data.Int(1), "readings": data.Int(3)})
+				So(out[1].Data, ShouldResemble, data.Map{"id": data.Int(1), "readings": data.Int(4)})
+				So(out[0].Timestamp, ShouldResemble, in.Timestamp)
+
+				Convey("And the input tuple should not be modified", func() {
+					So(in.Data["readings"], ShouldResemble, data.Array{data.Int(3), data.Int(4)})
+				})
+			})
+
+			Convey("Then it should not emit anything for NULL or an empty array", func() {
+				So(f.Process(ctx, core.NewTuple(data.Map{"readings": data.Null{}}), w), ShouldBeNil)
+				So(f.Process(ctx, core.NewTuple(data.Map{"readings": data.Array{}}), w), ShouldBeNil)
+				So(out, ShouldBeEmpty)
+			})
+
+			Convey("Then it should fail if the field isn't an array", func() {
+				So(f.Process(ctx, core.NewTuple(data.Map{"readings": data.Int(1)}), w), ShouldNotBeNil)
+				So(f.Process(ctx, core.NewTuple(data.Map{"id": data.Int(1)}), w), ShouldNotBeNil)
+			})
+		})
+
+		Convey("When creating it with an ordinality", func() {
+			f, err := c.CreateUDSF(ctx, udf.NewUDSFDeclarer(), data.String("sensors"),
+				data.String("a.readings"), data.String("idx"))
+			So(err, ShouldBeNil)
+
+			Convey("Then it should emit the positions of the elements", func() {
+				in := core.NewTuple(data.Map{
+					"a": data.Map{"readings": data.Array{data.String("x"), data.String("y")}},
+				})
+				So(f.Process(ctx, in, w), ShouldBeNil)
+				So(out, ShouldHaveLength, 2)
+				So(out[0].Data, ShouldResemble, data.Map{
+					"a":   data.Map{"readings": data.String("x")},
+					"idx": data.Int(1),
+				})
+				So(out[1].Data, ShouldResemble, data.Map{
+					"a":   data.Map{"readings": data.String("y")},
+					"idx": data.Int(2),
+				})
+			})
+		})
+
+		Convey("When creating it with too many arguments", func() {
+			_, err := c.CreateUDSF(ctx, udf.NewUDSFDeclarer(), data.String("sensors"),
+				data.String("readings"), data.String("idx"), data.String("x"))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -51,10 +51,19 @@ func init() {
 	udf.RegisterGlobalUDF("octet_length", octetLengthFunc)
 	udf.RegisterGlobalUDF("overlay", &arityDispatcher{
 		ternary: overlayFunc, quaternary: overlayFunc})
+	udf.RegisterGlobalUDF("parse_csv_line", parseCSVLineFunc)
+	udf.RegisterGlobalUDF("parse_kv", parseKVFunc)
+	udf.RegisterGlobalUDF("parse_query", parseQueryFunc)
+	udf.RegisterGlobalUDF("parse_url", parseURLFunc)
+	udf.RegisterGlobalUDF("regexp_match", regexpMatchFunc)
+	udf.RegisterGlobalUDF("regexp_matches", regexpMatchesFunc)
+	udf.RegisterGlobalUDF("regexp_replace", regexpReplaceFunc)
 	udf.RegisterGlobalUDF("rtrim", &arityDispatcher{
 		unary: rtrimSpaceFunc, binary: rtrimFunc})
 	udf.RegisterGlobalUDF("sha1", sha1Func)
 	udf.RegisterGlobalUDF("sha256", sha256Func)
+	udf.RegisterGlobalUDF("split_part", splitPartFunc)
+	udf.RegisterGlobalUDF("string_to_array", stringToArrayFunc)
 	udf.RegisterGlobalUDF("strpos", strposFunc)
 	udf.RegisterGlobalUDF("substring", &arityDispatcher{
 		binary: substringFunc, ternary: substringFunc})
@@ -64,8 +73,27 @@ func init() {
 	// time functions
 	udf.RegisterGlobalUDF("distance_us", diffUsFunc)
 	udf.RegisterGlobalUDF("clock_timestamp", clockTimestampFunc)
+	udf.RegisterGlobalUDF("date_bin", dateBinFunc)
+	udf.RegisterGlobalUDF("date_part", datePartFunc)
+	udf.RegisterGlobalUDF("date_trunc", dateTruncFunc)
+	udf.RegisterGlobalUDF("extract", datePartFunc)
+	udf.RegisterGlobalUDF("strftime", strftimeFunc)
+	udf.RegisterGlobalUDF("strptime", strptimeFunc)
 	// array functions
+	udf.RegisterGlobalUDF("array_append", arrayAppendFunc)
+	udf.RegisterGlobalUDF("array_concat", arrayConcatFunc)
+	udf.RegisterGlobalUDF("array_contains", arrayContainsFunc)
+	udf.RegisterGlobalUDF("array_distinct", arrayDistinctFunc)
 	udf.RegisterGlobalUDF("array_length", arrayLengthFunc)
+	udf.RegisterGlobalUDF("array_position", arrayPositionFunc)
+	udf.RegisterGlobalUDF("array_reverse", arrayReverseFunc)
+	udf.RegisterGlobalUDF("array_slice", arraySliceFunc)
+	udf.RegisterGlobalUDF("array_sort", arraySortFunc)
+	// map functions
+	udf.RegisterGlobalUDF("map_keys", mapKeysFunc)
+	udf.RegisterGlobalUDF("map_merge", mapMergeFunc)
+	udf.RegisterGlobalUDF("map_remove", mapRemoveFunc)
+	udf.RegisterGlobalUDF("map_values", mapValuesFunc)
 	// aggregate functions
 	udf.RegisterGlobalUDF("array_agg", arrayAggFunc)
 	udf.RegisterGlobalUDF("avg", avgFunc)
@@ -78,8 +106,56 @@ func init() {
 	udf.RegisterGlobalUDF("min", minFunc)
 	udf.RegisterGlobalUDF("string_agg", stringAggFunc)
 	udf.RegisterGlobalUDF("sum", sumFunc)
+	// statistical aggregate functions
+	udf.RegisterGlobalUDF("corr", corrFunc)
+	udf.RegisterGlobalUDF("covar_pop", covarPopFunc)
+	udf.RegisterGlobalUDF("covar_samp", covarSampFunc)
+	udf.RegisterGlobalUDF("percentile_cont", percentileContFunc)
+	udf.RegisterGlobalUDF("percentile_disc", percentileDiscFunc)
+	udf.RegisterGlobalUDF("stddev", stddevSampFunc)
+	udf.RegisterGlobalUDF("stddev_pop", stddevPopFunc)
+	udf.RegisterGlobalUDF("stddev_samp", stddevSampFunc)
+	udf.RegisterGlobalUDF("var_pop", varPopFunc)
+	udf.RegisterGlobalUDF("var_samp", varSampFunc)
+	udf.RegisterGlobalUDF("variance", varSampFunc)
+	// sketch functions
+	udf.RegisterGlobalUDF("approx_count_distinct", approxCountDistinctFunc)
+	udf.RegisterGlobalUDF("approx_top_k", approxTopKFunc)
+	udf.RegisterGlobalUDF("hll_estimate", hllEstimateFunc)
+	udf.RegisterGlobalUDF("hll_merge", hllMergeFunc)
+	udf.RegisterGlobalUDF("hll_sketch", hllSketchFunc)
+	udf.RegisterGlobalUDF("sketch_state", sketchStateFunc)
+	udf.RegisterGlobalUDF("top_k_estimate", topKEstimateFunc)
+	udf.RegisterGlobalUDF("top_k_merge", topKMergeFunc)
+	udf.RegisterGlobalUDF("top_k_sketch", topKSketchFunc)
+	// machine learning functions
+	udf.RegisterGlobalUDF("kmeans_centroids", kmeansCentroidsFunc)
+	udf.RegisterGlobalUDF("kmeans_distance", kmeansDistanceFunc)
+	udf.RegisterGlobalUDF("kmeans_predict", kmeansPredictFunc)
+	udf.RegisterGlobalUDF("linear_regression_predict", linearRegressionPredictFunc)
+	udf.RegisterGlobalUDF("pa_classifier_predict", paClassifierPredictFunc)
+	udf.RegisterGlobalUDF("pa_classifier_scores", paClassifierScoresFunc)
+	// state functions
+	udf.RegisterGlobalUDF("bloom_filter_contains", bloomFilterContainsFunc)
+	udf.RegisterGlobalUDF("counter_get", counterGetFunc)
+	udf.RegisterGlobalUDF("kv_get", kvGetFunc)
+	udf.RegisterGlobalUDF("kv_has", kvHasFunc)
+	udf.RegisterGlobalUDF("kv_size", kvSizeFunc)
+	udf.RegisterGlobalUDF("ring_buffer_len", ringBufferLenFunc)
+	udf.RegisterGlobalUDF("ring_buffer_values", ringBufferValuesFunc)
 	// conversion functions
 	udf.RegisterGlobalUDF("blob_to_raw_string", udf.MustConvertGeneric(blobToRawString))
 	// other functions
 	udf.RegisterGlobalUDF("coalesce", coalesceFunc)
+	// stream-generating functions
+	udf.MustRegisterGlobalUDSFCreator("explode", udf.MustConvertToUDSFCreator(createExplodeUDSF))
+	// states
+	udf.MustRegisterGlobalUDSCreator("bloom_filter", bloomFilterStateCreator)
+	udf.MustRegisterGlobalUDSCreator("counter", counterStateCreator)
+	udf.MustRegisterGlobalUDSCreator("kmeans", kmeansStateCreator)
+	udf.MustRegisterGlobalUDSCreator("kv", kvStateCreator)
+	udf.MustRegisterGlobalUDSCreator("linear_regression", linearRegressionStateCreator)
+	udf.MustRegisterGlobalUDSCreator("pa_classifier", paClassifierStateCreator)
+	udf.MustRegisterGlobalUDSCreator("ring_buffer", ringBufferStateCreator)
+	udf.MustRegisterGlobalUDSCreator("sketch", sketchStateCreator)
 }
@@ -0,0 +1,351 @@
+package builtin
+
+import (
+	"errors"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io"
+	"math"
+	"sync"
+)
+
+// kmeansState clusters feature vectors written to it by sequential
+// k-means. The first k distinct feature vectors become the initial
+// centroids. After that, each feature vector moves its nearest centroid
+// toward it by the inverse of the centroid's weight, i.e. the number of
+// feature vectors assigned to it. When decay is less than 1, the weights
+// of all centroids are multiplied by it for each feature vector so that
+// centroids keep following recent data.
+//
+// The distance from a feature vector to its nearest centroid, which is
+// returned by kmeans_distance, can be used as an anomaly score.
+//
+// It can be created in BQL as `kmeans` with the following parameters:
+//
+//	k: the number of clusters (required)
+//	feature_vector_field: the path of the field having the feature vector
+//	                      (default: "feature_vector")
+//	decay: the factor of the weights of centroids for each feature vector
+//	       (default: 1.0)
+//
+// For example:
+//
+//	CREATE STATE load_clusters TYPE kmeans WITH k = 5, decay = 0.999;
+//	CREATE SINK load_clusters_sink TYPE uds WITH name = "load_clusters";
+//	INSERT INTO load_clusters_sink FROM server_load;
+type kmeansState struct {
+	k       int
+	fvField string
+	fvPath  data.Path
+	decay   float64
+
+	m sync.RWMutex
+	// centroids is nil after the state is terminated.
+	centroids []kmeansCentroid
+}
+
+type kmeansCentroid struct {
+	center featureVector
+	weight float64
+}
+
+var (
+	_ core.LoadableSharedState = &kmeansState{}
+	_ core.Writer              = &kmeansState{}
+)
+
+var kmeansStateCreator udf.UDSCreator = &stateCreator{
+	typeName: "kmeans",
+	create:   createKMeansState,
+	restore:  restoreKMeansState,
+}
+
+func createKMeansState(ctx *core.Context, params data.Map) (core.SharedState, error) {
+	v, ok := params["k"]
+	if !ok {
+		return nil, errors.New("'k' parameter is missing")
+	}
+	k, err := data.AsInt(v)
+	if err != nil {
+		return nil, fmt.Errorf("'k' parameter must be an integer: %v", err)
+	}
+	if k <= 0 {
+		return nil, fmt.Errorf("'k' parameter must be greater than 0: %v", k)
+	}
+	fv, fvPath, err := pathParamOrDefault(params, "feature_vector_field", "feature_vector")
+	if err != nil {
+		return nil, err
+	}
+	decay, err := floatParam(params, "decay", 1)
+	if err != nil {
+		return nil, err
+	}
+	if decay <= 0 || decay > 1 {
+		return nil, fmt.Errorf("'decay' parameter must be greater than 0 and at most 1: %v", decay)
+	}
+	return &kmeansState{
+		k:         int(k),
+		fvField:   fv,
+		fvPath:    fvPath,
+		decay:     decay,
+		centroids: []kmeansCentroid{},
+	}, nil
+}
+
+func restoreKMeansState(d data.Map) (core.SharedState, error) {
+	params := []string{"k", "feature_vector_field", "decay"}
+	if !hasKeys(d, append(params, "centroids")...) {
+		return nil, errors.New("the saved kmeans state doesn't have required fields")
+	}
+	p := data.Map{}
+	for _, k := range params {
+		p[k] = d[k]
+	}
+	st, err := createKMeansState(nil, p)
+	if err != nil {
+		return nil, fmt.Errorf("the saved kmeans state doesn't have valid parameters: %v", err)
+	}
+	s := st.(*kmeansState)
+	centroids, err := data.AsArray(d["centroids"])
+	if err != nil || len(centroids) > s.k {
+		return nil, fmt.Errorf("the saved kmeans state doesn't have valid centroids: %v", d["centroids"])
+	}
+	for _, c := range centroids {
+		m, err := data.AsMap(c)
+		if err != nil || !hasKeys(m, "center", "weight") {
+			return nil, fmt.Errorf("the saved kmeans state doesn't have a valid centroid: %v", c)
+		}
+		center, err := asFeatureVector(m["center"])
+		if err != nil {
+			return nil, fmt.Errorf("the saved kmeans state doesn't have a valid centroid: %v", err)
+		}
+		weight, err := data.AsFloat(m["weight"])
+		if err != nil {
+			return nil, fmt.Errorf("the saved kmeans state doesn't have a valid centroid: %v", err)
+		}
+		s.centroids = append(s.centroids, kmeansCentroid{center, weight})
+	}
+	return s, nil
+}
+
+func (s *kmeansState) Terminate(ctx *core.Context) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.centroids = nil
+	return nil
+}
+
+// Write updates the centroids with the feature vector of the tuple. A
+// tuple having a NULL feature vector is ignored.
+func (s *kmeansState) Write(ctx *core.Context, t *core.Tuple) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.centroids == nil {
+		return errors.New("the state is already terminated")
+	}
+	v, err := t.Data.Get(s.fvPath)
+	if err != nil {
+		return err
+	}
+	if v.Type() == data.TypeNull {
+		return nil
+	}
+	x, err := asFeatureVector(v)
+	if err != nil {
+		return err
+	}
+
+	i, dist := s.nearest(x)
+	if len(s.centroids) < s.k && (i < 0 || dist > 0) {
+		s.centroids = append(s.centroids, kmeansCentroid{x, 1})
+		return nil
+	}
+	if s.decay < 1 {
+		for j := range s.centroids {
+			s.centroids[j].weight *= s.decay
+		}
+	}
+	c := &s.centroids[i]
+	c.weight++
+	// move the center toward x by 1/weight
+	a := 1 / c.weight
+	c.center.scale(1 - a)
+	c.center.addScaled(x, a)
+	return nil
+}
+
+// nearest returns the index of the centroid nearest to the feature
+// vector and the squared distance to it. It returns -1 if there's no
+// centroid. The caller must hold the lock.
+func (s *kmeansState) nearest(x featureVector) (int, float64) {
+	idx, best := -1, math.Inf(1)
+	for i, c := range s.centroids {
+		if d := x.squaredDistance(c.center); d < best {
+			idx, best = i, d
+		}
+	}
+	return idx, best
+}
+
+// assign returns the index of the centroid nearest to the feature vector
+// and the distance to it. It returns -1 if there's no centroid.
+func (s *kmeansState) assign(x featureVector) (int, float64, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	if s.centroids == nil {
+		return 0, 0, errors.New("the state is already terminated")
+	}
+	i, d := s.nearest(x)
+	return i, math.Sqrt(d), nil
+}
+
+func (s *kmeansState) centroidsValue() (data.Array, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	if s.centroids == nil {
+		return nil, errors.New("the state is already terminated")
+	}
+	return s.centroidsArray(), nil
+}
+
+// centroidsArray returns the centroids as an Array of Maps. The caller
+// must hold the lock.
+func (s *kmeansState) centroidsArray() data.Array {
+	a := make(data.Array, len(s.centroids))
+	for i, c := range s.centroids {
+		a[i] = data.Map{
+			"center": c.center.toMap(),
+			"weight": data.Float(c.weight),
+		}
+	}
+	return a
+}
+
+// Save writes the parameters and the centroids of the state.
+func (s *kmeansState) Save(ctx *core.Context, w io.Writer, params data.Map) error {
+	s.m.RLock()
+	if s.centroids == nil {
+		s.m.RUnlock()
+		return errors.New("the state is already terminated")
+	}
+	d := data.Map{
+		"k":                    data.Int(s.k),
+		"feature_vector_field": data.String(s.fvField),
+		"decay":                data.Float(s.decay),
+		"centroids":            s.centroidsArray(),
+	}
+	s.m.RUnlock()
+
+	return saveStateData(w, "kmeans", d)
+}
+
+// Load replaces the parameters and the centroids of the state with the
+// saved ones.
+func (s *kmeansState) Load(ctx *core.Context, r io.Reader, params data.Map) error {
+	d, err := loadStateData(r, "kmeans")
+	if err != nil {
+		return err
+	}
+	st, err := restoreKMeansState(d)
+	if err != nil {
+		return err
+	}
+	n := st.(*kmeansState)
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.centroids == nil {
+		return errors.New("the state is already terminated")
+	}
+	s.k, s.fvField, s.fvPath, s.decay = n.k, n.fvField, n.fvPath, n.decay
+	s.centroids = n.centroids
+	return nil
+}
+
+func lookupKMeansState(ctx *core.Context, name data.Value) (*kmeansState, error) {
+	st, n, err := lookupState(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := st.(*kmeansState)
+	if !ok {
+		return nil, fmt.Errorf("state '%s' isn't a kmeans state", n)
+	}
+	return s, nil
+}
+
+// kmeansAssign returns the index of the nearest centroid of the kmeans
+// state having the given name and the distance to it. It returns -1 if
+// the state has no centroid or the feature vector is NULL.
+func kmeansAssign(ctx *core.Context, name, fv data.Value) (int, float64, error) {
+	s, err := lookupKMeansState(ctx, name)
+	if err != nil {
+		return 0, 0, err
+	}
+	if fv.Type() == data.TypeNull {
+		return -1, 0, nil
+	}
+	x, err := asFeatureVector(fv)
+	if err != nil {
+		return 0, 0, err
+	}
+	return s.assign(x)
+}
+
+// kmeansPredictFunc returns the index of the centroid nearest to the
+// given feature vector in the kmeans state having the given name. It
+// returns Null if the state has no centroid yet or the feature vector is
+// NULL.
+//
+// It can be used in BQL as `kmeans_predict`.
+//
+//  Input: String, Map
+//  Return Type: Int
+var kmeansPredictFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, name, fv data.Value) (data.Value, error) {
+	i, _, err := kmeansAssign(ctx, name, fv)
+	if err != nil {
+		return nil, err
+	}
+	if i < 0 {
+		return data.Null{}, nil
+	}
+	return data.Int(i), nil
+})
+
+// kmeansDistanceFunc returns the Euclidean distance from the given
+// feature vector to the nearest centroid in the kmeans state having the
+// given name. It returns Null if the state has no centroid yet or the
+// feature vector is NULL.
+//
+// It can be used in BQL as `kmeans_distance`.
+//
+//  Input: String, Map
+//  Return Type: Float
+var kmeansDistanceFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, name, fv data.Value) (data.Value, error) {
+	i, d, err := kmeansAssign(ctx, name, fv)
+	if err != nil {
+		return nil, err
+	}
+	if i < 0 {
+		return data.Null{}, nil
+	}
+	return data.Float(d), nil
+})
+
+// kmeansCentroidsFunc returns the centroids of the kmeans state having
+// the given name. Each centroid is a Map having "center", the feature
+// vector of the centroid, and "weight".
+//
+// It can be used in BQL as `kmeans_centroids`.
+//
+//  Input: String
+//  Return Type: Array
+var kmeansCentroidsFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, name data.Value) (data.Value, error) {
+	s, err := lookupKMeansState(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return s.centroidsValue()
+})
@@ -0,0 +1,134 @@
+package builtin
+
+import (
+	"bytes"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestKMeansState(t *testing.T) {
+	ctx := core.NewContext(nil)
+	point := func(x, y float64) data.Map {
+		return data.Map{"x": data.Float(x), "y": data.Float(y)}
+	}
+
+	Convey("Given a kmeans state", t, func() {
+		st, err := createKMeansState(ctx, data.Map{"k": data.Int(2)})
+		So(err, ShouldBeNil)
+		So(ctx.SharedStates.Add("clusters", "kmeans", st), ShouldBeNil)
+		Reset(func() {
+			ctx.SharedStates.Remove("clusters")
+		})
+		s := st.(*kmeansState)
+
+		call := func(f udf.UDF, args ...data.Value) data.Value {
+			v, err := f.Call(ctx, append([]data.Value{data.String("clusters")}, args...)...)
+			So(err, ShouldBeNil)
+			return v
+		}
+
+		Convey("When nothing is written", func() {
+			Convey("Then kmeans_predict and kmeans_distance should return null", func() {
+				So(call(kmeansPredictFunc, point(0, 0)), ShouldResemble, data.Null{})
+				So(call(kmeansDistanceFunc, point(0, 0)), ShouldResemble, data.Null{})
+				So(call(kmeansCentroidsFunc), ShouldResemble, data.Array{})
+			})
+		})
+
+		Convey("When writing points of two clusters", func() {
+			// the same point is written first twice so that it doesn't
+			// become two centroids
+			for _, p := range []data.Map{point(0, 0), point(0, 0), point(10, 10),
+				point(1, 0), point(0, 1), point(9, 10), point(10, 9), point(1, 1), point(11, 11)} {
+				So(s.Write(ctx, core.NewTuple(data.Map{"feature_vector": p})), ShouldBeNil)
+			}
+			So(s.Write(ctx, core.NewTuple(data.Map{"feature_vector": data.Null{}})), ShouldBeNil)
+
+			Convey("Then the centroids should be the means of the clusters", func() {
+				c, err := data.AsArray(call(kmeansCentroidsFunc))
+				So(err, ShouldBeNil)
+				So(len(c), ShouldEqual, 2)
+				first, _ := data.AsMap(c[0])
+				center, err := asFeatureVector(first["center"])
+				So(err, ShouldBeNil)
+				So(center["x"], ShouldAlmostEqual, 0.4, 1e-9)
+				So(center["y"], ShouldAlmostEqual, 0.4, 1e-9)
+				So(first["weight"], ShouldEqual, data.Float(5))
+				second, _ := data.AsMap(c[1])
+				center, err = asFeatureVector(second["center"])
+				So(err, ShouldBeNil)
+				So(center["x"], ShouldAlmostEqual, 10, 1e-9)
+				So(center["y"], ShouldAlmostEqual, 10, 1e-9)
+			})
+
+			Convey("Then kmeans_predict should return the nearest cluster", func() {
+				So(call(kmeansPredictFunc, point(2, -1)), ShouldEqual, data.Int(0))
+				So(call(kmeansPredictFunc, point(8, 12)), ShouldEqual, data.Int(1))
+			})
+
+			Convey("Then kmeans_distance should return the distance to the nearest centroid", func() {
+				d, err := data.AsFloat(call(kmeansDistanceFunc, point(13, 14)))
+				So(err, ShouldBeNil)
+				So(d, ShouldAlmostEqual, 5, 1e-9)
+			})
+
+			Convey("Then a state loaded from the saved data should have the same centroids", func() {
+				buf := bytes.NewBuffer(nil)
+				So(s.Save(ctx, buf, data.Map{}), ShouldBeNil)
+				l, err := kmeansStateCreator.(udf.UDSLoader).LoadState(ctx, buf, data.Map{})
+				So(err, ShouldBeNil)
+				loaded := l.(*kmeansState)
+				expected, err := s.centroidsValue()
+				So(err, ShouldBeNil)
+				actual, err := loaded.centroidsValue()
+				So(err, ShouldBeNil)
+				So(actual, ShouldResemble, expected)
+			})
+		})
+
+		Convey("When writing a feature vector which isn't a map", func() {
+			err := s.Write(ctx, core.NewTuple(data.Map{"feature_vector": data.Int(1)}))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a kmeans state with decay", t, func() {
+		st, err := createKMeansState(ctx, data.Map{
+			"k":     data.Int(1),
+			"decay": data.Float(0.5),
+		})
+		So(err, ShouldBeNil)
+		s := st.(*kmeansState)
+
+		Convey("When writing points moving away", func() {
+			for i := 0; i < 20; i++ {
+				So(s.Write(ctx, core.NewTuple(data.Map{"feature_vector": point(float64(i), 0)})), ShouldBeNil)
+			}
+
+			Convey("Then the centroid should follow recent points", func() {
+				So(s.centroids[0].center["x"], ShouldBeGreaterThan, 17)
+				So(s.centroids[0].weight, ShouldBeLessThan, 2)
+			})
+		})
+	})
+
+	Convey("Given invalid parameters for a kmeans state", t, func() {
+		for _, params := range []data.Map{
+			{},
+			{"k": data.Int(0)},
+			{"k": data.Int(2), "decay": data.Float(1.5)},
+			{"k": data.Int(2), "feature_vector_field": data.Int(1)},
+		} {
+			Convey("Then creating the state should fail with "+params.String(), func() {
+				_, err := createKMeansState(ctx, params)
+				So(err, ShouldNotBeNil)
+			})
+		}
+	})
+}
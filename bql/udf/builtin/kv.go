@@ -0,0 +1,267 @@
+package builtin
+
+import (
+	"errors"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io"
+	"sync"
+)
+
+// kvState is an in-memory key/value table. Each tuple written to the
+// state is stored with the value of its key field as the key, replacing
+// the entry having the same key if any. Entries can be joined with
+// streams by JOIN STATE clauses or obtained by kv_get.
+//
+// It can be created in BQL as `kv` with the following parameters:
+//
+//	key: the path of the field used as the key of a tuple (required)
+//
+// For example:
+//
+//	CREATE STATE users TYPE kv WITH key = "user_id";
+//	CREATE SINK users_sink TYPE uds WITH name = "users";
+//	INSERT INTO users_sink FROM user_updates;
+type kvState struct {
+	key     string
+	keyPath data.Path
+
+	m sync.RWMutex
+	// entries holds the entries having the same hash value of their
+	// keys. It is nil after the state is terminated.
+	entries map[data.HashValue][]kvEntry
+	size    int
+}
+
+type kvEntry struct {
+	key   data.Value
+	value data.Map
+}
+
+var (
+	_ core.LookupableSharedState = &kvState{}
+	_ core.LoadableSharedState   = &kvState{}
+	_ core.Writer                = &kvState{}
+)
+
+var kvStateCreator udf.UDSCreator = &stateCreator{
+	typeName: "kv",
+	create:   createKVState,
+	restore:  restoreKVState,
+}
+
+func createKVState(ctx *core.Context, params data.Map) (core.SharedState, error) {
+	key, path, err := pathParam(params, "key", true)
+	if err != nil {
+		return nil, err
+	}
+	return &kvState{
+		key:     key,
+		keyPath: path,
+		entries: map[data.HashValue][]kvEntry{},
+	}, nil
+}
+
+func restoreKVState(d data.Map) (core.SharedState, error) {
+	st, err := createKVState(nil, data.Map{"key": d["key"]})
+	if err != nil {
+		return nil, fmt.Errorf("the saved kv state doesn't have a valid key: %v", err)
+	}
+	s := st.(*kvState)
+	entries, err := data.AsArray(d["entries"])
+	if err != nil {
+		return nil, fmt.Errorf("the saved kv state doesn't have valid entries: %v", err)
+	}
+	for _, e := range entries {
+		m, err := data.AsMap(e)
+		if err != nil {
+			return nil, fmt.Errorf("the saved kv state doesn't have valid entries: %v", err)
+		}
+		if err := s.put(m); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func (s *kvState) Terminate(ctx *core.Context) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.entries = nil
+	s.size = 0
+	return nil
+}
+
+// Write stores the tuple's data with the value of its key field. It
+// fails if the tuple doesn't have the field or the value is NULL.
+func (s *kvState) Write(ctx *core.Context, t *core.Tuple) error {
+	return s.put(t.Data.Copy())
+}
+
+func (s *kvState) put(m data.Map) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.entries == nil {
+		return errors.New("the state is already terminated")
+	}
+	key, err := m.Get(s.keyPath)
+	if err != nil {
+		return err
+	}
+	if key.Type() == data.TypeNull {
+		return errors.New("the key of a kv state cannot be null")
+	}
+	entry := kvEntry{key, m}
+	h := data.Hash(key)
+	bucket := s.entries[h]
+	for i, e := range bucket {
+		if data.Equal(e.key, key) {
+			bucket[i] = entry
+			return nil
+		}
+	}
+	s.entries[h] = append(bucket, entry)
+	s.size++
+	return nil
+}
+
+func (s *kvState) Lookup(ctx *core.Context, key data.Value) (data.Map, error) {
+	h := data.Hash(key)
+
+	s.m.RLock()
+	defer s.m.RUnlock()
+	if s.entries == nil {
+		return nil, errors.New("the state is already terminated")
+	}
+	for _, e := range s.entries[h] {
+		if data.Equal(e.key, key) {
+			return e.value, nil
+		}
+	}
+	return nil, core.NotExistError(fmt.Errorf("the state doesn't have the key %v", key))
+}
+
+// Save writes the key parameter and all entries of the state.
+func (s *kvState) Save(ctx *core.Context, w io.Writer, params data.Map) error {
+	s.m.RLock()
+	if s.entries == nil {
+		s.m.RUnlock()
+		return errors.New("the state is already terminated")
+	}
+	key := s.key
+	entries := make(data.Array, 0, s.size)
+	for _, bucket := range s.entries {
+		for _, e := range bucket {
+			entries = append(entries, e.value)
+		}
+	}
+	s.m.RUnlock()
+
+	return saveStateData(w, "kv", data.Map{
+		"key":     data.String(key),
+		"entries": entries,
+	})
+}
+
+// Load replaces the key parameter and all entries of the state with the
+// saved ones.
+func (s *kvState) Load(ctx *core.Context, r io.Reader, params data.Map) error {
+	d, err := loadStateData(r, "kv")
+	if err != nil {
+		return err
+	}
+	st, err := restoreKVState(d)
+	if err != nil {
+		return err
+	}
+	n := st.(*kvState)
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.entries == nil {
+		return errors.New("the state is already terminated")
+	}
+	s.key, s.keyPath = n.key, n.keyPath
+	s.entries, s.size = n.entries, n.size
+	return nil
+}
+
+// len returns the number of entries in the state.
+func (s *kvState) len() int {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	return s.size
+}
+
+func lookupKVState(ctx *core.Context, name data.Value) (*kvState, error) {
+	st, n, err := lookupState(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := st.(*kvState)
+	if !ok {
+		return nil, fmt.Errorf("state '%s' isn't a kv state", n)
+	}
+	return s, nil
+}
+
+// kvGetFunc returns the entry having the given key in the kv state
+// having the given name. It returns Null if the state doesn't have the
+// key.
+//
+// It can be used in BQL as `kv_get`.
+//
+//  Input: String, any
+//  Return Type: Map
+var kvGetFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, name, key data.Value) (data.Value, error) {
+	s, err := lookupKVState(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	m, err := s.Lookup(ctx, key)
+	if err != nil {
+		if core.IsNotExist(err) {
+			return data.Null{}, nil
+		}
+		return nil, err
+	}
+	return m.Copy(), nil
+})
+
+// kvHasFunc returns true if the kv state having the given name has an
+// entry with the given key.
+//
+// It can be used in BQL as `kv_has`.
+//
+//  Input: String, any
+//  Return Type: Bool
+var kvHasFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, name, key data.Value) (data.Value, error) {
+	s, err := lookupKVState(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.Lookup(ctx, key); err != nil {
+		if core.IsNotExist(err) {
+			return data.False, nil
+		}
+		return nil, err
+	}
+	return data.True, nil
+})
+
+// kvSizeFunc returns the number of entries in the kv state having the
+// given name.
+//
+// It can be used in BQL as `kv_size`.
+//
+//  Input: String
+//  Return Type: Int
+var kvSizeFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, name data.Value) (data.Value, error) {
+	s, err := lookupKVState(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return data.Int(s.len()), nil
+})
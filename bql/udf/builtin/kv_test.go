@@ -0,0 +1,189 @@
+package builtin
+
+import (
+	"bytes"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestKVState(t *testing.T) {
+	ctx := core.NewContext(nil)
+
+	Convey("Given a kv state", t, func() {
+		s, err := createKVState(ctx, data.Map{"key": data.String("id")})
+		So(err, ShouldBeNil)
+		So(ctx.SharedStates.Add("users", "kv", s), ShouldBeNil)
+		Reset(func() {
+			ctx.SharedStates.Remove("users")
+		})
+		kv := s.(*kvState)
+
+		Convey("When writing tuples", func() {
+			So(kv.Write(ctx, core.NewTuple(data.Map{"id": data.Int(1), "name": data.String("a")})), ShouldBeNil)
+			So(kv.Write(ctx, core.NewTuple(data.Map{"id": data.String("1"), "name": data.String("b")})), ShouldBeNil)
+
+			Convey("Then they should be looked up by their keys", func() {
+				m, err := kv.Lookup(ctx, data.Int(1))
+				So(err, ShouldBeNil)
+				So(m, ShouldResemble, data.Map{"id": data.Int(1), "name": data.String("a")})
+
+				m, err = kv.Lookup(ctx, data.String("1"))
+				So(err, ShouldBeNil)
+				So(m["name"], ShouldEqual, data.String("b"))
+				So(kv.len(), ShouldEqual, 2)
+			})
+
+			Convey("Then a numerically equal key should match", func() {
+				m, err := kv.Lookup(ctx, data.Float(1))
+				So(err, ShouldBeNil)
+				So(m["name"], ShouldEqual, data.String("a"))
+			})
+
+			Convey("Then looking up a missing key should fail with a not exist error", func() {
+				_, err := kv.Lookup(ctx, data.Int(2))
+				So(core.IsNotExist(err), ShouldBeTrue)
+			})
+
+			Convey("Then writing the same key should replace the entry", func() {
+				So(kv.Write(ctx, core.NewTuple(data.Map{"id": data.Int(1), "name": data.String("c")})), ShouldBeNil)
+				m, err := kv.Lookup(ctx, data.Int(1))
+				So(err, ShouldBeNil)
+				So(m["name"], ShouldEqual, data.String("c"))
+				So(kv.len(), ShouldEqual, 2)
+			})
+
+			Convey("Then the stored entry should not be affected by the written tuple", func() {
+				t := core.NewTuple(data.Map{"id": data.Int(3), "name": data.String("d")})
+				So(kv.Write(ctx, t), ShouldBeNil)
+				t.Data["name"] = data.String("e")
+				m, err := kv.Lookup(ctx, data.Int(3))
+				So(err, ShouldBeNil)
+				So(m["name"], ShouldEqual, data.String("d"))
+			})
+		})
+
+		Convey("When accessing entries by functions", func() {
+			So(kv.Write(ctx, core.NewTuple(data.Map{"id": data.Int(1), "name": data.String("a")})), ShouldBeNil)
+
+			Convey("Then kv_get should return the entry or null", func() {
+				v, err := kvGetFunc.Call(ctx, data.String("users"), data.Int(1))
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.Map{"id": data.Int(1), "name": data.String("a")})
+
+				v, err = kvGetFunc.Call(ctx, data.String("users"), data.Int(2))
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.Null{})
+			})
+
+			Convey("Then modifying the result of kv_get should not affect the state", func() {
+				v, err := kvGetFunc.Call(ctx, data.String("users"), data.Int(1))
+				So(err, ShouldBeNil)
+				v.(data.Map)["name"] = data.String("b")
+				m, err := kv.Lookup(ctx, data.Int(1))
+				So(err, ShouldBeNil)
+				So(m["name"], ShouldEqual, data.String("a"))
+			})
+
+			Convey("Then kv_has and kv_size should reflect the entries", func() {
+				v, err := kvHasFunc.Call(ctx, data.String("users"), data.Int(1))
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, data.True)
+				v, err = kvHasFunc.Call(ctx, data.String("users"), data.Int(2))
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, data.False)
+				v, err = kvSizeFunc.Call(ctx, data.String("users"))
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, data.Int(1))
+			})
+
+			Convey("Then the functions should fail with a missing state", func() {
+				_, err := kvGetFunc.Call(ctx, data.String("no_such_state"), data.Int(1))
+				So(err, ShouldNotBeNil)
+				_, err = kvSizeFunc.Call(nil, data.String("users"))
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When saving it", func() {
+			So(kv.Write(ctx, core.NewTuple(data.Map{"id": data.Int(1), "data": data.Blob("a")})), ShouldBeNil)
+			So(kv.Write(ctx, core.NewTuple(data.Map{"id": data.String("x"), "data": data.Array{data.Float(1.5)}})), ShouldBeNil)
+			buf := bytes.NewBuffer(nil)
+			So(kv.Save(ctx, buf, data.Map{}), ShouldBeNil)
+			saved := buf.Bytes()
+
+			Convey("Then a state loaded from the data should have the same entries", func() {
+				l, err := kvStateCreator.(udf.UDSLoader).LoadState(ctx, bytes.NewReader(saved), data.Map{})
+				So(err, ShouldBeNil)
+				loaded := l.(*kvState)
+				So(loaded.len(), ShouldEqual, 2)
+				m, err := loaded.Lookup(ctx, data.Int(1))
+				So(err, ShouldBeNil)
+				So(m, ShouldResemble, data.Map{"id": data.Int(1), "data": data.Blob("a")})
+				m, err = loaded.Lookup(ctx, data.String("x"))
+				So(err, ShouldBeNil)
+				So(m["data"], ShouldResemble, data.Array{data.Float(1.5)})
+			})
+
+			Convey("Then loading the data into another state should replace its entries", func() {
+				st, err := createKVState(ctx, data.Map{"key": data.String("name")})
+				So(err, ShouldBeNil)
+				other := st.(*kvState)
+				So(other.Write(ctx, core.NewTuple(data.Map{"name": data.String("b")})), ShouldBeNil)
+				So(other.Load(ctx, bytes.NewReader(saved), data.Map{}), ShouldBeNil)
+				So(other.len(), ShouldEqual, 2)
+				_, err = other.Lookup(ctx, data.String("b"))
+				So(core.IsNotExist(err), ShouldBeTrue)
+				So(other.Write(ctx, core.NewTuple(data.Map{"id": data.Int(2)})), ShouldBeNil)
+				So(other.len(), ShouldEqual, 3)
+			})
+
+			Convey("Then loading the data as another type of state should fail", func() {
+				_, err := counterStateCreator.(udf.UDSLoader).LoadState(ctx, bytes.NewReader(saved), data.Map{})
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When writing a tuple without the key", func() {
+			err := kv.Write(ctx, core.NewTuple(data.Map{"name": data.String("a")}))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When writing a tuple having a null key", func() {
+			err := kv.Write(ctx, core.NewTuple(data.Map{"id": data.Null{}}))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When terminating it", func() {
+			So(kv.Terminate(ctx), ShouldBeNil)
+
+			Convey("Then it should no longer be written or looked up", func() {
+				So(kv.Write(ctx, core.NewTuple(data.Map{"id": data.Int(1)})), ShouldNotBeNil)
+				_, err := kv.Lookup(ctx, data.Int(1))
+				So(err, ShouldNotBeNil)
+				So(core.IsNotExist(err), ShouldBeFalse)
+			})
+		})
+	})
+
+	Convey("Given invalid parameters for a kv state", t, func() {
+		for _, params := range []data.Map{
+			{},
+			{"key": data.Int(1)},
+			{"key": data.String("a..b[")},
+		} {
+			Convey("Then creating the state should fail with "+params.String(), func() {
+				_, err := createKVState(ctx, params)
+				So(err, ShouldNotBeNil)
+			})
+		}
+	})
+}
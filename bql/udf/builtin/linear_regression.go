@@ -0,0 +1,259 @@
+package builtin
+
+import (
+	"errors"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io"
+	"math"
+	"sync"
+)
+
+// linearRegressionState is an online linear regression model trained by
+// stochastic gradient descent on the squared error. Each tuple written to
+// the state updates the model once. Predictions are made by
+// linear_regression_predict.
+//
+// It can be created in BQL as `linear_regression` with the following
+// parameters:
+//
+//	label_field: the path of the field having the target value
+//	             (default: "label")
+//	feature_vector_field: the path of the field having the feature vector
+//	                      (default: "feature_vector")
+//	learning_rate: the step size of each update (default: 0.01)
+//	regularization: the weight of L2 regularization (default: 0)
+//
+// Features should be scaled to similar small ranges since a learning
+// rate that is too large for the features makes the model diverge.
+//
+// For example:
+//
+//	CREATE STATE power_model TYPE linear_regression WITH learning_rate = 0.001;
+//	CREATE STREAM power_training AS SELECT RSTREAM power AS label,
+//	    {"temperature": temperature, "hour": hour} AS feature_vector
+//	    FROM sensors [RANGE 1 TUPLES];
+//	CREATE SINK power_model_sink TYPE uds WITH name = "power_model";
+//	INSERT INTO power_model_sink FROM power_training;
+type linearRegressionState struct {
+	labelField string
+	labelPath  data.Path
+	fvField    string
+	fvPath     data.Path
+	rate       float64
+	reg        float64
+
+	m sync.RWMutex
+	// weights is nil after the state is terminated.
+	weights featureVector
+	bias    float64
+	// count is the number of tuples the model has learned from.
+	count int64
+}
+
+var (
+	_ core.LoadableSharedState = &linearRegressionState{}
+	_ core.Writer              = &linearRegressionState{}
+)
+
+var linearRegressionStateCreator udf.UDSCreator = &stateCreator{
+	typeName: "linear_regression",
+	create:   createLinearRegressionState,
+	restore:  restoreLinearRegressionState,
+}
+
+func createLinearRegressionState(ctx *core.Context, params data.Map) (core.SharedState, error) {
+	label, labelPath, err := pathParamOrDefault(params, "label_field", "label")
+	if err != nil {
+		return nil, err
+	}
+	fv, fvPath, err := pathParamOrDefault(params, "feature_vector_field", "feature_vector")
+	if err != nil {
+		return nil, err
+	}
+	rate, err := floatParam(params, "learning_rate", 0.01)
+	if err != nil {
+		return nil, err
+	}
+	if rate <= 0 {
+		return nil, fmt.Errorf("'learning_rate' parameter must be greater than 0: %v", rate)
+	}
+	reg, err := floatParam(params, "regularization", 0)
+	if err != nil {
+		return nil, err
+	}
+	if reg < 0 || rate*reg >= 1 {
+		return nil, fmt.Errorf("'regularization' parameter must be at least 0 and less than 1/learning_rate: %v", reg)
+	}
+	return &linearRegressionState{
+		labelField: label,
+		labelPath:  labelPath,
+		fvField:    fv,
+		fvPath:     fvPath,
+		rate:       rate,
+		reg:        reg,
+		weights:    featureVector{},
+	}, nil
+}
+
+func restoreLinearRegressionState(d data.Map) (core.SharedState, error) {
+	params := []string{"label_field", "feature_vector_field", "learning_rate", "regularization"}
+	if !hasKeys(d, append(params, "weights", "bias", "count")...) {
+		return nil, errors.New("the saved linear_regression state doesn't have required fields")
+	}
+	p := data.Map{}
+	for _, k := range params {
+		p[k] = d[k]
+	}
+	st, err := createLinearRegressionState(nil, p)
+	if err != nil {
+		return nil, fmt.Errorf("the saved linear_regression state doesn't have valid parameters: %v", err)
+	}
+	s := st.(*linearRegressionState)
+	if s.weights, err = asFeatureVector(d["weights"]); err != nil {
+		return nil, fmt.Errorf("the saved linear_regression state doesn't have valid weights: %v", err)
+	}
+	if s.bias, err = data.AsFloat(d["bias"]); err != nil {
+		return nil, fmt.Errorf("the saved linear_regression state doesn't have a valid bias: %v", err)
+	}
+	if s.count, err = data.AsInt(d["count"]); err != nil {
+		return nil, fmt.Errorf("the saved linear_regression state doesn't have a valid count: %v", err)
+	}
+	return s, nil
+}
+
+func (s *linearRegressionState) Terminate(ctx *core.Context) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.weights = nil
+	return nil
+}
+
+// Write updates the model with the label and the feature vector of the
+// tuple. A tuple having a NULL label is ignored.
+func (s *linearRegressionState) Write(ctx *core.Context, t *core.Tuple) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.weights == nil {
+		return errors.New("the state is already terminated")
+	}
+	l, err := t.Data.Get(s.labelPath)
+	if err != nil {
+		return err
+	}
+	y, ok, err := asNumber(l)
+	if err != nil {
+		return fmt.Errorf("the label must be a number: %v", err)
+	}
+	if !ok {
+		return nil
+	}
+	v, err := t.Data.Get(s.fvPath)
+	if err != nil {
+		return err
+	}
+	x, err := asFeatureVector(v)
+	if err != nil {
+		return err
+	}
+
+	e := x.dot(s.weights) + s.bias - y
+	if math.IsNaN(e) || math.IsInf(e, 0) {
+		return errors.New("the linear regression model diverged, try a smaller learning rate")
+	}
+	if s.reg > 0 {
+		s.weights.scale(1 - s.rate*s.reg)
+	}
+	s.weights.addScaled(x, -s.rate*e)
+	s.bias -= s.rate * e
+	s.count++
+	return nil
+}
+
+func (s *linearRegressionState) predict(x featureVector) (float64, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	if s.weights == nil {
+		return 0, errors.New("the state is already terminated")
+	}
+	return x.dot(s.weights) + s.bias, nil
+}
+
+// Save writes the parameters and the model of the state.
+func (s *linearRegressionState) Save(ctx *core.Context, w io.Writer, params data.Map) error {
+	s.m.RLock()
+	if s.weights == nil {
+		s.m.RUnlock()
+		return errors.New("the state is already terminated")
+	}
+	d := data.Map{
+		"label_field":          data.String(s.labelField),
+		"feature_vector_field": data.String(s.fvField),
+		"learning_rate":        data.Float(s.rate),
+		"regularization":       data.Float(s.reg),
+		"weights":              s.weights.toMap(),
+		"bias":                 data.Float(s.bias),
+		"count":                data.Int(s.count),
+	}
+	s.m.RUnlock()
+
+	return saveStateData(w, "linear_regression", d)
+}
+
+// Load replaces the parameters and the model of the state with the saved
+// ones.
+func (s *linearRegressionState) Load(ctx *core.Context, r io.Reader, params data.Map) error {
+	d, err := loadStateData(r, "linear_regression")
+	if err != nil {
+		return err
+	}
+	st, err := restoreLinearRegressionState(d)
+	if err != nil {
+		return err
+	}
+	n := st.(*linearRegressionState)
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.weights == nil {
+		return errors.New("the state is already terminated")
+	}
+	s.labelField, s.labelPath = n.labelField, n.labelPath
+	s.fvField, s.fvPath = n.fvField, n.fvPath
+	s.rate, s.reg = n.rate, n.reg
+	s.weights, s.bias, s.count = n.weights, n.bias, n.count
+	return nil
+}
+
+// linearRegressionPredictFunc returns the value predicted for the given
+// feature vector by the linear_regression state having the given name.
+// It returns Null for a NULL feature vector.
+//
+// It can be used in BQL as `linear_regression_predict`.
+//
+//  Input: String, Map
+//  Return Type: Float
+var linearRegressionPredictFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, name, fv data.Value) (data.Value, error) {
+	st, n, err := lookupState(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := st.(*linearRegressionState)
+	if !ok {
+		return nil, fmt.Errorf("state '%s' isn't a linear_regression state", n)
+	}
+	if fv.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	x, err := asFeatureVector(fv)
+	if err != nil {
+		return nil, err
+	}
+	y, err := s.predict(x)
+	if err != nil {
+		return nil, err
+	}
+	return data.Float(y), nil
+})
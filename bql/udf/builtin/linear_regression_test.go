@@ -0,0 +1,152 @@
+package builtin
+
+import (
+	"bytes"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestLinearRegressionState(t *testing.T) {
+	ctx := core.NewContext(nil)
+	fv := func(x1, x2 float64) data.Map {
+		return data.Map{"x1": data.Float(x1), "x2": data.Float(x2)}
+	}
+
+	Convey("Given a linear_regression state", t, func() {
+		st, err := createLinearRegressionState(ctx, data.Map{"learning_rate": data.Float(0.1)})
+		So(err, ShouldBeNil)
+		So(ctx.SharedStates.Add("model", "linear_regression", st), ShouldBeNil)
+		Reset(func() {
+			ctx.SharedStates.Remove("model")
+		})
+		s := st.(*linearRegressionState)
+
+		predict := func(x data.Value) data.Value {
+			v, err := linearRegressionPredictFunc.Call(ctx, data.String("model"), x)
+			So(err, ShouldBeNil)
+			return v
+		}
+
+		Convey("When nothing is written", func() {
+			Convey("Then it should predict 0", func() {
+				So(predict(fv(1, 2)), ShouldEqual, data.Float(0))
+			})
+		})
+
+		Convey("When writing samples of a linear function", func() {
+			// y = 2*x1 - x2 + 0.5
+			for i := 0; i < 2000; i++ {
+				x1, x2 := float64(i%7)/7, float64(i%5)/5
+				So(s.Write(ctx, core.NewTuple(data.Map{
+					"label":          data.Float(2*x1 - x2 + 0.5),
+					"feature_vector": fv(x1, x2),
+				})), ShouldBeNil)
+			}
+			So(s.Write(ctx, core.NewTuple(data.Map{"label": data.Null{}, "feature_vector": fv(1, 1)})), ShouldBeNil)
+
+			Convey("Then it should predict values of the function", func() {
+				y, err := data.AsFloat(predict(fv(0.5, 0.5)))
+				So(err, ShouldBeNil)
+				So(y, ShouldAlmostEqual, 1.0, 0.01)
+				y, err = data.AsFloat(predict(data.Map{"x1": data.Int(1)}))
+				So(err, ShouldBeNil)
+				So(y, ShouldAlmostEqual, 2.5, 0.01)
+				So(s.count, ShouldEqual, 2000)
+			})
+
+			Convey("Then it should return null for a null feature vector", func() {
+				So(predict(data.Null{}), ShouldResemble, data.Null{})
+			})
+
+			Convey("Then a state loaded from the saved data should make the same predictions", func() {
+				buf := bytes.NewBuffer(nil)
+				So(s.Save(ctx, buf, data.Map{}), ShouldBeNil)
+				l, err := linearRegressionStateCreator.(udf.UDSLoader).LoadState(ctx, buf, data.Map{})
+				So(err, ShouldBeNil)
+				loaded := l.(*linearRegressionState)
+				So(loaded.rate, ShouldEqual, 0.1)
+				for _, x := range []data.Map{fv(0, 0), fv(0.3, 0.8)} {
+					f, err := asFeatureVector(x)
+					So(err, ShouldBeNil)
+					expected, err := s.predict(f)
+					So(err, ShouldBeNil)
+					actual, err := loaded.predict(f)
+					So(err, ShouldBeNil)
+					So(actual, ShouldEqual, expected)
+				}
+			})
+		})
+
+		Convey("When writing a tuple having an invalid label or feature vector", func() {
+			err1 := s.Write(ctx, core.NewTuple(data.Map{"label": data.String("a"), "feature_vector": fv(1, 1)}))
+			err2 := s.Write(ctx, core.NewTuple(data.Map{"label": data.Int(1), "feature_vector": data.Map{"x": data.String("a")}}))
+			err3 := s.Write(ctx, core.NewTuple(data.Map{"label": data.Int(1)}))
+
+			Convey("Then it should fail", func() {
+				So(err1, ShouldNotBeNil)
+				So(err2, ShouldNotBeNil)
+				So(err3, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When writing tuples with a learning rate too large for them", func() {
+			var err error
+			for i := 0; i < 1000 && err == nil; i++ {
+				err = s.Write(ctx, core.NewTuple(data.Map{
+					"label":          data.Float(1e6),
+					"feature_vector": fv(1e3, 1e3),
+				}))
+			}
+
+			Convey("Then it should fail once the model diverges", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a linear_regression state with custom fields and regularization", t, func() {
+		st, err := createLinearRegressionState(ctx, data.Map{
+			"label_field":          data.String("y"),
+			"feature_vector_field": data.String("in.x"),
+			"learning_rate":        data.Float(0.1),
+			"regularization":       data.Float(1),
+		})
+		So(err, ShouldBeNil)
+		s := st.(*linearRegressionState)
+
+		Convey("When writing samples", func() {
+			for i := 0; i < 1000; i++ {
+				So(s.Write(ctx, core.NewTuple(data.Map{
+					"y":  data.Int(2),
+					"in": data.Map{"x": data.Map{"a": data.Int(1)}},
+				})), ShouldBeNil)
+			}
+
+			Convey("Then the weights should be shrunk by regularization", func() {
+				y, err := s.predict(featureVector{"a": 1})
+				So(err, ShouldBeNil)
+				So(y, ShouldAlmostEqual, 2, 0.05)
+				So(s.weights["a"], ShouldBeLessThan, s.bias)
+			})
+		})
+	})
+
+	Convey("Given invalid parameters for a linear_regression state", t, func() {
+		for _, params := range []data.Map{
+			{"label_field": data.Int(1)},
+			{"feature_vector_field": data.String("a[")},
+			{"learning_rate": data.Float(0)},
+			{"learning_rate": data.String("a")},
+			{"regularization": data.Float(-1)},
+			{"learning_rate": data.Float(0.5), "regularization": data.Float(2)},
+		} {
+			Convey("Then creating the state should fail with "+params.String(), func() {
+				_, err := createLinearRegressionState(ctx, params)
+				So(err, ShouldNotBeNil)
+			})
+		}
+	})
+}
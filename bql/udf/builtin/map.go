@@ -0,0 +1,134 @@
+package builtin
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sort"
+)
+
+// sortedKeys returns the keys of the given map in ascending order.
+func sortedKeys(m data.Map) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// mapKeysFunc returns the keys of the given map as an array of strings
+// in ascending order.
+//
+// It can be used in BQL as `map_keys`.
+//
+//  Input: Map
+//  Return Type: Array
+var mapKeysFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, arg data.Value) (data.Value, error) {
+	if arg.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	m, err := data.AsMap(arg)
+	if err != nil {
+		return nil, fmt.Errorf("%v is not a map", arg)
+	}
+	keys := sortedKeys(m)
+	res := make(data.Array, len(keys))
+	for i, k := range keys {
+		res[i] = data.String(k)
+	}
+	return res, nil
+})
+
+// mapValuesFunc returns the values of the given map as an array. The
+// values are in the ascending order of their keys so that they
+// correspond to the result of map_keys.
+//
+// It can be used in BQL as `map_values`.
+//
+//  Input: Map
+//  Return Type: Array
+var mapValuesFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, arg data.Value) (data.Value, error) {
+	if arg.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	m, err := data.AsMap(arg)
+	if err != nil {
+		return nil, fmt.Errorf("%v is not a map", arg)
+	}
+	keys := sortedKeys(m)
+	res := make(data.Array, len(keys))
+	for i, k := range keys {
+		res[i] = m[k]
+	}
+	return res, nil
+})
+
+// mapMergeFunc returns a new map having the entries of all maps given as
+// input arguments. If more than one map has the same key, the value in
+// the last one is used. Nested maps aren't merged. NULL values are
+// ignored.
+//
+// It can be used in BQL as `map_merge`.
+//
+//  Input: n * Map
+//  Return Type: Map
+var mapMergeFunc udf.UDF = &variadicFunc{
+	minParams: 1,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		res := data.Map{}
+		for _, arg := range args {
+			if arg.Type() == data.TypeNull {
+				continue
+			}
+			m, err := data.AsMap(arg)
+			if err != nil {
+				return nil, fmt.Errorf("%v is not a map", arg)
+			}
+			for k, v := range m {
+				res[k] = v
+			}
+		}
+		return res, nil
+	},
+}
+
+// mapRemoveFunc returns a new map having the entries of the map given as
+// the first argument except for those having the keys given as the other
+// arguments. Keys which aren't in the map and NULL keys are ignored.
+//
+// It can be used in BQL as `map_remove`.
+//
+//  Input: Map, n * String
+//  Return Type: Map
+var mapRemoveFunc udf.UDF = &variadicFunc{
+	minParams: 2,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		if args[0].Type() == data.TypeNull {
+			return data.Null{}, nil
+		}
+		m, err := data.AsMap(args[0])
+		if err != nil {
+			return nil, fmt.Errorf("%v is not a map", args[0])
+		}
+		removed := make(map[string]bool, len(args)-1)
+		for _, arg := range args[1:] {
+			if arg.Type() == data.TypeNull {
+				continue
+			}
+			k, err := data.AsString(arg)
+			if err != nil {
+				return nil, fmt.Errorf("cannot interpret %s as a string", arg)
+			}
+			removed[k] = true
+		}
+		res := make(data.Map, len(m))
+		for k, v := range m {
+			if !removed[k] {
+				res[k] = v
+			}
+		}
+		return res, nil
+	},
+}
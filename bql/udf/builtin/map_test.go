@@ -0,0 +1,88 @@
+package builtin
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestMapFuncs(t *testing.T) {
+	m := data.Map{"b": data.Int(2), "a": data.Int(1), "c": data.Map{"d": data.Int(3)}}
+
+	udfVariadicTestCases := []udfVariadicTestCase{
+		{"map_keys", mapKeysFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{m}, data.Array{data.String("a"), data.String("b"), data.String("c")}},
+			{[]data.Value{data.Map{}}, data.Array{}},
+			{[]data.Value{data.Null{}}, data.Null{}},
+			// invalid cases
+			{[]data.Value{data.Array{}}, nil},
+		}},
+		{"map_values", mapValuesFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{m}, data.Array{data.Int(1), data.Int(2), data.Map{"d": data.Int(3)}}},
+			{[]data.Value{data.Null{}}, data.Null{}},
+			// invalid cases
+			{[]data.Value{data.Int(1)}, nil},
+		}},
+		{"map_merge", mapMergeFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{m, data.Null{}, data.Map{"a": data.String("x"), "c": data.Map{"e": data.Int(4)}}},
+				data.Map{"a": data.String("x"), "b": data.Int(2), "c": data.Map{"e": data.Int(4)}}},
+			{[]data.Value{data.Null{}}, data.Map{}},
+			// invalid cases
+			{[]data.Value{m, data.Array{}}, nil},
+		}},
+		{"map_remove", mapRemoveFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{m, data.String("a"), data.String("c"), data.String("z"), data.Null{}},
+				data.Map{"b": data.Int(2)}},
+			{[]data.Value{data.Null{}, data.String("a")}, data.Null{}},
+			// invalid cases
+			{[]data.Value{m, data.Int(1)}, nil},
+			{[]data.Value{data.Array{}, data.String("a")}, nil},
+		}},
+	}
+
+	for _, testCase := range udfVariadicTestCases {
+		f := testCase.f
+		allInputs := testCase.inputs
+		name := testCase.name
+
+		Convey(fmt.Sprintf("Given the %s function", name), t, func() {
+			for _, tc := range allInputs {
+				tc := tc
+
+				Convey(fmt.Sprintf("When evaluating it on %#v", tc.input), func() {
+					val, err := f.Call(nil, tc.input...)
+
+					if tc.expected == nil {
+						Convey("Then evaluation should fail", func() {
+							So(err, ShouldNotBeNil)
+						})
+					} else {
+						Convey(fmt.Sprintf("Then the result should be %s", tc.expected), func() {
+							So(err, ShouldBeNil)
+							So(val, ShouldResemble, tc.expected)
+						})
+					}
+				})
+			}
+
+			Convey("Then it should equal the one in the default registry", func() {
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(name, len(allInputs[0].input))
+				So(err, ShouldBeNil)
+				So(regFun, ShouldHaveSameTypeAs, f)
+			})
+		})
+	}
+
+	Convey("Given a map", t, func() {
+		Convey("When removing a key from it", func() {
+			_, err := mapRemoveFunc.Call(nil, m, data.String("a"))
+			So(err, ShouldBeNil)
+
+			Convey("Then it shouldn't be modified", func() {
+				So(m["a"], ShouldEqual, data.Int(1))
+			})
+		})
+	})
+}
@@ -0,0 +1,113 @@
+package builtin
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+)
+
+// Machine learning states learn models from tuples written to them and
+// make predictions by UDFs. A feature vector is given as a Map of numbers
+// such as {"temperature": 21.5, "humidity": 40}. It's sparse: a missing
+// feature and a NULL feature are the same as 0.
+
+// featureVector is a sparse vector whose elements are identified by
+// names. It's also used for weights and centroids of models.
+type featureVector map[string]float64
+
+func asFeatureVector(v data.Value) (featureVector, error) {
+	m, err := data.AsMap(v)
+	if err != nil {
+		return nil, fmt.Errorf("a feature vector must be a map: %v", err)
+	}
+	fv := make(featureVector, len(m))
+	for k, e := range m {
+		f, ok, err := asNumber(e)
+		if err != nil {
+			return nil, fmt.Errorf("the feature '%v' of a feature vector must be a number: %v", k, err)
+		}
+		if !ok || f == 0 {
+			continue
+		}
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, fmt.Errorf("the feature '%v' of a feature vector must be finite: %v", k, f)
+		}
+		fv[k] = f
+	}
+	return fv, nil
+}
+
+func (v featureVector) toMap() data.Map {
+	m := make(data.Map, len(v))
+	for k, f := range v {
+		m[k] = data.Float(f)
+	}
+	return m
+}
+
+func (v featureVector) dot(w featureVector) float64 {
+	if len(w) < len(v) {
+		v, w = w, v
+	}
+	sum := 0.0
+	for k, f := range v {
+		sum += f * w[k]
+	}
+	return sum
+}
+
+func (v featureVector) squaredNorm() float64 {
+	return v.dot(v)
+}
+
+// addScaled adds a*x to the vector.
+func (v featureVector) addScaled(x featureVector, a float64) {
+	for k, f := range x {
+		v[k] += a * f
+	}
+}
+
+// scale multiplies all elements of the vector by a.
+func (v featureVector) scale(a float64) {
+	for k := range v {
+		v[k] *= a
+	}
+}
+
+// squaredDistance returns the squared Euclidean distance between two
+// vectors.
+func (v featureVector) squaredDistance(w featureVector) float64 {
+	sum := 0.0
+	for k, f := range v {
+		d := f - w[k]
+		sum += d * d
+	}
+	for k, f := range w {
+		if _, ok := v[k]; !ok {
+			sum += f * f
+		}
+	}
+	return sum
+}
+
+func (v featureVector) copy() featureVector {
+	c := make(featureVector, len(v))
+	for k, f := range v {
+		c[k] = f
+	}
+	return c
+}
+
+// floatParam returns the value of a numeric parameter or the default
+// value if the parameter is missing.
+func floatParam(params data.Map, name string, def float64) (float64, error) {
+	v, ok := params[name]
+	if !ok {
+		return def, nil
+	}
+	f, ok, err := asNumber(v)
+	if err != nil || !ok {
+		return 0, fmt.Errorf("'%v' parameter must be a number: %v", name, v)
+	}
+	return f, nil
+}
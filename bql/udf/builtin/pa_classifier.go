@@ -0,0 +1,325 @@
+package builtin
+
+import (
+	"errors"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io"
+	"math"
+	"sort"
+	"sync"
+)
+
+// paClassifierState is an online multiclass classifier trained by the
+// Passive-Aggressive algorithm (PA-I). It has a weight vector for each
+// label and predicts the label whose weight vector gives the highest
+// score. Each tuple written to the state updates the model once, so that
+// the score of the correct label exceeds the score of any other label by
+// a margin of 1 if the update isn't limited by the regularization
+// weight. Predictions are made by pa_classifier_predict.
+//
+// It can be created in BQL as `pa_classifier` with the following
+// parameters:
+//
+//	label_field: the path of the field having the label, which must be a
+//	             string (default: "label")
+//	feature_vector_field: the path of the field having the feature vector
+//	                      (default: "feature_vector")
+//	regularization_weight: the maximum step size of each update, which
+//	                       makes the model tolerant of noisy labels when
+//	                       smaller (default: 1.0)
+//
+// For example:
+//
+//	CREATE STATE traffic_model TYPE pa_classifier;
+//	CREATE SINK traffic_model_sink TYPE uds WITH name = "traffic_model";
+//	INSERT INTO traffic_model_sink FROM labeled_traffic;
+type paClassifierState struct {
+	labelField string
+	labelPath  data.Path
+	fvField    string
+	fvPath     data.Path
+	c          float64
+
+	m sync.RWMutex
+	// weights has the weight vector of each label. It is nil after the
+	// state is terminated.
+	weights map[string]featureVector
+}
+
+var (
+	_ core.LoadableSharedState = &paClassifierState{}
+	_ core.Writer              = &paClassifierState{}
+)
+
+var paClassifierStateCreator udf.UDSCreator = &stateCreator{
+	typeName: "pa_classifier",
+	create:   createPAClassifierState,
+	restore:  restorePAClassifierState,
+}
+
+func createPAClassifierState(ctx *core.Context, params data.Map) (core.SharedState, error) {
+	label, labelPath, err := pathParamOrDefault(params, "label_field", "label")
+	if err != nil {
+		return nil, err
+	}
+	fv, fvPath, err := pathParamOrDefault(params, "feature_vector_field", "feature_vector")
+	if err != nil {
+		return nil, err
+	}
+	c, err := floatParam(params, "regularization_weight", 1)
+	if err != nil {
+		return nil, err
+	}
+	if c <= 0 {
+		return nil, fmt.Errorf("'regularization_weight' parameter must be greater than 0: %v", c)
+	}
+	return &paClassifierState{
+		labelField: label,
+		labelPath:  labelPath,
+		fvField:    fv,
+		fvPath:     fvPath,
+		c:          c,
+		weights:    map[string]featureVector{},
+	}, nil
+}
+
+func restorePAClassifierState(d data.Map) (core.SharedState, error) {
+	params := []string{"label_field", "feature_vector_field", "regularization_weight"}
+	if !hasKeys(d, append(params, "weights")...) {
+		return nil, errors.New("the saved pa_classifier state doesn't have required fields")
+	}
+	p := data.Map{}
+	for _, k := range params {
+		p[k] = d[k]
+	}
+	st, err := createPAClassifierState(nil, p)
+	if err != nil {
+		return nil, fmt.Errorf("the saved pa_classifier state doesn't have valid parameters: %v", err)
+	}
+	s := st.(*paClassifierState)
+	weights, err := data.AsMap(d["weights"])
+	if err != nil {
+		return nil, fmt.Errorf("the saved pa_classifier state doesn't have valid weights: %v", err)
+	}
+	for l, w := range weights {
+		fv, err := asFeatureVector(w)
+		if err != nil {
+			return nil, fmt.Errorf("the saved pa_classifier state doesn't have valid weights: %v", err)
+		}
+		s.weights[l] = fv
+	}
+	return s, nil
+}
+
+func (s *paClassifierState) Terminate(ctx *core.Context) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.weights = nil
+	return nil
+}
+
+// Write updates the model with the label and the feature vector of the
+// tuple. A tuple having a NULL label is ignored.
+func (s *paClassifierState) Write(ctx *core.Context, t *core.Tuple) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.weights == nil {
+		return errors.New("the state is already terminated")
+	}
+	l, err := t.Data.Get(s.labelPath)
+	if err != nil {
+		return err
+	}
+	if l.Type() == data.TypeNull {
+		return nil
+	}
+	label, err := data.AsString(l)
+	if err != nil {
+		return fmt.Errorf("the label must be a string: %v", err)
+	}
+	v, err := t.Data.Get(s.fvPath)
+	if err != nil {
+		return err
+	}
+	x, err := asFeatureVector(v)
+	if err != nil {
+		return err
+	}
+
+	correct, ok := s.weights[label]
+	if !ok {
+		correct = featureVector{}
+		s.weights[label] = correct
+	}
+	norm := x.squaredNorm()
+	if norm == 0 {
+		// the feature vector doesn't change any score
+		return nil
+	}
+
+	// the wrong label having the highest score
+	var wrong featureVector
+	wrongScore := math.Inf(-1)
+	for l, w := range s.weights {
+		if l == label {
+			continue
+		}
+		if sc := x.dot(w); sc > wrongScore {
+			wrong, wrongScore = w, sc
+		}
+	}
+	if wrong == nil {
+		// only the correct label is known, so its score is compared with 0
+		wrongScore = 0
+	}
+
+	loss := 1 - (x.dot(correct) - wrongScore)
+	if loss <= 0 {
+		return nil
+	}
+	if wrong == nil {
+		correct.addScaled(x, math.Min(s.c, loss/norm))
+		return nil
+	}
+	tau := math.Min(s.c, loss/(2*norm))
+	correct.addScaled(x, tau)
+	wrong.addScaled(x, -tau)
+	return nil
+}
+
+// scores returns the score of each label for the feature vector.
+func (s *paClassifierState) scores(x featureVector) (map[string]float64, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	if s.weights == nil {
+		return nil, errors.New("the state is already terminated")
+	}
+	scores := make(map[string]float64, len(s.weights))
+	for l, w := range s.weights {
+		scores[l] = x.dot(w)
+	}
+	return scores, nil
+}
+
+// Save writes the parameters and the model of the state.
+func (s *paClassifierState) Save(ctx *core.Context, w io.Writer, params data.Map) error {
+	s.m.RLock()
+	if s.weights == nil {
+		s.m.RUnlock()
+		return errors.New("the state is already terminated")
+	}
+	weights := make(data.Map, len(s.weights))
+	for l, w := range s.weights {
+		weights[l] = w.toMap()
+	}
+	d := data.Map{
+		"label_field":           data.String(s.labelField),
+		"feature_vector_field":  data.String(s.fvField),
+		"regularization_weight": data.Float(s.c),
+		"weights":               weights,
+	}
+	s.m.RUnlock()
+
+	return saveStateData(w, "pa_classifier", d)
+}
+
+// Load replaces the parameters and the model of the state with the saved
+// ones.
+func (s *paClassifierState) Load(ctx *core.Context, r io.Reader, params data.Map) error {
+	d, err := loadStateData(r, "pa_classifier")
+	if err != nil {
+		return err
+	}
+	st, err := restorePAClassifierState(d)
+	if err != nil {
+		return err
+	}
+	n := st.(*paClassifierState)
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.weights == nil {
+		return errors.New("the state is already terminated")
+	}
+	s.labelField, s.labelPath = n.labelField, n.labelPath
+	s.fvField, s.fvPath = n.fvField, n.fvPath
+	s.c = n.c
+	s.weights = n.weights
+	return nil
+}
+
+// paClassifierScores computes the scores of the feature vector by the
+// pa_classifier state having the given name. It returns nil scores for a
+// NULL feature vector.
+func paClassifierScores(ctx *core.Context, name, fv data.Value) (map[string]float64, error) {
+	st, n, err := lookupState(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := st.(*paClassifierState)
+	if !ok {
+		return nil, fmt.Errorf("state '%s' isn't a pa_classifier state", n)
+	}
+	if fv.Type() == data.TypeNull {
+		return nil, nil
+	}
+	x, err := asFeatureVector(fv)
+	if err != nil {
+		return nil, err
+	}
+	return s.scores(x)
+}
+
+// paClassifierPredictFunc returns the label predicted for the given
+// feature vector by the pa_classifier state having the given name. When
+// more than one label has the highest score, the smallest one in
+// lexicographical order is returned. It returns Null if the state hasn't
+// learned any label or the feature vector is NULL.
+//
+// It can be used in BQL as `pa_classifier_predict`.
+//
+//  Input: String, Map
+//  Return Type: String
+var paClassifierPredictFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, name, fv data.Value) (data.Value, error) {
+	scores, err := paClassifierScores(ctx, name, fv)
+	if err != nil {
+		return nil, err
+	}
+	if len(scores) == 0 {
+		return data.Null{}, nil
+	}
+	labels := make([]string, 0, len(scores))
+	for l := range scores {
+		labels = append(labels, l)
+	}
+	sort.Strings(labels)
+	best := labels[0]
+	for _, l := range labels[1:] {
+		if scores[l] > scores[best] {
+			best = l
+		}
+	}
+	return data.String(best), nil
+})
+
+// paClassifierScoresFunc returns the score of each label for the given
+// feature vector by the pa_classifier state having the given name. It
+// returns Null for a NULL feature vector.
+//
+// It can be used in BQL as `pa_classifier_scores`.
+//
+//  Input: String, Map
+//  Return Type: Map
+var paClassifierScoresFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, name, fv data.Value) (data.Value, error) {
+	scores, err := paClassifierScores(ctx, name, fv)
+	if err != nil {
+		return nil, err
+	}
+	if scores == nil {
+		return data.Null{}, nil
+	}
+	return featureVector(scores).toMap(), nil
+})
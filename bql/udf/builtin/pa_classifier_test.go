@@ -0,0 +1,142 @@
+package builtin
+
+import (
+	"bytes"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestPAClassifierState(t *testing.T) {
+	ctx := core.NewContext(nil)
+	// samples of three classes whose feature vectors are around different
+	// points
+	samples := []struct {
+		label string
+		fv    data.Map
+	}{
+		{"a", data.Map{"x": data.Float(1), "y": data.Float(0.1), "bias": data.Int(1)}},
+		{"b", data.Map{"x": data.Float(-1), "y": data.Float(0.2), "bias": data.Int(1)}},
+		{"c", data.Map{"x": data.Float(0.1), "y": data.Float(1), "bias": data.Int(1)}},
+		{"a", data.Map{"x": data.Float(0.8), "y": data.Float(-0.2), "bias": data.Int(1)}},
+		{"b", data.Map{"x": data.Float(-0.9), "y": data.Float(-0.1), "bias": data.Int(1)}},
+		{"c", data.Map{"x": data.Float(-0.1), "y": data.Float(0.9), "bias": data.Int(1)}},
+	}
+
+	Convey("Given a pa_classifier state", t, func() {
+		st, err := createPAClassifierState(ctx, data.Map{})
+		So(err, ShouldBeNil)
+		So(ctx.SharedStates.Add("model", "pa_classifier", st), ShouldBeNil)
+		Reset(func() {
+			ctx.SharedStates.Remove("model")
+		})
+		s := st.(*paClassifierState)
+
+		predict := func(fv data.Value) data.Value {
+			v, err := paClassifierPredictFunc.Call(ctx, data.String("model"), fv)
+			So(err, ShouldBeNil)
+			return v
+		}
+
+		Convey("When nothing is written", func() {
+			Convey("Then it should predict null", func() {
+				So(predict(data.Map{"x": data.Int(1)}), ShouldResemble, data.Null{})
+			})
+		})
+
+		Convey("When writing labeled samples", func() {
+			for i := 0; i < 10; i++ {
+				for _, smp := range samples {
+					So(s.Write(ctx, core.NewTuple(data.Map{
+						"label":          data.String(smp.label),
+						"feature_vector": smp.fv,
+					})), ShouldBeNil)
+				}
+			}
+			So(s.Write(ctx, core.NewTuple(data.Map{"label": data.Null{}, "feature_vector": data.Map{}})), ShouldBeNil)
+
+			Convey("Then it should classify the samples correctly", func() {
+				for _, smp := range samples {
+					So(predict(smp.fv), ShouldEqual, data.String(smp.label))
+				}
+			})
+
+			Convey("Then it should classify new feature vectors", func() {
+				So(predict(data.Map{"x": data.Float(0.9), "bias": data.Int(1)}), ShouldEqual, data.String("a"))
+				So(predict(data.Map{"y": data.Float(1.1), "bias": data.Int(1)}), ShouldEqual, data.String("c"))
+			})
+
+			Convey("Then pa_classifier_scores should return the highest score for the predicted label", func() {
+				v, err := paClassifierScoresFunc.Call(ctx, data.String("model"), samples[1].fv)
+				So(err, ShouldBeNil)
+				scores, err := data.AsMap(v)
+				So(err, ShouldBeNil)
+				So(len(scores), ShouldEqual, 3)
+				b, _ := data.AsFloat(scores["b"])
+				for _, l := range []string{"a", "c"} {
+					f, _ := data.AsFloat(scores[l])
+					So(b, ShouldBeGreaterThan, f)
+				}
+			})
+
+			Convey("Then a state loaded from the saved data should have the same weights", func() {
+				buf := bytes.NewBuffer(nil)
+				So(s.Save(ctx, buf, data.Map{}), ShouldBeNil)
+				l, err := paClassifierStateCreator.(udf.UDSLoader).LoadState(ctx, buf, data.Map{})
+				So(err, ShouldBeNil)
+				loaded := l.(*paClassifierState)
+				So(len(loaded.weights), ShouldEqual, 3)
+				for _, smp := range samples {
+					x, err := asFeatureVector(smp.fv)
+					So(err, ShouldBeNil)
+					expected, err := s.scores(x)
+					So(err, ShouldBeNil)
+					actual, err := loaded.scores(x)
+					So(err, ShouldBeNil)
+					So(len(actual), ShouldEqual, len(expected))
+					for l, sc := range expected {
+						So(actual[l], ShouldAlmostEqual, sc, 1e-9)
+					}
+				}
+			})
+		})
+
+		Convey("When writing a sample of a single label", func() {
+			So(s.Write(ctx, core.NewTuple(data.Map{
+				"label":          data.String("a"),
+				"feature_vector": data.Map{"x": data.Int(2)},
+			})), ShouldBeNil)
+
+			Convey("Then the score of the label should reach the margin", func() {
+				scores, err := s.scores(featureVector{"x": 2})
+				So(err, ShouldBeNil)
+				So(scores["a"], ShouldAlmostEqual, 1, 1e-9)
+			})
+		})
+
+		Convey("When writing a tuple having an invalid label or feature vector", func() {
+			err1 := s.Write(ctx, core.NewTuple(data.Map{"label": data.Int(1), "feature_vector": data.Map{}}))
+			err2 := s.Write(ctx, core.NewTuple(data.Map{"label": data.String("a"), "feature_vector": data.Array{}}))
+
+			Convey("Then it should fail", func() {
+				So(err1, ShouldNotBeNil)
+				So(err2, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given invalid parameters for a pa_classifier state", t, func() {
+		for _, params := range []data.Map{
+			{"label_field": data.Int(1)},
+			{"regularization_weight": data.Float(0)},
+			{"regularization_weight": data.Null{}},
+		} {
+			Convey("Then creating the state should fail with "+params.String(), func() {
+				_, err := createPAClassifierState(ctx, params)
+				So(err, ShouldNotBeNil)
+			})
+		}
+	})
+}
@@ -0,0 +1,260 @@
+package builtin
+
+import (
+	"errors"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io"
+	"sync"
+)
+
+// ringBufferState keeps the last values written to it. When the buffer
+// is full, the oldest value is discarded. The values can be obtained by
+// ring_buffer_values.
+//
+// It can be created in BQL as `ring_buffer` with the following
+// parameters:
+//
+//	size: the maximum number of values kept in the buffer (required)
+//	field: the path of the field having the value (optional, the whole
+//	       data of a tuple is kept by default)
+//
+// For example:
+//
+//	CREATE STATE recent_errors TYPE ring_buffer WITH size = 10, field = "message";
+//	CREATE SINK recent_errors_sink TYPE uds WITH name = "recent_errors";
+//	INSERT INTO recent_errors_sink FROM errors;
+type ringBufferState struct {
+	field     string
+	fieldPath data.Path
+
+	m sync.RWMutex
+	// values is the buffer. It is nil after the state is terminated.
+	values data.Array
+	// head is the index of the oldest value in values.
+	head int
+	// n is the number of values in the buffer.
+	n int
+}
+
+var (
+	_ core.LoadableSharedState = &ringBufferState{}
+	_ core.Writer              = &ringBufferState{}
+)
+
+var ringBufferStateCreator udf.UDSCreator = &stateCreator{
+	typeName: "ring_buffer",
+	create:   createRingBufferState,
+	restore:  restoreRingBufferState,
+}
+
+func createRingBufferState(ctx *core.Context, params data.Map) (core.SharedState, error) {
+	v, ok := params["size"]
+	if !ok {
+		return nil, errors.New("'size' parameter is missing")
+	}
+	size, err := data.AsInt(v)
+	if err != nil {
+		return nil, fmt.Errorf("'size' parameter must be an integer: %v", err)
+	}
+	if size <= 0 {
+		return nil, fmt.Errorf("'size' parameter must be greater than 0: %v", size)
+	}
+	field, path, err := pathParam(params, "field", false)
+	if err != nil {
+		return nil, err
+	}
+	return &ringBufferState{
+		field:     field,
+		fieldPath: path,
+		values:    make(data.Array, size),
+	}, nil
+}
+
+func restoreRingBufferState(d data.Map) (core.SharedState, error) {
+	params := data.Map{"size": d["size"]}
+	if v, ok := d["field"]; ok && v.Type() != data.TypeNull {
+		params["field"] = v
+	}
+	st, err := createRingBufferState(nil, params)
+	if err != nil {
+		return nil, fmt.Errorf("the saved ring_buffer state doesn't have valid parameters: %v", err)
+	}
+	s := st.(*ringBufferState)
+	values, err := data.AsArray(d["values"])
+	if err != nil {
+		return nil, fmt.Errorf("the saved ring_buffer state doesn't have valid values: %v", err)
+	}
+	for _, v := range values {
+		s.push(v)
+	}
+	return s, nil
+}
+
+func (s *ringBufferState) Terminate(ctx *core.Context) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.values = nil
+	s.head = 0
+	s.n = 0
+	return nil
+}
+
+// Write appends the value of the tuple's field, or the tuple's data if
+// the field parameter isn't given, to the buffer.
+func (s *ringBufferState) Write(ctx *core.Context, t *core.Tuple) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.values == nil {
+		return errors.New("the state is already terminated")
+	}
+	var v data.Value = t.Data
+	if s.fieldPath != nil {
+		f, err := t.Data.Get(s.fieldPath)
+		if err != nil {
+			return err
+		}
+		v = f
+	}
+	s.push(copyValue(v))
+	return nil
+}
+
+// push appends the value to the buffer, discarding the oldest value if
+// the buffer is full. The caller must hold the lock.
+func (s *ringBufferState) push(v data.Value) {
+	size := len(s.values)
+	if s.n < size {
+		s.values[(s.head+s.n)%size] = v
+		s.n++
+		return
+	}
+	s.values[s.head] = v
+	s.head = (s.head + 1) % size
+}
+
+// snapshot returns the values in the buffer from the oldest one. The
+// values aren't copied.
+func (s *ringBufferState) snapshot() (data.Array, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	if s.values == nil {
+		return nil, errors.New("the state is already terminated")
+	}
+	return s.ordered(), nil
+}
+
+// ordered returns the values in the buffer from the oldest one. The
+// caller must hold the lock.
+func (s *ringBufferState) ordered() data.Array {
+	a := make(data.Array, s.n)
+	for i := range a {
+		a[i] = s.values[(s.head+i)%len(s.values)]
+	}
+	return a
+}
+
+// len returns the number of values in the buffer.
+func (s *ringBufferState) len() (int, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	if s.values == nil {
+		return 0, errors.New("the state is already terminated")
+	}
+	return s.n, nil
+}
+
+// Save writes the parameters and all values of the state.
+func (s *ringBufferState) Save(ctx *core.Context, w io.Writer, params data.Map) error {
+	s.m.RLock()
+	if s.values == nil {
+		s.m.RUnlock()
+		return errors.New("the state is already terminated")
+	}
+	d := data.Map{
+		"size":   data.Int(len(s.values)),
+		"field":  data.Null{},
+		"values": s.ordered(),
+	}
+	if s.fieldPath != nil {
+		d["field"] = data.String(s.field)
+	}
+	s.m.RUnlock()
+
+	return saveStateData(w, "ring_buffer", d)
+}
+
+// Load replaces the parameters and all values of the state with the
+// saved ones.
+func (s *ringBufferState) Load(ctx *core.Context, r io.Reader, params data.Map) error {
+	d, err := loadStateData(r, "ring_buffer")
+	if err != nil {
+		return err
+	}
+	st, err := restoreRingBufferState(d)
+	if err != nil {
+		return err
+	}
+	n := st.(*ringBufferState)
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.values == nil {
+		return errors.New("the state is already terminated")
+	}
+	s.field, s.fieldPath = n.field, n.fieldPath
+	s.values, s.head, s.n = n.values, n.head, n.n
+	return nil
+}
+
+func lookupRingBufferState(ctx *core.Context, name data.Value) (*ringBufferState, error) {
+	st, n, err := lookupState(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := st.(*ringBufferState)
+	if !ok {
+		return nil, fmt.Errorf("state '%s' isn't a ring_buffer state", n)
+	}
+	return s, nil
+}
+
+// ringBufferValuesFunc returns the values in the ring_buffer state
+// having the given name from the oldest one.
+//
+// It can be used in BQL as `ring_buffer_values`.
+//
+//  Input: String
+//  Return Type: Array
+var ringBufferValuesFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, name data.Value) (data.Value, error) {
+	s, err := lookupRingBufferState(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	values, err := s.snapshot()
+	if err != nil {
+		return nil, err
+	}
+	return copyValue(values), nil
+})
+
+// ringBufferLenFunc returns the number of values in the ring_buffer
+// state having the given name.
+//
+// It can be used in BQL as `ring_buffer_len`.
+//
+//  Input: String
+//  Return Type: Int
+var ringBufferLenFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, name data.Value) (data.Value, error) {
+	s, err := lookupRingBufferState(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	n, err := s.len()
+	if err != nil {
+		return nil, err
+	}
+	return data.Int(n), nil
+})
@@ -0,0 +1,116 @@
+package builtin
+
+import (
+	"bytes"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestRingBufferState(t *testing.T) {
+	ctx := core.NewContext(nil)
+
+	Convey("Given a ring_buffer state with a field", t, func() {
+		st, err := createRingBufferState(ctx, data.Map{
+			"size":  data.Int(3),
+			"field": data.String("msg"),
+		})
+		So(err, ShouldBeNil)
+		So(ctx.SharedStates.Add("recent", "ring_buffer", st), ShouldBeNil)
+		Reset(func() {
+			ctx.SharedStates.Remove("recent")
+		})
+		s := st.(*ringBufferState)
+
+		values := func() data.Value {
+			v, err := ringBufferValuesFunc.Call(ctx, data.String("recent"))
+			So(err, ShouldBeNil)
+			return v
+		}
+
+		Convey("When nothing is written", func() {
+			Convey("Then ring_buffer_values should return an empty array", func() {
+				So(values(), ShouldResemble, data.Array{})
+				n, err := ringBufferLenFunc.Call(ctx, data.String("recent"))
+				So(err, ShouldBeNil)
+				So(n, ShouldEqual, data.Int(0))
+			})
+		})
+
+		Convey("When writing fewer tuples than the size", func() {
+			So(s.Write(ctx, core.NewTuple(data.Map{"msg": data.String("a")})), ShouldBeNil)
+			So(s.Write(ctx, core.NewTuple(data.Map{"msg": data.String("b")})), ShouldBeNil)
+
+			Convey("Then ring_buffer_values should return all values", func() {
+				So(values(), ShouldResemble, data.Array{data.String("a"), data.String("b")})
+			})
+		})
+
+		Convey("When writing more tuples than the size", func() {
+			for _, m := range []string{"a", "b", "c", "d", "e"} {
+				So(s.Write(ctx, core.NewTuple(data.Map{"msg": data.String(m)})), ShouldBeNil)
+			}
+
+			Convey("Then ring_buffer_values should return the last values from the oldest one", func() {
+				So(values(), ShouldResemble, data.Array{data.String("c"), data.String("d"), data.String("e")})
+				n, err := ringBufferLenFunc.Call(ctx, data.String("recent"))
+				So(err, ShouldBeNil)
+				So(n, ShouldEqual, data.Int(3))
+			})
+
+			Convey("Then a state loaded from the saved data should have the same values", func() {
+				buf := bytes.NewBuffer(nil)
+				So(s.Save(ctx, buf, data.Map{}), ShouldBeNil)
+				l, err := ringBufferStateCreator.(udf.UDSLoader).LoadState(ctx, buf, data.Map{})
+				So(err, ShouldBeNil)
+				loaded := l.(*ringBufferState)
+				So(loaded.Write(ctx, core.NewTuple(data.Map{"msg": data.String("f")})), ShouldBeNil)
+				v, err := loaded.snapshot()
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.Array{data.String("d"), data.String("e"), data.String("f")})
+			})
+		})
+
+		Convey("When writing a tuple without the field", func() {
+			err := s.Write(ctx, core.NewTuple(data.Map{"a": data.Int(1)}))
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a ring_buffer state without a field", t, func() {
+		st, err := createRingBufferState(ctx, data.Map{"size": data.Int(2)})
+		So(err, ShouldBeNil)
+		s := st.(*ringBufferState)
+
+		Convey("When writing a tuple", func() {
+			t := core.NewTuple(data.Map{"a": data.Int(1)})
+			So(s.Write(ctx, t), ShouldBeNil)
+			t.Data["a"] = data.Int(2)
+
+			Convey("Then the buffer should have a copy of the tuple's data", func() {
+				v, err := s.snapshot()
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.Array{data.Map{"a": data.Int(1)}})
+			})
+		})
+	})
+
+	Convey("Given invalid parameters for a ring_buffer state", t, func() {
+		for _, params := range []data.Map{
+			{},
+			{"size": data.Int(0)},
+			{"size": data.String("a")},
+			{"size": data.Int(1), "field": data.Int(1)},
+		} {
+			Convey("Then creating the state should fail with "+params.String(), func() {
+				_, err := createRingBufferState(ctx, params)
+				So(err, ShouldNotBeNil)
+			})
+		}
+	})
+}
@@ -0,0 +1,815 @@
+package builtin
+
+import (
+	"errors"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io"
+	"math"
+	"sort"
+	"sync"
+)
+
+// Sketches are summaries of a multiset of values which answer queries
+// approximately using much less memory than the values themselves.
+// They are represented as data.Value so that they can be returned from
+// aggregate functions, sent in tuples, and merged later, e.g. by a
+// sketch state.
+//
+// A HyperLogLog sketch is a Blob whose first byte is the precision p and
+// whose following 2^p bytes are the registers. A top-k sketch is a Map
+// having the capacity and the counters of the Space-Saving algorithm:
+//
+//	{"capacity": 100, "items": [{"value": "a", "count": 10, "error": 2}, ...]}
+
+const (
+	// hllPrecision is the number of bits of a hash value used to select
+	// a register. 2^12 registers give a standard error of about 1.6%.
+	hllPrecision = 12
+
+	// topKCapacity is the number of counters of a top-k sketch created by
+	// top_k_sketch.
+	topKCapacity = 100
+)
+
+// hashForSketch returns a hash value of v whose bits are well mixed.
+// data.Hash alone isn't good enough for HyperLogLog because hash values
+// of similar small values share many bits.
+func hashForSketch(v data.Value) uint64 {
+	h := uint64(data.Hash(v))
+	// the finalizer of MurmurHash3
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	h *= 0xc4ceb9fe1a85ec53
+	h ^= h >> 33
+	return h
+}
+
+func newHLL() data.Blob {
+	b := make(data.Blob, 1+1<<hllPrecision)
+	b[0] = hllPrecision
+	return b
+}
+
+func asHLL(v data.Value) (data.Blob, error) {
+	b, err := data.AsBlob(v)
+	if err != nil || len(b) < 1 || b[0] < 4 || b[0] > 16 || len(b) != 1+1<<b[0] {
+		return nil, fmt.Errorf("%v is not a HyperLogLog sketch", v)
+	}
+	return b, nil
+}
+
+func hllAdd(b data.Blob, v data.Value) {
+	p := uint(b[0])
+	h := hashForSketch(v)
+	idx := h >> (64 - p)
+	// rank is the position of the leftmost 1 in the remaining bits
+	rank := byte(1)
+	for w := h << p; w&(1<<63) == 0 && rank <= byte(64-p); w <<= 1 {
+		rank++
+	}
+	if rank > b[1+idx] {
+		b[1+idx] = rank
+	}
+}
+
+// hllMerge merges the registers of other into b.
+func hllMerge(b, other data.Blob) error {
+	if b[0] != other[0] {
+		return fmt.Errorf("cannot merge HyperLogLog sketches having different precisions: %d and %d",
+			b[0], other[0])
+	}
+	for i, r := range other[1:] {
+		if r > b[1+i] {
+			b[1+i] = r
+		}
+	}
+	return nil
+}
+
+func hllEstimate(b data.Blob) int64 {
+	m := float64(len(b) - 1)
+	sum := 0.0
+	zeros := 0
+	for _, r := range b[1:] {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+	alpha := 0.7213 / (1 + 1.079/m)
+	e := alpha * m * m / sum
+	if e <= 2.5*m && zeros > 0 {
+		// linear counting is more accurate for small cardinalities
+		e = m * math.Log(m/float64(zeros))
+	}
+	return int64(e + 0.5)
+}
+
+// hllUDAF builds a HyperLogLog sketch of its input values. Its state is
+// the sketch itself. Null values are ignored.
+type hllUDAF struct {
+	// sketch is true if the result is the sketch rather than the
+	// estimated number of distinct values
+	sketch bool
+}
+
+func (u *hllUDAF) Accept(arity int) bool {
+	return arity == 1
+}
+
+func (u *hllUDAF) Init(ctx *core.Context) (data.Value, error) {
+	return newHLL(), nil
+}
+
+func (u *hllUDAF) Accumulate(ctx *core.Context, state data.Value, args ...data.Value) (data.Value, error) {
+	b, err := asHLL(state)
+	if err != nil {
+		return nil, err
+	}
+	if args[0].Type() != data.TypeNull {
+		hllAdd(b, args[0])
+	}
+	return b, nil
+}
+
+func (u *hllUDAF) Retract(ctx *core.Context, state data.Value, args ...data.Value) (data.Value, error) {
+	return nil, udf.ErrNotRetractable
+}
+
+func (u *hllUDAF) Result(ctx *core.Context, state data.Value) (data.Value, error) {
+	b, err := asHLL(state)
+	if err != nil {
+		return nil, err
+	}
+	if u.sketch {
+		// the state may still be updated after this call
+		return append(data.Blob(nil), b...), nil
+	}
+	return data.Int(hllEstimate(b)), nil
+}
+
+// approxCountDistinctFunc is an aggregate function that estimates the
+// number of distinct input values using HyperLogLog. The standard error
+// of the estimate is about 1.6%. Null values are ignored.
+//
+// It can be used in BQL as `approx_count_distinct`.
+//
+//  Input: any (aggregated)
+//  Return Type: Int
+var approxCountDistinctFunc udf.UDF = udf.ConvertUDAF(&hllUDAF{sketch: false})
+
+// hllSketchFunc is an aggregate function that returns a HyperLogLog
+// sketch of the input values. Sketches can be merged by hll_merge or by
+// a sketch state and the number of distinct values can be estimated by
+// hll_estimate. Null values are ignored.
+//
+// It can be used in BQL as `hll_sketch`.
+//
+//  Input: any (aggregated)
+//  Return Type: Blob
+var hllSketchFunc udf.UDF = udf.ConvertUDAF(&hllUDAF{sketch: true})
+
+// hllMergeFunc returns a HyperLogLog sketch of the union of the values
+// summarized by the given sketches. Null values are ignored.
+//
+// It can be used in BQL as `hll_merge`.
+//
+//  Input: n * Blob
+//  Return Type: Blob (Null if all inputs are Null)
+var hllMergeFunc udf.UDF = &variadicFunc{
+	minParams: 1,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		var res data.Blob
+		for _, arg := range args {
+			if arg.Type() == data.TypeNull {
+				continue
+			}
+			b, err := asHLL(arg)
+			if err != nil {
+				return nil, err
+			}
+			if res == nil {
+				res = append(data.Blob(nil), b...)
+			} else if err := hllMerge(res, b); err != nil {
+				return nil, err
+			}
+		}
+		if res == nil {
+			return data.Null{}, nil
+		}
+		return res, nil
+	},
+}
+
+// hllEstimateFunc estimates the number of distinct values summarized by
+// a HyperLogLog sketch.
+//
+// It can be used in BQL as `hll_estimate`.
+//
+//  Input: Blob
+//  Return Type: Int
+var hllEstimateFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, arg data.Value) (data.Value, error) {
+	if arg.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	b, err := asHLL(arg)
+	if err != nil {
+		return nil, err
+	}
+	return data.Int(hllEstimate(b)), nil
+})
+
+// topKSketch is a summary of the most frequent values computed by the
+// Space-Saving algorithm. It has at most capacity counters. The count of
+// a value is never underestimated and overestimated by at most its error.
+type topKSketch struct {
+	capacity int
+	items    []topKItem
+	index    map[data.HashValue][]int
+}
+
+type topKItem struct {
+	value data.Value
+	count int64
+	err   int64
+}
+
+func newTopKSketch(capacity int) *topKSketch {
+	return &topKSketch{
+		capacity: capacity,
+		index:    map[data.HashValue][]int{},
+	}
+}
+
+func asTopKSketch(v data.Value) (*topKSketch, error) {
+	invalid := fmt.Errorf("%v is not a top-k sketch", v)
+	m, err := data.AsMap(v)
+	if err != nil || !hasKeys(m, "capacity", "items") {
+		return nil, invalid
+	}
+	c, err := data.AsInt(m["capacity"])
+	if err != nil || c <= 0 {
+		return nil, invalid
+	}
+	items, err := data.AsArray(m["items"])
+	if err != nil || len(items) > int(c) {
+		return nil, invalid
+	}
+	s := newTopKSketch(int(c))
+	for _, it := range items {
+		im, err := data.AsMap(it)
+		if err != nil || !hasKeys(im, "value", "count", "error") {
+			return nil, invalid
+		}
+		item := topKItem{value: im["value"]}
+		if item.count, err = data.AsInt(im["count"]); err != nil {
+			return nil, invalid
+		}
+		if item.err, err = data.AsInt(im["error"]); err != nil {
+			return nil, invalid
+		}
+		if s.find(item.value) >= 0 {
+			return nil, invalid
+		}
+		s.insert(item)
+	}
+	return s, nil
+}
+
+func (s *topKSketch) toMap() data.Map {
+	items := make(data.Array, len(s.items))
+	for i, it := range s.items {
+		items[i] = data.Map{
+			"value": it.value,
+			"count": data.Int(it.count),
+			"error": data.Int(it.err),
+		}
+	}
+	return data.Map{
+		"capacity": data.Int(s.capacity),
+		"items":    items,
+	}
+}
+
+func (s *topKSketch) find(v data.Value) int {
+	for _, i := range s.index[data.Hash(v)] {
+		if data.Equal(s.items[i].value, v) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *topKSketch) insert(item topKItem) {
+	h := data.Hash(item.value)
+	s.index[h] = append(s.index[h], len(s.items))
+	s.items = append(s.items, item)
+}
+
+// minCount returns the smallest count if the sketch is full and 0
+// otherwise. It's the upper bound of the count of values which aren't
+// in the sketch.
+func (s *topKSketch) minCount() int64 {
+	if len(s.items) < s.capacity {
+		return 0
+	}
+	min := s.items[0].count
+	for _, it := range s.items[1:] {
+		if it.count < min {
+			min = it.count
+		}
+	}
+	return min
+}
+
+func (s *topKSketch) add(v data.Value) {
+	if i := s.find(v); i >= 0 {
+		s.items[i].count++
+		return
+	}
+	if len(s.items) < s.capacity {
+		s.insert(topKItem{value: v, count: 1})
+		return
+	}
+	// replace the value having the smallest count
+	min := 0
+	for i, it := range s.items {
+		if it.count < s.items[min].count {
+			min = i
+		}
+	}
+	old := s.items[min]
+	h := data.Hash(old.value)
+	idx := s.index[h]
+	for j, i := range idx {
+		if i == min {
+			s.index[h] = append(idx[:j], idx[j+1:]...)
+			break
+		}
+	}
+	if len(s.index[h]) == 0 {
+		delete(s.index, h)
+	}
+	s.items[min] = topKItem{value: v, count: old.count + 1, err: old.count}
+	h = data.Hash(v)
+	s.index[h] = append(s.index[h], min)
+}
+
+// merge returns a sketch of the union of the values summarized by s and
+// o. Values missing in one of the sketches are assumed to have the
+// largest count they can have in that sketch, so that the counts are
+// still never underestimated.
+func (s *topKSketch) merge(o *topKSketch) *topKSketch {
+	capacity := s.capacity
+	if o.capacity > capacity {
+		capacity = o.capacity
+	}
+	sMin, oMin := s.minCount(), o.minCount()
+	res := newTopKSketch(capacity)
+	for _, it := range s.items {
+		item := topKItem{value: it.value, count: it.count + oMin, err: it.err + oMin}
+		if i := o.find(it.value); i >= 0 {
+			item.count = it.count + o.items[i].count
+			item.err = it.err + o.items[i].err
+		}
+		res.items = append(res.items, item)
+	}
+	for _, it := range o.items {
+		if s.find(it.value) < 0 {
+			res.items = append(res.items, topKItem{value: it.value,
+				count: it.count + sMin, err: it.err + sMin})
+		}
+	}
+	items := res.sorted()
+	if len(items) > capacity {
+		items = items[:capacity]
+	}
+	res.items = nil
+	for _, it := range items {
+		res.insert(it)
+	}
+	return res
+}
+
+// sorted returns the items in descending order of their counts.
+func (s *topKSketch) sorted() []topKItem {
+	items := append([]topKItem(nil), s.items...)
+	sort.Stable(topKItemsDescending(items))
+	return items
+}
+
+type topKItemsDescending []topKItem
+
+func (t topKItemsDescending) Len() int {
+	return len(t)
+}
+
+func (t topKItemsDescending) Less(i, j int) bool {
+	if t[i].count != t[j].count {
+		return t[i].count > t[j].count
+	}
+	return data.Less(t[i].value, t[j].value)
+}
+
+func (t topKItemsDescending) Swap(i, j int) {
+	t[i], t[j] = t[j], t[i]
+}
+
+// top returns the k most frequent values and their estimated counts.
+func (s *topKSketch) top(k int) data.Array {
+	items := s.sorted()
+	if len(items) > k {
+		items = items[:k]
+	}
+	res := make(data.Array, len(items))
+	for i, it := range items {
+		res[i] = data.Map{
+			"value": it.value,
+			"count": data.Int(it.count),
+		}
+	}
+	return res
+}
+
+// topKSketchUDAF builds a top-k sketch of its input values. Null values
+// are ignored.
+type topKSketchUDAF struct {
+}
+
+func (u *topKSketchUDAF) Accept(arity int) bool {
+	return arity == 1
+}
+
+func (u *topKSketchUDAF) Init(ctx *core.Context) (data.Value, error) {
+	return newTopKSketch(topKCapacity).toMap(), nil
+}
+
+func (u *topKSketchUDAF) Accumulate(ctx *core.Context, state data.Value, args ...data.Value) (data.Value, error) {
+	if args[0].Type() == data.TypeNull {
+		return state, nil
+	}
+	s, err := asTopKSketch(state)
+	if err != nil {
+		return nil, err
+	}
+	s.add(args[0])
+	return s.toMap(), nil
+}
+
+func (u *topKSketchUDAF) Retract(ctx *core.Context, state data.Value, args ...data.Value) (data.Value, error) {
+	return nil, udf.ErrNotRetractable
+}
+
+func (u *topKSketchUDAF) Result(ctx *core.Context, state data.Value) (data.Value, error) {
+	s, err := asTopKSketch(state)
+	if err != nil {
+		return nil, err
+	}
+	// the state may still be updated after this call
+	return s.toMap(), nil
+}
+
+// topKSketchFunc is an aggregate function that returns a top-k sketch of
+// the input values having 100 counters. Sketches can be merged by
+// top_k_merge or by a sketch state and the most frequent values can be
+// obtained by top_k_estimate. Null values are ignored.
+//
+// It can be used in BQL as `top_k_sketch`.
+//
+//  Input: any (aggregated)
+//  Return Type: Map
+var topKSketchFunc udf.UDF = udf.ConvertUDAF(&topKSketchUDAF{})
+
+func asK(v data.Value) (int, error) {
+	k, err := data.AsInt(v)
+	if err != nil || k <= 0 {
+		return 0, fmt.Errorf("k must be a positive integer: %v", v)
+	}
+	return int(k), nil
+}
+
+type approxTopKFuncTmpl struct {
+}
+
+func (f *approxTopKFuncTmpl) Accept(arity int) bool {
+	return arity == 2
+}
+
+func (f *approxTopKFuncTmpl) IsAggregationParameter(k int) bool {
+	return k == 0
+}
+
+func (f *approxTopKFuncTmpl) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("function takes exactly two arguments")
+	}
+	arr, err := data.AsArray(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("function needs array input, not %T", args[0])
+	}
+	k, err := asK(args[1])
+	if err != nil {
+		return nil, err
+	}
+	capacity := topKCapacity
+	if k > capacity {
+		capacity = k
+	}
+	s := newTopKSketch(capacity)
+	for _, v := range arr {
+		if v.Type() != data.TypeNull {
+			s.add(v)
+		}
+	}
+	return s.top(k), nil
+}
+
+// approxTopKFunc is an aggregate function that returns the k most
+// frequent input values and their counts in descending order of the
+// counts. The counts are computed by the Space-Saving algorithm with at
+// least 100 counters, so they can be larger than the actual ones when
+// there are many distinct values. Null values are ignored.
+//
+// It can be used in BQL as `approx_top_k`.
+//
+//  Input: any (aggregated), Int
+//  Return Type: Array of Maps having "value" and "count"
+var approxTopKFunc udf.UDF = &approxTopKFuncTmpl{}
+
+// topKMergeFunc returns a top-k sketch of the union of the values
+// summarized by the given sketches. Null values are ignored.
+//
+// It can be used in BQL as `top_k_merge`.
+//
+//  Input: n * Map
+//  Return Type: Map (Null if all inputs are Null)
+var topKMergeFunc udf.UDF = &variadicFunc{
+	minParams: 1,
+	varFun: func(args ...data.Value) (data.Value, error) {
+		var res *topKSketch
+		for _, arg := range args {
+			if arg.Type() == data.TypeNull {
+				continue
+			}
+			s, err := asTopKSketch(arg)
+			if err != nil {
+				return nil, err
+			}
+			if res == nil {
+				res = s
+			} else {
+				res = res.merge(s)
+			}
+		}
+		if res == nil {
+			return data.Null{}, nil
+		}
+		return res.toMap(), nil
+	},
+}
+
+// topKEstimateFunc returns the k most frequent values summarized by a
+// top-k sketch and their estimated counts in descending order of the
+// counts.
+//
+// It can be used in BQL as `top_k_estimate`.
+//
+//  Input: Map, Int
+//  Return Type: Array of Maps having "value" and "count"
+var topKEstimateFunc udf.UDF = udf.BinaryFunc(func(ctx *core.Context, sketch, k data.Value) (data.Value, error) {
+	if sketch.Type() == data.TypeNull || k.Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	s, err := asTopKSketch(sketch)
+	if err != nil {
+		return nil, err
+	}
+	n, err := asK(k)
+	if err != nil {
+		return nil, err
+	}
+	return s.top(n), nil
+})
+
+// sketchState is a state holding a sketch which all sketches written to
+// it are merged into. It can be used to summarize values of a stream
+// over a longer period than a window, e.g. by writing sketches computed
+// for each tumbling window. Either HyperLogLog or top-k sketches can be
+// written to a state, but not both. Merging HyperLogLog sketches is
+// idempotent, whereas values summarized by more than one top-k sketch
+// written to a state are counted more than once.
+//
+// It can be created in BQL as `sketch` with the following parameters:
+//
+//	field: the path of the field having the sketch (default: "sketch")
+//
+// For example:
+//
+//	CREATE STATE daily_users TYPE sketch;
+//	CREATE STREAM user_sketches AS SELECT RSTREAM hll_sketch(user_id) AS sketch
+//	    FROM events [RANGE 60 SECONDS TUMBLING];
+//	CREATE SINK daily_users_sink TYPE uds WITH name = "daily_users";
+//	INSERT INTO daily_users_sink FROM user_sketches;
+//
+// The merged sketch can be obtained by the sketch_state function, e.g.
+// hll_estimate(sketch_state("daily_users")).
+type sketchState struct {
+	field     string
+	fieldPath data.Path
+
+	m sync.RWMutex
+	// sketch is the merged sketch. It is nil if no sketch has been
+	// written yet.
+	sketch     data.Value
+	terminated bool
+}
+
+var (
+	_ core.LoadableSharedState = &sketchState{}
+	_ core.Writer              = &sketchState{}
+)
+
+var sketchStateCreator udf.UDSCreator = &stateCreator{
+	typeName: "sketch",
+	create:   createSketchState,
+	restore:  restoreSketchState,
+}
+
+func createSketchState(ctx *core.Context, params data.Map) (core.SharedState, error) {
+	field, path, err := pathParamOrDefault(params, "field", "sketch")
+	if err != nil {
+		return nil, err
+	}
+	return &sketchState{
+		field:     field,
+		fieldPath: path,
+	}, nil
+}
+
+func restoreSketchState(d data.Map) (core.SharedState, error) {
+	st, err := createSketchState(nil, data.Map{"field": d["field"]})
+	if err != nil {
+		return nil, fmt.Errorf("the saved sketch state doesn't have a valid field: %v", err)
+	}
+	s := st.(*sketchState)
+	if v, ok := d["sketch"]; ok && v.Type() != data.TypeNull {
+		sketch, err := mergeSketches(nil, v)
+		if err != nil {
+			return nil, fmt.Errorf("the saved sketch state doesn't have a valid sketch: %v", err)
+		}
+		s.sketch = sketch
+	}
+	return s, nil
+}
+
+func (s *sketchState) Terminate(ctx *core.Context) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.sketch = nil
+	s.terminated = true
+	return nil
+}
+
+// Write merges the sketch in the tuple's field into the state. A NULL
+// sketch is ignored.
+func (s *sketchState) Write(ctx *core.Context, t *core.Tuple) error {
+	v, err := t.Data.Get(s.fieldPath)
+	if err != nil {
+		return err
+	}
+	if v.Type() == data.TypeNull {
+		return nil
+	}
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.terminated {
+		return errors.New("the state is already terminated")
+	}
+	merged, err := mergeSketches(s.sketch, v)
+	if err != nil {
+		return err
+	}
+	s.sketch = merged
+	return nil
+}
+
+// mergeSketches merges other into the sketch and returns the result.
+// The sketch can be nil and will be modified. other isn't modified.
+func mergeSketches(sketch, other data.Value) (data.Value, error) {
+	switch other.Type() {
+	case data.TypeBlob:
+		o, err := asHLL(other)
+		if err != nil {
+			return nil, err
+		}
+		if sketch == nil {
+			return append(data.Blob(nil), o...), nil
+		}
+		b, err := asHLL(sketch)
+		if err != nil {
+			return nil, errors.New("cannot merge a HyperLogLog sketch into a top-k sketch")
+		}
+		if err := hllMerge(b, o); err != nil {
+			return nil, err
+		}
+		return b, nil
+
+	case data.TypeMap:
+		o, err := asTopKSketch(other)
+		if err != nil {
+			return nil, err
+		}
+		if sketch == nil {
+			return o.toMap(), nil
+		}
+		t, err := asTopKSketch(sketch)
+		if err != nil {
+			return nil, errors.New("cannot merge a top-k sketch into a HyperLogLog sketch")
+		}
+		return t.merge(o).toMap(), nil
+
+	default:
+		return nil, fmt.Errorf("%v is not a sketch", other)
+	}
+}
+
+// value returns a copy of the merged sketch or Null if no sketch has
+// been written.
+func (s *sketchState) value() (data.Value, error) {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	if s.terminated {
+		return nil, errors.New("the state is already terminated")
+	}
+	if s.sketch == nil {
+		return data.Null{}, nil
+	}
+	return mergeSketches(nil, s.sketch)
+}
+
+// Save writes the field parameter and the merged sketch of the state.
+func (s *sketchState) Save(ctx *core.Context, w io.Writer, params data.Map) error {
+	s.m.RLock()
+	if s.terminated {
+		s.m.RUnlock()
+		return errors.New("the state is already terminated")
+	}
+	d := data.Map{
+		"field":  data.String(s.field),
+		"sketch": data.Null{},
+	}
+	if s.sketch != nil {
+		d["sketch"] = copyValue(s.sketch)
+	}
+	s.m.RUnlock()
+
+	return saveStateData(w, "sketch", d)
+}
+
+// Load replaces the field parameter and the merged sketch of the state
+// with the saved ones.
+func (s *sketchState) Load(ctx *core.Context, r io.Reader, params data.Map) error {
+	d, err := loadStateData(r, "sketch")
+	if err != nil {
+		return err
+	}
+	st, err := restoreSketchState(d)
+	if err != nil {
+		return err
+	}
+	n := st.(*sketchState)
+
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.terminated {
+		return errors.New("the state is already terminated")
+	}
+	s.field, s.fieldPath = n.field, n.fieldPath
+	s.sketch = n.sketch
+	return nil
+}
+
+// sketchStateFunc returns the sketch merged by the sketch state having
+// the given name. It returns Null if no sketch has been written to the
+// state yet.
+//
+// It can be used in BQL as `sketch_state`.
+//
+//  Input: String
+//  Return Type: Blob or Map
+var sketchStateFunc udf.UDF = udf.UnaryFunc(func(ctx *core.Context, name data.Value) (data.Value, error) {
+	st, n, err := lookupState(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := st.(*sketchState)
+	if !ok {
+		return nil, fmt.Errorf("state '%s' isn't a sketch state", n)
+	}
+	return s.value()
+})
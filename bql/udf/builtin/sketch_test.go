@@ -0,0 +1,402 @@
+package builtin
+
+import (
+	"bytes"
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"testing"
+)
+
+func TestHLLFuncs(t *testing.T) {
+	ctx := core.NewContext(nil)
+	seq := func(from, to int) data.Array {
+		arr := data.Array{}
+		for i := from; i < to; i++ {
+			arr = append(arr, data.Int(i))
+		}
+		return arr
+	}
+	relErr := func(v data.Value, expected int) float64 {
+		n, err := data.AsInt(v)
+		So(err, ShouldBeNil)
+		return math.Abs(float64(n)-float64(expected)) / float64(expected)
+	}
+
+	Convey("Given the approx_count_distinct function", t, func() {
+		f := approxCountDistinctFunc
+
+		for _, n := range []int{10, 1000, 50000} {
+			n := n
+			Convey(fmt.Sprintf("When evaluating it on %d distinct values", n), func() {
+				v, err := f.Call(ctx, seq(0, n))
+				So(err, ShouldBeNil)
+
+				Convey("Then the estimate should be close to the actual number", func() {
+					So(relErr(v, n), ShouldBeLessThan, 0.05)
+				})
+			})
+		}
+
+		Convey("When evaluating it on duplicated values and nulls", func() {
+			arr := append(seq(0, 100), seq(0, 100)...)
+			arr = append(arr, data.Float(1), data.Null{}, data.String("1"))
+			v, err := f.Call(ctx, arr)
+
+			Convey("Then they should be counted once", func() {
+				So(err, ShouldBeNil)
+				expected, err := f.Call(ctx, append(seq(0, 100), data.String("1")))
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, expected)
+				So(relErr(v, 101), ShouldBeLessThan, 0.05)
+			})
+		})
+
+		Convey("When evaluating it on an empty input", func() {
+			v, err := f.Call(ctx, data.Array{})
+
+			Convey("Then it should return 0", func() {
+				So(err, ShouldBeNil)
+				So(v, ShouldEqual, data.Int(0))
+			})
+		})
+
+		Convey("Then it should not be retractable", func() {
+			acc, err := f.(udf.IncrementalUDF).NewAccumulator(ctx)
+			So(err, ShouldBeNil)
+			So(acc.Add(data.Int(1)), ShouldBeNil)
+			So(acc.Retract(data.Int(1)), ShouldEqual, udf.ErrNotRetractable)
+		})
+	})
+
+	Convey("Given HyperLogLog sketches of overlapping values", t, func() {
+		s1, err := hllSketchFunc.Call(ctx, seq(0, 3000))
+		So(err, ShouldBeNil)
+		s2, err := hllSketchFunc.Call(ctx, seq(2000, 6000))
+		So(err, ShouldBeNil)
+
+		Convey("When merging them with hll_merge", func() {
+			m, err := hllMergeFunc.Call(ctx, s1, data.Null{}, s2)
+			So(err, ShouldBeNil)
+
+			Convey("Then the estimate should be the number of the union", func() {
+				v, err := hllEstimateFunc.Call(ctx, m)
+				So(err, ShouldBeNil)
+				So(relErr(v, 6000), ShouldBeLessThan, 0.05)
+			})
+
+			Convey("Then the merged sketches should not be modified", func() {
+				v, err := hllEstimateFunc.Call(ctx, s1)
+				So(err, ShouldBeNil)
+				So(relErr(v, 3000), ShouldBeLessThan, 0.05)
+			})
+		})
+
+		Convey("When merging a sketch having a different precision", func() {
+			b := make(data.Blob, 1+1<<10)
+			b[0] = 10
+			_, err := hllMergeFunc.Call(ctx, s1, b)
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When passing a value which isn't a sketch", func() {
+			_, err1 := hllMergeFunc.Call(ctx, s1, data.Blob("abc"))
+			_, err2 := hllEstimateFunc.Call(ctx, data.String("abc"))
+
+			Convey("Then it should fail", func() {
+				So(err1, ShouldNotBeNil)
+				So(err2, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When merging only nulls", func() {
+			v, err := hllMergeFunc.Call(ctx, data.Null{}, data.Null{})
+
+			Convey("Then the result should be null", func() {
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.Null{})
+			})
+		})
+	})
+}
+
+func TestTopKFuncs(t *testing.T) {
+	ctx := core.NewContext(nil)
+	item := func(v data.Value, c int64) data.Map {
+		return data.Map{"value": v, "count": data.Int(c)}
+	}
+
+	Convey("Given the approx_top_k function", t, func() {
+		f := approxTopKFunc
+
+		Convey("Then only its first parameter should be aggregated", func() {
+			So(f.Accept(2), ShouldBeTrue)
+			So(f.IsAggregationParameter(0), ShouldBeTrue)
+			So(f.IsAggregationParameter(1), ShouldBeFalse)
+		})
+
+		Convey("When evaluating it on a few distinct values", func() {
+			arr := data.Array{data.String("b"), data.String("a"), data.Null{},
+				data.String("c"), data.String("a"), data.String("b"), data.String("a")}
+			v, err := f.Call(ctx, arr, data.Int(2))
+
+			Convey("Then it should return the exact counts", func() {
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.Array{
+					item(data.String("a"), 3), item(data.String("b"), 2)})
+			})
+		})
+
+		Convey("When evaluating it on many distinct values", func() {
+			// value i appears 1000 / (i + 1) times for i < 10 and once for
+			// 1000 other values
+			arr := data.Array{}
+			for i := 0; i < 10; i++ {
+				for j := 0; j < 1000/(i+1); j++ {
+					arr = append(arr, data.Int(i))
+				}
+			}
+			for i := 0; i < 1000; i++ {
+				arr = append(arr, data.Int(1000+i))
+			}
+			v, err := f.Call(ctx, arr, data.Int(3))
+
+			Convey("Then it should return the most frequent values", func() {
+				So(err, ShouldBeNil)
+				top, err := data.AsArray(v)
+				So(err, ShouldBeNil)
+				So(len(top), ShouldEqual, 3)
+				for i, t := range top {
+					m, err := data.AsMap(t)
+					So(err, ShouldBeNil)
+					So(m["value"], ShouldEqual, data.Int(i))
+					c, err := data.AsInt(m["count"])
+					So(err, ShouldBeNil)
+					So(c, ShouldBeGreaterThanOrEqualTo, 1000/(i+1))
+				}
+			})
+		})
+
+		Convey("When evaluating it with an invalid k", func() {
+			_, err1 := f.Call(ctx, data.Array{data.Int(1)}, data.Int(0))
+			_, err2 := f.Call(ctx, data.Array{data.Int(1)}, data.String("a"))
+
+			Convey("Then it should fail", func() {
+				So(err1, ShouldNotBeNil)
+				So(err2, ShouldNotBeNil)
+			})
+		})
+
+		Convey("Then it should equal the one in the default registry", func() {
+			regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup("approx_top_k", 2)
+			So(err, ShouldBeNil)
+			So(regFun, ShouldEqual, f)
+		})
+	})
+
+	Convey("Given top-k sketches", t, func() {
+		s1, err := topKSketchFunc.Call(ctx, data.Array{data.Int(1), data.Int(2), data.Int(1)})
+		So(err, ShouldBeNil)
+		s2, err := topKSketchFunc.Call(ctx, data.Array{data.Int(2), data.Int(3), data.Float(2)})
+		So(err, ShouldBeNil)
+
+		Convey("When merging them with top_k_merge", func() {
+			m, err := topKMergeFunc.Call(ctx, s1, data.Null{}, s2)
+			So(err, ShouldBeNil)
+
+			Convey("Then the counts should be summed up", func() {
+				v, err := topKEstimateFunc.Call(ctx, m, data.Int(10))
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.Array{
+					item(data.Int(2), 3), item(data.Int(1), 2), item(data.Int(3), 1)})
+			})
+		})
+
+		Convey("When merging full sketches", func() {
+			a := newTopKSketch(2)
+			for _, v := range []int64{1, 1, 1, 2, 2, 3} {
+				a.add(data.Int(v))
+			}
+			b := newTopKSketch(2)
+			for _, v := range []int64{4, 4, 4, 4, 1, 5} {
+				b.add(data.Int(v))
+			}
+			m := a.merge(b)
+
+			Convey("Then the counts should not be underestimated", func() {
+				// the actual counts of 4 and 1 are both 4
+				So(m.top(2), ShouldResemble, data.Array{
+					item(data.Int(4), 7), item(data.Int(1), 5)})
+			})
+		})
+
+		Convey("When passing a value which isn't a sketch", func() {
+			_, err1 := topKMergeFunc.Call(ctx, s1, data.Map{"capacity": data.Int(1)})
+			_, err2 := topKEstimateFunc.Call(ctx, data.Map{"capacity": data.Int(1),
+				"items": data.Array{data.Map{"value": data.Int(1)}}}, data.Int(1))
+
+			Convey("Then it should fail", func() {
+				So(err1, ShouldNotBeNil)
+				So(err2, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestSketchState(t *testing.T) {
+	ctx := core.NewContext(nil)
+	seq := func(from, to int) data.Array {
+		arr := data.Array{}
+		for i := from; i < to; i++ {
+			arr = append(arr, data.Int(i))
+		}
+		return arr
+	}
+
+	Convey("Given a sketch state", t, func() {
+		st, err := createSketchState(ctx, data.Map{})
+		So(err, ShouldBeNil)
+		So(ctx.SharedStates.Add("sketches", "sketch", st), ShouldBeNil)
+		Reset(func() {
+			ctx.SharedStates.Remove("sketches")
+		})
+		s := st.(*sketchState)
+
+		Convey("When nothing is written", func() {
+			v, err := sketchStateFunc.Call(ctx, data.String("sketches"))
+
+			Convey("Then sketch_state should return null", func() {
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.Null{})
+			})
+		})
+
+		Convey("When writing HyperLogLog sketches", func() {
+			for i := 0; i < 3; i++ {
+				sk, err := hllSketchFunc.Call(ctx, seq(i*1000, i*1000+1500))
+				So(err, ShouldBeNil)
+				So(s.Write(ctx, core.NewTuple(data.Map{"sketch": sk})), ShouldBeNil)
+			}
+			So(s.Write(ctx, core.NewTuple(data.Map{"sketch": data.Null{}})), ShouldBeNil)
+
+			Convey("Then sketch_state should return the merged sketch", func() {
+				v, err := sketchStateFunc.Call(ctx, data.String("sketches"))
+				So(err, ShouldBeNil)
+				n, err := hllEstimateFunc.Call(ctx, v)
+				So(err, ShouldBeNil)
+				c, _ := data.AsInt(n)
+				So(math.Abs(float64(c)-3500)/3500, ShouldBeLessThan, 0.05)
+			})
+
+			Convey("Then writing a top-k sketch should fail", func() {
+				sk, err := topKSketchFunc.Call(ctx, seq(0, 10))
+				So(err, ShouldBeNil)
+				So(s.Write(ctx, core.NewTuple(data.Map{"sketch": sk})), ShouldNotBeNil)
+			})
+		})
+
+		Convey("When writing top-k sketches", func() {
+			for i := 0; i < 3; i++ {
+				sk, err := topKSketchFunc.Call(ctx, data.Array{data.Int(i), data.Int(1)})
+				So(err, ShouldBeNil)
+				So(s.Write(ctx, core.NewTuple(data.Map{"sketch": sk})), ShouldBeNil)
+			}
+
+			Convey("Then sketch_state should return the merged sketch", func() {
+				v, err := sketchStateFunc.Call(ctx, data.String("sketches"))
+				So(err, ShouldBeNil)
+				top, err := topKEstimateFunc.Call(ctx, v, data.Int(1))
+				So(err, ShouldBeNil)
+				So(top, ShouldResemble, data.Array{
+					data.Map{"value": data.Int(1), "count": data.Int(4)}})
+			})
+		})
+
+		Convey("When writing a value which isn't a sketch", func() {
+			err1 := s.Write(ctx, core.NewTuple(data.Map{"sketch": data.Int(1)}))
+			err2 := s.Write(ctx, core.NewTuple(data.Map{"other": data.Int(1)}))
+
+			Convey("Then it should fail", func() {
+				So(err1, ShouldNotBeNil)
+				So(err2, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When terminating it", func() {
+			So(s.Terminate(ctx), ShouldBeNil)
+
+			Convey("Then it should not be written or read", func() {
+				sk, err := hllSketchFunc.Call(ctx, seq(0, 10))
+				So(err, ShouldBeNil)
+				So(s.Write(ctx, core.NewTuple(data.Map{"sketch": sk})), ShouldNotBeNil)
+				_, err = s.value()
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given a sketch state with a custom field", t, func() {
+		st, err := createSketchState(ctx, data.Map{"field": data.String("s.hll")})
+		So(err, ShouldBeNil)
+		s := st.(*sketchState)
+
+		Convey("When writing a sketch in the field", func() {
+			sk, err := hllSketchFunc.Call(ctx, seq(0, 10))
+			So(err, ShouldBeNil)
+			So(s.Write(ctx, core.NewTuple(data.Map{"s": data.Map{"hll": sk}})), ShouldBeNil)
+
+			Convey("Then it should be merged", func() {
+				v, err := s.value()
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, sk)
+			})
+
+			Convey("Then a state loaded from the saved data should have the same sketch and field", func() {
+				buf := bytes.NewBuffer(nil)
+				So(s.Save(ctx, buf, data.Map{}), ShouldBeNil)
+				l, err := sketchStateCreator.(udf.UDSLoader).LoadState(ctx, buf, data.Map{})
+				So(err, ShouldBeNil)
+				loaded := l.(*sketchState)
+				v, err := loaded.value()
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, sk)
+				So(loaded.Write(ctx, core.NewTuple(data.Map{"s": data.Map{"hll": sk}})), ShouldBeNil)
+			})
+		})
+	})
+
+	Convey("Given invalid parameters for a sketch state", t, func() {
+		_, err1 := createSketchState(ctx, data.Map{"field": data.Int(1)})
+		_, err2 := createSketchState(ctx, data.Map{"field": data.String("a[")})
+
+		Convey("Then it should not be created", func() {
+			So(err1, ShouldNotBeNil)
+			So(err2, ShouldNotBeNil)
+		})
+	})
+
+	Convey("Given a state which isn't a sketch state", t, func() {
+		kv, err := createKVState(ctx, data.Map{"key": data.String("id")})
+		So(err, ShouldBeNil)
+		So(ctx.SharedStates.Add("not_sketch", "kv", kv), ShouldBeNil)
+		Reset(func() {
+			ctx.SharedStates.Remove("not_sketch")
+		})
+
+		Convey("When calling sketch_state with it", func() {
+			_, err1 := sketchStateFunc.Call(ctx, data.String("not_sketch"))
+			_, err2 := sketchStateFunc.Call(ctx, data.String("missing"))
+
+			Convey("Then it should fail", func() {
+				So(err1, ShouldNotBeNil)
+				So(err2, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,215 @@
+package builtin
+
+import (
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io"
+	"time"
+)
+
+// stateFormatVersion is the version of the format in which builtin
+// states are saved. It must be incremented when the format changes so
+// that old data can still be loaded.
+const stateFormatVersion = 1
+
+// savedState is the data written by Save methods of builtin states.
+type savedState struct {
+	FormatVersion int
+	Type          string
+	Data          savedValue
+}
+
+// savedValue is a representation of data.Value which can be encoded by
+// encoding/gob. Unlike msgpack used for tuples, it keeps the type of
+// every value, e.g. Blobs aren't converted to Strings.
+type savedValue struct {
+	Type   data.TypeID
+	Bool   bool
+	Int    int64
+	Float  float64
+	String string
+	Blob   []byte
+	Time   time.Time
+	Array  []savedValue
+	Map    map[string]savedValue
+}
+
+func newSavedValue(v data.Value) savedValue {
+	s := savedValue{Type: v.Type()}
+	switch v.Type() {
+	case data.TypeBool:
+		s.Bool, _ = data.AsBool(v)
+	case data.TypeInt:
+		s.Int, _ = data.AsInt(v)
+	case data.TypeFloat:
+		s.Float, _ = data.AsFloat(v)
+	case data.TypeString:
+		s.String, _ = data.AsString(v)
+	case data.TypeBlob:
+		s.Blob, _ = data.AsBlob(v)
+	case data.TypeTimestamp:
+		s.Time, _ = data.AsTimestamp(v)
+	case data.TypeArray:
+		a, _ := data.AsArray(v)
+		s.Array = make([]savedValue, len(a))
+		for i, e := range a {
+			s.Array[i] = newSavedValue(e)
+		}
+	case data.TypeMap:
+		m, _ := data.AsMap(v)
+		s.Map = make(map[string]savedValue, len(m))
+		for k, e := range m {
+			s.Map[k] = newSavedValue(e)
+		}
+	}
+	return s
+}
+
+func (s *savedValue) value() (data.Value, error) {
+	switch s.Type {
+	case data.TypeNull:
+		return data.Null{}, nil
+	case data.TypeBool:
+		return data.Bool(s.Bool), nil
+	case data.TypeInt:
+		return data.Int(s.Int), nil
+	case data.TypeFloat:
+		return data.Float(s.Float), nil
+	case data.TypeString:
+		return data.String(s.String), nil
+	case data.TypeBlob:
+		// gob decodes an empty slice as nil
+		return append(data.Blob{}, s.Blob...), nil
+	case data.TypeTimestamp:
+		return data.Timestamp(s.Time), nil
+	case data.TypeArray:
+		a := make(data.Array, len(s.Array))
+		for i := range s.Array {
+			v, err := s.Array[i].value()
+			if err != nil {
+				return nil, err
+			}
+			a[i] = v
+		}
+		return a, nil
+	case data.TypeMap:
+		m := make(data.Map, len(s.Map))
+		for k, e := range s.Map {
+			v, err := e.value()
+			if err != nil {
+				return nil, err
+			}
+			m[k] = v
+		}
+		return m, nil
+	default:
+		return nil, fmt.Errorf("unknown type id: %v", s.Type)
+	}
+}
+
+// saveStateData writes the data of a builtin state having the given type.
+func saveStateData(w io.Writer, typeName string, d data.Map) error {
+	return gob.NewEncoder(w).Encode(&savedState{
+		FormatVersion: stateFormatVersion,
+		Type:          typeName,
+		Data:          newSavedValue(d),
+	})
+}
+
+// loadStateData reads the data of a builtin state written by
+// saveStateData. It fails if the data was saved by a state having a
+// different type.
+func loadStateData(r io.Reader, typeName string) (data.Map, error) {
+	s := savedState{}
+	if err := gob.NewDecoder(r).Decode(&s); err != nil {
+		return nil, fmt.Errorf("cannot read the saved state: %v", err)
+	}
+	if s.FormatVersion != stateFormatVersion {
+		return nil, fmt.Errorf("unsupported format version of a saved state: %v", s.FormatVersion)
+	}
+	if s.Type != typeName {
+		return nil, fmt.Errorf("the saved state has the type '%v' instead of '%v'", s.Type, typeName)
+	}
+	v, err := s.Data.value()
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the saved state: %v", err)
+	}
+	return data.AsMap(v)
+}
+
+// stateCreator is a UDSCreator of a builtin state which can also load
+// the state from the data saved by the state's Save method.
+type stateCreator struct {
+	typeName string
+	create   func(ctx *core.Context, params data.Map) (core.SharedState, error)
+	// restore creates a state from the data saved by saveStateData.
+	restore func(d data.Map) (core.SharedState, error)
+}
+
+func (c *stateCreator) CreateState(ctx *core.Context, params data.Map) (core.SharedState, error) {
+	return c.create(ctx, params)
+}
+
+func (c *stateCreator) LoadState(ctx *core.Context, r io.Reader, params data.Map) (core.SharedState, error) {
+	d, err := loadStateData(r, c.typeName)
+	if err != nil {
+		return nil, err
+	}
+	return c.restore(d)
+}
+
+// pathParam returns the value of a parameter having the path of a field
+// and the compiled path. It returns an empty string and a nil path if the
+// parameter is missing and isn't required.
+func pathParam(params data.Map, name string, required bool) (string, data.Path, error) {
+	v, ok := params[name]
+	if !ok {
+		if required {
+			return "", nil, fmt.Errorf("'%v' parameter is missing", name)
+		}
+		return "", nil, nil
+	}
+	s, err := data.AsString(v)
+	if err != nil {
+		return "", nil, fmt.Errorf("'%v' parameter must be a string: %v", name, err)
+	}
+	p, err := data.CompilePath(s)
+	if err != nil {
+		return "", nil, fmt.Errorf("'%v' parameter doesn't have a valid path: %v", name, err)
+	}
+	return s, p, nil
+}
+
+// pathParamOrDefault is like pathParam, but it returns the default path
+// if the parameter is missing.
+func pathParamOrDefault(params data.Map, name, def string) (string, data.Path, error) {
+	if _, ok := params[name]; !ok {
+		return def, data.MustCompilePath(def), nil
+	}
+	return pathParam(params, name, true)
+}
+
+// copyValue returns a deep copy of a value.
+func copyValue(v data.Value) data.Value {
+	return data.Map{"v": v}.Copy()["v"]
+}
+
+// lookupState returns the state having the given name. It's used by
+// UDFs accessing states.
+func lookupState(ctx *core.Context, name data.Value) (core.SharedState, string, error) {
+	n, err := data.AsString(name)
+	if err != nil {
+		return nil, "", fmt.Errorf("cannot interpret %s as a state name", name)
+	}
+	if ctx == nil || ctx.SharedStates == nil {
+		return nil, "", errors.New("states cannot be used in this context")
+	}
+	s, err := ctx.SharedStates.Get(n)
+	if err != nil {
+		return nil, "", err
+	}
+	return s, n, nil
+}
@@ -0,0 +1,64 @@
+package builtin
+
+import (
+	"bytes"
+	"encoding/gob"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+	"time"
+)
+
+func TestSavedStateData(t *testing.T) {
+	Convey("Given data having values of all types", t, func() {
+		d := data.Map{
+			"null":   data.Null{},
+			"bool":   data.True,
+			"int":    data.Int(-1),
+			"float":  data.Float(1.5),
+			"string": data.String("a"),
+			"blob":   data.Blob{0, 1, 2},
+			"time":   data.Timestamp(time.Date(2015, time.May, 1, 2, 3, 4, 5, time.UTC)),
+			"array":  data.Array{data.Int(1), data.Array{}, data.Map{}},
+			"map":    data.Map{"a": data.Map{"b": data.Blob{}}},
+		}
+
+		Convey("When saving it", func() {
+			buf := bytes.NewBuffer(nil)
+			So(saveStateData(buf, "test", d), ShouldBeNil)
+			saved := buf.Bytes()
+
+			Convey("Then loading it should restore the same values", func() {
+				l, err := loadStateData(bytes.NewReader(saved), "test")
+				So(err, ShouldBeNil)
+				So(l, ShouldResemble, d)
+			})
+
+			Convey("Then loading it as another type should fail", func() {
+				_, err := loadStateData(bytes.NewReader(saved), "other")
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When saving it with an unsupported format version", func() {
+			buf := bytes.NewBuffer(nil)
+			So(gob.NewEncoder(buf).Encode(&savedState{
+				FormatVersion: stateFormatVersion + 1,
+				Type:          "test",
+				Data:          newSavedValue(d),
+			}), ShouldBeNil)
+
+			Convey("Then loading it should fail", func() {
+				_, err := loadStateData(buf, "test")
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+
+	Convey("Given broken data", t, func() {
+		Convey("Then loading it should fail", func() {
+			_, err := loadStateData(bytes.NewReader([]byte("broken")), "test")
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
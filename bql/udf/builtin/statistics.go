@@ -0,0 +1,410 @@
+package builtin
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"math"
+	"sort"
+)
+
+// asNumber converts a numeric value to a float64. It returns false if the
+// value is NULL and an error if the value isn't a number.
+func asNumber(v data.Value) (float64, bool, error) {
+	switch v.Type() {
+	case data.TypeInt:
+		i, _ := data.AsInt(v)
+		return float64(i), true, nil
+	case data.TypeFloat:
+		f, _ := data.AsFloat(v)
+		return f, true, nil
+	case data.TypeNull:
+		return 0, false, nil
+	default:
+		return 0, false, fmt.Errorf("cannot interpret %s (%T) as a number", v, v)
+	}
+}
+
+// hasKeys returns true if the map has all the given keys.
+func hasKeys(m data.Map, keys ...string) bool {
+	for _, k := range keys {
+		if _, ok := m[k]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// moments holds the number of values, their means, the sums of squared
+// deviations from the means, and the sum of the products of deviations
+// of pairs of values. They are updated by Welford's algorithm so that
+// values can be added and removed without losing much precision. Only
+// the x part is used when computing statistics of a single variable.
+type moments struct {
+	n   int64
+	mx  float64
+	my  float64
+	m2x float64
+	m2y float64
+	cxy float64
+}
+
+var momentKeys = []string{"mean_x", "mean_y", "m2_x", "m2_y", "c_xy"}
+
+func (m *moments) fields() []*float64 {
+	return []*float64{&m.mx, &m.my, &m.m2x, &m.m2y, &m.cxy}
+}
+
+func (m *moments) toMap() data.Map {
+	res := data.Map{"n": data.Int(m.n)}
+	for i, f := range m.fields() {
+		res[momentKeys[i]] = data.Float(*f)
+	}
+	return res
+}
+
+func momentsFromMap(v data.Value) (*moments, error) {
+	s, err := data.AsMap(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid state: %v", err)
+	}
+	if !hasKeys(s, "n") || !hasKeys(s, momentKeys...) {
+		return nil, fmt.Errorf("invalid state: %v", v)
+	}
+	m := &moments{}
+	if m.n, err = data.AsInt(s["n"]); err != nil {
+		return nil, fmt.Errorf("invalid state: %v", err)
+	}
+	for i, f := range m.fields() {
+		if *f, err = data.AsFloat(s[momentKeys[i]]); err != nil {
+			return nil, fmt.Errorf("invalid state: %v", err)
+		}
+	}
+	return m, nil
+}
+
+func (m *moments) add(x, y float64) {
+	m.n++
+	dx := x - m.mx
+	m.mx += dx / float64(m.n)
+	dy := y - m.my
+	m.my += dy / float64(m.n)
+	m.m2x += dx * (x - m.mx)
+	m.m2y += dy * (y - m.my)
+	m.cxy += dx * (y - m.my)
+}
+
+func (m *moments) remove(x, y float64) {
+	if m.n <= 1 {
+		*m = moments{}
+		return
+	}
+	n := float64(m.n)
+	mx := (n*m.mx - x) / (n - 1)
+	my := (n*m.my - y) / (n - 1)
+	m.m2x -= (x - mx) * (x - m.mx)
+	m.m2y -= (y - my) * (y - m.my)
+	m.cxy -= (x - mx) * (y - m.my)
+	m.n--
+	m.mx, m.my = mx, my
+	// rounding errors must not make sums of squares negative
+	if m.m2x < 0 {
+		m.m2x = 0
+	}
+	if m.m2y < 0 {
+		m.m2y = 0
+	}
+}
+
+// momentsUDAF is a template for aggregates computed from the moments of
+// one or two variables. Rows having a NULL value are ignored.
+type momentsUDAF struct {
+	arity  int
+	result func(m *moments) data.Value
+}
+
+func (u *momentsUDAF) Accept(arity int) bool {
+	return arity == u.arity
+}
+
+func (u *momentsUDAF) Init(ctx *core.Context) (data.Value, error) {
+	return (&moments{}).toMap(), nil
+}
+
+func (u *momentsUDAF) update(state data.Value, args []data.Value, add bool) (data.Value, error) {
+	m, err := momentsFromMap(state)
+	if err != nil {
+		return nil, err
+	}
+	var xy [2]float64
+	for i, arg := range args {
+		f, ok, err := asNumber(arg)
+		if err != nil {
+			return nil, err
+		} else if !ok {
+			return state, nil
+		}
+		xy[i] = f
+	}
+	if add {
+		m.add(xy[0], xy[1])
+	} else {
+		m.remove(xy[0], xy[1])
+	}
+	return m.toMap(), nil
+}
+
+func (u *momentsUDAF) Accumulate(ctx *core.Context, state data.Value, args ...data.Value) (data.Value, error) {
+	return u.update(state, args, true)
+}
+
+func (u *momentsUDAF) Retract(ctx *core.Context, state data.Value, args ...data.Value) (data.Value, error) {
+	return u.update(state, args, false)
+}
+
+func (u *momentsUDAF) Result(ctx *core.Context, state data.Value) (data.Value, error) {
+	m, err := momentsFromMap(state)
+	if err != nil {
+		return nil, err
+	}
+	return u.result(m), nil
+}
+
+// varianceResult returns a function computing the variance or the
+// standard deviation of the sample or of the population.
+func varianceResult(sample, stddev bool) func(m *moments) data.Value {
+	return func(m *moments) data.Value {
+		d := m.n
+		if sample {
+			d--
+		}
+		if d <= 0 {
+			return data.Null{}
+		}
+		v := m.m2x / float64(d)
+		if stddev {
+			v = math.Sqrt(v)
+		}
+		return data.Float(v)
+	}
+}
+
+// varSampFunc is an aggregate function that computes the sample
+// variance of all input values. Null values are ignored, non-numeric
+// values lead to an error.
+//
+// It can be used in BQL as `var_samp` or `variance`.
+//
+//  Input: Int or Float (aggregated)
+//  Return Type: Float (Null if there are fewer than two values)
+var varSampFunc udf.UDF = udf.ConvertUDAF(&momentsUDAF{
+	arity:  1,
+	result: varianceResult(true, false),
+})
+
+// varPopFunc is an aggregate function that computes the population
+// variance of all input values. Null values are ignored, non-numeric
+// values lead to an error.
+//
+// It can be used in BQL as `var_pop`.
+//
+//  Input: Int or Float (aggregated)
+//  Return Type: Float (Null on empty input)
+var varPopFunc udf.UDF = udf.ConvertUDAF(&momentsUDAF{
+	arity:  1,
+	result: varianceResult(false, false),
+})
+
+// stddevSampFunc is an aggregate function that computes the sample
+// standard deviation of all input values. Null values are ignored,
+// non-numeric values lead to an error.
+//
+// It can be used in BQL as `stddev_samp` or `stddev`.
+//
+//  Input: Int or Float (aggregated)
+//  Return Type: Float (Null if there are fewer than two values)
+var stddevSampFunc udf.UDF = udf.ConvertUDAF(&momentsUDAF{
+	arity:  1,
+	result: varianceResult(true, true),
+})
+
+// stddevPopFunc is an aggregate function that computes the population
+// standard deviation of all input values. Null values are ignored,
+// non-numeric values lead to an error.
+//
+// It can be used in BQL as `stddev_pop`.
+//
+//  Input: Int or Float (aggregated)
+//  Return Type: Float (Null on empty input)
+var stddevPopFunc udf.UDF = udf.ConvertUDAF(&momentsUDAF{
+	arity:  1,
+	result: varianceResult(false, true),
+})
+
+// covarSampFunc is an aggregate function that computes the sample
+// covariance of pairs of input values. Pairs having a Null value are
+// ignored, non-numeric values lead to an error.
+//
+// It can be used in BQL as `covar_samp`.
+//
+//  Input: Int or Float (aggregated), Int or Float (aggregated)
+//  Return Type: Float (Null if there are fewer than two pairs)
+var covarSampFunc udf.UDF = udf.ConvertUDAF(&momentsUDAF{
+	arity: 2,
+	result: func(m *moments) data.Value {
+		if m.n < 2 {
+			return data.Null{}
+		}
+		return data.Float(m.cxy / float64(m.n-1))
+	},
+})
+
+// covarPopFunc is an aggregate function that computes the population
+// covariance of pairs of input values. Pairs having a Null value are
+// ignored, non-numeric values lead to an error.
+//
+// It can be used in BQL as `covar_pop`.
+//
+//  Input: Int or Float (aggregated), Int or Float (aggregated)
+//  Return Type: Float (Null on empty input)
+var covarPopFunc udf.UDF = udf.ConvertUDAF(&momentsUDAF{
+	arity: 2,
+	result: func(m *moments) data.Value {
+		if m.n < 1 {
+			return data.Null{}
+		}
+		return data.Float(m.cxy / float64(m.n))
+	},
+})
+
+// corrFunc is an aggregate function that computes the Pearson
+// correlation coefficient of pairs of input values. Pairs having a Null
+// value are ignored, non-numeric values lead to an error.
+//
+// It can be used in BQL as `corr`.
+//
+//  Input: Int or Float (aggregated), Int or Float (aggregated)
+//  Return Type: Float (Null on empty input or if either input is
+//   constant)
+var corrFunc udf.UDF = udf.ConvertUDAF(&momentsUDAF{
+	arity: 2,
+	result: func(m *moments) data.Value {
+		if m.n < 1 || m.m2x == 0 || m.m2y == 0 {
+			return data.Null{}
+		}
+		c := m.cxy / math.Sqrt(m.m2x*m.m2y)
+		// rounding errors must not lead to values out of [-1, 1]
+		return data.Float(math.Max(-1, math.Min(1, c)))
+	},
+})
+
+// percentileFuncTmpl is a template for aggregate functions computing
+// percentiles. The first parameter is aggregated, the second one is the
+// fraction of the percentile or an array of fractions.
+type percentileFuncTmpl struct {
+	// disc is true if the result is one of the input values rather than
+	// an interpolation of the two nearest input values
+	disc bool
+}
+
+func (f *percentileFuncTmpl) Accept(arity int) bool {
+	return arity == 2
+}
+
+func (f *percentileFuncTmpl) IsAggregationParameter(k int) bool {
+	return k == 0
+}
+
+func (f *percentileFuncTmpl) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf("function takes exactly two arguments")
+	}
+	arr, err := data.AsArray(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("function needs array input, not %T", args[0])
+	}
+
+	var fractions []float64
+	fracArr, multi := args[1].(data.Array)
+	if !multi {
+		fracArr = data.Array{args[1]}
+	}
+	for _, v := range fracArr {
+		fr, ok, err := asNumber(v)
+		if err != nil || !ok {
+			return nil, fmt.Errorf("cannot interpret %s as a fraction", v)
+		}
+		if fr < 0 || fr > 1 || math.IsNaN(fr) {
+			return nil, fmt.Errorf("fraction %v is not between 0 and 1", fr)
+		}
+		fractions = append(fractions, fr)
+	}
+
+	values := make(valuesAscending, 0, len(arr))
+	nums := make([]float64, 0, len(arr))
+	for _, item := range arr {
+		if item.Type() == data.TypeNull {
+			continue
+		}
+		if !f.disc {
+			n, _, err := asNumber(item)
+			if err != nil {
+				return nil, err
+			}
+			nums = append(nums, n)
+		}
+		values = append(values, item)
+	}
+	if len(values) == 0 {
+		return data.Null{}, nil
+	}
+	sort.Sort(values)
+	sort.Float64s(nums)
+
+	res := make(data.Array, len(fractions))
+	for i, fr := range fractions {
+		if f.disc {
+			// the first value whose position in the sorted input is
+			// greater than or equal to the fraction
+			idx := int(math.Ceil(fr*float64(len(values)))) - 1
+			if idx < 0 {
+				idx = 0
+			}
+			res[i] = values[idx]
+			continue
+		}
+		pos := fr * float64(len(nums)-1)
+		lo := int(math.Floor(pos))
+		hi := int(math.Ceil(pos))
+		res[i] = data.Float(nums[lo] + (pos-float64(lo))*(nums[hi]-nums[lo]))
+	}
+	if !multi {
+		return res[0], nil
+	}
+	return res, nil
+}
+
+// percentileContFunc is an aggregate function that computes the
+// percentile of the input values at the given fraction, interpolating
+// linearly between the two nearest values if needed. When an array of
+// fractions is given, it returns an array of the percentiles. Null values
+// are ignored, non-numeric values lead to an error.
+//
+// It can be used in BQL as `percentile_cont`.
+//
+//  Input: Int or Float (aggregated), Float or Array of Floats
+//  Return Type: Float or Array of Floats (Null on empty input)
+var percentileContFunc udf.UDF = &percentileFuncTmpl{disc: false}
+
+// percentileDiscFunc is an aggregate function that returns the first
+// input value whose position in the sorted input is at or above the given
+// fraction. When an array of fractions is given, it returns an array of
+// the values. Null values are ignored. Values of different types are
+// ordered in the same way as ORDER BY does.
+//
+// It can be used in BQL as `percentile_disc`.
+//
+//  Input: any (aggregated), Float or Array of Floats
+//  Return Type: same as input or Array (Null on empty input)
+var percentileDiscFunc udf.UDF = &percentileFuncTmpl{disc: true}
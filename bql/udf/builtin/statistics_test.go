@@ -0,0 +1,222 @@
+package builtin
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestMomentsFuncs(t *testing.T) {
+	ints := func(is ...int64) data.Array {
+		arr := make(data.Array, len(is))
+		for i, v := range is {
+			arr[i] = data.Int(v)
+		}
+		return arr
+	}
+	xs := data.Array{data.Int(2), data.Int(4), data.Float(4), data.Null{},
+		data.Int(4), data.Int(5), data.Float(5), data.Int(7), data.Int(9)}
+
+	testCases := []struct {
+		name     string
+		f        udf.UDF
+		input    []data.Value
+		expected interface{}
+	}{
+		{"var_pop", varPopFunc, []data.Value{xs}, 4.0},
+		{"var_pop", varPopFunc, []data.Value{ints(3)}, 0.0},
+		{"var_pop", varPopFunc, []data.Value{data.Array{}}, nil},
+		{"var_samp", varSampFunc, []data.Value{xs}, 32.0 / 7},
+		{"var_samp", varSampFunc, []data.Value{ints(3)}, nil},
+		{"variance", varSampFunc, []data.Value{data.Array{data.Null{}, data.Null{}}}, nil},
+		{"stddev_pop", stddevPopFunc, []data.Value{xs}, 2.0},
+		{"stddev_samp", stddevSampFunc, []data.Value{ints(1, 3)}, 1.4142135623730951},
+		{"stddev", stddevSampFunc, []data.Value{ints(1, 3, 5)}, 2.0},
+		{"covar_pop", covarPopFunc, []data.Value{ints(1, 2, 3, 4), ints(2, 4, 6, 8)}, 2.5},
+		{"covar_samp", covarSampFunc, []data.Value{ints(1, 2, 3, 4), ints(2, 4, 6, 8)}, 10.0 / 3},
+		{"covar_samp", covarSampFunc, []data.Value{
+			data.Array{data.Int(1), data.Null{}, data.Int(3), data.Int(5)},
+			data.Array{data.Int(5), data.Int(1), data.Null{}, data.Int(1)}}, -8.0},
+		{"corr", corrFunc, []data.Value{ints(1, 2, 3, 4), ints(2, 4, 6, 8)}, 1.0},
+		{"corr", corrFunc, []data.Value{ints(1, 2, 3), ints(3, 2, 1)}, -1.0},
+		{"corr", corrFunc, []data.Value{ints(1, 2, 3), ints(1, 3, 2)}, 0.5},
+		{"corr", corrFunc, []data.Value{ints(1, 2, 3), ints(1, 1, 1)}, nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		Convey(fmt.Sprintf("Given the %s function", tc.name), t, func() {
+			Convey(fmt.Sprintf("When evaluating it on %v", tc.input), func() {
+				v, err := tc.f.Call(nil, tc.input...)
+
+				Convey(fmt.Sprintf("Then the result should be %v", tc.expected), func() {
+					So(err, ShouldBeNil)
+					if tc.expected == nil {
+						So(v, ShouldResemble, data.Null{})
+					} else {
+						f, err := data.AsFloat(v)
+						So(err, ShouldBeNil)
+						So(f, ShouldAlmostEqual, tc.expected, 1e-9)
+					}
+				})
+			})
+
+			Convey("Then it should equal the one in the default registry", func() {
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(tc.name, len(tc.input))
+				So(err, ShouldBeNil)
+				So(regFun, ShouldEqual, tc.f)
+			})
+		})
+	}
+
+	Convey("Given the var_pop function", t, func() {
+		Convey("When evaluating it on a value which isn't a number", func() {
+			_, err := varPopFunc.Call(nil, data.Array{data.Int(1), data.String("a")})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When maintaining it over a sliding window", func() {
+			acc, err := varPopFunc.(udf.IncrementalUDF).NewAccumulator(nil)
+			So(err, ShouldBeNil)
+
+			Convey("Then the result should be the same as the one of the function", func() {
+				size := 3
+				for i, v := range xs {
+					So(acc.Add(v), ShouldBeNil)
+					if i >= size {
+						So(acc.Retract(xs[i-size]), ShouldBeNil)
+					}
+					start := i - size + 1
+					if start < 0 {
+						start = 0
+					}
+					expected, err := varPopFunc.Call(nil, xs[start:i+1])
+					So(err, ShouldBeNil)
+					actual, err := acc.Result()
+					So(err, ShouldBeNil)
+					So(actual, ShouldAlmostEqual, expected, 1e-9)
+				}
+			})
+		})
+	})
+
+	Convey("Given the UDAF of covar_samp", t, func() {
+		u, ok := udf.AsUDAF(covarSampFunc)
+		So(ok, ShouldBeTrue)
+		ctx := core.NewContext(nil)
+		state := func(xs, ys data.Array) data.Value {
+			s, err := u.Init(ctx)
+			So(err, ShouldBeNil)
+			for i := range xs {
+				s, err = u.Accumulate(ctx, s, xs[i], ys[i])
+				So(err, ShouldBeNil)
+			}
+			return s
+		}
+
+		Convey("When retracting the oldest rows from a state", func() {
+			s := state(ints(1, 2, 3, 4, 5), ints(5, 3, 1, 2, 0))
+			var err error
+			for _, r := range [][2]data.Value{{data.Int(1), data.Int(5)}, {data.Int(2), data.Int(3)}} {
+				s, err = u.Retract(ctx, s, r[0], r[1])
+				So(err, ShouldBeNil)
+			}
+
+			Convey("Then the result should be the one of the remaining rows", func() {
+				expected, err := covarSampFunc.Call(nil, ints(3, 4, 5), ints(1, 2, 0))
+				So(err, ShouldBeNil)
+				v, err := u.Result(ctx, s)
+				So(err, ShouldBeNil)
+				So(v, ShouldAlmostEqual, expected, 1e-9)
+			})
+		})
+
+		Convey("When passing an invalid state", func() {
+			_, err := u.Result(ctx, data.Map{"n": data.Int(1)})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
+
+func TestPercentileFuncs(t *testing.T) {
+	values := data.Array{data.Int(4), data.Null{}, data.Float(1), data.Int(3), data.Int(2)}
+
+	udfVariadicTestCases := []udfVariadicTestCase{
+		{"percentile_cont", percentileContFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{values, data.Float(0.5)}, data.Float(2.5)},
+			{[]data.Value{values, data.Float(0.25)}, data.Float(1.75)},
+			{[]data.Value{values, data.Int(1)}, data.Float(4)},
+			{[]data.Value{values, data.Array{data.Int(0), data.Float(0.5)}},
+				data.Array{data.Float(1), data.Float(2.5)}},
+			{[]data.Value{data.Array{data.Int(7)}, data.Float(0.9)}, data.Float(7)},
+			{[]data.Value{data.Array{data.Null{}}, data.Float(0.5)}, data.Null{}},
+			// invalid cases
+			{[]data.Value{values, data.Float(1.5)}, nil},
+			{[]data.Value{values, data.Null{}}, nil},
+			{[]data.Value{data.Array{data.String("a")}, data.Float(0.5)}, nil},
+			{[]data.Value{data.Int(1), data.Float(0.5)}, nil},
+		}},
+		{"percentile_disc", percentileDiscFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{values, data.Float(0.5)}, data.Int(2)},
+			{[]data.Value{values, data.Float(0.51)}, data.Int(3)},
+			{[]data.Value{values, data.Int(0)}, data.Float(1)},
+			{[]data.Value{values, data.Array{data.Float(0.25), data.Int(1)}},
+				data.Array{data.Float(1), data.Int(4)}},
+			{[]data.Value{data.Array{data.String("b"), data.String("a"), data.String("c")}, data.Float(0.5)},
+				data.String("b")},
+			{[]data.Value{data.Array{}, data.Float(0.5)}, data.Null{}},
+			// invalid cases
+			{[]data.Value{values, data.Float(-0.1)}, nil},
+			{[]data.Value{values, data.String("0.5")}, nil},
+		}},
+	}
+
+	for _, testCase := range udfVariadicTestCases {
+		f := testCase.f
+		allInputs := testCase.inputs
+		name := testCase.name
+
+		Convey(fmt.Sprintf("Given the %s function", name), t, func() {
+			Convey("Then only its first parameter should be aggregated", func() {
+				So(f.Accept(2), ShouldBeTrue)
+				So(f.Accept(1), ShouldBeFalse)
+				So(f.IsAggregationParameter(0), ShouldBeTrue)
+				So(f.IsAggregationParameter(1), ShouldBeFalse)
+			})
+
+			for _, tc := range allInputs {
+				tc := tc
+
+				Convey(fmt.Sprintf("When evaluating it on %v", tc.input), func() {
+					val, err := f.Call(nil, tc.input...)
+
+					if tc.expected == nil {
+						Convey("Then evaluation should fail", func() {
+							So(err, ShouldNotBeNil)
+						})
+					} else {
+						Convey(fmt.Sprintf("Then the result should be %s", tc.expected), func() {
+							So(err, ShouldBeNil)
+							So(val, ShouldResemble, tc.expected)
+						})
+					}
+				})
+			}
+
+			Convey("Then it should equal the one in the default registry", func() {
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(name, len(allInputs[0].input))
+				So(err, ShouldBeNil)
+				So(regFun, ShouldHaveSameTypeAs, f)
+			})
+		})
+	}
+}
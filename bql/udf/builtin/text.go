@@ -0,0 +1,663 @@
+package builtin
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"io"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// regexpCache keeps the regular expression compiled last. Since the
+// pattern is a constant at most call sites, it's compiled only once.
+type regexpCache struct {
+	pattern string
+	flags   string
+	re      *regexp.Regexp
+}
+
+// compile compiles the pattern with the flags, which are any of "i"
+// (case-insensitive), "m" (multi-line mode), and "s" (let . match \n).
+// "g" is also accepted for functions which match globally. If the cache
+// is nil, the pattern is always compiled.
+func (c *regexpCache) compile(pattern, flags string) (*regexp.Regexp, error) {
+	if c != nil && c.re != nil && c.pattern == pattern && c.flags == flags {
+		return c.re, nil
+	}
+	goFlags := ""
+	for _, f := range flags {
+		switch f {
+		case 'i', 'm', 's':
+			goFlags += string(f)
+		case 'g':
+			// handled by the caller
+		default:
+			return nil, fmt.Errorf("invalid regular expression flag: %c", f)
+		}
+	}
+	expr := pattern
+	if goFlags != "" {
+		expr = "(?" + goFlags + ")" + pattern
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	if c != nil {
+		c.pattern, c.flags, c.re = pattern, flags, re
+	}
+	return re, nil
+}
+
+// regexpFuncTmpl is a template for functions whose first parameter is
+// a string, whose second parameter is a regular expression, and whose
+// last parameter is optional flags. Each call site has its own cache of
+// the compiled regular expression.
+type regexpFuncTmpl struct {
+	// arity is the number of parameters excluding flags.
+	arity int
+	// regexpFun is called with the arguments, which aren't NULL, the
+	// compiled regular expression, and whether the "g" flag is given.
+	regexpFun func(s string, re *regexp.Regexp, global bool, args []data.Value) (data.Value, error)
+	cache     *regexpCache
+}
+
+func (f *regexpFuncTmpl) Accept(arity int) bool {
+	return arity == f.arity || arity == f.arity+1
+}
+
+func (f *regexpFuncTmpl) IsAggregationParameter(k int) bool {
+	return false
+}
+
+func (f *regexpFuncTmpl) ForCallSite() udf.UDF {
+	g := *f
+	g.cache = &regexpCache{}
+	return &g
+}
+
+func (f *regexpFuncTmpl) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if !f.Accept(len(args)) {
+		return nil, fmt.Errorf("function takes %d or %d arguments", f.arity, f.arity+1)
+	}
+	for _, arg := range args {
+		if arg.Type() == data.TypeNull {
+			return data.Null{}, nil
+		}
+	}
+	s, err := data.AsString(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("cannot interpret %s as a string", args[0])
+	}
+	pattern, err := data.AsString(args[1])
+	if err != nil {
+		return nil, fmt.Errorf("cannot interpret %s as a regular expression", args[1])
+	}
+	flags := ""
+	if len(args) > f.arity {
+		flags, err = data.AsString(args[f.arity])
+		if err != nil {
+			return nil, fmt.Errorf("cannot interpret %s as flags", args[f.arity])
+		}
+	}
+	re, err := f.cache.compile(pattern, flags)
+	if err != nil {
+		return nil, err
+	}
+	return f.regexpFun(s, re, strings.ContainsRune(flags, 'g'), args[:f.arity])
+}
+
+// submatches returns the substrings captured by the groups of the
+// regular expression, or the whole match if it doesn't have groups.
+// Groups that didn't participate in the match are NULL.
+func submatches(s string, re *regexp.Regexp, loc []int) data.Array {
+	if re.NumSubexp() == 0 {
+		return data.Array{data.String(s[loc[0]:loc[1]])}
+	}
+	arr := make(data.Array, re.NumSubexp())
+	for i := range arr {
+		b, e := loc[2*i+2], loc[2*i+3]
+		if b < 0 {
+			arr[i] = data.Null{}
+		} else {
+			arr[i] = data.String(s[b:e])
+		}
+	}
+	return arr
+}
+
+// regexpMatchFunc(str, pattern[, flags]) returns an array of the
+// substrings captured by the groups in the first match of the regular
+// expression pattern in str. If the pattern has no groups, the array
+// only has the whole match. It returns NULL if there's no match. The
+// flags are any of "i" (case-insensitive), "m" (multi-line mode), and
+// "s" (let . match \n).
+// See also: PostgreSQL's `regexp_match`
+//
+// It can be used in BQL as `regexp_match`.
+//
+//  Input: String, String, [String]
+//  Return Type: Array
+var regexpMatchFunc udf.UDF = &regexpFuncTmpl{
+	arity: 2,
+	regexpFun: func(s string, re *regexp.Regexp, global bool, args []data.Value) (data.Value, error) {
+		loc := re.FindStringSubmatchIndex(s)
+		if loc == nil {
+			return data.Null{}, nil
+		}
+		return submatches(s, re, loc), nil
+	},
+}
+
+// regexpMatchesFunc(str, pattern[, flags]) returns an array having the
+// result of regexpMatchFunc for each match of the regular expression
+// pattern in str. Like PostgreSQL's `regexp_matches`, all matches are
+// returned only if the flags contain "g", otherwise at most the first
+// match is returned. It returns an empty array if there's no match.
+//
+// It can be used in BQL as `regexp_matches`.
+//
+//  Input: String, String, [String]
+//  Return Type: Array
+var regexpMatchesFunc udf.UDF = &regexpFuncTmpl{
+	arity: 2,
+	regexpFun: func(s string, re *regexp.Regexp, global bool, args []data.Value) (data.Value, error) {
+		n := 1
+		if global {
+			n = -1
+		}
+		locs := re.FindAllStringSubmatchIndex(s, n)
+		arr := make(data.Array, len(locs))
+		for i, loc := range locs {
+			arr[i] = submatches(s, re, loc)
+		}
+		return arr, nil
+	},
+}
+
+// expandReplacement appends the replacement for a match to dst. In the
+// replacement, \1 to \9 refer to the substrings captured by the groups,
+// \& refers to the whole match, and \\ is a backslash.
+func expandReplacement(dst *bytes.Buffer, repl string, s string, loc []int) {
+	for i := 0; i < len(repl); i++ {
+		c := repl[i]
+		if c != '\\' || i+1 == len(repl) {
+			dst.WriteByte(c)
+			continue
+		}
+		i++
+		switch n := repl[i]; {
+		case '1' <= n && n <= '9':
+			g := int(n - '0')
+			if 2*g+1 < len(loc) && loc[2*g] >= 0 {
+				dst.WriteString(s[loc[2*g]:loc[2*g+1]])
+			}
+		case n == '&':
+			dst.WriteString(s[loc[0]:loc[1]])
+		case n == '\\':
+			dst.WriteByte('\\')
+		default:
+			dst.WriteByte('\\')
+			dst.WriteByte(n)
+		}
+	}
+}
+
+// regexpReplaceFunc(str, pattern, replacement[, flags]) replaces the
+// first match of the regular expression pattern in str, or all matches if
+// the flags contain "g", with the replacement. In the replacement, \1 to
+// \9 refer to the substrings captured by the groups and \& refers to the
+// whole match.
+// See also: PostgreSQL's `regexp_replace`
+//
+// It can be used in BQL as `regexp_replace`.
+//
+//  Input: String, String, String, [String]
+//  Return Type: String
+var regexpReplaceFunc udf.UDF = &regexpFuncTmpl{
+	arity: 3,
+	regexpFun: func(s string, re *regexp.Regexp, global bool, args []data.Value) (data.Value, error) {
+		repl, err := data.AsString(args[2])
+		if err != nil {
+			return nil, fmt.Errorf("cannot interpret %s as a string", args[2])
+		}
+		n := 1
+		if global {
+			n = -1
+		}
+		var b bytes.Buffer
+		last := 0
+		for _, loc := range re.FindAllStringSubmatchIndex(s, n) {
+			b.WriteString(s[last:loc[0]])
+			expandReplacement(&b, repl, s, loc)
+			last = loc[1]
+		}
+		b.WriteString(s[last:])
+		return data.String(b.String()), nil
+	},
+}
+
+type splitPartFuncTmpl struct {
+}
+
+func (f *splitPartFuncTmpl) Accept(arity int) bool {
+	return arity == 3
+}
+
+func (f *splitPartFuncTmpl) IsAggregationParameter(k int) bool {
+	return false
+}
+
+func (f *splitPartFuncTmpl) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf("function takes exactly three arguments")
+	}
+	for _, arg := range args {
+		if arg.Type() == data.TypeNull {
+			return data.Null{}, nil
+		}
+	}
+	s, err := data.AsString(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("cannot interpret %s as a string", args[0])
+	}
+	delim, err := data.AsString(args[1])
+	if err != nil {
+		return nil, fmt.Errorf("cannot interpret %s as a string", args[1])
+	}
+	n, err := data.AsInt(args[2])
+	if err != nil {
+		return nil, fmt.Errorf("cannot interpret %s as an integer", args[2])
+	}
+	if n == 0 {
+		return nil, fmt.Errorf("field position must not be zero")
+	}
+	fields := []string{s}
+	if delim != "" {
+		fields = strings.Split(s, delim)
+	}
+	if n < 0 {
+		n += int64(len(fields)) + 1
+	}
+	if n < 1 || n > int64(len(fields)) {
+		return data.String(""), nil
+	}
+	return data.String(fields[n-1]), nil
+}
+
+// splitPartFunc(str, delimiter, n) splits str at the delimiter and
+// returns the n-th field (1-based). If n is negative, fields are counted
+// from the end. It returns an empty string if there's no such field.
+// See also: PostgreSQL's `split_part`
+//
+// It can be used in BQL as `split_part`.
+//
+//  Input: String, String, Int
+//  Return Type: String
+var splitPartFunc udf.UDF = &splitPartFuncTmpl{}
+
+type stringToArrayFuncTmpl struct {
+}
+
+func (f *stringToArrayFuncTmpl) Accept(arity int) bool {
+	return arity == 2 || arity == 3
+}
+
+func (f *stringToArrayFuncTmpl) IsAggregationParameter(k int) bool {
+	return false
+}
+
+func (f *stringToArrayFuncTmpl) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if len(args) != 2 && len(args) != 3 {
+		return nil, fmt.Errorf("function takes two or three arguments")
+	}
+	if args[0].Type() == data.TypeNull {
+		return data.Null{}, nil
+	}
+	s, err := data.AsString(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("cannot interpret %s as a string", args[0])
+	}
+	var fields []string
+	switch {
+	case s == "":
+		fields = []string{}
+	case args[1].Type() == data.TypeNull:
+		// split into characters
+		fields = strings.Split(s, "")
+	default:
+		delim, err := data.AsString(args[1])
+		if err != nil {
+			return nil, fmt.Errorf("cannot interpret %s as a string", args[1])
+		}
+		if delim == "" {
+			fields = []string{s}
+		} else {
+			fields = strings.Split(s, delim)
+		}
+	}
+	hasNullStr := false
+	nullStr := ""
+	if len(args) == 3 && args[2].Type() != data.TypeNull {
+		nullStr, err = data.AsString(args[2])
+		if err != nil {
+			return nil, fmt.Errorf("cannot interpret %s as a string", args[2])
+		}
+		hasNullStr = true
+	}
+	arr := make(data.Array, len(fields))
+	for i, field := range fields {
+		if hasNullStr && field == nullStr {
+			arr[i] = data.Null{}
+		} else {
+			arr[i] = data.String(field)
+		}
+	}
+	return arr, nil
+}
+
+// stringToArrayFunc(str, delimiter[, nullString]) splits str at the
+// delimiter and returns an array of the fields. If the delimiter is NULL,
+// str is split into characters. Fields equal to nullString are replaced
+// by NULL.
+// See also: PostgreSQL's `string_to_array`
+//
+// It can be used in BQL as `string_to_array`.
+//
+//  Input: String, String, [String]
+//  Return Type: Array
+var stringToArrayFunc udf.UDF = &stringToArrayFuncTmpl{}
+
+// splitOutsideQuotes splits s at separators which aren't enclosed in
+// double quotes. If sep is empty, s is split at runs of white spaces.
+// A backslash in double quotes escapes the next character.
+func splitOutsideQuotes(s, sep string, max int) []string {
+	parts := []string{}
+	start := 0
+	inQuote := false
+	for i := 0; i < len(s); {
+		if max > 0 && len(parts) == max-1 {
+			break
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		switch {
+		case inQuote && r == '\\':
+			i += size
+			if i < len(s) {
+				_, size = utf8.DecodeRuneInString(s[i:])
+			}
+		case r == '"':
+			inQuote = !inQuote
+		case inQuote:
+		case sep == "" && unicode.IsSpace(r):
+			parts = append(parts, s[start:i])
+			for i < len(s) {
+				r, size = utf8.DecodeRuneInString(s[i:])
+				if !unicode.IsSpace(r) {
+					break
+				}
+				i += size
+			}
+			start = i
+			continue
+		case sep != "" && strings.HasPrefix(s[i:], sep):
+			parts = append(parts, s[start:i])
+			i += len(sep)
+			start = i
+			continue
+		}
+		i += size
+	}
+	return append(parts, s[start:])
+}
+
+// unquote removes the double quotes enclosing s and unescapes the
+// characters escaped by backslashes in it.
+func unquote(s string) string {
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return s
+	}
+	s = s[1 : len(s)-1]
+	var b bytes.Buffer
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+type parseKVFuncTmpl struct {
+}
+
+func (f *parseKVFuncTmpl) Accept(arity int) bool {
+	return arity == 1 || arity == 3
+}
+
+func (f *parseKVFuncTmpl) IsAggregationParameter(k int) bool {
+	return false
+}
+
+func (f *parseKVFuncTmpl) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if len(args) != 1 && len(args) != 3 {
+		return nil, fmt.Errorf("function takes one or three arguments")
+	}
+	for _, arg := range args {
+		if arg.Type() == data.TypeNull {
+			return data.Null{}, nil
+		}
+	}
+	s, err := data.AsString(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("cannot interpret %s as a string", args[0])
+	}
+	pairSep, kvSep := "", "="
+	if len(args) == 3 {
+		pairSep, err = data.AsString(args[1])
+		if err != nil {
+			return nil, fmt.Errorf("cannot interpret %s as a string", args[1])
+		}
+		kvSep, err = data.AsString(args[2])
+		if err != nil {
+			return nil, fmt.Errorf("cannot interpret %s as a string", args[2])
+		}
+		if kvSep == "" {
+			return nil, fmt.Errorf("the key-value separator must not be empty")
+		}
+	}
+
+	m := data.Map{}
+	for _, pair := range splitOutsideQuotes(strings.TrimSpace(s), pairSep, 0) {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		kv := splitOutsideQuotes(pair, kvSep, 2)
+		key := unquote(strings.TrimSpace(kv[0]))
+		if len(kv) == 1 {
+			m[key] = data.Null{}
+		} else {
+			m[key] = data.String(unquote(strings.TrimSpace(kv[1])))
+		}
+	}
+	return m, nil
+}
+
+// parseKVFunc(str[, pairSeparator, keyValueSeparator]) parses key-value
+// pairs such as `a=1 b="x y"` in log lines and returns a map from keys to
+// values as strings. By default, pairs are separated by white spaces and
+// keys and values are separated by "=". Keys and values may be enclosed
+// in double quotes, in which the separators have no special meaning and
+// a backslash escapes the next character. A key without a value is mapped
+// to NULL. If a key appears more than once, the last value is used.
+//
+// It can be used in BQL as `parse_kv`.
+//
+//  Input: String, [String, String]
+//  Return Type: Map
+var parseKVFunc udf.UDF = &parseKVFuncTmpl{}
+
+// queryToMap converts query parameters to a map. A parameter having
+// a single value is mapped to a string and a parameter having multiple
+// values is mapped to an array of strings.
+func queryToMap(q url.Values) data.Map {
+	m := data.Map{}
+	for k, vs := range q {
+		if len(vs) == 1 {
+			m[k] = data.String(vs[0])
+			continue
+		}
+		arr := make(data.Array, len(vs))
+		for i, v := range vs {
+			arr[i] = data.String(v)
+		}
+		m[k] = arr
+	}
+	return m
+}
+
+// parseQueryFunc(str) parses a URL query string such as "a=1&b=x%20y",
+// optionally starting with "?", and returns a map from the parameter
+// names to the decoded values. A parameter having a single value is mapped
+// to a string and a parameter having multiple values is mapped to an array
+// of strings.
+//
+// It can be used in BQL as `parse_query`.
+//
+//  Input: String
+//  Return Type: Map
+var parseQueryFunc udf.UDF = &singleParamStringFunc{
+	strFun: func(s string) data.Value {
+		q, err := url.ParseQuery(strings.TrimPrefix(s, "?"))
+		if err != nil {
+			panic(err)
+		}
+		return queryToMap(q)
+	},
+}
+
+// nullIfEmpty returns NULL for an empty string.
+func nullIfEmpty(s string) data.Value {
+	if s == "" {
+		return data.Null{}
+	}
+	return data.String(s)
+}
+
+// parseURLFunc(str) parses a URL and returns a map having the following
+// fields:
+//
+//  "scheme", "user", "host" (without the port), "port" (Int), "path",
+//  "query" (a map like the result of parse_query), and "fragment"
+//
+// Fields which aren't in the URL are NULL except for "query", which is
+// an empty map then.
+//
+// It can be used in BQL as `parse_url`.
+//
+//  Input: String
+//  Return Type: Map
+var parseURLFunc udf.UDF = &singleParamStringFunc{
+	strFun: func(s string) data.Value {
+		u, err := url.Parse(s)
+		if err != nil {
+			panic(err)
+		}
+		q, err := url.ParseQuery(u.RawQuery)
+		if err != nil {
+			panic(err)
+		}
+		var user data.Value = data.Null{}
+		if u.User != nil {
+			user = data.String(u.User.Username())
+		}
+		var port data.Value = data.Null{}
+		if p := u.Port(); p != "" {
+			v, err := data.ToInt(data.String(p))
+			if err != nil {
+				panic(fmt.Errorf("invalid port: %v", p))
+			}
+			port = data.Int(v)
+		}
+		return data.Map{
+			"scheme":   nullIfEmpty(u.Scheme),
+			"user":     user,
+			"host":     nullIfEmpty(u.Hostname()),
+			"port":     port,
+			"path":     nullIfEmpty(u.Path),
+			"query":    queryToMap(q),
+			"fragment": nullIfEmpty(u.Fragment),
+		}
+	},
+}
+
+type parseCSVLineFuncTmpl struct {
+}
+
+func (f *parseCSVLineFuncTmpl) Accept(arity int) bool {
+	return arity == 1 || arity == 2
+}
+
+func (f *parseCSVLineFuncTmpl) IsAggregationParameter(k int) bool {
+	return false
+}
+
+func (f *parseCSVLineFuncTmpl) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if len(args) != 1 && len(args) != 2 {
+		return nil, fmt.Errorf("function takes one or two arguments")
+	}
+	for _, arg := range args {
+		if arg.Type() == data.TypeNull {
+			return data.Null{}, nil
+		}
+	}
+	s, err := data.AsString(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("cannot interpret %s as a string", args[0])
+	}
+	r := csv.NewReader(strings.NewReader(s))
+	r.FieldsPerRecord = -1
+	if len(args) == 2 {
+		delim, err := data.AsString(args[1])
+		if err != nil {
+			return nil, fmt.Errorf("cannot interpret %s as a string", args[1])
+		}
+		if utf8.RuneCountInString(delim) != 1 {
+			return nil, fmt.Errorf("the delimiter must be a single character: %v", delim)
+		}
+		r.Comma, _ = utf8.DecodeRuneInString(delim)
+	}
+
+	fields, err := r.Read()
+	if err == io.EOF {
+		return data.Array{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if _, err := r.Read(); err != io.EOF {
+		return nil, fmt.Errorf("the input has more than one line")
+	}
+	arr := make(data.Array, len(fields))
+	for i, field := range fields {
+		arr[i] = data.String(field)
+	}
+	return arr, nil
+}
+
+// parseCSVLineFunc(str[, delimiter]) parses a line of CSV as defined in
+// RFC 4180 and returns an array of the fields as strings. The delimiter
+// is "," by default.
+//
+// It can be used in BQL as `parse_csv_line`.
+//
+//  Input: String, [String]
+//  Return Type: Array
+var parseCSVLineFunc udf.UDF = &parseCSVLineFuncTmpl{}
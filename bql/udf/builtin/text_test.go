@@ -0,0 +1,232 @@
+package builtin
+
+import (
+	"fmt"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+func TestTextFuncs(t *testing.T) {
+	s := func(strs ...string) data.Array {
+		arr := make(data.Array, len(strs))
+		for i, str := range strs {
+			arr[i] = data.String(str)
+		}
+		return arr
+	}
+
+	udfVariadicTestCases := []udfVariadicTestCase{
+		{"regexp_match", regexpMatchFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{data.String("foobarbequebaz"), data.String("bar.*que")},
+				s("barbeque")},
+			{[]data.Value{data.String("foobarbequebaz"), data.String("(bar)(beque)")},
+				s("bar", "beque")},
+			{[]data.Value{data.String("abc"), data.String("(a)|(z)")},
+				data.Array{data.String("a"), data.Null{}}},
+			{[]data.Value{data.String("ABC"), data.String("b"), data.String("i")},
+				s("B")},
+			{[]data.Value{data.String("abc"), data.String("z")}, data.Null{}},
+			{[]data.Value{data.Null{}, data.String("z")}, data.Null{}},
+			{[]data.Value{data.String("abc"), data.String("z"), data.Null{}}, data.Null{}},
+			// invalid cases
+			{[]data.Value{data.String("abc"), data.String("(")}, nil},
+			{[]data.Value{data.String("abc"), data.String("a"), data.String("x")}, nil},
+			{[]data.Value{data.Int(1), data.String("1")}, nil},
+		}},
+		{"regexp_matches", regexpMatchesFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{data.String("a1b22c333"), data.String("[0-9]+")},
+				data.Array{s("1")}},
+			{[]data.Value{data.String("a1b22c333"), data.String("[0-9]+"), data.String("g")},
+				data.Array{s("1"), s("22"), s("333")}},
+			{[]data.Value{data.String("a=1, b=2"), data.String(`(\w)=(\d)`), data.String("g")},
+				data.Array{s("a", "1"), s("b", "2")}},
+			{[]data.Value{data.String("abc"), data.String("z"), data.String("g")},
+				data.Array{}},
+			{[]data.Value{data.Null{}, data.String("z")}, data.Null{}},
+			// invalid cases
+			{[]data.Value{data.String("abc"), data.String("a"), data.String("gx")}, nil},
+		}},
+		{"regexp_replace", regexpReplaceFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{data.String("foobarbaz"), data.String("b.."), data.String("")},
+				data.String("foobaz")},
+			{[]data.Value{data.String("foobarbaz"), data.String("b.."), data.String("X")},
+				data.String("fooXbaz")},
+			{[]data.Value{data.String("foobarbaz"), data.String("b(.)(.)"), data.String(`[\2\1]`), data.String("g")},
+				data.String("foo[ra][za]")},
+			{[]data.Value{data.String("FooBAR"), data.String("[ab]"), data.String(`<\&>`), data.String("gi")},
+				data.String("Foo<B><A>R")},
+			{[]data.Value{data.String("a.b"), data.String(`\.`), data.String(`\\`)},
+				data.String(`a\b`)},
+			{[]data.Value{data.String("abc"), data.String("z"), data.String("y")},
+				data.String("abc")},
+			{[]data.Value{data.String("abc"), data.Null{}, data.String("y")}, data.Null{}},
+			// invalid cases
+			{[]data.Value{data.String("abc"), data.String("b"), data.Int(1)}, nil},
+			{[]data.Value{data.String("abc"), data.String("b")}, nil},
+		}},
+		{"split_part", splitPartFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{data.String("abc~@~def~@~ghi"), data.String("~@~"), data.Int(2)},
+				data.String("def")},
+			{[]data.Value{data.String("abc,def,ghi"), data.String(","), data.Int(-1)},
+				data.String("ghi")},
+			{[]data.Value{data.String("abc,def,ghi"), data.String(","), data.Int(4)},
+				data.String("")},
+			{[]data.Value{data.String("abc,def,ghi"), data.String(","), data.Int(-4)},
+				data.String("")},
+			{[]data.Value{data.String("abc"), data.String(""), data.Int(1)},
+				data.String("abc")},
+			{[]data.Value{data.String("abc"), data.Null{}, data.Int(1)}, data.Null{}},
+			// invalid cases
+			{[]data.Value{data.String("abc"), data.String(","), data.Int(0)}, nil},
+			{[]data.Value{data.String("abc"), data.String(","), data.String("a")}, nil},
+		}},
+		{"string_to_array", stringToArrayFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{data.String("xx~^~yy~^~zz"), data.String("~^~")},
+				s("xx", "yy", "zz")},
+			{[]data.Value{data.String("xx,,zz"), data.String(","), data.String("")},
+				data.Array{data.String("xx"), data.Null{}, data.String("zz")}},
+			{[]data.Value{data.String("abc"), data.Null{}},
+				s("a", "b", "c")},
+			{[]data.Value{data.String("abc"), data.String("")},
+				s("abc")},
+			{[]data.Value{data.String(""), data.String(",")},
+				data.Array{}},
+			{[]data.Value{data.Null{}, data.String(",")}, data.Null{}},
+			// invalid cases
+			{[]data.Value{data.Int(1), data.String(",")}, nil},
+		}},
+		{"parse_kv", parseKVFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{data.String(`level=info  msg="hello, world" user=alice`)},
+				data.Map{"level": data.String("info"), "msg": data.String("hello, world"),
+					"user": data.String("alice")}},
+			{[]data.Value{data.String(`a=1 a=2 flag q="x \"y\" \\z"`)},
+				data.Map{"a": data.String("2"), "flag": data.Null{},
+					"q": data.String(`x "y" \z`)}},
+			{[]data.Value{data.String("a:1; b:2;c:x:y"), data.String(";"), data.String(":")},
+				data.Map{"a": data.String("1"), "b": data.String("2"), "c": data.String("x:y")}},
+			{[]data.Value{data.String("")}, data.Map{}},
+			{[]data.Value{data.Null{}}, data.Null{}},
+			// invalid cases
+			{[]data.Value{data.String("a=1"), data.String(","), data.String("")}, nil},
+			{[]data.Value{data.Int(1)}, nil},
+		}},
+		{"parse_query", parseQueryFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{data.String("?a=1&b=x%20y&c=2&c=3")},
+				data.Map{"a": data.String("1"), "b": data.String("x y"),
+					"c": s("2", "3")}},
+			{[]data.Value{data.String("")}, data.Map{}},
+			{[]data.Value{data.Null{}}, data.Null{}},
+			// invalid cases
+			{[]data.Value{data.String("a=%zz")}, nil},
+		}},
+		{"parse_url", parseURLFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{data.String("https://bob:pw@example.com:8080/a/b?x=1&y=2#top")},
+				data.Map{
+					"scheme":   data.String("https"),
+					"user":     data.String("bob"),
+					"host":     data.String("example.com"),
+					"port":     data.Int(8080),
+					"path":     data.String("/a/b"),
+					"query":    data.Map{"x": data.String("1"), "y": data.String("2")},
+					"fragment": data.String("top"),
+				}},
+			{[]data.Value{data.String("/index.html")},
+				data.Map{
+					"scheme":   data.Null{},
+					"user":     data.Null{},
+					"host":     data.Null{},
+					"port":     data.Null{},
+					"path":     data.String("/index.html"),
+					"query":    data.Map{},
+					"fragment": data.Null{},
+				}},
+			{[]data.Value{data.Null{}}, data.Null{}},
+			// invalid cases
+			{[]data.Value{data.String("http://[::1")}, nil},
+		}},
+		{"parse_csv_line", parseCSVLineFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{data.String(`a,"b,c",,"d ""e"""`)},
+				s("a", "b,c", "", `d "e"`)},
+			{[]data.Value{data.String("a\tb"), data.String("\t")},
+				s("a", "b")},
+			{[]data.Value{data.String("")}, data.Array{}},
+			{[]data.Value{data.Null{}}, data.Null{}},
+			// invalid cases
+			{[]data.Value{data.String("a\nb")}, nil},
+			{[]data.Value{data.String(`a,"b`)}, nil},
+			{[]data.Value{data.String("a"), data.String("::")}, nil},
+		}},
+	}
+
+	for _, testCase := range udfVariadicTestCases {
+		f := testCase.f
+		allInputs := testCase.inputs
+		name := testCase.name
+
+		Convey(fmt.Sprintf("Given the %s function", name), t, func() {
+			for _, tc := range allInputs {
+				tc := tc
+
+				Convey(fmt.Sprintf("When evaluating it on %#v", tc.input), func() {
+					val, err := f.Call(nil, tc.input...)
+
+					if tc.expected == nil {
+						Convey("Then evaluation should fail", func() {
+							So(err, ShouldNotBeNil)
+						})
+					} else {
+						Convey(fmt.Sprintf("Then the result should be %s", tc.expected), func() {
+							So(err, ShouldBeNil)
+							So(val, ShouldResemble, tc.expected)
+						})
+					}
+				})
+			}
+
+			Convey("Then it should equal the one in the default registry", func() {
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(name, len(allInputs[0].input))
+				So(err, ShouldBeNil)
+				So(regFun, ShouldHaveSameTypeAs, f)
+			})
+		})
+	}
+
+	Convey("Given a regexp function for a call site", t, func() {
+		f := regexpMatchFunc.(udf.CallSiteUDF).ForCallSite()
+		cache := f.(*regexpFuncTmpl).cache
+
+		Convey("When calling it with the same pattern twice", func() {
+			_, err := f.Call(nil, data.String("abc"), data.String("b"))
+			So(err, ShouldBeNil)
+			re := cache.re
+			v, err := f.Call(nil, data.String("xbz"), data.String("b"))
+
+			Convey("Then the compiled pattern should be reused", func() {
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.Array{data.String("b")})
+				So(cache.re, ShouldPointTo, re)
+			})
+		})
+
+		Convey("When calling it with different flags", func() {
+			_, err := f.Call(nil, data.String("abc"), data.String("b"))
+			So(err, ShouldBeNil)
+			re := cache.re
+			v, err := f.Call(nil, data.String("ABC"), data.String("b"), data.String("i"))
+
+			Convey("Then the pattern should be compiled again", func() {
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.Array{data.String("B")})
+				So(cache.re, ShouldNotPointTo, re)
+			})
+		})
+
+		Convey("Then it shouldn't share the cache with the global one", func() {
+			So(regexpMatchFunc.(*regexpFuncTmpl).cache, ShouldBeNil)
+			g := regexpMatchFunc.(udf.CallSiteUDF).ForCallSite()
+			So(g.(*regexpFuncTmpl).cache, ShouldNotPointTo, cache)
+		})
+	})
+}
@@ -1,10 +1,15 @@
 package builtin
 
 import (
+	"bytes"
 	"fmt"
 	"gopkg.in/sensorbee/sensorbee.v0/bql/udf"
 	"gopkg.in/sensorbee/sensorbee.v0/core"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -54,3 +59,652 @@ var diffUsFunc udf.UDF = &diffUsFuncTmpl{}
 var clockTimestampFunc = udf.MustConvertGeneric(func() time.Time {
 	return time.Now().In(time.UTC)
 })
+
+var (
+	locationsMutex sync.RWMutex
+	// locations caches time zones loaded by loadLocation because
+	// time.LoadLocation reads the time zone database every time.
+	locations = map[string]*time.Location{}
+
+	utcOffsetRegexp = regexp.MustCompile(`^([+-])([0-9]{2}):?([0-9]{2})?$`)
+)
+
+// loadLocation returns the time zone having the given name, which is
+// either a name in the IANA Time Zone database such as "Asia/Tokyo" or
+// a fixed offset from UTC such as "+09:00" or "-0330".
+func loadLocation(name string) (*time.Location, error) {
+	locationsMutex.RLock()
+	loc, ok := locations[name]
+	locationsMutex.RUnlock()
+	if ok {
+		return loc, nil
+	}
+
+	if m := utcOffsetRegexp.FindStringSubmatch(name); m != nil {
+		h, _ := strconv.Atoi(m[2])
+		min := 0
+		if m[3] != "" {
+			min, _ = strconv.Atoi(m[3])
+		}
+		offset := (h*60 + min) * 60
+		if m[1] == "-" {
+			offset = -offset
+		}
+		loc = time.FixedZone(name, offset)
+	} else {
+		l, err := time.LoadLocation(name)
+		if err != nil {
+			return nil, fmt.Errorf("unknown time zone: %v", name)
+		}
+		loc = l
+	}
+
+	locationsMutex.Lock()
+	defer locationsMutex.Unlock()
+	locations[name] = loc
+	return loc, nil
+}
+
+// zonedTimeFuncTmpl is a template for functions that have two parameters
+// and an optional third parameter specifying the time zone in which
+// the function interprets Timestamps. The time zone is UTC by default.
+type zonedTimeFuncTmpl struct {
+	twoParamFunc
+	// timeFun is called with the first two arguments, which aren't NULL,
+	// and the time zone.
+	timeFun func(data.Value, data.Value, *time.Location) (data.Value, error)
+}
+
+func (f *zonedTimeFuncTmpl) Accept(arity int) bool {
+	return arity == 2 || arity == 3
+}
+
+func (f *zonedTimeFuncTmpl) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if len(args) != 2 && len(args) != 3 {
+		return nil, fmt.Errorf("function takes two or three arguments")
+	}
+	for _, arg := range args {
+		if arg.Type() == data.TypeNull {
+			return data.Null{}, nil
+		}
+	}
+	loc := time.UTC
+	if len(args) == 3 {
+		name, err := data.AsString(args[2])
+		if err != nil {
+			return nil, fmt.Errorf("cannot interpret %s as a time zone", args[2])
+		}
+		loc, err = loadLocation(name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return f.timeFun(args[0], args[1], loc)
+}
+
+// stringAndTimestamp converts the arguments of a function taking a String
+// and a Timestamp.
+func stringAndTimestamp(arg1, arg2 data.Value) (string, time.Time, error) {
+	s, err := data.AsString(arg1)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("cannot interpret %s as a string", arg1)
+	}
+	t, err := data.AsTimestamp(arg2)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("cannot interpret %s as a timestamp", arg2)
+	}
+	return s, t, nil
+}
+
+// truncateTime truncates t in its time zone to the given unit.
+func truncateTime(unit string, t time.Time) (time.Time, error) {
+	y, m, d := t.Date()
+	h, min, s := t.Clock()
+	ns := t.Nanosecond()
+	switch strings.ToLower(unit) {
+	case "microsecond", "microseconds":
+		ns -= ns % 1000
+	case "millisecond", "milliseconds":
+		ns -= ns % 1000000
+	case "second", "seconds":
+		ns = 0
+	case "minute", "minutes":
+		s, ns = 0, 0
+	case "hour", "hours":
+		min, s, ns = 0, 0, 0
+	case "day", "days":
+		h, min, s, ns = 0, 0, 0, 0
+	case "week", "weeks":
+		// weeks start on Monday as in ISO 8601
+		d -= (int(t.Weekday()) + 6) % 7
+		h, min, s, ns = 0, 0, 0, 0
+	case "month", "months":
+		d, h, min, s, ns = 1, 0, 0, 0, 0
+	case "quarter", "quarters":
+		m = (m-1)/3*3 + 1
+		d, h, min, s, ns = 1, 0, 0, 0, 0
+	case "year", "years":
+		m, d, h, min, s, ns = time.January, 1, 0, 0, 0, 0
+	default:
+		return time.Time{}, fmt.Errorf("unsupported unit: %v", unit)
+	}
+	return time.Date(y, m, d, h, min, s, ns, t.Location()), nil
+}
+
+// dateTruncFunc(unit, t[, zone]) truncates the timestamp t to the given
+// unit, i.e., it sets all fields less significant than the unit to their
+// minimum values. The unit is one of "microsecond", "millisecond",
+// "second", "minute", "hour", "day", "week", "month", "quarter", and
+// "year". Weeks start on Monday. Units of a day and longer depend on the
+// time zone, which can be given as an IANA time zone name such as
+// "Asia/Tokyo" or an offset such as "+09:00" and is UTC by default.
+//
+// It can be used in BQL as `date_trunc`.
+//
+//  Input: String, Timestamp, [String]
+//  Return Type: Timestamp
+var dateTruncFunc udf.UDF = &zonedTimeFuncTmpl{
+	timeFun: func(arg1, arg2 data.Value, loc *time.Location) (data.Value, error) {
+		unit, t, err := stringAndTimestamp(arg1, arg2)
+		if err != nil {
+			return nil, err
+		}
+		tr, err := truncateTime(unit, t.In(loc))
+		if err != nil {
+			return nil, err
+		}
+		return data.Timestamp(tr.In(time.UTC)), nil
+	},
+}
+
+// datePartFunc(field, t[, zone]) returns a field of the timestamp t in
+// the given time zone, which is UTC by default. The field is one of the
+// following:
+//
+//  "year", "month" (1-12), "day" (1-31), "hour" (0-23), "minute" (0-59),
+//  "second" (0-59), "millisecond" (0-999, within the second),
+//  "microsecond" (0-999999, within the second), "quarter" (1-4),
+//  "doy" (day of the year, 1-366), "dow" (day of the week, 0-6 where 0
+//  is Sunday), "isodow" (1-7 where 1 is Monday), "week" (ISO 8601 week
+//  number), "isoyear" (ISO 8601 week-numbering year), and "epoch" (the
+//  number of seconds since 1970-01-01 00:00:00 UTC as a Float).
+//
+// It can be used in BQL as `date_part` or `extract`.
+//
+//  Input: String, Timestamp, [String]
+//  Return Type: Int or Float (for "epoch")
+var datePartFunc udf.UDF = &zonedTimeFuncTmpl{
+	timeFun: func(arg1, arg2 data.Value, loc *time.Location) (data.Value, error) {
+		field, t, err := stringAndTimestamp(arg1, arg2)
+		if err != nil {
+			return nil, err
+		}
+		t = t.In(loc)
+		var v int
+		switch strings.ToLower(field) {
+		case "year", "years":
+			v = t.Year()
+		case "month", "months":
+			v = int(t.Month())
+		case "day", "days":
+			v = t.Day()
+		case "hour", "hours":
+			v = t.Hour()
+		case "minute", "minutes":
+			v = t.Minute()
+		case "second", "seconds":
+			v = t.Second()
+		case "millisecond", "milliseconds":
+			v = t.Nanosecond() / 1000000
+		case "microsecond", "microseconds":
+			v = t.Nanosecond() / 1000
+		case "quarter":
+			v = (int(t.Month())-1)/3 + 1
+		case "doy":
+			v = t.YearDay()
+		case "dow":
+			v = int(t.Weekday())
+		case "isodow":
+			v = (int(t.Weekday())+6)%7 + 1
+		case "week":
+			_, v = t.ISOWeek()
+		case "isoyear":
+			v, _ = t.ISOWeek()
+		case "epoch":
+			return data.Float(float64(t.UnixNano()) / 1e9), nil
+		default:
+			return nil, fmt.Errorf("unsupported field: %v", field)
+		}
+		return data.Int(v), nil
+	},
+}
+
+type dateBinFuncTmpl struct {
+}
+
+func (f *dateBinFuncTmpl) Accept(arity int) bool {
+	return arity == 2 || arity == 3
+}
+
+func (f *dateBinFuncTmpl) IsAggregationParameter(k int) bool {
+	return false
+}
+
+func (f *dateBinFuncTmpl) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	if len(args) != 2 && len(args) != 3 {
+		return nil, fmt.Errorf("function takes two or three arguments")
+	}
+	for _, arg := range args {
+		if arg.Type() == data.TypeNull {
+			return data.Null{}, nil
+		}
+	}
+	stride, err := data.AsInt(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("cannot interpret %s as an interval", args[0])
+	}
+	if stride <= 0 {
+		return nil, fmt.Errorf("the interval must be positive: %v", stride)
+	}
+	t, err := data.AsTimestamp(args[1])
+	if err != nil {
+		return nil, fmt.Errorf("cannot interpret %s as a timestamp", args[1])
+	}
+	origin := time.Unix(0, 0).In(time.UTC)
+	if len(args) == 3 {
+		origin, err = data.AsTimestamp(args[2])
+		if err != nil {
+			return nil, fmt.Errorf("cannot interpret %s as a timestamp", args[2])
+		}
+	}
+	diff := t.Sub(origin).Nanoseconds() / 1000
+	bin := diff / stride
+	if diff%stride < 0 {
+		// round toward negative infinity for timestamps before the origin
+		bin--
+	}
+	res := origin.Add(time.Duration(bin*stride) * time.Microsecond)
+	return data.Timestamp(res.In(time.UTC)), nil
+}
+
+// dateBinFunc(interval, t[, origin]) returns the start of the bin of
+// the given interval in microseconds, e.g. INTERVAL "15 minutes", which
+// the timestamp t falls into. Bins are aligned with origin, which is
+// 1970-01-01 00:00:00 UTC by default.
+//
+// It can be used in BQL as `date_bin`.
+//
+//  Input: Int, Timestamp, [Timestamp]
+//  Return Type: Timestamp
+var dateBinFunc udf.UDF = &dateBinFuncTmpl{}
+
+// timeDirectiveReplacer expands the composite directives of strftime
+// and strptime. "%%" is replaced by itself so that it isn't regarded as
+// the beginning of a directive.
+var timeDirectiveReplacer = strings.NewReplacer(
+	"%%", "%%",
+	"%F", "%Y-%m-%d",
+	"%T", "%H:%M:%S",
+	"%R", "%H:%M",
+)
+
+// strftime formats t with the strftime(3)-style format.
+func strftime(t time.Time, format string) (string, error) {
+	format = timeDirectiveReplacer.Replace(format)
+	var b bytes.Buffer
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			b.WriteByte(format[i])
+			continue
+		}
+		i++
+		if i == len(format) {
+			return "", fmt.Errorf("the format ends with an incomplete directive: %v", format)
+		}
+		switch format[i] {
+		case 'a':
+			b.WriteString(t.Weekday().String()[:3])
+		case 'A':
+			b.WriteString(t.Weekday().String())
+		case 'b', 'h':
+			b.WriteString(t.Month().String()[:3])
+		case 'B':
+			b.WriteString(t.Month().String())
+		case 'd':
+			fmt.Fprintf(&b, "%02d", t.Day())
+		case 'e':
+			fmt.Fprintf(&b, "%2d", t.Day())
+		case 'f':
+			fmt.Fprintf(&b, "%06d", t.Nanosecond()/1000)
+		case 'G':
+			y, _ := t.ISOWeek()
+			fmt.Fprintf(&b, "%04d", y)
+		case 'H':
+			fmt.Fprintf(&b, "%02d", t.Hour())
+		case 'I':
+			h := t.Hour() % 12
+			if h == 0 {
+				h = 12
+			}
+			fmt.Fprintf(&b, "%02d", h)
+		case 'j':
+			fmt.Fprintf(&b, "%03d", t.YearDay())
+		case 'm':
+			fmt.Fprintf(&b, "%02d", t.Month())
+		case 'M':
+			fmt.Fprintf(&b, "%02d", t.Minute())
+		case 'p':
+			if t.Hour() < 12 {
+				b.WriteString("AM")
+			} else {
+				b.WriteString("PM")
+			}
+		case 's':
+			fmt.Fprintf(&b, "%d", t.Unix())
+		case 'S':
+			fmt.Fprintf(&b, "%02d", t.Second())
+		case 'u':
+			fmt.Fprintf(&b, "%d", (int(t.Weekday())+6)%7+1)
+		case 'V':
+			_, w := t.ISOWeek()
+			fmt.Fprintf(&b, "%02d", w)
+		case 'w':
+			fmt.Fprintf(&b, "%d", t.Weekday())
+		case 'y':
+			fmt.Fprintf(&b, "%02d", t.Year()%100)
+		case 'Y':
+			fmt.Fprintf(&b, "%04d", t.Year())
+		case 'z':
+			b.WriteString(t.Format("-0700"))
+		case 'Z':
+			b.WriteString(t.Format("MST"))
+		case '%':
+			b.WriteByte('%')
+		default:
+			return "", fmt.Errorf("unsupported directive %%%c in the format: %v", format[i], format)
+		}
+	}
+	return b.String(), nil
+}
+
+// strftimeFunc(t, format[, zone]) formats the timestamp t in the given
+// time zone, which is UTC by default. The format is a string containing
+// the following directives as in strftime(3):
+//
+//  %Y (year), %y (year without century), %m (month, 01-12), %d (day of
+//  the month, 01-31), %e (day of the month, space-padded), %H (hour,
+//  00-23), %I (hour, 01-12), %M (minute), %S (second), %f (microsecond,
+//  000000-999999), %p (AM or PM), %j (day of the year), %a and %A
+//  (abbreviated and full weekday name), %b, %h, and %B (abbreviated and
+//  full month name), %u (weekday, 1-7 where 1 is Monday), %w (weekday,
+//  0-6 where 0 is Sunday), %V (ISO 8601 week number), %G (ISO 8601 year),
+//  %s (seconds since the Unix epoch), %z (UTC offset such as +0900),
+//  %Z (time zone abbreviation), %F (%Y-%m-%d), %T (%H:%M:%S), %R (%H:%M),
+//  and %% (a literal %).
+//
+// It can be used in BQL as `strftime`.
+//
+//  Input: Timestamp, String, [String]
+//  Return Type: String
+var strftimeFunc udf.UDF = &zonedTimeFuncTmpl{
+	timeFun: func(arg1, arg2 data.Value, loc *time.Location) (data.Value, error) {
+		format, t, err := stringAndTimestamp(arg2, arg1)
+		if err != nil {
+			return nil, err
+		}
+		s, err := strftime(t.In(loc), format)
+		if err != nil {
+			return nil, err
+		}
+		return data.String(s), nil
+	},
+}
+
+// timeParser holds the state of strptime.
+type timeParser struct {
+	input string
+	rest  string
+}
+
+func (p *timeParser) fail() error {
+	return fmt.Errorf("cannot parse '%v' at '%v'", p.input, p.rest)
+}
+
+// number reads a decimal number having at least one and at most maxDigits
+// digits. It returns the value and the number of digits read.
+func (p *timeParser) number(maxDigits int) (int, int, error) {
+	n := 0
+	for n < len(p.rest) && n < maxDigits && '0' <= p.rest[n] && p.rest[n] <= '9' {
+		n++
+	}
+	if n == 0 {
+		return 0, 0, p.fail()
+	}
+	v, _ := strconv.Atoi(p.rest[:n])
+	p.rest = p.rest[n:]
+	return v, n, nil
+}
+
+// oneOf reads one of the given words ignoring case. It returns the index
+// of the word read.
+func (p *timeParser) oneOf(words []string) (int, error) {
+	for i, w := range words {
+		if len(p.rest) >= len(w) && strings.EqualFold(p.rest[:len(w)], w) {
+			p.rest = p.rest[len(w):]
+			return i, nil
+		}
+	}
+	return 0, p.fail()
+}
+
+func (p *timeParser) skipSpaces() {
+	p.rest = strings.TrimLeft(p.rest, " \t\n\r")
+}
+
+var (
+	// full names come first so that they are preferred over abbreviations
+	monthNames = []string{"January", "February", "March", "April", "May",
+		"June", "July", "August", "September", "October", "November", "December",
+		"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"}
+	weekdayNames = []string{"Sunday", "Monday", "Tuesday", "Wednesday",
+		"Thursday", "Friday", "Saturday",
+		"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+	utcOffsetParseRegexp = regexp.MustCompile(`^(?:Z|[+-][0-9]{2}(?::?[0-9]{2})?)`)
+	zoneNameRegexp       = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_/+-]*`)
+)
+
+// strptime parses s with the strptime(3)-style format. Timestamps are
+// interpreted in loc unless the format has %z, %Z, or %s.
+func strptime(s, format string, loc *time.Location) (time.Time, error) {
+	format = timeDirectiveReplacer.Replace(format)
+	p := &timeParser{input: s, rest: s}
+	year, month, day, yday := 1970, 1, 1, 0
+	hour, min, sec, nsec := 0, 0, 0, 0
+	hour12, pm := false, false
+	var epoch *int64
+
+	for i := 0; i < len(format); i++ {
+		c := format[i]
+		if c == ' ' || c == '\t' || c == '\n' {
+			// a white space matches zero or more white spaces
+			p.skipSpaces()
+			continue
+		}
+		if c != '%' {
+			if len(p.rest) == 0 || p.rest[0] != c {
+				return time.Time{}, p.fail()
+			}
+			p.rest = p.rest[1:]
+			continue
+		}
+		i++
+		if i == len(format) {
+			return time.Time{}, fmt.Errorf("the format ends with an incomplete directive: %v", format)
+		}
+		var err error
+		switch format[i] {
+		case 'Y':
+			year, _, err = p.number(4)
+		case 'y':
+			var y, n int
+			y, n, err = p.number(2)
+			if err == nil && n != 2 {
+				err = p.fail()
+			}
+			// as in POSIX, 69-99 are in the 20th century
+			if y >= 69 {
+				year = 1900 + y
+			} else {
+				year = 2000 + y
+			}
+		case 'm':
+			month, _, err = p.number(2)
+		case 'e':
+			p.skipSpaces()
+			day, _, err = p.number(2)
+		case 'd':
+			day, _, err = p.number(2)
+		case 'j':
+			yday, _, err = p.number(3)
+			if err == nil && (yday < 1 || yday > 366) {
+				err = fmt.Errorf("invalid day of the year: %v", yday)
+			}
+		case 'H':
+			hour, _, err = p.number(2)
+		case 'I':
+			hour, _, err = p.number(2)
+			hour12 = true
+		case 'M':
+			min, _, err = p.number(2)
+		case 'S':
+			sec, _, err = p.number(2)
+		case 'f':
+			var n int
+			nsec, n, err = p.number(9)
+			for ; n < 9; n++ {
+				nsec *= 10
+			}
+		case 'p':
+			var idx int
+			idx, err = p.oneOf([]string{"AM", "PM"})
+			pm = idx == 1
+		case 'b', 'h', 'B':
+			var idx int
+			idx, err = p.oneOf(monthNames)
+			month = idx%12 + 1
+		case 'a', 'A':
+			// weekdays are only validated by the format
+			_, err = p.oneOf(weekdayNames)
+		case 's':
+			neg := strings.HasPrefix(p.rest, "-")
+			if neg {
+				p.rest = p.rest[1:]
+			}
+			var v int
+			v, _, err = p.number(19)
+			e := int64(v)
+			if neg {
+				e = -e
+			}
+			epoch = &e
+		case 'z':
+			m := utcOffsetParseRegexp.FindString(p.rest)
+			if m == "" {
+				err = p.fail()
+				break
+			}
+			p.rest = p.rest[len(m):]
+			if m == "Z" {
+				loc = time.UTC
+			} else {
+				loc, err = loadLocation(m)
+			}
+		case 'Z':
+			m := zoneNameRegexp.FindString(p.rest)
+			if m == "" {
+				err = p.fail()
+				break
+			}
+			p.rest = p.rest[len(m):]
+			switch m {
+			case "UTC", "GMT", "Z":
+				loc = time.UTC
+			default:
+				loc, err = loadLocation(m)
+			}
+		case '%':
+			if !strings.HasPrefix(p.rest, "%") {
+				err = p.fail()
+				break
+			}
+			p.rest = p.rest[1:]
+		default:
+			err = fmt.Errorf("unsupported directive %%%c in the format: %v", format[i], format)
+		}
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+	if p.rest != "" {
+		return time.Time{}, fmt.Errorf("extra text at the end of '%v': '%v'", p.input, p.rest)
+	}
+
+	if epoch != nil {
+		return time.Unix(*epoch, int64(nsec)).In(time.UTC), nil
+	}
+	if hour12 {
+		if hour < 1 || hour > 12 {
+			return time.Time{}, fmt.Errorf("invalid hour: %v", hour)
+		}
+		hour %= 12
+		if pm {
+			hour += 12
+		}
+	} else if pm && hour < 12 {
+		hour += 12
+	}
+	if hour > 23 || min > 59 || sec > 59 {
+		return time.Time{}, fmt.Errorf("invalid time: %02d:%02d:%02d", hour, min, sec)
+	}
+	if yday > 0 {
+		t := time.Date(year, time.January, yday, hour, min, sec, nsec, loc)
+		if t.Year() != year {
+			return time.Time{}, fmt.Errorf("invalid day of the year: %v", yday)
+		}
+		return t.In(time.UTC), nil
+	}
+	t := time.Date(year, time.Month(month), day, hour, min, sec, nsec, loc)
+	if int(t.Month()) != month || t.Day() != day {
+		return time.Time{}, fmt.Errorf("invalid date: %04d-%02d-%02d", year, month, day)
+	}
+	return t.In(time.UTC), nil
+}
+
+// strptimeFunc(s, format[, zone]) parses the string s with the format,
+// which has the same directives as the one of strftime. A white space in
+// the format matches zero or more white spaces in s. Fields which aren't
+// in the format default to 1970-01-01 00:00:00. The time is interpreted
+// in the given time zone, which is UTC by default, unless the format has
+// %z, %Z, or %s. %Z accepts "UTC", "GMT", and IANA time zone names.
+//
+// It can be used in BQL as `strptime`.
+//
+//  Input: String, String, [String]
+//  Return Type: Timestamp
+var strptimeFunc udf.UDF = &zonedTimeFuncTmpl{
+	timeFun: func(arg1, arg2 data.Value, loc *time.Location) (data.Value, error) {
+		s, err := data.AsString(arg1)
+		if err != nil {
+			return nil, fmt.Errorf("cannot interpret %s as a string", arg1)
+		}
+		format, err := data.AsString(arg2)
+		if err != nil {
+			return nil, fmt.Errorf("cannot interpret %s as a string", arg2)
+		}
+		t, err := strptime(s, format, loc)
+		if err != nil {
+			return nil, err
+		}
+		return data.Timestamp(t), nil
+	},
+}
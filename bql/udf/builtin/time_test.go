@@ -123,3 +123,160 @@ func TestBinaryDateFuncs(t *testing.T) {
 		})
 	}
 }
+
+func TestVariadicDateFuncs(t *testing.T) {
+	// 2015-05-01 (Friday) 14:27:03.123456789 UTC
+	someTime := data.Timestamp(time.Date(2015, time.May, 1, 14, 27, 3, 123456789, time.UTC))
+	at := func(y int, m time.Month, d, h, min, s, ns int) data.Value {
+		return data.Timestamp(time.Date(y, m, d, h, min, s, ns, time.UTC))
+	}
+	str := func(s string) data.Value {
+		return data.String(s)
+	}
+
+	udfVariadicTestCases := []udfVariadicTestCase{
+		{"date_trunc", dateTruncFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{str("microsecond"), someTime}, at(2015, time.May, 1, 14, 27, 3, 123456000)},
+			{[]data.Value{str("millisecond"), someTime}, at(2015, time.May, 1, 14, 27, 3, 123000000)},
+			{[]data.Value{str("second"), someTime}, at(2015, time.May, 1, 14, 27, 3, 0)},
+			{[]data.Value{str("minute"), someTime}, at(2015, time.May, 1, 14, 27, 0, 0)},
+			{[]data.Value{str("HOUR"), someTime}, at(2015, time.May, 1, 14, 0, 0, 0)},
+			{[]data.Value{str("day"), someTime}, at(2015, time.May, 1, 0, 0, 0, 0)},
+			{[]data.Value{str("week"), someTime}, at(2015, time.April, 27, 0, 0, 0, 0)},
+			{[]data.Value{str("month"), someTime}, at(2015, time.May, 1, 0, 0, 0, 0)},
+			{[]data.Value{str("quarter"), someTime}, at(2015, time.April, 1, 0, 0, 0, 0)},
+			{[]data.Value{str("year"), someTime}, at(2015, time.January, 1, 0, 0, 0, 0)},
+			// the day starts at 15:00 UTC in Tokyo
+			{[]data.Value{str("day"), someTime, str("Asia/Tokyo")}, at(2015, time.April, 30, 15, 0, 0, 0)},
+			{[]data.Value{str("day"), someTime, str("+09:00")}, at(2015, time.April, 30, 15, 0, 0, 0)},
+			{[]data.Value{str("hour"), someTime, str("+0530")}, at(2015, time.May, 1, 13, 30, 0, 0)},
+			{[]data.Value{data.Null{}, someTime}, data.Null{}},
+			{[]data.Value{str("day"), data.Null{}}, data.Null{}},
+			{[]data.Value{str("day"), someTime, data.Null{}}, data.Null{}},
+			// invalid cases
+			{[]data.Value{str("fortnight"), someTime}, nil},
+			{[]data.Value{str("day"), data.Int(3)}, nil},
+			{[]data.Value{str("day"), someTime, str("Nowhere/Unknown")}, nil},
+			{[]data.Value{str("day")}, nil},
+		}},
+		{"date_part", datePartFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{str("year"), someTime}, data.Int(2015)},
+			{[]data.Value{str("month"), someTime}, data.Int(5)},
+			{[]data.Value{str("day"), someTime}, data.Int(1)},
+			{[]data.Value{str("hour"), someTime}, data.Int(14)},
+			{[]data.Value{str("minute"), someTime}, data.Int(27)},
+			{[]data.Value{str("second"), someTime}, data.Int(3)},
+			{[]data.Value{str("millisecond"), someTime}, data.Int(123)},
+			{[]data.Value{str("microsecond"), someTime}, data.Int(123456)},
+			{[]data.Value{str("quarter"), someTime}, data.Int(2)},
+			{[]data.Value{str("doy"), someTime}, data.Int(121)},
+			{[]data.Value{str("dow"), someTime}, data.Int(5)},
+			{[]data.Value{str("isodow"), someTime}, data.Int(5)},
+			{[]data.Value{str("week"), someTime}, data.Int(18)},
+			{[]data.Value{str("isoyear"), at(2016, time.January, 1, 0, 0, 0, 0)}, data.Int(2015)},
+			{[]data.Value{str("epoch"), at(1970, time.January, 1, 0, 0, 1, 500000000)}, data.Float(1.5)},
+			{[]data.Value{str("hour"), someTime, str("Asia/Tokyo")}, data.Int(23)},
+			{[]data.Value{str("hour"), someTime, str("-12:00")}, data.Int(2)},
+			{[]data.Value{str("day"), data.Null{}}, data.Null{}},
+			// invalid cases
+			{[]data.Value{str("century"), someTime}, nil},
+			{[]data.Value{data.Int(1), someTime}, nil},
+		}},
+		{"date_bin", dateBinFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{data.Int(15 * 60 * 1000000), someTime}, at(2015, time.May, 1, 14, 15, 0, 0)},
+			{[]data.Value{data.Int(1000000), someTime}, at(2015, time.May, 1, 14, 27, 3, 0)},
+			{[]data.Value{data.Int(15 * 60 * 1000000), someTime, at(2015, time.May, 1, 0, 5, 0, 0)},
+				at(2015, time.May, 1, 14, 20, 0, 0)},
+			{[]data.Value{data.Int(10 * 1000000), at(1969, time.December, 31, 23, 59, 55, 0)},
+				at(1969, time.December, 31, 23, 59, 50, 0)},
+			{[]data.Value{data.Null{}, someTime}, data.Null{}},
+			// invalid cases
+			{[]data.Value{data.Int(0), someTime}, nil},
+			{[]data.Value{str("1 minute"), someTime}, nil},
+			{[]data.Value{data.Int(1000000), str("2015-05-01")}, nil},
+		}},
+		{"strftime", strftimeFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{someTime, str("%Y-%m-%d %H:%M:%S.%f")}, str("2015-05-01 14:27:03.123456")},
+			{[]data.Value{someTime, str("%F %T %z %Z")}, str("2015-05-01 14:27:03 +0000 UTC")},
+			{[]data.Value{someTime, str("%a %A %b %B %h %e %j")}, str("Fri Friday May May May  1 121")},
+			{[]data.Value{someTime, str("%I:%M %p, %y, %u %w %G-W%V, %R, 100%%")},
+				str("02:27 PM, 15, 5 5 2015-W18, 14:27, 100%")},
+			{[]data.Value{at(1970, time.January, 1, 0, 1, 0, 0), str("%s")}, str("60")},
+			{[]data.Value{someTime, str("%F %T %z"), str("Asia/Tokyo")}, str("2015-05-01 23:27:03 +0900")},
+			{[]data.Value{someTime, str("日付: %F")}, str("日付: 2015-05-01")},
+			{[]data.Value{data.Null{}, str("%F")}, data.Null{}},
+			// invalid cases
+			{[]data.Value{someTime, str("%Q")}, nil},
+			{[]data.Value{someTime, str("%")}, nil},
+			{[]data.Value{str("2015-05-01"), str("%F")}, nil},
+		}},
+		{"strptime", strptimeFunc, []udfVariadicTestCaseInput{
+			{[]data.Value{str("2015-05-01 14:27:03.123456"), str("%Y-%m-%d %H:%M:%S.%f")},
+				at(2015, time.May, 1, 14, 27, 3, 123456000)},
+			{[]data.Value{str("2015-05-01T14:27:03+09:00"), str("%FT%T%z")},
+				at(2015, time.May, 1, 5, 27, 3, 0)},
+			{[]data.Value{str("2015-05-01T14:27:03Z"), str("%FT%T%z")},
+				at(2015, time.May, 1, 14, 27, 3, 0)},
+			{[]data.Value{str("01/May/2015:02:27:03 pm"), str("%d/%b/%Y:%I:%M:%S %p")},
+				at(2015, time.May, 1, 14, 27, 3, 0)},
+			{[]data.Value{str("Friday,  1 may 15"), str("%A, %e %B %y")},
+				at(2015, time.May, 1, 0, 0, 0, 0)},
+			{[]data.Value{str("2015 121"), str("%Y %j")}, at(2015, time.May, 1, 0, 0, 0, 0)},
+			{[]data.Value{str("1430490423"), str("%s")}, at(2015, time.May, 1, 14, 27, 3, 0)},
+			{[]data.Value{str("14:27 Asia/Tokyo"), str("%H:%M %Z")}, at(1970, time.January, 1, 5, 27, 0, 0)},
+			{[]data.Value{str("2015-05-01 14:27"), str("%Y-%m-%d %H:%M"), str("Asia/Tokyo")},
+				at(2015, time.May, 1, 5, 27, 0, 0)},
+			{[]data.Value{str("100% 2015"), str("100%% %Y")}, at(2015, time.January, 1, 0, 0, 0, 0)},
+			{[]data.Value{data.Null{}, str("%F")}, data.Null{}},
+			// invalid cases
+			{[]data.Value{str("2015-02-30"), str("%F")}, nil},
+			{[]data.Value{str("2015-05-01 25:00"), str("%F %H:%M")}, nil},
+			{[]data.Value{str("13:00 PM"), str("%I:%M %p")}, nil},
+			{[]data.Value{str("2015-05-01 extra"), str("%F")}, nil},
+			{[]data.Value{str("2015/05/01"), str("%F")}, nil},
+			{[]data.Value{str("2015"), str("%Q")}, nil},
+			{[]data.Value{data.Int(2015), str("%Y")}, nil},
+		}},
+	}
+
+	for _, testCase := range udfVariadicTestCases {
+		f := testCase.f
+		allInputs := testCase.inputs
+
+		Convey(fmt.Sprintf("Given the %s function", testCase.name), t, func() {
+			for _, tc := range allInputs {
+				tc := tc
+
+				Convey(fmt.Sprintf("When evaluating it on %#v", tc.input), func() {
+					val, err := f.Call(nil, tc.input...)
+
+					if tc.expected == nil {
+						Convey("Then evaluation should fail", func() {
+							So(err, ShouldNotBeNil)
+						})
+					} else {
+						Convey(fmt.Sprintf("Then the result should be %s", tc.expected), func() {
+							So(err, ShouldBeNil)
+							So(val, ShouldResemble, tc.expected)
+						})
+					}
+				})
+			}
+
+			Convey("Then it should equal the one in the default registry", func() {
+				regFun, err := udf.CopyGlobalUDFRegistry(nil).Lookup(testCase.name, 3)
+				So(err, ShouldBeNil)
+				So(regFun, ShouldHaveSameTypeAs, f)
+			})
+		})
+	}
+
+	Convey("Given the extract function in the default registry", t, func() {
+		f, err := udf.CopyGlobalUDFRegistry(nil).Lookup("extract", 2)
+		So(err, ShouldBeNil)
+
+		Convey("Then it should be the same as date_part", func() {
+			So(f, ShouldEqual, datePartFunc)
+		})
+	})
+}
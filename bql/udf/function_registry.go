@@ -25,6 +25,21 @@ type UDF interface {
 	IsAggregationParameter(k int) bool
 }
 
+// CallSiteUDF is a UDF which keeps state for each place where it's called
+// in BQL statements, e.g. a cache of values computed from arguments which
+// are usually constants. When an expression calling the function is
+// compiled, ForCallSite is called and the returned UDF is used for all
+// calls at that call site. Calls at a call site are never concurrent
+// because a compiled expression is only evaluated by one goroutine at a
+// time. This also applies to functions defined by CREATE FUNCTION, whose
+// bodies are compiled for each concurrent call.
+type CallSiteUDF interface {
+	UDF
+
+	// ForCallSite returns the UDF used at a new call site.
+	ForCallSite() UDF
+}
+
 type function struct {
 	f     func(*core.Context, ...data.Value) (data.Value, error)
 	arity int
@@ -136,12 +151,37 @@ type FunctionManager interface {
 	Register(name string, f UDF) error
 }
 
+// FunctionLister is implemented by a FunctionRegistry which can list all
+// functions it has. It's separated from FunctionManager so that existing
+// implementations of FunctionManager don't have to implement it. Features
+// like SHOW FUNCTIONS are unavailable with registries not implementing it.
+type FunctionLister interface {
+	// List returns all functions the registry has. The caller can safely
+	// modify the map returned from this method.
+	List() (map[string]UDF, error)
+}
+
+// FunctionUnregisterer is implemented by a FunctionManager which can
+// remove functions. Like FunctionLister, it's separated from
+// FunctionManager for compatibility with existing implementations.
+type FunctionUnregisterer interface {
+	// Unregister removes a function from the registry. It returns
+	// core.NotExistError when the registry doesn't have a function having
+	// the name.
+	Unregister(name string) error
+}
+
 type defaultFunctionRegistry struct {
 	ctx   *core.Context
 	m     sync.RWMutex
 	funcs map[string]UDF
 }
 
+var (
+	_ FunctionLister       = &defaultFunctionRegistry{}
+	_ FunctionUnregisterer = &defaultFunctionRegistry{}
+)
+
 // NewDefaultFunctionRegistry returns a new instance of the default
 // FunctionRegistry implementation.
 func NewDefaultFunctionRegistry(ctx *core.Context) FunctionManager {
@@ -178,7 +218,7 @@ func (fr *defaultFunctionRegistry) Register(name string, f UDF) error {
 	switch lowerName {
 	case "count", "avg", "max", "min", "sum",
 		"coalesce", "lower", "upper", "octet_length",
-		"substring":
+		"substring", "extract":
 		// skip check
 	default:
 		if err := core.ValidateSymbol(name); err != nil {
@@ -192,6 +232,28 @@ func (fr *defaultFunctionRegistry) Register(name string, f UDF) error {
 	return nil
 }
 
+func (fr *defaultFunctionRegistry) List() (map[string]UDF, error) {
+	fr.m.RLock()
+	defer fr.m.RUnlock()
+
+	m := make(map[string]UDF, len(fr.funcs))
+	for n, f := range fr.funcs {
+		m[n] = f
+	}
+	return m, nil
+}
+
+func (fr *defaultFunctionRegistry) Unregister(name string) error {
+	fr.m.Lock()
+	defer fr.m.Unlock()
+	lowerName := strings.ToLower(name)
+	if _, exists := fr.funcs[lowerName]; !exists {
+		return core.NotExistError(fmt.Errorf("function '%s' is unknown", name))
+	}
+	delete(fr.funcs, lowerName)
+	return nil
+}
+
 var (
 	// globalUDFRegistry has UDFs visible to all topologies. Do NOT use this
 	// instance in running topologies because it doesn't have an actual
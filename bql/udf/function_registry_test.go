@@ -119,5 +119,44 @@ func TestDefaultFunctionRegistry(t *testing.T) {
 				So(err, ShouldNotBeNil)
 			})
 		})
+
+		Convey("When adding a function with an upper case name", func() {
+			fun := func(*core.Context, data.Value) (data.Value, error) {
+				return data.Bool(true), nil
+			}
+			fr.Register("TEST4", UnaryFunc(fun))
+
+			Convey("Then List should return it with a lower case name", func() {
+				m, err := fr.(FunctionLister).List()
+				So(err, ShouldBeNil)
+				So(m, ShouldContainKey, "test4")
+				So(m, ShouldContainKey, "str")
+			})
+
+			Convey("And modifying the returned map should not affect the registry", func() {
+				m, err := fr.(FunctionLister).List()
+				So(err, ShouldBeNil)
+				delete(m, "test4")
+				_, err = fr.Lookup("test4", 1)
+				So(err, ShouldBeNil)
+			})
+		})
+
+		Convey("When unregistering a function", func() {
+			fun := func(*core.Context, data.Value) (data.Value, error) {
+				return data.Bool(true), nil
+			}
+			So(fr.Register("test5", UnaryFunc(fun)), ShouldBeNil)
+			So(fr.(FunctionUnregisterer).Unregister("TEST5"), ShouldBeNil)
+
+			Convey("Then it should no longer be found", func() {
+				_, err := fr.Lookup("test5", 1)
+				So(core.IsNotExist(err), ShouldBeTrue)
+			})
+
+			Convey("Then unregistering it again should fail", func() {
+				So(core.IsNotExist(fr.(FunctionUnregisterer).Unregister("test5")), ShouldBeTrue)
+			})
+		})
 	})
 }
@@ -0,0 +1,163 @@
+package udf
+
+import (
+	"fmt"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+// UDAF is a user defined aggregate function. In contrast to an aggregate
+// function implemented as a UDF, which is called with the values of all
+// rows at once, a UDAF computes its result from a state which is updated
+// row by row. Therefore, it can be evaluated incrementally on windows.
+//
+// All parameters of a UDAF are aggregation parameters. A state is a
+// data.Value so that it can be serialized, e.g. to be saved. A state
+// passed to Accumulate or Retract isn't used by the caller anymore, so
+// those methods can modify it and return it as the new state.
+//
+// A UDAF is registered as a UDF converted by ConvertUDAF, e.g. by
+// RegisterGlobalUDAF.
+type UDAF interface {
+	// Accept checks if the aggregate accepts the given number of
+	// arguments excluding core.Context.
+	Accept(arity int) bool
+
+	// Init returns the state for no rows.
+	Init(ctx *core.Context) (data.Value, error)
+
+	// Accumulate returns the state after adding a row having the given
+	// argument values.
+	Accumulate(ctx *core.Context, state data.Value, args ...data.Value) (data.Value, error)
+
+	// Retract returns the state after removing the oldest row, which has
+	// the given argument values. Rows are always retracted in the order
+	// in which they were added. It returns ErrNotRetractable if the row
+	// cannot be removed, e.g. because the result is a maximum.
+	Retract(ctx *core.Context, state data.Value, args ...data.Value) (data.Value, error)
+
+	// Result returns the result of the aggregate for the given state.
+	Result(ctx *core.Context, state data.Value) (data.Value, error)
+}
+
+type udafFunc struct {
+	f UDAF
+}
+
+var (
+	_ IncrementalUDF = &udafFunc{}
+)
+
+// ConvertUDAF creates a UDF from a UDAF. The UDF can be called with
+// arrays having the argument values of all rows like other aggregate
+// functions and it implements IncrementalUDF.
+func ConvertUDAF(f UDAF) UDF {
+	return &udafFunc{f}
+}
+
+// AsUDAF returns the UDAF from which the given UDF was created by
+// ConvertUDAF. It returns false if the UDF wasn't created from a UDAF.
+func AsUDAF(f UDF) (UDAF, bool) {
+	u, ok := f.(*udafFunc)
+	if !ok {
+		return nil, false
+	}
+	return u.f, true
+}
+
+func (u *udafFunc) Call(ctx *core.Context, args ...data.Value) (data.Value, error) {
+	arrs := make([]data.Array, len(args))
+	for i, arg := range args {
+		arr, err := data.AsArray(arg)
+		if err != nil {
+			return nil, fmt.Errorf("function needs array input, not %T", arg)
+		}
+		if i > 0 && len(arr) != len(arrs[0]) {
+			return nil, fmt.Errorf("arguments have different lengths: %d and %d",
+				len(arrs[0]), len(arr))
+		}
+		arrs[i] = arr
+	}
+
+	state, err := u.f.Init(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(arrs) > 0 {
+		row := make([]data.Value, len(arrs))
+		for i := range arrs[0] {
+			for j, arr := range arrs {
+				row[j] = arr[i]
+			}
+			state, err = u.f.Accumulate(ctx, state, row...)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return u.f.Result(ctx, state)
+}
+
+func (u *udafFunc) Accept(arity int) bool {
+	return arity > 0 && u.f.Accept(arity)
+}
+
+func (u *udafFunc) IsAggregationParameter(k int) bool {
+	return true
+}
+
+func (u *udafFunc) NewAccumulator(ctx *core.Context) (Accumulator, error) {
+	state, err := u.f.Init(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &udafAccumulator{
+		ctx:   ctx,
+		f:     u.f,
+		state: state,
+	}, nil
+}
+
+// udafAccumulator is an Accumulator maintaining the state of a UDAF.
+type udafAccumulator struct {
+	ctx   *core.Context
+	f     UDAF
+	state data.Value
+}
+
+func (a *udafAccumulator) Add(args ...data.Value) error {
+	s, err := a.f.Accumulate(a.ctx, a.state, args...)
+	if err != nil {
+		return err
+	}
+	a.state = s
+	return nil
+}
+
+func (a *udafAccumulator) Retract(args ...data.Value) error {
+	s, err := a.f.Retract(a.ctx, a.state, args...)
+	if err != nil {
+		return err
+	}
+	a.state = s
+	return nil
+}
+
+func (a *udafAccumulator) Result() (data.Value, error) {
+	return a.f.Result(a.ctx, a.state)
+}
+
+// RegisterGlobalUDAF adds a UDAF which is visible to all topologies as
+// a UDF converted by ConvertUDAF. Like RegisterGlobalUDF, call it from
+// init functions.
+func RegisterGlobalUDAF(name string, f UDAF) error {
+	return RegisterGlobalUDF(name, ConvertUDAF(f))
+}
+
+// MustRegisterGlobalUDAF is like RegisterGlobalUDAF but
+// panics if an error occurred.
+func MustRegisterGlobalUDAF(name string, f UDAF) {
+	if err := RegisterGlobalUDAF(name, f); err != nil {
+		panic(fmt.Errorf("udf.MustRegisterGlobalUDAF: cannot register '%v': %v", name, err))
+	}
+}
@@ -0,0 +1,217 @@
+package udf
+
+import (
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/core"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+// weightedAvgUDAF computes the average of its first argument weighted by
+// its second argument. Its state is a map having the weighted sum and the
+// sum of weights.
+type weightedAvgUDAF struct {
+}
+
+func (w *weightedAvgUDAF) Accept(arity int) bool {
+	return arity == 2
+}
+
+func (w *weightedAvgUDAF) Init(ctx *core.Context) (data.Value, error) {
+	return data.Map{"sum": data.Float(0), "weight": data.Float(0)}, nil
+}
+
+func (w *weightedAvgUDAF) update(state data.Value, sign float64, args ...data.Value) (data.Value, error) {
+	m, err := data.AsMap(state)
+	if err != nil {
+		return nil, err
+	}
+	v, err := data.ToFloat(args[0])
+	if err != nil {
+		return nil, err
+	}
+	wt, err := data.ToFloat(args[1])
+	if err != nil {
+		return nil, err
+	}
+	sum, _ := data.AsFloat(m["sum"])
+	weight, _ := data.AsFloat(m["weight"])
+	m["sum"] = data.Float(sum + sign*v*wt)
+	m["weight"] = data.Float(weight + sign*wt)
+	return m, nil
+}
+
+func (w *weightedAvgUDAF) Accumulate(ctx *core.Context, state data.Value, args ...data.Value) (data.Value, error) {
+	return w.update(state, 1, args...)
+}
+
+func (w *weightedAvgUDAF) Retract(ctx *core.Context, state data.Value, args ...data.Value) (data.Value, error) {
+	return w.update(state, -1, args...)
+}
+
+func (w *weightedAvgUDAF) Result(ctx *core.Context, state data.Value) (data.Value, error) {
+	m, err := data.AsMap(state)
+	if err != nil {
+		return nil, err
+	}
+	sum, _ := data.AsFloat(m["sum"])
+	weight, _ := data.AsFloat(m["weight"])
+	if weight == 0 {
+		return data.Null{}, nil
+	}
+	return data.Float(sum / weight), nil
+}
+
+// lastUDAF returns the last value added. It cannot retract values.
+type lastUDAF struct {
+}
+
+func (l *lastUDAF) Accept(arity int) bool {
+	return arity == 1
+}
+
+func (l *lastUDAF) Init(ctx *core.Context) (data.Value, error) {
+	return data.Null{}, nil
+}
+
+func (l *lastUDAF) Accumulate(ctx *core.Context, state data.Value, args ...data.Value) (data.Value, error) {
+	return args[0], nil
+}
+
+func (l *lastUDAF) Retract(ctx *core.Context, state data.Value, args ...data.Value) (data.Value, error) {
+	return nil, ErrNotRetractable
+}
+
+func (l *lastUDAF) Result(ctx *core.Context, state data.Value) (data.Value, error) {
+	return state, nil
+}
+
+func TestUDAF(t *testing.T) {
+	ctx := core.NewContext(nil)
+
+	Convey("Given a UDF converted from a UDAF", t, func() {
+		u := &weightedAvgUDAF{}
+		f := ConvertUDAF(u)
+
+		Convey("Then it should accept only two arguments", func() {
+			So(f.Accept(0), ShouldBeFalse)
+			So(f.Accept(1), ShouldBeFalse)
+			So(f.Accept(2), ShouldBeTrue)
+			So(f.Accept(3), ShouldBeFalse)
+		})
+
+		Convey("Then all parameters should be aggregation parameters", func() {
+			So(f.IsAggregationParameter(0), ShouldBeTrue)
+			So(f.IsAggregationParameter(1), ShouldBeTrue)
+		})
+
+		Convey("Then the UDAF should be obtained from it", func() {
+			a, ok := AsUDAF(f)
+			So(ok, ShouldBeTrue)
+			So(a, ShouldPointTo, u)
+		})
+
+		Convey("When calling it with arrays", func() {
+			v, err := f.Call(ctx, data.Array{data.Int(1), data.Int(4)},
+				data.Array{data.Float(3), data.Int(1)})
+
+			Convey("Then it should return the aggregate of all rows", func() {
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.Float(1.75))
+			})
+		})
+
+		Convey("When calling it with empty arrays", func() {
+			v, err := f.Call(ctx, data.Array{}, data.Array{})
+
+			Convey("Then it should return the result of the initial state", func() {
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.Null{})
+			})
+		})
+
+		Convey("When calling it with arrays having different lengths", func() {
+			_, err := f.Call(ctx, data.Array{data.Int(1)}, data.Array{})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When calling it with a value which isn't an array", func() {
+			_, err := f.Call(ctx, data.Int(1), data.Array{data.Int(1)})
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+
+		Convey("When creating an accumulator", func() {
+			inc, ok := f.(IncrementalUDF)
+			So(ok, ShouldBeTrue)
+			acc, err := inc.NewAccumulator(ctx)
+			So(err, ShouldBeNil)
+
+			Convey("Then it should maintain the aggregate of a window", func() {
+				So(acc.Add(data.Int(1), data.Int(3)), ShouldBeNil)
+				So(acc.Add(data.Int(4), data.Int(1)), ShouldBeNil)
+				v, err := acc.Result()
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.Float(1.75))
+
+				So(acc.Add(data.Int(2), data.Int(1)), ShouldBeNil)
+				So(acc.Retract(data.Int(1), data.Int(3)), ShouldBeNil)
+				v, err = acc.Result()
+				So(err, ShouldBeNil)
+				So(v, ShouldResemble, data.Float(3))
+			})
+
+			Convey("Then adding an invalid value should fail", func() {
+				So(acc.Add(data.String("a"), data.Int(1)), ShouldNotBeNil)
+			})
+		})
+
+	})
+
+	Convey("Given a UDAF which cannot retract values", t, func() {
+		f := ConvertUDAF(&lastUDAF{})
+		acc, err := f.(IncrementalUDF).NewAccumulator(ctx)
+		So(err, ShouldBeNil)
+
+		Convey("When retracting a value from its accumulator", func() {
+			So(acc.Add(data.Int(1)), ShouldBeNil)
+			err := acc.Retract(data.Int(1))
+
+			Convey("Then it should return ErrNotRetractable", func() {
+				So(err, ShouldEqual, ErrNotRetractable)
+			})
+		})
+	})
+
+	Convey("Given a UDF not converted from a UDAF", t, func() {
+		f := UnaryFunc(func(ctx *core.Context, v data.Value) (data.Value, error) {
+			return v, nil
+		})
+
+		Convey("Then AsUDAF should fail", func() {
+			_, ok := AsUDAF(f)
+			So(ok, ShouldBeFalse)
+		})
+	})
+
+	Convey("Given a UDAF registered globally", t, func() {
+		name := "test_weighted_avg"
+		So(RegisterGlobalUDAF(name, &weightedAvgUDAF{}), ShouldBeNil)
+		reg := CopyGlobalUDFRegistry(ctx)
+
+		Convey("When looking it up", func() {
+			f, err := reg.Lookup(name, 2)
+
+			Convey("Then it should be a UDF converted from the UDAF", func() {
+				So(err, ShouldBeNil)
+				_, ok := AsUDAF(f)
+				So(ok, ShouldBeTrue)
+			})
+		})
+	})
+}
@@ -20,13 +20,14 @@ type Statement struct {
 //
 //	* SELECT (CREATE STREAM is supported)
 //	* UPDATE SOURCE/SINK/STATE
-//	* DROP SOURCE/STREAM/SINK/STATE
+//	* DROP SOURCE/STREAM/SINK/STATE/FUNCTION
 //	* SAVE/LOAD STATE (they are automatically saved)
 func NewStatement(s interface{}) (*Statement, error) {
 	switch s.(type) {
 	case parser.SelectStmt, parser.SelectUnionStmt,
 		parser.UpdateSourceStmt, parser.UpdateSinkStmt, parser.UpdateStateStmt,
 		parser.DropSourceStmt, parser.DropStreamStmt, parser.DropSinkStmt, parser.DropStateStmt,
+		parser.DropFunctionStmt,
 		parser.SaveStateStmt, parser.LoadStateStmt, parser.LoadStateOrCreateStmt:
 		return nil, fmt.Errorf("statement isn't supported by sensorbee exp command: %v", s)
 	}
@@ -97,6 +97,20 @@ type StatefulBox interface {
 	Terminate(ctx *Context) error
 }
 
+// StateInheritingBox is a Box which can take over the internal state of
+// another Box when it replaces the Box with BoxNode.ReplaceBox.
+type StateInheritingBox interface {
+	Box
+
+	// InheritState is called after the Box is initialized and before
+	// it replaces prev. prev is the Box currently used by the node and it
+	// can be a different type of Box. No method of prev is called
+	// while this method is running, so it can safely move resources from
+	// prev to this Box. If this method returns an error, prev will still be
+	// used by the node and the Box will be terminated.
+	InheritState(ctx *Context, prev Box) error
+}
+
 // TODO: Support input constraints such as an acceptable frequency of tuples.
 
 // NamedInputBox is a box whose inputs have custom input names.
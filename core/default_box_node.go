@@ -3,15 +3,22 @@ package core
 import (
 	"fmt"
 	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"sync"
 )
 
 type defaultBoxNode struct {
 	*defaultNode
 	config *BoxConfig
 	srcs   *dataSources
-	box    Box
 	dsts   *dataDestinations
 
+	// boxMutex protects box and boxTerminated. Process of the box is
+	// called while holding the read lock so that ReplaceBox can wait
+	// until the box doesn't process any tuple.
+	boxMutex      sync.RWMutex
+	box           Box
+	boxTerminated bool
+
 	gracefulStopEnabled bool
 	stopOnDisconnectDir ConnDir
 	runErr              error
@@ -22,6 +29,8 @@ func (db *defaultBoxNode) Type() NodeType {
 }
 
 func (db *defaultBoxNode) Box() Box {
+	db.boxMutex.RLock()
+	defer db.boxMutex.RUnlock()
 	return db.box
 }
 
@@ -38,7 +47,7 @@ func (db *defaultBoxNode) Input(refname string, config *BoxInputConfig) error {
 		return err
 	}
 
-	if err := checkBoxInputName(db.box, db.name, config.inputName()); err != nil {
+	if err := checkBoxInputName(db.Box(), db.name, config.inputName()); err != nil {
 		return err
 	}
 
@@ -54,6 +63,72 @@ func (db *defaultBoxNode) Input(refname string, config *BoxInputConfig) error {
 	return nil
 }
 
+func (db *defaultBoxNode) RemoveInput(refname string) error {
+	s, err := db.topology.dataSource(refname)
+	if err != nil {
+		return err
+	}
+	if !db.srcs.has(s.Name()) {
+		return NotExistError(fmt.Errorf("box '%v' doesn't receive tuples from '%v'", db.name, refname))
+	}
+	db.srcs.remove(s.Name())
+	return nil
+}
+
+func (db *defaultBoxNode) ReplaceBox(b Box) error {
+	for _, n := range db.srcs.inputNames() {
+		if err := checkBoxInputName(b, db.name, n); err != nil {
+			return err
+		}
+	}
+
+	sb, stateful := b.(StatefulBox)
+	if stateful {
+		if err := initBox(db.topology.ctx, sb); err != nil {
+			return err
+		}
+	}
+	terminate := func(b Box) {
+		sb, ok := b.(StatefulBox)
+		if !ok {
+			return
+		}
+		if err := terminateBox(db.topology.ctx, sb); err != nil {
+			db.topology.ctx.ErrLog(err).WithFields(nodeLogFields(NTBox, db.name)).
+				Error("Cannot terminate the box")
+		}
+	}
+
+	prev, err := func() (Box, error) {
+		db.boxMutex.Lock()
+		defer db.boxMutex.Unlock()
+		if db.boxTerminated {
+			return nil, fmt.Errorf("box '%v' is already stopped", db.name)
+		}
+		if ib, ok := b.(StateInheritingBox); ok {
+			if err := ib.InheritState(db.topology.ctx, db.box); err != nil {
+				return nil, err
+			}
+		}
+		prev := db.box
+		db.box = b
+		return prev, nil
+	}()
+	if err != nil {
+		terminate(b)
+		return err
+	}
+	terminate(prev)
+	return nil
+}
+
+// process calls Process of the current box.
+func (db *defaultBoxNode) process(ctx *Context, t *Tuple, w Writer) error {
+	db.boxMutex.RLock()
+	defer db.boxMutex.RUnlock()
+	return db.box.Process(ctx, t, w)
+}
+
 func (db *defaultBoxNode) run() (runErr error) {
 	if err := db.checkAndPrepareForRunning("box"); err != nil {
 		return err
@@ -73,6 +148,9 @@ func (db *defaultBoxNode) run() (runErr error) {
 			db.dsts.Close(db.topology.ctx)
 			db.state.Set(TSStopped)
 		}()
+		db.boxMutex.Lock()
+		defer db.boxMutex.Unlock()
+		db.boxTerminated = true
 		if sb, ok := db.box.(StatefulBox); ok {
 			if err := sb.Terminate(db.topology.ctx); err != nil {
 				if db.runErr == nil {
@@ -85,7 +163,8 @@ func (db *defaultBoxNode) run() (runErr error) {
 		}
 	}()
 	db.state.Set(TSRunning)
-	w := newBoxWriterAdapter(db.box, db.name, db.dsts)
+	dsts := newValidatingWriter(db.dsts, db.config.Validator, NTBox, db.name)
+	w := newBoxWriterAdapter(BoxFunc(db.process), db.name, dsts)
 	db.runErr = db.srcs.pour(db.topology.ctx, w, 1) // TODO: make parallelism configurable
 	return
 }
@@ -150,7 +229,7 @@ func (db *defaultBoxNode) Status() data.Map {
 	if st == TSStopped && db.runErr != nil {
 		m["error"] = data.String(db.runErr.Error())
 	}
-	if b, ok := db.box.(Statuser); ok {
+	if b, ok := db.Box().(Statuser); ok {
 		m["box"] = b.Status()
 	}
 	return m
@@ -183,3 +262,27 @@ func (db *defaultBoxNode) RemoveOnStop() {
 		db.topology.Remove(db.name)
 	}
 }
+
+// initBox calls Init of the box and converts a panic to an error.
+func initBox(ctx *Context, sb StatefulBox) (err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			if er, ok := e.(error); ok {
+				err = er
+			} else {
+				err = fmt.Errorf("the box cannot be initialized due to panic: %v", e)
+			}
+		}
+	}()
+	return sb.Init(ctx)
+}
+
+// terminateBox calls Terminate of the box and converts a panic to an error.
+func terminateBox(ctx *Context, sb StatefulBox) (err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			err = fmt.Errorf("the box couldn't be terminated due to panic: %v", e)
+		}
+	}()
+	return sb.Terminate(ctx)
+}
@@ -56,7 +56,8 @@ func (ds *defaultSourceNode) run() (runErr error) {
 		return
 	}
 
-	ds.runErr = ds.source.GenerateStream(ds.topology.ctx, newTraceWriter(ds.dsts, ETOutput, ds.name))
+	dsts := newValidatingWriter(ds.dsts, ds.config.Validator, NTSource, ds.name)
+	ds.runErr = ds.source.GenerateStream(ds.topology.ctx, newTraceWriter(dsts, ETOutput, ds.name))
 	return
 }
 
@@ -161,19 +161,7 @@ func (t *defaultTopology) AddBox(name string, b Box, config *BoxConfig) (BoxNode
 	}
 
 	if sb, ok := b.(StatefulBox); ok {
-		err := func() (err error) {
-			defer func() {
-				if e := recover(); e != nil {
-					if er, ok := e.(error); ok {
-						err = er
-					} else {
-						err = fmt.Errorf("the box cannot be initialized due to panic: %v", e)
-					}
-				}
-			}()
-			return sb.Init(t.ctx)
-		}()
-		if err != nil {
+		if err := initBox(t.ctx, sb); err != nil {
 			return nil, err
 		}
 	}
@@ -0,0 +1,163 @@
+package core
+
+import (
+	"errors"
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+	"testing"
+)
+
+// stateInheritingBox adds "replaced": true to all tuples and records the
+// Box it replaced.
+type stateInheritingBox struct {
+	prev         Box
+	inheritErr   error
+	terminateCnt int
+}
+
+var (
+	_ StatefulBox        = &stateInheritingBox{}
+	_ StateInheritingBox = &stateInheritingBox{}
+)
+
+func (b *stateInheritingBox) Init(ctx *Context) error {
+	return nil
+}
+
+func (b *stateInheritingBox) Process(ctx *Context, t *Tuple, w Writer) error {
+	t = t.Copy()
+	t.Data["replaced"] = data.True
+	return w.Write(ctx, t)
+}
+
+func (b *stateInheritingBox) Terminate(ctx *Context) error {
+	b.terminateCnt++
+	return nil
+}
+
+func (b *stateInheritingBox) InheritState(ctx *Context, prev Box) error {
+	if b.inheritErr != nil {
+		return b.inheritErr
+	}
+	b.prev = prev
+	return nil
+}
+
+func TestDefaultTopologyReplaceBox(t *testing.T) {
+	Convey("Given a simple linear topology", t, func() {
+		/*
+		 *   so -*--> b -*--> si
+		 */
+		dt, err := NewDefaultTopology(NewContext(nil), "dt1")
+		So(err, ShouldBeNil)
+		t := dt.(*defaultTopology)
+		Reset(func() {
+			t.Stop()
+		})
+
+		so := NewTupleIncrementalEmitterSource(freshTuples())
+		_, err = t.AddSource("source", so, nil)
+		So(err, ShouldBeNil)
+
+		b := newTerminateChecker(BoxFunc(forwardBox))
+		bn, err := t.AddBox("box", b, nil)
+		So(err, ShouldBeNil)
+		So(bn.Input("source", nil), ShouldBeNil)
+
+		si := NewTupleCollectorSink()
+		sin, err := t.AddSink("sink", si, nil)
+		So(err, ShouldBeNil)
+		So(sin.Input("box", nil), ShouldBeNil)
+
+		so.EmitTuples(2)
+		si.Wait(2)
+
+		Convey("When replacing the box", func() {
+			nb := &stateInheritingBox{}
+			So(bn.ReplaceBox(nb), ShouldBeNil)
+
+			Convey("Then the new box should process subsequent tuples", func() {
+				so.EmitTuples(2)
+				si.Wait(4)
+				So(si.get(1).Data["replaced"], ShouldBeNil)
+				So(si.get(2).Data["replaced"], ShouldEqual, data.True)
+				So(si.get(3).Data["replaced"], ShouldEqual, data.True)
+			})
+
+			Convey("Then the old box should be terminated", func() {
+				So(b.terminateCnt, ShouldEqual, 1)
+				So(nb.terminateCnt, ShouldEqual, 0)
+			})
+
+			Convey("Then the new box should receive the old box", func() {
+				So(nb.prev, ShouldPointTo, b)
+			})
+
+			Convey("Then Box should return the new box", func() {
+				So(bn.Box(), ShouldPointTo, nb)
+			})
+
+			Convey("And stopping the topology", func() {
+				So(t.Stop(), ShouldBeNil)
+
+				Convey("Then only the new box should be terminated", func() {
+					So(b.terminateCnt, ShouldEqual, 1)
+					So(nb.terminateCnt, ShouldEqual, 1)
+				})
+
+				Convey("Then the box cannot be replaced any more", func() {
+					So(bn.ReplaceBox(BoxFunc(forwardBox)), ShouldNotBeNil)
+				})
+			})
+		})
+
+		Convey("When the new box fails to inherit the state", func() {
+			nb := &stateInheritingBox{
+				inheritErr: errors.New("failure"),
+			}
+			err := bn.ReplaceBox(nb)
+
+			Convey("Then ReplaceBox should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+
+			Convey("Then the new box should be terminated", func() {
+				So(nb.terminateCnt, ShouldEqual, 1)
+				So(b.terminateCnt, ShouldEqual, 0)
+			})
+
+			Convey("Then the old box should still process tuples", func() {
+				So(bn.Box(), ShouldPointTo, b)
+				so.EmitTuples(1)
+				si.Wait(3)
+				So(si.get(2).Data["replaced"], ShouldBeNil)
+			})
+		})
+
+		Convey("When replacing the box with a box not accepting the input name", func() {
+			err := bn.ReplaceBox(&DoesNothingBoxWithInputNames{InNames: []string{"hoge"}})
+
+			Convey("Then ReplaceBox should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(bn.Box(), ShouldPointTo, b)
+			})
+		})
+
+		Convey("When removing the input", func() {
+			So(bn.RemoveInput("SOURCE"), ShouldBeNil)
+
+			Convey("Then removing it again should fail", func() {
+				err := bn.RemoveInput("source")
+				So(IsNotExist(err), ShouldBeTrue)
+			})
+		})
+
+		Convey("When removing an input from a node which doesn't exist", func() {
+			err := bn.RemoveInput("no_such_node")
+
+			Convey("Then it should fail", func() {
+				So(err, ShouldNotBeNil)
+			})
+		})
+	})
+}
@@ -225,6 +225,24 @@ type BoxNode interface {
 	// tuples. There must be a Source or a Box having the name.
 	Input(refname string, config *BoxInputConfig) error
 
+	// RemoveInput disconnects the input from the node having the given name.
+	// It returns NotExistError when the Box doesn't receive tuples from the
+	// node. Tuples which have already been queued in the input are discarded.
+	RemoveInput(refname string) error
+
+	// ReplaceBox replaces the Box processing tuples with the given Box
+	// without disconnecting any input or output of the node. The new Box is
+	// initialized before it replaces the current one if it's a StatefulBox,
+	// and the current Box is terminated after it has been replaced. If
+	// the new Box is a StateInheritingBox, it can take over the state of the
+	// current Box. The current Box doesn't receive any tuple after
+	// ReplaceBox returns.
+	//
+	// The new Box must accept the input names of all inputs which are
+	// currently connected to the node. ReplaceBox fails when the node is
+	// already stopped.
+	ReplaceBox(b Box) error
+
 	// EnableGracefulStop activates a graceful stop mode. If it is enabled,
 	// Stop method waits until the Box doesn't have an incoming tuple. The Box
 	// doesn't wait until, for example, a source generates all tuples. It only
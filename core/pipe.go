@@ -320,6 +320,26 @@ func (s *dataSources) remove(name string) {
 	r.close() // This eventually closes the channel.
 }
 
+// has returns true if the dataSources receives tuples from the node having
+// the given name.
+func (s *dataSources) has(name string) bool {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	_, ok := s.recvs[name]
+	return ok
+}
+
+// inputNames returns the input names of all receivers.
+func (s *dataSources) inputNames() []string {
+	s.m.RLock()
+	defer s.m.RUnlock()
+	names := make([]string, 0, len(s.recvs))
+	for _, r := range s.recvs {
+		names = append(names, r.sender.inputName)
+	}
+	return names
+}
+
 // pour pours out tuples for the target Writer. The target must directly be
 // connected to a Box or a Sink.
 func (s *dataSources) pour(ctx *Context, w Writer, parallelism int) error {
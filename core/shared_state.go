@@ -68,6 +68,19 @@ type LoadableSharedState interface {
 	Load(ctx *Context, r io.Reader, params data.Map) error
 }
 
+// LookupableSharedState is a SharedState which has entries that can be
+// looked up by a key, e.g., by a JOIN STATE clause of a BQL statement.
+type LookupableSharedState interface {
+	SharedState
+
+	// Lookup returns the entry associated with the given key. It returns
+	// NotExistError if the state doesn't have an entry for the key. The
+	// caller must not modify the returned Map.
+	//
+	// Lookup and other methods including Write can be called concurrently.
+	Lookup(ctx *Context, key data.Value) (data.Map, error)
+}
+
 // TODO: Add MixiableSharedState interface
 
 // SharedStateRegistry manages SharedState with names assigned to each state.
@@ -97,6 +97,10 @@ type SourceConfig struct {
 	// If it is true, the source is removed.
 	RemoveOnStop bool
 
+	// Validator validates tuples emitted by the source. Invalid tuples are
+	// reported as dropped tuples. No validation is done when it's nil.
+	Validator TupleValidator
+
 	// Meta contains meta information of the source. This field won't be used
 	// by core package and application can store any form of information
 	// related to the source.
@@ -111,6 +115,11 @@ type BoxConfig struct {
 	// If it is true, the box is removed.
 	RemoveOnStop bool
 
+	// Validator validates tuples emitted by the box. Invalid tuples are
+	// reported as dropped tuples. No validation is done when it's nil.
+	// It isn't changed by BoxNode.ReplaceBox.
+	Validator TupleValidator
+
 	// Meta contains meta information of the box. This field won't be used
 	// by core package and application can store any form of information
 	// related to the box.
@@ -0,0 +1,61 @@
+package core
+
+// TupleValidator validates tuples emitted by a source or a box. It can be
+// set to SourceConfig.Validator or BoxConfig.Validator.
+type TupleValidator interface {
+	// Validate returns an error when the given tuple is invalid. Such a
+	// tuple isn't emitted but is reported as a dropped tuple with the
+	// error. Validate may modify the Data of the tuple, for example to
+	// convert values to the expected types. The tuple is copied before
+	// Validate is called when its Data is shared with other tuples.
+	Validate(t *Tuple) error
+}
+
+type tupleValidatorFunc func(t *Tuple) error
+
+// TupleValidatorFunc creates a TupleValidator from a function.
+func TupleValidatorFunc(f func(t *Tuple) error) TupleValidator {
+	return tupleValidatorFunc(f)
+}
+
+func (f tupleValidatorFunc) Validate(t *Tuple) error {
+	return f(t)
+}
+
+// validatingWriter validates tuples before writing them to the underlying
+// WriteCloser.
+type validatingWriter struct {
+	w        WriteCloser
+	v        TupleValidator
+	nodeType NodeType
+	nodeName string
+}
+
+// newValidatingWriter returns w as is when v is nil.
+func newValidatingWriter(w WriteCloser, v TupleValidator, nodeType NodeType, nodeName string) WriteCloser {
+	if v == nil {
+		return w
+	}
+	return &validatingWriter{
+		w:        w,
+		v:        v,
+		nodeType: nodeType,
+		nodeName: nodeName,
+	}
+}
+
+func (vw *validatingWriter) Write(ctx *Context, t *Tuple) error {
+	if t.Flags.IsSet(TFShared) || t.Flags.IsSet(TFSharedData) {
+		t = t.Copy()
+		t.Flags.Clear(TFShared)
+	}
+	if err := vw.v.Validate(t); err != nil {
+		ctx.droppedTuple(t, vw.nodeType, vw.nodeName, ETOutput, err)
+		return nil
+	}
+	return vw.w.Write(ctx, t)
+}
+
+func (vw *validatingWriter) Close(ctx *Context) error {
+	return vw.w.Close(ctx)
+}
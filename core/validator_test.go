@@ -0,0 +1,106 @@
+package core
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+	"gopkg.in/sensorbee/sensorbee.v0/data"
+)
+
+func TestTupleValidator(t *testing.T) {
+	Convey("Given a topology and a validator accepting even sequence numbers", t, func() {
+		ctx := NewContext(nil)
+		t, err := NewDefaultTopology(ctx, "dt1")
+		So(err, ShouldBeNil)
+		Reset(func() {
+			t.Stop()
+		})
+
+		v := TupleValidatorFunc(func(t *Tuple) error {
+			seq, _ := data.AsInt(t.Data["seq"])
+			if seq%2 != 0 {
+				return errors.New("odd sequence number")
+			}
+			t.Data["valid"] = data.True
+			return nil
+		})
+
+		dtso := NewDroppedTupleCollectorSource().(*droppedTupleCollectorSource)
+		_, err = t.AddSource("dropped_tuples", dtso, nil)
+		So(err, ShouldBeNil)
+		dtso.state.Wait(TSRunning)
+		dsi := NewTupleCollectorSink()
+		dsin, err := t.AddSink("dropped_sink", dsi, nil)
+		So(err, ShouldBeNil)
+		So(dsin.Input("dropped_tuples", nil), ShouldBeNil)
+
+		checkResults := func(si *TupleCollectorSink, nodeType NodeType, name string) {
+			si.Wait(4)
+			So(si.len(), ShouldEqual, 4)
+			for i := 0; i < 4; i++ {
+				So(si.get(i).Data["seq"], ShouldEqual, data.Int(2*i+2))
+				So(si.get(i).Data["valid"], ShouldEqual, data.True)
+			}
+
+			dsi.Wait(4)
+			So(dsi.len(), ShouldEqual, 4)
+			d := dsi.get(0).Data
+			So(d["node_type"], ShouldEqual, data.String(nodeType.String()))
+			So(d["node_name"], ShouldEqual, data.String(name))
+			So(d["event_type"], ShouldEqual, data.String(ETOutput.String()))
+			So(d["error"], ShouldEqual, data.String("odd sequence number"))
+			So(d["data"], ShouldResemble, data.Map{"seq": data.Int(1)})
+		}
+
+		Convey("When a source has the validator", func() {
+			son, err := t.AddSource("source", NewTupleEmitterSource(freshTuples()), &SourceConfig{
+				PausedOnStartup: true,
+				Validator:       v,
+			})
+			So(err, ShouldBeNil)
+			si := NewTupleCollectorSink()
+			sin, err := t.AddSink("sink", si, nil)
+			So(err, ShouldBeNil)
+			So(sin.Input("source", nil), ShouldBeNil)
+			So(son.Resume(), ShouldBeNil)
+
+			Convey("Then only valid tuples should be emitted and others should be dropped", func() {
+				checkResults(si, NTSource, "source")
+			})
+		})
+
+		Convey("When a box has the validator", func() {
+			son, err := t.AddSource("source", NewTupleEmitterSource(freshTuples()), &SourceConfig{
+				PausedOnStartup: true,
+			})
+			So(err, ShouldBeNil)
+			bn, err := t.AddBox("box", BoxFunc(forwardBox), &BoxConfig{
+				Validator: v,
+			})
+			So(err, ShouldBeNil)
+			So(bn.Input("source", nil), ShouldBeNil)
+			// the box should get a copy of a shared tuple
+			other := NewTupleCollectorSink()
+			osin, err := t.AddSink("other", other, nil)
+			So(err, ShouldBeNil)
+			So(osin.Input("source", nil), ShouldBeNil)
+			si := NewTupleCollectorSink()
+			sin, err := t.AddSink("sink", si, nil)
+			So(err, ShouldBeNil)
+			So(sin.Input("box", nil), ShouldBeNil)
+			So(son.Resume(), ShouldBeNil)
+
+			Convey("Then only valid tuples should be emitted and others should be dropped", func() {
+				checkResults(si, NTBox, "box")
+
+				Convey("And tuples shared with other nodes should not be modified", func() {
+					other.Wait(8)
+					for i := 0; i < 8; i++ {
+						So(other.get(i).Data, ShouldNotContainKey, "valid")
+					}
+				})
+			})
+		})
+	})
+}
@@ -63,6 +63,24 @@ func CompilePath(s string) (p Path, err error) {
 	return j, nil
 }
 
+// MapKeys returns the keys of the map accesses at the beginning of the
+// given Path, e.g. ["a", "b"] for `a.b[0]`. complete is false when the
+// Path has other kinds of accesses after those, such as array accesses.
+func MapKeys(p Path) (keys []string, complete bool) {
+	j, ok := p.(*jsonPeg)
+	if !ok {
+		return nil, false
+	}
+	for _, c := range j.components {
+		a, ok := c.(*mapValueExtractor)
+		if !ok {
+			return keys, false
+		}
+		keys = append(keys, a.key)
+	}
+	return keys, true
+}
+
 // evaluate returns the entry of the map located at the JSON Path
 // represented by this jsonPeg instance.
 func (j *jsonPeg) evaluate(m Map) (Value, error) {
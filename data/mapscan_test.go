@@ -1066,6 +1066,35 @@ func TestScanMap(t *testing.T) {
 	})
 }
 
+func TestMapKeys(t *testing.T) {
+	Convey("Given JSON Paths", t, func() {
+		examples := []struct {
+			path     string
+			keys     []string
+			complete bool
+		}{
+			{"a", []string{"a"}, true},
+			{"a.b", []string{"a", "b"}, true},
+			{`["a"]["b.c"]`, []string{"a", "b.c"}, true},
+			{"a.b[0].c", []string{"a", "b"}, false},
+			{"a..b", []string{"a"}, false},
+			{"a[1:2]", []string{"a"}, false},
+		}
+
+		for _, e := range examples {
+			e := e
+			Convey("When getting the map keys of "+e.path, func() {
+				keys, complete := MapKeys(MustCompilePath(e.path))
+
+				Convey("Then they should be the keys at the beginning of the path", func() {
+					So(keys, ShouldResemble, e.keys)
+					So(complete, ShouldEqual, e.complete)
+				})
+			})
+		}
+	})
+}
+
 func BenchmarkMapAccess(b *testing.B) {
 	m := Map{
 		"store": Map{